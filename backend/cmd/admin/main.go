@@ -0,0 +1,23 @@
+package main
+
+import (
+	"log"
+	"net/http"
+
+	"good-todo-go/internal/infrastructure/database"
+	"good-todo-go/internal/presentation/admin/router"
+)
+
+func main() {
+	e, cfg, entClient, err := router.NewRouter()
+	if err != nil {
+		log.Fatalf("Failed to initialize admin router: %v", err)
+	}
+	defer database.CloseEntClient(entClient)
+
+	addr := ":" + cfg.AdminPort
+	log.Printf("Starting admin server on %s", addr)
+	if err := e.Start(addr); err != nil && err != http.ErrServerClosed {
+		log.Fatalf("Failed to start admin server: %v", err)
+	}
+}
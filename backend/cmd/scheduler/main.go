@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"good-todo-go/internal/infrastructure/database"
+	"good-todo-go/internal/infrastructure/environment"
+	"good-todo-go/internal/infrastructure/repository"
+	"good-todo-go/internal/infrastructure/storage"
+	"good-todo-go/internal/pkg"
+	"good-todo-go/internal/usecase"
+)
+
+// The scheduler delivers due reminders, purges todos whose trash retention
+// has passed and deletes the blobs of deleted attachments. It can run next to
+// the API and with any number of replicas: each reminder is claimed with FOR
+// UPDATE SKIP LOCKED, so replicas never deliver the same reminder, and
+// purging a todo or deleting a blob twice is harmless.
+func main() {
+	cfg, err := environment.LoadConfig()
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+
+	entClient, err := database.NewEntClient(cfg)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer database.CloseEntClient(entClient)
+
+	tenantRepo := repository.NewTenantRepository(entClient)
+	mailer := pkg.NewSMTPMailer(cfg.SMTPHost, cfg.SMTPPort, cfg.SMTPUser, cfg.SMTPPassword, cfg.SMTPFrom)
+	scheduler := usecase.NewReminderScheduler(
+		tenantRepo,
+		repository.NewReminderRepository(entClient),
+		cfg.ReminderBatchSize,
+		usecase.NewEmailReminderChannel(mailer, pkg.NewLinkBuilder(cfg.FrontendURL)),
+		usecase.NewInAppReminderChannel(repository.NewNotificationRepository(entClient), pkg.NewUUIDGenerator()),
+	)
+
+	blobStore, err := storage.NewBlobStore(cfg)
+	if err != nil {
+		log.Fatalf("Failed to set up blob store: %v", err)
+	}
+	blobCleaner := usecase.NewBlobCleaner(
+		tenantRepo,
+		repository.NewAttachmentRepository(entClient),
+		blobStore,
+		cfg.BlobCleanupBatchSize,
+	)
+
+	trashPurger := usecase.NewTrashPurger(
+		tenantRepo,
+		repository.NewTodoRepository(entClient),
+		cfg.TrashPurgeBatchSize,
+	)
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	log.Printf("Starting scheduler (every %s)", cfg.ReminderPollInterval)
+	ticker := time.NewTicker(cfg.ReminderPollInterval)
+	defer ticker.Stop()
+	for {
+		n, err := scheduler.RunOnce(ctx)
+		if err != nil && ctx.Err() == nil {
+			log.Printf("Reminder run failed: %v", err)
+		}
+		if n > 0 {
+			log.Printf("Processed %d due reminder(s)", n)
+		}
+
+		// Purge before cleaning blobs, so attachments of purged todos go in the same run
+		n, err = trashPurger.RunOnce(ctx)
+		if err != nil && ctx.Err() == nil {
+			log.Printf("Trash purge failed: %v", err)
+		}
+		if n > 0 {
+			log.Printf("Purged %d todo(s) from the trash", n)
+		}
+
+		n, err = blobCleaner.RunOnce(ctx)
+		if err != nil && ctx.Err() == nil {
+			log.Printf("Blob cleanup failed: %v", err)
+		}
+		if n > 0 {
+			log.Printf("Deleted %d blob(s) of deleted attachments", n)
+		}
+
+		select {
+		case <-ctx.Done():
+			log.Println("Scheduler stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
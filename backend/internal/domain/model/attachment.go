@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+// Attachment is a file attached to a todo. Its contents live in the blob
+// store under StorageKey.
+type Attachment struct {
+	ID         string
+	TenantID   string
+	TodoID     string
+	UploadedBy string
+	FileName   string
+	// ContentType is sniffed from the contents
+	ContentType string
+	Size        int64
+	StorageKey  string
+	CreatedAt   time.Time
+}
@@ -0,0 +1,11 @@
+package model
+
+// Calendar components todos are rendered as in calendar feeds and exports
+const (
+	// CalendarComponentEvent places todos on the calendar at their due date,
+	// which every calendar app shows
+	CalendarComponentEvent = "vevent"
+	// CalendarComponentTodo keeps todos as tasks with their completion, for
+	// apps that support them
+	CalendarComponentTodo = "vtodo"
+)
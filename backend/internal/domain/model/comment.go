@@ -0,0 +1,21 @@
+package model
+
+import "time"
+
+// MaxCommentLength bounds the markdown body of a comment, in characters
+const MaxCommentLength = 10000
+
+// Comment is a markdown comment in the thread of a todo
+type Comment struct {
+	ID       string
+	TenantID string
+	TodoID   string
+	// AuthorID is nil once the author's account has been deleted
+	AuthorID *string
+	Body     string
+	EditedAt *time.Time
+	// DeletedAt is set on deleted comments, which keep their place in the
+	// thread with an empty body
+	DeletedAt *time.Time
+	CreatedAt time.Time
+}
@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+// Notification is an in-app message for a user
+type Notification struct {
+	ID       string
+	TenantID string
+	UserID   string
+	// TodoID is nil when the notification is not about a todo, or the todo was deleted
+	TodoID *string
+	// ReminderID is set when the notification delivers a reminder
+	ReminderID *string
+	Title      string
+	Body       string
+	ReadAt     *time.Time
+	CreatedAt  time.Time
+}
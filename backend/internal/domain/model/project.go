@@ -0,0 +1,24 @@
+package model
+
+import "time"
+
+// Project groups a user's todos. Like a todo, it can be shared read-only with
+// the rest of the tenant through IsPublic.
+type Project struct {
+	ID          string
+	TenantID    string
+	UserID      string
+	Name        string
+	Description string
+	IsPublic    bool
+	// ArchivedAt is nil while the project is active
+	ArchivedAt *time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// ProjectTodoCounts is the number of open and completed todos in a project
+type ProjectTodoCounts struct {
+	Open      int
+	Completed int
+}
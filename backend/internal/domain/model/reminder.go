@@ -0,0 +1,39 @@
+package model
+
+import "time"
+
+// Reminder delivery channels
+const (
+	ReminderChannelEmail = "email"
+	ReminderChannelInApp = "in_app"
+)
+
+// MaxReminderAttempts is how often delivery of a reminder is tried before it is given up
+const MaxReminderAttempts = 5
+
+// Reminder notifies the owner of a todo at RemindAt
+type Reminder struct {
+	ID       string
+	TenantID string
+	TodoID   string
+	RemindAt time.Time
+	// OffsetMinutes is set when the reminder is relative to the todo's due date
+	OffsetMinutes *int
+	Channel       string
+	SentAt        *time.Time
+	// FailedAt is set when delivery was given up
+	FailedAt  *time.Time
+	CreatedAt time.Time
+}
+
+// DueReminder is a reminder claimed for delivery, with what the channels need to know
+type DueReminder struct {
+	Reminder  *Reminder
+	Attempts  int
+	TodoTitle string
+	DueDate   *time.Time
+	// UserID, Email and UserName describe the todo's owner, who receives the reminder
+	UserID   string
+	Email    string
+	UserName string
+}
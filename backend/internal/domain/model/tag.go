@@ -0,0 +1,16 @@
+package model
+
+import "time"
+
+// Tag is a tenant-wide label that can be attached to any todo in the tenant
+type Tag struct {
+	ID        string
+	TenantID  string
+	Name      string
+	Color     string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// DefaultTagColor is used when a tag is created without a color
+const DefaultTagColor = "#808080"
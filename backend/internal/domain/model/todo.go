@@ -11,6 +11,208 @@ type Todo struct {
 	Completed   bool
 	IsPublic    bool
 	DueDate     *time.Time
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	CompletedAt *time.Time
+	// Priority is one of TodoPriorities
+	Priority string
+	// Position is the fractional index key of the todo in its owner's manual order
+	Position string
+	// AssigneeID is a member of the same tenant who may view and complete the
+	// todo; UserID stays the creator
+	AssigneeID *string
+	// ProjectID is nil when the todo is not in a project
+	ProjectID *string
+	// ParentID is set on subtasks; a parent always belongs to the same user
+	ParentID *string
+	// Recurrence is set on the open occurrence of a recurring series
+	Recurrence *TodoRecurrence
+	// Tags are sorted by name; nil when not loaded
+	Tags []*Tag
+	// Shares are only loaded when reading single todos, for access checks
+	Shares []*TodoShare
+	// Reminders are only written when the todo is created, for the next
+	// occurrence of a series; reads leave them out
+	Reminders []*Reminder
+	// CommentCount leaves out deleted comments
+	CommentCount int
+	// DeletedAt is set while the todo is in the trash
+	DeletedAt *time.Time
+	// Version goes up with every change; writes check it to detect concurrent changes
+	Version   int
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// MaxTodoDepth is how many levels a todo hierarchy may have, counting the
+// top-level todo: with 3, a subtask may have subtasks but those may not.
+const MaxTodoDepth = 3
+
+// Todo priorities
+const (
+	TodoPriorityNone   = "none"
+	TodoPriorityLow    = "low"
+	TodoPriorityMedium = "medium"
+	TodoPriorityHigh   = "high"
+	TodoPriorityUrgent = "urgent"
+)
+
+// TodoPriorities lists the priorities from lowest to highest. A priority is
+// stored as its index here so that todos sort by it.
+var TodoPriorities = []string{
+	TodoPriorityNone,
+	TodoPriorityLow,
+	TodoPriorityMedium,
+	TodoPriorityHigh,
+	TodoPriorityUrgent,
+}
+
+// MaxTodoPositionLength is how long a position key may grow before the
+// owner's positions are spread out again
+const MaxTodoPositionLength = 24
+
+// TodoRecurrence makes a todo repeat. Completing the occurrence creates the
+// next one and moves the recurrence over to it.
+type TodoRecurrence struct {
+	// Rule is an RRULE in canonical form
+	Rule     string
+	Timezone string
+	// Start is the due date of the first occurrence
+	Start time.Time
+}
+
+// TodoFilter narrows a todo listing. Nil fields are not filtered on.
+type TodoFilter struct {
+	Completed *bool
+	// Overdue matches incomplete todos whose due date has passed (or, when false, everything else)
+	Overdue *bool
+	// HasDueDate matches todos with (or, when false, without) a due date
+	HasDueDate    *bool
+	DueBefore     *time.Time
+	DueAfter      *time.Time
+	CreatedBefore *time.Time
+	CreatedAfter  *time.Time
+	// CompletedBefore/CompletedAfter implicitly restrict the listing to completed todos
+	CompletedBefore *time.Time
+	CompletedAfter  *time.Time
+	IsPublic        *bool
+	// Search is a case-insensitive substring match on the title
+	Search *string
+	// TagIDs matches todos that carry every one of these tags
+	TagIDs []string
+	// ProjectID matches todos in this project
+	ProjectID *string
+	// IncludeDeleted also matches todos in the trash. Only FindByUserID and
+	// CountByUserID honour it.
+	IncludeDeleted bool
+}
+
+// TodoAssignment records one change of a todo's assignee
+type TodoAssignment struct {
+	ID       string
+	TenantID string
+	TodoID   string
+	// PreviousAssigneeID and AssigneeID are nil when the todo was unassigned
+	PreviousAssigneeID *string
+	AssigneeID         *string
+	ChangedBy          string
+	CreatedAt          time.Time
+}
+
+// Permissions a todo can be shared with
+const (
+	TodoPermissionRead = "read"
+	// TodoPermissionEdit also allows changing the todo's own fields, but not
+	// its visibility, project, parent or sharing
+	TodoPermissionEdit = "edit"
+)
+
+// TodoShare gives a member of the tenant access to a todo they do not own
+type TodoShare struct {
+	ID       string
+	TenantID string
+	TodoID   string
+	UserID   string
+	// Permission is TodoPermissionRead or TodoPermissionEdit
+	Permission string
+	CreatedBy  string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// TodoBulkChange is a batch of todo writes that succeed or fail together
+type TodoBulkChange struct {
+	// Updates are written like a single todo update
+	Updates []*Todo
+	// CompleteDescendants are completed todos whose open subtasks are
+	// completed with them, at the same time
+	CompleteDescendants []*Todo
+	// Creates are the next occurrences of completed recurring todos, with
+	// their reminders
+	Creates []*Todo
+	// Deletes go to the trash without their subtasks, which become top-level todos
+	Deletes []string
+	// DeleteSubtrees go to the trash together with all of their subtasks
+	DeleteSubtrees []string
+	// TagLinks attach tags to todos; links that already exist are left alone
+	TagLinks []*TodoTagLink
+}
+
+// TodoTagLink attaches a tag to a todo
+type TodoTagLink struct {
+	TenantID string
+	TodoID   string
+	TagID    string
+}
+
+// Sortable todo fields
+const (
+	TodoSortDueDate   = "due_date"
+	TodoSortCreatedAt = "created_at"
+	TodoSortUpdatedAt = "updated_at"
+	TodoSortTitle     = "title"
+	TodoSortPriority  = "priority"
+	// TodoSortPosition is the owner's manual order
+	TodoSortPosition = "position"
+)
+
+// TodoSort orders a todo listing. Todos without a due date sort last.
+type TodoSort struct {
+	Field string
+	Desc  bool
+}
+
+// TodoCursor is the position of the last todo of a page in keyset pagination.
+// Only the value of the field being sorted on is set.
+type TodoCursor struct {
+	ID string
+	// Time holds created_at, updated_at or due_date; nil for an undated todo
+	Time     *time.Time
+	Title    string
+	Priority string
+	Position string
+}
+
+// TodoPage selects a page of a todo listing, either after a cursor or by offset
+type TodoPage struct {
+	Limit  int
+	Offset int
+	// After takes precedence over Offset when set
+	After *TodoCursor
+}
+
+// Markers around matched words in search highlights. They are control
+// characters so they cannot collide with user text and can be swapped for
+// markup after the text has been escaped.
+const (
+	SearchHighlightStart = "\x02"
+	SearchHighlightEnd   = "\x03"
+)
+
+// TodoSearchHit is a todo matched by a full-text search
+type TodoSearchHit struct {
+	Todo *Todo
+	Rank float64
+	// TitleHighlight is the full title with matches wrapped in the highlight markers
+	TitleHighlight string
+	// Snippet is an excerpt of the description with matches wrapped in the highlight markers
+	Snippet string
 }
@@ -0,0 +1,55 @@
+package model
+
+import "time"
+
+// Todo revision operations
+const (
+	TodoRevisionCreate = "create"
+	TodoRevisionUpdate = "update"
+	// TodoRevisionDelete and TodoRevisionRestore move the todo to and from the trash
+	TodoRevisionDelete  = "delete"
+	TodoRevisionRestore = "restore"
+	// TodoRevisionPurge deletes the todo for good, e.g. when the trash is emptied
+	TodoRevisionPurge = "purge"
+)
+
+// Todo fields recorded in revisions
+const (
+	TodoFieldUserID             = "user_id"
+	TodoFieldTitle              = "title"
+	TodoFieldDescription        = "description"
+	TodoFieldCompleted          = "completed"
+	TodoFieldCompletedAt        = "completed_at"
+	TodoFieldIsPublic           = "is_public"
+	TodoFieldPriority           = "priority"
+	TodoFieldDueDate            = "due_date"
+	TodoFieldAssigneeID         = "assignee_id"
+	TodoFieldProjectID          = "project_id"
+	TodoFieldParentID           = "parent_id"
+	TodoFieldRecurrenceRule     = "recurrence_rule"
+	TodoFieldRecurrenceTimezone = "recurrence_timezone"
+	TodoFieldRecurrenceStart    = "recurrence_start"
+)
+
+// TodoRevision records one change of a todo
+type TodoRevision struct {
+	ID       string
+	TenantID string
+	TodoID   string
+	// ActorID is nil for changes made outside of a user's request, like by the scheduler
+	ActorID   *string
+	Operation string
+	// Changes are sorted by field; a delete or restore may have none, and a
+	// purge lists the values the todo had
+	Changes   []*TodoFieldChange
+	CreatedAt time.Time
+}
+
+// TodoFieldChange is one field a revision changed. Old and New are nil when
+// the field was unset and otherwise a string, bool or time.Time; a priority
+// is its name.
+type TodoFieldChange struct {
+	Field string
+	Old   any
+	New   any
+}
@@ -3,23 +3,96 @@ package model
 import "time"
 
 type User struct {
-	ID                         string
-	TenantID                   string
-	Email                      string
-	PasswordHash               string
-	Name                       string
-	Role                       string
-	EmailVerified              bool
+	ID            string
+	TenantID      string
+	Email         string
+	PasswordHash  string
+	Name          string
+	Role          string
+	EmailVerified bool
+	IsActive      bool
+	// IsSystem marks accounts the app manages itself, like the deleted-user
+	// placeholder. They are left out of user listings.
+	IsSystem                   bool
+	ExternalID                 *string
 	VerificationToken          *string
 	VerificationTokenExpiresAt *time.Time
 	CreatedAt                  time.Time
 	UpdatedAt                  time.Time
 }
 
+// UserFilter narrows a user listing. Nil fields are not filtered on.
+type UserFilter struct {
+	Email      *string
+	ExternalID *string
+	Role       *string
+	IsActive   *bool
+	// System lists system users instead of members
+	System bool
+}
+
 type Tenant struct {
 	ID        string
 	Name      string
 	Slug      string
+	Settings  TenantSettings
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
+
+// TenantSettings holds the options a tenant admin can change
+type TenantSettings struct {
+	MagicLinkEnabled      bool
+	AccountDeletionPolicy string
+	SearchLanguage        string
+	// TrashRetentionDays is how long deleted todos stay in the trash
+	TrashRetentionDays int
+}
+
+// Bounds of TenantSettings.TrashRetentionDays
+const (
+	MinTrashRetentionDays = 1
+	MaxTrashRetentionDays = 365
+)
+
+// DefaultSearchLanguage indexes words as-is, without stemming or stop words
+const DefaultSearchLanguage = "simple"
+
+// SearchLanguages are the PostgreSQL text search configurations a tenant can choose
+var SearchLanguages = []string{
+	DefaultSearchLanguage, "danish", "dutch", "english", "finnish", "french", "german", "hungarian",
+	"italian", "norwegian", "portuguese", "romanian", "russian", "spanish", "swedish", "turkish",
+}
+
+// Account deletion policies decide who takes over a deleted member's public todos
+const (
+	// AccountDeletionAnonymize hands them to the tenant's "Deleted user" placeholder
+	AccountDeletionAnonymize = "anonymize"
+	// AccountDeletionReassign hands them to another tenant admin
+	AccountDeletionReassign = "reassign"
+)
+
+// MagicLinkToken is a single-use login token. Only the hash of the
+// emailed token is stored.
+type MagicLinkToken struct {
+	ID        string
+	TenantID  string
+	UserID    string
+	TokenHash string
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+// PasswordResetToken is a single-use token that lets a user set a new
+// password without knowing the current one. Only the hash of the emailed
+// token is stored.
+type PasswordResetToken struct {
+	ID        string
+	TenantID  string
+	UserID    string
+	TokenHash string
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
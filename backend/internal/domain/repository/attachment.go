@@ -0,0 +1,25 @@
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_repository
+package repository
+
+import (
+	"context"
+
+	"good-todo-go/internal/domain/model"
+)
+
+type IAttachmentRepository interface {
+	// All operations are tenant scoped (tenantID from context, RLS protected)
+	FindByID(ctx context.Context, attachmentID string) (*model.Attachment, error)
+	// FindByTodoID lists a todo's attachments, oldest first
+	FindByTodoID(ctx context.Context, todoID string) ([]*model.Attachment, error)
+	Create(ctx context.Context, attachment *model.Attachment) (*model.Attachment, error)
+	// Delete removes the attachment and queues its blob for deletion. Blobs of
+	// attachments removed along with their todo are queued the same way.
+	Delete(ctx context.Context, attachmentID string) error
+	// PendingBlobDeletions lists up to limit storage keys of the tenant whose
+	// blobs are waiting to be deleted, oldest first. The scheduler has no
+	// request context, so the tenant is passed explicitly.
+	PendingBlobDeletions(ctx context.Context, tenantID string, limit int) ([]string, error)
+	// CompleteBlobDeletions takes storage keys off the tenant's queue once their blobs are gone
+	CompleteBlobDeletions(ctx context.Context, tenantID string, storageKeys []string) error
+}
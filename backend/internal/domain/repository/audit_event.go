@@ -0,0 +1,20 @@
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_repository
+package repository
+
+import (
+	"context"
+
+	"good-todo-go/internal/domain/model"
+)
+
+type IAuditEventRepository interface {
+	// Create appends an event to the audit log of event.TenantID. It does not
+	// need tenant context, so events can be recorded before anyone is signed in.
+	Create(ctx context.Context, event *model.AuditEvent) error
+	// List returns a page of the tenant's events, newest first, and the total number of matches
+	List(ctx context.Context, filter *model.AuditEventFilter, offset, limit int) ([]*model.AuditEvent, int, error)
+	// ListAll returns a page of the events of every tenant, newest first, and
+	// the total number of matches. It runs without tenant context and is meant
+	// for the admin API only.
+	ListAll(ctx context.Context, filter *model.AuditEventFilter, offset, limit int) ([]*model.AuditEvent, int, error)
+}
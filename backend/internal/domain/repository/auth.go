@@ -3,6 +3,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"good-todo-go/internal/domain/model"
 )
@@ -10,6 +11,7 @@ import (
 type IAuthRepository interface {
 	// Tenant operations
 	FindTenantBySlug(ctx context.Context, slug string) (*model.Tenant, error)
+	FindTenantByID(ctx context.Context, tenantID string) (*model.Tenant, error)
 	CreateTenant(ctx context.Context, tenant *model.Tenant) (*model.Tenant, error)
 
 	// User operations
@@ -19,4 +21,18 @@ type IAuthRepository interface {
 	FindUserByVerificationToken(ctx context.Context, token string) (*model.User, error)
 	CreateUser(ctx context.Context, user *model.User) (*model.User, error)
 	UpdateUser(ctx context.Context, user *model.User) (*model.User, error)
+
+	// Magic link operations
+	CreateMagicLinkToken(ctx context.Context, token *model.MagicLinkToken) error
+	// ConsumeMagicLinkToken marks an unused, unexpired token as used and returns it.
+	// Concurrent consumers of the same token race on a single UPDATE, so only one succeeds.
+	ConsumeMagicLinkToken(ctx context.Context, tenantID, tokenHash string, now time.Time) (*model.MagicLinkToken, error)
+
+	// Password reset operations
+	CreatePasswordResetToken(ctx context.Context, token *model.PasswordResetToken) error
+	// FindPasswordResetToken returns an unused, unexpired token without using it up
+	FindPasswordResetToken(ctx context.Context, tenantID, tokenHash string, now time.Time) (*model.PasswordResetToken, error)
+	// ConsumePasswordResetToken marks an unused, unexpired token as used and
+	// returns it, with the same single-winner guarantee as ConsumeMagicLinkToken
+	ConsumePasswordResetToken(ctx context.Context, tenantID, tokenHash string, now time.Time) (*model.PasswordResetToken, error)
 }
@@ -0,0 +1,18 @@
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_repository
+package repository
+
+import (
+	"context"
+
+	"good-todo-go/internal/domain/model"
+)
+
+type ICommentRepository interface {
+	// All operations are tenant scoped (tenantID from context, RLS protected)
+	FindByID(ctx context.Context, commentID string) (*model.Comment, error)
+	// FindByTodoID lists a todo's comments oldest first, deleted ones included
+	FindByTodoID(ctx context.Context, todoID string) ([]*model.Comment, error)
+	Create(ctx context.Context, comment *model.Comment) (*model.Comment, error)
+	// Update writes the body, edited and deleted state
+	Update(ctx context.Context, comment *model.Comment) (*model.Comment, error)
+}
@@ -13,6 +13,7 @@ import (
 	context "context"
 	model "good-todo-go/internal/domain/model"
 	reflect "reflect"
+	time "time"
 
 	gomock "go.uber.org/mock/gomock"
 )
@@ -41,6 +42,64 @@ func (m *MockIAuthRepository) EXPECT() *MockIAuthRepositoryMockRecorder {
 	return m.recorder
 }
 
+// ConsumeMagicLinkToken mocks base method.
+func (m *MockIAuthRepository) ConsumeMagicLinkToken(ctx context.Context, tenantID, tokenHash string, now time.Time) (*model.MagicLinkToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeMagicLinkToken", ctx, tenantID, tokenHash, now)
+	ret0, _ := ret[0].(*model.MagicLinkToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumeMagicLinkToken indicates an expected call of ConsumeMagicLinkToken.
+func (mr *MockIAuthRepositoryMockRecorder) ConsumeMagicLinkToken(ctx, tenantID, tokenHash, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeMagicLinkToken", reflect.TypeOf((*MockIAuthRepository)(nil).ConsumeMagicLinkToken), ctx, tenantID, tokenHash, now)
+}
+
+// ConsumePasswordResetToken mocks base method.
+func (m *MockIAuthRepository) ConsumePasswordResetToken(ctx context.Context, tenantID, tokenHash string, now time.Time) (*model.PasswordResetToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumePasswordResetToken", ctx, tenantID, tokenHash, now)
+	ret0, _ := ret[0].(*model.PasswordResetToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumePasswordResetToken indicates an expected call of ConsumePasswordResetToken.
+func (mr *MockIAuthRepositoryMockRecorder) ConsumePasswordResetToken(ctx, tenantID, tokenHash, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumePasswordResetToken", reflect.TypeOf((*MockIAuthRepository)(nil).ConsumePasswordResetToken), ctx, tenantID, tokenHash, now)
+}
+
+// CreateMagicLinkToken mocks base method.
+func (m *MockIAuthRepository) CreateMagicLinkToken(ctx context.Context, token *model.MagicLinkToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMagicLinkToken", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateMagicLinkToken indicates an expected call of CreateMagicLinkToken.
+func (mr *MockIAuthRepositoryMockRecorder) CreateMagicLinkToken(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMagicLinkToken", reflect.TypeOf((*MockIAuthRepository)(nil).CreateMagicLinkToken), ctx, token)
+}
+
+// CreatePasswordResetToken mocks base method.
+func (m *MockIAuthRepository) CreatePasswordResetToken(ctx context.Context, token *model.PasswordResetToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePasswordResetToken", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePasswordResetToken indicates an expected call of CreatePasswordResetToken.
+func (mr *MockIAuthRepositoryMockRecorder) CreatePasswordResetToken(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePasswordResetToken", reflect.TypeOf((*MockIAuthRepository)(nil).CreatePasswordResetToken), ctx, token)
+}
+
 // CreateTenant mocks base method.
 func (m *MockIAuthRepository) CreateTenant(ctx context.Context, tenant *model.Tenant) (*model.Tenant, error) {
 	m.ctrl.T.Helper()
@@ -71,6 +130,36 @@ func (mr *MockIAuthRepositoryMockRecorder) CreateUser(ctx, user any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockIAuthRepository)(nil).CreateUser), ctx, user)
 }
 
+// FindPasswordResetToken mocks base method.
+func (m *MockIAuthRepository) FindPasswordResetToken(ctx context.Context, tenantID, tokenHash string, now time.Time) (*model.PasswordResetToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPasswordResetToken", ctx, tenantID, tokenHash, now)
+	ret0, _ := ret[0].(*model.PasswordResetToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPasswordResetToken indicates an expected call of FindPasswordResetToken.
+func (mr *MockIAuthRepositoryMockRecorder) FindPasswordResetToken(ctx, tenantID, tokenHash, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPasswordResetToken", reflect.TypeOf((*MockIAuthRepository)(nil).FindPasswordResetToken), ctx, tenantID, tokenHash, now)
+}
+
+// FindTenantByID mocks base method.
+func (m *MockIAuthRepository) FindTenantByID(ctx context.Context, tenantID string) (*model.Tenant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTenantByID", ctx, tenantID)
+	ret0, _ := ret[0].(*model.Tenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTenantByID indicates an expected call of FindTenantByID.
+func (mr *MockIAuthRepositoryMockRecorder) FindTenantByID(ctx, tenantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTenantByID", reflect.TypeOf((*MockIAuthRepository)(nil).FindTenantByID), ctx, tenantID)
+}
+
 // FindTenantBySlug mocks base method.
 func (m *MockIAuthRepository) FindTenantBySlug(ctx context.Context, slug string) (*model.Tenant, error) {
 	m.ctrl.T.Helper()
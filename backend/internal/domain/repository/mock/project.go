@@ -0,0 +1,131 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: project.go
+//
+// Generated by this command:
+//
+//	mockgen -source=project.go -destination=mock/project.go -package=mock_repository
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	model "good-todo-go/internal/domain/model"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIProjectRepository is a mock of IProjectRepository interface.
+type MockIProjectRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIProjectRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockIProjectRepositoryMockRecorder is the mock recorder for MockIProjectRepository.
+type MockIProjectRepositoryMockRecorder struct {
+	mock *MockIProjectRepository
+}
+
+// NewMockIProjectRepository creates a new mock instance.
+func NewMockIProjectRepository(ctrl *gomock.Controller) *MockIProjectRepository {
+	mock := &MockIProjectRepository{ctrl: ctrl}
+	mock.recorder = &MockIProjectRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIProjectRepository) EXPECT() *MockIProjectRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountTodos mocks base method.
+func (m *MockIProjectRepository) CountTodos(ctx context.Context, userID string, projectIDs []string) (map[string]*model.ProjectTodoCounts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountTodos", ctx, userID, projectIDs)
+	ret0, _ := ret[0].(map[string]*model.ProjectTodoCounts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountTodos indicates an expected call of CountTodos.
+func (mr *MockIProjectRepositoryMockRecorder) CountTodos(ctx, userID, projectIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountTodos", reflect.TypeOf((*MockIProjectRepository)(nil).CountTodos), ctx, userID, projectIDs)
+}
+
+// Create mocks base method.
+func (m *MockIProjectRepository) Create(ctx context.Context, project *model.Project) (*model.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, project)
+	ret0, _ := ret[0].(*model.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIProjectRepositoryMockRecorder) Create(ctx, project any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIProjectRepository)(nil).Create), ctx, project)
+}
+
+// Delete mocks base method.
+func (m *MockIProjectRepository) Delete(ctx context.Context, projectID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, projectID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockIProjectRepositoryMockRecorder) Delete(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIProjectRepository)(nil).Delete), ctx, projectID)
+}
+
+// FindByID mocks base method.
+func (m *MockIProjectRepository) FindByID(ctx context.Context, projectID string) (*model.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, projectID)
+	ret0, _ := ret[0].(*model.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockIProjectRepositoryMockRecorder) FindByID(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockIProjectRepository)(nil).FindByID), ctx, projectID)
+}
+
+// FindVisible mocks base method.
+func (m *MockIProjectRepository) FindVisible(ctx context.Context, userID string, includeArchived bool) ([]*model.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindVisible", ctx, userID, includeArchived)
+	ret0, _ := ret[0].([]*model.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindVisible indicates an expected call of FindVisible.
+func (mr *MockIProjectRepositoryMockRecorder) FindVisible(ctx, userID, includeArchived any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindVisible", reflect.TypeOf((*MockIProjectRepository)(nil).FindVisible), ctx, userID, includeArchived)
+}
+
+// Update mocks base method.
+func (m *MockIProjectRepository) Update(ctx context.Context, project *model.Project) (*model.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, project)
+	ret0, _ := ret[0].(*model.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIProjectRepositoryMockRecorder) Update(ctx, project any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIProjectRepository)(nil).Update), ctx, project)
+}
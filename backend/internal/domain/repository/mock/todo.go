@@ -13,6 +13,7 @@ import (
 	context "context"
 	model "good-todo-go/internal/domain/model"
 	reflect "reflect"
+	time "time"
 
 	gomock "go.uber.org/mock/gomock"
 )
@@ -41,34 +42,108 @@ func (m *MockITodoRepository) EXPECT() *MockITodoRepositoryMockRecorder {
 	return m.recorder
 }
 
+// ApplyBulk mocks base method.
+func (m *MockITodoRepository) ApplyBulk(ctx context.Context, change *model.TodoBulkChange) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyBulk", ctx, change)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApplyBulk indicates an expected call of ApplyBulk.
+func (mr *MockITodoRepositoryMockRecorder) ApplyBulk(ctx, change any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyBulk", reflect.TypeOf((*MockITodoRepository)(nil).ApplyBulk), ctx, change)
+}
+
+// Assign mocks base method.
+func (m *MockITodoRepository) Assign(ctx context.Context, change *model.TodoAssignment) (*model.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Assign", ctx, change)
+	ret0, _ := ret[0].(*model.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Assign indicates an expected call of Assign.
+func (mr *MockITodoRepositoryMockRecorder) Assign(ctx, change any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Assign", reflect.TypeOf((*MockITodoRepository)(nil).Assign), ctx, change)
+}
+
+// CountByAssigneeID mocks base method.
+func (m *MockITodoRepository) CountByAssigneeID(ctx context.Context, assigneeID string, filter *model.TodoFilter) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByAssigneeID", ctx, assigneeID, filter)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByAssigneeID indicates an expected call of CountByAssigneeID.
+func (mr *MockITodoRepositoryMockRecorder) CountByAssigneeID(ctx, assigneeID, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByAssigneeID", reflect.TypeOf((*MockITodoRepository)(nil).CountByAssigneeID), ctx, assigneeID, filter)
+}
+
 // CountByUserID mocks base method.
-func (m *MockITodoRepository) CountByUserID(ctx context.Context, userID string) (int, error) {
+func (m *MockITodoRepository) CountByUserID(ctx context.Context, userID string, filter *model.TodoFilter) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CountByUserID", ctx, userID)
+	ret := m.ctrl.Call(m, "CountByUserID", ctx, userID, filter)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CountByUserID indicates an expected call of CountByUserID.
-func (mr *MockITodoRepositoryMockRecorder) CountByUserID(ctx, userID any) *gomock.Call {
+func (mr *MockITodoRepositoryMockRecorder) CountByUserID(ctx, userID, filter any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByUserID", reflect.TypeOf((*MockITodoRepository)(nil).CountByUserID), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByUserID", reflect.TypeOf((*MockITodoRepository)(nil).CountByUserID), ctx, userID, filter)
 }
 
 // CountPublic mocks base method.
-func (m *MockITodoRepository) CountPublic(ctx context.Context) (int, error) {
+func (m *MockITodoRepository) CountPublic(ctx context.Context, filter *model.TodoFilter) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CountPublic", ctx)
+	ret := m.ctrl.Call(m, "CountPublic", ctx, filter)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CountPublic indicates an expected call of CountPublic.
-func (mr *MockITodoRepositoryMockRecorder) CountPublic(ctx any) *gomock.Call {
+func (mr *MockITodoRepositoryMockRecorder) CountPublic(ctx, filter any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountPublic", reflect.TypeOf((*MockITodoRepository)(nil).CountPublic), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountPublic", reflect.TypeOf((*MockITodoRepository)(nil).CountPublic), ctx, filter)
+}
+
+// CountSharedWith mocks base method.
+func (m *MockITodoRepository) CountSharedWith(ctx context.Context, userID string, filter *model.TodoFilter) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountSharedWith", ctx, userID, filter)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountSharedWith indicates an expected call of CountSharedWith.
+func (mr *MockITodoRepositoryMockRecorder) CountSharedWith(ctx, userID, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountSharedWith", reflect.TypeOf((*MockITodoRepository)(nil).CountSharedWith), ctx, userID, filter)
+}
+
+// CountTrash mocks base method.
+func (m *MockITodoRepository) CountTrash(ctx context.Context, userID string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountTrash", ctx, userID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountTrash indicates an expected call of CountTrash.
+func (mr *MockITodoRepositoryMockRecorder) CountTrash(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountTrash", reflect.TypeOf((*MockITodoRepository)(nil).CountTrash), ctx, userID)
 }
 
 // Create mocks base method.
@@ -87,17 +162,61 @@ func (mr *MockITodoRepositoryMockRecorder) Create(ctx, todo any) *gomock.Call {
 }
 
 // Delete mocks base method.
-func (m *MockITodoRepository) Delete(ctx context.Context, todoID string) error {
+func (m *MockITodoRepository) Delete(ctx context.Context, todoID string, version int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, todoID)
+	ret := m.ctrl.Call(m, "Delete", ctx, todoID, version)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockITodoRepositoryMockRecorder) Delete(ctx, todoID any) *gomock.Call {
+func (mr *MockITodoRepositoryMockRecorder) Delete(ctx, todoID, version any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockITodoRepository)(nil).Delete), ctx, todoID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockITodoRepository)(nil).Delete), ctx, todoID, version)
+}
+
+// DeleteSubtree mocks base method.
+func (m *MockITodoRepository) DeleteSubtree(ctx context.Context, todoID string, version int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSubtree", ctx, todoID, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSubtree indicates an expected call of DeleteSubtree.
+func (mr *MockITodoRepositoryMockRecorder) DeleteSubtree(ctx, todoID, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubtree", reflect.TypeOf((*MockITodoRepository)(nil).DeleteSubtree), ctx, todoID, version)
+}
+
+// FindAssignments mocks base method.
+func (m *MockITodoRepository) FindAssignments(ctx context.Context, todoID string) ([]*model.TodoAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAssignments", ctx, todoID)
+	ret0, _ := ret[0].([]*model.TodoAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAssignments indicates an expected call of FindAssignments.
+func (mr *MockITodoRepositoryMockRecorder) FindAssignments(ctx, todoID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAssignments", reflect.TypeOf((*MockITodoRepository)(nil).FindAssignments), ctx, todoID)
+}
+
+// FindByAssigneeID mocks base method.
+func (m *MockITodoRepository) FindByAssigneeID(ctx context.Context, assigneeID string, filter *model.TodoFilter, sort *model.TodoSort, page *model.TodoPage) ([]*model.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByAssigneeID", ctx, assigneeID, filter, sort, page)
+	ret0, _ := ret[0].([]*model.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByAssigneeID indicates an expected call of FindByAssigneeID.
+func (mr *MockITodoRepositoryMockRecorder) FindByAssigneeID(ctx, assigneeID, filter, sort, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByAssigneeID", reflect.TypeOf((*MockITodoRepository)(nil).FindByAssigneeID), ctx, assigneeID, filter, sort, page)
 }
 
 // FindByID mocks base method.
@@ -115,34 +234,258 @@ func (mr *MockITodoRepositoryMockRecorder) FindByID(ctx, todoID any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockITodoRepository)(nil).FindByID), ctx, todoID)
 }
 
+// FindByIDs mocks base method.
+func (m *MockITodoRepository) FindByIDs(ctx context.Context, ids []string) ([]*model.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIDs", ctx, ids)
+	ret0, _ := ret[0].([]*model.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIDs indicates an expected call of FindByIDs.
+func (mr *MockITodoRepositoryMockRecorder) FindByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDs", reflect.TypeOf((*MockITodoRepository)(nil).FindByIDs), ctx, ids)
+}
+
 // FindByUserID mocks base method.
-func (m *MockITodoRepository) FindByUserID(ctx context.Context, userID string, limit, offset int) ([]*model.Todo, error) {
+func (m *MockITodoRepository) FindByUserID(ctx context.Context, userID string, filter *model.TodoFilter, sort *model.TodoSort, page *model.TodoPage) ([]*model.Todo, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByUserID", ctx, userID, limit, offset)
+	ret := m.ctrl.Call(m, "FindByUserID", ctx, userID, filter, sort, page)
 	ret0, _ := ret[0].([]*model.Todo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindByUserID indicates an expected call of FindByUserID.
-func (mr *MockITodoRepositoryMockRecorder) FindByUserID(ctx, userID, limit, offset any) *gomock.Call {
+func (mr *MockITodoRepositoryMockRecorder) FindByUserID(ctx, userID, filter, sort, page any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserID", reflect.TypeOf((*MockITodoRepository)(nil).FindByUserID), ctx, userID, limit, offset)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserID", reflect.TypeOf((*MockITodoRepository)(nil).FindByUserID), ctx, userID, filter, sort, page)
+}
+
+// FindChildren mocks base method.
+func (m *MockITodoRepository) FindChildren(ctx context.Context, parentID string) ([]*model.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindChildren", ctx, parentID)
+	ret0, _ := ret[0].([]*model.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindChildren indicates an expected call of FindChildren.
+func (mr *MockITodoRepositoryMockRecorder) FindChildren(ctx, parentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindChildren", reflect.TypeOf((*MockITodoRepository)(nil).FindChildren), ctx, parentID)
 }
 
 // FindPublic mocks base method.
-func (m *MockITodoRepository) FindPublic(ctx context.Context, limit, offset int) ([]*model.Todo, error) {
+func (m *MockITodoRepository) FindPublic(ctx context.Context, filter *model.TodoFilter, page *model.TodoPage) ([]*model.Todo, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindPublic", ctx, limit, offset)
+	ret := m.ctrl.Call(m, "FindPublic", ctx, filter, page)
 	ret0, _ := ret[0].([]*model.Todo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindPublic indicates an expected call of FindPublic.
-func (mr *MockITodoRepositoryMockRecorder) FindPublic(ctx, limit, offset any) *gomock.Call {
+func (mr *MockITodoRepositoryMockRecorder) FindPublic(ctx, filter, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPublic", reflect.TypeOf((*MockITodoRepository)(nil).FindPublic), ctx, filter, page)
+}
+
+// FindSharedWith mocks base method.
+func (m *MockITodoRepository) FindSharedWith(ctx context.Context, userID string, filter *model.TodoFilter, sort *model.TodoSort, page *model.TodoPage) ([]*model.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSharedWith", ctx, userID, filter, sort, page)
+	ret0, _ := ret[0].([]*model.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSharedWith indicates an expected call of FindSharedWith.
+func (mr *MockITodoRepositoryMockRecorder) FindSharedWith(ctx, userID, filter, sort, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSharedWith", reflect.TypeOf((*MockITodoRepository)(nil).FindSharedWith), ctx, userID, filter, sort, page)
+}
+
+// FindShares mocks base method.
+func (m *MockITodoRepository) FindShares(ctx context.Context, todoID string) ([]*model.TodoShare, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindShares", ctx, todoID)
+	ret0, _ := ret[0].([]*model.TodoShare)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindShares indicates an expected call of FindShares.
+func (mr *MockITodoRepositoryMockRecorder) FindShares(ctx, todoID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindShares", reflect.TypeOf((*MockITodoRepository)(nil).FindShares), ctx, todoID)
+}
+
+// FindTrash mocks base method.
+func (m *MockITodoRepository) FindTrash(ctx context.Context, userID string, page *model.TodoPage) ([]*model.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTrash", ctx, userID, page)
+	ret0, _ := ret[0].([]*model.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTrash indicates an expected call of FindTrash.
+func (mr *MockITodoRepositoryMockRecorder) FindTrash(ctx, userID, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTrash", reflect.TypeOf((*MockITodoRepository)(nil).FindTrash), ctx, userID, page)
+}
+
+// FindTrashedByID mocks base method.
+func (m *MockITodoRepository) FindTrashedByID(ctx context.Context, todoID string) (*model.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTrashedByID", ctx, todoID)
+	ret0, _ := ret[0].(*model.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTrashedByID indicates an expected call of FindTrashedByID.
+func (mr *MockITodoRepositoryMockRecorder) FindTrashedByID(ctx, todoID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPublic", reflect.TypeOf((*MockITodoRepository)(nil).FindPublic), ctx, limit, offset)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTrashedByID", reflect.TypeOf((*MockITodoRepository)(nil).FindTrashedByID), ctx, todoID)
+}
+
+// ListCompletionTimes mocks base method.
+func (m *MockITodoRepository) ListCompletionTimes(ctx context.Context, userID string, from, to time.Time) ([]time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCompletionTimes", ctx, userID, from, to)
+	ret0, _ := ret[0].([]time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCompletionTimes indicates an expected call of ListCompletionTimes.
+func (mr *MockITodoRepositoryMockRecorder) ListCompletionTimes(ctx, userID, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCompletionTimes", reflect.TypeOf((*MockITodoRepository)(nil).ListCompletionTimes), ctx, userID, from, to)
+}
+
+// PositionNextTo mocks base method.
+func (m *MockITodoRepository) PositionNextTo(ctx context.Context, anchor *model.Todo, excludeID string, before bool) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PositionNextTo", ctx, anchor, excludeID, before)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PositionNextTo indicates an expected call of PositionNextTo.
+func (mr *MockITodoRepositoryMockRecorder) PositionNextTo(ctx, anchor, excludeID, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PositionNextTo", reflect.TypeOf((*MockITodoRepository)(nil).PositionNextTo), ctx, anchor, excludeID, before)
+}
+
+// PurgeTrash mocks base method.
+func (m *MockITodoRepository) PurgeTrash(ctx context.Context, tenantID string, deletedBefore time.Time, limit int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeTrash", ctx, tenantID, deletedBefore, limit)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeTrash indicates an expected call of PurgeTrash.
+func (mr *MockITodoRepositoryMockRecorder) PurgeTrash(ctx, tenantID, deletedBefore, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeTrash", reflect.TypeOf((*MockITodoRepository)(nil).PurgeTrash), ctx, tenantID, deletedBefore, limit)
+}
+
+// RebalancePositions mocks base method.
+func (m *MockITodoRepository) RebalancePositions(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebalancePositions", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RebalancePositions indicates an expected call of RebalancePositions.
+func (mr *MockITodoRepositoryMockRecorder) RebalancePositions(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebalancePositions", reflect.TypeOf((*MockITodoRepository)(nil).RebalancePositions), ctx, userID)
+}
+
+// Restore mocks base method.
+func (m *MockITodoRepository) Restore(ctx context.Context, todoID string) (*model.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, todoID)
+	ret0, _ := ret[0].(*model.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockITodoRepositoryMockRecorder) Restore(ctx, todoID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockITodoRepository)(nil).Restore), ctx, todoID)
+}
+
+// Search mocks base method.
+func (m *MockITodoRepository) Search(ctx context.Context, userID, tsquery string, limit, offset int) ([]*model.TodoSearchHit, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, userID, tsquery, limit, offset)
+	ret0, _ := ret[0].([]*model.TodoSearchHit)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockITodoRepositoryMockRecorder) Search(ctx, userID, tsquery, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockITodoRepository)(nil).Search), ctx, userID, tsquery, limit, offset)
+}
+
+// Share mocks base method.
+func (m *MockITodoRepository) Share(ctx context.Context, share *model.TodoShare) (*model.TodoShare, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Share", ctx, share)
+	ret0, _ := ret[0].(*model.TodoShare)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Share indicates an expected call of Share.
+func (mr *MockITodoRepositoryMockRecorder) Share(ctx, share any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Share", reflect.TypeOf((*MockITodoRepository)(nil).Share), ctx, share)
+}
+
+// SubtreeDepth mocks base method.
+func (m *MockITodoRepository) SubtreeDepth(ctx context.Context, todoID string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubtreeDepth", ctx, todoID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubtreeDepth indicates an expected call of SubtreeDepth.
+func (mr *MockITodoRepositoryMockRecorder) SubtreeDepth(ctx, todoID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubtreeDepth", reflect.TypeOf((*MockITodoRepository)(nil).SubtreeDepth), ctx, todoID)
+}
+
+// Unshare mocks base method.
+func (m *MockITodoRepository) Unshare(ctx context.Context, todoID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unshare", ctx, todoID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unshare indicates an expected call of Unshare.
+func (mr *MockITodoRepositoryMockRecorder) Unshare(ctx, todoID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unshare", reflect.TypeOf((*MockITodoRepository)(nil).Unshare), ctx, todoID, userID)
 }
 
 // Update mocks base method.
@@ -159,3 +502,19 @@ func (mr *MockITodoRepositoryMockRecorder) Update(ctx, todo any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockITodoRepository)(nil).Update), ctx, todo)
 }
+
+// UpdateWithFollowUps mocks base method.
+func (m *MockITodoRepository) UpdateWithFollowUps(ctx context.Context, todo *model.Todo, cascade bool, next *model.Todo) (*model.Todo, *model.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWithFollowUps", ctx, todo, cascade, next)
+	ret0, _ := ret[0].(*model.Todo)
+	ret1, _ := ret[1].(*model.Todo)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateWithFollowUps indicates an expected call of UpdateWithFollowUps.
+func (mr *MockITodoRepositoryMockRecorder) UpdateWithFollowUps(ctx, todo, cascade, next any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWithFollowUps", reflect.TypeOf((*MockITodoRepository)(nil).UpdateWithFollowUps), ctx, todo, cascade, next)
+}
@@ -41,6 +41,50 @@ func (m *MockIUserRepository) EXPECT() *MockIUserRepositoryMockRecorder {
 	return m.recorder
 }
 
+// Create mocks base method.
+func (m *MockIUserRepository) Create(ctx context.Context, user *model.User) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, user)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIUserRepositoryMockRecorder) Create(ctx, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIUserRepository)(nil).Create), ctx, user)
+}
+
+// Delete mocks base method.
+func (m *MockIUserRepository) Delete(ctx context.Context, userID, heirID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, userID, heirID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockIUserRepositoryMockRecorder) Delete(ctx, userID, heirID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIUserRepository)(nil).Delete), ctx, userID, heirID)
+}
+
+// FindByCalendarTokenHash mocks base method.
+func (m *MockIUserRepository) FindByCalendarTokenHash(ctx context.Context, tokenHash string) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByCalendarTokenHash", ctx, tokenHash)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByCalendarTokenHash indicates an expected call of FindByCalendarTokenHash.
+func (mr *MockIUserRepositoryMockRecorder) FindByCalendarTokenHash(ctx, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCalendarTokenHash", reflect.TypeOf((*MockIUserRepository)(nil).FindByCalendarTokenHash), ctx, tokenHash)
+}
+
 // FindByID mocks base method.
 func (m *MockIUserRepository) FindByID(ctx context.Context, userID string) (*model.User, error) {
 	m.ctrl.T.Helper()
@@ -71,6 +115,36 @@ func (mr *MockIUserRepositoryMockRecorder) FindByIDs(ctx, userIDs any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDs", reflect.TypeOf((*MockIUserRepository)(nil).FindByIDs), ctx, userIDs)
 }
 
+// List mocks base method.
+func (m *MockIUserRepository) List(ctx context.Context, filter *model.UserFilter, offset, limit int) ([]*model.User, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, filter, offset, limit)
+	ret0, _ := ret[0].([]*model.User)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockIUserRepositoryMockRecorder) List(ctx, filter, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIUserRepository)(nil).List), ctx, filter, offset, limit)
+}
+
+// SetCalendarTokenHash mocks base method.
+func (m *MockIUserRepository) SetCalendarTokenHash(ctx context.Context, userID string, tokenHash *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCalendarTokenHash", ctx, userID, tokenHash)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCalendarTokenHash indicates an expected call of SetCalendarTokenHash.
+func (mr *MockIUserRepositoryMockRecorder) SetCalendarTokenHash(ctx, userID, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCalendarTokenHash", reflect.TypeOf((*MockIUserRepository)(nil).SetCalendarTokenHash), ctx, userID, tokenHash)
+}
+
 // Update mocks base method.
 func (m *MockIUserRepository) Update(ctx context.Context, user *model.User) (*model.User, error) {
 	m.ctrl.T.Helper()
@@ -85,3 +159,18 @@ func (mr *MockIUserRepositoryMockRecorder) Update(ctx, user any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIUserRepository)(nil).Update), ctx, user)
 }
+
+// UpdateAccount mocks base method.
+func (m *MockIUserRepository) UpdateAccount(ctx context.Context, user *model.User) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAccount", ctx, user)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAccount indicates an expected call of UpdateAccount.
+func (mr *MockIUserRepositoryMockRecorder) UpdateAccount(ctx, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccount", reflect.TypeOf((*MockIUserRepository)(nil).UpdateAccount), ctx, user)
+}
@@ -0,0 +1,19 @@
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_repository
+package repository
+
+import (
+	"context"
+
+	"good-todo-go/internal/domain/model"
+)
+
+type INotificationRepository interface {
+	// All operations are tenant scoped (tenantID from context, RLS protected)
+	// FindByUserID lists the user's notifications, newest first
+	FindByUserID(ctx context.Context, userID string, unreadOnly bool, limit int) ([]*model.Notification, error)
+	// Create does nothing when a notification for the same reminder already exists
+	Create(ctx context.Context, notification *model.Notification) error
+	// MarkRead sets read_at on the user's notification unless it is already read.
+	// It returns false when the user has no such notification.
+	MarkRead(ctx context.Context, notificationID, userID string) (bool, error)
+}
@@ -0,0 +1,22 @@
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_repository
+package repository
+
+import (
+	"context"
+
+	"good-todo-go/internal/domain/model"
+)
+
+type IProjectRepository interface {
+	// All operations are tenant scoped (tenantID from context, RLS protected)
+	FindByID(ctx context.Context, projectID string) (*model.Project, error)
+	// FindVisible lists the user's own and shared projects sorted by name
+	FindVisible(ctx context.Context, userID string, includeArchived bool) ([]*model.Project, error)
+	// CountTodos counts the todos the user can see in each project. Projects
+	// without todos are missing from the result.
+	CountTodos(ctx context.Context, userID string, projectIDs []string) (map[string]*model.ProjectTodoCounts, error)
+	Create(ctx context.Context, project *model.Project) (*model.Project, error)
+	Update(ctx context.Context, project *model.Project) (*model.Project, error)
+	// Delete keeps the project's todos, outside of any project
+	Delete(ctx context.Context, projectID string) error
+}
@@ -0,0 +1,29 @@
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_repository
+package repository
+
+import (
+	"context"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+)
+
+// DeliverReminderFunc delivers a claimed reminder; an error counts as a failed attempt
+type DeliverReminderFunc func(ctx context.Context, reminder *model.DueReminder) error
+
+type IReminderRepository interface {
+	// All operations are tenant scoped (tenantID from context, RLS protected)
+	FindByID(ctx context.Context, reminderID string) (*model.Reminder, error)
+	// FindByTodoID lists a todo's reminders, earliest first
+	FindByTodoID(ctx context.Context, todoID string) ([]*model.Reminder, error)
+	Create(ctx context.Context, reminder *model.Reminder) (*model.Reminder, error)
+	Delete(ctx context.Context, reminderID string) error
+	// DeliverNext locks the tenant's earliest pending reminder due at now,
+	// skipping reminders locked by other schedulers (FOR UPDATE SKIP LOCKED),
+	// and passes it to deliver with the tenant set in the context. The outcome
+	// is recorded before the lock is released, so concurrent schedulers never
+	// deliver the same reminder. A failed attempt is retried later with
+	// backoff, up to model.MaxReminderAttempts. found is false when no reminder is due.
+	// Due reminders of completed todos are dropped rather than delivered.
+	DeliverNext(ctx context.Context, tenantID string, now time.Time, deliver DeliverReminderFunc) (found bool, err error)
+}
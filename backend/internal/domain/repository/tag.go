@@ -0,0 +1,23 @@
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_repository
+package repository
+
+import (
+	"context"
+
+	"good-todo-go/internal/domain/model"
+)
+
+type ITagRepository interface {
+	// All operations are tenant scoped (tenantID from context, RLS protected)
+	FindAll(ctx context.Context) ([]*model.Tag, error)
+	FindByID(ctx context.Context, tagID string) (*model.Tag, error)
+	// FindByName matches the name case-insensitively
+	FindByName(ctx context.Context, name string) (*model.Tag, error)
+	Create(ctx context.Context, tag *model.Tag) (*model.Tag, error)
+	Update(ctx context.Context, tag *model.Tag) (*model.Tag, error)
+	// Delete also detaches the tag from every todo
+	Delete(ctx context.Context, tagID string) error
+	// Attach is idempotent; attaching an already attached tag is not an error
+	Attach(ctx context.Context, tenantID, todoID, tagID string) error
+	Detach(ctx context.Context, todoID, tagID string) error
+}
@@ -0,0 +1,19 @@
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_repository
+package repository
+
+import (
+	"context"
+
+	"good-todo-go/internal/domain/model"
+)
+
+type ITenantRepository interface {
+	FindByID(ctx context.Context, tenantID string) (*model.Tenant, error)
+	// ListIDs returns every tenant; tenants are not RLS protected, so no tenant context needed
+	ListIDs(ctx context.Context) ([]string, error)
+	UpdateSettings(ctx context.Context, tenantID string, settings *model.TenantSettings) (*model.Tenant, error)
+	// FindBySCIMTokenHash searches by unique token hash, so no tenant context needed
+	FindBySCIMTokenHash(ctx context.Context, tokenHash string) (*model.Tenant, error)
+	// SetSCIMTokenHash replaces the tenant's SCIM token; nil revokes it
+	SetSCIMTokenHash(ctx context.Context, tenantID string, tokenHash *string) error
+}
@@ -3,20 +3,91 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"good-todo-go/internal/domain/model"
 )
 
+// ErrTodoVersionConflict is returned by todo writes when the todo is no longer
+// at the version the write was based on, because it changed in the meantime
+var ErrTodoVersionConflict = errors.New("todo version conflict")
+
 type ITodoRepository interface {
-	// Read operations use View with tenant context (tenantID from context)
+	// Read operations use View with tenant context (tenantID from context).
+	// FindByID, FindByIDs and FindChildren load the todos' shares.
 	FindByID(ctx context.Context, todoID string) (*model.Todo, error)
-	FindByUserID(ctx context.Context, userID string, limit, offset int) ([]*model.Todo, error)
-	CountByUserID(ctx context.Context, userID string) (int, error)
+	// FindByIDs returns the todos among ids that exist, in no particular order
+	FindByIDs(ctx context.Context, ids []string) ([]*model.Todo, error)
+	// A nil filter matches every todo of the user; a nil sort means newest first
+	FindByUserID(ctx context.Context, userID string, filter *model.TodoFilter, sort *model.TodoSort, page *model.TodoPage) ([]*model.Todo, error)
+	CountByUserID(ctx context.Context, userID string, filter *model.TodoFilter) (int, error)
+	// FindByAssigneeID and CountByAssigneeID list the todos assigned to a user, like FindByUserID
+	FindByAssigneeID(ctx context.Context, assigneeID string, filter *model.TodoFilter, sort *model.TodoSort, page *model.TodoPage) ([]*model.Todo, error)
+	CountByAssigneeID(ctx context.Context, assigneeID string, filter *model.TodoFilter) (int, error)
+	// FindSharedWith and CountSharedWith list the todos shared with a user, like FindByUserID
+	FindSharedWith(ctx context.Context, userID string, filter *model.TodoFilter, sort *model.TodoSort, page *model.TodoPage) ([]*model.Todo, error)
+	CountSharedWith(ctx context.Context, userID string, filter *model.TodoFilter) (int, error)
+	// ListCompletionTimes returns when the user's todos were completed within [from, to)
+	ListCompletionTimes(ctx context.Context, userID string, from, to time.Time) ([]time.Time, error)
 	// Public todos (visible to all users in the same tenant)
-	FindPublic(ctx context.Context, limit, offset int) ([]*model.Todo, error)
-	CountPublic(ctx context.Context) (int, error)
+	// FindPublic lists newest first; page.After must come from a todo of the same listing
+	FindPublic(ctx context.Context, filter *model.TodoFilter, page *model.TodoPage) ([]*model.Todo, error)
+	CountPublic(ctx context.Context, filter *model.TodoFilter) (int, error)
+	// Search ranks the user's own, assigned, shared and public todos against a to_tsquery expression,
+	// using the tenant's text search configuration. It returns the page and the total.
+	Search(ctx context.Context, userID, tsquery string, limit, offset int) ([]*model.TodoSearchHit, int, error)
+	// FindChildren lists the direct subtasks of a todo, oldest first
+	FindChildren(ctx context.Context, parentID string) ([]*model.Todo, error)
+	// SubtreeDepth is the number of levels in the todo's hierarchy below and
+	// including itself: 1 for a todo without subtasks
+	SubtreeDepth(ctx context.Context, todoID string) (int, error)
+	// PositionNextTo returns the position of the todo directly before (or
+	// after) anchor in its owner's manual order, skipping excludeID; empty
+	// when there is none
+	PositionNextTo(ctx context.Context, anchor *model.Todo, excludeID string, before bool) (string, error)
+	// FindAssignments lists the todo's assignee changes, oldest first
+	FindAssignments(ctx context.Context, todoID string) ([]*model.TodoAssignment, error)
+	// FindShares lists who the todo is shared with, oldest share first
+	FindShares(ctx context.Context, todoID string) ([]*model.TodoShare, error)
 	// Write operations use direct table access (RLS protected)
+	// Create also attaches todo.Tags, which only need their IDs set, and
+	// creates todo.Reminders. Without a Position the todo goes to the end of
+	// its owner's manual order.
 	Create(ctx context.Context, todo *model.Todo) (*model.Todo, error)
+	// Update leaves the assignee alone; it only changes through Assign. It fails
+	// with ErrTodoVersionConflict unless the todo is still at todo.Version.
 	Update(ctx context.Context, todo *model.Todo) (*model.Todo, error)
-	Delete(ctx context.Context, todoID string) error
+	// UpdateWithFollowUps writes the todo like Update and, in the same
+	// transaction, completes its open subtasks when cascade is set and creates
+	// next, the following occurrence of a recurring todo, unless it is nil.
+	// It returns the updated todo and the created occurrence.
+	UpdateWithFollowUps(ctx context.Context, todo *model.Todo, cascade bool, next *model.Todo) (*model.Todo, *model.Todo, error)
+	// Assign sets the todo's assignee to change.AssigneeID and records the change
+	Assign(ctx context.Context, change *model.TodoAssignment) (*model.Todo, error)
+	// Share grants share.UserID access to the todo, or changes the permission
+	// of an existing share, which keeps its ID and creator
+	Share(ctx context.Context, share *model.TodoShare) (*model.TodoShare, error)
+	// Unshare revokes the user's access; revoking access that was never
+	// granted is not an error
+	Unshare(ctx context.Context, todoID, userID string) error
+	// Delete moves a single todo to the trash; its subtasks become top-level
+	// todos. Like Update, it fails unless the todo is still at version.
+	Delete(ctx context.Context, todoID string, version int) error
+	// DeleteSubtree moves a todo to the trash together with all of its
+	// subtasks. Like Update, it fails unless the todo is still at version.
+	DeleteSubtree(ctx context.Context, todoID string, version int) error
+	// FindTrash lists the user's todos in the trash, most recently deleted first
+	FindTrash(ctx context.Context, userID string, page *model.TodoPage) ([]*model.Todo, error)
+	CountTrash(ctx context.Context, userID string) (int, error)
+	// FindTrashedByID only finds the todo while it is in the trash
+	FindTrashedByID(ctx context.Context, todoID string) (*model.Todo, error)
+	// Restore takes the todo and the subtasks deleted with it out of the trash
+	Restore(ctx context.Context, todoID string) (*model.Todo, error)
+	// PurgeTrash permanently removes up to limit of the tenant's todos that went to the trash before deletedBefore
+	PurgeTrash(ctx context.Context, tenantID string, deletedBefore time.Time, limit int) (int, error)
+	// RebalancePositions spreads the positions of the user's todos out evenly, keeping their order
+	RebalancePositions(ctx context.Context, userID string) error
+	// ApplyBulk writes the whole change in one transaction
+	ApplyBulk(ctx context.Context, change *model.TodoBulkChange) error
 }
@@ -0,0 +1,19 @@
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_repository
+package repository
+
+import (
+	"context"
+
+	"good-todo-go/internal/domain/model"
+)
+
+// ITodoRevisionRepository reads todo revisions. They are written by the
+// database layer itself whenever a todo is created or changed.
+type ITodoRevisionRepository interface {
+	// All operations are tenant scoped (tenantID from context, RLS protected)
+	// List returns a page of the todo's revisions, newest first, and their total number
+	List(ctx context.Context, todoID string, offset, limit int) ([]*model.TodoRevision, int, error)
+	// FindSince returns the revision and every later revision of the todo,
+	// oldest first; it fails when the revision is not one of the todo's
+	FindSince(ctx context.Context, todoID, revisionID string) ([]*model.TodoRevision, error)
+}
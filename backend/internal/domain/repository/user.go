@@ -3,12 +3,32 @@ package repository
 
 import (
 	"context"
+	"errors"
 
 	"good-todo-go/internal/domain/model"
 )
 
+// ErrUserConflict is returned by user writes when another user in the tenant
+// already has the email or external ID
+var ErrUserConflict = errors.New("user conflict")
+
 type IUserRepository interface {
 	FindByID(ctx context.Context, userID string) (*model.User, error)
 	FindByIDs(ctx context.Context, userIDs []string) ([]*model.User, error)
+	// List returns a page of users matching the filter, oldest first, and the
+	// total number of matches. System users are only listed when asked for.
+	List(ctx context.Context, filter *model.UserFilter, offset, limit int) ([]*model.User, int, error)
+	// Create and UpdateAccount return ErrUserConflict for a taken email or external ID
+	Create(ctx context.Context, user *model.User) (*model.User, error)
+	// Update changes the profile fields a user can edit themselves
 	Update(ctx context.Context, user *model.User) (*model.User, error)
+	// FindByCalendarTokenHash searches by unique token hash, so no tenant context needed
+	FindByCalendarTokenHash(ctx context.Context, tokenHash string) (*model.User, error)
+	// SetCalendarTokenHash replaces the user's calendar feed token; nil revokes it
+	SetCalendarTokenHash(ctx context.Context, userID string, tokenHash *string) error
+	// UpdateAccount changes account fields managed by provisioning: email, password, role, status and external ID
+	UpdateAccount(ctx context.Context, user *model.User) (*model.User, error)
+	// Delete removes a user in one transaction: their private todos and projects
+	// are deleted and their public ones are handed over to heirID
+	Delete(ctx context.Context, userID, heirID string) error
 }
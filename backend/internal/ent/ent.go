@@ -6,8 +6,22 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"good-todo-go/internal/ent/auditevent"
+	"good-todo-go/internal/ent/blobdeletion"
+	"good-todo-go/internal/ent/magiclinktoken"
+	"good-todo-go/internal/ent/notification"
+	"good-todo-go/internal/ent/passwordresettoken"
+	"good-todo-go/internal/ent/project"
+	"good-todo-go/internal/ent/reminder"
+	"good-todo-go/internal/ent/tag"
 	"good-todo-go/internal/ent/tenant"
 	"good-todo-go/internal/ent/todo"
+	"good-todo-go/internal/ent/todoassignment"
+	"good-todo-go/internal/ent/todoattachment"
+	"good-todo-go/internal/ent/todocomment"
+	"good-todo-go/internal/ent/todorevision"
+	"good-todo-go/internal/ent/todoshare"
+	"good-todo-go/internal/ent/todotag"
 	"good-todo-go/internal/ent/user"
 	"reflect"
 	"sync"
@@ -75,9 +89,23 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			tenant.Table: tenant.ValidColumn,
-			todo.Table:   todo.ValidColumn,
-			user.Table:   user.ValidColumn,
+			auditevent.Table:         auditevent.ValidColumn,
+			blobdeletion.Table:       blobdeletion.ValidColumn,
+			magiclinktoken.Table:     magiclinktoken.ValidColumn,
+			notification.Table:       notification.ValidColumn,
+			passwordresettoken.Table: passwordresettoken.ValidColumn,
+			project.Table:            project.ValidColumn,
+			reminder.Table:           reminder.ValidColumn,
+			tag.Table:                tag.ValidColumn,
+			tenant.Table:             tenant.ValidColumn,
+			todo.Table:               todo.ValidColumn,
+			todoassignment.Table:     todoassignment.ValidColumn,
+			todoattachment.Table:     todoattachment.ValidColumn,
+			todocomment.Table:        todocomment.ValidColumn,
+			todorevision.Table:       todorevision.ValidColumn,
+			todoshare.Table:          todoshare.ValidColumn,
+			todotag.Table:            todotag.ValidColumn,
+			user.Table:               user.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
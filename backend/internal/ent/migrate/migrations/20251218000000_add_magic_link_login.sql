@@ -0,0 +1,33 @@
+-- Add magic link opt-in to tenants
+ALTER TABLE "tenants" ADD COLUMN "magic_link_enabled" boolean NOT NULL DEFAULT false;
+
+-- Create "magic_link_tokens" table
+CREATE TABLE "magic_link_tokens" (
+  "id" character varying NOT NULL,
+  "tenant_id" character varying NOT NULL,
+  "token_hash" character varying NOT NULL,
+  "expires_at" timestamptz NOT NULL,
+  "used_at" timestamptz NULL,
+  "created_at" timestamptz NOT NULL,
+  "user_id" character varying NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "magic_link_tokens_users_magic_link_tokens" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "magic_link_tokens_token_hash_key" to table: "magic_link_tokens"
+CREATE UNIQUE INDEX "magic_link_tokens_token_hash_key" ON "magic_link_tokens" ("token_hash");
+-- Create index "magiclinktoken_tenant_id" to table: "magic_link_tokens"
+CREATE INDEX "magiclinktoken_tenant_id" ON "magic_link_tokens" ("tenant_id");
+-- Create index "magiclinktoken_user_id" to table: "magic_link_tokens"
+CREATE INDEX "magiclinktoken_user_id" ON "magic_link_tokens" ("user_id");
+
+-- Enable RLS on magic_link_tokens table
+ALTER TABLE "magic_link_tokens" ENABLE ROW LEVEL SECURITY;
+ALTER TABLE "magic_link_tokens" FORCE ROW LEVEL SECURITY;
+
+-- RLS Policy for magic_link_tokens (ALL operations)
+-- Tokens are always issued and consumed with the tenant resolved from its slug,
+-- so no lookup without tenant context is needed.
+CREATE POLICY "magic_link_tokens_tenant_isolation" ON "magic_link_tokens"
+    FOR ALL
+    USING ("tenant_id" = current_setting('app.current_tenant_id', true))
+    WITH CHECK ("tenant_id" = current_setting('app.current_tenant_id', true));
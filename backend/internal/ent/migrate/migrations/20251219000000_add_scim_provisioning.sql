@@ -0,0 +1,10 @@
+-- Add SCIM bearer token (hashed) to tenants
+ALTER TABLE "tenants" ADD COLUMN "scim_token_hash" character varying NULL;
+-- Create index "tenants_scim_token_hash_key" to table: "tenants"
+CREATE UNIQUE INDEX "tenants_scim_token_hash_key" ON "tenants" ("scim_token_hash");
+
+-- Add deactivation flag and identity provider ID to users
+ALTER TABLE "users" ADD COLUMN "is_active" boolean NOT NULL DEFAULT true;
+ALTER TABLE "users" ADD COLUMN "external_id" character varying NULL;
+-- Create index "user_tenant_id_external_id" to table: "users"
+CREATE UNIQUE INDEX "user_tenant_id_external_id" ON "users" ("tenant_id", "external_id");
@@ -0,0 +1,2 @@
+-- Add account deletion policy to tenants
+ALTER TABLE "tenants" ADD COLUMN "account_deletion_policy" character varying NOT NULL DEFAULT 'anonymize';
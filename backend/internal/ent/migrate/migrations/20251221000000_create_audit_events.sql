@@ -0,0 +1,40 @@
+-- Create "audit_events" table
+CREATE TABLE "audit_events" (
+  "id" character varying NOT NULL,
+  "tenant_id" character varying NOT NULL,
+  "actor_type" character varying NOT NULL,
+  "actor_id" character varying NULL,
+  "action" character varying NOT NULL,
+  "target_type" character varying NULL,
+  "target_id" character varying NULL,
+  "ip" character varying NOT NULL DEFAULT '',
+  "user_agent" character varying NOT NULL DEFAULT '',
+  "request_id" character varying NOT NULL DEFAULT '',
+  "metadata" jsonb NULL,
+  "created_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "auditevent_tenant_id_created_at" to table: "audit_events"
+CREATE INDEX "auditevent_tenant_id_created_at" ON "audit_events" ("tenant_id", "created_at");
+-- Create index "auditevent_tenant_id_action" to table: "audit_events"
+CREATE INDEX "auditevent_tenant_id_action" ON "audit_events" ("tenant_id", "action");
+-- Create index "auditevent_tenant_id_actor_id" to table: "audit_events"
+CREATE INDEX "auditevent_tenant_id_actor_id" ON "audit_events" ("tenant_id", "actor_id");
+
+-- Enable RLS on audit_events table
+ALTER TABLE "audit_events" ENABLE ROW LEVEL SECURITY;
+ALTER TABLE "audit_events" FORCE ROW LEVEL SECURITY;
+
+-- The audit log is append-only: tenants can read and add their own events,
+-- but there are deliberately no UPDATE or DELETE policies, so with RLS forced
+-- such statements never match a row.
+CREATE POLICY "audit_events_tenant_select" ON "audit_events"
+    FOR SELECT
+    USING ("tenant_id" = current_setting('app.current_tenant_id', true));
+
+CREATE POLICY "audit_events_tenant_insert" ON "audit_events"
+    FOR INSERT
+    WITH CHECK ("tenant_id" = current_setting('app.current_tenant_id', true));
+
+-- The default privileges from the app user migration include UPDATE and DELETE; take them back
+REVOKE UPDATE, DELETE, TRUNCATE ON "audit_events" FROM goodtodo_app;
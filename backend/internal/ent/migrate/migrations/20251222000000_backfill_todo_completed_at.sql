@@ -0,0 +1,7 @@
+-- Backfill completed_at for todos completed before it was maintained.
+-- updated_at is the best available approximation of the completion time.
+UPDATE "todos" SET "completed_at" = "updated_at" WHERE "completed" AND "completed_at" IS NULL;
+-- Incomplete todos never carry a completion time
+UPDATE "todos" SET "completed_at" = NULL WHERE NOT "completed" AND "completed_at" IS NOT NULL;
+-- Create index "todo_user_id_completed_at" to table: "todos"
+CREATE INDEX "todo_user_id_completed_at" ON "todos" ("user_id", "completed_at");
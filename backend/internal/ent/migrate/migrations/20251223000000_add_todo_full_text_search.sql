@@ -0,0 +1,28 @@
+-- Add text search configuration to tenants
+ALTER TABLE "tenants" ADD COLUMN "search_language" character varying NOT NULL DEFAULT 'simple';
+
+-- Each todo carries its tenant's configuration so the generated vector stays
+-- immutable; changing the tenant setting rewrites this column for its todos
+ALTER TABLE "todos" ADD COLUMN "search_language" regconfig NOT NULL DEFAULT 'simple';
+ALTER TABLE "todos" ADD COLUMN "search_vector" tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector("search_language", coalesce("title", '')), 'A') ||
+    setweight(to_tsvector("search_language", coalesce("description", '')), 'B')
+) STORED;
+-- Create index "todo_search_vector" to table: "todos"
+CREATE INDEX "todo_search_vector" ON "todos" USING GIN ("search_vector");
+
+-- New todos inherit the tenant's configuration
+CREATE FUNCTION "todos_set_search_language"() RETURNS trigger
+LANGUAGE plpgsql AS $$
+BEGIN
+    SELECT t."search_language"::regconfig INTO NEW."search_language"
+    FROM "tenants" t
+    WHERE t."id" = NEW."tenant_id";
+    NEW."search_language" := coalesce(NEW."search_language", 'simple'::regconfig);
+    RETURN NEW;
+END;
+$$;
+
+CREATE TRIGGER "todos_set_search_language"
+    BEFORE INSERT ON "todos"
+    FOR EACH ROW EXECUTE FUNCTION "todos_set_search_language"();
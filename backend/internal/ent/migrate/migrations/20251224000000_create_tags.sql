@@ -0,0 +1,47 @@
+-- Create "tags" table
+CREATE TABLE "tags" (
+  "id" character varying NOT NULL,
+  "tenant_id" character varying NOT NULL,
+  "name" character varying(50) NOT NULL,
+  "color" character varying NOT NULL DEFAULT '#808080',
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "tag_tenant_id" to table: "tags"
+CREATE INDEX "tag_tenant_id" ON "tags" ("tenant_id");
+-- Tag names are unique per tenant regardless of case
+CREATE UNIQUE INDEX "tag_tenant_id_lower_name" ON "tags" ("tenant_id", lower("name"));
+
+-- Create "todo_tags" table
+CREATE TABLE "todo_tags" (
+  "tenant_id" character varying NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "tag_id" character varying NOT NULL,
+  "todo_id" character varying NOT NULL,
+  PRIMARY KEY ("tag_id", "todo_id"),
+  CONSTRAINT "todo_tags_tags_tag" FOREIGN KEY ("tag_id") REFERENCES "tags" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "todo_tags_todos_todo" FOREIGN KEY ("todo_id") REFERENCES "todos" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "todotag_tenant_id" to table: "todo_tags"
+CREATE INDEX "todotag_tenant_id" ON "todo_tags" ("tenant_id");
+-- Create index "todotag_todo_id" to table: "todo_tags"
+CREATE INDEX "todotag_todo_id" ON "todo_tags" ("todo_id");
+
+-- Enable RLS on tags and todo_tags tables
+ALTER TABLE "tags" ENABLE ROW LEVEL SECURITY;
+ALTER TABLE "tags" FORCE ROW LEVEL SECURITY;
+ALTER TABLE "todo_tags" ENABLE ROW LEVEL SECURITY;
+ALTER TABLE "todo_tags" FORCE ROW LEVEL SECURITY;
+
+-- RLS Policy for tags (ALL operations)
+CREATE POLICY "tags_tenant_isolation" ON "tags"
+    FOR ALL
+    USING ("tenant_id" = current_setting('app.current_tenant_id', true))
+    WITH CHECK ("tenant_id" = current_setting('app.current_tenant_id', true));
+
+-- RLS Policy for todo_tags (ALL operations)
+CREATE POLICY "todo_tags_tenant_isolation" ON "todo_tags"
+    FOR ALL
+    USING ("tenant_id" = current_setting('app.current_tenant_id', true))
+    WITH CHECK ("tenant_id" = current_setting('app.current_tenant_id', true));
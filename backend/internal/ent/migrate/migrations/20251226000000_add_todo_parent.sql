@@ -0,0 +1,5 @@
+-- Modify "todos" table
+ALTER TABLE "todos" ADD COLUMN "parent_id" character varying NULL,
+  ADD CONSTRAINT "todos_todos_children" FOREIGN KEY ("parent_id") REFERENCES "todos" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Create index "todo_parent_id" to table: "todos"
+CREATE INDEX "todo_parent_id" ON "todos" ("parent_id");
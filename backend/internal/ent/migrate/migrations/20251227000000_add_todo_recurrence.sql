@@ -0,0 +1,2 @@
+-- Modify "todos" table
+ALTER TABLE "todos" ADD COLUMN "recurrence_rule" character varying NULL, ADD COLUMN "recurrence_timezone" character varying NULL, ADD COLUMN "recurrence_start" timestamptz NULL;
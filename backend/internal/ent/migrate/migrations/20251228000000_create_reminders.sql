@@ -0,0 +1,64 @@
+-- Create "reminders" table
+CREATE TABLE "reminders" (
+  "id" character varying NOT NULL,
+  "tenant_id" character varying NOT NULL,
+  "remind_at" timestamptz NOT NULL,
+  "offset_minutes" bigint NULL,
+  "channel" character varying NOT NULL,
+  "attempts" bigint NOT NULL DEFAULT 0,
+  "next_attempt_at" timestamptz NOT NULL,
+  "sent_at" timestamptz NULL,
+  "failed_at" timestamptz NULL,
+  "created_at" timestamptz NOT NULL,
+  "todo_id" character varying NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "reminders_todos_reminders" FOREIGN KEY ("todo_id") REFERENCES "todos" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "reminder_tenant_id" to table: "reminders"
+CREATE INDEX "reminder_tenant_id" ON "reminders" ("tenant_id");
+-- Create index "reminder_todo_id" to table: "reminders"
+CREATE INDEX "reminder_todo_id" ON "reminders" ("todo_id");
+-- Create index "reminder_next_attempt_at" to table: "reminders"
+CREATE INDEX "reminder_next_attempt_at" ON "reminders" ("next_attempt_at") WHERE ("sent_at" IS NULL AND "failed_at" IS NULL);
+
+-- Create "notifications" table
+CREATE TABLE "notifications" (
+  "id" character varying NOT NULL,
+  "tenant_id" character varying NOT NULL,
+  "reminder_id" character varying NULL,
+  "title" character varying NOT NULL,
+  "body" text NOT NULL DEFAULT '',
+  "read_at" timestamptz NULL,
+  "created_at" timestamptz NOT NULL,
+  "todo_id" character varying NULL,
+  "user_id" character varying NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "notifications_todos_notifications" FOREIGN KEY ("todo_id") REFERENCES "todos" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "notifications_users_notifications" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "notifications_reminder_id_key" to table: "notifications"
+CREATE UNIQUE INDEX "notifications_reminder_id_key" ON "notifications" ("reminder_id");
+-- Create index "notification_tenant_id" to table: "notifications"
+CREATE INDEX "notification_tenant_id" ON "notifications" ("tenant_id");
+-- Create index "notification_user_id_created_at" to table: "notifications"
+CREATE INDEX "notification_user_id_created_at" ON "notifications" ("user_id", "created_at");
+-- Create index "notification_todo_id" to table: "notifications"
+CREATE INDEX "notification_todo_id" ON "notifications" ("todo_id");
+
+-- Enable RLS on reminders and notifications tables
+ALTER TABLE "reminders" ENABLE ROW LEVEL SECURITY;
+ALTER TABLE "reminders" FORCE ROW LEVEL SECURITY;
+ALTER TABLE "notifications" ENABLE ROW LEVEL SECURITY;
+ALTER TABLE "notifications" FORCE ROW LEVEL SECURITY;
+
+-- RLS Policy for reminders (ALL operations)
+CREATE POLICY "reminders_tenant_isolation" ON "reminders"
+    FOR ALL
+    USING ("tenant_id" = current_setting('app.current_tenant_id', true))
+    WITH CHECK ("tenant_id" = current_setting('app.current_tenant_id', true));
+
+-- RLS Policy for notifications (ALL operations)
+CREATE POLICY "notifications_tenant_isolation" ON "notifications"
+    FOR ALL
+    USING ("tenant_id" = current_setting('app.current_tenant_id', true))
+    WITH CHECK ("tenant_id" = current_setting('app.current_tenant_id', true));
@@ -0,0 +1,50 @@
+-- Modify "todos" table
+ALTER TABLE "todos" ADD COLUMN "assignee_id" character varying NULL,
+  ADD CONSTRAINT "todos_users_assigned_todos" FOREIGN KEY ("assignee_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Create index "todo_assignee_id" to table: "todos"
+CREATE INDEX "todo_assignee_id" ON "todos" ("assignee_id");
+
+-- Create "todo_assignments" table
+CREATE TABLE "todo_assignments" (
+  "id" character varying NOT NULL,
+  "tenant_id" character varying NOT NULL,
+  "previous_assignee_id" character varying NULL,
+  "assignee_id" character varying NULL,
+  "changed_by" character varying NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "todo_id" character varying NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "todo_assignments_todos_assignments" FOREIGN KEY ("todo_id") REFERENCES "todos" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "todoassignment_tenant_id" to table: "todo_assignments"
+CREATE INDEX "todoassignment_tenant_id" ON "todo_assignments" ("tenant_id");
+-- Create index "todoassignment_todo_id_created_at" to table: "todo_assignments"
+CREATE INDEX "todoassignment_todo_id_created_at" ON "todo_assignments" ("todo_id", "created_at");
+
+-- Enable RLS on todo_assignments table
+ALTER TABLE "todo_assignments" ENABLE ROW LEVEL SECURITY;
+ALTER TABLE "todo_assignments" FORCE ROW LEVEL SECURITY;
+
+-- RLS Policy for todo_assignments (ALL operations)
+CREATE POLICY "todo_assignments_tenant_isolation" ON "todo_assignments"
+    FOR ALL
+    USING ("tenant_id" = current_setting('app.current_tenant_id', true))
+    WITH CHECK ("tenant_id" = current_setting('app.current_tenant_id', true));
+
+-- Foreign keys are checked without RLS, so the todos policy itself makes
+-- sure an assignee belongs to the todo's tenant. The subquery runs under
+-- the users policy, which only shows the current tenant's members.
+DROP POLICY IF EXISTS "todos_tenant_isolation" ON "todos";
+CREATE POLICY "todos_tenant_isolation" ON "todos"
+    FOR ALL
+    USING ("tenant_id" = current_setting('app.current_tenant_id', true))
+    WITH CHECK (
+        "tenant_id" = current_setting('app.current_tenant_id', true)
+        AND (
+            "assignee_id" IS NULL
+            OR EXISTS (
+                SELECT 1 FROM "users" u
+                WHERE u."id" = "assignee_id" AND u."tenant_id" = "todos"."tenant_id"
+            )
+        )
+    );
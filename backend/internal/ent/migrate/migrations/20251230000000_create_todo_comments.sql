@@ -0,0 +1,30 @@
+-- Create "todo_comments" table
+CREATE TABLE "todo_comments" (
+  "id" character varying NOT NULL,
+  "tenant_id" character varying NOT NULL,
+  "body" text NOT NULL,
+  "edited_at" timestamptz NULL,
+  "deleted_at" timestamptz NULL,
+  "created_at" timestamptz NOT NULL,
+  "todo_id" character varying NOT NULL,
+  "author_id" character varying NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "todo_comments_todos_comments" FOREIGN KEY ("todo_id") REFERENCES "todos" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "todo_comments_users_comments" FOREIGN KEY ("author_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE SET NULL
+);
+-- Create index "todocomment_tenant_id" to table: "todo_comments"
+CREATE INDEX "todocomment_tenant_id" ON "todo_comments" ("tenant_id");
+-- Create index "todocomment_todo_id_created_at" to table: "todo_comments"
+CREATE INDEX "todocomment_todo_id_created_at" ON "todo_comments" ("todo_id", "created_at");
+-- Create index "todocomment_author_id" to table: "todo_comments"
+CREATE INDEX "todocomment_author_id" ON "todo_comments" ("author_id");
+
+-- Enable RLS on todo_comments table
+ALTER TABLE "todo_comments" ENABLE ROW LEVEL SECURITY;
+ALTER TABLE "todo_comments" FORCE ROW LEVEL SECURITY;
+
+-- RLS Policy for todo_comments (ALL operations)
+CREATE POLICY "todo_comments_tenant_isolation" ON "todo_comments"
+    FOR ALL
+    USING ("tenant_id" = current_setting('app.current_tenant_id', true))
+    WITH CHECK ("tenant_id" = current_setting('app.current_tenant_id', true));
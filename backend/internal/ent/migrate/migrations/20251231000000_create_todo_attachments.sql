@@ -0,0 +1,64 @@
+-- Create "todo_attachments" table
+CREATE TABLE "todo_attachments" (
+  "id" character varying NOT NULL,
+  "tenant_id" character varying NOT NULL,
+  "uploaded_by" character varying NOT NULL,
+  "file_name" character varying NOT NULL,
+  "content_type" character varying NOT NULL,
+  "size" bigint NOT NULL,
+  "storage_key" character varying NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "todo_id" character varying NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "todo_attachments_todos_attachments" FOREIGN KEY ("todo_id") REFERENCES "todos" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "todo_attachments_storage_key_key" to table: "todo_attachments"
+CREATE UNIQUE INDEX "todo_attachments_storage_key_key" ON "todo_attachments" ("storage_key");
+-- Create index "todoattachment_tenant_id" to table: "todo_attachments"
+CREATE INDEX "todoattachment_tenant_id" ON "todo_attachments" ("tenant_id");
+-- Create index "todoattachment_todo_id_created_at" to table: "todo_attachments"
+CREATE INDEX "todoattachment_todo_id_created_at" ON "todo_attachments" ("todo_id", "created_at");
+
+-- Create "blob_deletions" table
+CREATE TABLE "blob_deletions" (
+  "id" character varying NOT NULL,
+  "tenant_id" character varying NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "blobdeletion_tenant_id_created_at" to table: "blob_deletions"
+CREATE INDEX "blobdeletion_tenant_id_created_at" ON "blob_deletions" ("tenant_id", "created_at");
+
+-- Deleted attachments, including those removed with their todo by the
+-- cascade, queue their blob for the scheduler to delete
+CREATE FUNCTION "todo_attachments_queue_blob_deletion"() RETURNS trigger
+LANGUAGE plpgsql AS $$
+BEGIN
+    INSERT INTO "blob_deletions" ("id", "tenant_id", "created_at")
+    VALUES (OLD."storage_key", OLD."tenant_id", now())
+    ON CONFLICT ("id") DO NOTHING;
+    RETURN OLD;
+END;
+$$;
+
+CREATE TRIGGER "todo_attachments_queue_blob_deletion"
+    AFTER DELETE ON "todo_attachments"
+    FOR EACH ROW EXECUTE FUNCTION "todo_attachments_queue_blob_deletion"();
+
+-- Enable RLS on todo_attachments and blob_deletions tables
+ALTER TABLE "todo_attachments" ENABLE ROW LEVEL SECURITY;
+ALTER TABLE "todo_attachments" FORCE ROW LEVEL SECURITY;
+ALTER TABLE "blob_deletions" ENABLE ROW LEVEL SECURITY;
+ALTER TABLE "blob_deletions" FORCE ROW LEVEL SECURITY;
+
+-- RLS Policy for todo_attachments (ALL operations)
+CREATE POLICY "todo_attachments_tenant_isolation" ON "todo_attachments"
+    FOR ALL
+    USING ("tenant_id" = current_setting('app.current_tenant_id', true))
+    WITH CHECK ("tenant_id" = current_setting('app.current_tenant_id', true));
+
+-- RLS Policy for blob_deletions (ALL operations)
+CREATE POLICY "blob_deletions_tenant_isolation" ON "blob_deletions"
+    FOR ALL
+    USING ("tenant_id" = current_setting('app.current_tenant_id', true))
+    WITH CHECK ("tenant_id" = current_setting('app.current_tenant_id', true));
@@ -0,0 +1,16 @@
+-- Modify "todos" table
+ALTER TABLE "todos" ADD COLUMN "priority" bigint NOT NULL DEFAULT 0,
+  ADD COLUMN "position" character varying COLLATE "C" NULL;
+-- Existing todos are ordered by creation. The keys have a fixed width and do
+-- not end in '0', as fractional index keys must.
+UPDATE "todos" AS t SET "position" = lpad(o."n"::text, 10, '0') || 'V'
+FROM (
+  SELECT "id", row_number() OVER (PARTITION BY "user_id" ORDER BY "created_at", "id") AS "n"
+  FROM "todos"
+) AS o
+WHERE t."id" = o."id";
+ALTER TABLE "todos" ALTER COLUMN "position" SET NOT NULL;
+-- Create index "todo_user_id_position" to table: "todos"
+CREATE INDEX "todo_user_id_position" ON "todos" ("user_id", "position");
+-- Create index "todo_user_id_priority" to table: "todos"
+CREATE INDEX "todo_user_id_priority" ON "todos" ("user_id", "priority");
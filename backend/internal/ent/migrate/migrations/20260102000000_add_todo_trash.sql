@@ -0,0 +1,8 @@
+-- Modify "tenants" table
+ALTER TABLE "tenants" ADD COLUMN "trash_retention_days" bigint NOT NULL DEFAULT 30;
+-- Modify "todos" table
+ALTER TABLE "todos" ADD COLUMN "deleted_at" timestamptz NULL;
+-- Create index "todo_user_id_deleted_at" to table: "todos"
+CREATE INDEX "todo_user_id_deleted_at" ON "todos" ("user_id", "deleted_at");
+-- Create index "todo_tenant_id_deleted_at" to table: "todos"
+CREATE INDEX "todo_tenant_id_deleted_at" ON "todos" ("tenant_id", "deleted_at");
@@ -0,0 +1,27 @@
+-- Create "todo_revisions" table
+CREATE TABLE "todo_revisions" (
+  "id" character varying NOT NULL,
+  "tenant_id" character varying NOT NULL,
+  "actor_id" character varying NULL,
+  "operation" character varying NOT NULL,
+  "old_values" jsonb NULL,
+  "new_values" jsonb NULL,
+  "created_at" timestamptz NOT NULL,
+  "todo_id" character varying NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "todo_revisions_todos_revisions" FOREIGN KEY ("todo_id") REFERENCES "todos" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "todorevision_tenant_id" to table: "todo_revisions"
+CREATE INDEX "todorevision_tenant_id" ON "todo_revisions" ("tenant_id");
+-- Create index "todorevision_todo_id_created_at" to table: "todo_revisions"
+CREATE INDEX "todorevision_todo_id_created_at" ON "todo_revisions" ("todo_id", "created_at");
+
+-- Enable RLS on todo_revisions table
+ALTER TABLE "todo_revisions" ENABLE ROW LEVEL SECURITY;
+ALTER TABLE "todo_revisions" FORCE ROW LEVEL SECURITY;
+
+-- RLS Policy for todo_revisions (ALL operations)
+CREATE POLICY "todo_revisions_tenant_isolation" ON "todo_revisions"
+    FOR ALL
+    USING ("tenant_id" = current_setting('app.current_tenant_id', true))
+    WITH CHECK ("tenant_id" = current_setting('app.current_tenant_id', true));
@@ -0,0 +1,2 @@
+-- Modify "todos" table
+ALTER TABLE "todos" ADD COLUMN "version" bigint NOT NULL DEFAULT 1;
@@ -0,0 +1,43 @@
+-- Create "todo_shares" table
+CREATE TABLE "todo_shares" (
+  "id" character varying NOT NULL,
+  "tenant_id" character varying NOT NULL,
+  "permission" character varying NOT NULL,
+  "created_by" character varying NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "todo_id" character varying NOT NULL,
+  "user_id" character varying NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "todo_shares_todos_shares" FOREIGN KEY ("todo_id") REFERENCES "todos" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "todo_shares_users_shared_todos" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "todoshare_tenant_id" to table: "todo_shares"
+CREATE INDEX "todoshare_tenant_id" ON "todo_shares" ("tenant_id");
+-- Create index "todoshare_todo_id_user_id" to table: "todo_shares"
+CREATE UNIQUE INDEX "todoshare_todo_id_user_id" ON "todo_shares" ("todo_id", "user_id");
+-- Create index "todoshare_user_id" to table: "todo_shares"
+CREATE INDEX "todoshare_user_id" ON "todo_shares" ("user_id");
+
+-- Enable RLS on todo_shares table
+ALTER TABLE "todo_shares" ENABLE ROW LEVEL SECURITY;
+ALTER TABLE "todo_shares" FORCE ROW LEVEL SECURITY;
+
+-- RLS Policy for todo_shares (ALL operations). Foreign keys are checked
+-- without RLS, so like the todos policy for assignees it makes sure the
+-- todo and the member it is shared with belong to the same tenant. The
+-- subqueries run under the todos and users policies.
+CREATE POLICY "todo_shares_tenant_isolation" ON "todo_shares"
+    FOR ALL
+    USING ("tenant_id" = current_setting('app.current_tenant_id', true))
+    WITH CHECK (
+        "tenant_id" = current_setting('app.current_tenant_id', true)
+        AND EXISTS (
+            SELECT 1 FROM "todos" t
+            WHERE t."id" = "todo_id" AND t."tenant_id" = "todo_shares"."tenant_id"
+        )
+        AND EXISTS (
+            SELECT 1 FROM "users" u
+            WHERE u."id" = "user_id" AND u."tenant_id" = "todo_shares"."tenant_id"
+        )
+    );
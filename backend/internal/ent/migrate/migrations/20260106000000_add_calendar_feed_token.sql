@@ -0,0 +1,4 @@
+-- Add calendar feed token (hashed) to users
+ALTER TABLE "users" ADD COLUMN "calendar_token_hash" character varying NULL;
+-- Create index "users_calendar_token_hash_key" to table: "users"
+CREATE UNIQUE INDEX "users_calendar_token_hash_key" ON "users" ("calendar_token_hash");
@@ -0,0 +1,30 @@
+-- Create "password_reset_tokens" table
+CREATE TABLE "password_reset_tokens" (
+  "id" character varying NOT NULL,
+  "tenant_id" character varying NOT NULL,
+  "token_hash" character varying NOT NULL,
+  "expires_at" timestamptz NOT NULL,
+  "used_at" timestamptz NULL,
+  "created_at" timestamptz NOT NULL,
+  "user_id" character varying NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "password_reset_tokens_users_password_reset_tokens" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "password_reset_tokens_token_hash_key" to table: "password_reset_tokens"
+CREATE UNIQUE INDEX "password_reset_tokens_token_hash_key" ON "password_reset_tokens" ("token_hash");
+-- Create index "passwordresettoken_tenant_id" to table: "password_reset_tokens"
+CREATE INDEX "passwordresettoken_tenant_id" ON "password_reset_tokens" ("tenant_id");
+-- Create index "passwordresettoken_user_id" to table: "password_reset_tokens"
+CREATE INDEX "passwordresettoken_user_id" ON "password_reset_tokens" ("user_id");
+
+-- Enable RLS on password_reset_tokens table
+ALTER TABLE "password_reset_tokens" ENABLE ROW LEVEL SECURITY;
+ALTER TABLE "password_reset_tokens" FORCE ROW LEVEL SECURITY;
+
+-- RLS Policy for password_reset_tokens (ALL operations)
+-- Like magic link tokens, reset tokens are issued and consumed with the tenant
+-- resolved from its slug.
+CREATE POLICY "password_reset_tokens_tenant_isolation" ON "password_reset_tokens"
+    FOR ALL
+    USING ("tenant_id" = current_setting('app.current_tenant_id', true))
+    WITH CHECK ("tenant_id" = current_setting('app.current_tenant_id', true));
@@ -0,0 +1,5 @@
+-- Modify "users" table
+ALTER TABLE "users" ADD COLUMN "is_system" boolean NOT NULL DEFAULT false;
+
+-- Mark the deleted-user placeholders created so far
+UPDATE "users" SET "is_system" = true WHERE "email" = 'deleted-user@deleted.invalid';
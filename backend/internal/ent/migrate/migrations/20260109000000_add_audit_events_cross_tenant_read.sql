@@ -0,0 +1,7 @@
+-- The admin API reads the audit log of every tenant. It opts in per
+-- transaction with SET LOCAL app.audit_all_tenants = 'on'; tenant requests
+-- never set it, so their reads stay limited to their own tenant. Inserts are
+-- still limited to the current tenant.
+CREATE POLICY "audit_events_all_tenants_select" ON "audit_events"
+    FOR SELECT
+    USING (current_setting('app.audit_all_tenants', true) = 'on');
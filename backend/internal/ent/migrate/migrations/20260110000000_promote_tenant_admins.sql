@@ -0,0 +1,13 @@
+-- Tenants created before admin roles were assigned at signup have no admin,
+-- so promote their earliest active user
+UPDATE "users" SET "role" = 'admin'
+WHERE "id" IN (
+  SELECT DISTINCT ON (u."tenant_id") u."id"
+  FROM "users" u
+  WHERE u."is_active" AND NOT u."is_system"
+    AND NOT EXISTS (
+      SELECT 1 FROM "users" a
+      WHERE a."tenant_id" = u."tenant_id" AND a."role" = 'admin' AND a."is_active"
+    )
+  ORDER BY u."tenant_id", u."created_at", u."id"
+);
@@ -0,0 +1,3 @@
+-- Revisions are kept when a todo is purged from the trash
+-- Modify "todo_revisions" table
+ALTER TABLE "todo_revisions" DROP CONSTRAINT "todo_revisions_todos_revisions";
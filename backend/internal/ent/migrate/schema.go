@@ -3,16 +3,313 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
 
 var (
+	// AuditEventsColumns holds the columns for the "audit_events" table.
+	AuditEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "tenant_id", Type: field.TypeString},
+		{Name: "actor_type", Type: field.TypeEnum, Enums: []string{"user", "scim", "anonymous"}},
+		{Name: "actor_id", Type: field.TypeString, Nullable: true},
+		{Name: "action", Type: field.TypeString},
+		{Name: "target_type", Type: field.TypeString, Nullable: true},
+		{Name: "target_id", Type: field.TypeString, Nullable: true},
+		{Name: "ip", Type: field.TypeString, Default: ""},
+		{Name: "user_agent", Type: field.TypeString, Default: ""},
+		{Name: "request_id", Type: field.TypeString, Default: ""},
+		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AuditEventsTable holds the schema information for the "audit_events" table.
+	AuditEventsTable = &schema.Table{
+		Name:       "audit_events",
+		Columns:    AuditEventsColumns,
+		PrimaryKey: []*schema.Column{AuditEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditevent_tenant_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEventsColumns[1], AuditEventsColumns[11]},
+			},
+			{
+				Name:    "auditevent_tenant_id_action",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEventsColumns[1], AuditEventsColumns[4]},
+			},
+			{
+				Name:    "auditevent_tenant_id_actor_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEventsColumns[1], AuditEventsColumns[3]},
+			},
+		},
+	}
+	// BlobDeletionsColumns holds the columns for the "blob_deletions" table.
+	BlobDeletionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "tenant_id", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// BlobDeletionsTable holds the schema information for the "blob_deletions" table.
+	BlobDeletionsTable = &schema.Table{
+		Name:       "blob_deletions",
+		Columns:    BlobDeletionsColumns,
+		PrimaryKey: []*schema.Column{BlobDeletionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "blobdeletion_tenant_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{BlobDeletionsColumns[1], BlobDeletionsColumns[2]},
+			},
+		},
+	}
+	// MagicLinkTokensColumns holds the columns for the "magic_link_tokens" table.
+	MagicLinkTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "tenant_id", Type: field.TypeString},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeString},
+	}
+	// MagicLinkTokensTable holds the schema information for the "magic_link_tokens" table.
+	MagicLinkTokensTable = &schema.Table{
+		Name:       "magic_link_tokens",
+		Columns:    MagicLinkTokensColumns,
+		PrimaryKey: []*schema.Column{MagicLinkTokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "magic_link_tokens_users_magic_link_tokens",
+				Columns:    []*schema.Column{MagicLinkTokensColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "magiclinktoken_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{MagicLinkTokensColumns[1]},
+			},
+			{
+				Name:    "magiclinktoken_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{MagicLinkTokensColumns[6]},
+			},
+		},
+	}
+	// NotificationsColumns holds the columns for the "notifications" table.
+	NotificationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "tenant_id", Type: field.TypeString},
+		{Name: "reminder_id", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "title", Type: field.TypeString},
+		{Name: "body", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "read_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "todo_id", Type: field.TypeString, Nullable: true},
+		{Name: "user_id", Type: field.TypeString},
+	}
+	// NotificationsTable holds the schema information for the "notifications" table.
+	NotificationsTable = &schema.Table{
+		Name:       "notifications",
+		Columns:    NotificationsColumns,
+		PrimaryKey: []*schema.Column{NotificationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "notifications_todos_notifications",
+				Columns:    []*schema.Column{NotificationsColumns[7]},
+				RefColumns: []*schema.Column{TodosColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "notifications_users_notifications",
+				Columns:    []*schema.Column{NotificationsColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "notification_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationsColumns[1]},
+			},
+			{
+				Name:    "notification_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationsColumns[8], NotificationsColumns[6]},
+			},
+			{
+				Name:    "notification_todo_id",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationsColumns[7]},
+			},
+		},
+	}
+	// PasswordResetTokensColumns holds the columns for the "password_reset_tokens" table.
+	PasswordResetTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "tenant_id", Type: field.TypeString},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeString},
+	}
+	// PasswordResetTokensTable holds the schema information for the "password_reset_tokens" table.
+	PasswordResetTokensTable = &schema.Table{
+		Name:       "password_reset_tokens",
+		Columns:    PasswordResetTokensColumns,
+		PrimaryKey: []*schema.Column{PasswordResetTokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "password_reset_tokens_users_password_reset_tokens",
+				Columns:    []*schema.Column{PasswordResetTokensColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "passwordresettoken_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{PasswordResetTokensColumns[1]},
+			},
+			{
+				Name:    "passwordresettoken_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{PasswordResetTokensColumns[6]},
+			},
+		},
+	}
+	// ProjectsColumns holds the columns for the "projects" table.
+	ProjectsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "tenant_id", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647, Default: ""},
+		{Name: "is_public", Type: field.TypeBool, Default: false},
+		{Name: "archived_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeString},
+	}
+	// ProjectsTable holds the schema information for the "projects" table.
+	ProjectsTable = &schema.Table{
+		Name:       "projects",
+		Columns:    ProjectsColumns,
+		PrimaryKey: []*schema.Column{ProjectsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "projects_users_projects",
+				Columns:    []*schema.Column{ProjectsColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "project_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{ProjectsColumns[1]},
+			},
+			{
+				Name:    "project_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{ProjectsColumns[8]},
+			},
+			{
+				Name:    "project_tenant_id_is_public",
+				Unique:  false,
+				Columns: []*schema.Column{ProjectsColumns[1], ProjectsColumns[4]},
+			},
+		},
+	}
+	// RemindersColumns holds the columns for the "reminders" table.
+	RemindersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "tenant_id", Type: field.TypeString},
+		{Name: "remind_at", Type: field.TypeTime},
+		{Name: "offset_minutes", Type: field.TypeInt, Nullable: true},
+		{Name: "channel", Type: field.TypeString},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "next_attempt_at", Type: field.TypeTime},
+		{Name: "sent_at", Type: field.TypeTime, Nullable: true},
+		{Name: "failed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "todo_id", Type: field.TypeString},
+	}
+	// RemindersTable holds the schema information for the "reminders" table.
+	RemindersTable = &schema.Table{
+		Name:       "reminders",
+		Columns:    RemindersColumns,
+		PrimaryKey: []*schema.Column{RemindersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "reminders_todos_reminders",
+				Columns:    []*schema.Column{RemindersColumns[10]},
+				RefColumns: []*schema.Column{TodosColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "reminder_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{RemindersColumns[1]},
+			},
+			{
+				Name:    "reminder_todo_id",
+				Unique:  false,
+				Columns: []*schema.Column{RemindersColumns[10]},
+			},
+			{
+				Name:    "reminder_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{RemindersColumns[6]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "\"sent_at\" IS NULL AND \"failed_at\" IS NULL",
+				},
+			},
+		},
+	}
+	// TagsColumns holds the columns for the "tags" table.
+	TagsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "tenant_id", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString, SchemaType: map[string]string{"postgres": "character varying(50)"}},
+		{Name: "color", Type: field.TypeString, Default: "#808080"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// TagsTable holds the schema information for the "tags" table.
+	TagsTable = &schema.Table{
+		Name:       "tags",
+		Columns:    TagsColumns,
+		PrimaryKey: []*schema.Column{TagsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tag_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{TagsColumns[1]},
+			},
+		},
+	}
 	// TenantsColumns holds the columns for the "tenants" table.
 	TenantsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString},
 		{Name: "name", Type: field.TypeString},
 		{Name: "slug", Type: field.TypeString, Unique: true},
+		{Name: "magic_link_enabled", Type: field.TypeBool, Default: false},
+		{Name: "account_deletion_policy", Type: field.TypeEnum, Enums: []string{"anonymize", "reassign"}, Default: "anonymize"},
+		{Name: "search_language", Type: field.TypeEnum, Enums: []string{"simple", "danish", "dutch", "english", "finnish", "french", "german", "hungarian", "italian", "norwegian", "portuguese", "romanian", "russian", "spanish", "swedish", "turkish"}, Default: "simple"},
+		{Name: "trash_retention_days", Type: field.TypeInt, Default: 30},
+		{Name: "scim_token_hash", Type: field.TypeString, Unique: true, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 	}
@@ -30,11 +327,21 @@ var (
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647, Default: ""},
 		{Name: "completed", Type: field.TypeBool, Default: false},
 		{Name: "is_public", Type: field.TypeBool, Default: false},
+		{Name: "priority", Type: field.TypeInt, Default: 0},
+		{Name: "position", Type: field.TypeString, Collation: "C"},
 		{Name: "due_date", Type: field.TypeTime, Nullable: true},
 		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "recurrence_rule", Type: field.TypeString, Nullable: true},
+		{Name: "recurrence_timezone", Type: field.TypeString, Nullable: true},
+		{Name: "recurrence_start", Type: field.TypeTime, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "version", Type: field.TypeInt, Default: 1},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "project_id", Type: field.TypeString, Nullable: true},
+		{Name: "parent_id", Type: field.TypeString, Nullable: true},
 		{Name: "user_id", Type: field.TypeString},
+		{Name: "assignee_id", Type: field.TypeString, Nullable: true},
 	}
 	// TodosTable holds the schema information for the "todos" table.
 	TodosTable = &schema.Table{
@@ -42,12 +349,30 @@ var (
 		Columns:    TodosColumns,
 		PrimaryKey: []*schema.Column{TodosColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "todos_projects_todos",
+				Columns:    []*schema.Column{TodosColumns[17]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "todos_todos_children",
+				Columns:    []*schema.Column{TodosColumns[18]},
+				RefColumns: []*schema.Column{TodosColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "todos_users_todos",
-				Columns:    []*schema.Column{TodosColumns[10]},
+				Columns:    []*schema.Column{TodosColumns[19]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
+			{
+				Symbol:     "todos_users_assigned_todos",
+				Columns:    []*schema.Column{TodosColumns[20]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 		},
 		Indexes: []*schema.Index{
 			{
@@ -58,18 +383,296 @@ var (
 			{
 				Name:    "todo_user_id",
 				Unique:  false,
-				Columns: []*schema.Column{TodosColumns[10]},
+				Columns: []*schema.Column{TodosColumns[19]},
 			},
 			{
 				Name:    "todo_tenant_id_user_id",
 				Unique:  false,
-				Columns: []*schema.Column{TodosColumns[1], TodosColumns[10]},
+				Columns: []*schema.Column{TodosColumns[1], TodosColumns[19]},
 			},
 			{
 				Name:    "todo_tenant_id_is_public",
 				Unique:  false,
 				Columns: []*schema.Column{TodosColumns[1], TodosColumns[5]},
 			},
+			{
+				Name:    "todo_user_id_completed_at",
+				Unique:  false,
+				Columns: []*schema.Column{TodosColumns[19], TodosColumns[9]},
+			},
+			{
+				Name:    "todo_project_id",
+				Unique:  false,
+				Columns: []*schema.Column{TodosColumns[17]},
+			},
+			{
+				Name:    "todo_parent_id",
+				Unique:  false,
+				Columns: []*schema.Column{TodosColumns[18]},
+			},
+			{
+				Name:    "todo_assignee_id",
+				Unique:  false,
+				Columns: []*schema.Column{TodosColumns[20]},
+			},
+			{
+				Name:    "todo_user_id_position",
+				Unique:  false,
+				Columns: []*schema.Column{TodosColumns[19], TodosColumns[7]},
+			},
+			{
+				Name:    "todo_user_id_priority",
+				Unique:  false,
+				Columns: []*schema.Column{TodosColumns[19], TodosColumns[6]},
+			},
+			{
+				Name:    "todo_user_id_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{TodosColumns[19], TodosColumns[13]},
+			},
+			{
+				Name:    "todo_tenant_id_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{TodosColumns[1], TodosColumns[13]},
+			},
+		},
+	}
+	// TodoAssignmentsColumns holds the columns for the "todo_assignments" table.
+	TodoAssignmentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "tenant_id", Type: field.TypeString},
+		{Name: "previous_assignee_id", Type: field.TypeString, Nullable: true},
+		{Name: "assignee_id", Type: field.TypeString, Nullable: true},
+		{Name: "changed_by", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "todo_id", Type: field.TypeString},
+	}
+	// TodoAssignmentsTable holds the schema information for the "todo_assignments" table.
+	TodoAssignmentsTable = &schema.Table{
+		Name:       "todo_assignments",
+		Columns:    TodoAssignmentsColumns,
+		PrimaryKey: []*schema.Column{TodoAssignmentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "todo_assignments_todos_assignments",
+				Columns:    []*schema.Column{TodoAssignmentsColumns[6]},
+				RefColumns: []*schema.Column{TodosColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "todoassignment_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{TodoAssignmentsColumns[1]},
+			},
+			{
+				Name:    "todoassignment_todo_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{TodoAssignmentsColumns[6], TodoAssignmentsColumns[5]},
+			},
+		},
+	}
+	// TodoAttachmentsColumns holds the columns for the "todo_attachments" table.
+	TodoAttachmentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "tenant_id", Type: field.TypeString},
+		{Name: "uploaded_by", Type: field.TypeString},
+		{Name: "file_name", Type: field.TypeString},
+		{Name: "content_type", Type: field.TypeString},
+		{Name: "size", Type: field.TypeInt64},
+		{Name: "storage_key", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "todo_id", Type: field.TypeString},
+	}
+	// TodoAttachmentsTable holds the schema information for the "todo_attachments" table.
+	TodoAttachmentsTable = &schema.Table{
+		Name:       "todo_attachments",
+		Columns:    TodoAttachmentsColumns,
+		PrimaryKey: []*schema.Column{TodoAttachmentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "todo_attachments_todos_attachments",
+				Columns:    []*schema.Column{TodoAttachmentsColumns[8]},
+				RefColumns: []*schema.Column{TodosColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "todoattachment_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{TodoAttachmentsColumns[1]},
+			},
+			{
+				Name:    "todoattachment_todo_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{TodoAttachmentsColumns[8], TodoAttachmentsColumns[7]},
+			},
+		},
+	}
+	// TodoCommentsColumns holds the columns for the "todo_comments" table.
+	TodoCommentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "tenant_id", Type: field.TypeString},
+		{Name: "body", Type: field.TypeString, Size: 2147483647},
+		{Name: "edited_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "todo_id", Type: field.TypeString},
+		{Name: "author_id", Type: field.TypeString, Nullable: true},
+	}
+	// TodoCommentsTable holds the schema information for the "todo_comments" table.
+	TodoCommentsTable = &schema.Table{
+		Name:       "todo_comments",
+		Columns:    TodoCommentsColumns,
+		PrimaryKey: []*schema.Column{TodoCommentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "todo_comments_todos_comments",
+				Columns:    []*schema.Column{TodoCommentsColumns[6]},
+				RefColumns: []*schema.Column{TodosColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "todo_comments_users_comments",
+				Columns:    []*schema.Column{TodoCommentsColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "todocomment_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{TodoCommentsColumns[1]},
+			},
+			{
+				Name:    "todocomment_todo_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{TodoCommentsColumns[6], TodoCommentsColumns[5]},
+			},
+			{
+				Name:    "todocomment_author_id",
+				Unique:  false,
+				Columns: []*schema.Column{TodoCommentsColumns[7]},
+			},
+		},
+	}
+	// TodoRevisionsColumns holds the columns for the "todo_revisions" table.
+	TodoRevisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "tenant_id", Type: field.TypeString},
+		{Name: "todo_id", Type: field.TypeString},
+		{Name: "actor_id", Type: field.TypeString, Nullable: true},
+		{Name: "operation", Type: field.TypeEnum, Enums: []string{"create", "update", "delete", "restore", "purge"}},
+		{Name: "old_values", Type: field.TypeJSON, Nullable: true},
+		{Name: "new_values", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// TodoRevisionsTable holds the schema information for the "todo_revisions" table.
+	TodoRevisionsTable = &schema.Table{
+		Name:       "todo_revisions",
+		Columns:    TodoRevisionsColumns,
+		PrimaryKey: []*schema.Column{TodoRevisionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "todorevision_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{TodoRevisionsColumns[1]},
+			},
+			{
+				Name:    "todorevision_todo_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{TodoRevisionsColumns[2], TodoRevisionsColumns[7]},
+			},
+		},
+	}
+	// TodoSharesColumns holds the columns for the "todo_shares" table.
+	TodoSharesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "tenant_id", Type: field.TypeString},
+		{Name: "permission", Type: field.TypeEnum, Enums: []string{"read", "edit"}},
+		{Name: "created_by", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "todo_id", Type: field.TypeString},
+		{Name: "user_id", Type: field.TypeString},
+	}
+	// TodoSharesTable holds the schema information for the "todo_shares" table.
+	TodoSharesTable = &schema.Table{
+		Name:       "todo_shares",
+		Columns:    TodoSharesColumns,
+		PrimaryKey: []*schema.Column{TodoSharesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "todo_shares_todos_shares",
+				Columns:    []*schema.Column{TodoSharesColumns[6]},
+				RefColumns: []*schema.Column{TodosColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "todo_shares_users_shared_todos",
+				Columns:    []*schema.Column{TodoSharesColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "todoshare_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{TodoSharesColumns[1]},
+			},
+			{
+				Name:    "todoshare_todo_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{TodoSharesColumns[6], TodoSharesColumns[7]},
+			},
+			{
+				Name:    "todoshare_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{TodoSharesColumns[7]},
+			},
+		},
+	}
+	// TodoTagsColumns holds the columns for the "todo_tags" table.
+	TodoTagsColumns = []*schema.Column{
+		{Name: "tenant_id", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "tag_id", Type: field.TypeString},
+		{Name: "todo_id", Type: field.TypeString},
+	}
+	// TodoTagsTable holds the schema information for the "todo_tags" table.
+	TodoTagsTable = &schema.Table{
+		Name:       "todo_tags",
+		Columns:    TodoTagsColumns,
+		PrimaryKey: []*schema.Column{TodoTagsColumns[2], TodoTagsColumns[3]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "todo_tags_tags_tag",
+				Columns:    []*schema.Column{TodoTagsColumns[2]},
+				RefColumns: []*schema.Column{TagsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "todo_tags_todos_todo",
+				Columns:    []*schema.Column{TodoTagsColumns[3]},
+				RefColumns: []*schema.Column{TodosColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "todotag_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{TodoTagsColumns[0]},
+			},
+			{
+				Name:    "todotag_todo_id",
+				Unique:  false,
+				Columns: []*schema.Column{TodoTagsColumns[3]},
+			},
 		},
 	}
 	// UsersColumns holds the columns for the "users" table.
@@ -80,8 +683,12 @@ var (
 		{Name: "name", Type: field.TypeString, Default: ""},
 		{Name: "role", Type: field.TypeEnum, Enums: []string{"admin", "member"}, Default: "member"},
 		{Name: "email_verified", Type: field.TypeBool, Default: false},
+		{Name: "is_active", Type: field.TypeBool, Default: true},
+		{Name: "is_system", Type: field.TypeBool, Default: false},
+		{Name: "external_id", Type: field.TypeString, Nullable: true},
 		{Name: "verification_token", Type: field.TypeString, Nullable: true},
 		{Name: "verification_token_expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "calendar_token_hash", Type: field.TypeString, Unique: true, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "tenant_id", Type: field.TypeString},
@@ -94,7 +701,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "users_tenants_users",
-				Columns:    []*schema.Column{UsersColumns[10]},
+				Columns:    []*schema.Column{UsersColumns[14]},
 				RefColumns: []*schema.Column{TenantsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -103,24 +710,60 @@ var (
 			{
 				Name:    "user_tenant_id_email",
 				Unique:  true,
-				Columns: []*schema.Column{UsersColumns[10], UsersColumns[1]},
+				Columns: []*schema.Column{UsersColumns[14], UsersColumns[1]},
+			},
+			{
+				Name:    "user_tenant_id_external_id",
+				Unique:  true,
+				Columns: []*schema.Column{UsersColumns[14], UsersColumns[8]},
 			},
 			{
 				Name:    "user_tenant_id",
 				Unique:  false,
-				Columns: []*schema.Column{UsersColumns[10]},
+				Columns: []*schema.Column{UsersColumns[14]},
 			},
 		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AuditEventsTable,
+		BlobDeletionsTable,
+		MagicLinkTokensTable,
+		NotificationsTable,
+		PasswordResetTokensTable,
+		ProjectsTable,
+		RemindersTable,
+		TagsTable,
 		TenantsTable,
 		TodosTable,
+		TodoAssignmentsTable,
+		TodoAttachmentsTable,
+		TodoCommentsTable,
+		TodoRevisionsTable,
+		TodoSharesTable,
+		TodoTagsTable,
 		UsersTable,
 	}
 )
 
 func init() {
-	TodosTable.ForeignKeys[0].RefTable = UsersTable
+	MagicLinkTokensTable.ForeignKeys[0].RefTable = UsersTable
+	NotificationsTable.ForeignKeys[0].RefTable = TodosTable
+	NotificationsTable.ForeignKeys[1].RefTable = UsersTable
+	PasswordResetTokensTable.ForeignKeys[0].RefTable = UsersTable
+	ProjectsTable.ForeignKeys[0].RefTable = UsersTable
+	RemindersTable.ForeignKeys[0].RefTable = TodosTable
+	TodosTable.ForeignKeys[0].RefTable = ProjectsTable
+	TodosTable.ForeignKeys[1].RefTable = TodosTable
+	TodosTable.ForeignKeys[2].RefTable = UsersTable
+	TodosTable.ForeignKeys[3].RefTable = UsersTable
+	TodoAssignmentsTable.ForeignKeys[0].RefTable = TodosTable
+	TodoAttachmentsTable.ForeignKeys[0].RefTable = TodosTable
+	TodoCommentsTable.ForeignKeys[0].RefTable = TodosTable
+	TodoCommentsTable.ForeignKeys[1].RefTable = UsersTable
+	TodoSharesTable.ForeignKeys[0].RefTable = TodosTable
+	TodoSharesTable.ForeignKeys[1].RefTable = UsersTable
+	TodoTagsTable.ForeignKeys[0].RefTable = TagsTable
+	TodoTagsTable.ForeignKeys[1].RefTable = TodosTable
 	UsersTable.ForeignKeys[0].RefTable = TenantsTable
 }
@@ -6,11 +6,53 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// AuditEvent is the predicate function for auditevent builders.
+type AuditEvent func(*sql.Selector)
+
+// BlobDeletion is the predicate function for blobdeletion builders.
+type BlobDeletion func(*sql.Selector)
+
+// MagicLinkToken is the predicate function for magiclinktoken builders.
+type MagicLinkToken func(*sql.Selector)
+
+// Notification is the predicate function for notification builders.
+type Notification func(*sql.Selector)
+
+// PasswordResetToken is the predicate function for passwordresettoken builders.
+type PasswordResetToken func(*sql.Selector)
+
+// Project is the predicate function for project builders.
+type Project func(*sql.Selector)
+
+// Reminder is the predicate function for reminder builders.
+type Reminder func(*sql.Selector)
+
+// Tag is the predicate function for tag builders.
+type Tag func(*sql.Selector)
+
 // Tenant is the predicate function for tenant builders.
 type Tenant func(*sql.Selector)
 
 // Todo is the predicate function for todo builders.
 type Todo func(*sql.Selector)
 
+// TodoAssignment is the predicate function for todoassignment builders.
+type TodoAssignment func(*sql.Selector)
+
+// TodoAttachment is the predicate function for todoattachment builders.
+type TodoAttachment func(*sql.Selector)
+
+// TodoComment is the predicate function for todocomment builders.
+type TodoComment func(*sql.Selector)
+
+// TodoRevision is the predicate function for todorevision builders.
+type TodoRevision func(*sql.Selector)
+
+// TodoShare is the predicate function for todoshare builders.
+type TodoShare func(*sql.Selector)
+
+// TodoTag is the predicate function for todotag builders.
+type TodoTag func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
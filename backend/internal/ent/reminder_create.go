@@ -0,0 +1,399 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"good-todo-go/internal/ent/reminder"
+	"good-todo-go/internal/ent/todo"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ReminderCreate is the builder for creating a Reminder entity.
+type ReminderCreate struct {
+	config
+	mutation *ReminderMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *ReminderCreate) SetTenantID(v string) *ReminderCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetTodoID sets the "todo_id" field.
+func (_c *ReminderCreate) SetTodoID(v string) *ReminderCreate {
+	_c.mutation.SetTodoID(v)
+	return _c
+}
+
+// SetRemindAt sets the "remind_at" field.
+func (_c *ReminderCreate) SetRemindAt(v time.Time) *ReminderCreate {
+	_c.mutation.SetRemindAt(v)
+	return _c
+}
+
+// SetOffsetMinutes sets the "offset_minutes" field.
+func (_c *ReminderCreate) SetOffsetMinutes(v int) *ReminderCreate {
+	_c.mutation.SetOffsetMinutes(v)
+	return _c
+}
+
+// SetNillableOffsetMinutes sets the "offset_minutes" field if the given value is not nil.
+func (_c *ReminderCreate) SetNillableOffsetMinutes(v *int) *ReminderCreate {
+	if v != nil {
+		_c.SetOffsetMinutes(*v)
+	}
+	return _c
+}
+
+// SetChannel sets the "channel" field.
+func (_c *ReminderCreate) SetChannel(v string) *ReminderCreate {
+	_c.mutation.SetChannel(v)
+	return _c
+}
+
+// SetAttempts sets the "attempts" field.
+func (_c *ReminderCreate) SetAttempts(v int) *ReminderCreate {
+	_c.mutation.SetAttempts(v)
+	return _c
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_c *ReminderCreate) SetNillableAttempts(v *int) *ReminderCreate {
+	if v != nil {
+		_c.SetAttempts(*v)
+	}
+	return _c
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_c *ReminderCreate) SetNextAttemptAt(v time.Time) *ReminderCreate {
+	_c.mutation.SetNextAttemptAt(v)
+	return _c
+}
+
+// SetSentAt sets the "sent_at" field.
+func (_c *ReminderCreate) SetSentAt(v time.Time) *ReminderCreate {
+	_c.mutation.SetSentAt(v)
+	return _c
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (_c *ReminderCreate) SetNillableSentAt(v *time.Time) *ReminderCreate {
+	if v != nil {
+		_c.SetSentAt(*v)
+	}
+	return _c
+}
+
+// SetFailedAt sets the "failed_at" field.
+func (_c *ReminderCreate) SetFailedAt(v time.Time) *ReminderCreate {
+	_c.mutation.SetFailedAt(v)
+	return _c
+}
+
+// SetNillableFailedAt sets the "failed_at" field if the given value is not nil.
+func (_c *ReminderCreate) SetNillableFailedAt(v *time.Time) *ReminderCreate {
+	if v != nil {
+		_c.SetFailedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ReminderCreate) SetCreatedAt(v time.Time) *ReminderCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ReminderCreate) SetNillableCreatedAt(v *time.Time) *ReminderCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ReminderCreate) SetID(v string) *ReminderCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetTodo sets the "todo" edge to the Todo entity.
+func (_c *ReminderCreate) SetTodo(v *Todo) *ReminderCreate {
+	return _c.SetTodoID(v.ID)
+}
+
+// Mutation returns the ReminderMutation object of the builder.
+func (_c *ReminderCreate) Mutation() *ReminderMutation {
+	return _c.mutation
+}
+
+// Save creates the Reminder in the database.
+func (_c *ReminderCreate) Save(ctx context.Context) (*Reminder, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ReminderCreate) SaveX(ctx context.Context) *Reminder {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReminderCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReminderCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ReminderCreate) defaults() {
+	if _, ok := _c.mutation.Attempts(); !ok {
+		v := reminder.DefaultAttempts
+		_c.mutation.SetAttempts(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := reminder.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ReminderCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "Reminder.tenant_id"`)}
+	}
+	if v, ok := _c.mutation.TenantID(); ok {
+		if err := reminder.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "Reminder.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TodoID(); !ok {
+		return &ValidationError{Name: "todo_id", err: errors.New(`ent: missing required field "Reminder.todo_id"`)}
+	}
+	if v, ok := _c.mutation.TodoID(); ok {
+		if err := reminder.TodoIDValidator(v); err != nil {
+			return &ValidationError{Name: "todo_id", err: fmt.Errorf(`ent: validator failed for field "Reminder.todo_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RemindAt(); !ok {
+		return &ValidationError{Name: "remind_at", err: errors.New(`ent: missing required field "Reminder.remind_at"`)}
+	}
+	if _, ok := _c.mutation.Channel(); !ok {
+		return &ValidationError{Name: "channel", err: errors.New(`ent: missing required field "Reminder.channel"`)}
+	}
+	if v, ok := _c.mutation.Channel(); ok {
+		if err := reminder.ChannelValidator(v); err != nil {
+			return &ValidationError{Name: "channel", err: fmt.Errorf(`ent: validator failed for field "Reminder.channel": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "Reminder.attempts"`)}
+	}
+	if _, ok := _c.mutation.NextAttemptAt(); !ok {
+		return &ValidationError{Name: "next_attempt_at", err: errors.New(`ent: missing required field "Reminder.next_attempt_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Reminder.created_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := reminder.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Reminder.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.TodoIDs()) == 0 {
+		return &ValidationError{Name: "todo", err: errors.New(`ent: missing required edge "Reminder.todo"`)}
+	}
+	return nil
+}
+
+func (_c *ReminderCreate) sqlSave(ctx context.Context) (*Reminder, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Reminder.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ReminderCreate) createSpec() (*Reminder, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Reminder{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(reminder.Table, sqlgraph.NewFieldSpec(reminder.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(reminder.FieldTenantID, field.TypeString, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.RemindAt(); ok {
+		_spec.SetField(reminder.FieldRemindAt, field.TypeTime, value)
+		_node.RemindAt = value
+	}
+	if value, ok := _c.mutation.OffsetMinutes(); ok {
+		_spec.SetField(reminder.FieldOffsetMinutes, field.TypeInt, value)
+		_node.OffsetMinutes = &value
+	}
+	if value, ok := _c.mutation.Channel(); ok {
+		_spec.SetField(reminder.FieldChannel, field.TypeString, value)
+		_node.Channel = value
+	}
+	if value, ok := _c.mutation.Attempts(); ok {
+		_spec.SetField(reminder.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := _c.mutation.NextAttemptAt(); ok {
+		_spec.SetField(reminder.FieldNextAttemptAt, field.TypeTime, value)
+		_node.NextAttemptAt = value
+	}
+	if value, ok := _c.mutation.SentAt(); ok {
+		_spec.SetField(reminder.FieldSentAt, field.TypeTime, value)
+		_node.SentAt = &value
+	}
+	if value, ok := _c.mutation.FailedAt(); ok {
+		_spec.SetField(reminder.FieldFailedAt, field.TypeTime, value)
+		_node.FailedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(reminder.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.TodoIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reminder.TodoTable,
+			Columns: []string{reminder.TodoColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TodoID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ReminderCreateBulk is the builder for creating many Reminder entities in bulk.
+type ReminderCreateBulk struct {
+	config
+	err      error
+	builders []*ReminderCreate
+}
+
+// Save creates the Reminder entities in the database.
+func (_c *ReminderCreateBulk) Save(ctx context.Context) ([]*Reminder, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Reminder, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReminderMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ReminderCreateBulk) SaveX(ctx context.Context) []*Reminder {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReminderCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReminderCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,496 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"good-todo-go/internal/ent/predicate"
+	"good-todo-go/internal/ent/reminder"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ReminderUpdate is the builder for updating Reminder entities.
+type ReminderUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ReminderMutation
+}
+
+// Where appends a list predicates to the ReminderUpdate builder.
+func (_u *ReminderUpdate) Where(ps ...predicate.Reminder) *ReminderUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetRemindAt sets the "remind_at" field.
+func (_u *ReminderUpdate) SetRemindAt(v time.Time) *ReminderUpdate {
+	_u.mutation.SetRemindAt(v)
+	return _u
+}
+
+// SetNillableRemindAt sets the "remind_at" field if the given value is not nil.
+func (_u *ReminderUpdate) SetNillableRemindAt(v *time.Time) *ReminderUpdate {
+	if v != nil {
+		_u.SetRemindAt(*v)
+	}
+	return _u
+}
+
+// SetOffsetMinutes sets the "offset_minutes" field.
+func (_u *ReminderUpdate) SetOffsetMinutes(v int) *ReminderUpdate {
+	_u.mutation.ResetOffsetMinutes()
+	_u.mutation.SetOffsetMinutes(v)
+	return _u
+}
+
+// SetNillableOffsetMinutes sets the "offset_minutes" field if the given value is not nil.
+func (_u *ReminderUpdate) SetNillableOffsetMinutes(v *int) *ReminderUpdate {
+	if v != nil {
+		_u.SetOffsetMinutes(*v)
+	}
+	return _u
+}
+
+// AddOffsetMinutes adds value to the "offset_minutes" field.
+func (_u *ReminderUpdate) AddOffsetMinutes(v int) *ReminderUpdate {
+	_u.mutation.AddOffsetMinutes(v)
+	return _u
+}
+
+// ClearOffsetMinutes clears the value of the "offset_minutes" field.
+func (_u *ReminderUpdate) ClearOffsetMinutes() *ReminderUpdate {
+	_u.mutation.ClearOffsetMinutes()
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *ReminderUpdate) SetAttempts(v int) *ReminderUpdate {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *ReminderUpdate) SetNillableAttempts(v *int) *ReminderUpdate {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *ReminderUpdate) AddAttempts(v int) *ReminderUpdate {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_u *ReminderUpdate) SetNextAttemptAt(v time.Time) *ReminderUpdate {
+	_u.mutation.SetNextAttemptAt(v)
+	return _u
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_u *ReminderUpdate) SetNillableNextAttemptAt(v *time.Time) *ReminderUpdate {
+	if v != nil {
+		_u.SetNextAttemptAt(*v)
+	}
+	return _u
+}
+
+// SetSentAt sets the "sent_at" field.
+func (_u *ReminderUpdate) SetSentAt(v time.Time) *ReminderUpdate {
+	_u.mutation.SetSentAt(v)
+	return _u
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (_u *ReminderUpdate) SetNillableSentAt(v *time.Time) *ReminderUpdate {
+	if v != nil {
+		_u.SetSentAt(*v)
+	}
+	return _u
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (_u *ReminderUpdate) ClearSentAt() *ReminderUpdate {
+	_u.mutation.ClearSentAt()
+	return _u
+}
+
+// SetFailedAt sets the "failed_at" field.
+func (_u *ReminderUpdate) SetFailedAt(v time.Time) *ReminderUpdate {
+	_u.mutation.SetFailedAt(v)
+	return _u
+}
+
+// SetNillableFailedAt sets the "failed_at" field if the given value is not nil.
+func (_u *ReminderUpdate) SetNillableFailedAt(v *time.Time) *ReminderUpdate {
+	if v != nil {
+		_u.SetFailedAt(*v)
+	}
+	return _u
+}
+
+// ClearFailedAt clears the value of the "failed_at" field.
+func (_u *ReminderUpdate) ClearFailedAt() *ReminderUpdate {
+	_u.mutation.ClearFailedAt()
+	return _u
+}
+
+// Mutation returns the ReminderMutation object of the builder.
+func (_u *ReminderUpdate) Mutation() *ReminderMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ReminderUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReminderUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ReminderUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReminderUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ReminderUpdate) check() error {
+	if _u.mutation.TodoCleared() && len(_u.mutation.TodoIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Reminder.todo"`)
+	}
+	return nil
+}
+
+func (_u *ReminderUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(reminder.Table, reminder.Columns, sqlgraph.NewFieldSpec(reminder.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.RemindAt(); ok {
+		_spec.SetField(reminder.FieldRemindAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.OffsetMinutes(); ok {
+		_spec.SetField(reminder.FieldOffsetMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOffsetMinutes(); ok {
+		_spec.AddField(reminder.FieldOffsetMinutes, field.TypeInt, value)
+	}
+	if _u.mutation.OffsetMinutesCleared() {
+		_spec.ClearField(reminder.FieldOffsetMinutes, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(reminder.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(reminder.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NextAttemptAt(); ok {
+		_spec.SetField(reminder.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.SentAt(); ok {
+		_spec.SetField(reminder.FieldSentAt, field.TypeTime, value)
+	}
+	if _u.mutation.SentAtCleared() {
+		_spec.ClearField(reminder.FieldSentAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FailedAt(); ok {
+		_spec.SetField(reminder.FieldFailedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FailedAtCleared() {
+		_spec.ClearField(reminder.FieldFailedAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reminder.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ReminderUpdateOne is the builder for updating a single Reminder entity.
+type ReminderUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ReminderMutation
+}
+
+// SetRemindAt sets the "remind_at" field.
+func (_u *ReminderUpdateOne) SetRemindAt(v time.Time) *ReminderUpdateOne {
+	_u.mutation.SetRemindAt(v)
+	return _u
+}
+
+// SetNillableRemindAt sets the "remind_at" field if the given value is not nil.
+func (_u *ReminderUpdateOne) SetNillableRemindAt(v *time.Time) *ReminderUpdateOne {
+	if v != nil {
+		_u.SetRemindAt(*v)
+	}
+	return _u
+}
+
+// SetOffsetMinutes sets the "offset_minutes" field.
+func (_u *ReminderUpdateOne) SetOffsetMinutes(v int) *ReminderUpdateOne {
+	_u.mutation.ResetOffsetMinutes()
+	_u.mutation.SetOffsetMinutes(v)
+	return _u
+}
+
+// SetNillableOffsetMinutes sets the "offset_minutes" field if the given value is not nil.
+func (_u *ReminderUpdateOne) SetNillableOffsetMinutes(v *int) *ReminderUpdateOne {
+	if v != nil {
+		_u.SetOffsetMinutes(*v)
+	}
+	return _u
+}
+
+// AddOffsetMinutes adds value to the "offset_minutes" field.
+func (_u *ReminderUpdateOne) AddOffsetMinutes(v int) *ReminderUpdateOne {
+	_u.mutation.AddOffsetMinutes(v)
+	return _u
+}
+
+// ClearOffsetMinutes clears the value of the "offset_minutes" field.
+func (_u *ReminderUpdateOne) ClearOffsetMinutes() *ReminderUpdateOne {
+	_u.mutation.ClearOffsetMinutes()
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *ReminderUpdateOne) SetAttempts(v int) *ReminderUpdateOne {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *ReminderUpdateOne) SetNillableAttempts(v *int) *ReminderUpdateOne {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *ReminderUpdateOne) AddAttempts(v int) *ReminderUpdateOne {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_u *ReminderUpdateOne) SetNextAttemptAt(v time.Time) *ReminderUpdateOne {
+	_u.mutation.SetNextAttemptAt(v)
+	return _u
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_u *ReminderUpdateOne) SetNillableNextAttemptAt(v *time.Time) *ReminderUpdateOne {
+	if v != nil {
+		_u.SetNextAttemptAt(*v)
+	}
+	return _u
+}
+
+// SetSentAt sets the "sent_at" field.
+func (_u *ReminderUpdateOne) SetSentAt(v time.Time) *ReminderUpdateOne {
+	_u.mutation.SetSentAt(v)
+	return _u
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (_u *ReminderUpdateOne) SetNillableSentAt(v *time.Time) *ReminderUpdateOne {
+	if v != nil {
+		_u.SetSentAt(*v)
+	}
+	return _u
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (_u *ReminderUpdateOne) ClearSentAt() *ReminderUpdateOne {
+	_u.mutation.ClearSentAt()
+	return _u
+}
+
+// SetFailedAt sets the "failed_at" field.
+func (_u *ReminderUpdateOne) SetFailedAt(v time.Time) *ReminderUpdateOne {
+	_u.mutation.SetFailedAt(v)
+	return _u
+}
+
+// SetNillableFailedAt sets the "failed_at" field if the given value is not nil.
+func (_u *ReminderUpdateOne) SetNillableFailedAt(v *time.Time) *ReminderUpdateOne {
+	if v != nil {
+		_u.SetFailedAt(*v)
+	}
+	return _u
+}
+
+// ClearFailedAt clears the value of the "failed_at" field.
+func (_u *ReminderUpdateOne) ClearFailedAt() *ReminderUpdateOne {
+	_u.mutation.ClearFailedAt()
+	return _u
+}
+
+// Mutation returns the ReminderMutation object of the builder.
+func (_u *ReminderUpdateOne) Mutation() *ReminderMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ReminderUpdate builder.
+func (_u *ReminderUpdateOne) Where(ps ...predicate.Reminder) *ReminderUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ReminderUpdateOne) Select(field string, fields ...string) *ReminderUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Reminder entity.
+func (_u *ReminderUpdateOne) Save(ctx context.Context) (*Reminder, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReminderUpdateOne) SaveX(ctx context.Context) *Reminder {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ReminderUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReminderUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ReminderUpdateOne) check() error {
+	if _u.mutation.TodoCleared() && len(_u.mutation.TodoIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Reminder.todo"`)
+	}
+	return nil
+}
+
+func (_u *ReminderUpdateOne) sqlSave(ctx context.Context) (_node *Reminder, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(reminder.Table, reminder.Columns, sqlgraph.NewFieldSpec(reminder.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Reminder.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, reminder.FieldID)
+		for _, f := range fields {
+			if !reminder.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != reminder.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.RemindAt(); ok {
+		_spec.SetField(reminder.FieldRemindAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.OffsetMinutes(); ok {
+		_spec.SetField(reminder.FieldOffsetMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOffsetMinutes(); ok {
+		_spec.AddField(reminder.FieldOffsetMinutes, field.TypeInt, value)
+	}
+	if _u.mutation.OffsetMinutesCleared() {
+		_spec.ClearField(reminder.FieldOffsetMinutes, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(reminder.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(reminder.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NextAttemptAt(); ok {
+		_spec.SetField(reminder.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.SentAt(); ok {
+		_spec.SetField(reminder.FieldSentAt, field.TypeTime, value)
+	}
+	if _u.mutation.SentAtCleared() {
+		_spec.ClearField(reminder.FieldSentAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FailedAt(); ok {
+		_spec.SetField(reminder.FieldFailedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FailedAtCleared() {
+		_spec.ClearField(reminder.FieldFailedAt, field.TypeTime)
+	}
+	_node = &Reminder{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reminder.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
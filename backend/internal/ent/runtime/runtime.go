@@ -2,9 +2,525 @@
 
 package runtime
 
-// The schema-stitching logic is generated in good-todo-go/internal/ent/runtime.go
+import (
+	"good-todo-go/internal/ent/auditevent"
+	"good-todo-go/internal/ent/blobdeletion"
+	"good-todo-go/internal/ent/magiclinktoken"
+	"good-todo-go/internal/ent/notification"
+	"good-todo-go/internal/ent/passwordresettoken"
+	"good-todo-go/internal/ent/project"
+	"good-todo-go/internal/ent/reminder"
+	"good-todo-go/internal/ent/schema"
+	"good-todo-go/internal/ent/tag"
+	"good-todo-go/internal/ent/tenant"
+	"good-todo-go/internal/ent/todo"
+	"good-todo-go/internal/ent/todoassignment"
+	"good-todo-go/internal/ent/todoattachment"
+	"good-todo-go/internal/ent/todocomment"
+	"good-todo-go/internal/ent/todorevision"
+	"good-todo-go/internal/ent/todoshare"
+	"good-todo-go/internal/ent/todotag"
+	"good-todo-go/internal/ent/user"
+	"time"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	auditeventFields := schema.AuditEvent{}.Fields()
+	_ = auditeventFields
+	// auditeventDescTenantID is the schema descriptor for tenant_id field.
+	auditeventDescTenantID := auditeventFields[1].Descriptor()
+	// auditevent.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	auditevent.TenantIDValidator = auditeventDescTenantID.Validators[0].(func(string) error)
+	// auditeventDescAction is the schema descriptor for action field.
+	auditeventDescAction := auditeventFields[4].Descriptor()
+	// auditevent.ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	auditevent.ActionValidator = auditeventDescAction.Validators[0].(func(string) error)
+	// auditeventDescIP is the schema descriptor for ip field.
+	auditeventDescIP := auditeventFields[7].Descriptor()
+	// auditevent.DefaultIP holds the default value on creation for the ip field.
+	auditevent.DefaultIP = auditeventDescIP.Default.(string)
+	// auditeventDescUserAgent is the schema descriptor for user_agent field.
+	auditeventDescUserAgent := auditeventFields[8].Descriptor()
+	// auditevent.DefaultUserAgent holds the default value on creation for the user_agent field.
+	auditevent.DefaultUserAgent = auditeventDescUserAgent.Default.(string)
+	// auditeventDescRequestID is the schema descriptor for request_id field.
+	auditeventDescRequestID := auditeventFields[9].Descriptor()
+	// auditevent.DefaultRequestID holds the default value on creation for the request_id field.
+	auditevent.DefaultRequestID = auditeventDescRequestID.Default.(string)
+	// auditeventDescCreatedAt is the schema descriptor for created_at field.
+	auditeventDescCreatedAt := auditeventFields[11].Descriptor()
+	// auditevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditevent.DefaultCreatedAt = auditeventDescCreatedAt.Default.(func() time.Time)
+	// auditeventDescID is the schema descriptor for id field.
+	auditeventDescID := auditeventFields[0].Descriptor()
+	// auditevent.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	auditevent.IDValidator = auditeventDescID.Validators[0].(func(string) error)
+	blobdeletionFields := schema.BlobDeletion{}.Fields()
+	_ = blobdeletionFields
+	// blobdeletionDescTenantID is the schema descriptor for tenant_id field.
+	blobdeletionDescTenantID := blobdeletionFields[1].Descriptor()
+	// blobdeletion.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	blobdeletion.TenantIDValidator = blobdeletionDescTenantID.Validators[0].(func(string) error)
+	// blobdeletionDescCreatedAt is the schema descriptor for created_at field.
+	blobdeletionDescCreatedAt := blobdeletionFields[2].Descriptor()
+	// blobdeletion.DefaultCreatedAt holds the default value on creation for the created_at field.
+	blobdeletion.DefaultCreatedAt = blobdeletionDescCreatedAt.Default.(func() time.Time)
+	// blobdeletionDescID is the schema descriptor for id field.
+	blobdeletionDescID := blobdeletionFields[0].Descriptor()
+	// blobdeletion.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	blobdeletion.IDValidator = blobdeletionDescID.Validators[0].(func(string) error)
+	magiclinktokenFields := schema.MagicLinkToken{}.Fields()
+	_ = magiclinktokenFields
+	// magiclinktokenDescTenantID is the schema descriptor for tenant_id field.
+	magiclinktokenDescTenantID := magiclinktokenFields[1].Descriptor()
+	// magiclinktoken.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	magiclinktoken.TenantIDValidator = magiclinktokenDescTenantID.Validators[0].(func(string) error)
+	// magiclinktokenDescUserID is the schema descriptor for user_id field.
+	magiclinktokenDescUserID := magiclinktokenFields[2].Descriptor()
+	// magiclinktoken.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	magiclinktoken.UserIDValidator = magiclinktokenDescUserID.Validators[0].(func(string) error)
+	// magiclinktokenDescTokenHash is the schema descriptor for token_hash field.
+	magiclinktokenDescTokenHash := magiclinktokenFields[3].Descriptor()
+	// magiclinktoken.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	magiclinktoken.TokenHashValidator = magiclinktokenDescTokenHash.Validators[0].(func(string) error)
+	// magiclinktokenDescCreatedAt is the schema descriptor for created_at field.
+	magiclinktokenDescCreatedAt := magiclinktokenFields[6].Descriptor()
+	// magiclinktoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	magiclinktoken.DefaultCreatedAt = magiclinktokenDescCreatedAt.Default.(func() time.Time)
+	// magiclinktokenDescID is the schema descriptor for id field.
+	magiclinktokenDescID := magiclinktokenFields[0].Descriptor()
+	// magiclinktoken.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	magiclinktoken.IDValidator = magiclinktokenDescID.Validators[0].(func(string) error)
+	notificationFields := schema.Notification{}.Fields()
+	_ = notificationFields
+	// notificationDescTenantID is the schema descriptor for tenant_id field.
+	notificationDescTenantID := notificationFields[1].Descriptor()
+	// notification.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	notification.TenantIDValidator = notificationDescTenantID.Validators[0].(func(string) error)
+	// notificationDescUserID is the schema descriptor for user_id field.
+	notificationDescUserID := notificationFields[2].Descriptor()
+	// notification.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	notification.UserIDValidator = notificationDescUserID.Validators[0].(func(string) error)
+	// notificationDescTitle is the schema descriptor for title field.
+	notificationDescTitle := notificationFields[5].Descriptor()
+	// notification.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	notification.TitleValidator = notificationDescTitle.Validators[0].(func(string) error)
+	// notificationDescBody is the schema descriptor for body field.
+	notificationDescBody := notificationFields[6].Descriptor()
+	// notification.DefaultBody holds the default value on creation for the body field.
+	notification.DefaultBody = notificationDescBody.Default.(string)
+	// notificationDescCreatedAt is the schema descriptor for created_at field.
+	notificationDescCreatedAt := notificationFields[8].Descriptor()
+	// notification.DefaultCreatedAt holds the default value on creation for the created_at field.
+	notification.DefaultCreatedAt = notificationDescCreatedAt.Default.(func() time.Time)
+	// notificationDescID is the schema descriptor for id field.
+	notificationDescID := notificationFields[0].Descriptor()
+	// notification.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	notification.IDValidator = notificationDescID.Validators[0].(func(string) error)
+	passwordresettokenFields := schema.PasswordResetToken{}.Fields()
+	_ = passwordresettokenFields
+	// passwordresettokenDescTenantID is the schema descriptor for tenant_id field.
+	passwordresettokenDescTenantID := passwordresettokenFields[1].Descriptor()
+	// passwordresettoken.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	passwordresettoken.TenantIDValidator = passwordresettokenDescTenantID.Validators[0].(func(string) error)
+	// passwordresettokenDescUserID is the schema descriptor for user_id field.
+	passwordresettokenDescUserID := passwordresettokenFields[2].Descriptor()
+	// passwordresettoken.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	passwordresettoken.UserIDValidator = passwordresettokenDescUserID.Validators[0].(func(string) error)
+	// passwordresettokenDescTokenHash is the schema descriptor for token_hash field.
+	passwordresettokenDescTokenHash := passwordresettokenFields[3].Descriptor()
+	// passwordresettoken.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	passwordresettoken.TokenHashValidator = passwordresettokenDescTokenHash.Validators[0].(func(string) error)
+	// passwordresettokenDescCreatedAt is the schema descriptor for created_at field.
+	passwordresettokenDescCreatedAt := passwordresettokenFields[6].Descriptor()
+	// passwordresettoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	passwordresettoken.DefaultCreatedAt = passwordresettokenDescCreatedAt.Default.(func() time.Time)
+	// passwordresettokenDescID is the schema descriptor for id field.
+	passwordresettokenDescID := passwordresettokenFields[0].Descriptor()
+	// passwordresettoken.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	passwordresettoken.IDValidator = passwordresettokenDescID.Validators[0].(func(string) error)
+	projectFields := schema.Project{}.Fields()
+	_ = projectFields
+	// projectDescTenantID is the schema descriptor for tenant_id field.
+	projectDescTenantID := projectFields[1].Descriptor()
+	// project.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	project.TenantIDValidator = projectDescTenantID.Validators[0].(func(string) error)
+	// projectDescUserID is the schema descriptor for user_id field.
+	projectDescUserID := projectFields[2].Descriptor()
+	// project.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	project.UserIDValidator = projectDescUserID.Validators[0].(func(string) error)
+	// projectDescName is the schema descriptor for name field.
+	projectDescName := projectFields[3].Descriptor()
+	// project.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	project.NameValidator = projectDescName.Validators[0].(func(string) error)
+	// projectDescDescription is the schema descriptor for description field.
+	projectDescDescription := projectFields[4].Descriptor()
+	// project.DefaultDescription holds the default value on creation for the description field.
+	project.DefaultDescription = projectDescDescription.Default.(string)
+	// projectDescIsPublic is the schema descriptor for is_public field.
+	projectDescIsPublic := projectFields[5].Descriptor()
+	// project.DefaultIsPublic holds the default value on creation for the is_public field.
+	project.DefaultIsPublic = projectDescIsPublic.Default.(bool)
+	// projectDescCreatedAt is the schema descriptor for created_at field.
+	projectDescCreatedAt := projectFields[7].Descriptor()
+	// project.DefaultCreatedAt holds the default value on creation for the created_at field.
+	project.DefaultCreatedAt = projectDescCreatedAt.Default.(func() time.Time)
+	// projectDescUpdatedAt is the schema descriptor for updated_at field.
+	projectDescUpdatedAt := projectFields[8].Descriptor()
+	// project.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	project.DefaultUpdatedAt = projectDescUpdatedAt.Default.(func() time.Time)
+	// project.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	project.UpdateDefaultUpdatedAt = projectDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// projectDescID is the schema descriptor for id field.
+	projectDescID := projectFields[0].Descriptor()
+	// project.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	project.IDValidator = projectDescID.Validators[0].(func(string) error)
+	reminderFields := schema.Reminder{}.Fields()
+	_ = reminderFields
+	// reminderDescTenantID is the schema descriptor for tenant_id field.
+	reminderDescTenantID := reminderFields[1].Descriptor()
+	// reminder.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	reminder.TenantIDValidator = reminderDescTenantID.Validators[0].(func(string) error)
+	// reminderDescTodoID is the schema descriptor for todo_id field.
+	reminderDescTodoID := reminderFields[2].Descriptor()
+	// reminder.TodoIDValidator is a validator for the "todo_id" field. It is called by the builders before save.
+	reminder.TodoIDValidator = reminderDescTodoID.Validators[0].(func(string) error)
+	// reminderDescChannel is the schema descriptor for channel field.
+	reminderDescChannel := reminderFields[5].Descriptor()
+	// reminder.ChannelValidator is a validator for the "channel" field. It is called by the builders before save.
+	reminder.ChannelValidator = reminderDescChannel.Validators[0].(func(string) error)
+	// reminderDescAttempts is the schema descriptor for attempts field.
+	reminderDescAttempts := reminderFields[6].Descriptor()
+	// reminder.DefaultAttempts holds the default value on creation for the attempts field.
+	reminder.DefaultAttempts = reminderDescAttempts.Default.(int)
+	// reminderDescCreatedAt is the schema descriptor for created_at field.
+	reminderDescCreatedAt := reminderFields[10].Descriptor()
+	// reminder.DefaultCreatedAt holds the default value on creation for the created_at field.
+	reminder.DefaultCreatedAt = reminderDescCreatedAt.Default.(func() time.Time)
+	// reminderDescID is the schema descriptor for id field.
+	reminderDescID := reminderFields[0].Descriptor()
+	// reminder.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	reminder.IDValidator = reminderDescID.Validators[0].(func(string) error)
+	tagFields := schema.Tag{}.Fields()
+	_ = tagFields
+	// tagDescTenantID is the schema descriptor for tenant_id field.
+	tagDescTenantID := tagFields[1].Descriptor()
+	// tag.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	tag.TenantIDValidator = tagDescTenantID.Validators[0].(func(string) error)
+	// tagDescName is the schema descriptor for name field.
+	tagDescName := tagFields[2].Descriptor()
+	// tag.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	tag.NameValidator = func() func(string) error {
+		validators := tagDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// tagDescColor is the schema descriptor for color field.
+	tagDescColor := tagFields[3].Descriptor()
+	// tag.DefaultColor holds the default value on creation for the color field.
+	tag.DefaultColor = tagDescColor.Default.(string)
+	// tag.ColorValidator is a validator for the "color" field. It is called by the builders before save.
+	tag.ColorValidator = tagDescColor.Validators[0].(func(string) error)
+	// tagDescCreatedAt is the schema descriptor for created_at field.
+	tagDescCreatedAt := tagFields[4].Descriptor()
+	// tag.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tag.DefaultCreatedAt = tagDescCreatedAt.Default.(func() time.Time)
+	// tagDescUpdatedAt is the schema descriptor for updated_at field.
+	tagDescUpdatedAt := tagFields[5].Descriptor()
+	// tag.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	tag.DefaultUpdatedAt = tagDescUpdatedAt.Default.(func() time.Time)
+	// tag.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	tag.UpdateDefaultUpdatedAt = tagDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// tagDescID is the schema descriptor for id field.
+	tagDescID := tagFields[0].Descriptor()
+	// tag.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	tag.IDValidator = tagDescID.Validators[0].(func(string) error)
+	tenantFields := schema.Tenant{}.Fields()
+	_ = tenantFields
+	// tenantDescName is the schema descriptor for name field.
+	tenantDescName := tenantFields[1].Descriptor()
+	// tenant.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	tenant.NameValidator = tenantDescName.Validators[0].(func(string) error)
+	// tenantDescSlug is the schema descriptor for slug field.
+	tenantDescSlug := tenantFields[2].Descriptor()
+	// tenant.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	tenant.SlugValidator = tenantDescSlug.Validators[0].(func(string) error)
+	// tenantDescMagicLinkEnabled is the schema descriptor for magic_link_enabled field.
+	tenantDescMagicLinkEnabled := tenantFields[3].Descriptor()
+	// tenant.DefaultMagicLinkEnabled holds the default value on creation for the magic_link_enabled field.
+	tenant.DefaultMagicLinkEnabled = tenantDescMagicLinkEnabled.Default.(bool)
+	// tenantDescTrashRetentionDays is the schema descriptor for trash_retention_days field.
+	tenantDescTrashRetentionDays := tenantFields[6].Descriptor()
+	// tenant.DefaultTrashRetentionDays holds the default value on creation for the trash_retention_days field.
+	tenant.DefaultTrashRetentionDays = tenantDescTrashRetentionDays.Default.(int)
+	// tenant.TrashRetentionDaysValidator is a validator for the "trash_retention_days" field. It is called by the builders before save.
+	tenant.TrashRetentionDaysValidator = tenantDescTrashRetentionDays.Validators[0].(func(int) error)
+	// tenantDescCreatedAt is the schema descriptor for created_at field.
+	tenantDescCreatedAt := tenantFields[8].Descriptor()
+	// tenant.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tenant.DefaultCreatedAt = tenantDescCreatedAt.Default.(func() time.Time)
+	// tenantDescUpdatedAt is the schema descriptor for updated_at field.
+	tenantDescUpdatedAt := tenantFields[9].Descriptor()
+	// tenant.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	tenant.DefaultUpdatedAt = tenantDescUpdatedAt.Default.(func() time.Time)
+	// tenant.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	tenant.UpdateDefaultUpdatedAt = tenantDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// tenantDescID is the schema descriptor for id field.
+	tenantDescID := tenantFields[0].Descriptor()
+	// tenant.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	tenant.IDValidator = tenantDescID.Validators[0].(func(string) error)
+	todoHooks := schema.Todo{}.Hooks()
+	todo.Hooks[0] = todoHooks[0]
+	todo.Hooks[1] = todoHooks[1]
+	todoInters := schema.Todo{}.Interceptors()
+	todo.Interceptors[0] = todoInters[0]
+	todoFields := schema.Todo{}.Fields()
+	_ = todoFields
+	// todoDescTenantID is the schema descriptor for tenant_id field.
+	todoDescTenantID := todoFields[1].Descriptor()
+	// todo.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	todo.TenantIDValidator = todoDescTenantID.Validators[0].(func(string) error)
+	// todoDescUserID is the schema descriptor for user_id field.
+	todoDescUserID := todoFields[2].Descriptor()
+	// todo.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	todo.UserIDValidator = todoDescUserID.Validators[0].(func(string) error)
+	// todoDescTitle is the schema descriptor for title field.
+	todoDescTitle := todoFields[6].Descriptor()
+	// todo.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	todo.TitleValidator = todoDescTitle.Validators[0].(func(string) error)
+	// todoDescDescription is the schema descriptor for description field.
+	todoDescDescription := todoFields[7].Descriptor()
+	// todo.DefaultDescription holds the default value on creation for the description field.
+	todo.DefaultDescription = todoDescDescription.Default.(string)
+	// todoDescCompleted is the schema descriptor for completed field.
+	todoDescCompleted := todoFields[8].Descriptor()
+	// todo.DefaultCompleted holds the default value on creation for the completed field.
+	todo.DefaultCompleted = todoDescCompleted.Default.(bool)
+	// todoDescIsPublic is the schema descriptor for is_public field.
+	todoDescIsPublic := todoFields[9].Descriptor()
+	// todo.DefaultIsPublic holds the default value on creation for the is_public field.
+	todo.DefaultIsPublic = todoDescIsPublic.Default.(bool)
+	// todoDescPriority is the schema descriptor for priority field.
+	todoDescPriority := todoFields[10].Descriptor()
+	// todo.DefaultPriority holds the default value on creation for the priority field.
+	todo.DefaultPriority = todoDescPriority.Default.(int)
+	// todo.PriorityValidator is a validator for the "priority" field. It is called by the builders before save.
+	todo.PriorityValidator = todoDescPriority.Validators[0].(func(int) error)
+	// todoDescPosition is the schema descriptor for position field.
+	todoDescPosition := todoFields[11].Descriptor()
+	// todo.PositionValidator is a validator for the "position" field. It is called by the builders before save.
+	todo.PositionValidator = todoDescPosition.Validators[0].(func(string) error)
+	// todoDescVersion is the schema descriptor for version field.
+	todoDescVersion := todoFields[18].Descriptor()
+	// todo.DefaultVersion holds the default value on creation for the version field.
+	todo.DefaultVersion = todoDescVersion.Default.(int)
+	// todoDescCreatedAt is the schema descriptor for created_at field.
+	todoDescCreatedAt := todoFields[19].Descriptor()
+	// todo.DefaultCreatedAt holds the default value on creation for the created_at field.
+	todo.DefaultCreatedAt = todoDescCreatedAt.Default.(func() time.Time)
+	// todoDescUpdatedAt is the schema descriptor for updated_at field.
+	todoDescUpdatedAt := todoFields[20].Descriptor()
+	// todo.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	todo.DefaultUpdatedAt = todoDescUpdatedAt.Default.(func() time.Time)
+	// todo.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	todo.UpdateDefaultUpdatedAt = todoDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// todoDescID is the schema descriptor for id field.
+	todoDescID := todoFields[0].Descriptor()
+	// todo.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	todo.IDValidator = todoDescID.Validators[0].(func(string) error)
+	todoassignmentFields := schema.TodoAssignment{}.Fields()
+	_ = todoassignmentFields
+	// todoassignmentDescTenantID is the schema descriptor for tenant_id field.
+	todoassignmentDescTenantID := todoassignmentFields[1].Descriptor()
+	// todoassignment.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	todoassignment.TenantIDValidator = todoassignmentDescTenantID.Validators[0].(func(string) error)
+	// todoassignmentDescTodoID is the schema descriptor for todo_id field.
+	todoassignmentDescTodoID := todoassignmentFields[2].Descriptor()
+	// todoassignment.TodoIDValidator is a validator for the "todo_id" field. It is called by the builders before save.
+	todoassignment.TodoIDValidator = todoassignmentDescTodoID.Validators[0].(func(string) error)
+	// todoassignmentDescChangedBy is the schema descriptor for changed_by field.
+	todoassignmentDescChangedBy := todoassignmentFields[5].Descriptor()
+	// todoassignment.ChangedByValidator is a validator for the "changed_by" field. It is called by the builders before save.
+	todoassignment.ChangedByValidator = todoassignmentDescChangedBy.Validators[0].(func(string) error)
+	// todoassignmentDescCreatedAt is the schema descriptor for created_at field.
+	todoassignmentDescCreatedAt := todoassignmentFields[6].Descriptor()
+	// todoassignment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	todoassignment.DefaultCreatedAt = todoassignmentDescCreatedAt.Default.(func() time.Time)
+	// todoassignmentDescID is the schema descriptor for id field.
+	todoassignmentDescID := todoassignmentFields[0].Descriptor()
+	// todoassignment.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	todoassignment.IDValidator = todoassignmentDescID.Validators[0].(func(string) error)
+	todoattachmentFields := schema.TodoAttachment{}.Fields()
+	_ = todoattachmentFields
+	// todoattachmentDescTenantID is the schema descriptor for tenant_id field.
+	todoattachmentDescTenantID := todoattachmentFields[1].Descriptor()
+	// todoattachment.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	todoattachment.TenantIDValidator = todoattachmentDescTenantID.Validators[0].(func(string) error)
+	// todoattachmentDescTodoID is the schema descriptor for todo_id field.
+	todoattachmentDescTodoID := todoattachmentFields[2].Descriptor()
+	// todoattachment.TodoIDValidator is a validator for the "todo_id" field. It is called by the builders before save.
+	todoattachment.TodoIDValidator = todoattachmentDescTodoID.Validators[0].(func(string) error)
+	// todoattachmentDescUploadedBy is the schema descriptor for uploaded_by field.
+	todoattachmentDescUploadedBy := todoattachmentFields[3].Descriptor()
+	// todoattachment.UploadedByValidator is a validator for the "uploaded_by" field. It is called by the builders before save.
+	todoattachment.UploadedByValidator = todoattachmentDescUploadedBy.Validators[0].(func(string) error)
+	// todoattachmentDescFileName is the schema descriptor for file_name field.
+	todoattachmentDescFileName := todoattachmentFields[4].Descriptor()
+	// todoattachment.FileNameValidator is a validator for the "file_name" field. It is called by the builders before save.
+	todoattachment.FileNameValidator = todoattachmentDescFileName.Validators[0].(func(string) error)
+	// todoattachmentDescContentType is the schema descriptor for content_type field.
+	todoattachmentDescContentType := todoattachmentFields[5].Descriptor()
+	// todoattachment.ContentTypeValidator is a validator for the "content_type" field. It is called by the builders before save.
+	todoattachment.ContentTypeValidator = todoattachmentDescContentType.Validators[0].(func(string) error)
+	// todoattachmentDescStorageKey is the schema descriptor for storage_key field.
+	todoattachmentDescStorageKey := todoattachmentFields[7].Descriptor()
+	// todoattachment.StorageKeyValidator is a validator for the "storage_key" field. It is called by the builders before save.
+	todoattachment.StorageKeyValidator = todoattachmentDescStorageKey.Validators[0].(func(string) error)
+	// todoattachmentDescCreatedAt is the schema descriptor for created_at field.
+	todoattachmentDescCreatedAt := todoattachmentFields[8].Descriptor()
+	// todoattachment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	todoattachment.DefaultCreatedAt = todoattachmentDescCreatedAt.Default.(func() time.Time)
+	// todoattachmentDescID is the schema descriptor for id field.
+	todoattachmentDescID := todoattachmentFields[0].Descriptor()
+	// todoattachment.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	todoattachment.IDValidator = todoattachmentDescID.Validators[0].(func(string) error)
+	todocommentFields := schema.TodoComment{}.Fields()
+	_ = todocommentFields
+	// todocommentDescTenantID is the schema descriptor for tenant_id field.
+	todocommentDescTenantID := todocommentFields[1].Descriptor()
+	// todocomment.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	todocomment.TenantIDValidator = todocommentDescTenantID.Validators[0].(func(string) error)
+	// todocommentDescTodoID is the schema descriptor for todo_id field.
+	todocommentDescTodoID := todocommentFields[2].Descriptor()
+	// todocomment.TodoIDValidator is a validator for the "todo_id" field. It is called by the builders before save.
+	todocomment.TodoIDValidator = todocommentDescTodoID.Validators[0].(func(string) error)
+	// todocommentDescCreatedAt is the schema descriptor for created_at field.
+	todocommentDescCreatedAt := todocommentFields[7].Descriptor()
+	// todocomment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	todocomment.DefaultCreatedAt = todocommentDescCreatedAt.Default.(func() time.Time)
+	// todocommentDescID is the schema descriptor for id field.
+	todocommentDescID := todocommentFields[0].Descriptor()
+	// todocomment.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	todocomment.IDValidator = todocommentDescID.Validators[0].(func(string) error)
+	todorevisionFields := schema.TodoRevision{}.Fields()
+	_ = todorevisionFields
+	// todorevisionDescTenantID is the schema descriptor for tenant_id field.
+	todorevisionDescTenantID := todorevisionFields[1].Descriptor()
+	// todorevision.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	todorevision.TenantIDValidator = todorevisionDescTenantID.Validators[0].(func(string) error)
+	// todorevisionDescTodoID is the schema descriptor for todo_id field.
+	todorevisionDescTodoID := todorevisionFields[2].Descriptor()
+	// todorevision.TodoIDValidator is a validator for the "todo_id" field. It is called by the builders before save.
+	todorevision.TodoIDValidator = todorevisionDescTodoID.Validators[0].(func(string) error)
+	// todorevisionDescCreatedAt is the schema descriptor for created_at field.
+	todorevisionDescCreatedAt := todorevisionFields[7].Descriptor()
+	// todorevision.DefaultCreatedAt holds the default value on creation for the created_at field.
+	todorevision.DefaultCreatedAt = todorevisionDescCreatedAt.Default.(func() time.Time)
+	// todorevisionDescID is the schema descriptor for id field.
+	todorevisionDescID := todorevisionFields[0].Descriptor()
+	// todorevision.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	todorevision.IDValidator = todorevisionDescID.Validators[0].(func(string) error)
+	todoshareFields := schema.TodoShare{}.Fields()
+	_ = todoshareFields
+	// todoshareDescTenantID is the schema descriptor for tenant_id field.
+	todoshareDescTenantID := todoshareFields[1].Descriptor()
+	// todoshare.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	todoshare.TenantIDValidator = todoshareDescTenantID.Validators[0].(func(string) error)
+	// todoshareDescTodoID is the schema descriptor for todo_id field.
+	todoshareDescTodoID := todoshareFields[2].Descriptor()
+	// todoshare.TodoIDValidator is a validator for the "todo_id" field. It is called by the builders before save.
+	todoshare.TodoIDValidator = todoshareDescTodoID.Validators[0].(func(string) error)
+	// todoshareDescUserID is the schema descriptor for user_id field.
+	todoshareDescUserID := todoshareFields[3].Descriptor()
+	// todoshare.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	todoshare.UserIDValidator = todoshareDescUserID.Validators[0].(func(string) error)
+	// todoshareDescCreatedBy is the schema descriptor for created_by field.
+	todoshareDescCreatedBy := todoshareFields[5].Descriptor()
+	// todoshare.CreatedByValidator is a validator for the "created_by" field. It is called by the builders before save.
+	todoshare.CreatedByValidator = todoshareDescCreatedBy.Validators[0].(func(string) error)
+	// todoshareDescCreatedAt is the schema descriptor for created_at field.
+	todoshareDescCreatedAt := todoshareFields[6].Descriptor()
+	// todoshare.DefaultCreatedAt holds the default value on creation for the created_at field.
+	todoshare.DefaultCreatedAt = todoshareDescCreatedAt.Default.(func() time.Time)
+	// todoshareDescUpdatedAt is the schema descriptor for updated_at field.
+	todoshareDescUpdatedAt := todoshareFields[7].Descriptor()
+	// todoshare.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	todoshare.DefaultUpdatedAt = todoshareDescUpdatedAt.Default.(func() time.Time)
+	// todoshare.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	todoshare.UpdateDefaultUpdatedAt = todoshareDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// todoshareDescID is the schema descriptor for id field.
+	todoshareDescID := todoshareFields[0].Descriptor()
+	// todoshare.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	todoshare.IDValidator = todoshareDescID.Validators[0].(func(string) error)
+	todotagFields := schema.TodoTag{}.Fields()
+	_ = todotagFields
+	// todotagDescTenantID is the schema descriptor for tenant_id field.
+	todotagDescTenantID := todotagFields[2].Descriptor()
+	// todotag.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	todotag.TenantIDValidator = todotagDescTenantID.Validators[0].(func(string) error)
+	// todotagDescCreatedAt is the schema descriptor for created_at field.
+	todotagDescCreatedAt := todotagFields[3].Descriptor()
+	// todotag.DefaultCreatedAt holds the default value on creation for the created_at field.
+	todotag.DefaultCreatedAt = todotagDescCreatedAt.Default.(func() time.Time)
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescTenantID is the schema descriptor for tenant_id field.
+	userDescTenantID := userFields[1].Descriptor()
+	// user.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	user.TenantIDValidator = userDescTenantID.Validators[0].(func(string) error)
+	// userDescEmail is the schema descriptor for email field.
+	userDescEmail := userFields[2].Descriptor()
+	// user.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	user.EmailValidator = userDescEmail.Validators[0].(func(string) error)
+	// userDescPasswordHash is the schema descriptor for password_hash field.
+	userDescPasswordHash := userFields[3].Descriptor()
+	// user.PasswordHashValidator is a validator for the "password_hash" field. It is called by the builders before save.
+	user.PasswordHashValidator = userDescPasswordHash.Validators[0].(func(string) error)
+	// userDescName is the schema descriptor for name field.
+	userDescName := userFields[4].Descriptor()
+	// user.DefaultName holds the default value on creation for the name field.
+	user.DefaultName = userDescName.Default.(string)
+	// userDescEmailVerified is the schema descriptor for email_verified field.
+	userDescEmailVerified := userFields[6].Descriptor()
+	// user.DefaultEmailVerified holds the default value on creation for the email_verified field.
+	user.DefaultEmailVerified = userDescEmailVerified.Default.(bool)
+	// userDescIsActive is the schema descriptor for is_active field.
+	userDescIsActive := userFields[7].Descriptor()
+	// user.DefaultIsActive holds the default value on creation for the is_active field.
+	user.DefaultIsActive = userDescIsActive.Default.(bool)
+	// userDescIsSystem is the schema descriptor for is_system field.
+	userDescIsSystem := userFields[8].Descriptor()
+	// user.DefaultIsSystem holds the default value on creation for the is_system field.
+	user.DefaultIsSystem = userDescIsSystem.Default.(bool)
+	// userDescCreatedAt is the schema descriptor for created_at field.
+	userDescCreatedAt := userFields[13].Descriptor()
+	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
+	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
+	// userDescUpdatedAt is the schema descriptor for updated_at field.
+	userDescUpdatedAt := userFields[14].Descriptor()
+	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
+	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	user.UpdateDefaultUpdatedAt = userDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// userDescID is the schema descriptor for id field.
+	userDescID := userFields[0].Descriptor()
+	// user.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	user.IDValidator = userDescID.Validators[0].(func(string) error)
+}
 
 const (
-	Version = "v0.14.5"                                         // Version of ent codegen.
-	Sum     = "h1:Rj2WOYJtCkWyFo6a+5wB3EfBRP0rnx1fMk6gGA0UUe4=" // Sum of ent codegen.
+	Version = "v0.14.5" // Version of ent codegen.
 )
@@ -0,0 +1,74 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AuditEvent holds the schema definition for the AuditEvent entity.
+// Events are append-only: every field is immutable and the database only
+// grants SELECT and INSERT on the table.
+type AuditEvent struct {
+	ent.Schema
+}
+
+// Fields of the AuditEvent.
+func (AuditEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Immutable(),
+		field.String("tenant_id").
+			NotEmpty().
+			Immutable(),
+		field.Enum("actor_type").
+			Values("user", "scim", "anonymous").
+			Immutable().
+			Comment("Who performed the action: a signed-in user, the tenant's identity provider, or an unauthenticated caller"),
+		field.String("actor_id").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Not a foreign key, so events outlive deleted users"),
+		field.String("action").
+			NotEmpty().
+			Immutable(),
+		field.String("target_type").
+			Optional().
+			Nillable().
+			Immutable(),
+		field.String("target_id").
+			Optional().
+			Nillable().
+			Immutable(),
+		field.String("ip").
+			Default("").
+			Immutable(),
+		field.String("user_agent").
+			Default("").
+			Immutable(),
+		field.String("request_id").
+			Default("").
+			Immutable(),
+		field.JSON("metadata", map[string]string{}).
+			Optional().
+			Immutable(),
+		field.Time("created_at").
+			Default(func() time.Time {
+				return time.Now().UTC()
+			}).
+			Immutable(),
+	}
+}
+
+// Indexes of the AuditEvent.
+func (AuditEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "created_at"),
+		index.Fields("tenant_id", "action"),
+		index.Fields("tenant_id", "actor_id"),
+	}
+}
@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// BlobDeletion holds the schema definition for the BlobDeletion entity.
+// Deleting an attachment row queues its blob here (by a trigger in the
+// migration, so cascading deletes are covered too); the scheduler removes
+// the blob from the store and then the row.
+type BlobDeletion struct {
+	ent.Schema
+}
+
+// Fields of the BlobDeletion.
+func (BlobDeletion) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Immutable().
+			Comment("The blob's storage key"),
+		field.String("tenant_id").
+			NotEmpty().
+			Immutable(),
+		field.Time("created_at").
+			Default(func() time.Time {
+				return time.Now().UTC()
+			}).
+			Immutable(),
+	}
+}
+
+// Indexes of the BlobDeletion.
+func (BlobDeletion) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "created_at"),
+	}
+}
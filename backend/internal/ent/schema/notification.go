@@ -0,0 +1,76 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Notification holds the schema definition for the Notification entity.
+type Notification struct {
+	ent.Schema
+}
+
+// Fields of the Notification.
+func (Notification) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Immutable(),
+		field.String("tenant_id").
+			NotEmpty().
+			Immutable(),
+		field.String("user_id").
+			NotEmpty().
+			Immutable(),
+		field.String("todo_id").
+			Optional().
+			Nillable(),
+		field.String("reminder_id").
+			Optional().
+			Nillable().
+			Unique().
+			Immutable().
+			Comment("Makes delivering the same reminder twice a no-op"),
+		field.String("title").
+			NotEmpty(),
+		field.Text("body").
+			Default(""),
+		field.Time("read_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(func() time.Time {
+				return time.Now().UTC()
+			}).
+			Immutable(),
+	}
+}
+
+// Edges of the Notification.
+func (Notification) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("notifications").
+			Field("user_id").
+			Required().
+			Unique().
+			Immutable(),
+		edge.From("todo", Todo.Type).
+			Ref("notifications").
+			Field("todo_id").
+			Unique(),
+	}
+}
+
+// Indexes of the Notification.
+func (Notification) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id"),
+		index.Fields("user_id", "created_at"),
+		index.Fields("todo_id"),
+	}
+}
@@ -0,0 +1,78 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Project holds the schema definition for the Project entity.
+type Project struct {
+	ent.Schema
+}
+
+// Fields of the Project.
+func (Project) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Immutable(),
+		field.String("tenant_id").
+			NotEmpty().
+			Immutable(),
+		field.String("user_id").
+			NotEmpty().
+			Immutable(),
+		field.String("name").
+			NotEmpty(),
+		field.Text("description").
+			Optional().
+			Default(""),
+		field.Bool("is_public").
+			Default(false).
+			Comment("If true, visible to all users in the same tenant"),
+		field.Time("archived_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(func() time.Time {
+				return time.Now().UTC()
+			}).
+			Immutable(),
+		field.Time("updated_at").
+			Default(func() time.Time {
+				return time.Now().UTC()
+			}).
+			UpdateDefault(func() time.Time {
+				return time.Now().UTC()
+			}),
+	}
+}
+
+// Edges of the Project.
+func (Project) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("projects").
+			Field("user_id").
+			Required().
+			Unique().
+			Immutable(),
+		// Deleting a project keeps its todos, outside of any project
+		edge.To("todos", Todo.Type).
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+	}
+}
+
+// Indexes of the Project.
+func (Project) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id"),
+		index.Fields("user_id"),
+		index.Fields("tenant_id", "is_public"),
+	}
+}
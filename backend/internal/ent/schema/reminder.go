@@ -0,0 +1,79 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Reminder holds the schema definition for the Reminder entity.
+type Reminder struct {
+	ent.Schema
+}
+
+// Fields of the Reminder.
+func (Reminder) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Immutable(),
+		field.String("tenant_id").
+			NotEmpty().
+			Immutable(),
+		field.String("todo_id").
+			NotEmpty().
+			Immutable(),
+		field.Time("remind_at"),
+		field.Int("offset_minutes").
+			Optional().
+			Nillable().
+			Comment("Set when the reminder is relative to the due date; remind_at follows due date changes"),
+		field.String("channel").
+			NotEmpty().
+			Immutable().
+			Comment("email or in_app"),
+		field.Int("attempts").
+			Default(0),
+		field.Time("next_attempt_at").
+			Comment("remind_at, pushed back after a failed delivery"),
+		field.Time("sent_at").
+			Optional().
+			Nillable(),
+		field.Time("failed_at").
+			Optional().
+			Nillable().
+			Comment("Set when delivery was given up after too many attempts"),
+		field.Time("created_at").
+			Default(func() time.Time {
+				return time.Now().UTC()
+			}).
+			Immutable(),
+	}
+}
+
+// Edges of the Reminder.
+func (Reminder) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("todo", Todo.Type).
+			Ref("reminders").
+			Field("todo_id").
+			Required().
+			Unique().
+			Immutable(),
+	}
+}
+
+// Indexes of the Reminder.
+func (Reminder) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id"),
+		index.Fields("todo_id"),
+		// The scheduler polls pending reminders by next_attempt_at (partial index in the migration)
+		index.Fields("next_attempt_at").
+			Annotations(entsql.IndexWhere(`"sent_at" IS NULL AND "failed_at" IS NULL`)),
+	}
+}
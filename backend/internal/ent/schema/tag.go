@@ -0,0 +1,72 @@
+package schema
+
+import (
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Tag holds the schema definition for the Tag entity.
+type Tag struct {
+	ent.Schema
+}
+
+// Fields of the Tag.
+func (Tag) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Immutable(),
+		field.String("tenant_id").
+			NotEmpty().
+			Immutable(),
+		field.String("name").
+			NotEmpty().
+			// MaxLen counts bytes; the column limit counts characters
+			SchemaType(map[string]string{dialect.Postgres: "character varying(50)"}).
+			Validate(func(s string) error {
+				if utf8.RuneCountInString(s) > 50 {
+					return fmt.Errorf("name must be at most 50 characters")
+				}
+				return nil
+			}).
+			Comment("Unique per tenant, case-insensitively (expression index in the migration)"),
+		field.String("color").
+			Default("#808080").
+			Match(hexColorPattern).
+			Comment("#RRGGBB"),
+		field.Time("created_at").
+			Default(func() time.Time {
+				return time.Now().UTC()
+			}).
+			Immutable(),
+		field.Time("updated_at").
+			Default(func() time.Time {
+				return time.Now().UTC()
+			}).
+			UpdateDefault(func() time.Time {
+				return time.Now().UTC()
+			}),
+	}
+}
+
+// Edges of the Tag.
+func (Tag) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("todos", Todo.Type).
+			Through("todo_tags", TodoTag.Type),
+	}
+}
+
+// Indexes of the Tag.
+func (Tag) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id"),
+	}
+}
@@ -24,6 +24,28 @@ func (Tenant) Fields() []ent.Field {
 		field.String("slug").
 			NotEmpty().
 			Unique(),
+		field.Bool("magic_link_enabled").
+			Default(false).
+			Comment("If true, members can sign in with an emailed magic link"),
+		field.Enum("account_deletion_policy").
+			Values("anonymize", "reassign").
+			Default("anonymize").
+			Comment("What happens to a deleted member's public todos: handed to a placeholder user or to another admin"),
+		field.Enum("search_language").
+			Values("simple", "danish", "dutch", "english", "finnish", "french", "german", "hungarian",
+				"italian", "norwegian", "portuguese", "romanian", "russian", "spanish", "swedish", "turkish").
+			Default("simple").
+			Comment("PostgreSQL text search configuration used to index and search the tenant's todos"),
+		field.Int("trash_retention_days").
+			Range(1, 365).
+			Default(30).
+			Comment("Days a deleted todo stays in the trash before it is purged for good"),
+		field.String("scim_token_hash").
+			Optional().
+			Nillable().
+			Unique().
+			Sensitive().
+			Comment("SHA-256 of the bearer token used by the identity provider for SCIM provisioning"),
 		field.Time("created_at").
 			Default(func() time.Time {
 				return time.Now().UTC()
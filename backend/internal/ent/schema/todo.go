@@ -1,9 +1,16 @@
 package schema
 
 import (
+	"context"
 	"time"
 
+	gen "good-todo-go/internal/ent"
+	"good-todo-go/internal/ent/hook"
+	"good-todo-go/internal/ent/intercept"
+	"good-todo-go/internal/ent/todo"
+
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -15,6 +22,11 @@ type Todo struct {
 }
 
 // Fields of the Todo.
+//
+// The table also has search_language (regconfig, copied from the tenant by a
+// trigger) and a generated search_vector (tsvector) column. ent cannot model
+// generated columns, so both live only in the migrations and are queried with
+// raw SQL by the search repository method.
 func (Todo) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("id").
@@ -25,7 +37,18 @@ func (Todo) Fields() []ent.Field {
 			Immutable(),
 		field.String("user_id").
 			NotEmpty().
-			Immutable(),
+			Comment("Owner; only changes when an account is deleted and its public todos go to an heir"),
+		field.String("assignee_id").
+			Optional().
+			Nillable().
+			Comment("Member of the same tenant who may view and complete the todo; user_id stays the creator"),
+		field.String("project_id").
+			Optional().
+			Nillable(),
+		field.String("parent_id").
+			Optional().
+			Nillable().
+			Comment("Parent todo when this todo is a subtask; always owned by the same user"),
 		field.String("title").
 			NotEmpty(),
 		field.Text("description").
@@ -36,12 +59,39 @@ func (Todo) Fields() []ent.Field {
 		field.Bool("is_public").
 			Default(false).
 			Comment("If true, visible to all users in the same tenant"),
+		field.Int("priority").
+			Range(0, 4).
+			Default(0).
+			Comment("Index into model.TodoPriorities, from none (0) to urgent (4)"),
+		field.String("position").
+			NotEmpty().
+			Annotations(entsql.Annotation{Collation: "C"}).
+			Comment("Fractional index key in the owner's manual order; compared byte by byte"),
 		field.Time("due_date").
 			Optional().
 			Nillable(),
 		field.Time("completed_at").
 			Optional().
 			Nillable(),
+		field.String("recurrence_rule").
+			Optional().
+			Nillable().
+			Comment("RFC 5545 RRULE in canonical form; set on the open occurrence of a series"),
+		field.String("recurrence_timezone").
+			Optional().
+			Nillable().
+			Comment("IANA timezone whose wall clock the rule is evaluated in"),
+		field.Time("recurrence_start").
+			Optional().
+			Nillable().
+			Comment("Due date of the first occurrence (DTSTART); the rule is anchored on it"),
+		field.Time("deleted_at").
+			Optional().
+			Nillable().
+			Comment("Set while the todo is in the trash; queries leave such todos out unless asked"),
+		field.Int("version").
+			Default(1).
+			Comment("Incremented by every update; writes based on an older read check it and fail"),
 		field.Time("created_at").
 			Default(func() time.Time {
 				return time.Now().UTC()
@@ -64,8 +114,37 @@ func (Todo) Edges() []ent.Edge {
 			Ref("todos").
 			Field("user_id").
 			Required().
+			Unique(),
+		edge.From("assignee", User.Type).
+			Ref("assigned_todos").
+			Field("assignee_id").
+			Unique(),
+		edge.From("project", Project.Type).
+			Ref("todos").
+			Field("project_id").
+			Unique(),
+		// Deleting a parent without its subtasks turns them into top-level todos
+		edge.To("children", Todo.Type).
+			From("parent").
+			Field("parent_id").
 			Unique().
-			Immutable(),
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+		edge.From("tags", Tag.Type).
+			Ref("todos").
+			Through("todo_tags", TodoTag.Type),
+		edge.To("reminders", Reminder.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("assignments", TodoAssignment.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("comments", TodoComment.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("attachments", TodoAttachment.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("shares", TodoShare.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// Notifications outlive the todo they were about
+		edge.To("notifications", Notification.Type).
+			Annotations(entsql.OnDelete(entsql.SetNull)),
 	}
 }
 
@@ -76,5 +155,59 @@ func (Todo) Indexes() []ent.Index {
 		index.Fields("user_id"),
 		index.Fields("tenant_id", "user_id"),
 		index.Fields("tenant_id", "is_public"),
+		index.Fields("user_id", "completed_at"),
+		index.Fields("project_id"),
+		index.Fields("parent_id"),
+		index.Fields("assignee_id"),
+		index.Fields("user_id", "position"),
+		index.Fields("user_id", "priority"),
+		index.Fields("user_id", "deleted_at"),
+		index.Fields("tenant_id", "deleted_at"),
+	}
+}
+
+type includeDeletedKey struct{}
+
+// IncludeDeleted returns a context whose todo queries also see todos in the trash
+func IncludeDeleted(ctx context.Context) context.Context {
+	return context.WithValue(ctx, includeDeletedKey{}, true)
+}
+
+// Interceptors of the Todo.
+//
+// Todos in the trash are left out of every todo query, including edge
+// traversals like a project's todos. Raw SQL has to filter on deleted_at itself.
+func (Todo) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		intercept.TraverseTodo(func(ctx context.Context, q *gen.TodoQuery) error {
+			if include, _ := ctx.Value(includeDeletedKey{}).(bool); !include {
+				q.Where(todo.DeletedAtIsNil())
+			}
+			return nil
+		}),
+	}
+}
+
+// Hooks of the Todo.
+//
+// Every create, update and delete of a todo, bulk ones included, is recorded
+// as a TodoRevision. Raw SQL is not seen by the hooks, so tracked columns (see
+// todoRevisionValues) must only be written through the builders, and todos
+// only deleted through them.
+func (Todo) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.On(bumpTodoVersion, ent.OpUpdate|ent.OpUpdateOne),
+		hook.On(recordTodoRevisions, ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne|ent.OpDelete|ent.OpDeleteOne),
 	}
 }
+
+// bumpTodoVersion increments the version with every update, bulk updates
+// included. Raw SQL that writes todos has to do the same.
+func bumpTodoVersion(next ent.Mutator) ent.Mutator {
+	return hook.TodoFunc(func(ctx context.Context, m *gen.TodoMutation) (ent.Value, error) {
+		if _, set := m.Version(); !set {
+			m.AddVersion(1)
+		}
+		return next.Mutate(ctx, m)
+	})
+}
@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// TodoAssignment holds the schema definition for the TodoAssignment entity.
+// Each row records one change of a todo's assignee.
+type TodoAssignment struct {
+	ent.Schema
+}
+
+// Fields of the TodoAssignment.
+//
+// The user columns have no foreign keys so that the history survives the
+// deletion of the users it names.
+func (TodoAssignment) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Immutable(),
+		field.String("tenant_id").
+			NotEmpty().
+			Immutable(),
+		field.String("todo_id").
+			NotEmpty().
+			Immutable(),
+		field.String("previous_assignee_id").
+			Optional().
+			Nillable().
+			Immutable(),
+		field.String("assignee_id").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Nil when the todo was unassigned"),
+		field.String("changed_by").
+			NotEmpty().
+			Immutable(),
+		field.Time("created_at").
+			Default(func() time.Time {
+				return time.Now().UTC()
+			}).
+			Immutable(),
+	}
+}
+
+// Edges of the TodoAssignment.
+func (TodoAssignment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("todo", Todo.Type).
+			Ref("assignments").
+			Field("todo_id").
+			Required().
+			Unique().
+			Immutable(),
+	}
+}
+
+// Indexes of the TodoAssignment.
+func (TodoAssignment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id"),
+		index.Fields("todo_id", "created_at"),
+	}
+}
@@ -0,0 +1,73 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// TodoAttachment holds the schema definition for the TodoAttachment entity.
+// The contents live in the blob store under storage_key.
+type TodoAttachment struct {
+	ent.Schema
+}
+
+// Fields of the TodoAttachment.
+func (TodoAttachment) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Immutable(),
+		field.String("tenant_id").
+			NotEmpty().
+			Immutable(),
+		field.String("todo_id").
+			NotEmpty().
+			Immutable(),
+		field.String("uploaded_by").
+			NotEmpty().
+			Immutable().
+			Comment("No foreign key, so attachments outlive the uploader's account"),
+		field.String("file_name").
+			NotEmpty().
+			Immutable(),
+		field.String("content_type").
+			NotEmpty().
+			Immutable().
+			Comment("Sniffed from the contents, not taken from the client"),
+		field.Int64("size").
+			Immutable(),
+		field.String("storage_key").
+			NotEmpty().
+			Unique().
+			Immutable(),
+		field.Time("created_at").
+			Default(func() time.Time {
+				return time.Now().UTC()
+			}).
+			Immutable(),
+	}
+}
+
+// Edges of the TodoAttachment.
+func (TodoAttachment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("todo", Todo.Type).
+			Ref("attachments").
+			Field("todo_id").
+			Required().
+			Unique().
+			Immutable(),
+	}
+}
+
+// Indexes of the TodoAttachment.
+func (TodoAttachment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id"),
+		index.Fields("todo_id", "created_at"),
+	}
+}
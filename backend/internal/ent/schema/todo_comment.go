@@ -0,0 +1,73 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// TodoComment holds the schema definition for the TodoComment entity.
+type TodoComment struct {
+	ent.Schema
+}
+
+// Fields of the TodoComment.
+func (TodoComment) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Immutable(),
+		field.String("tenant_id").
+			NotEmpty().
+			Immutable(),
+		field.String("todo_id").
+			NotEmpty().
+			Immutable(),
+		field.String("author_id").
+			Optional().
+			Nillable().
+			Comment("Nil once the author's account has been deleted"),
+		field.Text("body").
+			Comment("Markdown; cleared when the comment is deleted"),
+		field.Time("edited_at").
+			Optional().
+			Nillable(),
+		field.Time("deleted_at").
+			Optional().
+			Nillable().
+			Comment("Deleted comments stay in the thread as a placeholder"),
+		field.Time("created_at").
+			Default(func() time.Time {
+				return time.Now().UTC()
+			}).
+			Immutable(),
+	}
+}
+
+// Edges of the TodoComment.
+func (TodoComment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("todo", Todo.Type).
+			Ref("comments").
+			Field("todo_id").
+			Required().
+			Unique().
+			Immutable(),
+		edge.From("author", User.Type).
+			Ref("comments").
+			Field("author_id").
+			Unique(),
+	}
+}
+
+// Indexes of the TodoComment.
+func (TodoComment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id"),
+		index.Fields("todo_id", "created_at"),
+		index.Fields("author_id"),
+	}
+}
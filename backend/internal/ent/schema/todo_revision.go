@@ -0,0 +1,241 @@
+package schema
+
+import (
+	"context"
+	"time"
+
+	gen "good-todo-go/internal/ent"
+	"good-todo-go/internal/ent/hook"
+	"good-todo-go/internal/ent/todo"
+	"good-todo-go/internal/ent/todorevision"
+	"good-todo-go/internal/pkg"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// TodoRevision holds the schema definition for the TodoRevision entity.
+// Revisions are written by the Todo hooks, never by the repositories.
+type TodoRevision struct {
+	ent.Schema
+}
+
+// Fields of the TodoRevision.
+func (TodoRevision) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Immutable(),
+		field.String("tenant_id").
+			NotEmpty().
+			Immutable(),
+		field.String("todo_id").
+			NotEmpty().
+			Immutable().
+			Comment("Not a foreign key, so revisions outlive todos purged from the trash"),
+		field.String("actor_id").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Nil for changes made outside of a user's request; not a foreign key, so revisions outlive deleted users"),
+		field.Enum("operation").
+			Values("create", "update", "delete", "restore", "purge").
+			Immutable().
+			Comment("delete and restore move the todo to and from the trash; purge deletes it for good"),
+		field.JSON("old_values", map[string]any{}).
+			Optional().
+			Immutable().
+			Comment("Values of the changed fields before the change, keyed by column name"),
+		field.JSON("new_values", map[string]any{}).
+			Optional().
+			Immutable().
+			Comment("Values of the changed fields after the change, keyed by column name"),
+		field.Time("created_at").
+			Default(func() time.Time {
+				return time.Now().UTC()
+			}).
+			Immutable(),
+	}
+}
+
+// Indexes of the TodoRevision.
+func (TodoRevision) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id"),
+		index.Fields("todo_id", "created_at"),
+	}
+}
+
+// recordTodoRevisions writes a revision for every todo a mutation creates,
+// changes or deletes, in the same transaction. Bulk updates do not return the
+// rows they change, so those rows are read before and after the update.
+func recordTodoRevisions(next ent.Mutator) ent.Mutator {
+	return hook.TodoFunc(func(ctx context.Context, m *gen.TodoMutation) (ent.Value, error) {
+		// Trashed todos are written too, e.g. when they are restored
+		queryCtx := IncludeDeleted(ctx)
+
+		var ids []string
+		before := map[string]*gen.Todo{}
+		if !m.Op().Is(ent.OpCreate) {
+			var err error
+			ids, err = m.IDs(queryCtx)
+			if err != nil {
+				return nil, err
+			}
+			if len(ids) == 0 {
+				return next.Mutate(ctx, m)
+			}
+			todos, err := m.Client().Todo.Query().Where(todo.IDIn(ids...)).All(queryCtx)
+			if err != nil {
+				return nil, err
+			}
+			for _, t := range todos {
+				before[t.ID] = t
+			}
+		}
+
+		v, err := next.Mutate(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+
+		// Deleted todos are recorded with the values they had
+		deleting := m.Op().Is(ent.OpDelete | ent.OpDeleteOne)
+		after := map[string]*gen.Todo{}
+		if t, ok := v.(*gen.Todo); ok {
+			ids = []string{t.ID}
+			after[t.ID] = t
+		} else if !deleting {
+			todos, err := m.Client().Todo.Query().Where(todo.IDIn(ids...)).All(queryCtx)
+			if err != nil {
+				return nil, err
+			}
+			for _, t := range todos {
+				after[t.ID] = t
+			}
+		}
+
+		now := time.Now().UTC()
+		actorID := pkg.ActorIDFrom(ctx)
+		var revisions []*gen.TodoRevisionCreate
+		for _, id := range ids {
+			t := after[id]
+			if deleting {
+				t = before[id]
+			}
+			if t == nil {
+				continue
+			}
+			operation, oldValues, newValues := diffTodo(before[id], after[id])
+			if operation == todorevision.OperationUpdate && len(newValues) == 0 {
+				continue
+			}
+			revision := m.Client().TodoRevision.Create().
+				SetID(uuid.NewString()).
+				SetTenantID(t.TenantID).
+				SetTodoID(t.ID).
+				SetOperation(operation).
+				SetOldValues(oldValues).
+				SetNewValues(newValues).
+				SetCreatedAt(now)
+			if actorID != "" {
+				revision.SetActorID(actorID)
+			}
+			revisions = append(revisions, revision)
+		}
+		if len(revisions) > 0 {
+			if err := m.Client().TodoRevision.CreateBulk(revisions...).Exec(ctx); err != nil {
+				return nil, err
+			}
+		}
+		return v, nil
+	})
+}
+
+// diffTodo returns what kind of change turned before into after, and the
+// tracked fields that differ. before is nil for a new todo and after for a
+// deleted one.
+func diffTodo(before, after *gen.Todo) (todorevision.Operation, map[string]any, map[string]any) {
+	if after == nil {
+		return todorevision.OperationPurge, setTodoRevisionValues(before), nil
+	}
+	newValues := todoRevisionValues(after)
+	if before == nil {
+		return todorevision.OperationCreate, nil, setTodoRevisionValues(after)
+	}
+
+	operation := todorevision.OperationUpdate
+	switch {
+	case before.DeletedAt == nil && after.DeletedAt != nil:
+		operation = todorevision.OperationDelete
+	case before.DeletedAt != nil && after.DeletedAt == nil:
+		operation = todorevision.OperationRestore
+	}
+
+	oldValues := todoRevisionValues(before)
+	for field, value := range newValues {
+		if sameTodoRevisionValue(oldValues[field], value) {
+			delete(oldValues, field)
+			delete(newValues, field)
+		}
+	}
+	return operation, oldValues, newValues
+}
+
+// todoRevisionValues returns the tracked fields of a todo. Its position,
+// timestamps and trash state are left out: reordering is not an edit and
+// moving to the trash is told by the operation.
+func todoRevisionValues(t *gen.Todo) map[string]any {
+	values := map[string]any{
+		todo.FieldUserID:      t.UserID,
+		todo.FieldTitle:       t.Title,
+		todo.FieldDescription: t.Description,
+		todo.FieldCompleted:   t.Completed,
+		todo.FieldIsPublic:    t.IsPublic,
+		todo.FieldPriority:    t.Priority,
+	}
+	for field, value := range map[string]*string{
+		todo.FieldAssigneeID:         t.AssigneeID,
+		todo.FieldProjectID:          t.ProjectID,
+		todo.FieldParentID:           t.ParentID,
+		todo.FieldRecurrenceRule:     t.RecurrenceRule,
+		todo.FieldRecurrenceTimezone: t.RecurrenceTimezone,
+	} {
+		values[field] = nil
+		if value != nil {
+			values[field] = *value
+		}
+	}
+	for field, value := range map[string]*time.Time{
+		todo.FieldDueDate:         t.DueDate,
+		todo.FieldCompletedAt:     t.CompletedAt,
+		todo.FieldRecurrenceStart: t.RecurrenceStart,
+	} {
+		values[field] = nil
+		if value != nil {
+			values[field] = value.UTC()
+		}
+	}
+	return values
+}
+
+// setTodoRevisionValues returns the tracked fields of a todo that are set
+func setTodoRevisionValues(t *gen.Todo) map[string]any {
+	values := todoRevisionValues(t)
+	for field, value := range values {
+		if value == nil {
+			delete(values, field)
+		}
+	}
+	return values
+}
+
+func sameTodoRevisionValue(a, b any) bool {
+	if at, ok := a.(time.Time); ok {
+		bt, ok := b.(time.Time)
+		return ok && at.Equal(bt)
+	}
+	return a == b
+}
@@ -0,0 +1,79 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// TodoShare holds the schema definition for the TodoShare entity.
+// Each row gives one member access to a todo they do not own.
+type TodoShare struct {
+	ent.Schema
+}
+
+// Fields of the TodoShare.
+func (TodoShare) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Immutable(),
+		field.String("tenant_id").
+			NotEmpty().
+			Immutable(),
+		field.String("todo_id").
+			NotEmpty().
+			Immutable(),
+		field.String("user_id").
+			NotEmpty().
+			Immutable(),
+		field.Enum("permission").
+			Values("read", "edit").
+			Comment("edit also allows changing the todo's own fields, but not its visibility, place or sharing"),
+		field.String("created_by").
+			NotEmpty().
+			Immutable(),
+		field.Time("created_at").
+			Default(func() time.Time {
+				return time.Now().UTC()
+			}).
+			Immutable(),
+		field.Time("updated_at").
+			Default(func() time.Time {
+				return time.Now().UTC()
+			}).
+			UpdateDefault(func() time.Time {
+				return time.Now().UTC()
+			}),
+	}
+}
+
+// Edges of the TodoShare.
+func (TodoShare) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("todo", Todo.Type).
+			Ref("shares").
+			Field("todo_id").
+			Required().
+			Unique().
+			Immutable(),
+		edge.From("user", User.Type).
+			Ref("shared_todos").
+			Field("user_id").
+			Required().
+			Unique().
+			Immutable(),
+	}
+}
+
+// Indexes of the TodoShare.
+func (TodoShare) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id"),
+		index.Fields("todo_id", "user_id").Unique(),
+		index.Fields("user_id"),
+	}
+}
@@ -0,0 +1,73 @@
+package schema
+
+import (
+	"regexp"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// hexColorPattern matches a #RRGGBB color
+var hexColorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// TodoTag holds the schema definition for the link between a Todo and a Tag.
+// It carries tenant_id so the join table can have its own RLS policy.
+type TodoTag struct {
+	ent.Schema
+}
+
+// Annotations of the TodoTag.
+func (TodoTag) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		field.ID("tag_id", "todo_id"),
+	}
+}
+
+// Fields of the TodoTag.
+func (TodoTag) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("tag_id").
+			Immutable(),
+		field.String("todo_id").
+			Immutable(),
+		field.String("tenant_id").
+			NotEmpty().
+			Immutable(),
+		field.Time("created_at").
+			Default(func() time.Time {
+				return time.Now().UTC()
+			}).
+			Immutable(),
+	}
+}
+
+// Edges of the TodoTag.
+func (TodoTag) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("tag", Tag.Type).
+			Field("tag_id").
+			Required().
+			Unique().
+			Immutable().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("todo", Todo.Type).
+			Field("todo_id").
+			Required().
+			Unique().
+			Immutable().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the TodoTag.
+func (TodoTag) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id"),
+		index.Fields("todo_id"),
+	}
+}
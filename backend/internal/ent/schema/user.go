@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -35,12 +36,29 @@ func (User) Fields() []ent.Field {
 			Default("member"),
 		field.Bool("email_verified").
 			Default(false),
+		field.Bool("is_active").
+			Default(true).
+			Comment("Deactivated users cannot sign in; set by SCIM deprovisioning"),
+		field.Bool("is_system").
+			Default(false).
+			Immutable().
+			Comment("Accounts the app manages itself, like the deleted-user placeholder; hidden from user listings"),
+		field.String("external_id").
+			Optional().
+			Nillable().
+			Comment("Identifier assigned by the tenant's identity provider (SCIM externalId)"),
 		field.String("verification_token").
 			Optional().
 			Nillable(),
 		field.Time("verification_token_expires_at").
 			Optional().
 			Nillable(),
+		field.String("calendar_token_hash").
+			Optional().
+			Nillable().
+			Unique().
+			Sensitive().
+			Comment("SHA-256 of the secret token in the user's calendar feed URL"),
 		field.Time("created_at").
 			Default(func() time.Time {
 				return time.Now().UTC()
@@ -66,6 +84,22 @@ func (User) Edges() []ent.Edge {
 			Unique().
 			Immutable(),
 		edge.To("todos", Todo.Type),
+		// A deleted member's assigned todos go back to being unassigned
+		edge.To("assigned_todos", Todo.Type).
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+		edge.To("projects", Project.Type),
+		edge.To("magic_link_tokens", MagicLinkToken.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("password_reset_tokens", PasswordResetToken.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("notifications", Notification.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// A deleted member loses access to the todos shared with them
+		edge.To("shared_todos", TodoShare.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// Comments stay in the thread without an author
+		edge.To("comments", TodoComment.Type).
+			Annotations(entsql.OnDelete(entsql.SetNull)),
 	}
 }
 
@@ -73,6 +107,7 @@ func (User) Edges() []ent.Edge {
 func (User) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("tenant_id", "email").Unique(),
+		index.Fields("tenant_id", "external_id").Unique(),
 		index.Fields("tenant_id"),
 	}
 }
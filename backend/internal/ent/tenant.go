@@ -21,6 +21,16 @@ type Tenant struct {
 	Name string `json:"name,omitempty"`
 	// Slug holds the value of the "slug" field.
 	Slug string `json:"slug,omitempty"`
+	// If true, members can sign in with an emailed magic link
+	MagicLinkEnabled bool `json:"magic_link_enabled,omitempty"`
+	// What happens to a deleted member's public todos: handed to a placeholder user or to another admin
+	AccountDeletionPolicy tenant.AccountDeletionPolicy `json:"account_deletion_policy,omitempty"`
+	// PostgreSQL text search configuration used to index and search the tenant's todos
+	SearchLanguage tenant.SearchLanguage `json:"search_language,omitempty"`
+	// Days a deleted todo stays in the trash before it is purged for good
+	TrashRetentionDays int `json:"trash_retention_days,omitempty"`
+	// SHA-256 of the bearer token used by the identity provider for SCIM provisioning
+	ScimTokenHash *string `json:"-"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -54,7 +64,11 @@ func (*Tenant) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case tenant.FieldID, tenant.FieldName, tenant.FieldSlug:
+		case tenant.FieldMagicLinkEnabled:
+			values[i] = new(sql.NullBool)
+		case tenant.FieldTrashRetentionDays:
+			values[i] = new(sql.NullInt64)
+		case tenant.FieldID, tenant.FieldName, tenant.FieldSlug, tenant.FieldAccountDeletionPolicy, tenant.FieldSearchLanguage, tenant.FieldScimTokenHash:
 			values[i] = new(sql.NullString)
 		case tenant.FieldCreatedAt, tenant.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -91,6 +105,37 @@ func (_m *Tenant) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Slug = value.String
 			}
+		case tenant.FieldMagicLinkEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field magic_link_enabled", values[i])
+			} else if value.Valid {
+				_m.MagicLinkEnabled = value.Bool
+			}
+		case tenant.FieldAccountDeletionPolicy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_deletion_policy", values[i])
+			} else if value.Valid {
+				_m.AccountDeletionPolicy = tenant.AccountDeletionPolicy(value.String)
+			}
+		case tenant.FieldSearchLanguage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field search_language", values[i])
+			} else if value.Valid {
+				_m.SearchLanguage = tenant.SearchLanguage(value.String)
+			}
+		case tenant.FieldTrashRetentionDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field trash_retention_days", values[i])
+			} else if value.Valid {
+				_m.TrashRetentionDays = int(value.Int64)
+			}
+		case tenant.FieldScimTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scim_token_hash", values[i])
+			} else if value.Valid {
+				_m.ScimTokenHash = new(string)
+				*_m.ScimTokenHash = value.String
+			}
 		case tenant.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -150,6 +195,20 @@ func (_m *Tenant) String() string {
 	builder.WriteString("slug=")
 	builder.WriteString(_m.Slug)
 	builder.WriteString(", ")
+	builder.WriteString("magic_link_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MagicLinkEnabled))
+	builder.WriteString(", ")
+	builder.WriteString("account_deletion_policy=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AccountDeletionPolicy))
+	builder.WriteString(", ")
+	builder.WriteString("search_language=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SearchLanguage))
+	builder.WriteString(", ")
+	builder.WriteString("trash_retention_days=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TrashRetentionDays))
+	builder.WriteString(", ")
+	builder.WriteString("scim_token_hash=<sensitive>")
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
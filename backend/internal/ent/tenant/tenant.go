@@ -3,6 +3,7 @@
 package tenant
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -18,6 +19,16 @@ const (
 	FieldName = "name"
 	// FieldSlug holds the string denoting the slug field in the database.
 	FieldSlug = "slug"
+	// FieldMagicLinkEnabled holds the string denoting the magic_link_enabled field in the database.
+	FieldMagicLinkEnabled = "magic_link_enabled"
+	// FieldAccountDeletionPolicy holds the string denoting the account_deletion_policy field in the database.
+	FieldAccountDeletionPolicy = "account_deletion_policy"
+	// FieldSearchLanguage holds the string denoting the search_language field in the database.
+	FieldSearchLanguage = "search_language"
+	// FieldTrashRetentionDays holds the string denoting the trash_retention_days field in the database.
+	FieldTrashRetentionDays = "trash_retention_days"
+	// FieldScimTokenHash holds the string denoting the scim_token_hash field in the database.
+	FieldScimTokenHash = "scim_token_hash"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -40,6 +51,11 @@ var Columns = []string{
 	FieldID,
 	FieldName,
 	FieldSlug,
+	FieldMagicLinkEnabled,
+	FieldAccountDeletionPolicy,
+	FieldSearchLanguage,
+	FieldTrashRetentionDays,
+	FieldScimTokenHash,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -59,6 +75,12 @@ var (
 	NameValidator func(string) error
 	// SlugValidator is a validator for the "slug" field. It is called by the builders before save.
 	SlugValidator func(string) error
+	// DefaultMagicLinkEnabled holds the default value on creation for the "magic_link_enabled" field.
+	DefaultMagicLinkEnabled bool
+	// DefaultTrashRetentionDays holds the default value on creation for the "trash_retention_days" field.
+	DefaultTrashRetentionDays int
+	// TrashRetentionDaysValidator is a validator for the "trash_retention_days" field. It is called by the builders before save.
+	TrashRetentionDaysValidator func(int) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -69,6 +91,72 @@ var (
 	IDValidator func(string) error
 )
 
+// AccountDeletionPolicy defines the type for the "account_deletion_policy" enum field.
+type AccountDeletionPolicy string
+
+// AccountDeletionPolicyAnonymize is the default value of the AccountDeletionPolicy enum.
+const DefaultAccountDeletionPolicy = AccountDeletionPolicyAnonymize
+
+// AccountDeletionPolicy values.
+const (
+	AccountDeletionPolicyAnonymize AccountDeletionPolicy = "anonymize"
+	AccountDeletionPolicyReassign  AccountDeletionPolicy = "reassign"
+)
+
+func (adp AccountDeletionPolicy) String() string {
+	return string(adp)
+}
+
+// AccountDeletionPolicyValidator is a validator for the "account_deletion_policy" field enum values. It is called by the builders before save.
+func AccountDeletionPolicyValidator(adp AccountDeletionPolicy) error {
+	switch adp {
+	case AccountDeletionPolicyAnonymize, AccountDeletionPolicyReassign:
+		return nil
+	default:
+		return fmt.Errorf("tenant: invalid enum value for account_deletion_policy field: %q", adp)
+	}
+}
+
+// SearchLanguage defines the type for the "search_language" enum field.
+type SearchLanguage string
+
+// SearchLanguageSimple is the default value of the SearchLanguage enum.
+const DefaultSearchLanguage = SearchLanguageSimple
+
+// SearchLanguage values.
+const (
+	SearchLanguageSimple     SearchLanguage = "simple"
+	SearchLanguageDanish     SearchLanguage = "danish"
+	SearchLanguageDutch      SearchLanguage = "dutch"
+	SearchLanguageEnglish    SearchLanguage = "english"
+	SearchLanguageFinnish    SearchLanguage = "finnish"
+	SearchLanguageFrench     SearchLanguage = "french"
+	SearchLanguageGerman     SearchLanguage = "german"
+	SearchLanguageHungarian  SearchLanguage = "hungarian"
+	SearchLanguageItalian    SearchLanguage = "italian"
+	SearchLanguageNorwegian  SearchLanguage = "norwegian"
+	SearchLanguagePortuguese SearchLanguage = "portuguese"
+	SearchLanguageRomanian   SearchLanguage = "romanian"
+	SearchLanguageRussian    SearchLanguage = "russian"
+	SearchLanguageSpanish    SearchLanguage = "spanish"
+	SearchLanguageSwedish    SearchLanguage = "swedish"
+	SearchLanguageTurkish    SearchLanguage = "turkish"
+)
+
+func (sl SearchLanguage) String() string {
+	return string(sl)
+}
+
+// SearchLanguageValidator is a validator for the "search_language" field enum values. It is called by the builders before save.
+func SearchLanguageValidator(sl SearchLanguage) error {
+	switch sl {
+	case SearchLanguageSimple, SearchLanguageDanish, SearchLanguageDutch, SearchLanguageEnglish, SearchLanguageFinnish, SearchLanguageFrench, SearchLanguageGerman, SearchLanguageHungarian, SearchLanguageItalian, SearchLanguageNorwegian, SearchLanguagePortuguese, SearchLanguageRomanian, SearchLanguageRussian, SearchLanguageSpanish, SearchLanguageSwedish, SearchLanguageTurkish:
+		return nil
+	default:
+		return fmt.Errorf("tenant: invalid enum value for search_language field: %q", sl)
+	}
+}
+
 // OrderOption defines the ordering options for the Tenant queries.
 type OrderOption func(*sql.Selector)
 
@@ -87,6 +175,31 @@ func BySlug(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSlug, opts...).ToFunc()
 }
 
+// ByMagicLinkEnabled orders the results by the magic_link_enabled field.
+func ByMagicLinkEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMagicLinkEnabled, opts...).ToFunc()
+}
+
+// ByAccountDeletionPolicy orders the results by the account_deletion_policy field.
+func ByAccountDeletionPolicy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccountDeletionPolicy, opts...).ToFunc()
+}
+
+// BySearchLanguage orders the results by the search_language field.
+func BySearchLanguage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSearchLanguage, opts...).ToFunc()
+}
+
+// ByTrashRetentionDays orders the results by the trash_retention_days field.
+func ByTrashRetentionDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrashRetentionDays, opts...).ToFunc()
+}
+
+// ByScimTokenHash orders the results by the scim_token_hash field.
+func ByScimTokenHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScimTokenHash, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
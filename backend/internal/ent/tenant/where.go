@@ -75,6 +75,21 @@ func Slug(v string) predicate.Tenant {
 	return predicate.Tenant(sql.FieldEQ(FieldSlug, v))
 }
 
+// MagicLinkEnabled applies equality check predicate on the "magic_link_enabled" field. It's identical to MagicLinkEnabledEQ.
+func MagicLinkEnabled(v bool) predicate.Tenant {
+	return predicate.Tenant(sql.FieldEQ(FieldMagicLinkEnabled, v))
+}
+
+// TrashRetentionDays applies equality check predicate on the "trash_retention_days" field. It's identical to TrashRetentionDaysEQ.
+func TrashRetentionDays(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldEQ(FieldTrashRetentionDays, v))
+}
+
+// ScimTokenHash applies equality check predicate on the "scim_token_hash" field. It's identical to ScimTokenHashEQ.
+func ScimTokenHash(v string) predicate.Tenant {
+	return predicate.Tenant(sql.FieldEQ(FieldScimTokenHash, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Tenant {
 	return predicate.Tenant(sql.FieldEQ(FieldCreatedAt, v))
@@ -215,6 +230,171 @@ func SlugContainsFold(v string) predicate.Tenant {
 	return predicate.Tenant(sql.FieldContainsFold(FieldSlug, v))
 }
 
+// MagicLinkEnabledEQ applies the EQ predicate on the "magic_link_enabled" field.
+func MagicLinkEnabledEQ(v bool) predicate.Tenant {
+	return predicate.Tenant(sql.FieldEQ(FieldMagicLinkEnabled, v))
+}
+
+// MagicLinkEnabledNEQ applies the NEQ predicate on the "magic_link_enabled" field.
+func MagicLinkEnabledNEQ(v bool) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNEQ(FieldMagicLinkEnabled, v))
+}
+
+// AccountDeletionPolicyEQ applies the EQ predicate on the "account_deletion_policy" field.
+func AccountDeletionPolicyEQ(v AccountDeletionPolicy) predicate.Tenant {
+	return predicate.Tenant(sql.FieldEQ(FieldAccountDeletionPolicy, v))
+}
+
+// AccountDeletionPolicyNEQ applies the NEQ predicate on the "account_deletion_policy" field.
+func AccountDeletionPolicyNEQ(v AccountDeletionPolicy) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNEQ(FieldAccountDeletionPolicy, v))
+}
+
+// AccountDeletionPolicyIn applies the In predicate on the "account_deletion_policy" field.
+func AccountDeletionPolicyIn(vs ...AccountDeletionPolicy) predicate.Tenant {
+	return predicate.Tenant(sql.FieldIn(FieldAccountDeletionPolicy, vs...))
+}
+
+// AccountDeletionPolicyNotIn applies the NotIn predicate on the "account_deletion_policy" field.
+func AccountDeletionPolicyNotIn(vs ...AccountDeletionPolicy) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNotIn(FieldAccountDeletionPolicy, vs...))
+}
+
+// SearchLanguageEQ applies the EQ predicate on the "search_language" field.
+func SearchLanguageEQ(v SearchLanguage) predicate.Tenant {
+	return predicate.Tenant(sql.FieldEQ(FieldSearchLanguage, v))
+}
+
+// SearchLanguageNEQ applies the NEQ predicate on the "search_language" field.
+func SearchLanguageNEQ(v SearchLanguage) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNEQ(FieldSearchLanguage, v))
+}
+
+// SearchLanguageIn applies the In predicate on the "search_language" field.
+func SearchLanguageIn(vs ...SearchLanguage) predicate.Tenant {
+	return predicate.Tenant(sql.FieldIn(FieldSearchLanguage, vs...))
+}
+
+// SearchLanguageNotIn applies the NotIn predicate on the "search_language" field.
+func SearchLanguageNotIn(vs ...SearchLanguage) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNotIn(FieldSearchLanguage, vs...))
+}
+
+// TrashRetentionDaysEQ applies the EQ predicate on the "trash_retention_days" field.
+func TrashRetentionDaysEQ(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldEQ(FieldTrashRetentionDays, v))
+}
+
+// TrashRetentionDaysNEQ applies the NEQ predicate on the "trash_retention_days" field.
+func TrashRetentionDaysNEQ(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNEQ(FieldTrashRetentionDays, v))
+}
+
+// TrashRetentionDaysIn applies the In predicate on the "trash_retention_days" field.
+func TrashRetentionDaysIn(vs ...int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldIn(FieldTrashRetentionDays, vs...))
+}
+
+// TrashRetentionDaysNotIn applies the NotIn predicate on the "trash_retention_days" field.
+func TrashRetentionDaysNotIn(vs ...int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNotIn(FieldTrashRetentionDays, vs...))
+}
+
+// TrashRetentionDaysGT applies the GT predicate on the "trash_retention_days" field.
+func TrashRetentionDaysGT(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldGT(FieldTrashRetentionDays, v))
+}
+
+// TrashRetentionDaysGTE applies the GTE predicate on the "trash_retention_days" field.
+func TrashRetentionDaysGTE(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldGTE(FieldTrashRetentionDays, v))
+}
+
+// TrashRetentionDaysLT applies the LT predicate on the "trash_retention_days" field.
+func TrashRetentionDaysLT(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldLT(FieldTrashRetentionDays, v))
+}
+
+// TrashRetentionDaysLTE applies the LTE predicate on the "trash_retention_days" field.
+func TrashRetentionDaysLTE(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldLTE(FieldTrashRetentionDays, v))
+}
+
+// ScimTokenHashEQ applies the EQ predicate on the "scim_token_hash" field.
+func ScimTokenHashEQ(v string) predicate.Tenant {
+	return predicate.Tenant(sql.FieldEQ(FieldScimTokenHash, v))
+}
+
+// ScimTokenHashNEQ applies the NEQ predicate on the "scim_token_hash" field.
+func ScimTokenHashNEQ(v string) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNEQ(FieldScimTokenHash, v))
+}
+
+// ScimTokenHashIn applies the In predicate on the "scim_token_hash" field.
+func ScimTokenHashIn(vs ...string) predicate.Tenant {
+	return predicate.Tenant(sql.FieldIn(FieldScimTokenHash, vs...))
+}
+
+// ScimTokenHashNotIn applies the NotIn predicate on the "scim_token_hash" field.
+func ScimTokenHashNotIn(vs ...string) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNotIn(FieldScimTokenHash, vs...))
+}
+
+// ScimTokenHashGT applies the GT predicate on the "scim_token_hash" field.
+func ScimTokenHashGT(v string) predicate.Tenant {
+	return predicate.Tenant(sql.FieldGT(FieldScimTokenHash, v))
+}
+
+// ScimTokenHashGTE applies the GTE predicate on the "scim_token_hash" field.
+func ScimTokenHashGTE(v string) predicate.Tenant {
+	return predicate.Tenant(sql.FieldGTE(FieldScimTokenHash, v))
+}
+
+// ScimTokenHashLT applies the LT predicate on the "scim_token_hash" field.
+func ScimTokenHashLT(v string) predicate.Tenant {
+	return predicate.Tenant(sql.FieldLT(FieldScimTokenHash, v))
+}
+
+// ScimTokenHashLTE applies the LTE predicate on the "scim_token_hash" field.
+func ScimTokenHashLTE(v string) predicate.Tenant {
+	return predicate.Tenant(sql.FieldLTE(FieldScimTokenHash, v))
+}
+
+// ScimTokenHashContains applies the Contains predicate on the "scim_token_hash" field.
+func ScimTokenHashContains(v string) predicate.Tenant {
+	return predicate.Tenant(sql.FieldContains(FieldScimTokenHash, v))
+}
+
+// ScimTokenHashHasPrefix applies the HasPrefix predicate on the "scim_token_hash" field.
+func ScimTokenHashHasPrefix(v string) predicate.Tenant {
+	return predicate.Tenant(sql.FieldHasPrefix(FieldScimTokenHash, v))
+}
+
+// ScimTokenHashHasSuffix applies the HasSuffix predicate on the "scim_token_hash" field.
+func ScimTokenHashHasSuffix(v string) predicate.Tenant {
+	return predicate.Tenant(sql.FieldHasSuffix(FieldScimTokenHash, v))
+}
+
+// ScimTokenHashIsNil applies the IsNil predicate on the "scim_token_hash" field.
+func ScimTokenHashIsNil() predicate.Tenant {
+	return predicate.Tenant(sql.FieldIsNull(FieldScimTokenHash))
+}
+
+// ScimTokenHashNotNil applies the NotNil predicate on the "scim_token_hash" field.
+func ScimTokenHashNotNil() predicate.Tenant {
+	return predicate.Tenant(sql.FieldNotNull(FieldScimTokenHash))
+}
+
+// ScimTokenHashEqualFold applies the EqualFold predicate on the "scim_token_hash" field.
+func ScimTokenHashEqualFold(v string) predicate.Tenant {
+	return predicate.Tenant(sql.FieldEqualFold(FieldScimTokenHash, v))
+}
+
+// ScimTokenHashContainsFold applies the ContainsFold predicate on the "scim_token_hash" field.
+func ScimTokenHashContainsFold(v string) predicate.Tenant {
+	return predicate.Tenant(sql.FieldContainsFold(FieldScimTokenHash, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Tenant {
 	return predicate.Tenant(sql.FieldEQ(FieldCreatedAt, v))
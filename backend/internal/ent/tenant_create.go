@@ -33,6 +33,76 @@ func (_c *TenantCreate) SetSlug(v string) *TenantCreate {
 	return _c
 }
 
+// SetMagicLinkEnabled sets the "magic_link_enabled" field.
+func (_c *TenantCreate) SetMagicLinkEnabled(v bool) *TenantCreate {
+	_c.mutation.SetMagicLinkEnabled(v)
+	return _c
+}
+
+// SetNillableMagicLinkEnabled sets the "magic_link_enabled" field if the given value is not nil.
+func (_c *TenantCreate) SetNillableMagicLinkEnabled(v *bool) *TenantCreate {
+	if v != nil {
+		_c.SetMagicLinkEnabled(*v)
+	}
+	return _c
+}
+
+// SetAccountDeletionPolicy sets the "account_deletion_policy" field.
+func (_c *TenantCreate) SetAccountDeletionPolicy(v tenant.AccountDeletionPolicy) *TenantCreate {
+	_c.mutation.SetAccountDeletionPolicy(v)
+	return _c
+}
+
+// SetNillableAccountDeletionPolicy sets the "account_deletion_policy" field if the given value is not nil.
+func (_c *TenantCreate) SetNillableAccountDeletionPolicy(v *tenant.AccountDeletionPolicy) *TenantCreate {
+	if v != nil {
+		_c.SetAccountDeletionPolicy(*v)
+	}
+	return _c
+}
+
+// SetSearchLanguage sets the "search_language" field.
+func (_c *TenantCreate) SetSearchLanguage(v tenant.SearchLanguage) *TenantCreate {
+	_c.mutation.SetSearchLanguage(v)
+	return _c
+}
+
+// SetNillableSearchLanguage sets the "search_language" field if the given value is not nil.
+func (_c *TenantCreate) SetNillableSearchLanguage(v *tenant.SearchLanguage) *TenantCreate {
+	if v != nil {
+		_c.SetSearchLanguage(*v)
+	}
+	return _c
+}
+
+// SetTrashRetentionDays sets the "trash_retention_days" field.
+func (_c *TenantCreate) SetTrashRetentionDays(v int) *TenantCreate {
+	_c.mutation.SetTrashRetentionDays(v)
+	return _c
+}
+
+// SetNillableTrashRetentionDays sets the "trash_retention_days" field if the given value is not nil.
+func (_c *TenantCreate) SetNillableTrashRetentionDays(v *int) *TenantCreate {
+	if v != nil {
+		_c.SetTrashRetentionDays(*v)
+	}
+	return _c
+}
+
+// SetScimTokenHash sets the "scim_token_hash" field.
+func (_c *TenantCreate) SetScimTokenHash(v string) *TenantCreate {
+	_c.mutation.SetScimTokenHash(v)
+	return _c
+}
+
+// SetNillableScimTokenHash sets the "scim_token_hash" field if the given value is not nil.
+func (_c *TenantCreate) SetNillableScimTokenHash(v *string) *TenantCreate {
+	if v != nil {
+		_c.SetScimTokenHash(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *TenantCreate) SetCreatedAt(v time.Time) *TenantCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -117,6 +187,22 @@ func (_c *TenantCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *TenantCreate) defaults() {
+	if _, ok := _c.mutation.MagicLinkEnabled(); !ok {
+		v := tenant.DefaultMagicLinkEnabled
+		_c.mutation.SetMagicLinkEnabled(v)
+	}
+	if _, ok := _c.mutation.AccountDeletionPolicy(); !ok {
+		v := tenant.DefaultAccountDeletionPolicy
+		_c.mutation.SetAccountDeletionPolicy(v)
+	}
+	if _, ok := _c.mutation.SearchLanguage(); !ok {
+		v := tenant.DefaultSearchLanguage
+		_c.mutation.SetSearchLanguage(v)
+	}
+	if _, ok := _c.mutation.TrashRetentionDays(); !ok {
+		v := tenant.DefaultTrashRetentionDays
+		_c.mutation.SetTrashRetentionDays(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := tenant.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -145,6 +231,33 @@ func (_c *TenantCreate) check() error {
 			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "Tenant.slug": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.MagicLinkEnabled(); !ok {
+		return &ValidationError{Name: "magic_link_enabled", err: errors.New(`ent: missing required field "Tenant.magic_link_enabled"`)}
+	}
+	if _, ok := _c.mutation.AccountDeletionPolicy(); !ok {
+		return &ValidationError{Name: "account_deletion_policy", err: errors.New(`ent: missing required field "Tenant.account_deletion_policy"`)}
+	}
+	if v, ok := _c.mutation.AccountDeletionPolicy(); ok {
+		if err := tenant.AccountDeletionPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "account_deletion_policy", err: fmt.Errorf(`ent: validator failed for field "Tenant.account_deletion_policy": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SearchLanguage(); !ok {
+		return &ValidationError{Name: "search_language", err: errors.New(`ent: missing required field "Tenant.search_language"`)}
+	}
+	if v, ok := _c.mutation.SearchLanguage(); ok {
+		if err := tenant.SearchLanguageValidator(v); err != nil {
+			return &ValidationError{Name: "search_language", err: fmt.Errorf(`ent: validator failed for field "Tenant.search_language": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TrashRetentionDays(); !ok {
+		return &ValidationError{Name: "trash_retention_days", err: errors.New(`ent: missing required field "Tenant.trash_retention_days"`)}
+	}
+	if v, ok := _c.mutation.TrashRetentionDays(); ok {
+		if err := tenant.TrashRetentionDaysValidator(v); err != nil {
+			return &ValidationError{Name: "trash_retention_days", err: fmt.Errorf(`ent: validator failed for field "Tenant.trash_retention_days": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Tenant.created_at"`)}
 	}
@@ -199,6 +312,26 @@ func (_c *TenantCreate) createSpec() (*Tenant, *sqlgraph.CreateSpec) {
 		_spec.SetField(tenant.FieldSlug, field.TypeString, value)
 		_node.Slug = value
 	}
+	if value, ok := _c.mutation.MagicLinkEnabled(); ok {
+		_spec.SetField(tenant.FieldMagicLinkEnabled, field.TypeBool, value)
+		_node.MagicLinkEnabled = value
+	}
+	if value, ok := _c.mutation.AccountDeletionPolicy(); ok {
+		_spec.SetField(tenant.FieldAccountDeletionPolicy, field.TypeEnum, value)
+		_node.AccountDeletionPolicy = value
+	}
+	if value, ok := _c.mutation.SearchLanguage(); ok {
+		_spec.SetField(tenant.FieldSearchLanguage, field.TypeEnum, value)
+		_node.SearchLanguage = value
+	}
+	if value, ok := _c.mutation.TrashRetentionDays(); ok {
+		_spec.SetField(tenant.FieldTrashRetentionDays, field.TypeInt, value)
+		_node.TrashRetentionDays = value
+	}
+	if value, ok := _c.mutation.ScimTokenHash(); ok {
+		_spec.SetField(tenant.FieldScimTokenHash, field.TypeString, value)
+		_node.ScimTokenHash = &value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(tenant.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -57,6 +57,89 @@ func (_u *TenantUpdate) SetNillableSlug(v *string) *TenantUpdate {
 	return _u
 }
 
+// SetMagicLinkEnabled sets the "magic_link_enabled" field.
+func (_u *TenantUpdate) SetMagicLinkEnabled(v bool) *TenantUpdate {
+	_u.mutation.SetMagicLinkEnabled(v)
+	return _u
+}
+
+// SetNillableMagicLinkEnabled sets the "magic_link_enabled" field if the given value is not nil.
+func (_u *TenantUpdate) SetNillableMagicLinkEnabled(v *bool) *TenantUpdate {
+	if v != nil {
+		_u.SetMagicLinkEnabled(*v)
+	}
+	return _u
+}
+
+// SetAccountDeletionPolicy sets the "account_deletion_policy" field.
+func (_u *TenantUpdate) SetAccountDeletionPolicy(v tenant.AccountDeletionPolicy) *TenantUpdate {
+	_u.mutation.SetAccountDeletionPolicy(v)
+	return _u
+}
+
+// SetNillableAccountDeletionPolicy sets the "account_deletion_policy" field if the given value is not nil.
+func (_u *TenantUpdate) SetNillableAccountDeletionPolicy(v *tenant.AccountDeletionPolicy) *TenantUpdate {
+	if v != nil {
+		_u.SetAccountDeletionPolicy(*v)
+	}
+	return _u
+}
+
+// SetSearchLanguage sets the "search_language" field.
+func (_u *TenantUpdate) SetSearchLanguage(v tenant.SearchLanguage) *TenantUpdate {
+	_u.mutation.SetSearchLanguage(v)
+	return _u
+}
+
+// SetNillableSearchLanguage sets the "search_language" field if the given value is not nil.
+func (_u *TenantUpdate) SetNillableSearchLanguage(v *tenant.SearchLanguage) *TenantUpdate {
+	if v != nil {
+		_u.SetSearchLanguage(*v)
+	}
+	return _u
+}
+
+// SetTrashRetentionDays sets the "trash_retention_days" field.
+func (_u *TenantUpdate) SetTrashRetentionDays(v int) *TenantUpdate {
+	_u.mutation.ResetTrashRetentionDays()
+	_u.mutation.SetTrashRetentionDays(v)
+	return _u
+}
+
+// SetNillableTrashRetentionDays sets the "trash_retention_days" field if the given value is not nil.
+func (_u *TenantUpdate) SetNillableTrashRetentionDays(v *int) *TenantUpdate {
+	if v != nil {
+		_u.SetTrashRetentionDays(*v)
+	}
+	return _u
+}
+
+// AddTrashRetentionDays adds value to the "trash_retention_days" field.
+func (_u *TenantUpdate) AddTrashRetentionDays(v int) *TenantUpdate {
+	_u.mutation.AddTrashRetentionDays(v)
+	return _u
+}
+
+// SetScimTokenHash sets the "scim_token_hash" field.
+func (_u *TenantUpdate) SetScimTokenHash(v string) *TenantUpdate {
+	_u.mutation.SetScimTokenHash(v)
+	return _u
+}
+
+// SetNillableScimTokenHash sets the "scim_token_hash" field if the given value is not nil.
+func (_u *TenantUpdate) SetNillableScimTokenHash(v *string) *TenantUpdate {
+	if v != nil {
+		_u.SetScimTokenHash(*v)
+	}
+	return _u
+}
+
+// ClearScimTokenHash clears the value of the "scim_token_hash" field.
+func (_u *TenantUpdate) ClearScimTokenHash() *TenantUpdate {
+	_u.mutation.ClearScimTokenHash()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *TenantUpdate) SetUpdatedAt(v time.Time) *TenantUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -152,6 +235,21 @@ func (_u *TenantUpdate) check() error {
 			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "Tenant.slug": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.AccountDeletionPolicy(); ok {
+		if err := tenant.AccountDeletionPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "account_deletion_policy", err: fmt.Errorf(`ent: validator failed for field "Tenant.account_deletion_policy": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SearchLanguage(); ok {
+		if err := tenant.SearchLanguageValidator(v); err != nil {
+			return &ValidationError{Name: "search_language", err: fmt.Errorf(`ent: validator failed for field "Tenant.search_language": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TrashRetentionDays(); ok {
+		if err := tenant.TrashRetentionDaysValidator(v); err != nil {
+			return &ValidationError{Name: "trash_retention_days", err: fmt.Errorf(`ent: validator failed for field "Tenant.trash_retention_days": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -173,6 +271,27 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Slug(); ok {
 		_spec.SetField(tenant.FieldSlug, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.MagicLinkEnabled(); ok {
+		_spec.SetField(tenant.FieldMagicLinkEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AccountDeletionPolicy(); ok {
+		_spec.SetField(tenant.FieldAccountDeletionPolicy, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.SearchLanguage(); ok {
+		_spec.SetField(tenant.FieldSearchLanguage, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.TrashRetentionDays(); ok {
+		_spec.SetField(tenant.FieldTrashRetentionDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTrashRetentionDays(); ok {
+		_spec.AddField(tenant.FieldTrashRetentionDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ScimTokenHash(); ok {
+		_spec.SetField(tenant.FieldScimTokenHash, field.TypeString, value)
+	}
+	if _u.mutation.ScimTokenHashCleared() {
+		_spec.ClearField(tenant.FieldScimTokenHash, field.TypeString)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(tenant.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -269,6 +388,89 @@ func (_u *TenantUpdateOne) SetNillableSlug(v *string) *TenantUpdateOne {
 	return _u
 }
 
+// SetMagicLinkEnabled sets the "magic_link_enabled" field.
+func (_u *TenantUpdateOne) SetMagicLinkEnabled(v bool) *TenantUpdateOne {
+	_u.mutation.SetMagicLinkEnabled(v)
+	return _u
+}
+
+// SetNillableMagicLinkEnabled sets the "magic_link_enabled" field if the given value is not nil.
+func (_u *TenantUpdateOne) SetNillableMagicLinkEnabled(v *bool) *TenantUpdateOne {
+	if v != nil {
+		_u.SetMagicLinkEnabled(*v)
+	}
+	return _u
+}
+
+// SetAccountDeletionPolicy sets the "account_deletion_policy" field.
+func (_u *TenantUpdateOne) SetAccountDeletionPolicy(v tenant.AccountDeletionPolicy) *TenantUpdateOne {
+	_u.mutation.SetAccountDeletionPolicy(v)
+	return _u
+}
+
+// SetNillableAccountDeletionPolicy sets the "account_deletion_policy" field if the given value is not nil.
+func (_u *TenantUpdateOne) SetNillableAccountDeletionPolicy(v *tenant.AccountDeletionPolicy) *TenantUpdateOne {
+	if v != nil {
+		_u.SetAccountDeletionPolicy(*v)
+	}
+	return _u
+}
+
+// SetSearchLanguage sets the "search_language" field.
+func (_u *TenantUpdateOne) SetSearchLanguage(v tenant.SearchLanguage) *TenantUpdateOne {
+	_u.mutation.SetSearchLanguage(v)
+	return _u
+}
+
+// SetNillableSearchLanguage sets the "search_language" field if the given value is not nil.
+func (_u *TenantUpdateOne) SetNillableSearchLanguage(v *tenant.SearchLanguage) *TenantUpdateOne {
+	if v != nil {
+		_u.SetSearchLanguage(*v)
+	}
+	return _u
+}
+
+// SetTrashRetentionDays sets the "trash_retention_days" field.
+func (_u *TenantUpdateOne) SetTrashRetentionDays(v int) *TenantUpdateOne {
+	_u.mutation.ResetTrashRetentionDays()
+	_u.mutation.SetTrashRetentionDays(v)
+	return _u
+}
+
+// SetNillableTrashRetentionDays sets the "trash_retention_days" field if the given value is not nil.
+func (_u *TenantUpdateOne) SetNillableTrashRetentionDays(v *int) *TenantUpdateOne {
+	if v != nil {
+		_u.SetTrashRetentionDays(*v)
+	}
+	return _u
+}
+
+// AddTrashRetentionDays adds value to the "trash_retention_days" field.
+func (_u *TenantUpdateOne) AddTrashRetentionDays(v int) *TenantUpdateOne {
+	_u.mutation.AddTrashRetentionDays(v)
+	return _u
+}
+
+// SetScimTokenHash sets the "scim_token_hash" field.
+func (_u *TenantUpdateOne) SetScimTokenHash(v string) *TenantUpdateOne {
+	_u.mutation.SetScimTokenHash(v)
+	return _u
+}
+
+// SetNillableScimTokenHash sets the "scim_token_hash" field if the given value is not nil.
+func (_u *TenantUpdateOne) SetNillableScimTokenHash(v *string) *TenantUpdateOne {
+	if v != nil {
+		_u.SetScimTokenHash(*v)
+	}
+	return _u
+}
+
+// ClearScimTokenHash clears the value of the "scim_token_hash" field.
+func (_u *TenantUpdateOne) ClearScimTokenHash() *TenantUpdateOne {
+	_u.mutation.ClearScimTokenHash()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *TenantUpdateOne) SetUpdatedAt(v time.Time) *TenantUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
@@ -377,6 +579,21 @@ func (_u *TenantUpdateOne) check() error {
 			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "Tenant.slug": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.AccountDeletionPolicy(); ok {
+		if err := tenant.AccountDeletionPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "account_deletion_policy", err: fmt.Errorf(`ent: validator failed for field "Tenant.account_deletion_policy": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SearchLanguage(); ok {
+		if err := tenant.SearchLanguageValidator(v); err != nil {
+			return &ValidationError{Name: "search_language", err: fmt.Errorf(`ent: validator failed for field "Tenant.search_language": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TrashRetentionDays(); ok {
+		if err := tenant.TrashRetentionDaysValidator(v); err != nil {
+			return &ValidationError{Name: "trash_retention_days", err: fmt.Errorf(`ent: validator failed for field "Tenant.trash_retention_days": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -415,6 +632,27 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 	if value, ok := _u.mutation.Slug(); ok {
 		_spec.SetField(tenant.FieldSlug, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.MagicLinkEnabled(); ok {
+		_spec.SetField(tenant.FieldMagicLinkEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AccountDeletionPolicy(); ok {
+		_spec.SetField(tenant.FieldAccountDeletionPolicy, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.SearchLanguage(); ok {
+		_spec.SetField(tenant.FieldSearchLanguage, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.TrashRetentionDays(); ok {
+		_spec.SetField(tenant.FieldTrashRetentionDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTrashRetentionDays(); ok {
+		_spec.AddField(tenant.FieldTrashRetentionDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ScimTokenHash(); ok {
+		_spec.SetField(tenant.FieldScimTokenHash, field.TypeString, value)
+	}
+	if _u.mutation.ScimTokenHashCleared() {
+		_spec.ClearField(tenant.FieldScimTokenHash, field.TypeString)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(tenant.FieldUpdatedAt, field.TypeTime, value)
 	}
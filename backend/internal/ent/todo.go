@@ -4,6 +4,7 @@ package ent
 
 import (
 	"fmt"
+	"good-todo-go/internal/ent/project"
 	"good-todo-go/internal/ent/todo"
 	"good-todo-go/internal/ent/user"
 	"strings"
@@ -20,8 +21,14 @@ type Todo struct {
 	ID string `json:"id,omitempty"`
 	// TenantID holds the value of the "tenant_id" field.
 	TenantID string `json:"tenant_id,omitempty"`
-	// UserID holds the value of the "user_id" field.
+	// Owner; only changes when an account is deleted and its public todos go to an heir
 	UserID string `json:"user_id,omitempty"`
+	// Member of the same tenant who may view and complete the todo; user_id stays the creator
+	AssigneeID *string `json:"assignee_id,omitempty"`
+	// ProjectID holds the value of the "project_id" field.
+	ProjectID *string `json:"project_id,omitempty"`
+	// Parent todo when this todo is a subtask; always owned by the same user
+	ParentID *string `json:"parent_id,omitempty"`
 	// Title holds the value of the "title" field.
 	Title string `json:"title,omitempty"`
 	// Description holds the value of the "description" field.
@@ -30,10 +37,24 @@ type Todo struct {
 	Completed bool `json:"completed,omitempty"`
 	// If true, visible to all users in the same tenant
 	IsPublic bool `json:"is_public,omitempty"`
+	// Index into model.TodoPriorities, from none (0) to urgent (4)
+	Priority int `json:"priority,omitempty"`
+	// Fractional index key in the owner's manual order; compared byte by byte
+	Position string `json:"position,omitempty"`
 	// DueDate holds the value of the "due_date" field.
 	DueDate *time.Time `json:"due_date,omitempty"`
 	// CompletedAt holds the value of the "completed_at" field.
 	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	// RFC 5545 RRULE in canonical form; set on the open occurrence of a series
+	RecurrenceRule *string `json:"recurrence_rule,omitempty"`
+	// IANA timezone whose wall clock the rule is evaluated in
+	RecurrenceTimezone *string `json:"recurrence_timezone,omitempty"`
+	// Due date of the first occurrence (DTSTART); the rule is anchored on it
+	RecurrenceStart *time.Time `json:"recurrence_start,omitempty"`
+	// Set while the todo is in the trash; queries leave such todos out unless asked
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Incremented by every update; writes based on an older read check it and fail
+	Version int `json:"version,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -48,9 +69,33 @@ type Todo struct {
 type TodoEdges struct {
 	// User holds the value of the user edge.
 	User *User `json:"user,omitempty"`
+	// Assignee holds the value of the assignee edge.
+	Assignee *User `json:"assignee,omitempty"`
+	// Project holds the value of the project edge.
+	Project *Project `json:"project,omitempty"`
+	// Parent holds the value of the parent edge.
+	Parent *Todo `json:"parent,omitempty"`
+	// Children holds the value of the children edge.
+	Children []*Todo `json:"children,omitempty"`
+	// Tags holds the value of the tags edge.
+	Tags []*Tag `json:"tags,omitempty"`
+	// Reminders holds the value of the reminders edge.
+	Reminders []*Reminder `json:"reminders,omitempty"`
+	// Assignments holds the value of the assignments edge.
+	Assignments []*TodoAssignment `json:"assignments,omitempty"`
+	// Comments holds the value of the comments edge.
+	Comments []*TodoComment `json:"comments,omitempty"`
+	// Attachments holds the value of the attachments edge.
+	Attachments []*TodoAttachment `json:"attachments,omitempty"`
+	// Shares holds the value of the shares edge.
+	Shares []*TodoShare `json:"shares,omitempty"`
+	// Notifications holds the value of the notifications edge.
+	Notifications []*Notification `json:"notifications,omitempty"`
+	// TodoTags holds the value of the todo_tags edge.
+	TodoTags []*TodoTag `json:"todo_tags,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [13]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -64,6 +109,120 @@ func (e TodoEdges) UserOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "user"}
 }
 
+// AssigneeOrErr returns the Assignee value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TodoEdges) AssigneeOrErr() (*User, error) {
+	if e.Assignee != nil {
+		return e.Assignee, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "assignee"}
+}
+
+// ProjectOrErr returns the Project value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TodoEdges) ProjectOrErr() (*Project, error) {
+	if e.Project != nil {
+		return e.Project, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: project.Label}
+	}
+	return nil, &NotLoadedError{edge: "project"}
+}
+
+// ParentOrErr returns the Parent value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TodoEdges) ParentOrErr() (*Todo, error) {
+	if e.Parent != nil {
+		return e.Parent, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: todo.Label}
+	}
+	return nil, &NotLoadedError{edge: "parent"}
+}
+
+// ChildrenOrErr returns the Children value or an error if the edge
+// was not loaded in eager-loading.
+func (e TodoEdges) ChildrenOrErr() ([]*Todo, error) {
+	if e.loadedTypes[4] {
+		return e.Children, nil
+	}
+	return nil, &NotLoadedError{edge: "children"}
+}
+
+// TagsOrErr returns the Tags value or an error if the edge
+// was not loaded in eager-loading.
+func (e TodoEdges) TagsOrErr() ([]*Tag, error) {
+	if e.loadedTypes[5] {
+		return e.Tags, nil
+	}
+	return nil, &NotLoadedError{edge: "tags"}
+}
+
+// RemindersOrErr returns the Reminders value or an error if the edge
+// was not loaded in eager-loading.
+func (e TodoEdges) RemindersOrErr() ([]*Reminder, error) {
+	if e.loadedTypes[6] {
+		return e.Reminders, nil
+	}
+	return nil, &NotLoadedError{edge: "reminders"}
+}
+
+// AssignmentsOrErr returns the Assignments value or an error if the edge
+// was not loaded in eager-loading.
+func (e TodoEdges) AssignmentsOrErr() ([]*TodoAssignment, error) {
+	if e.loadedTypes[7] {
+		return e.Assignments, nil
+	}
+	return nil, &NotLoadedError{edge: "assignments"}
+}
+
+// CommentsOrErr returns the Comments value or an error if the edge
+// was not loaded in eager-loading.
+func (e TodoEdges) CommentsOrErr() ([]*TodoComment, error) {
+	if e.loadedTypes[8] {
+		return e.Comments, nil
+	}
+	return nil, &NotLoadedError{edge: "comments"}
+}
+
+// AttachmentsOrErr returns the Attachments value or an error if the edge
+// was not loaded in eager-loading.
+func (e TodoEdges) AttachmentsOrErr() ([]*TodoAttachment, error) {
+	if e.loadedTypes[9] {
+		return e.Attachments, nil
+	}
+	return nil, &NotLoadedError{edge: "attachments"}
+}
+
+// SharesOrErr returns the Shares value or an error if the edge
+// was not loaded in eager-loading.
+func (e TodoEdges) SharesOrErr() ([]*TodoShare, error) {
+	if e.loadedTypes[10] {
+		return e.Shares, nil
+	}
+	return nil, &NotLoadedError{edge: "shares"}
+}
+
+// NotificationsOrErr returns the Notifications value or an error if the edge
+// was not loaded in eager-loading.
+func (e TodoEdges) NotificationsOrErr() ([]*Notification, error) {
+	if e.loadedTypes[11] {
+		return e.Notifications, nil
+	}
+	return nil, &NotLoadedError{edge: "notifications"}
+}
+
+// TodoTagsOrErr returns the TodoTags value or an error if the edge
+// was not loaded in eager-loading.
+func (e TodoEdges) TodoTagsOrErr() ([]*TodoTag, error) {
+	if e.loadedTypes[12] {
+		return e.TodoTags, nil
+	}
+	return nil, &NotLoadedError{edge: "todo_tags"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Todo) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -71,9 +230,11 @@ func (*Todo) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case todo.FieldCompleted, todo.FieldIsPublic:
 			values[i] = new(sql.NullBool)
-		case todo.FieldID, todo.FieldTenantID, todo.FieldUserID, todo.FieldTitle, todo.FieldDescription:
+		case todo.FieldPriority, todo.FieldVersion:
+			values[i] = new(sql.NullInt64)
+		case todo.FieldID, todo.FieldTenantID, todo.FieldUserID, todo.FieldAssigneeID, todo.FieldProjectID, todo.FieldParentID, todo.FieldTitle, todo.FieldDescription, todo.FieldPosition, todo.FieldRecurrenceRule, todo.FieldRecurrenceTimezone:
 			values[i] = new(sql.NullString)
-		case todo.FieldDueDate, todo.FieldCompletedAt, todo.FieldCreatedAt, todo.FieldUpdatedAt:
+		case todo.FieldDueDate, todo.FieldCompletedAt, todo.FieldRecurrenceStart, todo.FieldDeletedAt, todo.FieldCreatedAt, todo.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -108,6 +269,27 @@ func (_m *Todo) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.UserID = value.String
 			}
+		case todo.FieldAssigneeID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field assignee_id", values[i])
+			} else if value.Valid {
+				_m.AssigneeID = new(string)
+				*_m.AssigneeID = value.String
+			}
+		case todo.FieldProjectID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field project_id", values[i])
+			} else if value.Valid {
+				_m.ProjectID = new(string)
+				*_m.ProjectID = value.String
+			}
+		case todo.FieldParentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_id", values[i])
+			} else if value.Valid {
+				_m.ParentID = new(string)
+				*_m.ParentID = value.String
+			}
 		case todo.FieldTitle:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field title", values[i])
@@ -132,6 +314,18 @@ func (_m *Todo) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.IsPublic = value.Bool
 			}
+		case todo.FieldPriority:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
+			} else if value.Valid {
+				_m.Priority = int(value.Int64)
+			}
+		case todo.FieldPosition:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				_m.Position = value.String
+			}
 		case todo.FieldDueDate:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field due_date", values[i])
@@ -146,6 +340,40 @@ func (_m *Todo) assignValues(columns []string, values []any) error {
 				_m.CompletedAt = new(time.Time)
 				*_m.CompletedAt = value.Time
 			}
+		case todo.FieldRecurrenceRule:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field recurrence_rule", values[i])
+			} else if value.Valid {
+				_m.RecurrenceRule = new(string)
+				*_m.RecurrenceRule = value.String
+			}
+		case todo.FieldRecurrenceTimezone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field recurrence_timezone", values[i])
+			} else if value.Valid {
+				_m.RecurrenceTimezone = new(string)
+				*_m.RecurrenceTimezone = value.String
+			}
+		case todo.FieldRecurrenceStart:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field recurrence_start", values[i])
+			} else if value.Valid {
+				_m.RecurrenceStart = new(time.Time)
+				*_m.RecurrenceStart = value.Time
+			}
+		case todo.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case todo.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				_m.Version = int(value.Int64)
+			}
 		case todo.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -176,6 +404,66 @@ func (_m *Todo) QueryUser() *UserQuery {
 	return NewTodoClient(_m.config).QueryUser(_m)
 }
 
+// QueryAssignee queries the "assignee" edge of the Todo entity.
+func (_m *Todo) QueryAssignee() *UserQuery {
+	return NewTodoClient(_m.config).QueryAssignee(_m)
+}
+
+// QueryProject queries the "project" edge of the Todo entity.
+func (_m *Todo) QueryProject() *ProjectQuery {
+	return NewTodoClient(_m.config).QueryProject(_m)
+}
+
+// QueryParent queries the "parent" edge of the Todo entity.
+func (_m *Todo) QueryParent() *TodoQuery {
+	return NewTodoClient(_m.config).QueryParent(_m)
+}
+
+// QueryChildren queries the "children" edge of the Todo entity.
+func (_m *Todo) QueryChildren() *TodoQuery {
+	return NewTodoClient(_m.config).QueryChildren(_m)
+}
+
+// QueryTags queries the "tags" edge of the Todo entity.
+func (_m *Todo) QueryTags() *TagQuery {
+	return NewTodoClient(_m.config).QueryTags(_m)
+}
+
+// QueryReminders queries the "reminders" edge of the Todo entity.
+func (_m *Todo) QueryReminders() *ReminderQuery {
+	return NewTodoClient(_m.config).QueryReminders(_m)
+}
+
+// QueryAssignments queries the "assignments" edge of the Todo entity.
+func (_m *Todo) QueryAssignments() *TodoAssignmentQuery {
+	return NewTodoClient(_m.config).QueryAssignments(_m)
+}
+
+// QueryComments queries the "comments" edge of the Todo entity.
+func (_m *Todo) QueryComments() *TodoCommentQuery {
+	return NewTodoClient(_m.config).QueryComments(_m)
+}
+
+// QueryAttachments queries the "attachments" edge of the Todo entity.
+func (_m *Todo) QueryAttachments() *TodoAttachmentQuery {
+	return NewTodoClient(_m.config).QueryAttachments(_m)
+}
+
+// QueryShares queries the "shares" edge of the Todo entity.
+func (_m *Todo) QueryShares() *TodoShareQuery {
+	return NewTodoClient(_m.config).QueryShares(_m)
+}
+
+// QueryNotifications queries the "notifications" edge of the Todo entity.
+func (_m *Todo) QueryNotifications() *NotificationQuery {
+	return NewTodoClient(_m.config).QueryNotifications(_m)
+}
+
+// QueryTodoTags queries the "todo_tags" edge of the Todo entity.
+func (_m *Todo) QueryTodoTags() *TodoTagQuery {
+	return NewTodoClient(_m.config).QueryTodoTags(_m)
+}
+
 // Update returns a builder for updating this Todo.
 // Note that you need to call Todo.Unwrap() before calling this method if this Todo
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -205,6 +493,21 @@ func (_m *Todo) String() string {
 	builder.WriteString("user_id=")
 	builder.WriteString(_m.UserID)
 	builder.WriteString(", ")
+	if v := _m.AssigneeID; v != nil {
+		builder.WriteString("assignee_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.ProjectID; v != nil {
+		builder.WriteString("project_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.ParentID; v != nil {
+		builder.WriteString("parent_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("title=")
 	builder.WriteString(_m.Title)
 	builder.WriteString(", ")
@@ -217,6 +520,12 @@ func (_m *Todo) String() string {
 	builder.WriteString("is_public=")
 	builder.WriteString(fmt.Sprintf("%v", _m.IsPublic))
 	builder.WriteString(", ")
+	builder.WriteString("priority=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Priority))
+	builder.WriteString(", ")
+	builder.WriteString("position=")
+	builder.WriteString(_m.Position)
+	builder.WriteString(", ")
 	if v := _m.DueDate; v != nil {
 		builder.WriteString("due_date=")
 		builder.WriteString(v.Format(time.ANSIC))
@@ -227,6 +536,29 @@ func (_m *Todo) String() string {
 		builder.WriteString(v.Format(time.ANSIC))
 	}
 	builder.WriteString(", ")
+	if v := _m.RecurrenceRule; v != nil {
+		builder.WriteString("recurrence_rule=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.RecurrenceTimezone; v != nil {
+		builder.WriteString("recurrence_timezone=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.RecurrenceStart; v != nil {
+		builder.WriteString("recurrence_start=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Version))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -6,8 +6,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"good-todo-go/internal/ent/notification"
 	"good-todo-go/internal/ent/predicate"
+	"good-todo-go/internal/ent/project"
+	"good-todo-go/internal/ent/reminder"
+	"good-todo-go/internal/ent/tag"
 	"good-todo-go/internal/ent/todo"
+	"good-todo-go/internal/ent/todoassignment"
+	"good-todo-go/internal/ent/todoattachment"
+	"good-todo-go/internal/ent/todocomment"
+	"good-todo-go/internal/ent/todoshare"
+	"good-todo-go/internal/ent/user"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -28,6 +37,80 @@ func (_u *TodoUpdate) Where(ps ...predicate.Todo) *TodoUpdate {
 	return _u
 }
 
+// SetUserID sets the "user_id" field.
+func (_u *TodoUpdate) SetUserID(v string) *TodoUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *TodoUpdate) SetNillableUserID(v *string) *TodoUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetAssigneeID sets the "assignee_id" field.
+func (_u *TodoUpdate) SetAssigneeID(v string) *TodoUpdate {
+	_u.mutation.SetAssigneeID(v)
+	return _u
+}
+
+// SetNillableAssigneeID sets the "assignee_id" field if the given value is not nil.
+func (_u *TodoUpdate) SetNillableAssigneeID(v *string) *TodoUpdate {
+	if v != nil {
+		_u.SetAssigneeID(*v)
+	}
+	return _u
+}
+
+// ClearAssigneeID clears the value of the "assignee_id" field.
+func (_u *TodoUpdate) ClearAssigneeID() *TodoUpdate {
+	_u.mutation.ClearAssigneeID()
+	return _u
+}
+
+// SetProjectID sets the "project_id" field.
+func (_u *TodoUpdate) SetProjectID(v string) *TodoUpdate {
+	_u.mutation.SetProjectID(v)
+	return _u
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (_u *TodoUpdate) SetNillableProjectID(v *string) *TodoUpdate {
+	if v != nil {
+		_u.SetProjectID(*v)
+	}
+	return _u
+}
+
+// ClearProjectID clears the value of the "project_id" field.
+func (_u *TodoUpdate) ClearProjectID() *TodoUpdate {
+	_u.mutation.ClearProjectID()
+	return _u
+}
+
+// SetParentID sets the "parent_id" field.
+func (_u *TodoUpdate) SetParentID(v string) *TodoUpdate {
+	_u.mutation.SetParentID(v)
+	return _u
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (_u *TodoUpdate) SetNillableParentID(v *string) *TodoUpdate {
+	if v != nil {
+		_u.SetParentID(*v)
+	}
+	return _u
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (_u *TodoUpdate) ClearParentID() *TodoUpdate {
+	_u.mutation.ClearParentID()
+	return _u
+}
+
 // SetTitle sets the "title" field.
 func (_u *TodoUpdate) SetTitle(v string) *TodoUpdate {
 	_u.mutation.SetTitle(v)
@@ -90,6 +173,41 @@ func (_u *TodoUpdate) SetNillableIsPublic(v *bool) *TodoUpdate {
 	return _u
 }
 
+// SetPriority sets the "priority" field.
+func (_u *TodoUpdate) SetPriority(v int) *TodoUpdate {
+	_u.mutation.ResetPriority()
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *TodoUpdate) SetNillablePriority(v *int) *TodoUpdate {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// AddPriority adds value to the "priority" field.
+func (_u *TodoUpdate) AddPriority(v int) *TodoUpdate {
+	_u.mutation.AddPriority(v)
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *TodoUpdate) SetPosition(v string) *TodoUpdate {
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *TodoUpdate) SetNillablePosition(v *string) *TodoUpdate {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
 // SetDueDate sets the "due_date" field.
 func (_u *TodoUpdate) SetDueDate(v time.Time) *TodoUpdate {
 	_u.mutation.SetDueDate(v)
@@ -130,239 +248,1762 @@ func (_u *TodoUpdate) ClearCompletedAt() *TodoUpdate {
 	return _u
 }
 
+// SetRecurrenceRule sets the "recurrence_rule" field.
+func (_u *TodoUpdate) SetRecurrenceRule(v string) *TodoUpdate {
+	_u.mutation.SetRecurrenceRule(v)
+	return _u
+}
+
+// SetNillableRecurrenceRule sets the "recurrence_rule" field if the given value is not nil.
+func (_u *TodoUpdate) SetNillableRecurrenceRule(v *string) *TodoUpdate {
+	if v != nil {
+		_u.SetRecurrenceRule(*v)
+	}
+	return _u
+}
+
+// ClearRecurrenceRule clears the value of the "recurrence_rule" field.
+func (_u *TodoUpdate) ClearRecurrenceRule() *TodoUpdate {
+	_u.mutation.ClearRecurrenceRule()
+	return _u
+}
+
+// SetRecurrenceTimezone sets the "recurrence_timezone" field.
+func (_u *TodoUpdate) SetRecurrenceTimezone(v string) *TodoUpdate {
+	_u.mutation.SetRecurrenceTimezone(v)
+	return _u
+}
+
+// SetNillableRecurrenceTimezone sets the "recurrence_timezone" field if the given value is not nil.
+func (_u *TodoUpdate) SetNillableRecurrenceTimezone(v *string) *TodoUpdate {
+	if v != nil {
+		_u.SetRecurrenceTimezone(*v)
+	}
+	return _u
+}
+
+// ClearRecurrenceTimezone clears the value of the "recurrence_timezone" field.
+func (_u *TodoUpdate) ClearRecurrenceTimezone() *TodoUpdate {
+	_u.mutation.ClearRecurrenceTimezone()
+	return _u
+}
+
+// SetRecurrenceStart sets the "recurrence_start" field.
+func (_u *TodoUpdate) SetRecurrenceStart(v time.Time) *TodoUpdate {
+	_u.mutation.SetRecurrenceStart(v)
+	return _u
+}
+
+// SetNillableRecurrenceStart sets the "recurrence_start" field if the given value is not nil.
+func (_u *TodoUpdate) SetNillableRecurrenceStart(v *time.Time) *TodoUpdate {
+	if v != nil {
+		_u.SetRecurrenceStart(*v)
+	}
+	return _u
+}
+
+// ClearRecurrenceStart clears the value of the "recurrence_start" field.
+func (_u *TodoUpdate) ClearRecurrenceStart() *TodoUpdate {
+	_u.mutation.ClearRecurrenceStart()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *TodoUpdate) SetDeletedAt(v time.Time) *TodoUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *TodoUpdate) SetNillableDeletedAt(v *time.Time) *TodoUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *TodoUpdate) ClearDeletedAt() *TodoUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *TodoUpdate) SetVersion(v int) *TodoUpdate {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *TodoUpdate) SetNillableVersion(v *int) *TodoUpdate {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *TodoUpdate) AddVersion(v int) *TodoUpdate {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *TodoUpdate) SetUpdatedAt(v time.Time) *TodoUpdate {
 	_u.mutation.SetUpdatedAt(v)
 	return _u
 }
 
+// SetUser sets the "user" edge to the User entity.
+func (_u *TodoUpdate) SetUser(v *User) *TodoUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// SetAssignee sets the "assignee" edge to the User entity.
+func (_u *TodoUpdate) SetAssignee(v *User) *TodoUpdate {
+	return _u.SetAssigneeID(v.ID)
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (_u *TodoUpdate) SetProject(v *Project) *TodoUpdate {
+	return _u.SetProjectID(v.ID)
+}
+
+// SetParent sets the "parent" edge to the Todo entity.
+func (_u *TodoUpdate) SetParent(v *Todo) *TodoUpdate {
+	return _u.SetParentID(v.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Todo entity by IDs.
+func (_u *TodoUpdate) AddChildIDs(ids ...string) *TodoUpdate {
+	_u.mutation.AddChildIDs(ids...)
+	return _u
+}
+
+// AddChildren adds the "children" edges to the Todo entity.
+func (_u *TodoUpdate) AddChildren(v ...*Todo) *TodoUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddChildIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (_u *TodoUpdate) AddTagIDs(ids ...string) *TodoUpdate {
+	_u.mutation.AddTagIDs(ids...)
+	return _u
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (_u *TodoUpdate) AddTags(v ...*Tag) *TodoUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTagIDs(ids...)
+}
+
+// AddReminderIDs adds the "reminders" edge to the Reminder entity by IDs.
+func (_u *TodoUpdate) AddReminderIDs(ids ...string) *TodoUpdate {
+	_u.mutation.AddReminderIDs(ids...)
+	return _u
+}
+
+// AddReminders adds the "reminders" edges to the Reminder entity.
+func (_u *TodoUpdate) AddReminders(v ...*Reminder) *TodoUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReminderIDs(ids...)
+}
+
+// AddAssignmentIDs adds the "assignments" edge to the TodoAssignment entity by IDs.
+func (_u *TodoUpdate) AddAssignmentIDs(ids ...string) *TodoUpdate {
+	_u.mutation.AddAssignmentIDs(ids...)
+	return _u
+}
+
+// AddAssignments adds the "assignments" edges to the TodoAssignment entity.
+func (_u *TodoUpdate) AddAssignments(v ...*TodoAssignment) *TodoUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAssignmentIDs(ids...)
+}
+
+// AddCommentIDs adds the "comments" edge to the TodoComment entity by IDs.
+func (_u *TodoUpdate) AddCommentIDs(ids ...string) *TodoUpdate {
+	_u.mutation.AddCommentIDs(ids...)
+	return _u
+}
+
+// AddComments adds the "comments" edges to the TodoComment entity.
+func (_u *TodoUpdate) AddComments(v ...*TodoComment) *TodoUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCommentIDs(ids...)
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the TodoAttachment entity by IDs.
+func (_u *TodoUpdate) AddAttachmentIDs(ids ...string) *TodoUpdate {
+	_u.mutation.AddAttachmentIDs(ids...)
+	return _u
+}
+
+// AddAttachments adds the "attachments" edges to the TodoAttachment entity.
+func (_u *TodoUpdate) AddAttachments(v ...*TodoAttachment) *TodoUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAttachmentIDs(ids...)
+}
+
+// AddShareIDs adds the "shares" edge to the TodoShare entity by IDs.
+func (_u *TodoUpdate) AddShareIDs(ids ...string) *TodoUpdate {
+	_u.mutation.AddShareIDs(ids...)
+	return _u
+}
+
+// AddShares adds the "shares" edges to the TodoShare entity.
+func (_u *TodoUpdate) AddShares(v ...*TodoShare) *TodoUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddShareIDs(ids...)
+}
+
+// AddNotificationIDs adds the "notifications" edge to the Notification entity by IDs.
+func (_u *TodoUpdate) AddNotificationIDs(ids ...string) *TodoUpdate {
+	_u.mutation.AddNotificationIDs(ids...)
+	return _u
+}
+
+// AddNotifications adds the "notifications" edges to the Notification entity.
+func (_u *TodoUpdate) AddNotifications(v ...*Notification) *TodoUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddNotificationIDs(ids...)
+}
+
 // Mutation returns the TodoMutation object of the builder.
 func (_u *TodoUpdate) Mutation() *TodoMutation {
 	return _u.mutation
 }
 
-// Save executes the query and returns the number of nodes affected by the update operation.
-func (_u *TodoUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
-	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+// ClearUser clears the "user" edge to the User entity.
+func (_u *TodoUpdate) ClearUser() *TodoUpdate {
+	_u.mutation.ClearUser()
+	return _u
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (_u *TodoUpdate) SaveX(ctx context.Context) int {
-	affected, err := _u.Save(ctx)
-	if err != nil {
-		panic(err)
+// ClearAssignee clears the "assignee" edge to the User entity.
+func (_u *TodoUpdate) ClearAssignee() *TodoUpdate {
+	_u.mutation.ClearAssignee()
+	return _u
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (_u *TodoUpdate) ClearProject() *TodoUpdate {
+	_u.mutation.ClearProject()
+	return _u
+}
+
+// ClearParent clears the "parent" edge to the Todo entity.
+func (_u *TodoUpdate) ClearParent() *TodoUpdate {
+	_u.mutation.ClearParent()
+	return _u
+}
+
+// ClearChildren clears all "children" edges to the Todo entity.
+func (_u *TodoUpdate) ClearChildren() *TodoUpdate {
+	_u.mutation.ClearChildren()
+	return _u
+}
+
+// RemoveChildIDs removes the "children" edge to Todo entities by IDs.
+func (_u *TodoUpdate) RemoveChildIDs(ids ...string) *TodoUpdate {
+	_u.mutation.RemoveChildIDs(ids...)
+	return _u
+}
+
+// RemoveChildren removes "children" edges to Todo entities.
+func (_u *TodoUpdate) RemoveChildren(v ...*Todo) *TodoUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return affected
+	return _u.RemoveChildIDs(ids...)
 }
 
-// Exec executes the query.
-func (_u *TodoUpdate) Exec(ctx context.Context) error {
-	_, err := _u.Save(ctx)
-	return err
+// ClearTags clears all "tags" edges to the Tag entity.
+func (_u *TodoUpdate) ClearTags() *TodoUpdate {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (_u *TodoUpdate) RemoveTagIDs(ids ...string) *TodoUpdate {
+	_u.mutation.RemoveTagIDs(ids...)
+	return _u
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (_u *TodoUpdate) RemoveTags(v ...*Tag) *TodoUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTagIDs(ids...)
+}
+
+// ClearReminders clears all "reminders" edges to the Reminder entity.
+func (_u *TodoUpdate) ClearReminders() *TodoUpdate {
+	_u.mutation.ClearReminders()
+	return _u
+}
+
+// RemoveReminderIDs removes the "reminders" edge to Reminder entities by IDs.
+func (_u *TodoUpdate) RemoveReminderIDs(ids ...string) *TodoUpdate {
+	_u.mutation.RemoveReminderIDs(ids...)
+	return _u
+}
+
+// RemoveReminders removes "reminders" edges to Reminder entities.
+func (_u *TodoUpdate) RemoveReminders(v ...*Reminder) *TodoUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReminderIDs(ids...)
+}
+
+// ClearAssignments clears all "assignments" edges to the TodoAssignment entity.
+func (_u *TodoUpdate) ClearAssignments() *TodoUpdate {
+	_u.mutation.ClearAssignments()
+	return _u
+}
+
+// RemoveAssignmentIDs removes the "assignments" edge to TodoAssignment entities by IDs.
+func (_u *TodoUpdate) RemoveAssignmentIDs(ids ...string) *TodoUpdate {
+	_u.mutation.RemoveAssignmentIDs(ids...)
+	return _u
+}
+
+// RemoveAssignments removes "assignments" edges to TodoAssignment entities.
+func (_u *TodoUpdate) RemoveAssignments(v ...*TodoAssignment) *TodoUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAssignmentIDs(ids...)
+}
+
+// ClearComments clears all "comments" edges to the TodoComment entity.
+func (_u *TodoUpdate) ClearComments() *TodoUpdate {
+	_u.mutation.ClearComments()
+	return _u
+}
+
+// RemoveCommentIDs removes the "comments" edge to TodoComment entities by IDs.
+func (_u *TodoUpdate) RemoveCommentIDs(ids ...string) *TodoUpdate {
+	_u.mutation.RemoveCommentIDs(ids...)
+	return _u
+}
+
+// RemoveComments removes "comments" edges to TodoComment entities.
+func (_u *TodoUpdate) RemoveComments(v ...*TodoComment) *TodoUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCommentIDs(ids...)
+}
+
+// ClearAttachments clears all "attachments" edges to the TodoAttachment entity.
+func (_u *TodoUpdate) ClearAttachments() *TodoUpdate {
+	_u.mutation.ClearAttachments()
+	return _u
+}
+
+// RemoveAttachmentIDs removes the "attachments" edge to TodoAttachment entities by IDs.
+func (_u *TodoUpdate) RemoveAttachmentIDs(ids ...string) *TodoUpdate {
+	_u.mutation.RemoveAttachmentIDs(ids...)
+	return _u
+}
+
+// RemoveAttachments removes "attachments" edges to TodoAttachment entities.
+func (_u *TodoUpdate) RemoveAttachments(v ...*TodoAttachment) *TodoUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAttachmentIDs(ids...)
+}
+
+// ClearShares clears all "shares" edges to the TodoShare entity.
+func (_u *TodoUpdate) ClearShares() *TodoUpdate {
+	_u.mutation.ClearShares()
+	return _u
+}
+
+// RemoveShareIDs removes the "shares" edge to TodoShare entities by IDs.
+func (_u *TodoUpdate) RemoveShareIDs(ids ...string) *TodoUpdate {
+	_u.mutation.RemoveShareIDs(ids...)
+	return _u
+}
+
+// RemoveShares removes "shares" edges to TodoShare entities.
+func (_u *TodoUpdate) RemoveShares(v ...*TodoShare) *TodoUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveShareIDs(ids...)
+}
+
+// ClearNotifications clears all "notifications" edges to the Notification entity.
+func (_u *TodoUpdate) ClearNotifications() *TodoUpdate {
+	_u.mutation.ClearNotifications()
+	return _u
+}
+
+// RemoveNotificationIDs removes the "notifications" edge to Notification entities by IDs.
+func (_u *TodoUpdate) RemoveNotificationIDs(ids ...string) *TodoUpdate {
+	_u.mutation.RemoveNotificationIDs(ids...)
+	return _u
+}
+
+// RemoveNotifications removes "notifications" edges to Notification entities.
+func (_u *TodoUpdate) RemoveNotifications(v ...*Notification) *TodoUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveNotificationIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TodoUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TodoUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TodoUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TodoUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TodoUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if todo.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized todo.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := todo.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TodoUpdate) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := todo.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "Todo.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Title(); ok {
+		if err := todo.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Todo.title": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Priority(); ok {
+		if err := todo.PriorityValidator(v); err != nil {
+			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "Todo.priority": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Position(); ok {
+		if err := todo.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "Todo.position": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Todo.user"`)
+	}
+	return nil
+}
+
+func (_u *TodoUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(todo.Table, todo.Columns, sqlgraph.NewFieldSpec(todo.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(todo.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(todo.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(todo.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Completed(); ok {
+		_spec.SetField(todo.FieldCompleted, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.IsPublic(); ok {
+		_spec.SetField(todo.FieldIsPublic, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(todo.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPriority(); ok {
+		_spec.AddField(todo.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(todo.FieldPosition, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DueDate(); ok {
+		_spec.SetField(todo.FieldDueDate, field.TypeTime, value)
+	}
+	if _u.mutation.DueDateCleared() {
+		_spec.ClearField(todo.FieldDueDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(todo.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(todo.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RecurrenceRule(); ok {
+		_spec.SetField(todo.FieldRecurrenceRule, field.TypeString, value)
+	}
+	if _u.mutation.RecurrenceRuleCleared() {
+		_spec.ClearField(todo.FieldRecurrenceRule, field.TypeString)
+	}
+	if value, ok := _u.mutation.RecurrenceTimezone(); ok {
+		_spec.SetField(todo.FieldRecurrenceTimezone, field.TypeString, value)
+	}
+	if _u.mutation.RecurrenceTimezoneCleared() {
+		_spec.ClearField(todo.FieldRecurrenceTimezone, field.TypeString)
+	}
+	if value, ok := _u.mutation.RecurrenceStart(); ok {
+		_spec.SetField(todo.FieldRecurrenceStart, field.TypeTime, value)
+	}
+	if _u.mutation.RecurrenceStartCleared() {
+		_spec.ClearField(todo.FieldRecurrenceStart, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(todo.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(todo.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(todo.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(todo.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(todo.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.UserTable,
+			Columns: []string{todo.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.UserTable,
+			Columns: []string{todo.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AssigneeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.AssigneeTable,
+			Columns: []string{todo.AssigneeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AssigneeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.AssigneeTable,
+			Columns: []string{todo.AssigneeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.ProjectTable,
+			Columns: []string{todo.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.ProjectTable,
+			Columns: []string{todo.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.ParentTable,
+			Columns: []string{todo.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.ParentTable,
+			Columns: []string{todo.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.ChildrenTable,
+			Columns: []string{todo.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !_u.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.ChildrenTable,
+			Columns: []string{todo.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.ChildrenTable,
+			Columns: []string{todo.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   todo.TagsTable,
+			Columns: todo.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		createE := &TodoTagCreate{config: _u.config, mutation: newTodoTagMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTagsIDs(); len(nodes) > 0 && !_u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   todo.TagsTable,
+			Columns: todo.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		createE := &TodoTagCreate{config: _u.config, mutation: newTodoTagMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   todo.TagsTable,
+			Columns: todo.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		createE := &TodoTagCreate{config: _u.config, mutation: newTodoTagMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RemindersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.RemindersTable,
+			Columns: []string{todo.RemindersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminder.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRemindersIDs(); len(nodes) > 0 && !_u.mutation.RemindersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.RemindersTable,
+			Columns: []string{todo.RemindersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminder.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemindersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.RemindersTable,
+			Columns: []string{todo.RemindersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminder.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.AssignmentsTable,
+			Columns: []string{todo.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoassignment.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAssignmentsIDs(); len(nodes) > 0 && !_u.mutation.AssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.AssignmentsTable,
+			Columns: []string{todo.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoassignment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.AssignmentsTable,
+			Columns: []string{todo.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoassignment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.CommentsTable,
+			Columns: []string{todo.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todocomment.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCommentsIDs(); len(nodes) > 0 && !_u.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.CommentsTable,
+			Columns: []string{todo.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todocomment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.CommentsTable,
+			Columns: []string{todo.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todocomment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.AttachmentsTable,
+			Columns: []string{todo.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoattachment.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAttachmentsIDs(); len(nodes) > 0 && !_u.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.AttachmentsTable,
+			Columns: []string{todo.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoattachment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.AttachmentsTable,
+			Columns: []string{todo.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoattachment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SharesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.SharesTable,
+			Columns: []string{todo.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoshare.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSharesIDs(); len(nodes) > 0 && !_u.mutation.SharesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.SharesTable,
+			Columns: []string{todo.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoshare.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SharesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.SharesTable,
+			Columns: []string{todo.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoshare.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.NotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.NotificationsTable,
+			Columns: []string{todo.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedNotificationsIDs(); len(nodes) > 0 && !_u.mutation.NotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.NotificationsTable,
+			Columns: []string{todo.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.NotificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.NotificationsTable,
+			Columns: []string{todo.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{todo.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TodoUpdateOne is the builder for updating a single Todo entity.
+type TodoUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TodoMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *TodoUpdateOne) SetUserID(v string) *TodoUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *TodoUpdateOne) SetNillableUserID(v *string) *TodoUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetAssigneeID sets the "assignee_id" field.
+func (_u *TodoUpdateOne) SetAssigneeID(v string) *TodoUpdateOne {
+	_u.mutation.SetAssigneeID(v)
+	return _u
+}
+
+// SetNillableAssigneeID sets the "assignee_id" field if the given value is not nil.
+func (_u *TodoUpdateOne) SetNillableAssigneeID(v *string) *TodoUpdateOne {
+	if v != nil {
+		_u.SetAssigneeID(*v)
+	}
+	return _u
+}
+
+// ClearAssigneeID clears the value of the "assignee_id" field.
+func (_u *TodoUpdateOne) ClearAssigneeID() *TodoUpdateOne {
+	_u.mutation.ClearAssigneeID()
+	return _u
+}
+
+// SetProjectID sets the "project_id" field.
+func (_u *TodoUpdateOne) SetProjectID(v string) *TodoUpdateOne {
+	_u.mutation.SetProjectID(v)
+	return _u
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (_u *TodoUpdateOne) SetNillableProjectID(v *string) *TodoUpdateOne {
+	if v != nil {
+		_u.SetProjectID(*v)
+	}
+	return _u
+}
+
+// ClearProjectID clears the value of the "project_id" field.
+func (_u *TodoUpdateOne) ClearProjectID() *TodoUpdateOne {
+	_u.mutation.ClearProjectID()
+	return _u
+}
+
+// SetParentID sets the "parent_id" field.
+func (_u *TodoUpdateOne) SetParentID(v string) *TodoUpdateOne {
+	_u.mutation.SetParentID(v)
+	return _u
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (_u *TodoUpdateOne) SetNillableParentID(v *string) *TodoUpdateOne {
+	if v != nil {
+		_u.SetParentID(*v)
+	}
+	return _u
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (_u *TodoUpdateOne) ClearParentID() *TodoUpdateOne {
+	_u.mutation.ClearParentID()
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *TodoUpdateOne) SetTitle(v string) *TodoUpdateOne {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *TodoUpdateOne) SetNillableTitle(v *string) *TodoUpdateOne {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *TodoUpdateOne) SetDescription(v string) *TodoUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *TodoUpdateOne) SetNillableDescription(v *string) *TodoUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *TodoUpdateOne) ClearDescription() *TodoUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetCompleted sets the "completed" field.
+func (_u *TodoUpdateOne) SetCompleted(v bool) *TodoUpdateOne {
+	_u.mutation.SetCompleted(v)
+	return _u
+}
+
+// SetNillableCompleted sets the "completed" field if the given value is not nil.
+func (_u *TodoUpdateOne) SetNillableCompleted(v *bool) *TodoUpdateOne {
+	if v != nil {
+		_u.SetCompleted(*v)
+	}
+	return _u
+}
+
+// SetIsPublic sets the "is_public" field.
+func (_u *TodoUpdateOne) SetIsPublic(v bool) *TodoUpdateOne {
+	_u.mutation.SetIsPublic(v)
+	return _u
+}
+
+// SetNillableIsPublic sets the "is_public" field if the given value is not nil.
+func (_u *TodoUpdateOne) SetNillableIsPublic(v *bool) *TodoUpdateOne {
+	if v != nil {
+		_u.SetIsPublic(*v)
+	}
+	return _u
+}
+
+// SetPriority sets the "priority" field.
+func (_u *TodoUpdateOne) SetPriority(v int) *TodoUpdateOne {
+	_u.mutation.ResetPriority()
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *TodoUpdateOne) SetNillablePriority(v *int) *TodoUpdateOne {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// AddPriority adds value to the "priority" field.
+func (_u *TodoUpdateOne) AddPriority(v int) *TodoUpdateOne {
+	_u.mutation.AddPriority(v)
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *TodoUpdateOne) SetPosition(v string) *TodoUpdateOne {
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *TodoUpdateOne) SetNillablePosition(v *string) *TodoUpdateOne {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// SetDueDate sets the "due_date" field.
+func (_u *TodoUpdateOne) SetDueDate(v time.Time) *TodoUpdateOne {
+	_u.mutation.SetDueDate(v)
+	return _u
+}
+
+// SetNillableDueDate sets the "due_date" field if the given value is not nil.
+func (_u *TodoUpdateOne) SetNillableDueDate(v *time.Time) *TodoUpdateOne {
+	if v != nil {
+		_u.SetDueDate(*v)
+	}
+	return _u
+}
+
+// ClearDueDate clears the value of the "due_date" field.
+func (_u *TodoUpdateOne) ClearDueDate() *TodoUpdateOne {
+	_u.mutation.ClearDueDate()
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *TodoUpdateOne) SetCompletedAt(v time.Time) *TodoUpdateOne {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *TodoUpdateOne) SetNillableCompletedAt(v *time.Time) *TodoUpdateOne {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *TodoUpdateOne) ClearCompletedAt() *TodoUpdateOne {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// SetRecurrenceRule sets the "recurrence_rule" field.
+func (_u *TodoUpdateOne) SetRecurrenceRule(v string) *TodoUpdateOne {
+	_u.mutation.SetRecurrenceRule(v)
+	return _u
+}
+
+// SetNillableRecurrenceRule sets the "recurrence_rule" field if the given value is not nil.
+func (_u *TodoUpdateOne) SetNillableRecurrenceRule(v *string) *TodoUpdateOne {
+	if v != nil {
+		_u.SetRecurrenceRule(*v)
+	}
+	return _u
+}
+
+// ClearRecurrenceRule clears the value of the "recurrence_rule" field.
+func (_u *TodoUpdateOne) ClearRecurrenceRule() *TodoUpdateOne {
+	_u.mutation.ClearRecurrenceRule()
+	return _u
+}
+
+// SetRecurrenceTimezone sets the "recurrence_timezone" field.
+func (_u *TodoUpdateOne) SetRecurrenceTimezone(v string) *TodoUpdateOne {
+	_u.mutation.SetRecurrenceTimezone(v)
+	return _u
+}
+
+// SetNillableRecurrenceTimezone sets the "recurrence_timezone" field if the given value is not nil.
+func (_u *TodoUpdateOne) SetNillableRecurrenceTimezone(v *string) *TodoUpdateOne {
+	if v != nil {
+		_u.SetRecurrenceTimezone(*v)
+	}
+	return _u
+}
+
+// ClearRecurrenceTimezone clears the value of the "recurrence_timezone" field.
+func (_u *TodoUpdateOne) ClearRecurrenceTimezone() *TodoUpdateOne {
+	_u.mutation.ClearRecurrenceTimezone()
+	return _u
+}
+
+// SetRecurrenceStart sets the "recurrence_start" field.
+func (_u *TodoUpdateOne) SetRecurrenceStart(v time.Time) *TodoUpdateOne {
+	_u.mutation.SetRecurrenceStart(v)
+	return _u
+}
+
+// SetNillableRecurrenceStart sets the "recurrence_start" field if the given value is not nil.
+func (_u *TodoUpdateOne) SetNillableRecurrenceStart(v *time.Time) *TodoUpdateOne {
+	if v != nil {
+		_u.SetRecurrenceStart(*v)
+	}
+	return _u
+}
+
+// ClearRecurrenceStart clears the value of the "recurrence_start" field.
+func (_u *TodoUpdateOne) ClearRecurrenceStart() *TodoUpdateOne {
+	_u.mutation.ClearRecurrenceStart()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *TodoUpdateOne) SetDeletedAt(v time.Time) *TodoUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *TodoUpdateOne) SetNillableDeletedAt(v *time.Time) *TodoUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *TodoUpdateOne) ClearDeletedAt() *TodoUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *TodoUpdateOne) SetVersion(v int) *TodoUpdateOne {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *TodoUpdateOne) SetNillableVersion(v *int) *TodoUpdateOne {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *TodoUpdateOne) AddVersion(v int) *TodoUpdateOne {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TodoUpdateOne) SetUpdatedAt(v time.Time) *TodoUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *TodoUpdateOne) SetUser(v *User) *TodoUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// SetAssignee sets the "assignee" edge to the User entity.
+func (_u *TodoUpdateOne) SetAssignee(v *User) *TodoUpdateOne {
+	return _u.SetAssigneeID(v.ID)
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (_u *TodoUpdateOne) SetProject(v *Project) *TodoUpdateOne {
+	return _u.SetProjectID(v.ID)
+}
+
+// SetParent sets the "parent" edge to the Todo entity.
+func (_u *TodoUpdateOne) SetParent(v *Todo) *TodoUpdateOne {
+	return _u.SetParentID(v.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Todo entity by IDs.
+func (_u *TodoUpdateOne) AddChildIDs(ids ...string) *TodoUpdateOne {
+	_u.mutation.AddChildIDs(ids...)
+	return _u
+}
+
+// AddChildren adds the "children" edges to the Todo entity.
+func (_u *TodoUpdateOne) AddChildren(v ...*Todo) *TodoUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddChildIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (_u *TodoUpdateOne) AddTagIDs(ids ...string) *TodoUpdateOne {
+	_u.mutation.AddTagIDs(ids...)
+	return _u
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (_u *TodoUpdateOne) AddTags(v ...*Tag) *TodoUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTagIDs(ids...)
+}
+
+// AddReminderIDs adds the "reminders" edge to the Reminder entity by IDs.
+func (_u *TodoUpdateOne) AddReminderIDs(ids ...string) *TodoUpdateOne {
+	_u.mutation.AddReminderIDs(ids...)
+	return _u
+}
+
+// AddReminders adds the "reminders" edges to the Reminder entity.
+func (_u *TodoUpdateOne) AddReminders(v ...*Reminder) *TodoUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReminderIDs(ids...)
+}
+
+// AddAssignmentIDs adds the "assignments" edge to the TodoAssignment entity by IDs.
+func (_u *TodoUpdateOne) AddAssignmentIDs(ids ...string) *TodoUpdateOne {
+	_u.mutation.AddAssignmentIDs(ids...)
+	return _u
+}
+
+// AddAssignments adds the "assignments" edges to the TodoAssignment entity.
+func (_u *TodoUpdateOne) AddAssignments(v ...*TodoAssignment) *TodoUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAssignmentIDs(ids...)
+}
+
+// AddCommentIDs adds the "comments" edge to the TodoComment entity by IDs.
+func (_u *TodoUpdateOne) AddCommentIDs(ids ...string) *TodoUpdateOne {
+	_u.mutation.AddCommentIDs(ids...)
+	return _u
+}
+
+// AddComments adds the "comments" edges to the TodoComment entity.
+func (_u *TodoUpdateOne) AddComments(v ...*TodoComment) *TodoUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCommentIDs(ids...)
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the TodoAttachment entity by IDs.
+func (_u *TodoUpdateOne) AddAttachmentIDs(ids ...string) *TodoUpdateOne {
+	_u.mutation.AddAttachmentIDs(ids...)
+	return _u
+}
+
+// AddAttachments adds the "attachments" edges to the TodoAttachment entity.
+func (_u *TodoUpdateOne) AddAttachments(v ...*TodoAttachment) *TodoUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAttachmentIDs(ids...)
+}
+
+// AddShareIDs adds the "shares" edge to the TodoShare entity by IDs.
+func (_u *TodoUpdateOne) AddShareIDs(ids ...string) *TodoUpdateOne {
+	_u.mutation.AddShareIDs(ids...)
+	return _u
+}
+
+// AddShares adds the "shares" edges to the TodoShare entity.
+func (_u *TodoUpdateOne) AddShares(v ...*TodoShare) *TodoUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddShareIDs(ids...)
+}
+
+// AddNotificationIDs adds the "notifications" edge to the Notification entity by IDs.
+func (_u *TodoUpdateOne) AddNotificationIDs(ids ...string) *TodoUpdateOne {
+	_u.mutation.AddNotificationIDs(ids...)
+	return _u
+}
+
+// AddNotifications adds the "notifications" edges to the Notification entity.
+func (_u *TodoUpdateOne) AddNotifications(v ...*Notification) *TodoUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddNotificationIDs(ids...)
+}
+
+// Mutation returns the TodoMutation object of the builder.
+func (_u *TodoUpdateOne) Mutation() *TodoMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *TodoUpdateOne) ClearUser() *TodoUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearAssignee clears the "assignee" edge to the User entity.
+func (_u *TodoUpdateOne) ClearAssignee() *TodoUpdateOne {
+	_u.mutation.ClearAssignee()
+	return _u
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (_u *TodoUpdateOne) ClearProject() *TodoUpdateOne {
+	_u.mutation.ClearProject()
+	return _u
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (_u *TodoUpdate) ExecX(ctx context.Context) {
-	if err := _u.Exec(ctx); err != nil {
-		panic(err)
-	}
+// ClearParent clears the "parent" edge to the Todo entity.
+func (_u *TodoUpdateOne) ClearParent() *TodoUpdateOne {
+	_u.mutation.ClearParent()
+	return _u
 }
 
-// defaults sets the default values of the builder before save.
-func (_u *TodoUpdate) defaults() {
-	if _, ok := _u.mutation.UpdatedAt(); !ok {
-		v := todo.UpdateDefaultUpdatedAt()
-		_u.mutation.SetUpdatedAt(v)
-	}
+// ClearChildren clears all "children" edges to the Todo entity.
+func (_u *TodoUpdateOne) ClearChildren() *TodoUpdateOne {
+	_u.mutation.ClearChildren()
+	return _u
 }
 
-// check runs all checks and user-defined validators on the builder.
-func (_u *TodoUpdate) check() error {
-	if v, ok := _u.mutation.Title(); ok {
-		if err := todo.TitleValidator(v); err != nil {
-			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Todo.title": %w`, err)}
-		}
-	}
-	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
-		return errors.New(`ent: clearing a required unique edge "Todo.user"`)
-	}
-	return nil
+// RemoveChildIDs removes the "children" edge to Todo entities by IDs.
+func (_u *TodoUpdateOne) RemoveChildIDs(ids ...string) *TodoUpdateOne {
+	_u.mutation.RemoveChildIDs(ids...)
+	return _u
 }
 
-func (_u *TodoUpdate) sqlSave(ctx context.Context) (_node int, err error) {
-	if err := _u.check(); err != nil {
-		return _node, err
-	}
-	_spec := sqlgraph.NewUpdateSpec(todo.Table, todo.Columns, sqlgraph.NewFieldSpec(todo.FieldID, field.TypeString))
-	if ps := _u.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
-		}
-	}
-	if value, ok := _u.mutation.Title(); ok {
-		_spec.SetField(todo.FieldTitle, field.TypeString, value)
-	}
-	if value, ok := _u.mutation.Description(); ok {
-		_spec.SetField(todo.FieldDescription, field.TypeString, value)
-	}
-	if _u.mutation.DescriptionCleared() {
-		_spec.ClearField(todo.FieldDescription, field.TypeString)
-	}
-	if value, ok := _u.mutation.Completed(); ok {
-		_spec.SetField(todo.FieldCompleted, field.TypeBool, value)
-	}
-	if value, ok := _u.mutation.IsPublic(); ok {
-		_spec.SetField(todo.FieldIsPublic, field.TypeBool, value)
-	}
-	if value, ok := _u.mutation.DueDate(); ok {
-		_spec.SetField(todo.FieldDueDate, field.TypeTime, value)
-	}
-	if _u.mutation.DueDateCleared() {
-		_spec.ClearField(todo.FieldDueDate, field.TypeTime)
-	}
-	if value, ok := _u.mutation.CompletedAt(); ok {
-		_spec.SetField(todo.FieldCompletedAt, field.TypeTime, value)
-	}
-	if _u.mutation.CompletedAtCleared() {
-		_spec.ClearField(todo.FieldCompletedAt, field.TypeTime)
-	}
-	if value, ok := _u.mutation.UpdatedAt(); ok {
-		_spec.SetField(todo.FieldUpdatedAt, field.TypeTime, value)
-	}
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{todo.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
-		}
-		return 0, err
+// RemoveChildren removes "children" edges to Todo entities.
+func (_u *TodoUpdateOne) RemoveChildren(v ...*Todo) *TodoUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	_u.mutation.done = true
-	return _node, nil
+	return _u.RemoveChildIDs(ids...)
 }
 
-// TodoUpdateOne is the builder for updating a single Todo entity.
-type TodoUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *TodoMutation
+// ClearTags clears all "tags" edges to the Tag entity.
+func (_u *TodoUpdateOne) ClearTags() *TodoUpdateOne {
+	_u.mutation.ClearTags()
+	return _u
 }
 
-// SetTitle sets the "title" field.
-func (_u *TodoUpdateOne) SetTitle(v string) *TodoUpdateOne {
-	_u.mutation.SetTitle(v)
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (_u *TodoUpdateOne) RemoveTagIDs(ids ...string) *TodoUpdateOne {
+	_u.mutation.RemoveTagIDs(ids...)
 	return _u
 }
 
-// SetNillableTitle sets the "title" field if the given value is not nil.
-func (_u *TodoUpdateOne) SetNillableTitle(v *string) *TodoUpdateOne {
-	if v != nil {
-		_u.SetTitle(*v)
+// RemoveTags removes "tags" edges to Tag entities.
+func (_u *TodoUpdateOne) RemoveTags(v ...*Tag) *TodoUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
+	return _u.RemoveTagIDs(ids...)
+}
+
+// ClearReminders clears all "reminders" edges to the Reminder entity.
+func (_u *TodoUpdateOne) ClearReminders() *TodoUpdateOne {
+	_u.mutation.ClearReminders()
 	return _u
 }
 
-// SetDescription sets the "description" field.
-func (_u *TodoUpdateOne) SetDescription(v string) *TodoUpdateOne {
-	_u.mutation.SetDescription(v)
+// RemoveReminderIDs removes the "reminders" edge to Reminder entities by IDs.
+func (_u *TodoUpdateOne) RemoveReminderIDs(ids ...string) *TodoUpdateOne {
+	_u.mutation.RemoveReminderIDs(ids...)
 	return _u
 }
 
-// SetNillableDescription sets the "description" field if the given value is not nil.
-func (_u *TodoUpdateOne) SetNillableDescription(v *string) *TodoUpdateOne {
-	if v != nil {
-		_u.SetDescription(*v)
+// RemoveReminders removes "reminders" edges to Reminder entities.
+func (_u *TodoUpdateOne) RemoveReminders(v ...*Reminder) *TodoUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return _u
+	return _u.RemoveReminderIDs(ids...)
 }
 
-// ClearDescription clears the value of the "description" field.
-func (_u *TodoUpdateOne) ClearDescription() *TodoUpdateOne {
-	_u.mutation.ClearDescription()
+// ClearAssignments clears all "assignments" edges to the TodoAssignment entity.
+func (_u *TodoUpdateOne) ClearAssignments() *TodoUpdateOne {
+	_u.mutation.ClearAssignments()
 	return _u
 }
 
-// SetCompleted sets the "completed" field.
-func (_u *TodoUpdateOne) SetCompleted(v bool) *TodoUpdateOne {
-	_u.mutation.SetCompleted(v)
+// RemoveAssignmentIDs removes the "assignments" edge to TodoAssignment entities by IDs.
+func (_u *TodoUpdateOne) RemoveAssignmentIDs(ids ...string) *TodoUpdateOne {
+	_u.mutation.RemoveAssignmentIDs(ids...)
 	return _u
 }
 
-// SetNillableCompleted sets the "completed" field if the given value is not nil.
-func (_u *TodoUpdateOne) SetNillableCompleted(v *bool) *TodoUpdateOne {
-	if v != nil {
-		_u.SetCompleted(*v)
+// RemoveAssignments removes "assignments" edges to TodoAssignment entities.
+func (_u *TodoUpdateOne) RemoveAssignments(v ...*TodoAssignment) *TodoUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
+	return _u.RemoveAssignmentIDs(ids...)
+}
+
+// ClearComments clears all "comments" edges to the TodoComment entity.
+func (_u *TodoUpdateOne) ClearComments() *TodoUpdateOne {
+	_u.mutation.ClearComments()
 	return _u
 }
 
-// SetIsPublic sets the "is_public" field.
-func (_u *TodoUpdateOne) SetIsPublic(v bool) *TodoUpdateOne {
-	_u.mutation.SetIsPublic(v)
+// RemoveCommentIDs removes the "comments" edge to TodoComment entities by IDs.
+func (_u *TodoUpdateOne) RemoveCommentIDs(ids ...string) *TodoUpdateOne {
+	_u.mutation.RemoveCommentIDs(ids...)
 	return _u
 }
 
-// SetNillableIsPublic sets the "is_public" field if the given value is not nil.
-func (_u *TodoUpdateOne) SetNillableIsPublic(v *bool) *TodoUpdateOne {
-	if v != nil {
-		_u.SetIsPublic(*v)
+// RemoveComments removes "comments" edges to TodoComment entities.
+func (_u *TodoUpdateOne) RemoveComments(v ...*TodoComment) *TodoUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
+	return _u.RemoveCommentIDs(ids...)
+}
+
+// ClearAttachments clears all "attachments" edges to the TodoAttachment entity.
+func (_u *TodoUpdateOne) ClearAttachments() *TodoUpdateOne {
+	_u.mutation.ClearAttachments()
 	return _u
 }
 
-// SetDueDate sets the "due_date" field.
-func (_u *TodoUpdateOne) SetDueDate(v time.Time) *TodoUpdateOne {
-	_u.mutation.SetDueDate(v)
+// RemoveAttachmentIDs removes the "attachments" edge to TodoAttachment entities by IDs.
+func (_u *TodoUpdateOne) RemoveAttachmentIDs(ids ...string) *TodoUpdateOne {
+	_u.mutation.RemoveAttachmentIDs(ids...)
 	return _u
 }
 
-// SetNillableDueDate sets the "due_date" field if the given value is not nil.
-func (_u *TodoUpdateOne) SetNillableDueDate(v *time.Time) *TodoUpdateOne {
-	if v != nil {
-		_u.SetDueDate(*v)
+// RemoveAttachments removes "attachments" edges to TodoAttachment entities.
+func (_u *TodoUpdateOne) RemoveAttachments(v ...*TodoAttachment) *TodoUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return _u
+	return _u.RemoveAttachmentIDs(ids...)
 }
 
-// ClearDueDate clears the value of the "due_date" field.
-func (_u *TodoUpdateOne) ClearDueDate() *TodoUpdateOne {
-	_u.mutation.ClearDueDate()
+// ClearShares clears all "shares" edges to the TodoShare entity.
+func (_u *TodoUpdateOne) ClearShares() *TodoUpdateOne {
+	_u.mutation.ClearShares()
 	return _u
 }
 
-// SetCompletedAt sets the "completed_at" field.
-func (_u *TodoUpdateOne) SetCompletedAt(v time.Time) *TodoUpdateOne {
-	_u.mutation.SetCompletedAt(v)
+// RemoveShareIDs removes the "shares" edge to TodoShare entities by IDs.
+func (_u *TodoUpdateOne) RemoveShareIDs(ids ...string) *TodoUpdateOne {
+	_u.mutation.RemoveShareIDs(ids...)
 	return _u
 }
 
-// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
-func (_u *TodoUpdateOne) SetNillableCompletedAt(v *time.Time) *TodoUpdateOne {
-	if v != nil {
-		_u.SetCompletedAt(*v)
+// RemoveShares removes "shares" edges to TodoShare entities.
+func (_u *TodoUpdateOne) RemoveShares(v ...*TodoShare) *TodoUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return _u
+	return _u.RemoveShareIDs(ids...)
 }
 
-// ClearCompletedAt clears the value of the "completed_at" field.
-func (_u *TodoUpdateOne) ClearCompletedAt() *TodoUpdateOne {
-	_u.mutation.ClearCompletedAt()
+// ClearNotifications clears all "notifications" edges to the Notification entity.
+func (_u *TodoUpdateOne) ClearNotifications() *TodoUpdateOne {
+	_u.mutation.ClearNotifications()
 	return _u
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (_u *TodoUpdateOne) SetUpdatedAt(v time.Time) *TodoUpdateOne {
-	_u.mutation.SetUpdatedAt(v)
+// RemoveNotificationIDs removes the "notifications" edge to Notification entities by IDs.
+func (_u *TodoUpdateOne) RemoveNotificationIDs(ids ...string) *TodoUpdateOne {
+	_u.mutation.RemoveNotificationIDs(ids...)
 	return _u
 }
 
-// Mutation returns the TodoMutation object of the builder.
-func (_u *TodoUpdateOne) Mutation() *TodoMutation {
-	return _u.mutation
+// RemoveNotifications removes "notifications" edges to Notification entities.
+func (_u *TodoUpdateOne) RemoveNotifications(v ...*Notification) *TodoUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveNotificationIDs(ids...)
 }
 
 // Where appends a list predicates to the TodoUpdate builder.
@@ -380,7 +2021,9 @@ func (_u *TodoUpdateOne) Select(field string, fields ...string) *TodoUpdateOne {
 
 // Save executes the query and returns the updated Todo entity.
 func (_u *TodoUpdateOne) Save(ctx context.Context) (*Todo, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -407,20 +2050,39 @@ func (_u *TodoUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *TodoUpdateOne) defaults() {
+func (_u *TodoUpdateOne) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if todo.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized todo.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := todo.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *TodoUpdateOne) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := todo.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "Todo.user_id": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.Title(); ok {
 		if err := todo.TitleValidator(v); err != nil {
 			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Todo.title": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Priority(); ok {
+		if err := todo.PriorityValidator(v); err != nil {
+			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "Todo.priority": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Position(); ok {
+		if err := todo.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "Todo.position": %w`, err)}
+		}
+	}
 	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Todo.user"`)
 	}
@@ -471,6 +2133,15 @@ func (_u *TodoUpdateOne) sqlSave(ctx context.Context) (_node *Todo, err error) {
 	if value, ok := _u.mutation.IsPublic(); ok {
 		_spec.SetField(todo.FieldIsPublic, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(todo.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPriority(); ok {
+		_spec.AddField(todo.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(todo.FieldPosition, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.DueDate(); ok {
 		_spec.SetField(todo.FieldDueDate, field.TypeTime, value)
 	}
@@ -483,9 +2154,527 @@ func (_u *TodoUpdateOne) sqlSave(ctx context.Context) (_node *Todo, err error) {
 	if _u.mutation.CompletedAtCleared() {
 		_spec.ClearField(todo.FieldCompletedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.RecurrenceRule(); ok {
+		_spec.SetField(todo.FieldRecurrenceRule, field.TypeString, value)
+	}
+	if _u.mutation.RecurrenceRuleCleared() {
+		_spec.ClearField(todo.FieldRecurrenceRule, field.TypeString)
+	}
+	if value, ok := _u.mutation.RecurrenceTimezone(); ok {
+		_spec.SetField(todo.FieldRecurrenceTimezone, field.TypeString, value)
+	}
+	if _u.mutation.RecurrenceTimezoneCleared() {
+		_spec.ClearField(todo.FieldRecurrenceTimezone, field.TypeString)
+	}
+	if value, ok := _u.mutation.RecurrenceStart(); ok {
+		_spec.SetField(todo.FieldRecurrenceStart, field.TypeTime, value)
+	}
+	if _u.mutation.RecurrenceStartCleared() {
+		_spec.ClearField(todo.FieldRecurrenceStart, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(todo.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(todo.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(todo.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(todo.FieldVersion, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(todo.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.UserTable,
+			Columns: []string{todo.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.UserTable,
+			Columns: []string{todo.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AssigneeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.AssigneeTable,
+			Columns: []string{todo.AssigneeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AssigneeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.AssigneeTable,
+			Columns: []string{todo.AssigneeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.ProjectTable,
+			Columns: []string{todo.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.ProjectTable,
+			Columns: []string{todo.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.ParentTable,
+			Columns: []string{todo.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.ParentTable,
+			Columns: []string{todo.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.ChildrenTable,
+			Columns: []string{todo.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !_u.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.ChildrenTable,
+			Columns: []string{todo.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.ChildrenTable,
+			Columns: []string{todo.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   todo.TagsTable,
+			Columns: todo.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		createE := &TodoTagCreate{config: _u.config, mutation: newTodoTagMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTagsIDs(); len(nodes) > 0 && !_u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   todo.TagsTable,
+			Columns: todo.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		createE := &TodoTagCreate{config: _u.config, mutation: newTodoTagMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   todo.TagsTable,
+			Columns: todo.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		createE := &TodoTagCreate{config: _u.config, mutation: newTodoTagMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RemindersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.RemindersTable,
+			Columns: []string{todo.RemindersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminder.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRemindersIDs(); len(nodes) > 0 && !_u.mutation.RemindersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.RemindersTable,
+			Columns: []string{todo.RemindersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminder.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemindersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.RemindersTable,
+			Columns: []string{todo.RemindersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminder.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.AssignmentsTable,
+			Columns: []string{todo.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoassignment.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAssignmentsIDs(); len(nodes) > 0 && !_u.mutation.AssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.AssignmentsTable,
+			Columns: []string{todo.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoassignment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.AssignmentsTable,
+			Columns: []string{todo.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoassignment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.CommentsTable,
+			Columns: []string{todo.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todocomment.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCommentsIDs(); len(nodes) > 0 && !_u.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.CommentsTable,
+			Columns: []string{todo.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todocomment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.CommentsTable,
+			Columns: []string{todo.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todocomment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.AttachmentsTable,
+			Columns: []string{todo.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoattachment.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAttachmentsIDs(); len(nodes) > 0 && !_u.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.AttachmentsTable,
+			Columns: []string{todo.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoattachment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.AttachmentsTable,
+			Columns: []string{todo.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoattachment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SharesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.SharesTable,
+			Columns: []string{todo.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoshare.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSharesIDs(); len(nodes) > 0 && !_u.mutation.SharesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.SharesTable,
+			Columns: []string{todo.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoshare.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SharesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.SharesTable,
+			Columns: []string{todo.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoshare.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.NotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.NotificationsTable,
+			Columns: []string{todo.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedNotificationsIDs(); len(nodes) > 0 && !_u.mutation.NotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.NotificationsTable,
+			Columns: []string{todo.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.NotificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.NotificationsTable,
+			Columns: []string{todo.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Todo{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,194 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"good-todo-go/internal/ent/todo"
+	"good-todo-go/internal/ent/todoassignment"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// TodoAssignment is the model entity for the TodoAssignment schema.
+type TodoAssignment struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID string `json:"tenant_id,omitempty"`
+	// TodoID holds the value of the "todo_id" field.
+	TodoID string `json:"todo_id,omitempty"`
+	// PreviousAssigneeID holds the value of the "previous_assignee_id" field.
+	PreviousAssigneeID *string `json:"previous_assignee_id,omitempty"`
+	// Nil when the todo was unassigned
+	AssigneeID *string `json:"assignee_id,omitempty"`
+	// ChangedBy holds the value of the "changed_by" field.
+	ChangedBy string `json:"changed_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TodoAssignmentQuery when eager-loading is set.
+	Edges        TodoAssignmentEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TodoAssignmentEdges holds the relations/edges for other nodes in the graph.
+type TodoAssignmentEdges struct {
+	// Todo holds the value of the todo edge.
+	Todo *Todo `json:"todo,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// TodoOrErr returns the Todo value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TodoAssignmentEdges) TodoOrErr() (*Todo, error) {
+	if e.Todo != nil {
+		return e.Todo, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: todo.Label}
+	}
+	return nil, &NotLoadedError{edge: "todo"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TodoAssignment) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case todoassignment.FieldID, todoassignment.FieldTenantID, todoassignment.FieldTodoID, todoassignment.FieldPreviousAssigneeID, todoassignment.FieldAssigneeID, todoassignment.FieldChangedBy:
+			values[i] = new(sql.NullString)
+		case todoassignment.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TodoAssignment fields.
+func (_m *TodoAssignment) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case todoassignment.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case todoassignment.FieldTenantID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = value.String
+			}
+		case todoassignment.FieldTodoID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field todo_id", values[i])
+			} else if value.Valid {
+				_m.TodoID = value.String
+			}
+		case todoassignment.FieldPreviousAssigneeID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_assignee_id", values[i])
+			} else if value.Valid {
+				_m.PreviousAssigneeID = new(string)
+				*_m.PreviousAssigneeID = value.String
+			}
+		case todoassignment.FieldAssigneeID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field assignee_id", values[i])
+			} else if value.Valid {
+				_m.AssigneeID = new(string)
+				*_m.AssigneeID = value.String
+			}
+		case todoassignment.FieldChangedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field changed_by", values[i])
+			} else if value.Valid {
+				_m.ChangedBy = value.String
+			}
+		case todoassignment.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TodoAssignment.
+// This includes values selected through modifiers, order, etc.
+func (_m *TodoAssignment) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTodo queries the "todo" edge of the TodoAssignment entity.
+func (_m *TodoAssignment) QueryTodo() *TodoQuery {
+	return NewTodoAssignmentClient(_m.config).QueryTodo(_m)
+}
+
+// Update returns a builder for updating this TodoAssignment.
+// Note that you need to call TodoAssignment.Unwrap() before calling this method if this TodoAssignment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TodoAssignment) Update() *TodoAssignmentUpdateOne {
+	return NewTodoAssignmentClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TodoAssignment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TodoAssignment) Unwrap() *TodoAssignment {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TodoAssignment is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TodoAssignment) String() string {
+	var builder strings.Builder
+	builder.WriteString("TodoAssignment(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(_m.TenantID)
+	builder.WriteString(", ")
+	builder.WriteString("todo_id=")
+	builder.WriteString(_m.TodoID)
+	builder.WriteString(", ")
+	if v := _m.PreviousAssigneeID; v != nil {
+		builder.WriteString("previous_assignee_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.AssigneeID; v != nil {
+		builder.WriteString("assignee_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("changed_by=")
+	builder.WriteString(_m.ChangedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TodoAssignments is a parsable slice of TodoAssignment.
+type TodoAssignments []*TodoAssignment
@@ -0,0 +1,519 @@
+// Code generated by ent, DO NOT EDIT.
+
+package todoassignment
+
+import (
+	"good-todo-go/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldContainsFold(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TodoID applies equality check predicate on the "todo_id" field. It's identical to TodoIDEQ.
+func TodoID(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldEQ(FieldTodoID, v))
+}
+
+// PreviousAssigneeID applies equality check predicate on the "previous_assignee_id" field. It's identical to PreviousAssigneeIDEQ.
+func PreviousAssigneeID(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldEQ(FieldPreviousAssigneeID, v))
+}
+
+// AssigneeID applies equality check predicate on the "assignee_id" field. It's identical to AssigneeIDEQ.
+func AssigneeID(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldEQ(FieldAssigneeID, v))
+}
+
+// ChangedBy applies equality check predicate on the "changed_by" field. It's identical to ChangedByEQ.
+func ChangedBy(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldEQ(FieldChangedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDContains applies the Contains predicate on the "tenant_id" field.
+func TenantIDContains(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldContains(FieldTenantID, v))
+}
+
+// TenantIDHasPrefix applies the HasPrefix predicate on the "tenant_id" field.
+func TenantIDHasPrefix(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldHasPrefix(FieldTenantID, v))
+}
+
+// TenantIDHasSuffix applies the HasSuffix predicate on the "tenant_id" field.
+func TenantIDHasSuffix(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldHasSuffix(FieldTenantID, v))
+}
+
+// TenantIDEqualFold applies the EqualFold predicate on the "tenant_id" field.
+func TenantIDEqualFold(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldEqualFold(FieldTenantID, v))
+}
+
+// TenantIDContainsFold applies the ContainsFold predicate on the "tenant_id" field.
+func TenantIDContainsFold(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldContainsFold(FieldTenantID, v))
+}
+
+// TodoIDEQ applies the EQ predicate on the "todo_id" field.
+func TodoIDEQ(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldEQ(FieldTodoID, v))
+}
+
+// TodoIDNEQ applies the NEQ predicate on the "todo_id" field.
+func TodoIDNEQ(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldNEQ(FieldTodoID, v))
+}
+
+// TodoIDIn applies the In predicate on the "todo_id" field.
+func TodoIDIn(vs ...string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldIn(FieldTodoID, vs...))
+}
+
+// TodoIDNotIn applies the NotIn predicate on the "todo_id" field.
+func TodoIDNotIn(vs ...string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldNotIn(FieldTodoID, vs...))
+}
+
+// TodoIDGT applies the GT predicate on the "todo_id" field.
+func TodoIDGT(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldGT(FieldTodoID, v))
+}
+
+// TodoIDGTE applies the GTE predicate on the "todo_id" field.
+func TodoIDGTE(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldGTE(FieldTodoID, v))
+}
+
+// TodoIDLT applies the LT predicate on the "todo_id" field.
+func TodoIDLT(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldLT(FieldTodoID, v))
+}
+
+// TodoIDLTE applies the LTE predicate on the "todo_id" field.
+func TodoIDLTE(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldLTE(FieldTodoID, v))
+}
+
+// TodoIDContains applies the Contains predicate on the "todo_id" field.
+func TodoIDContains(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldContains(FieldTodoID, v))
+}
+
+// TodoIDHasPrefix applies the HasPrefix predicate on the "todo_id" field.
+func TodoIDHasPrefix(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldHasPrefix(FieldTodoID, v))
+}
+
+// TodoIDHasSuffix applies the HasSuffix predicate on the "todo_id" field.
+func TodoIDHasSuffix(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldHasSuffix(FieldTodoID, v))
+}
+
+// TodoIDEqualFold applies the EqualFold predicate on the "todo_id" field.
+func TodoIDEqualFold(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldEqualFold(FieldTodoID, v))
+}
+
+// TodoIDContainsFold applies the ContainsFold predicate on the "todo_id" field.
+func TodoIDContainsFold(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldContainsFold(FieldTodoID, v))
+}
+
+// PreviousAssigneeIDEQ applies the EQ predicate on the "previous_assignee_id" field.
+func PreviousAssigneeIDEQ(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldEQ(FieldPreviousAssigneeID, v))
+}
+
+// PreviousAssigneeIDNEQ applies the NEQ predicate on the "previous_assignee_id" field.
+func PreviousAssigneeIDNEQ(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldNEQ(FieldPreviousAssigneeID, v))
+}
+
+// PreviousAssigneeIDIn applies the In predicate on the "previous_assignee_id" field.
+func PreviousAssigneeIDIn(vs ...string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldIn(FieldPreviousAssigneeID, vs...))
+}
+
+// PreviousAssigneeIDNotIn applies the NotIn predicate on the "previous_assignee_id" field.
+func PreviousAssigneeIDNotIn(vs ...string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldNotIn(FieldPreviousAssigneeID, vs...))
+}
+
+// PreviousAssigneeIDGT applies the GT predicate on the "previous_assignee_id" field.
+func PreviousAssigneeIDGT(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldGT(FieldPreviousAssigneeID, v))
+}
+
+// PreviousAssigneeIDGTE applies the GTE predicate on the "previous_assignee_id" field.
+func PreviousAssigneeIDGTE(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldGTE(FieldPreviousAssigneeID, v))
+}
+
+// PreviousAssigneeIDLT applies the LT predicate on the "previous_assignee_id" field.
+func PreviousAssigneeIDLT(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldLT(FieldPreviousAssigneeID, v))
+}
+
+// PreviousAssigneeIDLTE applies the LTE predicate on the "previous_assignee_id" field.
+func PreviousAssigneeIDLTE(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldLTE(FieldPreviousAssigneeID, v))
+}
+
+// PreviousAssigneeIDContains applies the Contains predicate on the "previous_assignee_id" field.
+func PreviousAssigneeIDContains(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldContains(FieldPreviousAssigneeID, v))
+}
+
+// PreviousAssigneeIDHasPrefix applies the HasPrefix predicate on the "previous_assignee_id" field.
+func PreviousAssigneeIDHasPrefix(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldHasPrefix(FieldPreviousAssigneeID, v))
+}
+
+// PreviousAssigneeIDHasSuffix applies the HasSuffix predicate on the "previous_assignee_id" field.
+func PreviousAssigneeIDHasSuffix(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldHasSuffix(FieldPreviousAssigneeID, v))
+}
+
+// PreviousAssigneeIDIsNil applies the IsNil predicate on the "previous_assignee_id" field.
+func PreviousAssigneeIDIsNil() predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldIsNull(FieldPreviousAssigneeID))
+}
+
+// PreviousAssigneeIDNotNil applies the NotNil predicate on the "previous_assignee_id" field.
+func PreviousAssigneeIDNotNil() predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldNotNull(FieldPreviousAssigneeID))
+}
+
+// PreviousAssigneeIDEqualFold applies the EqualFold predicate on the "previous_assignee_id" field.
+func PreviousAssigneeIDEqualFold(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldEqualFold(FieldPreviousAssigneeID, v))
+}
+
+// PreviousAssigneeIDContainsFold applies the ContainsFold predicate on the "previous_assignee_id" field.
+func PreviousAssigneeIDContainsFold(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldContainsFold(FieldPreviousAssigneeID, v))
+}
+
+// AssigneeIDEQ applies the EQ predicate on the "assignee_id" field.
+func AssigneeIDEQ(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldEQ(FieldAssigneeID, v))
+}
+
+// AssigneeIDNEQ applies the NEQ predicate on the "assignee_id" field.
+func AssigneeIDNEQ(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldNEQ(FieldAssigneeID, v))
+}
+
+// AssigneeIDIn applies the In predicate on the "assignee_id" field.
+func AssigneeIDIn(vs ...string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldIn(FieldAssigneeID, vs...))
+}
+
+// AssigneeIDNotIn applies the NotIn predicate on the "assignee_id" field.
+func AssigneeIDNotIn(vs ...string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldNotIn(FieldAssigneeID, vs...))
+}
+
+// AssigneeIDGT applies the GT predicate on the "assignee_id" field.
+func AssigneeIDGT(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldGT(FieldAssigneeID, v))
+}
+
+// AssigneeIDGTE applies the GTE predicate on the "assignee_id" field.
+func AssigneeIDGTE(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldGTE(FieldAssigneeID, v))
+}
+
+// AssigneeIDLT applies the LT predicate on the "assignee_id" field.
+func AssigneeIDLT(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldLT(FieldAssigneeID, v))
+}
+
+// AssigneeIDLTE applies the LTE predicate on the "assignee_id" field.
+func AssigneeIDLTE(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldLTE(FieldAssigneeID, v))
+}
+
+// AssigneeIDContains applies the Contains predicate on the "assignee_id" field.
+func AssigneeIDContains(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldContains(FieldAssigneeID, v))
+}
+
+// AssigneeIDHasPrefix applies the HasPrefix predicate on the "assignee_id" field.
+func AssigneeIDHasPrefix(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldHasPrefix(FieldAssigneeID, v))
+}
+
+// AssigneeIDHasSuffix applies the HasSuffix predicate on the "assignee_id" field.
+func AssigneeIDHasSuffix(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldHasSuffix(FieldAssigneeID, v))
+}
+
+// AssigneeIDIsNil applies the IsNil predicate on the "assignee_id" field.
+func AssigneeIDIsNil() predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldIsNull(FieldAssigneeID))
+}
+
+// AssigneeIDNotNil applies the NotNil predicate on the "assignee_id" field.
+func AssigneeIDNotNil() predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldNotNull(FieldAssigneeID))
+}
+
+// AssigneeIDEqualFold applies the EqualFold predicate on the "assignee_id" field.
+func AssigneeIDEqualFold(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldEqualFold(FieldAssigneeID, v))
+}
+
+// AssigneeIDContainsFold applies the ContainsFold predicate on the "assignee_id" field.
+func AssigneeIDContainsFold(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldContainsFold(FieldAssigneeID, v))
+}
+
+// ChangedByEQ applies the EQ predicate on the "changed_by" field.
+func ChangedByEQ(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldEQ(FieldChangedBy, v))
+}
+
+// ChangedByNEQ applies the NEQ predicate on the "changed_by" field.
+func ChangedByNEQ(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldNEQ(FieldChangedBy, v))
+}
+
+// ChangedByIn applies the In predicate on the "changed_by" field.
+func ChangedByIn(vs ...string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldIn(FieldChangedBy, vs...))
+}
+
+// ChangedByNotIn applies the NotIn predicate on the "changed_by" field.
+func ChangedByNotIn(vs ...string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldNotIn(FieldChangedBy, vs...))
+}
+
+// ChangedByGT applies the GT predicate on the "changed_by" field.
+func ChangedByGT(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldGT(FieldChangedBy, v))
+}
+
+// ChangedByGTE applies the GTE predicate on the "changed_by" field.
+func ChangedByGTE(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldGTE(FieldChangedBy, v))
+}
+
+// ChangedByLT applies the LT predicate on the "changed_by" field.
+func ChangedByLT(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldLT(FieldChangedBy, v))
+}
+
+// ChangedByLTE applies the LTE predicate on the "changed_by" field.
+func ChangedByLTE(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldLTE(FieldChangedBy, v))
+}
+
+// ChangedByContains applies the Contains predicate on the "changed_by" field.
+func ChangedByContains(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldContains(FieldChangedBy, v))
+}
+
+// ChangedByHasPrefix applies the HasPrefix predicate on the "changed_by" field.
+func ChangedByHasPrefix(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldHasPrefix(FieldChangedBy, v))
+}
+
+// ChangedByHasSuffix applies the HasSuffix predicate on the "changed_by" field.
+func ChangedByHasSuffix(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldHasSuffix(FieldChangedBy, v))
+}
+
+// ChangedByEqualFold applies the EqualFold predicate on the "changed_by" field.
+func ChangedByEqualFold(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldEqualFold(FieldChangedBy, v))
+}
+
+// ChangedByContainsFold applies the ContainsFold predicate on the "changed_by" field.
+func ChangedByContainsFold(v string) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldContainsFold(FieldChangedBy, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasTodo applies the HasEdge predicate on the "todo" edge.
+func HasTodo() predicate.TodoAssignment {
+	return predicate.TodoAssignment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TodoTable, TodoColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTodoWith applies the HasEdge predicate on the "todo" edge with a given conditions (other predicates).
+func HasTodoWith(preds ...predicate.Todo) predicate.TodoAssignment {
+	return predicate.TodoAssignment(func(s *sql.Selector) {
+		step := newTodoStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TodoAssignment) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TodoAssignment) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TodoAssignment) predicate.TodoAssignment {
+	return predicate.TodoAssignment(sql.NotPredicates(p))
+}
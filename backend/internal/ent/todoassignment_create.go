@@ -0,0 +1,330 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"good-todo-go/internal/ent/todo"
+	"good-todo-go/internal/ent/todoassignment"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TodoAssignmentCreate is the builder for creating a TodoAssignment entity.
+type TodoAssignmentCreate struct {
+	config
+	mutation *TodoAssignmentMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *TodoAssignmentCreate) SetTenantID(v string) *TodoAssignmentCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetTodoID sets the "todo_id" field.
+func (_c *TodoAssignmentCreate) SetTodoID(v string) *TodoAssignmentCreate {
+	_c.mutation.SetTodoID(v)
+	return _c
+}
+
+// SetPreviousAssigneeID sets the "previous_assignee_id" field.
+func (_c *TodoAssignmentCreate) SetPreviousAssigneeID(v string) *TodoAssignmentCreate {
+	_c.mutation.SetPreviousAssigneeID(v)
+	return _c
+}
+
+// SetNillablePreviousAssigneeID sets the "previous_assignee_id" field if the given value is not nil.
+func (_c *TodoAssignmentCreate) SetNillablePreviousAssigneeID(v *string) *TodoAssignmentCreate {
+	if v != nil {
+		_c.SetPreviousAssigneeID(*v)
+	}
+	return _c
+}
+
+// SetAssigneeID sets the "assignee_id" field.
+func (_c *TodoAssignmentCreate) SetAssigneeID(v string) *TodoAssignmentCreate {
+	_c.mutation.SetAssigneeID(v)
+	return _c
+}
+
+// SetNillableAssigneeID sets the "assignee_id" field if the given value is not nil.
+func (_c *TodoAssignmentCreate) SetNillableAssigneeID(v *string) *TodoAssignmentCreate {
+	if v != nil {
+		_c.SetAssigneeID(*v)
+	}
+	return _c
+}
+
+// SetChangedBy sets the "changed_by" field.
+func (_c *TodoAssignmentCreate) SetChangedBy(v string) *TodoAssignmentCreate {
+	_c.mutation.SetChangedBy(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TodoAssignmentCreate) SetCreatedAt(v time.Time) *TodoAssignmentCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TodoAssignmentCreate) SetNillableCreatedAt(v *time.Time) *TodoAssignmentCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TodoAssignmentCreate) SetID(v string) *TodoAssignmentCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetTodo sets the "todo" edge to the Todo entity.
+func (_c *TodoAssignmentCreate) SetTodo(v *Todo) *TodoAssignmentCreate {
+	return _c.SetTodoID(v.ID)
+}
+
+// Mutation returns the TodoAssignmentMutation object of the builder.
+func (_c *TodoAssignmentCreate) Mutation() *TodoAssignmentMutation {
+	return _c.mutation
+}
+
+// Save creates the TodoAssignment in the database.
+func (_c *TodoAssignmentCreate) Save(ctx context.Context) (*TodoAssignment, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TodoAssignmentCreate) SaveX(ctx context.Context) *TodoAssignment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TodoAssignmentCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TodoAssignmentCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TodoAssignmentCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := todoassignment.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TodoAssignmentCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "TodoAssignment.tenant_id"`)}
+	}
+	if v, ok := _c.mutation.TenantID(); ok {
+		if err := todoassignment.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "TodoAssignment.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TodoID(); !ok {
+		return &ValidationError{Name: "todo_id", err: errors.New(`ent: missing required field "TodoAssignment.todo_id"`)}
+	}
+	if v, ok := _c.mutation.TodoID(); ok {
+		if err := todoassignment.TodoIDValidator(v); err != nil {
+			return &ValidationError{Name: "todo_id", err: fmt.Errorf(`ent: validator failed for field "TodoAssignment.todo_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ChangedBy(); !ok {
+		return &ValidationError{Name: "changed_by", err: errors.New(`ent: missing required field "TodoAssignment.changed_by"`)}
+	}
+	if v, ok := _c.mutation.ChangedBy(); ok {
+		if err := todoassignment.ChangedByValidator(v); err != nil {
+			return &ValidationError{Name: "changed_by", err: fmt.Errorf(`ent: validator failed for field "TodoAssignment.changed_by": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TodoAssignment.created_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := todoassignment.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "TodoAssignment.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.TodoIDs()) == 0 {
+		return &ValidationError{Name: "todo", err: errors.New(`ent: missing required edge "TodoAssignment.todo"`)}
+	}
+	return nil
+}
+
+func (_c *TodoAssignmentCreate) sqlSave(ctx context.Context) (*TodoAssignment, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected TodoAssignment.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TodoAssignmentCreate) createSpec() (*TodoAssignment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TodoAssignment{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(todoassignment.Table, sqlgraph.NewFieldSpec(todoassignment.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(todoassignment.FieldTenantID, field.TypeString, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.PreviousAssigneeID(); ok {
+		_spec.SetField(todoassignment.FieldPreviousAssigneeID, field.TypeString, value)
+		_node.PreviousAssigneeID = &value
+	}
+	if value, ok := _c.mutation.AssigneeID(); ok {
+		_spec.SetField(todoassignment.FieldAssigneeID, field.TypeString, value)
+		_node.AssigneeID = &value
+	}
+	if value, ok := _c.mutation.ChangedBy(); ok {
+		_spec.SetField(todoassignment.FieldChangedBy, field.TypeString, value)
+		_node.ChangedBy = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(todoassignment.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.TodoIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todoassignment.TodoTable,
+			Columns: []string{todoassignment.TodoColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TodoID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TodoAssignmentCreateBulk is the builder for creating many TodoAssignment entities in bulk.
+type TodoAssignmentCreateBulk struct {
+	config
+	err      error
+	builders []*TodoAssignmentCreate
+}
+
+// Save creates the TodoAssignment entities in the database.
+func (_c *TodoAssignmentCreateBulk) Save(ctx context.Context) ([]*TodoAssignment, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TodoAssignment, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TodoAssignmentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TodoAssignmentCreateBulk) SaveX(ctx context.Context) []*TodoAssignment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TodoAssignmentCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TodoAssignmentCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
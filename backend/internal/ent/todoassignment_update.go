@@ -0,0 +1,209 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"good-todo-go/internal/ent/predicate"
+	"good-todo-go/internal/ent/todoassignment"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TodoAssignmentUpdate is the builder for updating TodoAssignment entities.
+type TodoAssignmentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TodoAssignmentMutation
+}
+
+// Where appends a list predicates to the TodoAssignmentUpdate builder.
+func (_u *TodoAssignmentUpdate) Where(ps ...predicate.TodoAssignment) *TodoAssignmentUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the TodoAssignmentMutation object of the builder.
+func (_u *TodoAssignmentUpdate) Mutation() *TodoAssignmentMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TodoAssignmentUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TodoAssignmentUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TodoAssignmentUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TodoAssignmentUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TodoAssignmentUpdate) check() error {
+	if _u.mutation.TodoCleared() && len(_u.mutation.TodoIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TodoAssignment.todo"`)
+	}
+	return nil
+}
+
+func (_u *TodoAssignmentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(todoassignment.Table, todoassignment.Columns, sqlgraph.NewFieldSpec(todoassignment.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.PreviousAssigneeIDCleared() {
+		_spec.ClearField(todoassignment.FieldPreviousAssigneeID, field.TypeString)
+	}
+	if _u.mutation.AssigneeIDCleared() {
+		_spec.ClearField(todoassignment.FieldAssigneeID, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{todoassignment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TodoAssignmentUpdateOne is the builder for updating a single TodoAssignment entity.
+type TodoAssignmentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TodoAssignmentMutation
+}
+
+// Mutation returns the TodoAssignmentMutation object of the builder.
+func (_u *TodoAssignmentUpdateOne) Mutation() *TodoAssignmentMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the TodoAssignmentUpdate builder.
+func (_u *TodoAssignmentUpdateOne) Where(ps ...predicate.TodoAssignment) *TodoAssignmentUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TodoAssignmentUpdateOne) Select(field string, fields ...string) *TodoAssignmentUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TodoAssignment entity.
+func (_u *TodoAssignmentUpdateOne) Save(ctx context.Context) (*TodoAssignment, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TodoAssignmentUpdateOne) SaveX(ctx context.Context) *TodoAssignment {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TodoAssignmentUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TodoAssignmentUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TodoAssignmentUpdateOne) check() error {
+	if _u.mutation.TodoCleared() && len(_u.mutation.TodoIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TodoAssignment.todo"`)
+	}
+	return nil
+}
+
+func (_u *TodoAssignmentUpdateOne) sqlSave(ctx context.Context) (_node *TodoAssignment, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(todoassignment.Table, todoassignment.Columns, sqlgraph.NewFieldSpec(todoassignment.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TodoAssignment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, todoassignment.FieldID)
+		for _, f := range fields {
+			if !todoassignment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != todoassignment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.PreviousAssigneeIDCleared() {
+		_spec.ClearField(todoassignment.FieldPreviousAssigneeID, field.TypeString)
+	}
+	if _u.mutation.AssigneeIDCleared() {
+		_spec.ClearField(todoassignment.FieldAssigneeID, field.TypeString)
+	}
+	_node = &TodoAssignment{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{todoassignment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
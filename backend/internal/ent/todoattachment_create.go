@@ -0,0 +1,361 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"good-todo-go/internal/ent/todo"
+	"good-todo-go/internal/ent/todoattachment"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TodoAttachmentCreate is the builder for creating a TodoAttachment entity.
+type TodoAttachmentCreate struct {
+	config
+	mutation *TodoAttachmentMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *TodoAttachmentCreate) SetTenantID(v string) *TodoAttachmentCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetTodoID sets the "todo_id" field.
+func (_c *TodoAttachmentCreate) SetTodoID(v string) *TodoAttachmentCreate {
+	_c.mutation.SetTodoID(v)
+	return _c
+}
+
+// SetUploadedBy sets the "uploaded_by" field.
+func (_c *TodoAttachmentCreate) SetUploadedBy(v string) *TodoAttachmentCreate {
+	_c.mutation.SetUploadedBy(v)
+	return _c
+}
+
+// SetFileName sets the "file_name" field.
+func (_c *TodoAttachmentCreate) SetFileName(v string) *TodoAttachmentCreate {
+	_c.mutation.SetFileName(v)
+	return _c
+}
+
+// SetContentType sets the "content_type" field.
+func (_c *TodoAttachmentCreate) SetContentType(v string) *TodoAttachmentCreate {
+	_c.mutation.SetContentType(v)
+	return _c
+}
+
+// SetSize sets the "size" field.
+func (_c *TodoAttachmentCreate) SetSize(v int64) *TodoAttachmentCreate {
+	_c.mutation.SetSize(v)
+	return _c
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (_c *TodoAttachmentCreate) SetStorageKey(v string) *TodoAttachmentCreate {
+	_c.mutation.SetStorageKey(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TodoAttachmentCreate) SetCreatedAt(v time.Time) *TodoAttachmentCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TodoAttachmentCreate) SetNillableCreatedAt(v *time.Time) *TodoAttachmentCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TodoAttachmentCreate) SetID(v string) *TodoAttachmentCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetTodo sets the "todo" edge to the Todo entity.
+func (_c *TodoAttachmentCreate) SetTodo(v *Todo) *TodoAttachmentCreate {
+	return _c.SetTodoID(v.ID)
+}
+
+// Mutation returns the TodoAttachmentMutation object of the builder.
+func (_c *TodoAttachmentCreate) Mutation() *TodoAttachmentMutation {
+	return _c.mutation
+}
+
+// Save creates the TodoAttachment in the database.
+func (_c *TodoAttachmentCreate) Save(ctx context.Context) (*TodoAttachment, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TodoAttachmentCreate) SaveX(ctx context.Context) *TodoAttachment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TodoAttachmentCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TodoAttachmentCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TodoAttachmentCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := todoattachment.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TodoAttachmentCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "TodoAttachment.tenant_id"`)}
+	}
+	if v, ok := _c.mutation.TenantID(); ok {
+		if err := todoattachment.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "TodoAttachment.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TodoID(); !ok {
+		return &ValidationError{Name: "todo_id", err: errors.New(`ent: missing required field "TodoAttachment.todo_id"`)}
+	}
+	if v, ok := _c.mutation.TodoID(); ok {
+		if err := todoattachment.TodoIDValidator(v); err != nil {
+			return &ValidationError{Name: "todo_id", err: fmt.Errorf(`ent: validator failed for field "TodoAttachment.todo_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UploadedBy(); !ok {
+		return &ValidationError{Name: "uploaded_by", err: errors.New(`ent: missing required field "TodoAttachment.uploaded_by"`)}
+	}
+	if v, ok := _c.mutation.UploadedBy(); ok {
+		if err := todoattachment.UploadedByValidator(v); err != nil {
+			return &ValidationError{Name: "uploaded_by", err: fmt.Errorf(`ent: validator failed for field "TodoAttachment.uploaded_by": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.FileName(); !ok {
+		return &ValidationError{Name: "file_name", err: errors.New(`ent: missing required field "TodoAttachment.file_name"`)}
+	}
+	if v, ok := _c.mutation.FileName(); ok {
+		if err := todoattachment.FileNameValidator(v); err != nil {
+			return &ValidationError{Name: "file_name", err: fmt.Errorf(`ent: validator failed for field "TodoAttachment.file_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ContentType(); !ok {
+		return &ValidationError{Name: "content_type", err: errors.New(`ent: missing required field "TodoAttachment.content_type"`)}
+	}
+	if v, ok := _c.mutation.ContentType(); ok {
+		if err := todoattachment.ContentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "content_type", err: fmt.Errorf(`ent: validator failed for field "TodoAttachment.content_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "TodoAttachment.size"`)}
+	}
+	if _, ok := _c.mutation.StorageKey(); !ok {
+		return &ValidationError{Name: "storage_key", err: errors.New(`ent: missing required field "TodoAttachment.storage_key"`)}
+	}
+	if v, ok := _c.mutation.StorageKey(); ok {
+		if err := todoattachment.StorageKeyValidator(v); err != nil {
+			return &ValidationError{Name: "storage_key", err: fmt.Errorf(`ent: validator failed for field "TodoAttachment.storage_key": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TodoAttachment.created_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := todoattachment.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "TodoAttachment.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.TodoIDs()) == 0 {
+		return &ValidationError{Name: "todo", err: errors.New(`ent: missing required edge "TodoAttachment.todo"`)}
+	}
+	return nil
+}
+
+func (_c *TodoAttachmentCreate) sqlSave(ctx context.Context) (*TodoAttachment, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected TodoAttachment.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TodoAttachmentCreate) createSpec() (*TodoAttachment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TodoAttachment{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(todoattachment.Table, sqlgraph.NewFieldSpec(todoattachment.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(todoattachment.FieldTenantID, field.TypeString, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.UploadedBy(); ok {
+		_spec.SetField(todoattachment.FieldUploadedBy, field.TypeString, value)
+		_node.UploadedBy = value
+	}
+	if value, ok := _c.mutation.FileName(); ok {
+		_spec.SetField(todoattachment.FieldFileName, field.TypeString, value)
+		_node.FileName = value
+	}
+	if value, ok := _c.mutation.ContentType(); ok {
+		_spec.SetField(todoattachment.FieldContentType, field.TypeString, value)
+		_node.ContentType = value
+	}
+	if value, ok := _c.mutation.Size(); ok {
+		_spec.SetField(todoattachment.FieldSize, field.TypeInt64, value)
+		_node.Size = value
+	}
+	if value, ok := _c.mutation.StorageKey(); ok {
+		_spec.SetField(todoattachment.FieldStorageKey, field.TypeString, value)
+		_node.StorageKey = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(todoattachment.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.TodoIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todoattachment.TodoTable,
+			Columns: []string{todoattachment.TodoColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TodoID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TodoAttachmentCreateBulk is the builder for creating many TodoAttachment entities in bulk.
+type TodoAttachmentCreateBulk struct {
+	config
+	err      error
+	builders []*TodoAttachmentCreate
+}
+
+// Save creates the TodoAttachment entities in the database.
+func (_c *TodoAttachmentCreateBulk) Save(ctx context.Context) ([]*TodoAttachment, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TodoAttachment, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TodoAttachmentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TodoAttachmentCreateBulk) SaveX(ctx context.Context) []*TodoAttachment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TodoAttachmentCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TodoAttachmentCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
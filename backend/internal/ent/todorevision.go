@@ -0,0 +1,180 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"good-todo-go/internal/ent/todorevision"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// TodoRevision is the model entity for the TodoRevision schema.
+type TodoRevision struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID string `json:"tenant_id,omitempty"`
+	// Not a foreign key, so revisions outlive todos purged from the trash
+	TodoID string `json:"todo_id,omitempty"`
+	// Nil for changes made outside of a user's request; not a foreign key, so revisions outlive deleted users
+	ActorID *string `json:"actor_id,omitempty"`
+	// delete and restore move the todo to and from the trash; purge deletes it for good
+	Operation todorevision.Operation `json:"operation,omitempty"`
+	// Values of the changed fields before the change, keyed by column name
+	OldValues map[string]interface{} `json:"old_values,omitempty"`
+	// Values of the changed fields after the change, keyed by column name
+	NewValues map[string]interface{} `json:"new_values,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TodoRevision) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case todorevision.FieldOldValues, todorevision.FieldNewValues:
+			values[i] = new([]byte)
+		case todorevision.FieldID, todorevision.FieldTenantID, todorevision.FieldTodoID, todorevision.FieldActorID, todorevision.FieldOperation:
+			values[i] = new(sql.NullString)
+		case todorevision.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TodoRevision fields.
+func (_m *TodoRevision) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case todorevision.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case todorevision.FieldTenantID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = value.String
+			}
+		case todorevision.FieldTodoID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field todo_id", values[i])
+			} else if value.Valid {
+				_m.TodoID = value.String
+			}
+		case todorevision.FieldActorID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field actor_id", values[i])
+			} else if value.Valid {
+				_m.ActorID = new(string)
+				*_m.ActorID = value.String
+			}
+		case todorevision.FieldOperation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field operation", values[i])
+			} else if value.Valid {
+				_m.Operation = todorevision.Operation(value.String)
+			}
+		case todorevision.FieldOldValues:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field old_values", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.OldValues); err != nil {
+					return fmt.Errorf("unmarshal field old_values: %w", err)
+				}
+			}
+		case todorevision.FieldNewValues:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field new_values", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.NewValues); err != nil {
+					return fmt.Errorf("unmarshal field new_values: %w", err)
+				}
+			}
+		case todorevision.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TodoRevision.
+// This includes values selected through modifiers, order, etc.
+func (_m *TodoRevision) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TodoRevision.
+// Note that you need to call TodoRevision.Unwrap() before calling this method if this TodoRevision
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TodoRevision) Update() *TodoRevisionUpdateOne {
+	return NewTodoRevisionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TodoRevision entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TodoRevision) Unwrap() *TodoRevision {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TodoRevision is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TodoRevision) String() string {
+	var builder strings.Builder
+	builder.WriteString("TodoRevision(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(_m.TenantID)
+	builder.WriteString(", ")
+	builder.WriteString("todo_id=")
+	builder.WriteString(_m.TodoID)
+	builder.WriteString(", ")
+	if v := _m.ActorID; v != nil {
+		builder.WriteString("actor_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("operation=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Operation))
+	builder.WriteString(", ")
+	builder.WriteString("old_values=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OldValues))
+	builder.WriteString(", ")
+	builder.WriteString("new_values=")
+	builder.WriteString(fmt.Sprintf("%v", _m.NewValues))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TodoRevisions is a parsable slice of TodoRevision.
+type TodoRevisions []*TodoRevision
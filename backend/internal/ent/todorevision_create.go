@@ -0,0 +1,310 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"good-todo-go/internal/ent/todorevision"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TodoRevisionCreate is the builder for creating a TodoRevision entity.
+type TodoRevisionCreate struct {
+	config
+	mutation *TodoRevisionMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *TodoRevisionCreate) SetTenantID(v string) *TodoRevisionCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetTodoID sets the "todo_id" field.
+func (_c *TodoRevisionCreate) SetTodoID(v string) *TodoRevisionCreate {
+	_c.mutation.SetTodoID(v)
+	return _c
+}
+
+// SetActorID sets the "actor_id" field.
+func (_c *TodoRevisionCreate) SetActorID(v string) *TodoRevisionCreate {
+	_c.mutation.SetActorID(v)
+	return _c
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (_c *TodoRevisionCreate) SetNillableActorID(v *string) *TodoRevisionCreate {
+	if v != nil {
+		_c.SetActorID(*v)
+	}
+	return _c
+}
+
+// SetOperation sets the "operation" field.
+func (_c *TodoRevisionCreate) SetOperation(v todorevision.Operation) *TodoRevisionCreate {
+	_c.mutation.SetOperation(v)
+	return _c
+}
+
+// SetOldValues sets the "old_values" field.
+func (_c *TodoRevisionCreate) SetOldValues(v map[string]interface{}) *TodoRevisionCreate {
+	_c.mutation.SetOldValues(v)
+	return _c
+}
+
+// SetNewValues sets the "new_values" field.
+func (_c *TodoRevisionCreate) SetNewValues(v map[string]interface{}) *TodoRevisionCreate {
+	_c.mutation.SetNewValues(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TodoRevisionCreate) SetCreatedAt(v time.Time) *TodoRevisionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TodoRevisionCreate) SetNillableCreatedAt(v *time.Time) *TodoRevisionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TodoRevisionCreate) SetID(v string) *TodoRevisionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the TodoRevisionMutation object of the builder.
+func (_c *TodoRevisionCreate) Mutation() *TodoRevisionMutation {
+	return _c.mutation
+}
+
+// Save creates the TodoRevision in the database.
+func (_c *TodoRevisionCreate) Save(ctx context.Context) (*TodoRevision, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TodoRevisionCreate) SaveX(ctx context.Context) *TodoRevision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TodoRevisionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TodoRevisionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TodoRevisionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := todorevision.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TodoRevisionCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "TodoRevision.tenant_id"`)}
+	}
+	if v, ok := _c.mutation.TenantID(); ok {
+		if err := todorevision.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "TodoRevision.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TodoID(); !ok {
+		return &ValidationError{Name: "todo_id", err: errors.New(`ent: missing required field "TodoRevision.todo_id"`)}
+	}
+	if v, ok := _c.mutation.TodoID(); ok {
+		if err := todorevision.TodoIDValidator(v); err != nil {
+			return &ValidationError{Name: "todo_id", err: fmt.Errorf(`ent: validator failed for field "TodoRevision.todo_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Operation(); !ok {
+		return &ValidationError{Name: "operation", err: errors.New(`ent: missing required field "TodoRevision.operation"`)}
+	}
+	if v, ok := _c.mutation.Operation(); ok {
+		if err := todorevision.OperationValidator(v); err != nil {
+			return &ValidationError{Name: "operation", err: fmt.Errorf(`ent: validator failed for field "TodoRevision.operation": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TodoRevision.created_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := todorevision.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "TodoRevision.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *TodoRevisionCreate) sqlSave(ctx context.Context) (*TodoRevision, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected TodoRevision.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TodoRevisionCreate) createSpec() (*TodoRevision, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TodoRevision{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(todorevision.Table, sqlgraph.NewFieldSpec(todorevision.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(todorevision.FieldTenantID, field.TypeString, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.TodoID(); ok {
+		_spec.SetField(todorevision.FieldTodoID, field.TypeString, value)
+		_node.TodoID = value
+	}
+	if value, ok := _c.mutation.ActorID(); ok {
+		_spec.SetField(todorevision.FieldActorID, field.TypeString, value)
+		_node.ActorID = &value
+	}
+	if value, ok := _c.mutation.Operation(); ok {
+		_spec.SetField(todorevision.FieldOperation, field.TypeEnum, value)
+		_node.Operation = value
+	}
+	if value, ok := _c.mutation.OldValues(); ok {
+		_spec.SetField(todorevision.FieldOldValues, field.TypeJSON, value)
+		_node.OldValues = value
+	}
+	if value, ok := _c.mutation.NewValues(); ok {
+		_spec.SetField(todorevision.FieldNewValues, field.TypeJSON, value)
+		_node.NewValues = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(todorevision.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// TodoRevisionCreateBulk is the builder for creating many TodoRevision entities in bulk.
+type TodoRevisionCreateBulk struct {
+	config
+	err      error
+	builders []*TodoRevisionCreate
+}
+
+// Save creates the TodoRevision entities in the database.
+func (_c *TodoRevisionCreateBulk) Save(ctx context.Context) ([]*TodoRevision, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TodoRevision, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TodoRevisionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TodoRevisionCreateBulk) SaveX(ctx context.Context) []*TodoRevision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TodoRevisionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TodoRevisionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,284 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"good-todo-go/internal/ent/predicate"
+	"good-todo-go/internal/ent/todoshare"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TodoShareUpdate is the builder for updating TodoShare entities.
+type TodoShareUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TodoShareMutation
+}
+
+// Where appends a list predicates to the TodoShareUpdate builder.
+func (_u *TodoShareUpdate) Where(ps ...predicate.TodoShare) *TodoShareUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetPermission sets the "permission" field.
+func (_u *TodoShareUpdate) SetPermission(v todoshare.Permission) *TodoShareUpdate {
+	_u.mutation.SetPermission(v)
+	return _u
+}
+
+// SetNillablePermission sets the "permission" field if the given value is not nil.
+func (_u *TodoShareUpdate) SetNillablePermission(v *todoshare.Permission) *TodoShareUpdate {
+	if v != nil {
+		_u.SetPermission(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TodoShareUpdate) SetUpdatedAt(v time.Time) *TodoShareUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the TodoShareMutation object of the builder.
+func (_u *TodoShareUpdate) Mutation() *TodoShareMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TodoShareUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TodoShareUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TodoShareUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TodoShareUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TodoShareUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := todoshare.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TodoShareUpdate) check() error {
+	if v, ok := _u.mutation.Permission(); ok {
+		if err := todoshare.PermissionValidator(v); err != nil {
+			return &ValidationError{Name: "permission", err: fmt.Errorf(`ent: validator failed for field "TodoShare.permission": %w`, err)}
+		}
+	}
+	if _u.mutation.TodoCleared() && len(_u.mutation.TodoIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TodoShare.todo"`)
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TodoShare.user"`)
+	}
+	return nil
+}
+
+func (_u *TodoShareUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(todoshare.Table, todoshare.Columns, sqlgraph.NewFieldSpec(todoshare.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Permission(); ok {
+		_spec.SetField(todoshare.FieldPermission, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(todoshare.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{todoshare.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TodoShareUpdateOne is the builder for updating a single TodoShare entity.
+type TodoShareUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TodoShareMutation
+}
+
+// SetPermission sets the "permission" field.
+func (_u *TodoShareUpdateOne) SetPermission(v todoshare.Permission) *TodoShareUpdateOne {
+	_u.mutation.SetPermission(v)
+	return _u
+}
+
+// SetNillablePermission sets the "permission" field if the given value is not nil.
+func (_u *TodoShareUpdateOne) SetNillablePermission(v *todoshare.Permission) *TodoShareUpdateOne {
+	if v != nil {
+		_u.SetPermission(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TodoShareUpdateOne) SetUpdatedAt(v time.Time) *TodoShareUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the TodoShareMutation object of the builder.
+func (_u *TodoShareUpdateOne) Mutation() *TodoShareMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the TodoShareUpdate builder.
+func (_u *TodoShareUpdateOne) Where(ps ...predicate.TodoShare) *TodoShareUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TodoShareUpdateOne) Select(field string, fields ...string) *TodoShareUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TodoShare entity.
+func (_u *TodoShareUpdateOne) Save(ctx context.Context) (*TodoShare, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TodoShareUpdateOne) SaveX(ctx context.Context) *TodoShare {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TodoShareUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TodoShareUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TodoShareUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := todoshare.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TodoShareUpdateOne) check() error {
+	if v, ok := _u.mutation.Permission(); ok {
+		if err := todoshare.PermissionValidator(v); err != nil {
+			return &ValidationError{Name: "permission", err: fmt.Errorf(`ent: validator failed for field "TodoShare.permission": %w`, err)}
+		}
+	}
+	if _u.mutation.TodoCleared() && len(_u.mutation.TodoIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TodoShare.todo"`)
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TodoShare.user"`)
+	}
+	return nil
+}
+
+func (_u *TodoShareUpdateOne) sqlSave(ctx context.Context) (_node *TodoShare, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(todoshare.Table, todoshare.Columns, sqlgraph.NewFieldSpec(todoshare.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TodoShare.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, todoshare.FieldID)
+		for _, f := range fields {
+			if !todoshare.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != todoshare.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Permission(); ok {
+		_spec.SetField(todoshare.FieldPermission, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(todoshare.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &TodoShare{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{todoshare.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
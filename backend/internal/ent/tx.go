@@ -14,10 +14,38 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// AuditEvent is the client for interacting with the AuditEvent builders.
+	AuditEvent *AuditEventClient
+	// BlobDeletion is the client for interacting with the BlobDeletion builders.
+	BlobDeletion *BlobDeletionClient
+	// MagicLinkToken is the client for interacting with the MagicLinkToken builders.
+	MagicLinkToken *MagicLinkTokenClient
+	// Notification is the client for interacting with the Notification builders.
+	Notification *NotificationClient
+	// PasswordResetToken is the client for interacting with the PasswordResetToken builders.
+	PasswordResetToken *PasswordResetTokenClient
+	// Project is the client for interacting with the Project builders.
+	Project *ProjectClient
+	// Reminder is the client for interacting with the Reminder builders.
+	Reminder *ReminderClient
+	// Tag is the client for interacting with the Tag builders.
+	Tag *TagClient
 	// Tenant is the client for interacting with the Tenant builders.
 	Tenant *TenantClient
 	// Todo is the client for interacting with the Todo builders.
 	Todo *TodoClient
+	// TodoAssignment is the client for interacting with the TodoAssignment builders.
+	TodoAssignment *TodoAssignmentClient
+	// TodoAttachment is the client for interacting with the TodoAttachment builders.
+	TodoAttachment *TodoAttachmentClient
+	// TodoComment is the client for interacting with the TodoComment builders.
+	TodoComment *TodoCommentClient
+	// TodoRevision is the client for interacting with the TodoRevision builders.
+	TodoRevision *TodoRevisionClient
+	// TodoShare is the client for interacting with the TodoShare builders.
+	TodoShare *TodoShareClient
+	// TodoTag is the client for interacting with the TodoTag builders.
+	TodoTag *TodoTagClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 
@@ -151,8 +179,22 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.AuditEvent = NewAuditEventClient(tx.config)
+	tx.BlobDeletion = NewBlobDeletionClient(tx.config)
+	tx.MagicLinkToken = NewMagicLinkTokenClient(tx.config)
+	tx.Notification = NewNotificationClient(tx.config)
+	tx.PasswordResetToken = NewPasswordResetTokenClient(tx.config)
+	tx.Project = NewProjectClient(tx.config)
+	tx.Reminder = NewReminderClient(tx.config)
+	tx.Tag = NewTagClient(tx.config)
 	tx.Tenant = NewTenantClient(tx.config)
 	tx.Todo = NewTodoClient(tx.config)
+	tx.TodoAssignment = NewTodoAssignmentClient(tx.config)
+	tx.TodoAttachment = NewTodoAttachmentClient(tx.config)
+	tx.TodoComment = NewTodoCommentClient(tx.config)
+	tx.TodoRevision = NewTodoRevisionClient(tx.config)
+	tx.TodoShare = NewTodoShareClient(tx.config)
+	tx.TodoTag = NewTodoTagClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 }
 
@@ -163,7 +205,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Tenant.QueryXXX(), the query will be executed
+// applies a query, for example: AuditEvent.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -30,10 +30,18 @@ type User struct {
 	Role user.Role `json:"role,omitempty"`
 	// EmailVerified holds the value of the "email_verified" field.
 	EmailVerified bool `json:"email_verified,omitempty"`
+	// Deactivated users cannot sign in; set by SCIM deprovisioning
+	IsActive bool `json:"is_active,omitempty"`
+	// Accounts the app manages itself, like the deleted-user placeholder; hidden from user listings
+	IsSystem bool `json:"is_system,omitempty"`
+	// Identifier assigned by the tenant's identity provider (SCIM externalId)
+	ExternalID *string `json:"external_id,omitempty"`
 	// VerificationToken holds the value of the "verification_token" field.
 	VerificationToken *string `json:"verification_token,omitempty"`
 	// VerificationTokenExpiresAt holds the value of the "verification_token_expires_at" field.
 	VerificationTokenExpiresAt *time.Time `json:"verification_token_expires_at,omitempty"`
+	// SHA-256 of the secret token in the user's calendar feed URL
+	CalendarTokenHash *string `json:"-"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -50,9 +58,23 @@ type UserEdges struct {
 	Tenant *Tenant `json:"tenant,omitempty"`
 	// Todos holds the value of the todos edge.
 	Todos []*Todo `json:"todos,omitempty"`
+	// AssignedTodos holds the value of the assigned_todos edge.
+	AssignedTodos []*Todo `json:"assigned_todos,omitempty"`
+	// Projects holds the value of the projects edge.
+	Projects []*Project `json:"projects,omitempty"`
+	// MagicLinkTokens holds the value of the magic_link_tokens edge.
+	MagicLinkTokens []*MagicLinkToken `json:"magic_link_tokens,omitempty"`
+	// PasswordResetTokens holds the value of the password_reset_tokens edge.
+	PasswordResetTokens []*PasswordResetToken `json:"password_reset_tokens,omitempty"`
+	// Notifications holds the value of the notifications edge.
+	Notifications []*Notification `json:"notifications,omitempty"`
+	// SharedTodos holds the value of the shared_todos edge.
+	SharedTodos []*TodoShare `json:"shared_todos,omitempty"`
+	// Comments holds the value of the comments edge.
+	Comments []*TodoComment `json:"comments,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [9]bool
 }
 
 // TenantOrErr returns the Tenant value or an error if the edge
@@ -75,14 +97,77 @@ func (e UserEdges) TodosOrErr() ([]*Todo, error) {
 	return nil, &NotLoadedError{edge: "todos"}
 }
 
+// AssignedTodosOrErr returns the AssignedTodos value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) AssignedTodosOrErr() ([]*Todo, error) {
+	if e.loadedTypes[2] {
+		return e.AssignedTodos, nil
+	}
+	return nil, &NotLoadedError{edge: "assigned_todos"}
+}
+
+// ProjectsOrErr returns the Projects value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ProjectsOrErr() ([]*Project, error) {
+	if e.loadedTypes[3] {
+		return e.Projects, nil
+	}
+	return nil, &NotLoadedError{edge: "projects"}
+}
+
+// MagicLinkTokensOrErr returns the MagicLinkTokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) MagicLinkTokensOrErr() ([]*MagicLinkToken, error) {
+	if e.loadedTypes[4] {
+		return e.MagicLinkTokens, nil
+	}
+	return nil, &NotLoadedError{edge: "magic_link_tokens"}
+}
+
+// PasswordResetTokensOrErr returns the PasswordResetTokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) PasswordResetTokensOrErr() ([]*PasswordResetToken, error) {
+	if e.loadedTypes[5] {
+		return e.PasswordResetTokens, nil
+	}
+	return nil, &NotLoadedError{edge: "password_reset_tokens"}
+}
+
+// NotificationsOrErr returns the Notifications value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) NotificationsOrErr() ([]*Notification, error) {
+	if e.loadedTypes[6] {
+		return e.Notifications, nil
+	}
+	return nil, &NotLoadedError{edge: "notifications"}
+}
+
+// SharedTodosOrErr returns the SharedTodos value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) SharedTodosOrErr() ([]*TodoShare, error) {
+	if e.loadedTypes[7] {
+		return e.SharedTodos, nil
+	}
+	return nil, &NotLoadedError{edge: "shared_todos"}
+}
+
+// CommentsOrErr returns the Comments value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) CommentsOrErr() ([]*TodoComment, error) {
+	if e.loadedTypes[8] {
+		return e.Comments, nil
+	}
+	return nil, &NotLoadedError{edge: "comments"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case user.FieldEmailVerified:
+		case user.FieldEmailVerified, user.FieldIsActive, user.FieldIsSystem:
 			values[i] = new(sql.NullBool)
-		case user.FieldID, user.FieldTenantID, user.FieldEmail, user.FieldPasswordHash, user.FieldName, user.FieldRole, user.FieldVerificationToken:
+		case user.FieldID, user.FieldTenantID, user.FieldEmail, user.FieldPasswordHash, user.FieldName, user.FieldRole, user.FieldExternalID, user.FieldVerificationToken, user.FieldCalendarTokenHash:
 			values[i] = new(sql.NullString)
 		case user.FieldVerificationTokenExpiresAt, user.FieldCreatedAt, user.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -143,6 +228,25 @@ func (_m *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.EmailVerified = value.Bool
 			}
+		case user.FieldIsActive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_active", values[i])
+			} else if value.Valid {
+				_m.IsActive = value.Bool
+			}
+		case user.FieldIsSystem:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_system", values[i])
+			} else if value.Valid {
+				_m.IsSystem = value.Bool
+			}
+		case user.FieldExternalID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field external_id", values[i])
+			} else if value.Valid {
+				_m.ExternalID = new(string)
+				*_m.ExternalID = value.String
+			}
 		case user.FieldVerificationToken:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field verification_token", values[i])
@@ -157,6 +261,13 @@ func (_m *User) assignValues(columns []string, values []any) error {
 				_m.VerificationTokenExpiresAt = new(time.Time)
 				*_m.VerificationTokenExpiresAt = value.Time
 			}
+		case user.FieldCalendarTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field calendar_token_hash", values[i])
+			} else if value.Valid {
+				_m.CalendarTokenHash = new(string)
+				*_m.CalendarTokenHash = value.String
+			}
 		case user.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -192,6 +303,41 @@ func (_m *User) QueryTodos() *TodoQuery {
 	return NewUserClient(_m.config).QueryTodos(_m)
 }
 
+// QueryAssignedTodos queries the "assigned_todos" edge of the User entity.
+func (_m *User) QueryAssignedTodos() *TodoQuery {
+	return NewUserClient(_m.config).QueryAssignedTodos(_m)
+}
+
+// QueryProjects queries the "projects" edge of the User entity.
+func (_m *User) QueryProjects() *ProjectQuery {
+	return NewUserClient(_m.config).QueryProjects(_m)
+}
+
+// QueryMagicLinkTokens queries the "magic_link_tokens" edge of the User entity.
+func (_m *User) QueryMagicLinkTokens() *MagicLinkTokenQuery {
+	return NewUserClient(_m.config).QueryMagicLinkTokens(_m)
+}
+
+// QueryPasswordResetTokens queries the "password_reset_tokens" edge of the User entity.
+func (_m *User) QueryPasswordResetTokens() *PasswordResetTokenQuery {
+	return NewUserClient(_m.config).QueryPasswordResetTokens(_m)
+}
+
+// QueryNotifications queries the "notifications" edge of the User entity.
+func (_m *User) QueryNotifications() *NotificationQuery {
+	return NewUserClient(_m.config).QueryNotifications(_m)
+}
+
+// QuerySharedTodos queries the "shared_todos" edge of the User entity.
+func (_m *User) QuerySharedTodos() *TodoShareQuery {
+	return NewUserClient(_m.config).QuerySharedTodos(_m)
+}
+
+// QueryComments queries the "comments" edge of the User entity.
+func (_m *User) QueryComments() *TodoCommentQuery {
+	return NewUserClient(_m.config).QueryComments(_m)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -232,6 +378,17 @@ func (_m *User) String() string {
 	builder.WriteString("email_verified=")
 	builder.WriteString(fmt.Sprintf("%v", _m.EmailVerified))
 	builder.WriteString(", ")
+	builder.WriteString("is_active=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsActive))
+	builder.WriteString(", ")
+	builder.WriteString("is_system=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsSystem))
+	builder.WriteString(", ")
+	if v := _m.ExternalID; v != nil {
+		builder.WriteString("external_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	if v := _m.VerificationToken; v != nil {
 		builder.WriteString("verification_token=")
 		builder.WriteString(*v)
@@ -242,6 +399,8 @@ func (_m *User) String() string {
 		builder.WriteString(v.Format(time.ANSIC))
 	}
 	builder.WriteString(", ")
+	builder.WriteString("calendar_token_hash=<sensitive>")
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -6,6 +6,8 @@ import (
 	"fmt"
 
 	"good-todo-go/internal/ent"
+	// The schema's defaults, validators and interceptors are registered here
+	_ "good-todo-go/internal/ent/runtime"
 	"good-todo-go/internal/infrastructure/environment"
 
 	entsql "entgo.io/ent/dialect/sql"
@@ -103,6 +103,23 @@ func WithTenantScope(ctx context.Context, client *ent.Client, tenantID string) (
 	return tx, nil
 }
 
+// WithAuditAllTenantsScope starts a transaction that can read the audit log of
+// every tenant through the audit_events_all_tenants_select policy. It is only
+// meant for the admin API; no other table honours the setting.
+func WithAuditAllTenantsScope(ctx context.Context, client *ent.Client) (*ent.Tx, error) {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, "SET LOCAL app.audit_all_tenants = 'on'"); err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("failed to set audit scope: %w", err)
+	}
+
+	return tx, nil
+}
+
 // TenantScopedTx automatically gets tenantID from context and starts a scoped transaction
 // Usage:
 //
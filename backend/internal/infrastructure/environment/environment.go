@@ -1,6 +1,8 @@
 package environment
 
 import (
+	"time"
+
 	"github.com/caarlos0/env/v11"
 	"github.com/joho/godotenv"
 )
@@ -11,6 +13,9 @@ type Config struct {
 	AdminPort string `env:"ADMIN_PORT" envDefault:"8001"`
 	AppEnv    string `env:"APP_ENV" envDefault:"local"`
 
+	// FrontendURL is the base URL used for links sent by email
+	FrontendURL string `env:"FRONTEND_URL" envDefault:"http://localhost:3000"`
+
 	// Database
 	DBHost     string `env:"POSTGRES_DB_HOST" envDefault:"localhost"`
 	DBPort     string `env:"POSTGRES_DB_PORT" envDefault:"5432"`
@@ -23,12 +28,40 @@ type Config struct {
 	JWTExpiresIn        int    `env:"JWT_EXPIRES_IN" envDefault:"3600"`
 	JWTRefreshExpiresIn int    `env:"JWT_REFRESH_EXPIRES_IN" envDefault:"604800"`
 
+	// AdminAPIToken is the bearer token of the admin API; the admin server does not start without one
+	AdminAPIToken string `env:"ADMIN_API_TOKEN" envDefault:""`
+
+	// Password policy
+	PasswordMinLength int `env:"PASSWORD_MIN_LENGTH" envDefault:"8"`
+	PasswordMinScore  int `env:"PASSWORD_MIN_SCORE" envDefault:"3"`
+
 	// SMTP
 	SMTPHost     string `env:"SMTP_HOST" envDefault:"localhost"`
 	SMTPPort     string `env:"SMTP_PORT" envDefault:"1025"`
 	SMTPUser     string `env:"SMTP_USER" envDefault:""`
 	SMTPPassword string `env:"SMTP_PASSWORD" envDefault:""`
 	SMTPFrom     string `env:"SMTP_FROM" envDefault:"noreply@goodtodo.local"`
+
+	// Reminder scheduler
+	ReminderPollInterval time.Duration `env:"REMINDER_POLL_INTERVAL" envDefault:"30s"`
+	ReminderBatchSize    int           `env:"REMINDER_BATCH_SIZE" envDefault:"100"`
+
+	// Attachments
+	AttachmentMaxBytes int64 `env:"ATTACHMENT_MAX_BYTES" envDefault:"10485760"`
+	// BlobCleanupBatchSize is how many blobs of deleted attachments the scheduler removes per tenant and run
+	BlobCleanupBatchSize int `env:"BLOB_CLEANUP_BATCH_SIZE" envDefault:"100"`
+
+	// TrashPurgeBatchSize is how many expired todos in the trash the scheduler removes per tenant and run
+	TrashPurgeBatchSize int `env:"TRASH_PURGE_BATCH_SIZE" envDefault:"100"`
+
+	// Blob store: local or s3. The S3 settings work with any S3-compatible service.
+	BlobStoreDriver   string `env:"BLOB_STORE_DRIVER" envDefault:"local"`
+	BlobStoreLocalDir string `env:"BLOB_STORE_LOCAL_DIR" envDefault:"./data/blobs"`
+	S3Endpoint        string `env:"S3_ENDPOINT" envDefault:"https://s3.amazonaws.com"`
+	S3Region          string `env:"S3_REGION" envDefault:"us-east-1"`
+	S3Bucket          string `env:"S3_BUCKET" envDefault:""`
+	S3AccessKeyID     string `env:"S3_ACCESS_KEY_ID" envDefault:""`
+	S3SecretAccessKey string `env:"S3_SECRET_ACCESS_KEY" envDefault:""`
 }
 
 func LoadConfig() (*Config, error) {
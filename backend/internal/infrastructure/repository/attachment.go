@@ -0,0 +1,167 @@
+package repository
+
+import (
+	"context"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/ent"
+	"good-todo-go/internal/ent/blobdeletion"
+	"good-todo-go/internal/ent/todoattachment"
+	"good-todo-go/internal/infrastructure/database"
+)
+
+type AttachmentRepository struct {
+	client *ent.Client
+}
+
+func NewAttachmentRepository(client *ent.Client) repository.IAttachmentRepository {
+	return &AttachmentRepository{client: client}
+}
+
+// FindByID reads a single attachment (RLS handles tenant isolation)
+func (r *AttachmentRepository) FindByID(ctx context.Context, attachmentID string) (*model.Attachment, error) {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	a, err := tx.TodoAttachment.Get(ctx, attachmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return toAttachmentModel(a), nil
+}
+
+// FindByTodoID reads a todo's attachments (RLS handles tenant isolation)
+func (r *AttachmentRepository) FindByTodoID(ctx context.Context, todoID string) ([]*model.Attachment, error) {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	attachments, err := tx.TodoAttachment.Query().
+		Where(todoattachment.TodoIDEQ(todoID)).
+		Order(todoattachment.ByCreatedAt(), todoattachment.ByID()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.Attachment, len(attachments))
+	for i, a := range attachments {
+		result[i] = toAttachmentModel(a)
+	}
+	return result, nil
+}
+
+// Create writes directly to todo_attachments table (RLS protected)
+func (r *AttachmentRepository) Create(ctx context.Context, m *model.Attachment) (*model.Attachment, error) {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	created, err := tx.TodoAttachment.Create().
+		SetID(m.ID).
+		SetTenantID(m.TenantID).
+		SetTodoID(m.TodoID).
+		SetUploadedBy(m.UploadedBy).
+		SetFileName(m.FileName).
+		SetContentType(m.ContentType).
+		SetSize(m.Size).
+		SetStorageKey(m.StorageKey).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return toAttachmentModel(created), nil
+}
+
+// Delete writes directly to todo_attachments table (RLS protected); a
+// trigger queues the blob in blob_deletions
+func (r *AttachmentRepository) Delete(ctx context.Context, attachmentID string) error {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := tx.TodoAttachment.DeleteOneID(attachmentID).Exec(ctx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// PendingBlobDeletions reads the tenant's blob deletion queue (RLS handles tenant isolation)
+func (r *AttachmentRepository) PendingBlobDeletions(ctx context.Context, tenantID string, limit int) ([]string, error) {
+	tx, err := database.WithTenantScope(ctx, r.client, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	keys, err := tx.BlobDeletion.Query().
+		Order(blobdeletion.ByCreatedAt(), blobdeletion.ByID()).
+		Limit(limit).
+		IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// CompleteBlobDeletions writes directly to blob_deletions table (RLS protected)
+func (r *AttachmentRepository) CompleteBlobDeletions(ctx context.Context, tenantID string, storageKeys []string) error {
+	if len(storageKeys) == 0 {
+		return nil
+	}
+
+	tx, err := database.WithTenantScope(ctx, r.client, tenantID)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.BlobDeletion.Delete().
+		Where(blobdeletion.IDIn(storageKeys...)).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func toAttachmentModel(a *ent.TodoAttachment) *model.Attachment {
+	return &model.Attachment{
+		ID:          a.ID,
+		TenantID:    a.TenantID,
+		TodoID:      a.TodoID,
+		UploadedBy:  a.UploadedBy,
+		FileName:    a.FileName,
+		ContentType: a.ContentType,
+		Size:        a.Size,
+		StorageKey:  a.StorageKey,
+		CreatedAt:   a.CreatedAt,
+	}
+}
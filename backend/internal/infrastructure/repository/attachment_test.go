@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/infrastructure/database"
+	"good-todo-go/internal/integration_test/common"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttachmentRepository_BlobDeletionQueue(t *testing.T) {
+	t.Parallel()
+
+	client := common.SetupTestClient(t)
+
+	tenant := common.CreateTenant(t, client, common.DefaultTenantBuilder(client, ""))
+	user := common.CreateUser(t, client, common.DefaultUserBuilder(client, "", tenant.ID))
+	todo := common.CreateTodo(t, client, common.DefaultTodoBuilder(client, "todo-attached", tenant.ID, user.ID))
+
+	repo := NewAttachmentRepository(client)
+	todoRepo := NewTodoRepository(client)
+	ctx := database.WithTenantID(context.Background(), tenant.ID)
+
+	for _, id := range []string{"attachment-1", "attachment-2", "attachment-3"} {
+		_, err := repo.Create(ctx, &model.Attachment{
+			ID:          id,
+			TenantID:    tenant.ID,
+			TodoID:      todo.ID,
+			UploadedBy:  user.ID,
+			FileName:    id + ".txt",
+			ContentType: "text/plain; charset=utf-8",
+			Size:        5,
+			StorageKey:  tenant.ID + "/" + id,
+		})
+		require.NoError(t, err)
+	}
+
+	attachments, err := repo.FindByTodoID(ctx, todo.ID)
+	require.NoError(t, err)
+	require.Len(t, attachments, 3)
+
+	pending, err := repo.PendingBlobDeletions(context.Background(), tenant.ID, 10)
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+
+	// Deleting one attachment and then the todo queues every blob
+	require.NoError(t, repo.Delete(ctx, "attachment-1"))
+	require.NoError(t, todoRepo.Delete(ctx, todo.ID, todo.Version))
+
+	attachments, err = repo.FindByTodoID(ctx, todo.ID)
+	require.NoError(t, err)
+	assert.Empty(t, attachments)
+
+	pending, err = repo.PendingBlobDeletions(context.Background(), tenant.ID, 10)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{
+		tenant.ID + "/attachment-1",
+		tenant.ID + "/attachment-2",
+		tenant.ID + "/attachment-3",
+	}, pending)
+
+	require.NoError(t, repo.CompleteBlobDeletions(context.Background(), tenant.ID, pending[:2]))
+
+	pending, err = repo.PendingBlobDeletions(context.Background(), tenant.ID, 10)
+	require.NoError(t, err)
+	assert.Len(t, pending, 1)
+}
+
+func TestAttachmentRepository_RLS_TenantIsolation(t *testing.T) {
+	t.Parallel()
+
+	adminClient, appClient := common.SetupTestClientWithRLS(t)
+
+	tenant1 := common.CreateTenant(t, adminClient, common.DefaultTenantBuilder(adminClient, "tenant1-attachment"))
+	tenant2 := common.CreateTenant(t, adminClient, common.DefaultTenantBuilder(adminClient, "tenant2-attachment"))
+	user := common.CreateUser(t, adminClient, common.DefaultUserBuilder(adminClient, "", tenant1.ID).SetEmail("uploader-attachment@example.com"))
+	todo := common.CreateTodo(t, adminClient, common.DefaultTodoBuilder(adminClient, "todo-rls-attachment", tenant1.ID, user.ID))
+
+	repo := NewAttachmentRepository(appClient)
+	ctx1 := database.WithTenantID(context.Background(), tenant1.ID)
+	ctx2 := database.WithTenantID(context.Background(), tenant2.ID)
+
+	attachment := &model.Attachment{
+		ID:          "attachment-rls",
+		TenantID:    tenant1.ID,
+		TodoID:      todo.ID,
+		UploadedBy:  user.ID,
+		FileName:    "notes.txt",
+		ContentType: "text/plain; charset=utf-8",
+		Size:        5,
+		StorageKey:  tenant1.ID + "/attachment-rls",
+	}
+	_, err := repo.Create(ctx1, attachment)
+	require.NoError(t, err)
+
+	attachments, err := repo.FindByTodoID(ctx2, todo.ID)
+	require.NoError(t, err)
+	assert.Empty(t, attachments)
+
+	_, err = repo.FindByID(ctx2, "attachment-rls")
+	assert.Error(t, err)
+
+	// The queue of one tenant is invisible to another
+	require.NoError(t, repo.Delete(ctx1, "attachment-rls"))
+
+	pending, err := repo.PendingBlobDeletions(context.Background(), tenant2.ID, 10)
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+
+	pending, err = repo.PendingBlobDeletions(context.Background(), tenant1.ID, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{tenant1.ID + "/attachment-rls"}, pending)
+}
@@ -0,0 +1,147 @@
+package repository
+
+import (
+	"context"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/ent"
+	"good-todo-go/internal/ent/auditevent"
+	"good-todo-go/internal/infrastructure/database"
+)
+
+type AuditEventRepository struct {
+	client *ent.Client
+}
+
+func NewAuditEventRepository(client *ent.Client) repository.IAuditEventRepository {
+	return &AuditEventRepository{client: client}
+}
+
+func (r *AuditEventRepository) Create(ctx context.Context, e *model.AuditEvent) error {
+	tx, err := database.WithTenantScope(ctx, r.client, e.TenantID)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	builder := tx.AuditEvent.Create().
+		SetID(e.ID).
+		SetTenantID(e.TenantID).
+		SetActorType(auditevent.ActorType(e.ActorType)).
+		SetNillableActorID(e.ActorID).
+		SetAction(e.Action).
+		SetNillableTargetType(e.TargetType).
+		SetNillableTargetID(e.TargetID).
+		SetIP(e.IP).
+		SetUserAgent(e.UserAgent).
+		SetRequestID(e.RequestID)
+
+	if len(e.Metadata) > 0 {
+		builder.SetMetadata(e.Metadata)
+	}
+
+	if err := builder.Exec(ctx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *AuditEventRepository) List(ctx context.Context, filter *model.AuditEventFilter, offset, limit int) ([]*model.AuditEvent, int, error) {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer tx.Rollback()
+
+	events, total, err := listAuditEvents(ctx, tx.AuditEvent.Query(), filter, offset, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, 0, err
+	}
+	return events, total, nil
+}
+
+func (r *AuditEventRepository) ListAll(ctx context.Context, filter *model.AuditEventFilter, offset, limit int) ([]*model.AuditEvent, int, error) {
+	tx, err := database.WithAuditAllTenantsScope(ctx, r.client)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer tx.Rollback()
+
+	query := tx.AuditEvent.Query()
+	if filter != nil && filter.TenantID != nil {
+		query = query.Where(auditevent.TenantIDEQ(*filter.TenantID))
+	}
+
+	events, total, err := listAuditEvents(ctx, query, filter, offset, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, 0, err
+	}
+	return events, total, nil
+}
+
+func listAuditEvents(ctx context.Context, query *ent.AuditEventQuery, filter *model.AuditEventFilter, offset, limit int) ([]*model.AuditEvent, int, error) {
+	if filter != nil {
+		if filter.ActorID != nil {
+			query = query.Where(auditevent.ActorIDEQ(*filter.ActorID))
+		}
+		if filter.Action != nil {
+			query = query.Where(auditevent.ActionEQ(*filter.Action))
+		}
+		if filter.TargetID != nil {
+			query = query.Where(auditevent.TargetIDEQ(*filter.TargetID))
+		}
+		if filter.From != nil {
+			query = query.Where(auditevent.CreatedAtGTE(*filter.From))
+		}
+		if filter.To != nil {
+			query = query.Where(auditevent.CreatedAtLT(*filter.To))
+		}
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	events, err := query.
+		Order(ent.Desc(auditevent.FieldCreatedAt), ent.Desc(auditevent.FieldID)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	result := make([]*model.AuditEvent, len(events))
+	for i, e := range events {
+		result[i] = toAuditEventModel(e)
+	}
+	return result, total, nil
+}
+
+func toAuditEventModel(e *ent.AuditEvent) *model.AuditEvent {
+	return &model.AuditEvent{
+		ID:         e.ID,
+		TenantID:   e.TenantID,
+		ActorType:  string(e.ActorType),
+		ActorID:    e.ActorID,
+		Action:     e.Action,
+		TargetType: e.TargetType,
+		TargetID:   e.TargetID,
+		IP:         e.IP,
+		UserAgent:  e.UserAgent,
+		RequestID:  e.RequestID,
+		Metadata:   e.Metadata,
+		CreatedAt:  e.CreatedAt,
+	}
+}
@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/infrastructure/database"
+	"good-todo-go/internal/integration_test/common"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditEventRepository_CreateAndList(t *testing.T) {
+	t.Parallel()
+
+	adminClient, appClient := common.SetupTestClientWithRLS(t)
+	dataSet := common.CreateTestDataSet(t, adminClient)
+	repo := NewAuditEventRepository(appClient)
+
+	actorID := dataSet.User1.ID
+	targetType := model.AuditTargetUser
+	require.NoError(t, repo.Create(context.Background(), &model.AuditEvent{
+		ID:         "audit-event-1",
+		TenantID:   dataSet.Tenant1.ID,
+		ActorType:  model.AuditActorUser,
+		ActorID:    &actorID,
+		Action:     model.AuditActionPasswordChanged,
+		TargetType: &targetType,
+		TargetID:   &actorID,
+		IP:         "203.0.113.7",
+	}))
+	require.NoError(t, repo.Create(context.Background(), &model.AuditEvent{
+		ID:        "audit-event-2",
+		TenantID:  dataSet.Tenant1.ID,
+		ActorType: model.AuditActorAnonymous,
+		Action:    model.AuditActionLoginFailed,
+		Metadata:  map[string]string{"reason": "unknown_user"},
+	}))
+	require.NoError(t, repo.Create(context.Background(), &model.AuditEvent{
+		ID:        "audit-event-3",
+		TenantID:  dataSet.Tenant2.ID,
+		ActorType: model.AuditActorAnonymous,
+		Action:    model.AuditActionLoginFailed,
+	}))
+
+	ctx := database.WithTenantID(context.Background(), dataSet.Tenant1.ID)
+
+	t.Run("lists only the current tenant's events", func(t *testing.T) {
+		events, total, err := repo.List(ctx, &model.AuditEventFilter{}, 0, 10)
+		require.NoError(t, err)
+		assert.Equal(t, 2, total)
+		assert.Len(t, events, 2)
+	})
+
+	t.Run("filters by action", func(t *testing.T) {
+		action := model.AuditActionLoginFailed
+		events, total, err := repo.List(ctx, &model.AuditEventFilter{Action: &action}, 0, 10)
+		require.NoError(t, err)
+		require.Equal(t, 1, total)
+		assert.Equal(t, "audit-event-2", events[0].ID)
+		assert.Equal(t, "unknown_user", events[0].Metadata["reason"])
+	})
+
+	t.Run("filters by actor", func(t *testing.T) {
+		events, total, err := repo.List(ctx, &model.AuditEventFilter{ActorID: &actorID}, 0, 10)
+		require.NoError(t, err)
+		require.Equal(t, 1, total)
+		assert.Equal(t, "203.0.113.7", events[0].IP)
+	})
+
+	t.Run("lists every tenant's events without tenant context", func(t *testing.T) {
+		events, total, err := repo.ListAll(context.Background(), &model.AuditEventFilter{}, 0, 10)
+		require.NoError(t, err)
+		assert.Equal(t, 3, total)
+		assert.Len(t, events, 3)
+
+		tenantID := dataSet.Tenant2.ID
+		events, total, err = repo.ListAll(context.Background(), &model.AuditEventFilter{TenantID: &tenantID}, 0, 10)
+		require.NoError(t, err)
+		require.Equal(t, 1, total)
+		assert.Equal(t, "audit-event-3", events[0].ID)
+	})
+
+	t.Run("the cross-tenant scope does not reach other tables", func(t *testing.T) {
+		tx, err := database.WithAuditAllTenantsScope(context.Background(), appClient)
+		require.NoError(t, err)
+		defer tx.Rollback()
+
+		count, err := tx.User.Query().Count(context.Background())
+		require.NoError(t, err)
+		assert.Zero(t, count)
+	})
+
+	t.Run("events cannot be modified or deleted", func(t *testing.T) {
+		tx, err := database.WithTenantScope(context.Background(), appClient, dataSet.Tenant1.ID)
+		require.NoError(t, err)
+		defer tx.Rollback()
+
+		_, err = tx.ExecContext(context.Background(), `DELETE FROM "audit_events" WHERE "id" = $1`, "audit-event-1")
+		require.Error(t, err)
+	})
+}
@@ -3,12 +3,16 @@ package repository
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"good-todo-go/internal/domain/model"
 	"good-todo-go/internal/domain/repository"
 	"good-todo-go/internal/ent"
+	"good-todo-go/internal/ent/magiclinktoken"
+	"good-todo-go/internal/ent/passwordresettoken"
 	"good-todo-go/internal/ent/tenant"
 	"good-todo-go/internal/ent/user"
+	"good-todo-go/internal/infrastructure/database"
 )
 
 type AuthRepository struct {
@@ -29,6 +33,14 @@ func (r *AuthRepository) FindTenantBySlug(ctx context.Context, slug string) (*mo
 	return toTenantModel(t), nil
 }
 
+func (r *AuthRepository) FindTenantByID(ctx context.Context, tenantID string) (*model.Tenant, error) {
+	t, err := r.client.Tenant.Get(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	return toTenantModel(t), nil
+}
+
 func (r *AuthRepository) CreateTenant(ctx context.Context, t *model.Tenant) (*model.Tenant, error) {
 	created, err := r.client.Tenant.Create().
 		SetID(t.ID).
@@ -126,8 +138,12 @@ func (r *AuthRepository) CreateUser(ctx context.Context, u *model.User) (*model.
 		SetPasswordHash(u.PasswordHash).
 		SetName(u.Name).
 		SetRole(user.Role(u.Role)).
-		SetEmailVerified(u.EmailVerified)
+		SetEmailVerified(u.EmailVerified).
+		SetIsActive(u.IsActive)
 
+	if u.ExternalID != nil {
+		builder.SetExternalID(*u.ExternalID)
+	}
 	if u.VerificationToken != nil {
 		builder.SetVerificationToken(*u.VerificationToken)
 	}
@@ -162,6 +178,7 @@ func (r *AuthRepository) UpdateUser(ctx context.Context, u *model.User) (*model.
 
 	builder := tx.User.UpdateOneID(u.ID).
 		SetName(u.Name).
+		SetPasswordHash(u.PasswordHash).
 		SetEmailVerified(u.EmailVerified)
 
 	if u.VerificationToken != nil {
@@ -188,11 +205,185 @@ func (r *AuthRepository) UpdateUser(ctx context.Context, u *model.User) (*model.
 	return toUserModel(updated), nil
 }
 
+func (r *AuthRepository) CreateMagicLinkToken(ctx context.Context, t *model.MagicLinkToken) error {
+	tx, err := database.WithTenantScope(ctx, r.client, t.TenantID)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.MagicLinkToken.Create().
+		SetID(t.ID).
+		SetTenantID(t.TenantID).
+		SetUserID(t.UserID).
+		SetTokenHash(t.TokenHash).
+		SetExpiresAt(t.ExpiresAt).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (r *AuthRepository) ConsumeMagicLinkToken(ctx context.Context, tenantID, tokenHash string, now time.Time) (*model.MagicLinkToken, error) {
+	tx, err := database.WithTenantScope(ctx, r.client, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	t, err := tx.MagicLinkToken.Query().
+		Where(
+			magiclinktoken.TokenHashEQ(tokenHash),
+			magiclinktoken.UsedAtIsNil(),
+			magiclinktoken.ExpiresAtGT(now),
+		).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The used_at guard makes the UPDATE the point of truth, so a concurrent
+	// consumer of the same token gets a not found error instead of a second login
+	t, err = tx.MagicLinkToken.UpdateOne(t).
+		Where(magiclinktoken.UsedAtIsNil()).
+		SetUsedAt(now).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return toMagicLinkTokenModel(t), nil
+}
+
+func (r *AuthRepository) CreatePasswordResetToken(ctx context.Context, t *model.PasswordResetToken) error {
+	tx, err := database.WithTenantScope(ctx, r.client, t.TenantID)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.PasswordResetToken.Create().
+		SetID(t.ID).
+		SetTenantID(t.TenantID).
+		SetUserID(t.UserID).
+		SetTokenHash(t.TokenHash).
+		SetExpiresAt(t.ExpiresAt).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (r *AuthRepository) FindPasswordResetToken(ctx context.Context, tenantID, tokenHash string, now time.Time) (*model.PasswordResetToken, error) {
+	tx, err := database.WithTenantScope(ctx, r.client, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	t, err := tx.PasswordResetToken.Query().
+		Where(
+			passwordresettoken.TokenHashEQ(tokenHash),
+			passwordresettoken.UsedAtIsNil(),
+			passwordresettoken.ExpiresAtGT(now),
+		).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return toPasswordResetTokenModel(t), nil
+}
+
+func (r *AuthRepository) ConsumePasswordResetToken(ctx context.Context, tenantID, tokenHash string, now time.Time) (*model.PasswordResetToken, error) {
+	tx, err := database.WithTenantScope(ctx, r.client, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	t, err := tx.PasswordResetToken.Query().
+		Where(
+			passwordresettoken.TokenHashEQ(tokenHash),
+			passwordresettoken.UsedAtIsNil(),
+			passwordresettoken.ExpiresAtGT(now),
+		).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Same used_at guard as for magic links
+	t, err = tx.PasswordResetToken.UpdateOne(t).
+		Where(passwordresettoken.UsedAtIsNil()).
+		SetUsedAt(now).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return toPasswordResetTokenModel(t), nil
+}
+
+func toMagicLinkTokenModel(t *ent.MagicLinkToken) *model.MagicLinkToken {
+	return &model.MagicLinkToken{
+		ID:        t.ID,
+		TenantID:  t.TenantID,
+		UserID:    t.UserID,
+		TokenHash: t.TokenHash,
+		ExpiresAt: t.ExpiresAt,
+		UsedAt:    t.UsedAt,
+		CreatedAt: t.CreatedAt,
+	}
+}
+
+func toPasswordResetTokenModel(t *ent.PasswordResetToken) *model.PasswordResetToken {
+	return &model.PasswordResetToken{
+		ID:        t.ID,
+		TenantID:  t.TenantID,
+		UserID:    t.UserID,
+		TokenHash: t.TokenHash,
+		ExpiresAt: t.ExpiresAt,
+		UsedAt:    t.UsedAt,
+		CreatedAt: t.CreatedAt,
+	}
+}
+
 func toTenantModel(t *ent.Tenant) *model.Tenant {
 	return &model.Tenant{
-		ID:        t.ID,
-		Name:      t.Name,
-		Slug:      t.Slug,
+		ID:   t.ID,
+		Name: t.Name,
+		Slug: t.Slug,
+		Settings: model.TenantSettings{
+			MagicLinkEnabled:      t.MagicLinkEnabled,
+			AccountDeletionPolicy: string(t.AccountDeletionPolicy),
+			SearchLanguage:        string(t.SearchLanguage),
+			TrashRetentionDays:    t.TrashRetentionDays,
+		},
 		CreatedAt: t.CreatedAt,
 		UpdatedAt: t.UpdatedAt,
 	}
@@ -207,6 +398,9 @@ func toUserModel(u *ent.User) *model.User {
 		Name:                       u.Name,
 		Role:                       string(u.Role),
 		EmailVerified:              u.EmailVerified,
+		IsActive:                   u.IsActive,
+		IsSystem:                   u.IsSystem,
+		ExternalID:                 u.ExternalID,
 		VerificationToken:          u.VerificationToken,
 		VerificationTokenExpiresAt: u.VerificationTokenExpiresAt,
 		CreatedAt:                  u.CreatedAt,
@@ -103,6 +103,24 @@ func TestAuthRepository_FindTenantBySlug(t *testing.T) {
 	}
 }
 
+func TestAuthRepository_FindTenantByID(t *testing.T) {
+	t.Parallel()
+
+	client := common.SetupTestClient(t)
+	repo := NewAuthRepository(client)
+
+	// Create test tenant
+	tenant := common.CreateTenant(t, client, common.DefaultTenantBuilder(client, ""))
+
+	found, err := repo.FindTenantByID(context.Background(), tenant.ID)
+	require.NoError(t, err)
+	assert.Equal(t, tenant.ID, found.ID)
+	assert.Equal(t, tenant.Slug, found.Slug)
+
+	_, err = repo.FindTenantByID(context.Background(), "non-existent-id")
+	require.Error(t, err)
+}
+
 func TestAuthRepository_CreateUser(t *testing.T) {
 	t.Parallel()
 
@@ -371,3 +389,92 @@ func TestAuthRepository_UpdateUser(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthRepository_ConsumeMagicLinkToken(t *testing.T) {
+	t.Parallel()
+
+	client := common.SetupTestClient(t)
+	repo := NewAuthRepository(client)
+
+	tenant := common.CreateTenant(t, client, common.DefaultTenantBuilder(client, ""))
+	user := common.CreateUser(t, client, common.DefaultUserBuilder(client, "", tenant.ID))
+
+	now := time.Now()
+	require.NoError(t, repo.CreateMagicLinkToken(context.Background(), &model.MagicLinkToken{
+		ID:        "magic-link-token-1",
+		TenantID:  tenant.ID,
+		UserID:    user.ID,
+		TokenHash: "valid-token-hash",
+		ExpiresAt: now.Add(15 * time.Minute),
+	}))
+	require.NoError(t, repo.CreateMagicLinkToken(context.Background(), &model.MagicLinkToken{
+		ID:        "magic-link-token-2",
+		TenantID:  tenant.ID,
+		UserID:    user.ID,
+		TokenHash: "expired-token-hash",
+		ExpiresAt: now.Add(-time.Minute),
+	}))
+
+	consumed, err := repo.ConsumeMagicLinkToken(context.Background(), tenant.ID, "valid-token-hash", now)
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, consumed.UserID)
+	assert.NotNil(t, consumed.UsedAt)
+
+	// Tokens are single use
+	_, err = repo.ConsumeMagicLinkToken(context.Background(), tenant.ID, "valid-token-hash", now)
+	require.Error(t, err)
+
+	_, err = repo.ConsumeMagicLinkToken(context.Background(), tenant.ID, "expired-token-hash", now)
+	require.Error(t, err)
+
+	_, err = repo.ConsumeMagicLinkToken(context.Background(), "wrong-tenant-id", "valid-token-hash", now)
+	require.Error(t, err)
+}
+
+func TestAuthRepository_PasswordResetToken(t *testing.T) {
+	t.Parallel()
+
+	client := common.SetupTestClient(t)
+	repo := NewAuthRepository(client)
+
+	tenant := common.CreateTenant(t, client, common.DefaultTenantBuilder(client, ""))
+	user := common.CreateUser(t, client, common.DefaultUserBuilder(client, "", tenant.ID))
+
+	now := time.Now()
+	require.NoError(t, repo.CreatePasswordResetToken(context.Background(), &model.PasswordResetToken{
+		ID:        "password-reset-token-1",
+		TenantID:  tenant.ID,
+		UserID:    user.ID,
+		TokenHash: "valid-token-hash",
+		ExpiresAt: now.Add(time.Hour),
+	}))
+	require.NoError(t, repo.CreatePasswordResetToken(context.Background(), &model.PasswordResetToken{
+		ID:        "password-reset-token-2",
+		TenantID:  tenant.ID,
+		UserID:    user.ID,
+		TokenHash: "expired-token-hash",
+		ExpiresAt: now.Add(-time.Minute),
+	}))
+
+	// Finding a token does not use it up
+	found, err := repo.FindPasswordResetToken(context.Background(), tenant.ID, "valid-token-hash", now)
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, found.UserID)
+	assert.Nil(t, found.UsedAt)
+
+	consumed, err := repo.ConsumePasswordResetToken(context.Background(), tenant.ID, "valid-token-hash", now)
+	require.NoError(t, err)
+	assert.NotNil(t, consumed.UsedAt)
+
+	// Tokens are single use
+	_, err = repo.FindPasswordResetToken(context.Background(), tenant.ID, "valid-token-hash", now)
+	require.Error(t, err)
+	_, err = repo.ConsumePasswordResetToken(context.Background(), tenant.ID, "valid-token-hash", now)
+	require.Error(t, err)
+
+	_, err = repo.FindPasswordResetToken(context.Background(), tenant.ID, "expired-token-hash", now)
+	require.Error(t, err)
+
+	_, err = repo.FindPasswordResetToken(context.Background(), "wrong-tenant-id", "valid-token-hash", now)
+	require.Error(t, err)
+}
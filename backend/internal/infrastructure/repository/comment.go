@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/ent"
+	"good-todo-go/internal/ent/todocomment"
+	"good-todo-go/internal/infrastructure/database"
+)
+
+type CommentRepository struct {
+	client *ent.Client
+}
+
+func NewCommentRepository(client *ent.Client) repository.ICommentRepository {
+	return &CommentRepository{client: client}
+}
+
+// FindByID reads a single comment (RLS handles tenant isolation)
+func (r *CommentRepository) FindByID(ctx context.Context, commentID string) (*model.Comment, error) {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	c, err := tx.TodoComment.Get(ctx, commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return toCommentModel(c), nil
+}
+
+// FindByTodoID reads a todo's comments (RLS handles tenant isolation)
+func (r *CommentRepository) FindByTodoID(ctx context.Context, todoID string) ([]*model.Comment, error) {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	comments, err := tx.TodoComment.Query().
+		Where(todocomment.TodoIDEQ(todoID)).
+		Order(todocomment.ByCreatedAt(), todocomment.ByID()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.Comment, len(comments))
+	for i, c := range comments {
+		result[i] = toCommentModel(c)
+	}
+	return result, nil
+}
+
+// Create writes directly to todo_comments table (RLS protected)
+func (r *CommentRepository) Create(ctx context.Context, m *model.Comment) (*model.Comment, error) {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	created, err := tx.TodoComment.Create().
+		SetID(m.ID).
+		SetTenantID(m.TenantID).
+		SetTodoID(m.TodoID).
+		SetNillableAuthorID(m.AuthorID).
+		SetBody(m.Body).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return toCommentModel(created), nil
+}
+
+// Update writes directly to todo_comments table (RLS protected)
+func (r *CommentRepository) Update(ctx context.Context, m *model.Comment) (*model.Comment, error) {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	updated, err := tx.TodoComment.UpdateOneID(m.ID).
+		SetBody(m.Body).
+		SetNillableEditedAt(m.EditedAt).
+		SetNillableDeletedAt(m.DeletedAt).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return toCommentModel(updated), nil
+}
+
+func toCommentModel(c *ent.TodoComment) *model.Comment {
+	return &model.Comment{
+		ID:        c.ID,
+		TenantID:  c.TenantID,
+		TodoID:    c.TodoID,
+		AuthorID:  c.AuthorID,
+		Body:      c.Body,
+		EditedAt:  c.EditedAt,
+		DeletedAt: c.DeletedAt,
+		CreatedAt: c.CreatedAt,
+	}
+}
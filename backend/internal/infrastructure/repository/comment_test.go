@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/infrastructure/database"
+	"good-todo-go/internal/integration_test/common"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommentRepository_ThreadAndCounts(t *testing.T) {
+	t.Parallel()
+
+	client := common.SetupTestClient(t)
+
+	tenant := common.CreateTenant(t, client, common.DefaultTenantBuilder(client, ""))
+	user := common.CreateUser(t, client, common.DefaultUserBuilder(client, "", tenant.ID))
+	todo := common.CreateTodo(t, client, common.DefaultTodoBuilder(client, "todo-commented", tenant.ID, user.ID).SetIsPublic(true))
+
+	repo := NewCommentRepository(client)
+	todoRepo := NewTodoRepository(client)
+	ctx := database.WithTenantID(context.Background(), tenant.ID)
+
+	for _, id := range []string{"comment-1", "comment-2", "comment-3"} {
+		_, err := repo.Create(ctx, &model.Comment{ID: id, TenantID: tenant.ID, TodoID: todo.ID, AuthorID: &user.ID, Body: "Body of " + id})
+		require.NoError(t, err)
+	}
+
+	deleted, err := repo.FindByID(ctx, "comment-2")
+	require.NoError(t, err)
+	now := time.Now().UTC()
+	deleted.Body = ""
+	deleted.DeletedAt = &now
+	_, err = repo.Update(ctx, deleted)
+	require.NoError(t, err)
+
+	// Deleted comments stay in the thread but are not counted
+	comments, err := repo.FindByTodoID(ctx, todo.ID)
+	require.NoError(t, err)
+	require.Len(t, comments, 3)
+	assert.Equal(t, "comment-1", comments[0].ID)
+	assert.NotNil(t, comments[1].DeletedAt)
+	assert.Empty(t, comments[1].Body)
+
+	found, err := todoRepo.FindByID(ctx, todo.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, found.CommentCount)
+
+	public, err := todoRepo.FindPublic(ctx, nil, &model.TodoPage{Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, public, 1)
+	assert.Equal(t, 2, public[0].CommentCount)
+}
+
+func TestCommentRepository_RLS_TenantIsolation(t *testing.T) {
+	t.Parallel()
+
+	adminClient, appClient := common.SetupTestClientWithRLS(t)
+
+	tenant1 := common.CreateTenant(t, adminClient, common.DefaultTenantBuilder(adminClient, "tenant1-comment"))
+	tenant2 := common.CreateTenant(t, adminClient, common.DefaultTenantBuilder(adminClient, "tenant2-comment"))
+	user := common.CreateUser(t, adminClient, common.DefaultUserBuilder(adminClient, "", tenant1.ID).SetEmail("author-comment@example.com"))
+	todo := common.CreateTodo(t, adminClient, common.DefaultTodoBuilder(adminClient, "todo-rls-comment", tenant1.ID, user.ID))
+
+	repo := NewCommentRepository(appClient)
+	ctx1 := database.WithTenantID(context.Background(), tenant1.ID)
+	ctx2 := database.WithTenantID(context.Background(), tenant2.ID)
+
+	_, err := repo.Create(ctx1, &model.Comment{ID: "comment-rls", TenantID: tenant1.ID, TodoID: todo.ID, AuthorID: &user.ID, Body: "Hi"})
+	require.NoError(t, err)
+
+	comments, err := repo.FindByTodoID(ctx2, todo.ID)
+	require.NoError(t, err)
+	assert.Empty(t, comments)
+
+	_, err = repo.FindByID(ctx2, "comment-rls")
+	assert.Error(t, err)
+
+	// Writing into another tenant is rejected by the policy
+	_, err = repo.Create(ctx2, &model.Comment{ID: "comment-cross", TenantID: tenant1.ID, TodoID: todo.ID, Body: "Hi"})
+	assert.Error(t, err)
+}
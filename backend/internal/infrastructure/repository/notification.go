@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/ent"
+	"good-todo-go/internal/ent/notification"
+	"good-todo-go/internal/infrastructure/database"
+)
+
+type NotificationRepository struct {
+	client *ent.Client
+}
+
+func NewNotificationRepository(client *ent.Client) repository.INotificationRepository {
+	return &NotificationRepository{client: client}
+}
+
+// FindByUserID reads the user's notifications (RLS handles tenant isolation)
+func (r *NotificationRepository) FindByUserID(ctx context.Context, userID string, unreadOnly bool, limit int) ([]*model.Notification, error) {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	query := tx.Notification.Query().
+		Where(notification.UserIDEQ(userID))
+	if unreadOnly {
+		query.Where(notification.ReadAtIsNil())
+	}
+	notifications, err := query.
+		Order(ent.Desc(notification.FieldCreatedAt), ent.Desc(notification.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.Notification, len(notifications))
+	for i, n := range notifications {
+		result[i] = toNotificationModel(n)
+	}
+	return result, nil
+}
+
+// Create writes directly to notifications table (RLS protected)
+func (r *NotificationRepository) Create(ctx context.Context, n *model.Notification) error {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// A reminder that is delivered again after a crash must not notify twice
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO notifications (id, tenant_id, user_id, todo_id, reminder_id, title, body, created_at)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		 ON CONFLICT (reminder_id) DO NOTHING`,
+		n.ID, n.TenantID, n.UserID, n.TodoID, n.ReminderID, n.Title, n.Body, time.Now().UTC(),
+	)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// MarkRead writes directly to notifications table (RLS protected)
+func (r *NotificationRepository) MarkRead(ctx context.Context, notificationID, userID string) (bool, error) {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	n, err := tx.Notification.Query().
+		Where(notification.IDEQ(notificationID), notification.UserIDEQ(userID)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if n.ReadAt == nil {
+		if err := n.Update().SetReadAt(time.Now().UTC()).Exec(ctx); err != nil {
+			return false, err
+		}
+	}
+
+	return true, tx.Commit()
+}
+
+func toNotificationModel(n *ent.Notification) *model.Notification {
+	return &model.Notification{
+		ID:         n.ID,
+		TenantID:   n.TenantID,
+		UserID:     n.UserID,
+		TodoID:     n.TodoID,
+		ReminderID: n.ReminderID,
+		Title:      n.Title,
+		Body:       n.Body,
+		ReadAt:     n.ReadAt,
+		CreatedAt:  n.CreatedAt,
+	}
+}
@@ -0,0 +1,215 @@
+package repository
+
+import (
+	"context"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/ent"
+	"good-todo-go/internal/ent/project"
+	"good-todo-go/internal/ent/todo"
+	"good-todo-go/internal/infrastructure/database"
+)
+
+type ProjectRepository struct {
+	client *ent.Client
+}
+
+func NewProjectRepository(client *ent.Client) repository.IProjectRepository {
+	return &ProjectRepository{client: client}
+}
+
+// FindByID reads a single project (RLS handles tenant isolation)
+func (r *ProjectRepository) FindByID(ctx context.Context, projectID string) (*model.Project, error) {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	p, err := tx.Project.Get(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return toProjectModel(p), nil
+}
+
+// FindVisible reads the user's own and shared projects (RLS handles tenant isolation)
+func (r *ProjectRepository) FindVisible(ctx context.Context, userID string, includeArchived bool) ([]*model.Project, error) {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	query := tx.Project.Query().
+		Where(project.Or(project.UserIDEQ(userID), project.IsPublicEQ(true)))
+	if !includeArchived {
+		query = query.Where(project.ArchivedAtIsNil())
+	}
+
+	projects, err := query.
+		Order(project.ByName(), project.ByID()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.Project, len(projects))
+	for i, p := range projects {
+		result[i] = toProjectModel(p)
+	}
+	return result, nil
+}
+
+// CountTodos counts visible todos per project (RLS handles tenant isolation)
+func (r *ProjectRepository) CountTodos(ctx context.Context, userID string, projectIDs []string) (map[string]*model.ProjectTodoCounts, error) {
+	result := make(map[string]*model.ProjectTodoCounts, len(projectIDs))
+	if len(projectIDs) == 0 {
+		return result, nil
+	}
+
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var rows []struct {
+		ProjectID string `json:"project_id"`
+		Completed bool   `json:"completed"`
+		Count     int    `json:"count"`
+	}
+	err = tx.Todo.Query().
+		Where(
+			todo.ProjectIDIn(projectIDs...),
+			todo.Or(todo.UserIDEQ(userID), todo.IsPublicEQ(true)),
+		).
+		GroupBy(todo.FieldProjectID, todo.FieldCompleted).
+		Aggregate(ent.Count()).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		counts, ok := result[row.ProjectID]
+		if !ok {
+			counts = &model.ProjectTodoCounts{}
+			result[row.ProjectID] = counts
+		}
+		if row.Completed {
+			counts.Completed += row.Count
+		} else {
+			counts.Open += row.Count
+		}
+	}
+	return result, nil
+}
+
+// Create writes directly to projects table (RLS protected)
+func (r *ProjectRepository) Create(ctx context.Context, p *model.Project) (*model.Project, error) {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	created, err := tx.Project.Create().
+		SetID(p.ID).
+		SetTenantID(p.TenantID).
+		SetUserID(p.UserID).
+		SetName(p.Name).
+		SetDescription(p.Description).
+		SetIsPublic(p.IsPublic).
+		SetNillableArchivedAt(p.ArchivedAt).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return toProjectModel(created), nil
+}
+
+// Update writes directly to projects table (RLS protected)
+func (r *ProjectRepository) Update(ctx context.Context, p *model.Project) (*model.Project, error) {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	builder := tx.Project.UpdateOneID(p.ID).
+		SetName(p.Name).
+		SetDescription(p.Description).
+		SetIsPublic(p.IsPublic)
+
+	if p.ArchivedAt != nil {
+		builder.SetArchivedAt(*p.ArchivedAt)
+	} else {
+		builder.ClearArchivedAt()
+	}
+
+	updated, err := builder.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return toProjectModel(updated), nil
+}
+
+// Delete writes directly to projects table; todos are detached by ON DELETE SET NULL (RLS protected)
+func (r *ProjectRepository) Delete(ctx context.Context, projectID string) error {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// The foreign key would clear project_id too, but unseen by the todo hooks
+	if err := tx.Todo.Update().
+		Where(todo.ProjectIDEQ(projectID)).
+		ClearProjectID().
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	if err := tx.Project.DeleteOneID(projectID).Exec(ctx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// toProjectModel converts ent.Project to model.Project
+func toProjectModel(p *ent.Project) *model.Project {
+	return &model.Project{
+		ID:          p.ID,
+		TenantID:    p.TenantID,
+		UserID:      p.UserID,
+		Name:        p.Name,
+		Description: p.Description,
+		IsPublic:    p.IsPublic,
+		ArchivedAt:  p.ArchivedAt,
+		CreatedAt:   p.CreatedAt,
+		UpdatedAt:   p.UpdatedAt,
+	}
+}
@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/infrastructure/database"
+	"good-todo-go/internal/integration_test/common"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectRepository_FindVisibleAndCountTodos(t *testing.T) {
+	t.Parallel()
+
+	client := common.SetupTestClient(t)
+
+	tenant := common.CreateTenant(t, client, common.DefaultTenantBuilder(client, ""))
+	owner := common.CreateUser(t, client, common.DefaultUserBuilder(client, "", tenant.ID))
+	other := common.CreateUser(t, client, common.DefaultUserBuilder(client, "", tenant.ID))
+
+	projectRepo := NewProjectRepository(client)
+	todoRepo := NewTodoRepository(client)
+	ctx := database.WithTenantID(context.Background(), tenant.ID)
+
+	shared, err := projectRepo.Create(ctx, &model.Project{ID: "project-shared", TenantID: tenant.ID, UserID: owner.ID, Name: "Shared", IsPublic: true})
+	require.NoError(t, err)
+	_, err = projectRepo.Create(ctx, &model.Project{ID: "project-private", TenantID: tenant.ID, UserID: owner.ID, Name: "Private"})
+	require.NoError(t, err)
+
+	for _, todo := range []*model.Todo{
+		{ID: "todo-open-private", Title: "open", IsPublic: false},
+		{ID: "todo-open-public", Title: "open", IsPublic: true},
+		{ID: "todo-done-public", Title: "done", IsPublic: true, Completed: true},
+	} {
+		todo.TenantID = tenant.ID
+		todo.UserID = owner.ID
+		todo.ProjectID = &shared.ID
+		_, err := todoRepo.Create(ctx, todo)
+		require.NoError(t, err)
+	}
+
+	// Owners see their private projects, others only shared ones
+	projects, err := projectRepo.FindVisible(ctx, owner.ID, false)
+	require.NoError(t, err)
+	assert.Len(t, projects, 2)
+	projects, err = projectRepo.FindVisible(ctx, other.ID, false)
+	require.NoError(t, err)
+	require.Len(t, projects, 1)
+	assert.Equal(t, shared.ID, projects[0].ID)
+
+	counts, err := projectRepo.CountTodos(ctx, owner.ID, []string{shared.ID})
+	require.NoError(t, err)
+	assert.Equal(t, &model.ProjectTodoCounts{Open: 2, Completed: 1}, counts[shared.ID])
+
+	// Other members only count the public todos
+	counts, err = projectRepo.CountTodos(ctx, other.ID, []string{shared.ID})
+	require.NoError(t, err)
+	assert.Equal(t, &model.ProjectTodoCounts{Open: 1, Completed: 1}, counts[shared.ID])
+
+	todos, err := todoRepo.FindByUserID(ctx, owner.ID, &model.TodoFilter{ProjectID: &shared.ID}, nil, nil)
+	require.NoError(t, err)
+	assert.Len(t, todos, 3)
+}
+
+func TestProjectRepository_ArchiveAndDelete(t *testing.T) {
+	t.Parallel()
+
+	client := common.SetupTestClient(t)
+
+	tenant := common.CreateTenant(t, client, common.DefaultTenantBuilder(client, ""))
+	user := common.CreateUser(t, client, common.DefaultUserBuilder(client, "", tenant.ID))
+
+	projectRepo := NewProjectRepository(client)
+	todoRepo := NewTodoRepository(client)
+	ctx := database.WithTenantID(context.Background(), tenant.ID)
+
+	project, err := projectRepo.Create(ctx, &model.Project{ID: "project-1", TenantID: tenant.ID, UserID: user.ID, Name: "Home"})
+	require.NoError(t, err)
+	todo, err := todoRepo.Create(ctx, &model.Todo{ID: "todo-1", TenantID: tenant.ID, UserID: user.ID, Title: "Todo", ProjectID: &project.ID})
+	require.NoError(t, err)
+
+	project.ArchivedAt = &project.CreatedAt
+	_, err = projectRepo.Update(ctx, project)
+	require.NoError(t, err)
+
+	projects, err := projectRepo.FindVisible(ctx, user.ID, false)
+	require.NoError(t, err)
+	assert.Empty(t, projects)
+	projects, err = projectRepo.FindVisible(ctx, user.ID, true)
+	require.NoError(t, err)
+	assert.Len(t, projects, 1)
+
+	// Deleting the project keeps the todo, outside of any project
+	require.NoError(t, projectRepo.Delete(ctx, project.ID))
+	found, err := todoRepo.FindByID(ctx, todo.ID)
+	require.NoError(t, err)
+	assert.Nil(t, found.ProjectID)
+}
@@ -0,0 +1,218 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/ent"
+	"good-todo-go/internal/ent/reminder"
+	"good-todo-go/internal/infrastructure/database"
+)
+
+type ReminderRepository struct {
+	client *ent.Client
+}
+
+func NewReminderRepository(client *ent.Client) repository.IReminderRepository {
+	return &ReminderRepository{client: client}
+}
+
+// FindByID reads a single reminder (RLS handles tenant isolation)
+func (r *ReminderRepository) FindByID(ctx context.Context, reminderID string) (*model.Reminder, error) {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	rm, err := tx.Reminder.Get(ctx, reminderID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return toReminderModel(rm), nil
+}
+
+// FindByTodoID reads a todo's reminders (RLS handles tenant isolation)
+func (r *ReminderRepository) FindByTodoID(ctx context.Context, todoID string) ([]*model.Reminder, error) {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	reminders, err := tx.Reminder.Query().
+		Where(reminder.TodoIDEQ(todoID)).
+		Order(reminder.ByRemindAt(), reminder.ByID()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.Reminder, len(reminders))
+	for i, rm := range reminders {
+		result[i] = toReminderModel(rm)
+	}
+	return result, nil
+}
+
+// Create writes directly to reminders table (RLS protected)
+func (r *ReminderRepository) Create(ctx context.Context, m *model.Reminder) (*model.Reminder, error) {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	created, err := tx.Reminder.Create().
+		SetID(m.ID).
+		SetTenantID(m.TenantID).
+		SetTodoID(m.TodoID).
+		SetRemindAt(m.RemindAt).
+		SetNillableOffsetMinutes(m.OffsetMinutes).
+		SetChannel(m.Channel).
+		SetNextAttemptAt(m.RemindAt).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return toReminderModel(created), nil
+}
+
+// Delete writes directly to reminders table (RLS protected)
+func (r *ReminderRepository) Delete(ctx context.Context, reminderID string) error {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := tx.Reminder.DeleteOneID(reminderID).Exec(ctx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Reminders of trashed todos stay pending and fire if the todo is restored.
+// Those of completed todos are dropped by expiredReminderQuery instead.
+const dueReminderQuery = `
+SELECT r."id", r."tenant_id", r."todo_id", r."remind_at", r."offset_minutes", r."channel",
+    r."attempts", r."created_at", t."title", t."due_date", u."id", u."email", u."name"
+FROM "reminders" r
+JOIN "todos" t ON t."id" = r."todo_id"
+JOIN "users" u ON u."id" = t."user_id"
+WHERE r."sent_at" IS NULL AND r."failed_at" IS NULL
+  AND r."next_attempt_at" <= $1
+  AND NOT t."completed" AND t."deleted_at" IS NULL
+ORDER BY r."next_attempt_at", r."id"
+LIMIT 1
+FOR UPDATE OF r SKIP LOCKED`
+
+// Reminders that come due while their todo is completed expire, so that
+// reopening the todo later does not fire reminders that are long past.
+// Trashed todos are left to PurgeTrash, which removes their reminders with them.
+const expiredReminderQuery = `
+DELETE FROM "reminders" r
+USING "todos" t
+WHERE t."id" = r."todo_id"
+  AND r."sent_at" IS NULL AND r."failed_at" IS NULL
+  AND r."next_attempt_at" <= $1
+  AND t."completed" AND t."deleted_at" IS NULL`
+
+// DeliverNext claims one due reminder of the tenant (RLS handles tenant isolation).
+// The scheduler has no request context, so the tenant is passed explicitly.
+func (r *ReminderRepository) DeliverNext(ctx context.Context, tenantID string, now time.Time, deliver repository.DeliverReminderFunc) (bool, error) {
+	tx, err := database.WithTenantScope(ctx, r.client, tenantID)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, expiredReminderQuery, now); err != nil {
+		return false, err
+	}
+
+	rows, err := tx.QueryContext(ctx, dueReminderQuery, now)
+	if err != nil {
+		return false, err
+	}
+	due, err := scanDueReminder(rows)
+	if err != nil {
+		return false, err
+	}
+	if due == nil {
+		return false, nil
+	}
+
+	// The row stays locked until commit, so no other scheduler can pick it up
+	// while it is being delivered
+	update := tx.Reminder.UpdateOneID(due.Reminder.ID).AddAttempts(1)
+	if deliverErr := deliver(database.WithTenantID(ctx, tenantID), due); deliverErr == nil {
+		update.SetSentAt(now)
+	} else if due.Attempts+1 >= model.MaxReminderAttempts {
+		update.SetFailedAt(now)
+	} else {
+		update.SetNextAttemptAt(now.Add(reminderRetryDelay(due.Attempts + 1)))
+	}
+	if err := update.Exec(ctx); err != nil {
+		return true, err
+	}
+
+	return true, tx.Commit()
+}
+
+func scanDueReminder(rows *sql.Rows) (*model.DueReminder, error) {
+	defer rows.Close()
+	if !rows.Next() {
+		return nil, rows.Err()
+	}
+
+	rm := &model.Reminder{}
+	due := &model.DueReminder{Reminder: rm}
+	var offset sql.NullInt64
+	if err := rows.Scan(
+		&rm.ID, &rm.TenantID, &rm.TodoID, &rm.RemindAt, &offset, &rm.Channel,
+		&due.Attempts, &rm.CreatedAt, &due.TodoTitle, &due.DueDate, &due.UserID, &due.Email, &due.UserName,
+	); err != nil {
+		return nil, err
+	}
+	if offset.Valid {
+		minutes := int(offset.Int64)
+		rm.OffsetMinutes = &minutes
+	}
+	return due, rows.Err()
+}
+
+// reminderRetryDelay backs off exponentially: 1, 2, 4, ... minutes
+func reminderRetryDelay(attempts int) time.Duration {
+	return time.Minute << (attempts - 1)
+}
+
+func toReminderModel(r *ent.Reminder) *model.Reminder {
+	return &model.Reminder{
+		ID:            r.ID,
+		TenantID:      r.TenantID,
+		TodoID:        r.TodoID,
+		RemindAt:      r.RemindAt,
+		OffsetMinutes: r.OffsetMinutes,
+		Channel:       r.Channel,
+		SentAt:        r.SentAt,
+		FailedAt:      r.FailedAt,
+		CreatedAt:     r.CreatedAt,
+	}
+}
@@ -0,0 +1,164 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/infrastructure/database"
+	"good-todo-go/internal/integration_test/common"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReminderRepository_DeliverNext(t *testing.T) {
+	t.Parallel()
+
+	client := common.SetupTestClient(t)
+
+	tenant := common.CreateTenant(t, client, common.DefaultTenantBuilder(client, ""))
+	user := common.CreateUser(t, client, common.DefaultUserBuilder(client, "", tenant.ID))
+	todo := common.CreateTodo(t, client, common.DefaultTodoBuilder(client, "todo-reminded", tenant.ID, user.ID))
+
+	repo := NewReminderRepository(client)
+	ctx := database.WithTenantID(context.Background(), tenant.ID)
+	now := time.Now().UTC().Truncate(time.Second)
+
+	for _, r := range []*model.Reminder{
+		{ID: "reminder-ok", TenantID: tenant.ID, TodoID: todo.ID, RemindAt: now.Add(-2 * time.Minute), Channel: model.ReminderChannelInApp},
+		{ID: "reminder-flaky", TenantID: tenant.ID, TodoID: todo.ID, RemindAt: now.Add(-time.Minute), Channel: model.ReminderChannelEmail},
+		{ID: "reminder-later", TenantID: tenant.ID, TodoID: todo.ID, RemindAt: now.Add(time.Hour), Channel: model.ReminderChannelInApp},
+	} {
+		_, err := repo.Create(ctx, r)
+		require.NoError(t, err)
+	}
+
+	var delivered []string
+	deliver := func(_ context.Context, due *model.DueReminder) error {
+		delivered = append(delivered, due.Reminder.ID)
+		assert.Equal(t, user.ID, due.UserID)
+		assert.Equal(t, todo.Title, due.TodoTitle)
+		if due.Reminder.ID == "reminder-flaky" {
+			return errors.New("smtp down")
+		}
+		return nil
+	}
+
+	// Due reminders come out oldest first; the future one is left alone
+	for i := 0; i < 2; i++ {
+		found, err := repo.DeliverNext(context.Background(), tenant.ID, now, deliver)
+		require.NoError(t, err)
+		assert.True(t, found)
+	}
+	found, err := repo.DeliverNext(context.Background(), tenant.ID, now, deliver)
+	require.NoError(t, err)
+	assert.False(t, found)
+	assert.Equal(t, []string{"reminder-ok", "reminder-flaky"}, delivered)
+
+	sent, err := repo.FindByID(ctx, "reminder-ok")
+	require.NoError(t, err)
+	assert.NotNil(t, sent.SentAt)
+
+	// A failed delivery is retried after a delay
+	flaky, err := repo.FindByID(ctx, "reminder-flaky")
+	require.NoError(t, err)
+	assert.Nil(t, flaky.SentAt)
+	assert.Nil(t, flaky.FailedAt)
+
+	found, err = repo.DeliverNext(context.Background(), tenant.ID, now.Add(time.Minute), deliver)
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "reminder-flaky", delivered[2])
+
+	// Another tenant's scheduler run sees none of these reminders
+	otherTenant := common.CreateTenant(t, client, common.DefaultTenantBuilder(client, ""))
+	found, err = repo.DeliverNext(context.Background(), otherTenant.ID, now.Add(time.Hour), deliver)
+	require.NoError(t, err)
+	assert.False(t, found)
+}
+
+func TestReminderRepository_DeliverNext_CompletedTodo(t *testing.T) {
+	t.Parallel()
+
+	client := common.SetupTestClient(t)
+
+	tenant := common.CreateTenant(t, client, common.DefaultTenantBuilder(client, ""))
+	user := common.CreateUser(t, client, common.DefaultUserBuilder(client, "", tenant.ID))
+	todo := common.CreateTodo(t, client, common.DefaultTodoBuilder(client, "", tenant.ID, user.ID).SetCompleted(true))
+
+	repo := NewReminderRepository(client)
+	ctx := database.WithTenantID(context.Background(), tenant.ID)
+	now := time.Now().UTC().Truncate(time.Second)
+
+	for _, r := range []*model.Reminder{
+		{ID: "reminder-past", TenantID: tenant.ID, TodoID: todo.ID, RemindAt: now.Add(-time.Minute), Channel: model.ReminderChannelInApp},
+		{ID: "reminder-later", TenantID: tenant.ID, TodoID: todo.ID, RemindAt: now.Add(time.Hour), Channel: model.ReminderChannelInApp},
+	} {
+		_, err := repo.Create(ctx, r)
+		require.NoError(t, err)
+	}
+
+	deliver := func(_ context.Context, due *model.DueReminder) error {
+		t.Errorf("reminder %s of a completed todo was delivered", due.Reminder.ID)
+		return nil
+	}
+
+	// The reminder that came due while the todo was completed expires, so
+	// reopening the todo does not fire it; the later one is kept
+	found, err := repo.DeliverNext(context.Background(), tenant.ID, now, deliver)
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	reminders, err := repo.FindByTodoID(ctx, todo.ID)
+	require.NoError(t, err)
+	require.Len(t, reminders, 1)
+	assert.Equal(t, "reminder-later", reminders[0].ID)
+}
+
+func TestNotificationRepository_CreateAndMarkRead(t *testing.T) {
+	t.Parallel()
+
+	client := common.SetupTestClient(t)
+
+	tenant := common.CreateTenant(t, client, common.DefaultTenantBuilder(client, ""))
+	user := common.CreateUser(t, client, common.DefaultUserBuilder(client, "", tenant.ID))
+	todo := common.CreateTodo(t, client, common.DefaultTodoBuilder(client, "todo-notified", tenant.ID, user.ID))
+
+	reminderRepo := NewReminderRepository(client)
+	repo := NewNotificationRepository(client)
+	ctx := database.WithTenantID(context.Background(), tenant.ID)
+
+	_, err := reminderRepo.Create(ctx, &model.Reminder{
+		ID: "reminder-1", TenantID: tenant.ID, TodoID: todo.ID, RemindAt: time.Now().Add(time.Hour), Channel: model.ReminderChannelInApp,
+	})
+	require.NoError(t, err)
+
+	reminderID := "reminder-1"
+	for _, id := range []string{"notification-1", "notification-2"} {
+		// Delivering the same reminder twice stores one notification
+		require.NoError(t, repo.Create(ctx, &model.Notification{
+			ID: id, TenantID: tenant.ID, UserID: user.ID, TodoID: &todo.ID, ReminderID: &reminderID, Title: "Reminder",
+		}))
+	}
+
+	notifications, err := repo.FindByUserID(ctx, user.ID, true, 10)
+	require.NoError(t, err)
+	require.Len(t, notifications, 1)
+	assert.Equal(t, "notification-1", notifications[0].ID)
+
+	marked, err := repo.MarkRead(ctx, "notification-1", user.ID)
+	require.NoError(t, err)
+	assert.True(t, marked)
+
+	// Other users cannot mark it
+	marked, err = repo.MarkRead(ctx, "notification-1", "someone-else")
+	require.NoError(t, err)
+	assert.False(t, marked)
+
+	notifications, err = repo.FindByUserID(ctx, user.ID, true, 10)
+	require.NoError(t, err)
+	assert.Empty(t, notifications)
+}
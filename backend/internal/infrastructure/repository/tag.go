@@ -0,0 +1,207 @@
+package repository
+
+import (
+	"context"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/ent"
+	"good-todo-go/internal/ent/tag"
+	"good-todo-go/internal/ent/todotag"
+	"good-todo-go/internal/infrastructure/database"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+type TagRepository struct {
+	client *ent.Client
+}
+
+func NewTagRepository(client *ent.Client) repository.ITagRepository {
+	return &TagRepository{client: client}
+}
+
+// FindAll reads the tenant's tags sorted by name (RLS handles tenant isolation)
+func (r *TagRepository) FindAll(ctx context.Context) ([]*model.Tag, error) {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	tags, err := tx.Tag.Query().
+		Order(tag.ByName(), tag.ByID()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return toTagModels(tags), nil
+}
+
+// FindByID reads a single tag (RLS handles tenant isolation)
+func (r *TagRepository) FindByID(ctx context.Context, tagID string) (*model.Tag, error) {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	t, err := tx.Tag.Get(ctx, tagID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return toTagModel(t), nil
+}
+
+// FindByName reads a tag by case-insensitive name (RLS handles tenant isolation)
+func (r *TagRepository) FindByName(ctx context.Context, name string) (*model.Tag, error) {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// Compare with lower() to use the same rule as the unique index
+	t, err := tx.Tag.Query().
+		Where(func(s *sql.Selector) {
+			s.Where(sql.ExprP("lower("+s.C(tag.FieldName)+") = lower($1)", name))
+		}).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return toTagModel(t), nil
+}
+
+// Create writes directly to tags table (RLS protected)
+func (r *TagRepository) Create(ctx context.Context, t *model.Tag) (*model.Tag, error) {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	created, err := tx.Tag.Create().
+		SetID(t.ID).
+		SetTenantID(t.TenantID).
+		SetName(t.Name).
+		SetColor(t.Color).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return toTagModel(created), nil
+}
+
+// Update writes directly to tags table (RLS protected)
+func (r *TagRepository) Update(ctx context.Context, t *model.Tag) (*model.Tag, error) {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	updated, err := tx.Tag.UpdateOneID(t.ID).
+		SetName(t.Name).
+		SetColor(t.Color).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return toTagModel(updated), nil
+}
+
+// Delete writes directly to tags table; links cascade (RLS protected)
+func (r *TagRepository) Delete(ctx context.Context, tagID string) error {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := tx.Tag.DeleteOneID(tagID).Exec(ctx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Attach links a tag to a todo (RLS protected)
+func (r *TagRepository) Attach(ctx context.Context, tenantID, todoID, tagID string) error {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// Re-attaching an already linked tag is a no-op
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO todo_tags (tag_id, todo_id, tenant_id, created_at)
+		 VALUES ($1, $2, $3, now())
+		 ON CONFLICT (tag_id, todo_id) DO NOTHING`,
+		tagID, todoID, tenantID,
+	)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Detach unlinks a tag from a todo (RLS protected)
+func (r *TagRepository) Detach(ctx context.Context, todoID, tagID string) error {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.TodoTag.Delete().
+		Where(todotag.TodoIDEQ(todoID), todotag.TagIDEQ(tagID)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// toTagModel converts ent.Tag to model.Tag
+func toTagModel(t *ent.Tag) *model.Tag {
+	return &model.Tag{
+		ID:        t.ID,
+		TenantID:  t.TenantID,
+		Name:      t.Name,
+		Color:     t.Color,
+		CreatedAt: t.CreatedAt,
+		UpdatedAt: t.UpdatedAt,
+	}
+}
+
+func toTagModels(tags []*ent.Tag) []*model.Tag {
+	result := make([]*model.Tag, len(tags))
+	for i, t := range tags {
+		result[i] = toTagModel(t)
+	}
+	return result
+}
@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/infrastructure/database"
+	"good-todo-go/internal/integration_test/common"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTagRepository_CreateAndFind(t *testing.T) {
+	t.Parallel()
+
+	client := common.SetupTestClient(t)
+
+	tenant := common.CreateTenant(t, client, common.DefaultTenantBuilder(client, ""))
+	repo := NewTagRepository(client)
+	ctx := database.WithTenantID(context.Background(), tenant.ID)
+
+	for _, tag := range []*model.Tag{
+		{ID: "tag-work", TenantID: tenant.ID, Name: "Work", Color: "#ff0000"},
+		{ID: "tag-home", TenantID: tenant.ID, Name: "home", Color: model.DefaultTagColor},
+	} {
+		_, err := repo.Create(ctx, tag)
+		require.NoError(t, err)
+	}
+
+	tags, err := repo.FindAll(ctx)
+	require.NoError(t, err)
+	require.Len(t, tags, 2)
+	assert.Equal(t, "Work", tags[0].Name)
+	assert.Equal(t, "home", tags[1].Name)
+
+	found, err := repo.FindByName(ctx, "WORK")
+	require.NoError(t, err)
+	assert.Equal(t, "tag-work", found.ID)
+
+	// Names are unique per tenant regardless of case
+	_, err = repo.Create(ctx, &model.Tag{ID: "tag-work-2", TenantID: tenant.ID, Name: "work", Color: model.DefaultTagColor})
+	assert.Error(t, err)
+
+	// ...but another tenant may reuse the name
+	otherTenant := common.CreateTenant(t, client, common.DefaultTenantBuilder(client, ""))
+	otherCtx := database.WithTenantID(context.Background(), otherTenant.ID)
+	_, err = repo.Create(otherCtx, &model.Tag{ID: "tag-work-other", TenantID: otherTenant.ID, Name: "Work", Color: model.DefaultTagColor})
+	require.NoError(t, err)
+}
+
+func TestTagRepository_AttachDetach(t *testing.T) {
+	t.Parallel()
+
+	client := common.SetupTestClient(t)
+
+	tenant := common.CreateTenant(t, client, common.DefaultTenantBuilder(client, ""))
+	user := common.CreateUser(t, client, common.DefaultUserBuilder(client, "", tenant.ID))
+	todo1 := common.CreateTodo(t, client, common.DefaultTodoBuilder(client, "todo-tagged-1", tenant.ID, user.ID))
+	todo2 := common.CreateTodo(t, client, common.DefaultTodoBuilder(client, "todo-tagged-2", tenant.ID, user.ID))
+
+	tagRepo := NewTagRepository(client)
+	todoRepo := NewTodoRepository(client)
+	ctx := database.WithTenantID(context.Background(), tenant.ID)
+
+	work, err := tagRepo.Create(ctx, &model.Tag{ID: "tag-work", TenantID: tenant.ID, Name: "work", Color: model.DefaultTagColor})
+	require.NoError(t, err)
+	urgent, err := tagRepo.Create(ctx, &model.Tag{ID: "tag-urgent", TenantID: tenant.ID, Name: "urgent", Color: model.DefaultTagColor})
+	require.NoError(t, err)
+
+	require.NoError(t, tagRepo.Attach(ctx, tenant.ID, todo1.ID, work.ID))
+	require.NoError(t, tagRepo.Attach(ctx, tenant.ID, todo1.ID, urgent.ID))
+	require.NoError(t, tagRepo.Attach(ctx, tenant.ID, todo2.ID, work.ID))
+	// Attaching twice is a no-op
+	require.NoError(t, tagRepo.Attach(ctx, tenant.ID, todo2.ID, work.ID))
+
+	found, err := todoRepo.FindByID(ctx, todo1.ID)
+	require.NoError(t, err)
+	require.Len(t, found.Tags, 2)
+	assert.Equal(t, "urgent", found.Tags[0].Name)
+	assert.Equal(t, "work", found.Tags[1].Name)
+
+	// Tag filters require every tag
+	todos, err := todoRepo.FindByUserID(ctx, user.ID, &model.TodoFilter{TagIDs: []string{work.ID, urgent.ID}}, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, todos, 1)
+	assert.Equal(t, todo1.ID, todos[0].ID)
+
+	count, err := todoRepo.CountByUserID(ctx, user.ID, &model.TodoFilter{TagIDs: []string{work.ID}})
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	require.NoError(t, tagRepo.Detach(ctx, todo1.ID, urgent.ID))
+	found, err = todoRepo.FindByID(ctx, todo1.ID)
+	require.NoError(t, err)
+	require.Len(t, found.Tags, 1)
+
+	// Deleting a tag detaches it everywhere
+	require.NoError(t, tagRepo.Delete(ctx, work.ID))
+	found, err = todoRepo.FindByID(ctx, todo2.ID)
+	require.NoError(t, err)
+	assert.Empty(t, found.Tags)
+}
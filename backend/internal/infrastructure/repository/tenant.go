@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/ent"
+	"good-todo-go/internal/ent/tenant"
+	"good-todo-go/internal/infrastructure/database"
+)
+
+type TenantRepository struct {
+	client *ent.Client
+}
+
+func NewTenantRepository(client *ent.Client) repository.ITenantRepository {
+	return &TenantRepository{client: client}
+}
+
+func (r *TenantRepository) FindByID(ctx context.Context, tenantID string) (*model.Tenant, error) {
+	t, err := r.client.Tenant.Get(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	return toTenantModel(t), nil
+}
+
+func (r *TenantRepository) ListIDs(ctx context.Context) ([]string, error) {
+	return r.client.Tenant.Query().
+		Order(tenant.ByID()).
+		IDs(ctx)
+}
+
+func (r *TenantRepository) UpdateSettings(ctx context.Context, tenantID string, settings *model.TenantSettings) (*model.Tenant, error) {
+	tx, err := database.WithTenantScope(ctx, r.client, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	current, err := tx.Tenant.Get(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := tx.Tenant.UpdateOneID(tenantID).
+		SetMagicLinkEnabled(settings.MagicLinkEnabled).
+		SetAccountDeletionPolicy(tenant.AccountDeletionPolicy(settings.AccountDeletionPolicy)).
+		SetSearchLanguage(tenant.SearchLanguage(settings.SearchLanguage)).
+		SetTrashRetentionDays(settings.TrashRetentionDays).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Re-index the tenant's todos; search_vector is regenerated from the new configuration
+	if current.SearchLanguage != updated.SearchLanguage {
+		if _, err := tx.ExecContext(ctx,
+			`UPDATE "todos" SET "search_language" = $1::regconfig WHERE "tenant_id" = $2`,
+			string(updated.SearchLanguage), tenantID,
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return toTenantModel(updated), nil
+}
+
+func (r *TenantRepository) FindBySCIMTokenHash(ctx context.Context, tokenHash string) (*model.Tenant, error) {
+	t, err := r.client.Tenant.Query().
+		Where(tenant.ScimTokenHashEQ(tokenHash)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toTenantModel(t), nil
+}
+
+func (r *TenantRepository) SetSCIMTokenHash(ctx context.Context, tenantID string, tokenHash *string) error {
+	builder := r.client.Tenant.UpdateOneID(tenantID)
+	if tokenHash != nil {
+		builder.SetScimTokenHash(*tokenHash)
+	} else {
+		builder.ClearScimTokenHash()
+	}
+	return builder.Exec(ctx)
+}
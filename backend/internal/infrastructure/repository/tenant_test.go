@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/integration_test/common"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTenantRepository_UpdateSettings(t *testing.T) {
+	t.Parallel()
+
+	client := common.SetupTestClient(t)
+	repo := NewTenantRepository(client)
+
+	tenant := common.CreateTenant(t, client, common.DefaultTenantBuilder(client, ""))
+
+	found, err := repo.FindByID(context.Background(), tenant.ID)
+	require.NoError(t, err)
+	assert.False(t, found.Settings.MagicLinkEnabled)
+	assert.Equal(t, model.AccountDeletionAnonymize, found.Settings.AccountDeletionPolicy)
+	assert.Equal(t, model.DefaultSearchLanguage, found.Settings.SearchLanguage)
+
+	updated, err := repo.UpdateSettings(context.Background(), tenant.ID, &model.TenantSettings{
+		MagicLinkEnabled:      true,
+		AccountDeletionPolicy: model.AccountDeletionReassign,
+		SearchLanguage:        "german",
+	})
+	require.NoError(t, err)
+	assert.True(t, updated.Settings.MagicLinkEnabled)
+	assert.Equal(t, model.AccountDeletionReassign, updated.Settings.AccountDeletionPolicy)
+	assert.Equal(t, "german", updated.Settings.SearchLanguage)
+
+	_, err = repo.UpdateSettings(context.Background(), "non-existent-id", &model.TenantSettings{
+		AccountDeletionPolicy: model.AccountDeletionAnonymize,
+		SearchLanguage:        model.DefaultSearchLanguage,
+	})
+	require.Error(t, err)
+}
+
+func TestTenantRepository_SCIMToken(t *testing.T) {
+	t.Parallel()
+
+	client := common.SetupTestClient(t)
+	repo := NewTenantRepository(client)
+
+	tenant := common.CreateTenant(t, client, common.DefaultTenantBuilder(client, ""))
+
+	tokenHash := "scim-token-hash"
+	require.NoError(t, repo.SetSCIMTokenHash(context.Background(), tenant.ID, &tokenHash))
+
+	found, err := repo.FindBySCIMTokenHash(context.Background(), tokenHash)
+	require.NoError(t, err)
+	assert.Equal(t, tenant.ID, found.ID)
+
+	// Revoked tokens no longer authenticate
+	require.NoError(t, repo.SetSCIMTokenHash(context.Background(), tenant.ID, nil))
+	_, err = repo.FindBySCIMTokenHash(context.Background(), tokenHash)
+	require.Error(t, err)
+}
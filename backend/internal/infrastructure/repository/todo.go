@@ -2,12 +2,26 @@ package repository
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"good-todo-go/internal/domain/model"
 	"good-todo-go/internal/domain/repository"
 	"good-todo-go/internal/ent"
+	"good-todo-go/internal/ent/predicate"
+	"good-todo-go/internal/ent/reminder"
+	"good-todo-go/internal/ent/schema"
+	"good-todo-go/internal/ent/tag"
 	"good-todo-go/internal/ent/todo"
+	"good-todo-go/internal/ent/todoassignment"
+	"good-todo-go/internal/ent/todocomment"
+	"good-todo-go/internal/ent/todoshare"
+	"good-todo-go/internal/ent/todotag"
 	"good-todo-go/internal/infrastructure/database"
+	"good-todo-go/internal/pkg/fracindex"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/lib/pq"
 )
 
 type TodoRepository struct {
@@ -26,19 +40,28 @@ func (r *TodoRepository) FindByID(ctx context.Context, todoID string) (*model.To
 	}
 	defer tx.Rollback()
 
-	t, err := tx.Todo.Get(ctx, todoID)
+	t, err := tx.Todo.Query().
+		Where(todo.IDEQ(todoID)).
+		WithTags(withTodoTags).
+		WithShares(withTodoShares).
+		Only(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	result := toTodoModel(t)
+	if err := loadCommentCounts(ctx, tx, []*model.Todo{result}); err != nil {
+		return nil, err
+	}
+
 	if err := tx.Commit(); err != nil {
 		return nil, err
 	}
-	return toTodoModel(t), nil
+	return result, nil
 }
 
-// FindByUserID reads todos (RLS handles tenant isolation)
-func (r *TodoRepository) FindByUserID(ctx context.Context, userID string, limit, offset int) ([]*model.Todo, error) {
+// FindByIDs reads the todos that exist among ids, in no particular order (RLS handles tenant isolation)
+func (r *TodoRepository) FindByIDs(ctx context.Context, ids []string) ([]*model.Todo, error) {
 	tx, err := database.TenantScopedTx(ctx, r.client)
 	if err != nil {
 		return nil, err
@@ -46,28 +69,57 @@ func (r *TodoRepository) FindByUserID(ctx context.Context, userID string, limit,
 	defer tx.Rollback()
 
 	todos, err := tx.Todo.Query().
-		Where(todo.UserIDEQ(userID)).
-		Order(ent.Desc(todo.FieldCreatedAt)).
-		Limit(limit).
-		Offset(offset).
+		Where(todo.IDIn(ids...)).
+		WithTags(withTodoTags).
+		WithShares(withTodoShares).
 		All(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	result, err := toTodoModelsWithCounts(ctx, tx, todos)
+	if err != nil {
+		return nil, err
+	}
+
 	if err := tx.Commit(); err != nil {
 		return nil, err
 	}
+	return result, nil
+}
 
-	result := make([]*model.Todo, len(todos))
-	for i, t := range todos {
-		result[i] = toTodoModel(t)
+// FindByUserID reads todos (RLS handles tenant isolation)
+func (r *TodoRepository) FindByUserID(ctx context.Context, userID string, filter *model.TodoFilter, sort *model.TodoSort, page *model.TodoPage) ([]*model.Todo, error) {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	query := tx.Todo.Query().
+		Where(todo.UserIDEQ(userID)).
+		Where(todoFilterPredicates(filter)...).
+		WithTags(withTodoTags).
+		Order(todoOrder(sort)...)
+
+	todos, err := applyTodoPage(query, sort, page).All(todoFilterContext(ctx, filter))
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := toTodoModelsWithCounts(ctx, tx, todos)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
 	}
 	return result, nil
 }
 
 // CountByUserID counts todos (RLS handles tenant isolation)
-func (r *TodoRepository) CountByUserID(ctx context.Context, userID string) (int, error) {
+func (r *TodoRepository) CountByUserID(ctx context.Context, userID string, filter *model.TodoFilter) (int, error) {
 	tx, err := database.TenantScopedTx(ctx, r.client)
 	if err != nil {
 		return 0, err
@@ -76,7 +128,8 @@ func (r *TodoRepository) CountByUserID(ctx context.Context, userID string) (int,
 
 	count, err := tx.Todo.Query().
 		Where(todo.UserIDEQ(userID)).
-		Count(ctx)
+		Where(todoFilterPredicates(filter)...).
+		Count(todoFilterContext(ctx, filter))
 	if err != nil {
 		return 0, err
 	}
@@ -87,20 +140,26 @@ func (r *TodoRepository) CountByUserID(ctx context.Context, userID string) (int,
 	return count, nil
 }
 
-// FindPublic reads public todos from the same tenant (RLS handles tenant isolation)
-func (r *TodoRepository) FindPublic(ctx context.Context, limit, offset int) ([]*model.Todo, error) {
+// FindByAssigneeID reads todos assigned to a user (RLS handles tenant isolation)
+func (r *TodoRepository) FindByAssigneeID(ctx context.Context, assigneeID string, filter *model.TodoFilter, sort *model.TodoSort, page *model.TodoPage) ([]*model.Todo, error) {
 	tx, err := database.TenantScopedTx(ctx, r.client)
 	if err != nil {
 		return nil, err
 	}
 	defer tx.Rollback()
 
-	todos, err := tx.Todo.Query().
-		Where(todo.IsPublicEQ(true)).
-		Order(ent.Desc(todo.FieldCreatedAt)).
-		Limit(limit).
-		Offset(offset).
-		All(ctx)
+	query := tx.Todo.Query().
+		Where(todo.AssigneeIDEQ(assigneeID)).
+		Where(todoFilterPredicates(filter)...).
+		WithTags(withTodoTags).
+		Order(todoOrder(sort)...)
+
+	todos, err := applyTodoPage(query, sort, page).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := toTodoModelsWithCounts(ctx, tx, todos)
 	if err != nil {
 		return nil, err
 	}
@@ -108,16 +167,41 @@ func (r *TodoRepository) FindPublic(ctx context.Context, limit, offset int) ([]*
 	if err := tx.Commit(); err != nil {
 		return nil, err
 	}
+	return result, nil
+}
 
-	result := make([]*model.Todo, len(todos))
-	for i, t := range todos {
-		result[i] = toTodoModel(t)
+// FindSharedWith reads todos shared with a user (RLS handles tenant isolation)
+func (r *TodoRepository) FindSharedWith(ctx context.Context, userID string, filter *model.TodoFilter, sort *model.TodoSort, page *model.TodoPage) ([]*model.Todo, error) {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	query := tx.Todo.Query().
+		Where(todo.HasSharesWith(todoshare.UserIDEQ(userID))).
+		Where(todoFilterPredicates(filter)...).
+		WithTags(withTodoTags).
+		Order(todoOrder(sort)...)
+
+	todos, err := applyTodoPage(query, sort, page).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := toTodoModelsWithCounts(ctx, tx, todos)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
 	}
 	return result, nil
 }
 
-// CountPublic counts public todos in the same tenant (RLS handles tenant isolation)
-func (r *TodoRepository) CountPublic(ctx context.Context) (int, error) {
+// CountSharedWith counts todos shared with a user (RLS handles tenant isolation)
+func (r *TodoRepository) CountSharedWith(ctx context.Context, userID string, filter *model.TodoFilter) (int, error) {
 	tx, err := database.TenantScopedTx(ctx, r.client)
 	if err != nil {
 		return 0, err
@@ -125,7 +209,8 @@ func (r *TodoRepository) CountPublic(ctx context.Context) (int, error) {
 	defer tx.Rollback()
 
 	count, err := tx.Todo.Query().
-		Where(todo.IsPublicEQ(true)).
+		Where(todo.HasSharesWith(todoshare.UserIDEQ(userID))).
+		Where(todoFilterPredicates(filter)...).
 		Count(ctx)
 	if err != nil {
 		return 0, err
@@ -137,28 +222,75 @@ func (r *TodoRepository) CountPublic(ctx context.Context) (int, error) {
 	return count, nil
 }
 
-// Create writes directly to todos table (RLS protected)
-func (r *TodoRepository) Create(ctx context.Context, t *model.Todo) (*model.Todo, error) {
+// CountByAssigneeID counts todos assigned to a user (RLS handles tenant isolation)
+func (r *TodoRepository) CountByAssigneeID(ctx context.Context, assigneeID string, filter *model.TodoFilter) (int, error) {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	count, err := tx.Todo.Query().
+		Where(todo.AssigneeIDEQ(assigneeID)).
+		Where(todoFilterPredicates(filter)...).
+		Count(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// FindAssignments reads a todo's assignment history (RLS handles tenant isolation)
+func (r *TodoRepository) FindAssignments(ctx context.Context, todoID string) ([]*model.TodoAssignment, error) {
 	tx, err := database.TenantScopedTx(ctx, r.client)
 	if err != nil {
 		return nil, err
 	}
 	defer tx.Rollback()
 
-	builder := tx.Todo.Create().
-		SetID(t.ID).
-		SetTenantID(t.TenantID).
-		SetUserID(t.UserID).
-		SetTitle(t.Title).
-		SetDescription(t.Description).
-		SetCompleted(t.Completed).
-		SetIsPublic(t.IsPublic)
+	assignments, err := tx.TodoAssignment.Query().
+		Where(todoassignment.TodoIDEQ(todoID)).
+		Order(todoassignment.ByCreatedAt(), todoassignment.ByID()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-	if t.DueDate != nil {
-		builder.SetDueDate(*t.DueDate)
+	if err := tx.Commit(); err != nil {
+		return nil, err
 	}
 
-	created, err := builder.Save(ctx)
+	result := make([]*model.TodoAssignment, len(assignments))
+	for i, a := range assignments {
+		result[i] = &model.TodoAssignment{
+			ID:                 a.ID,
+			TenantID:           a.TenantID,
+			TodoID:             a.TodoID,
+			PreviousAssigneeID: a.PreviousAssigneeID,
+			AssigneeID:         a.AssigneeID,
+			ChangedBy:          a.ChangedBy,
+			CreatedAt:          a.CreatedAt,
+		}
+	}
+	return result, nil
+}
+
+// FindShares reads who a todo is shared with (RLS handles tenant isolation)
+func (r *TodoRepository) FindShares(ctx context.Context, todoID string) ([]*model.TodoShare, error) {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	shares, err := tx.TodoShare.Query().
+		Where(todoshare.TodoIDEQ(todoID)).
+		Order(todoshare.ByCreatedAt(), todoshare.ByID()).
+		All(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -167,30 +299,187 @@ func (r *TodoRepository) Create(ctx context.Context, t *model.Todo) (*model.Todo
 		return nil, err
 	}
 
-	return toTodoModel(created), nil
+	result := make([]*model.TodoShare, len(shares))
+	for i, share := range shares {
+		result[i] = toTodoShareModel(share)
+	}
+	return result, nil
 }
 
-// Update writes directly to todos table (RLS protected)
-func (r *TodoRepository) Update(ctx context.Context, t *model.Todo) (*model.Todo, error) {
+// ListCompletionTimes reads completion times in a range (RLS handles tenant isolation)
+func (r *TodoRepository) ListCompletionTimes(ctx context.Context, userID string, from, to time.Time) ([]time.Time, error) {
 	tx, err := database.TenantScopedTx(ctx, r.client)
 	if err != nil {
 		return nil, err
 	}
 	defer tx.Rollback()
 
-	builder := tx.Todo.UpdateOneID(t.ID).
-		SetTitle(t.Title).
-		SetDescription(t.Description).
-		SetCompleted(t.Completed).
-		SetIsPublic(t.IsPublic)
+	var rows []struct {
+		CompletedAt time.Time `json:"completed_at"`
+	}
+	err = tx.Todo.Query().
+		Where(
+			todo.UserIDEQ(userID),
+			todo.CompletedEQ(true),
+			todo.CompletedAtGTE(from),
+			todo.CompletedAtLT(to),
+		).
+		Order(todo.ByCompletedAt()).
+		Select(todo.FieldCompletedAt).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
 
-	if t.DueDate != nil {
-		builder.SetDueDate(*t.DueDate)
-	} else {
-		builder.ClearDueDate()
+	if err := tx.Commit(); err != nil {
+		return nil, err
 	}
 
-	updated, err := builder.Save(ctx)
+	result := make([]time.Time, len(rows))
+	for i, row := range rows {
+		result[i] = row.CompletedAt
+	}
+	return result, nil
+}
+
+// todoSearchMatches selects the current tenant's todos visible to $2 (own,
+// assigned, shared or public) that
+// match the to_tsquery expression $1. The query is parsed with the tenant's
+// configuration; each todo's vector was built with the same one, kept in sync
+// by TenantRepository.UpdateSettings.
+const todoSearchMatches = `
+WITH "q" AS (
+    SELECT to_tsquery(t."search_language"::regconfig, $1) AS "query"
+    FROM "tenants" t
+    WHERE t."id" = current_setting('app.current_tenant_id', true)
+)
+SELECT %s
+FROM "todos" td, "q"
+WHERE td."search_vector" @@ "q"."query"
+  AND td."deleted_at" IS NULL
+  AND (
+    td."user_id" = $2 OR td."assignee_id" = $2 OR td."is_public"
+    OR EXISTS (SELECT 1 FROM "todo_shares" s WHERE s."todo_id" = td."id" AND s."user_id" = $2)
+  )`
+
+var (
+	todoSearchQuery = fmt.Sprintf(todoSearchMatches, fmt.Sprintf(`
+    td."id", td."user_id", td."tenant_id", td."title", coalesce(td."description", ''),
+    td."completed", td."is_public", td."due_date", td."completed_at", td."project_id", td."parent_id",
+    td."assignee_id", td."recurrence_rule", td."recurrence_timezone", td."recurrence_start",
+    td."priority", td."position", td."version", td."created_at", td."updated_at",
+    ts_rank_cd(td."search_vector", "q"."query") AS "rank",
+    ts_headline(td."search_language", td."title", "q"."query",
+        'StartSel="%[1]s", StopSel="%[2]s", HighlightAll=true'),
+    ts_headline(td."search_language", coalesce(td."description", ''), "q"."query",
+        'StartSel="%[1]s", StopSel="%[2]s", MaxFragments=2, MaxWords=20, MinWords=5, FragmentDelimiter=" … "'),
+    count(*) OVER ()`, model.SearchHighlightStart, model.SearchHighlightEnd)) + `
+ORDER BY "rank" DESC, td."created_at" DESC, td."id"
+LIMIT $3 OFFSET $4`
+	todoSearchCountQuery = fmt.Sprintf(todoSearchMatches, "count(*)")
+)
+
+// Search runs a full-text search (RLS handles tenant isolation)
+func (r *TodoRepository) Search(ctx context.Context, userID, tsquery string, limit, offset int) ([]*model.TodoSearchHit, int, error) {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, todoSearchQuery, tsquery, userID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	hits := []*model.TodoSearchHit{}
+	total := 0
+	for rows.Next() {
+		t := &model.Todo{}
+		hit := &model.TodoSearchHit{Todo: t}
+		var recurrenceRule, recurrenceTimezone sql.NullString
+		var recurrenceStart sql.NullTime
+		var priority int
+		if err := rows.Scan(
+			&t.ID, &t.UserID, &t.TenantID, &t.Title, &t.Description,
+			&t.Completed, &t.IsPublic, &t.DueDate, &t.CompletedAt, &t.ProjectID, &t.ParentID,
+			&t.AssigneeID, &recurrenceRule, &recurrenceTimezone, &recurrenceStart,
+			&priority, &t.Position, &t.Version, &t.CreatedAt, &t.UpdatedAt,
+			&hit.Rank, &hit.TitleHighlight, &hit.Snippet, &total,
+		); err != nil {
+			return nil, 0, err
+		}
+		t.Priority = todoPriorityName(priority)
+		if recurrenceRule.Valid {
+			t.Recurrence = &model.TodoRecurrence{
+				Rule:     recurrenceRule.String,
+				Timezone: recurrenceTimezone.String,
+				Start:    recurrenceStart.Time,
+			}
+		}
+		hits = append(hits, hit)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+	rows.Close()
+
+	// Past the last page no row carries the window count, so count separately
+	if len(hits) == 0 && offset > 0 {
+		countRows, err := tx.QueryContext(ctx, todoSearchCountQuery, tsquery, userID)
+		if err != nil {
+			return nil, 0, err
+		}
+		defer countRows.Close()
+		if countRows.Next() {
+			if err := countRows.Scan(&total); err != nil {
+				return nil, 0, err
+			}
+		}
+		if err := countRows.Err(); err != nil {
+			return nil, 0, err
+		}
+		countRows.Close()
+	}
+
+	todos := make([]*model.Todo, len(hits))
+	for i, hit := range hits {
+		todos[i] = hit.Todo
+	}
+	if err := loadTodoTags(ctx, tx, todos); err != nil {
+		return nil, 0, err
+	}
+	if err := loadCommentCounts(ctx, tx, todos); err != nil {
+		return nil, 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, 0, err
+	}
+	return hits, total, nil
+}
+
+// FindPublic reads public todos from the same tenant (RLS handles tenant isolation)
+func (r *TodoRepository) FindPublic(ctx context.Context, filter *model.TodoFilter, page *model.TodoPage) ([]*model.Todo, error) {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	query := tx.Todo.Query().
+		Where(todo.IsPublicEQ(true)).
+		Where(todoFilterPredicates(filter)...).
+		WithTags(withTodoTags).
+		Order(todoOrder(nil)...)
+
+	todos, err := applyTodoPage(query, nil, page).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := toTodoModelsWithCounts(ctx, tx, todos)
 	if err != nil {
 		return nil, err
 	}
@@ -198,37 +487,1243 @@ func (r *TodoRepository) Update(ctx context.Context, t *model.Todo) (*model.Todo
 	if err := tx.Commit(); err != nil {
 		return nil, err
 	}
+	return result, nil
+}
+
+// CountPublic counts public todos in the same tenant (RLS handles tenant isolation)
+func (r *TodoRepository) CountPublic(ctx context.Context, filter *model.TodoFilter) (int, error) {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	count, err := tx.Todo.Query().
+		Where(todo.IsPublicEQ(true)).
+		Where(todoFilterPredicates(filter)...).
+		Count(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// FindChildren reads the direct subtasks of a todo (RLS handles tenant isolation)
+func (r *TodoRepository) FindChildren(ctx context.Context, parentID string) ([]*model.Todo, error) {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	todos, err := tx.Todo.Query().
+		Where(todo.ParentIDEQ(parentID)).
+		WithTags(withTodoTags).
+		WithShares(withTodoShares).
+		Order(todo.ByCreatedAt(), todo.ByID()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := toTodoModelsWithCounts(ctx, tx, todos)
+	if err != nil {
+		return nil, err
+	}
 
-	return toTodoModel(updated), nil
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return result, nil
 }
 
-// Delete writes directly to todos table (RLS protected)
-func (r *TodoRepository) Delete(ctx context.Context, todoID string) error {
+// todoSubtreeIDs selects the ids of every subtask below $1 with their level,
+// 1 being the direct children. Subtasks in the trash are left out. The usecase
+// keeps hierarchies acyclic and shallow; the level bound only stops runaway
+// recursion should that fail.
+const todoSubtreeIDs = `
+WITH RECURSIVE "subtree" ("id", "level") AS (
+    SELECT "id", 1 FROM "todos" WHERE "parent_id" = $1 AND "deleted_at" IS NULL
+    UNION ALL
+    SELECT t."id", s."level" + 1
+    FROM "todos" t JOIN "subtree" s ON t."parent_id" = s."id"
+    WHERE t."deleted_at" IS NULL AND s."level" < 32
+)`
+
+// todoTrashedTogether selects $1 and the subtasks below it that went to the
+// trash in the same statement, which stamped them all with deleted_at $2
+const todoTrashedTogether = `
+WITH RECURSIVE "trashed" ("id", "level") AS (
+    SELECT "id", 0 FROM "todos" WHERE "id" = $1 AND "deleted_at" = $2
+    UNION ALL
+    SELECT t."id", s."level" + 1
+    FROM "todos" t JOIN "trashed" s ON t."parent_id" = s."id"
+    WHERE t."deleted_at" = $2 AND s."level" < 32
+)`
+
+// SubtreeDepth measures the todo's hierarchy (RLS handles tenant isolation)
+func (r *TodoRepository) SubtreeDepth(ctx context.Context, todoID string) (int, error) {
 	tx, err := database.TenantScopedTx(ctx, r.client)
 	if err != nil {
-		return err
+		return 0, err
 	}
 	defer tx.Rollback()
 
-	if err := tx.Todo.DeleteOneID(todoID).Exec(ctx); err != nil {
-		return err
+	rows, err := tx.QueryContext(ctx, todoSubtreeIDs+`
+SELECT coalesce(max("level"), 0) + 1 FROM "subtree"`, todoID)
+	if err != nil {
+		return 0, err
 	}
+	defer rows.Close()
 
-	return tx.Commit()
+	depth := 1
+	if rows.Next() {
+		if err := rows.Scan(&depth); err != nil {
+			return 0, err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+	rows.Close()
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return depth, nil
 }
 
-// toTodoModel converts ent.Todo to model.Todo
-func toTodoModel(t *ent.Todo) *model.Todo {
-	return &model.Todo{
-		ID:          t.ID,
-		UserID:      t.UserID,
-		TenantID:    t.TenantID,
-		Title:       t.Title,
-		Description: t.Description,
-		Completed:   t.Completed,
-		IsPublic:    t.IsPublic,
-		DueDate:     t.DueDate,
-		CreatedAt:   t.CreatedAt,
-		UpdatedAt:   t.UpdatedAt,
+// Create writes directly to todos table (RLS protected)
+func (r *TodoRepository) Create(ctx context.Context, t *model.Todo) (*model.Todo, error) {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	created, err := createTodo(ctx, tx, t)
+	if err != nil {
+		return nil, err
+	}
+	created.Edges.Tags = []*ent.Tag{}
+	if len(t.Tags) > 0 {
+		created.Edges.Tags, err = created.QueryTags().
+			Order(tag.ByName(), tag.ByID()).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return toTodoModel(created), nil
+}
+
+// Update writes directly to todos table (RLS protected)
+func (r *TodoRepository) Update(ctx context.Context, t *model.Todo) (*model.Todo, error) {
+	updated, _, err := r.UpdateWithFollowUps(ctx, t, false, nil)
+	return updated, err
+}
+
+// UpdateWithFollowUps writes directly to todos table (RLS protected), all in
+// one transaction
+func (r *TodoRepository) UpdateWithFollowUps(ctx context.Context, t *model.Todo, cascade bool, next *model.Todo) (*model.Todo, *model.Todo, error) {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer tx.Rollback()
+
+	updated, err := updateTodo(ctx, tx, t)
+	if err != nil {
+		return nil, nil, err
+	}
+	updated.Edges.Tags, err = updated.QueryTags().
+		Order(tag.ByName(), tag.ByID()).
+		All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := toTodoModel(updated)
+	if err := loadCommentCounts(ctx, tx, []*model.Todo{result}); err != nil {
+		return nil, nil, err
+	}
+
+	if cascade && result.CompletedAt != nil {
+		if err := completeTodoDescendants(ctx, tx, result.ID, *result.CompletedAt); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var occurrence *model.Todo
+	if next != nil {
+		created, err := createTodo(ctx, tx, next)
+		if err != nil {
+			return nil, nil, err
+		}
+		created.Edges.Tags = []*ent.Tag{}
+		if len(next.Tags) > 0 {
+			created.Edges.Tags, err = created.QueryTags().
+				Order(tag.ByName(), tag.ByID()).
+				All(ctx)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+		occurrence = toTodoModel(created)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, nil, err
+	}
+
+	return result, occurrence, nil
+}
+
+// Assign writes directly to todos and todo_assignments tables (RLS protected).
+// The policy on todos rejects an assignee from another tenant.
+func (r *TodoRepository) Assign(ctx context.Context, change *model.TodoAssignment) (*model.Todo, error) {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	builder := tx.Todo.UpdateOneID(change.TodoID)
+	if change.AssigneeID != nil {
+		builder.SetAssigneeID(*change.AssigneeID)
+	} else {
+		builder.ClearAssigneeID()
+	}
+	updated, err := builder.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.TodoAssignment.Create().
+		SetID(change.ID).
+		SetTenantID(change.TenantID).
+		SetTodoID(change.TodoID).
+		SetNillablePreviousAssigneeID(change.PreviousAssigneeID).
+		SetNillableAssigneeID(change.AssigneeID).
+		SetChangedBy(change.ChangedBy).
+		Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	updated.Edges.Tags, err = updated.QueryTags().
+		Order(tag.ByName(), tag.ByID()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := toTodoModel(updated)
+	if err := loadCommentCounts(ctx, tx, []*model.Todo{result}); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Share writes directly to todo_shares table (RLS protected). The policy
+// rejects a member from another tenant.
+func (r *TodoRepository) Share(ctx context.Context, share *model.TodoShare) (*model.TodoShare, error) {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// A new grant and a permission change are one statement, so concurrent
+	// grants to the same member cannot both insert
+	if _, err := tx.ExecContext(ctx,
+		`INSERT INTO todo_shares (id, tenant_id, todo_id, user_id, permission, created_by, created_at, updated_at)
+		 VALUES ($1, $2, $3, $4, $5, $6, now(), now())
+		 ON CONFLICT (todo_id, user_id) DO UPDATE
+		 SET permission = EXCLUDED.permission, updated_at = EXCLUDED.updated_at`,
+		share.ID, share.TenantID, share.TodoID, share.UserID, share.Permission, share.CreatedBy,
+	); err != nil {
+		return nil, err
+	}
+	saved, err := tx.TodoShare.Query().
+		Where(todoshare.TodoIDEQ(share.TodoID), todoshare.UserIDEQ(share.UserID)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return toTodoShareModel(saved), nil
+}
+
+// Unshare writes directly to todo_shares table (RLS protected)
+func (r *TodoRepository) Unshare(ctx context.Context, todoID, userID string) error {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.TodoShare.Delete().
+		Where(todoshare.TodoIDEQ(todoID), todoshare.UserIDEQ(userID)).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Delete writes directly to todos table (RLS protected); the todo goes to the trash
+func (r *TodoRepository) Delete(ctx context.Context, todoID string, version int) error {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := trashTodoAtVersion(ctx, tx, todoID, version, time.Now().UTC()); err != nil {
+		return err
+	}
+	if err := detachTodoChildren(ctx, tx, []string{todoID}); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// DeleteSubtree writes directly to todos table (RLS protected); the todo and
+// its subtasks go to the trash
+func (r *TodoRepository) DeleteSubtree(ctx context.Context, todoID string, version int) error {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// The root goes first, so its version decides before anything moves
+	deletedAt := time.Now().UTC()
+	if err := trashTodoAtVersion(ctx, tx, todoID, version, deletedAt); err != nil {
+		return err
+	}
+	if err := trashTodoSubtree(ctx, tx, todoID, deletedAt); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// FindTrash reads the user's todos in the trash, most recently deleted first
+// (RLS handles tenant isolation)
+func (r *TodoRepository) FindTrash(ctx context.Context, userID string, page *model.TodoPage) ([]*model.Todo, error) {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	query := tx.Todo.Query().
+		Where(todo.UserIDEQ(userID), todo.DeletedAtNotNil()).
+		WithTags(withTodoTags).
+		Order(todo.ByDeletedAt(sql.OrderDesc()), todo.ByID())
+
+	todos, err := applyTodoPage(query, nil, page).All(schema.IncludeDeleted(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := toTodoModelsWithCounts(ctx, tx, todos)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CountTrash counts the user's todos in the trash (RLS handles tenant isolation)
+func (r *TodoRepository) CountTrash(ctx context.Context, userID string) (int, error) {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	count, err := tx.Todo.Query().
+		Where(todo.UserIDEQ(userID), todo.DeletedAtNotNil()).
+		Count(schema.IncludeDeleted(ctx))
+	if err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// FindTrashedByID reads a single todo in the trash (RLS handles tenant isolation)
+func (r *TodoRepository) FindTrashedByID(ctx context.Context, todoID string) (*model.Todo, error) {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	t, err := tx.Todo.Query().
+		Where(todo.IDEQ(todoID), todo.DeletedAtNotNil()).
+		Only(schema.IncludeDeleted(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return toTodoModel(t), nil
+}
+
+// Restore writes directly to todos table (RLS protected). The subtasks that
+// went to the trash with the todo come back with it; a todo whose parent is
+// still in the trash comes back as a top-level todo.
+func (r *TodoRepository) Restore(ctx context.Context, todoID string) (*model.Todo, error) {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	trashed, err := tx.Todo.Query().
+		Where(todo.IDEQ(todoID), todo.DeletedAtNotNil()).
+		Only(schema.IncludeDeleted(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	ids, err := queryTodoIDs(ctx, tx, todoTrashedTogether+`
+SELECT "id" FROM "trashed"`, todoID, *trashed.DeletedAt)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Todo.Update().
+		Where(todo.IDIn(ids...)).
+		ClearDeletedAt().
+		Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	if trashed.ParentID != nil {
+		parentLive, err := tx.Todo.Query().Where(todo.IDEQ(*trashed.ParentID)).Exist(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !parentLive {
+			if err := tx.Todo.UpdateOneID(todoID).ClearParentID().Exec(ctx); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	restored, err := tx.Todo.Query().
+		Where(todo.IDEQ(todoID)).
+		WithTags(withTodoTags).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := toTodoModel(restored)
+	if err := loadCommentCounts(ctx, tx, []*model.Todo{result}); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PurgeTrash writes directly to todos table (RLS protected). The purger has
+// no request context, so the tenant is passed explicitly.
+func (r *TodoRepository) PurgeTrash(ctx context.Context, tenantID string, deletedBefore time.Time, limit int) (int, error) {
+	tx, err := database.WithTenantScope(ctx, r.client, tenantID)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	ids, err := tx.Todo.Query().
+		Where(todo.DeletedAtLT(deletedBefore)).
+		Order(todo.ByDeletedAt(), todo.ByID()).
+		Limit(limit).
+		IDs(schema.IncludeDeleted(ctx))
+	if err != nil {
+		return 0, err
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	// Comments, reminders and attachments go with the todo; the blobs of the
+	// attachments are queued for the blob cleaner. The history stays, ending
+	// with a purge revision.
+	purged, err := tx.Todo.Delete().Where(todo.IDIn(ids...)).Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return purged, nil
+}
+
+// ApplyBulk writes directly to todos and todo_tags tables (RLS protected),
+// all in one transaction
+func (r *TodoRepository) ApplyBulk(ctx context.Context, change *model.TodoBulkChange) error {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, t := range change.Updates {
+		if _, err := updateTodo(ctx, tx, t); err != nil {
+			return err
+		}
+	}
+	for _, t := range change.CompleteDescendants {
+		if err := completeTodoDescendants(ctx, tx, t.ID, *t.CompletedAt); err != nil {
+			return err
+		}
+	}
+	for _, t := range change.Creates {
+		if _, err := createTodo(ctx, tx, t); err != nil {
+			return err
+		}
+	}
+	if len(change.Deletes) > 0 {
+		if err := trashTodos(ctx, tx, change.Deletes); err != nil {
+			return err
+		}
+	}
+	for _, todoID := range change.DeleteSubtrees {
+		if err := trashTodoSubtree(ctx, tx, todoID, time.Now().UTC()); err != nil {
+			return err
+		}
+	}
+	for _, link := range change.TagLinks {
+		if _, err := tx.ExecContext(ctx,
+			`INSERT INTO todo_tags (tag_id, todo_id, tenant_id, created_at)
+			 VALUES ($1, $2, $3, now())
+			 ON CONFLICT (tag_id, todo_id) DO NOTHING`,
+			link.TagID, link.TodoID, link.TenantID,
+		); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// PositionNextTo reads the todo next to anchor in its owner's manual order (RLS handles tenant isolation)
+func (r *TodoRepository) PositionNextTo(ctx context.Context, anchor *model.Todo, excludeID string, before bool) (string, error) {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback()
+
+	// The todo before the anchor is the first one after it in descending order
+	sort := &model.TodoSort{Field: model.TodoSortPosition, Desc: before}
+	positions, err := tx.Todo.Query().
+		Where(
+			todo.UserIDEQ(anchor.UserID),
+			todo.IDNEQ(excludeID),
+			todoAfterCursor(sort, &model.TodoCursor{ID: anchor.ID, Position: anchor.Position}),
+		).
+		Order(todoOrder(sort)...).
+		Limit(1).
+		Select(todo.FieldPosition).
+		Strings(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return "", err
+	}
+	if len(positions) == 0 {
+		return "", nil
+	}
+	return positions[0], nil
+}
+
+// RebalancePositions writes directly to todos table (RLS protected)
+func (r *TodoRepository) RebalancePositions(ctx context.Context, userID string) error {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := rebalancePositions(ctx, tx, userID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// todoFilterContext lets the query see todos in the trash when the filter asks for them
+func todoFilterContext(ctx context.Context, filter *model.TodoFilter) context.Context {
+	if filter != nil && filter.IncludeDeleted {
+		return schema.IncludeDeleted(ctx)
+	}
+	return ctx
+}
+
+// todoFilterPredicates translates a TodoFilter into ent predicates
+func todoFilterPredicates(filter *model.TodoFilter) []predicate.Todo {
+	if filter == nil {
+		return nil
+	}
+
+	var preds []predicate.Todo
+	if filter.Completed != nil {
+		preds = append(preds, todo.CompletedEQ(*filter.Completed))
+	}
+	if filter.Overdue != nil {
+		overdue := todo.And(
+			todo.CompletedEQ(false),
+			todo.DueDateNotNil(),
+			todo.DueDateLT(time.Now().UTC()),
+		)
+		if *filter.Overdue {
+			preds = append(preds, overdue)
+		} else {
+			preds = append(preds, todo.Not(overdue))
+		}
+	}
+	if filter.HasDueDate != nil {
+		if *filter.HasDueDate {
+			preds = append(preds, todo.DueDateNotNil())
+		} else {
+			preds = append(preds, todo.DueDateIsNil())
+		}
+	}
+	if filter.DueBefore != nil {
+		preds = append(preds, todo.DueDateLT(*filter.DueBefore))
+	}
+	if filter.DueAfter != nil {
+		preds = append(preds, todo.DueDateGTE(*filter.DueAfter))
+	}
+	if filter.CreatedBefore != nil {
+		preds = append(preds, todo.CreatedAtLT(*filter.CreatedBefore))
+	}
+	if filter.CreatedAfter != nil {
+		preds = append(preds, todo.CreatedAtGTE(*filter.CreatedAfter))
+	}
+	if filter.CompletedBefore != nil {
+		preds = append(preds, todo.CompletedAtLT(*filter.CompletedBefore))
+	}
+	if filter.CompletedAfter != nil {
+		preds = append(preds, todo.CompletedAtGTE(*filter.CompletedAfter))
+	}
+	if filter.IsPublic != nil {
+		preds = append(preds, todo.IsPublicEQ(*filter.IsPublic))
+	}
+	if filter.Search != nil && *filter.Search != "" {
+		preds = append(preds, todo.TitleContainsFold(*filter.Search))
+	}
+	if filter.ProjectID != nil {
+		preds = append(preds, todo.ProjectIDEQ(*filter.ProjectID))
+	}
+	// Every requested tag must be attached
+	for _, tagID := range filter.TagIDs {
+		preds = append(preds, todo.HasTagsWith(tag.IDEQ(tagID)))
+	}
+	return preds
+}
+
+// todoOrder translates a TodoSort into ent order options. The ID is used as a
+// tie-breaker so that pages are stable when sort values repeat.
+func todoOrder(s *model.TodoSort) []todo.OrderOption {
+	if s == nil {
+		s = &model.TodoSort{Field: model.TodoSortCreatedAt, Desc: true}
+	}
+
+	direction := sql.OrderAsc()
+	if s.Desc {
+		direction = sql.OrderDesc()
+	}
+
+	var primary todo.OrderOption
+	switch s.Field {
+	case model.TodoSortDueDate:
+		primary = todo.ByDueDate(direction, sql.OrderNullsLast())
+	case model.TodoSortUpdatedAt:
+		primary = todo.ByUpdatedAt(direction)
+	case model.TodoSortTitle:
+		primary = todo.ByTitle(direction)
+	case model.TodoSortPriority:
+		primary = todo.ByPriority(direction)
+	case model.TodoSortPosition:
+		primary = todo.ByPosition(direction)
+	default:
+		primary = todo.ByCreatedAt(direction)
+	}
+	return []todo.OrderOption{primary, todo.ByID(direction)}
+}
+
+// applyTodoPage limits the query to one page. With a cursor, only todos that
+// sort strictly after it are returned; otherwise the offset is used.
+func applyTodoPage(query *ent.TodoQuery, s *model.TodoSort, page *model.TodoPage) *ent.TodoQuery {
+	if page == nil {
+		return query
+	}
+	if page.After != nil {
+		query = query.Where(todoAfterCursor(s, page.After))
+	} else if page.Offset > 0 {
+		query = query.Offset(page.Offset)
+	}
+	if page.Limit > 0 {
+		query = query.Limit(page.Limit)
+	}
+	return query
+}
+
+// todoAfterCursor matches the todos that follow the cursor in the order
+// produced by todoOrder for the same sort
+func todoAfterCursor(s *model.TodoSort, c *model.TodoCursor) predicate.Todo {
+	if s == nil {
+		s = &model.TodoSort{Field: model.TodoSortCreatedAt, Desc: true}
+	}
+
+	idAfter := todo.IDGT(c.ID)
+	if s.Desc {
+		idAfter = todo.IDLT(c.ID)
+	}
+
+	var value time.Time
+	if c.Time != nil {
+		value = *c.Time
+	}
+
+	switch s.Field {
+	case model.TodoSortDueDate:
+		// Undated todos come last in both directions
+		if c.Time == nil {
+			return todo.And(todo.DueDateIsNil(), idAfter)
+		}
+		beyond := todo.DueDateGT(value)
+		if s.Desc {
+			beyond = todo.DueDateLT(value)
+		}
+		return todo.Or(beyond, todo.And(todo.DueDateEQ(value), idAfter), todo.DueDateIsNil())
+	case model.TodoSortUpdatedAt:
+		beyond := todo.UpdatedAtGT(value)
+		if s.Desc {
+			beyond = todo.UpdatedAtLT(value)
+		}
+		return todo.Or(beyond, todo.And(todo.UpdatedAtEQ(value), idAfter))
+	case model.TodoSortTitle:
+		beyond := todo.TitleGT(c.Title)
+		if s.Desc {
+			beyond = todo.TitleLT(c.Title)
+		}
+		return todo.Or(beyond, todo.And(todo.TitleEQ(c.Title), idAfter))
+	case model.TodoSortPriority:
+		rank := todoPriorityRank(c.Priority)
+		beyond := todo.PriorityGT(rank)
+		if s.Desc {
+			beyond = todo.PriorityLT(rank)
+		}
+		return todo.Or(beyond, todo.And(todo.PriorityEQ(rank), idAfter))
+	case model.TodoSortPosition:
+		beyond := todo.PositionGT(c.Position)
+		if s.Desc {
+			beyond = todo.PositionLT(c.Position)
+		}
+		return todo.Or(beyond, todo.And(todo.PositionEQ(c.Position), idAfter))
+	default:
+		beyond := todo.CreatedAtGT(value)
+		if s.Desc {
+			beyond = todo.CreatedAtLT(value)
+		}
+		return todo.Or(beyond, todo.And(todo.CreatedAtEQ(value), idAfter))
+	}
+}
+
+// withTodoTags eager-loads a todo's tags in display order
+func withTodoTags(q *ent.TagQuery) {
+	q.Order(tag.ByName(), tag.ByID())
+}
+
+// withTodoShares eager-loads a todo's shares, oldest first
+func withTodoShares(q *ent.TodoShareQuery) {
+	q.Order(todoshare.ByCreatedAt(), todoshare.ByID())
+}
+
+// loadTodoTags fills in the tags of todos that were read with raw SQL
+func loadTodoTags(ctx context.Context, tx *ent.Tx, todos []*model.Todo) error {
+	if len(todos) == 0 {
+		return nil
+	}
+
+	byID := make(map[string]*model.Todo, len(todos))
+	ids := make([]string, len(todos))
+	for i, t := range todos {
+		t.Tags = []*model.Tag{}
+		byID[t.ID] = t
+		ids[i] = t.ID
+	}
+
+	links, err := tx.TodoTag.Query().
+		Where(todotag.TodoIDIn(ids...)).
+		WithTag().
+		Order(todotag.ByTagField(tag.FieldName), todotag.ByTagID()).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, link := range links {
+		if t, ok := byID[link.TodoID]; ok && link.Edges.Tag != nil {
+			t.Tags = append(t.Tags, toTagModel(link.Edges.Tag))
+		}
+	}
+	return nil
+}
+
+// rescheduleOffsetReminders keeps pending reminders that are relative to the
+// due date in step with it; without a due date they are dropped. Reminders
+// already in step keep their retry schedule.
+func rescheduleOffsetReminders(ctx context.Context, tx *ent.Tx, todoID string, dueDate *time.Time) error {
+	pending := []predicate.Reminder{
+		reminder.TodoIDEQ(todoID),
+		reminder.OffsetMinutesNotNil(),
+		reminder.SentAtIsNil(),
+		reminder.FailedAtIsNil(),
+	}
+	if dueDate == nil {
+		_, err := tx.Reminder.Delete().Where(pending...).Exec(ctx)
+		return err
+	}
+	_, err := tx.ExecContext(ctx,
+		`UPDATE "reminders"
+		 SET "remind_at" = $2 - make_interval(mins => "offset_minutes"::int),
+		     "next_attempt_at" = $2 - make_interval(mins => "offset_minutes"::int)
+		 WHERE "todo_id" = $1 AND "offset_minutes" IS NOT NULL
+		   AND "sent_at" IS NULL AND "failed_at" IS NULL
+		   AND "remind_at" <> $2 - make_interval(mins => "offset_minutes"::int)`,
+		todoID, *dueDate,
+	)
+	return err
+}
+
+// loadCommentCounts fills in how many comments each todo has, leaving out
+// deleted ones
+func loadCommentCounts(ctx context.Context, tx *ent.Tx, todos []*model.Todo) error {
+	if len(todos) == 0 {
+		return nil
+	}
+
+	byID := make(map[string]*model.Todo, len(todos))
+	ids := make([]string, len(todos))
+	for i, t := range todos {
+		byID[t.ID] = t
+		ids[i] = t.ID
+	}
+
+	var counts []struct {
+		TodoID string `json:"todo_id"`
+		Count  int    `json:"count"`
+	}
+	if err := tx.TodoComment.Query().
+		Where(todocomment.TodoIDIn(ids...), todocomment.DeletedAtIsNil()).
+		GroupBy(todocomment.FieldTodoID).
+		Aggregate(ent.Count()).
+		Scan(ctx, &counts); err != nil {
+		return err
+	}
+	for _, c := range counts {
+		if t, ok := byID[c.TodoID]; ok {
+			t.CommentCount = c.Count
+		}
+	}
+	return nil
+}
+
+// createTodo inserts a todo and links its tags
+func createTodo(ctx context.Context, tx *ent.Tx, t *model.Todo) (*ent.Todo, error) {
+	position := t.Position
+	if position == "" {
+		var err error
+		position, err = appendPosition(ctx, tx, t.UserID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	builder := tx.Todo.Create().
+		SetID(t.ID).
+		SetTenantID(t.TenantID).
+		SetUserID(t.UserID).
+		SetTitle(t.Title).
+		SetDescription(t.Description).
+		SetCompleted(t.Completed).
+		SetIsPublic(t.IsPublic).
+		SetPriority(todoPriorityRank(t.Priority)).
+		SetPosition(position).
+		SetNillableProjectID(t.ProjectID).
+		SetNillableParentID(t.ParentID).
+		SetNillableAssigneeID(t.AssigneeID)
+
+	if t.Recurrence != nil {
+		builder.
+			SetRecurrenceRule(t.Recurrence.Rule).
+			SetRecurrenceTimezone(t.Recurrence.Timezone).
+			SetRecurrenceStart(t.Recurrence.Start)
+	}
+	if t.DueDate != nil {
+		builder.SetDueDate(*t.DueDate)
+	}
+	if t.CompletedAt != nil {
+		builder.SetCompletedAt(*t.CompletedAt)
+	}
+
+	created, err := builder.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(t.Tags) > 0 {
+		links := make([]*ent.TodoTagCreate, len(t.Tags))
+		for i, tg := range t.Tags {
+			links[i] = tx.TodoTag.Create().SetTodoID(created.ID).SetTagID(tg.ID)
+		}
+		if err := tx.TodoTag.CreateBulk(links...).Exec(ctx); err != nil {
+			return nil, err
+		}
+	}
+	if len(t.Reminders) > 0 {
+		reminders := make([]*ent.ReminderCreate, len(t.Reminders))
+		for i, rm := range t.Reminders {
+			reminders[i] = tx.Reminder.Create().
+				SetID(rm.ID).
+				SetTenantID(rm.TenantID).
+				SetTodoID(created.ID).
+				SetRemindAt(rm.RemindAt).
+				SetNillableOffsetMinutes(rm.OffsetMinutes).
+				SetChannel(rm.Channel).
+				SetNextAttemptAt(rm.RemindAt)
+		}
+		if err := tx.Reminder.CreateBulk(reminders...).Exec(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return created, nil
+}
+
+// updateTodo writes every field of a todo except its assignee, keeping
+// offset reminders in step with the due date. The version condition makes the
+// write fail when the todo changed since t was read.
+func updateTodo(ctx context.Context, tx *ent.Tx, t *model.Todo) (*ent.Todo, error) {
+	builder := tx.Todo.UpdateOneID(t.ID).
+		Where(todo.VersionEQ(t.Version)).
+		SetTitle(t.Title).
+		SetDescription(t.Description).
+		SetCompleted(t.Completed).
+		SetIsPublic(t.IsPublic).
+		SetPriority(todoPriorityRank(t.Priority)).
+		SetPosition(t.Position)
+
+	if t.DueDate != nil {
+		builder.SetDueDate(*t.DueDate)
+	} else {
+		builder.ClearDueDate()
+	}
+	if t.CompletedAt != nil {
+		builder.SetCompletedAt(*t.CompletedAt)
+	} else {
+		builder.ClearCompletedAt()
+	}
+	if t.ProjectID != nil {
+		builder.SetProjectID(*t.ProjectID)
+	} else {
+		builder.ClearProjectID()
+	}
+	if t.ParentID != nil {
+		builder.SetParentID(*t.ParentID)
+	} else {
+		builder.ClearParentID()
+	}
+	if t.Recurrence != nil {
+		builder.
+			SetRecurrenceRule(t.Recurrence.Rule).
+			SetRecurrenceTimezone(t.Recurrence.Timezone).
+			SetRecurrenceStart(t.Recurrence.Start)
+	} else {
+		builder.
+			ClearRecurrenceRule().
+			ClearRecurrenceTimezone().
+			ClearRecurrenceStart()
+	}
+
+	updated, err := builder.Save(ctx)
+	if ent.IsNotFound(err) {
+		return nil, repository.ErrTodoVersionConflict
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := rescheduleOffsetReminders(ctx, tx, updated.ID, updated.DueDate); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// trashTodos moves todos to the trash. Their subtasks that are not moved
+// with them become top-level todos.
+func trashTodos(ctx context.Context, tx *ent.Tx, todoIDs []string) error {
+	if err := detachTodoChildren(ctx, tx, todoIDs); err != nil {
+		return err
+	}
+	return tx.Todo.Update().
+		Where(todo.IDIn(todoIDs...), todo.DeletedAtIsNil()).
+		SetDeletedAt(time.Now().UTC()).
+		Exec(ctx)
+}
+
+// detachTodoChildren turns the subtasks of todos that are not among them into
+// top-level todos
+func detachTodoChildren(ctx context.Context, tx *ent.Tx, todoIDs []string) error {
+	return tx.Todo.Update().
+		Where(todo.ParentIDIn(todoIDs...), todo.IDNotIn(todoIDs...), todo.DeletedAtIsNil()).
+		ClearParentID().
+		Exec(ctx)
+}
+
+// trashTodoAtVersion moves a todo to the trash if it is still at version. The
+// condition is part of the update, so a concurrent write cannot slip between
+// the check and the write.
+func trashTodoAtVersion(ctx context.Context, tx *ent.Tx, todoID string, version int, deletedAt time.Time) error {
+	n, err := tx.Todo.Update().
+		Where(todo.IDEQ(todoID), todo.VersionEQ(version), todo.DeletedAtIsNil()).
+		SetDeletedAt(deletedAt).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return repository.ErrTodoVersionConflict
+	}
+	return nil
+}
+
+// trashTodoSubtree moves a todo and its subtasks to the trash with the same
+// deleted_at, so they can be restored together
+func trashTodoSubtree(ctx context.Context, tx *ent.Tx, todoID string, deletedAt time.Time) error {
+	ids, err := queryTodoIDs(ctx, tx, todoSubtreeIDs+`
+SELECT "id" FROM "subtree"`, todoID)
+	if err != nil {
+		return err
+	}
+	return tx.Todo.Update().
+		Where(todo.IDIn(append(ids, todoID)...), todo.DeletedAtIsNil()).
+		SetDeletedAt(deletedAt).
+		Exec(ctx)
+}
+
+func completeTodoDescendants(ctx context.Context, tx *ent.Tx, todoID string, completedAt time.Time) error {
+	ids, err := queryTodoIDs(ctx, tx, todoSubtreeIDs+`
+SELECT "id" FROM "subtree"`, todoID)
+	if err != nil || len(ids) == 0 {
+		return err
+	}
+	return tx.Todo.Update().
+		Where(todo.IDIn(ids...), todo.CompletedEQ(false)).
+		SetCompleted(true).
+		SetCompletedAt(completedAt).
+		SetUpdatedAt(completedAt).
+		Exec(ctx)
+}
+
+// queryTodoIDs runs a raw query selecting todo ids. The recursive queries only
+// read: writes go through the builders so the todo hooks record them.
+func queryTodoIDs(ctx context.Context, tx *ent.Tx, query string, args ...any) ([]string, error) {
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// appendPosition returns a position after the user's last todo, spreading
+// the user's positions out first when keys at the end have grown too long
+func appendPosition(ctx context.Context, tx *ent.Tx, userID string) (string, error) {
+	position, err := positionAfterLast(ctx, tx, userID)
+	if err != nil || len(position) <= model.MaxTodoPositionLength {
+		return position, err
+	}
+	if err := rebalancePositions(ctx, tx, userID); err != nil {
+		return "", err
+	}
+	return positionAfterLast(ctx, tx, userID)
+}
+
+func positionAfterLast(ctx context.Context, tx *ent.Tx, userID string) (string, error) {
+	last, err := tx.Todo.Query().
+		Where(todo.UserIDEQ(userID)).
+		Order(todo.ByPosition(sql.OrderDesc())).
+		Limit(1).
+		Select(todo.FieldPosition).
+		Strings(ctx)
+	if err != nil {
+		return "", err
+	}
+	if len(last) == 0 {
+		return fracindex.Between("", "")
+	}
+	return fracindex.Between(last[0], "")
+}
+
+// rebalancePositions gives the user's todos evenly spaced positions in their
+// current order. It is not an edit of the todos, so updated_at stays, but the
+// version is bumped so a stale update cannot write an old position back.
+func rebalancePositions(ctx context.Context, tx *ent.Tx, userID string) error {
+	ids, err := tx.Todo.Query().
+		Where(todo.UserIDEQ(userID)).
+		Order(todo.ByPosition(), todo.ByID()).
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	// Raw SQL is not seen by the revision hook. That is deliberate: positions
+	// are not tracked (see todoRevisionValues), so nothing is lost
+	_, err = tx.ExecContext(ctx,
+		`UPDATE "todos" AS t SET "position" = v."position", "version" = t."version" + 1
+		 FROM unnest($1::text[], $2::text[]) AS v("id", "position")
+		 WHERE t."id" = v."id"`,
+		pq.Array(ids), pq.Array(fracindex.Spread(len(ids))),
+	)
+	return err
+}
+
+// todoPriorityRank is the stored value of a priority; an unknown priority counts as none
+func todoPriorityRank(priority string) int {
+	for rank, p := range model.TodoPriorities {
+		if p == priority {
+			return rank
+		}
+	}
+	return 0
+}
+
+func todoPriorityName(rank int) string {
+	if rank < 0 || rank >= len(model.TodoPriorities) {
+		return model.TodoPriorityNone
+	}
+	return model.TodoPriorities[rank]
+}
+
+// toTodoModelsWithCounts converts todos read through ent and loads their comment counts
+func toTodoModelsWithCounts(ctx context.Context, tx *ent.Tx, todos []*ent.Todo) ([]*model.Todo, error) {
+	result := make([]*model.Todo, len(todos))
+	for i, t := range todos {
+		result[i] = toTodoModel(t)
+	}
+	if err := loadCommentCounts(ctx, tx, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// toTodoModel converts ent.Todo to model.Todo
+func toTodoModel(t *ent.Todo) *model.Todo {
+	var tags []*model.Tag
+	if t.Edges.Tags != nil {
+		tags = toTagModels(t.Edges.Tags)
+	}
+	var shares []*model.TodoShare
+	if t.Edges.Shares != nil {
+		shares = make([]*model.TodoShare, len(t.Edges.Shares))
+		for i, share := range t.Edges.Shares {
+			shares[i] = toTodoShareModel(share)
+		}
+	}
+	var recurrence *model.TodoRecurrence
+	if t.RecurrenceRule != nil {
+		recurrence = &model.TodoRecurrence{Rule: *t.RecurrenceRule}
+		if t.RecurrenceTimezone != nil {
+			recurrence.Timezone = *t.RecurrenceTimezone
+		}
+		if t.RecurrenceStart != nil {
+			recurrence.Start = *t.RecurrenceStart
+		}
+	}
+	return &model.Todo{
+		ID:          t.ID,
+		UserID:      t.UserID,
+		TenantID:    t.TenantID,
+		Title:       t.Title,
+		Description: t.Description,
+		Completed:   t.Completed,
+		IsPublic:    t.IsPublic,
+		DueDate:     t.DueDate,
+		CompletedAt: t.CompletedAt,
+		Priority:    todoPriorityName(t.Priority),
+		Position:    t.Position,
+		ProjectID:   t.ProjectID,
+		ParentID:    t.ParentID,
+		AssigneeID:  t.AssigneeID,
+		Recurrence:  recurrence,
+		DeletedAt:   t.DeletedAt,
+		Version:     t.Version,
+		CreatedAt:   t.CreatedAt,
+		UpdatedAt:   t.UpdatedAt,
+		Tags:        tags,
+		Shares:      shares,
+	}
+}
+
+func toTodoShareModel(s *ent.TodoShare) *model.TodoShare {
+	return &model.TodoShare{
+		ID:         s.ID,
+		TenantID:   s.TenantID,
+		TodoID:     s.TodoID,
+		UserID:     s.UserID,
+		Permission: string(s.Permission),
+		CreatedBy:  s.CreatedBy,
+		CreatedAt:  s.CreatedAt,
+		UpdatedAt:  s.UpdatedAt,
 	}
 }
@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/ent"
+	"good-todo-go/internal/ent/todorevision"
+	"good-todo-go/internal/infrastructure/database"
+)
+
+type TodoRevisionRepository struct {
+	client *ent.Client
+}
+
+func NewTodoRevisionRepository(client *ent.Client) repository.ITodoRevisionRepository {
+	return &TodoRevisionRepository{client: client}
+}
+
+// List reads the todo's revisions (RLS handles tenant isolation)
+func (r *TodoRevisionRepository) List(ctx context.Context, todoID string, offset, limit int) ([]*model.TodoRevision, int, error) {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer tx.Rollback()
+
+	query := tx.TodoRevision.Query().Where(todorevision.TodoIDEQ(todoID))
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	revisions, err := query.
+		Order(ent.Desc(todorevision.FieldCreatedAt), ent.Desc(todorevision.FieldID)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, 0, err
+	}
+
+	result := make([]*model.TodoRevision, len(revisions))
+	for i, rev := range revisions {
+		result[i] = toTodoRevisionModel(rev)
+	}
+	return result, total, nil
+}
+
+// FindSince reads the revision and the ones after it (RLS handles tenant isolation)
+func (r *TodoRevisionRepository) FindSince(ctx context.Context, todoID, revisionID string) ([]*model.TodoRevision, error) {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	since, err := tx.TodoRevision.Query().
+		Where(todorevision.IDEQ(revisionID), todorevision.TodoIDEQ(todoID)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions, err := tx.TodoRevision.Query().
+		Where(
+			todorevision.TodoIDEQ(todoID),
+			todorevision.Or(
+				todorevision.CreatedAtGT(since.CreatedAt),
+				todorevision.And(todorevision.CreatedAtEQ(since.CreatedAt), todorevision.IDGTE(since.ID)),
+			),
+		).
+		Order(ent.Asc(todorevision.FieldCreatedAt), ent.Asc(todorevision.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.TodoRevision, len(revisions))
+	for i, rev := range revisions {
+		result[i] = toTodoRevisionModel(rev)
+	}
+	return result, nil
+}
+
+// toTodoRevisionModel converts ent.TodoRevision to model.TodoRevision
+func toTodoRevisionModel(rev *ent.TodoRevision) *model.TodoRevision {
+	fields := make(map[string]bool, len(rev.NewValues))
+	for field := range rev.OldValues {
+		fields[field] = true
+	}
+	for field := range rev.NewValues {
+		fields[field] = true
+	}
+
+	changes := make([]*model.TodoFieldChange, 0, len(fields))
+	for field := range fields {
+		changes = append(changes, &model.TodoFieldChange{
+			Field: field,
+			Old:   toTodoFieldValue(field, rev.OldValues[field]),
+			New:   toTodoFieldValue(field, rev.NewValues[field]),
+		})
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Field < changes[j].Field })
+
+	return &model.TodoRevision{
+		ID:        rev.ID,
+		TenantID:  rev.TenantID,
+		TodoID:    rev.TodoID,
+		ActorID:   rev.ActorID,
+		Operation: string(rev.Operation),
+		Changes:   changes,
+		CreatedAt: rev.CreatedAt,
+	}
+}
+
+// toTodoFieldValue turns a value decoded from a revision's JSON back into
+// what the field holds: times come back as strings and priorities as numbers
+func toTodoFieldValue(field string, value any) any {
+	switch field {
+	case model.TodoFieldDueDate, model.TodoFieldCompletedAt, model.TodoFieldRecurrenceStart:
+		if s, ok := value.(string); ok {
+			if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+				return t.UTC()
+			}
+		}
+	case model.TodoFieldPriority:
+		if rank, ok := value.(float64); ok {
+			return todoPriorityName(int(rank))
+		}
+	}
+	return value
+}
@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/infrastructure/database"
+	"good-todo-go/internal/integration_test/common"
+	"good-todo-go/internal/pkg"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTodoRevisionRepository(t *testing.T) {
+	t.Parallel()
+
+	client := common.SetupTestClient(t)
+
+	tenant := common.CreateTenant(t, client, common.DefaultTenantBuilder(client, ""))
+	user := common.CreateUser(t, client, common.DefaultUserBuilder(client, "", tenant.ID))
+
+	todoRepo := NewTodoRepository(client)
+	repo := NewTodoRevisionRepository(client)
+	ctx := pkg.WithActorID(database.WithTenantID(context.Background(), tenant.ID), user.ID)
+
+	created, err := todoRepo.Create(ctx, &model.Todo{ID: "todo-history", TenantID: tenant.ID, UserID: user.ID, Title: "Before"})
+	require.NoError(t, err)
+
+	dueDate := time.Date(2026, 2, 1, 9, 0, 0, 0, time.UTC)
+	created.Title = "After"
+	created.DueDate = &dueDate
+	created.Priority = model.TodoPriorityHigh
+	updated, err := todoRepo.Update(ctx, created)
+	require.NoError(t, err)
+
+	// Moving the todo in the manual order is not a revision
+	updated.Position = updated.Position + "V"
+	moved, err := todoRepo.Update(ctx, updated)
+	require.NoError(t, err)
+
+	require.NoError(t, todoRepo.Delete(ctx, "todo-history", moved.Version))
+	restored, err := todoRepo.Restore(ctx, "todo-history")
+	require.NoError(t, err)
+
+	revisions, total, err := repo.List(ctx, "todo-history", 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 4, total)
+	require.Len(t, revisions, 4)
+
+	operations := make([]string, len(revisions))
+	for i, rev := range revisions {
+		operations[i] = rev.Operation
+		require.NotNil(t, rev.ActorID)
+		assert.Equal(t, user.ID, *rev.ActorID)
+	}
+	assert.Equal(t, []string{"restore", "delete", "update", "create"}, operations)
+
+	assert.Equal(t, []*model.TodoFieldChange{
+		{Field: model.TodoFieldDueDate, Old: nil, New: dueDate},
+		{Field: model.TodoFieldPriority, Old: model.TodoPriorityNone, New: model.TodoPriorityHigh},
+		{Field: model.TodoFieldTitle, Old: "Before", New: "After"},
+	}, revisions[2].Changes)
+
+	since, err := repo.FindSince(ctx, "todo-history", revisions[2].ID)
+	require.NoError(t, err)
+	require.Len(t, since, 3)
+	assert.Equal(t, revisions[2].ID, since[0].ID)
+	assert.Equal(t, revisions[0].ID, since[2].ID)
+
+	_, err = repo.FindSince(ctx, "another-todo", revisions[2].ID)
+	assert.Error(t, err)
+
+	// Purging the todo from the trash records its last values and keeps the history
+	require.NoError(t, todoRepo.Delete(ctx, "todo-history", restored.Version))
+	purged, err := todoRepo.PurgeTrash(context.Background(), tenant.ID, time.Now().Add(time.Hour), 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, purged)
+
+	revisions, total, err = repo.List(ctx, "todo-history", 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 6, total)
+	require.Len(t, revisions, 6)
+	assert.Equal(t, model.TodoRevisionPurge, revisions[0].Operation)
+	assert.Nil(t, revisions[0].ActorID)
+	assert.Contains(t, revisions[0].Changes, &model.TodoFieldChange{Field: model.TodoFieldTitle, Old: "After", New: nil})
+}
@@ -3,8 +3,10 @@ package repository
 import (
 	"context"
 	"testing"
+	"time"
 
 	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
 	"good-todo-go/internal/infrastructure/database"
 	"good-todo-go/internal/integration_test/common"
 
@@ -86,11 +88,273 @@ func TestTodoRepository_FindByUserID(t *testing.T) {
 	// Set tenant context
 	ctx := database.WithTenantID(context.Background(), tenant.ID)
 
-	todos, err := repo.FindByUserID(ctx, user.ID, 10, 0)
+	todos, err := repo.FindByUserID(ctx, user.ID, nil, nil, &model.TodoPage{Limit: 10})
 	require.NoError(t, err)
 	assert.Len(t, todos, 3)
 }
 
+func TestTodoRepository_FindByUserID_FilterAndSort(t *testing.T) {
+	t.Parallel()
+
+	client := common.SetupTestClient(t)
+
+	tenant := common.CreateTenant(t, client, common.DefaultTenantBuilder(client, ""))
+	user := common.CreateUser(t, client, common.DefaultUserBuilder(client, "", tenant.ID))
+
+	now := time.Now().UTC()
+	yesterday := now.Add(-24 * time.Hour)
+	lastWeek := now.Add(-7 * 24 * time.Hour)
+	tomorrow := now.Add(24 * time.Hour)
+	nextWeek := now.Add(7 * 24 * time.Hour)
+
+	overdue := common.CreateTodo(t, client, common.DefaultTodoBuilder(client, "", tenant.ID, user.ID).
+		SetTitle("Write report").SetDueDate(yesterday))
+	upcoming := common.CreateTodo(t, client, common.DefaultTodoBuilder(client, "", tenant.ID, user.ID).
+		SetTitle("Review REPORT draft").SetDueDate(tomorrow).SetIsPublic(true))
+	done := common.CreateTodo(t, client, common.CompletedTodoBuilder(client, "", tenant.ID, user.ID).
+		SetTitle("Archive mail").SetDueDate(lastWeek))
+	undated := common.CreateTodo(t, client, common.DefaultTodoBuilder(client, "", tenant.ID, user.ID).
+		SetTitle("Buy milk"))
+
+	repo := NewTodoRepository(client)
+	ctx := database.WithTenantID(context.Background(), tenant.ID)
+
+	ids := func(todos []*model.Todo) []string {
+		result := make([]string, len(todos))
+		for i, t := range todos {
+			result[i] = t.ID
+		}
+		return result
+	}
+
+	yes, no := true, false
+	search := "report"
+
+	tests := []struct {
+		name   string
+		filter *model.TodoFilter
+		sort   *model.TodoSort
+		want   []string
+	}{
+		{
+			name:   "completed",
+			filter: &model.TodoFilter{Completed: &yes},
+			want:   []string{done.ID},
+		},
+		{
+			name:   "overdue",
+			filter: &model.TodoFilter{Overdue: &yes},
+			want:   []string{overdue.ID},
+		},
+		{
+			name:   "not overdue",
+			filter: &model.TodoFilter{Overdue: &no},
+			sort:   &model.TodoSort{Field: model.TodoSortTitle},
+			want:   []string{done.ID, undated.ID, upcoming.ID},
+		},
+		{
+			name:   "due range",
+			filter: &model.TodoFilter{DueAfter: &now, DueBefore: &nextWeek},
+			want:   []string{upcoming.ID},
+		},
+		{
+			name:   "public",
+			filter: &model.TodoFilter{IsPublic: &yes},
+			want:   []string{upcoming.ID},
+		},
+		{
+			name:   "title search is case-insensitive",
+			filter: &model.TodoFilter{Search: &search},
+			sort:   &model.TodoSort{Field: model.TodoSortTitle},
+			want:   []string{upcoming.ID, overdue.ID},
+		},
+		{
+			name: "due date ascending puts undated todos last",
+			sort: &model.TodoSort{Field: model.TodoSortDueDate},
+			want: []string{done.ID, overdue.ID, upcoming.ID, undated.ID},
+		},
+		{
+			name: "due date descending puts undated todos last",
+			sort: &model.TodoSort{Field: model.TodoSortDueDate, Desc: true},
+			want: []string{upcoming.ID, overdue.ID, done.ID, undated.ID},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			todos, err := repo.FindByUserID(ctx, user.ID, tt.filter, tt.sort, &model.TodoPage{Limit: 10})
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want, ids(todos))
+
+			count, err := repo.CountByUserID(ctx, user.ID, tt.filter)
+			require.NoError(t, err)
+			assert.Equal(t, len(tt.want), count)
+		})
+	}
+}
+
+func TestTodoRepository_FindByUserID_Cursor(t *testing.T) {
+	t.Parallel()
+
+	client := common.SetupTestClient(t)
+
+	tenant := common.CreateTenant(t, client, common.DefaultTenantBuilder(client, ""))
+	user := common.CreateUser(t, client, common.DefaultUserBuilder(client, "", tenant.ID))
+
+	// Two todos share a created_at and a due date so the ID tie-breaker is exercised
+	base := time.Date(2025, 5, 1, 9, 0, 0, 0, time.UTC)
+	due := base.AddDate(0, 0, 7)
+	for i, title := range []string{"a", "b", "c", "d", "e"} {
+		builder := common.DefaultTodoBuilder(client, "", tenant.ID, user.ID).
+			SetTitle(title).
+			SetPriority(i % 3).
+			SetCreatedAt(base.Add(time.Duration(min(i, 3)) * time.Hour))
+		if i < 3 {
+			builder.SetDueDate(due.Add(time.Duration(max(i, 1)) * time.Hour))
+		}
+		common.CreateTodo(t, client, builder)
+	}
+
+	repo := NewTodoRepository(client)
+	ctx := database.WithTenantID(context.Background(), tenant.ID)
+
+	sorts := []*model.TodoSort{
+		nil,
+		{Field: model.TodoSortCreatedAt},
+		{Field: model.TodoSortTitle, Desc: true},
+		{Field: model.TodoSortDueDate},
+		{Field: model.TodoSortDueDate, Desc: true},
+		{Field: model.TodoSortPriority, Desc: true},
+		{Field: model.TodoSortPosition},
+	}
+
+	for _, sort := range sorts {
+		// Walking the listing two at a time must yield the same order as one big page
+		all, err := repo.FindByUserID(ctx, user.ID, nil, sort, &model.TodoPage{Limit: 10})
+		require.NoError(t, err)
+		require.Len(t, all, 5)
+
+		var walked []*model.Todo
+		page := &model.TodoPage{Limit: 2}
+		for {
+			todos, err := repo.FindByUserID(ctx, user.ID, nil, sort, page)
+			require.NoError(t, err)
+			walked = append(walked, todos...)
+			if len(todos) < page.Limit {
+				break
+			}
+
+			last := todos[len(todos)-1]
+			after := &model.TodoCursor{ID: last.ID, Title: last.Title, Priority: last.Priority, Position: last.Position}
+			switch {
+			case sort == nil || sort.Field == model.TodoSortCreatedAt:
+				after.Time = &last.CreatedAt
+			case sort.Field == model.TodoSortDueDate:
+				after.Time = last.DueDate
+			}
+			page = &model.TodoPage{Limit: 2, After: after}
+		}
+
+		assert.Equal(t, all, walked)
+	}
+}
+
+func TestTodoRepository_ManualOrder(t *testing.T) {
+	t.Parallel()
+
+	client := common.SetupTestClient(t)
+
+	tenant := common.CreateTenant(t, client, common.DefaultTenantBuilder(client, ""))
+	user := common.CreateUser(t, client, common.DefaultUserBuilder(client, "", tenant.ID))
+
+	repo := NewTodoRepository(client)
+	ctx := database.WithTenantID(context.Background(), tenant.ID)
+
+	// Created todos go to the end of the order
+	ids := []string{"order-1", "order-2", "order-3"}
+	for _, id := range ids {
+		_, err := repo.Create(ctx, &model.Todo{ID: id, TenantID: tenant.ID, UserID: user.ID, Title: id, Priority: model.TodoPriorityHigh})
+		require.NoError(t, err)
+	}
+
+	byPosition := &model.TodoSort{Field: model.TodoSortPosition}
+	listed := func() []string {
+		todos, err := repo.FindByUserID(ctx, user.ID, nil, byPosition, nil)
+		require.NoError(t, err)
+		result := make([]string, len(todos))
+		for i, td := range todos {
+			assert.Equal(t, model.TodoPriorityHigh, td.Priority)
+			result[i] = td.ID
+		}
+		return result
+	}
+	assert.Equal(t, ids, listed())
+
+	second, err := repo.FindByID(ctx, "order-2")
+	require.NoError(t, err)
+
+	before, err := repo.PositionNextTo(ctx, second, "", true)
+	require.NoError(t, err)
+	first, err := repo.FindByID(ctx, "order-1")
+	require.NoError(t, err)
+	assert.Equal(t, first.Position, before)
+
+	// The todo being moved is not its own neighbour
+	after, err := repo.PositionNextTo(ctx, second, "order-3", false)
+	require.NoError(t, err)
+	assert.Empty(t, after)
+
+	// Moving order-3 to the top only changes its own position
+	third, err := repo.FindByID(ctx, "order-3")
+	require.NoError(t, err)
+	third.Position = "0V"
+	_, err = repo.Update(ctx, third)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"order-3", "order-1", "order-2"}, listed())
+
+	// Rebalancing keeps the order and leaves updated_at alone
+	require.NoError(t, repo.RebalancePositions(ctx, user.ID))
+	assert.Equal(t, []string{"order-3", "order-1", "order-2"}, listed())
+	rebalanced, err := repo.FindByID(ctx, "order-1")
+	require.NoError(t, err)
+	assert.NotEqual(t, first.Position, rebalanced.Position)
+	assert.Equal(t, first.UpdatedAt, rebalanced.UpdatedAt)
+	assert.Equal(t, first.Version+1, rebalanced.Version)
+
+	// An update based on a read from before the rebalance would write the
+	// old position back, so it conflicts
+	first.Title = "stale"
+	_, err = repo.Update(ctx, first)
+	assert.ErrorIs(t, err, repository.ErrTodoVersionConflict)
+}
+
+func TestTodoRepository_ListCompletionTimes(t *testing.T) {
+	t.Parallel()
+
+	client := common.SetupTestClient(t)
+
+	tenant := common.CreateTenant(t, client, common.DefaultTenantBuilder(client, ""))
+	user := common.CreateUser(t, client, common.DefaultUserBuilder(client, "", tenant.ID))
+
+	from := time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 7)
+
+	common.CreateTodo(t, client, common.CompletedTodoBuilder(client, "", tenant.ID, user.ID).SetCompletedAt(from.Add(time.Hour)))
+	common.CreateTodo(t, client, common.CompletedTodoBuilder(client, "", tenant.ID, user.ID).SetCompletedAt(to.Add(-time.Hour)))
+	common.CreateTodo(t, client, common.CompletedTodoBuilder(client, "", tenant.ID, user.ID).SetCompletedAt(to))
+	common.CreateTodo(t, client, common.DefaultTodoBuilder(client, "", tenant.ID, user.ID))
+
+	repo := NewTodoRepository(client)
+	ctx := database.WithTenantID(context.Background(), tenant.ID)
+
+	times, err := repo.ListCompletionTimes(ctx, user.ID, from, to)
+	require.NoError(t, err)
+	require.Len(t, times, 2)
+	assert.True(t, times[0].Equal(from.Add(time.Hour)))
+	assert.True(t, times[1].Equal(to.Add(-time.Hour)))
+}
+
 func TestTodoRepository_CountByUserID(t *testing.T) {
 	t.Parallel()
 
@@ -109,11 +373,83 @@ func TestTodoRepository_CountByUserID(t *testing.T) {
 	// Set tenant context
 	ctx := database.WithTenantID(context.Background(), tenant.ID)
 
-	count, err := repo.CountByUserID(ctx, user.ID)
+	count, err := repo.CountByUserID(ctx, user.ID, nil)
 	require.NoError(t, err)
 	assert.Equal(t, 2, count)
 }
 
+func TestTodoRepository_Search(t *testing.T) {
+	t.Parallel()
+
+	client := common.SetupTestClient(t)
+
+	tenant := common.CreateTenant(t, client, common.DefaultTenantBuilder(client, ""))
+	user := common.CreateUser(t, client, common.DefaultUserBuilder(client, "", tenant.ID))
+	other := common.CreateUser(t, client, common.DefaultUserBuilder(client, "", tenant.ID))
+
+	titleMatch := common.CreateTodo(t, client, common.DefaultTodoBuilder(client, "", tenant.ID, user.ID).
+		SetTitle("Quarterly report").SetDescription("Collect the numbers"))
+	descriptionMatch := common.CreateTodo(t, client, common.DefaultTodoBuilder(client, "", tenant.ID, user.ID).
+		SetTitle("Email finance").SetDescription("Ask about the report deadline"))
+	publicMatch := common.CreateTodo(t, client, common.PublicTodoBuilder(client, "", tenant.ID, other.ID).
+		SetTitle("Reporting guidelines"))
+	common.CreateTodo(t, client, common.DefaultTodoBuilder(client, "", tenant.ID, other.ID).
+		SetTitle("Private report of someone else"))
+
+	repo := NewTodoRepository(client)
+	tenantRepo := NewTenantRepository(client)
+	ctx := database.WithTenantID(context.Background(), tenant.ID)
+
+	t.Run("prefix match over own and public todos, title matches first", func(t *testing.T) {
+		hits, total, err := repo.Search(ctx, user.ID, "report:*", 10, 0)
+		require.NoError(t, err)
+		assert.Equal(t, 3, total)
+		require.Len(t, hits, 3)
+
+		got := []string{hits[0].Todo.ID, hits[1].Todo.ID, hits[2].Todo.ID}
+		assert.ElementsMatch(t, []string{titleMatch.ID, descriptionMatch.ID, publicMatch.ID}, got)
+		assert.Equal(t, descriptionMatch.ID, hits[2].Todo.ID)
+		assert.Contains(t, hits[2].Snippet, model.SearchHighlightStart+"report"+model.SearchHighlightEnd)
+		// The version is usable for If-Match like in any other listing
+		for _, hit := range hits {
+			assert.Equal(t, 1, hit.Todo.Version)
+		}
+	})
+
+	t.Run("total survives paging past the last result", func(t *testing.T) {
+		hits, total, err := repo.Search(ctx, user.ID, "report:*", 10, 10)
+		require.NoError(t, err)
+		assert.Empty(t, hits)
+		assert.Equal(t, 3, total)
+	})
+
+	t.Run("changing the tenant language re-indexes with stemming", func(t *testing.T) {
+		// "simple" does not stem, so "deadlines" does not match "deadline"
+		hits, _, err := repo.Search(ctx, user.ID, "deadlines", 10, 0)
+		require.NoError(t, err)
+		assert.Empty(t, hits)
+
+		_, err = tenantRepo.UpdateSettings(context.Background(), tenant.ID, &model.TenantSettings{
+			AccountDeletionPolicy: model.AccountDeletionAnonymize,
+			SearchLanguage:        "english",
+		})
+		require.NoError(t, err)
+
+		hits, _, err = repo.Search(ctx, user.ID, "deadlines", 10, 0)
+		require.NoError(t, err)
+		require.Len(t, hits, 1)
+		assert.Equal(t, descriptionMatch.ID, hits[0].Todo.ID)
+
+		// Todos created afterwards pick up the tenant's language too
+		created := common.CreateTodo(t, client, common.DefaultTodoBuilder(client, "", tenant.ID, user.ID).
+			SetTitle("Plan the deadlines"))
+		hits, _, err = repo.Search(ctx, user.ID, "deadline", 10, 0)
+		require.NoError(t, err)
+		assert.Len(t, hits, 2)
+		assert.Contains(t, []string{hits[0].Todo.ID, hits[1].Todo.ID}, created.ID)
+	})
+}
+
 func TestTodoRepository_FindPublic(t *testing.T) {
 	t.Parallel()
 
@@ -133,7 +469,7 @@ func TestTodoRepository_FindPublic(t *testing.T) {
 	// Set tenant context
 	ctx := database.WithTenantID(context.Background(), tenant.ID)
 
-	todos, err := repo.FindPublic(ctx, 10, 0)
+	todos, err := repo.FindPublic(ctx, nil, &model.TodoPage{Limit: 10})
 	require.NoError(t, err)
 	assert.Len(t, todos, 2)
 
@@ -166,6 +502,7 @@ func TestTodoRepository_Update(t *testing.T) {
 		Description: "Updated Description",
 		Completed:   true,
 		IsPublic:    true,
+		Version:     todo.Version,
 	}
 
 	updated, err := repo.Update(ctx, todoModel)
@@ -174,6 +511,86 @@ func TestTodoRepository_Update(t *testing.T) {
 	assert.Equal(t, "Updated Description", updated.Description)
 	assert.True(t, updated.Completed)
 	assert.True(t, updated.IsPublic)
+	assert.Equal(t, todo.Version+1, updated.Version)
+}
+
+func TestTodoRepository_UpdateWithFollowUps_RollsBack(t *testing.T) {
+	t.Parallel()
+
+	client := common.SetupTestClient(t)
+
+	tenant := common.CreateTenant(t, client, common.DefaultTenantBuilder(client, ""))
+	user := common.CreateUser(t, client, common.DefaultUserBuilder(client, "", tenant.ID))
+
+	repo := NewTodoRepository(client)
+	ctx := database.WithTenantID(context.Background(), tenant.ID)
+
+	due := time.Date(2025, 12, 8, 9, 0, 0, 0, time.UTC)
+	recurrence := &model.TodoRecurrence{Rule: "FREQ=WEEKLY", Timezone: "UTC", Start: due}
+	created, err := repo.Create(ctx, &model.Todo{
+		ID: "todo-recurring", TenantID: tenant.ID, UserID: user.ID, Title: "weekly",
+		DueDate: &due, Recurrence: recurrence,
+	})
+	require.NoError(t, err)
+
+	completedAt := time.Now().UTC()
+	completed := *created
+	completed.Completed = true
+	completed.CompletedAt = &completedAt
+	completed.Recurrence = nil
+
+	// The next occurrence reuses the todo's ID, so creating it fails
+	nextDue := due.AddDate(0, 0, 7)
+	next := &model.Todo{
+		ID: created.ID, TenantID: tenant.ID, UserID: user.ID, Title: "weekly",
+		DueDate: &nextDue, Recurrence: recurrence,
+	}
+	_, _, err = repo.UpdateWithFollowUps(ctx, &completed, false, next)
+	require.Error(t, err)
+
+	// The completion was rolled back with it and the series is still there
+	current, err := repo.FindByID(ctx, "todo-recurring")
+	require.NoError(t, err)
+	assert.False(t, current.Completed)
+	assert.Equal(t, created.Version, current.Version)
+	require.NotNil(t, current.Recurrence)
+	assert.Equal(t, "FREQ=WEEKLY", current.Recurrence.Rule)
+}
+
+func TestTodoRepository_VersionConflict(t *testing.T) {
+	t.Parallel()
+
+	client := common.SetupTestClient(t)
+
+	tenant := common.CreateTenant(t, client, common.DefaultTenantBuilder(client, ""))
+	user := common.CreateUser(t, client, common.DefaultUserBuilder(client, "", tenant.ID))
+
+	repo := NewTodoRepository(client)
+	ctx := database.WithTenantID(context.Background(), tenant.ID)
+
+	created, err := repo.Create(ctx, &model.Todo{ID: "todo-versioned", TenantID: tenant.ID, UserID: user.ID, Title: "first"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, created.Version)
+
+	// Two writers read version 1; the first write wins
+	stale := *created
+	created.Title = "second"
+	updated, err := repo.Update(ctx, created)
+	require.NoError(t, err)
+	assert.Equal(t, 2, updated.Version)
+
+	stale.Title = "third"
+	_, err = repo.Update(ctx, &stale)
+	assert.ErrorIs(t, err, repository.ErrTodoVersionConflict)
+	assert.ErrorIs(t, repo.Delete(ctx, "todo-versioned", stale.Version), repository.ErrTodoVersionConflict)
+	assert.ErrorIs(t, repo.DeleteSubtree(ctx, "todo-versioned", stale.Version), repository.ErrTodoVersionConflict)
+
+	current, err := repo.FindByID(ctx, "todo-versioned")
+	require.NoError(t, err)
+	assert.Equal(t, "second", current.Title)
+	assert.Nil(t, current.DeletedAt)
+
+	require.NoError(t, repo.Delete(ctx, "todo-versioned", current.Version))
 }
 
 func TestTodoRepository_Delete(t *testing.T) {
@@ -192,10 +609,337 @@ func TestTodoRepository_Delete(t *testing.T) {
 	ctx := database.WithTenantID(context.Background(), tenant.ID)
 
 	// Delete the todo
-	err := repo.Delete(ctx, todo.ID)
+	err := repo.Delete(ctx, todo.ID, todo.Version)
 	require.NoError(t, err)
 
 	// Verify deletion
 	_, err = repo.FindByID(ctx, todo.ID)
 	assert.Error(t, err) // Should not find deleted todo
 }
+
+func TestTodoRepository_Subtasks(t *testing.T) {
+	t.Parallel()
+
+	client := common.SetupTestClient(t)
+
+	tenant := common.CreateTenant(t, client, common.DefaultTenantBuilder(client, ""))
+	user := common.CreateUser(t, client, common.DefaultUserBuilder(client, "", tenant.ID))
+
+	repo := NewTodoRepository(client)
+	ctx := database.WithTenantID(context.Background(), tenant.ID)
+
+	// root -> child -> grandchild
+	var parentID *string
+	for _, id := range []string{"todo-root", "todo-child", "todo-grandchild"} {
+		created, err := repo.Create(ctx, &model.Todo{ID: id, TenantID: tenant.ID, UserID: user.ID, Title: id, ParentID: parentID})
+		require.NoError(t, err)
+		parentID = &created.ID
+	}
+
+	depth, err := repo.SubtreeDepth(ctx, "todo-root")
+	require.NoError(t, err)
+	assert.Equal(t, 3, depth)
+	depth, err = repo.SubtreeDepth(ctx, "todo-grandchild")
+	require.NoError(t, err)
+	assert.Equal(t, 1, depth)
+
+	children, err := repo.FindChildren(ctx, "todo-root")
+	require.NoError(t, err)
+	require.Len(t, children, 1)
+	assert.Equal(t, "todo-child", children[0].ID)
+
+	completedAt := time.Date(2025, 12, 26, 9, 0, 0, 0, time.UTC)
+	root, err := repo.FindByID(ctx, "todo-root")
+	require.NoError(t, err)
+	root.Completed = true
+	root.CompletedAt = &completedAt
+	_, _, err = repo.UpdateWithFollowUps(ctx, root, true, nil)
+	require.NoError(t, err)
+	grandchild, err := repo.FindByID(ctx, "todo-grandchild")
+	require.NoError(t, err)
+	assert.True(t, grandchild.Completed)
+	require.NotNil(t, grandchild.CompletedAt)
+	assert.True(t, completedAt.Equal(*grandchild.CompletedAt))
+
+	// Deleting the child alone promotes the grandchild to a top-level todo
+	child, err := repo.FindByID(ctx, "todo-child")
+	require.NoError(t, err)
+	require.NoError(t, repo.Delete(ctx, "todo-child", child.Version))
+	grandchild, err = repo.FindByID(ctx, "todo-grandchild")
+	require.NoError(t, err)
+	assert.Nil(t, grandchild.ParentID)
+
+	rootID := "todo-root"
+	_, err = repo.Create(ctx, &model.Todo{ID: "todo-child-2", TenantID: tenant.ID, UserID: user.ID, Title: "child", ParentID: &rootID})
+	require.NoError(t, err)
+	root, err = repo.FindByID(ctx, "todo-root")
+	require.NoError(t, err)
+	require.NoError(t, repo.DeleteSubtree(ctx, "todo-root", root.Version))
+	_, err = repo.FindByID(ctx, "todo-child-2")
+	assert.Error(t, err)
+	_, err = repo.FindByID(ctx, "todo-grandchild")
+	assert.NoError(t, err)
+}
+
+func TestTodoRepository_ApplyBulk(t *testing.T) {
+	t.Parallel()
+
+	client := common.SetupTestClient(t)
+
+	tenant := common.CreateTenant(t, client, common.DefaultTenantBuilder(client, ""))
+	user := common.CreateUser(t, client, common.DefaultUserBuilder(client, "", tenant.ID))
+
+	repo := NewTodoRepository(client)
+	ctx := database.WithTenantID(context.Background(), tenant.ID)
+
+	for _, id := range []string{"bulk-1", "bulk-2", "bulk-3"} {
+		_, err := repo.Create(ctx, &model.Todo{ID: id, TenantID: tenant.ID, UserID: user.ID, Title: id})
+		require.NoError(t, err)
+	}
+	parentID := "bulk-1"
+	_, err := repo.Create(ctx, &model.Todo{ID: "bulk-1-child", TenantID: tenant.ID, UserID: user.ID, Title: "child", ParentID: &parentID})
+	require.NoError(t, err)
+
+	tag, err := NewTagRepository(client).Create(ctx, &model.Tag{ID: "bulk-tag", TenantID: tenant.ID, Name: "bulk", Color: model.DefaultTagColor})
+	require.NoError(t, err)
+
+	found, err := repo.FindByIDs(ctx, []string{"bulk-1", "bulk-2", "missing"})
+	require.NoError(t, err)
+	assert.Len(t, found, 2)
+
+	completedAt := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	first, err := repo.FindByID(ctx, "bulk-1")
+	require.NoError(t, err)
+	first.Completed = true
+	first.CompletedAt = &completedAt
+
+	link := &model.TodoTagLink{TenantID: tenant.ID, TodoID: "bulk-1", TagID: tag.ID}
+	require.NoError(t, repo.ApplyBulk(ctx, &model.TodoBulkChange{
+		Updates:             []*model.Todo{first},
+		CompleteDescendants: []*model.Todo{first},
+		Creates:             []*model.Todo{{ID: "bulk-4", TenantID: tenant.ID, UserID: user.ID, Title: "bulk-4"}},
+		Deletes:             []string{"bulk-2"},
+		// Linking a tag twice is not an error
+		TagLinks: []*model.TodoTagLink{link, link},
+	}))
+
+	child, err := repo.FindByID(ctx, "bulk-1-child")
+	require.NoError(t, err)
+	assert.True(t, child.Completed)
+	_, err = repo.FindByID(ctx, "bulk-2")
+	assert.Error(t, err)
+	created, err := repo.FindByID(ctx, "bulk-4")
+	require.NoError(t, err)
+	assert.NotEmpty(t, created.Position)
+	first, err = repo.FindByID(ctx, "bulk-1")
+	require.NoError(t, err)
+	assert.True(t, first.Completed)
+	require.Len(t, first.Tags, 1)
+	assert.Equal(t, tag.ID, first.Tags[0].ID)
+
+	// A failing write rolls the whole change back
+	third, err := repo.FindByID(ctx, "bulk-3")
+	require.NoError(t, err)
+	third.Title = "renamed"
+	err = repo.ApplyBulk(ctx, &model.TodoBulkChange{
+		Updates: []*model.Todo{third},
+		Creates: []*model.Todo{{ID: "bulk-1", TenantID: tenant.ID, UserID: user.ID, Title: "duplicate"}},
+	})
+	require.Error(t, err)
+	third, err = repo.FindByID(ctx, "bulk-3")
+	require.NoError(t, err)
+	assert.Equal(t, "bulk-3", third.Title)
+}
+
+func TestTodoRepository_Trash(t *testing.T) {
+	t.Parallel()
+
+	client := common.SetupTestClient(t)
+
+	tenant := common.CreateTenant(t, client, common.DefaultTenantBuilder(client, ""))
+	user := common.CreateUser(t, client, common.DefaultUserBuilder(client, "", tenant.ID))
+
+	repo := NewTodoRepository(client)
+	ctx := database.WithTenantID(context.Background(), tenant.ID)
+
+	parent, err := repo.Create(ctx, &model.Todo{ID: "trash-parent", TenantID: tenant.ID, UserID: user.ID, Title: "parent"})
+	require.NoError(t, err)
+	parentID := "trash-parent"
+	_, err = repo.Create(ctx, &model.Todo{ID: "trash-child", TenantID: tenant.ID, UserID: user.ID, Title: "child", ParentID: &parentID})
+	require.NoError(t, err)
+
+	// Trashed todos disappear from normal reads but stay in the trash
+	require.NoError(t, repo.DeleteSubtree(ctx, "trash-parent", parent.Version))
+	_, err = repo.FindByID(ctx, "trash-child")
+	assert.Error(t, err)
+
+	trash, err := repo.FindTrash(ctx, user.ID, &model.TodoPage{Limit: 20})
+	require.NoError(t, err)
+	assert.Len(t, trash, 2)
+	count, err := repo.CountTrash(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	trashed, err := repo.FindTrashedByID(ctx, "trash-parent")
+	require.NoError(t, err)
+	assert.NotNil(t, trashed.DeletedAt)
+
+	// A listing that asks for them includes trashed todos, e.g. for an export
+	withTrash := &model.TodoFilter{IncludeDeleted: true}
+	listed, err := repo.FindByUserID(ctx, user.ID, withTrash, nil, nil)
+	require.NoError(t, err)
+	assert.Len(t, listed, 2)
+	listed, err = repo.FindByUserID(ctx, user.ID, nil, nil, nil)
+	require.NoError(t, err)
+	assert.Empty(t, listed)
+
+	// Restoring the parent brings back the subtasks trashed with it
+	restored, err := repo.Restore(ctx, "trash-parent")
+	require.NoError(t, err)
+	assert.Nil(t, restored.DeletedAt)
+	child, err := repo.FindByID(ctx, "trash-child")
+	require.NoError(t, err)
+	require.NotNil(t, child.ParentID)
+	assert.Equal(t, "trash-parent", *child.ParentID)
+
+	// Purging removes trashed todos deleted before the cutoff for good
+	require.NoError(t, repo.Delete(ctx, "trash-child", child.Version))
+	purged, err := repo.PurgeTrash(context.Background(), tenant.ID, time.Now().Add(time.Hour), 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, purged)
+	_, err = repo.FindTrashedByID(ctx, "trash-child")
+	assert.Error(t, err)
+	_, err = repo.FindByID(ctx, "trash-parent")
+	assert.NoError(t, err)
+}
+
+func TestTodoRepository_Assign(t *testing.T) {
+	t.Parallel()
+
+	client := common.SetupTestClient(t)
+
+	tenant := common.CreateTenant(t, client, common.DefaultTenantBuilder(client, ""))
+	owner := common.CreateUser(t, client, common.DefaultUserBuilder(client, "", tenant.ID))
+	member := common.CreateUser(t, client, common.DefaultUserBuilder(client, "", tenant.ID).SetEmail("member-assign@example.com"))
+	todo := common.CreateTodo(t, client, common.DefaultTodoBuilder(client, "todo-assigned", tenant.ID, owner.ID))
+	common.CreateTodo(t, client, common.DefaultTodoBuilder(client, "todo-unassigned", tenant.ID, owner.ID))
+
+	repo := NewTodoRepository(client)
+	ctx := database.WithTenantID(context.Background(), tenant.ID)
+
+	assigned, err := repo.Assign(ctx, &model.TodoAssignment{
+		ID: "assignment-1", TenantID: tenant.ID, TodoID: todo.ID, AssigneeID: &member.ID, ChangedBy: owner.ID,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, assigned.AssigneeID)
+	assert.Equal(t, member.ID, *assigned.AssigneeID)
+	assert.Equal(t, owner.ID, assigned.UserID)
+
+	todos, err := repo.FindByAssigneeID(ctx, member.ID, nil, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, todos, 1)
+	assert.Equal(t, todo.ID, todos[0].ID)
+
+	count, err := repo.CountByAssigneeID(ctx, member.ID, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	// Updating the todo keeps its assignee
+	assigned.Title = "Renamed"
+	updated, err := repo.Update(ctx, assigned)
+	require.NoError(t, err)
+	require.NotNil(t, updated.AssigneeID)
+
+	_, err = repo.Assign(ctx, &model.TodoAssignment{
+		ID: "assignment-2", TenantID: tenant.ID, TodoID: todo.ID, PreviousAssigneeID: &member.ID, ChangedBy: member.ID,
+	})
+	require.NoError(t, err)
+
+	history, err := repo.FindAssignments(ctx, todo.ID)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.Equal(t, member.ID, *history[0].AssigneeID)
+	assert.Nil(t, history[0].PreviousAssigneeID)
+	assert.Nil(t, history[1].AssigneeID)
+	assert.Equal(t, member.ID, history[1].ChangedBy)
+}
+
+func TestTodoRepository_RLS_AssigneeIsolation(t *testing.T) {
+	t.Parallel()
+
+	adminClient, appClient := common.SetupTestClientWithRLS(t)
+
+	tenant1 := common.CreateTenant(t, adminClient, common.DefaultTenantBuilder(adminClient, "tenant1-assign"))
+	tenant2 := common.CreateTenant(t, adminClient, common.DefaultTenantBuilder(adminClient, "tenant2-assign"))
+	owner := common.CreateUser(t, adminClient, common.DefaultUserBuilder(adminClient, "", tenant1.ID).SetEmail("owner-assign@example.com"))
+	outsider := common.CreateUser(t, adminClient, common.DefaultUserBuilder(adminClient, "", tenant2.ID).SetEmail("outsider-assign@example.com"))
+	todo := common.CreateTodo(t, adminClient, common.DefaultTodoBuilder(adminClient, "todo-rls-assign", tenant1.ID, owner.ID))
+
+	repo := NewTodoRepository(appClient)
+	ctx := database.WithTenantID(context.Background(), tenant1.ID)
+
+	// The foreign key alone would accept a user of another tenant; the policy does not
+	_, err := repo.Assign(ctx, &model.TodoAssignment{
+		ID: "assignment-rls", TenantID: tenant1.ID, TodoID: todo.ID, AssigneeID: &outsider.ID, ChangedBy: owner.ID,
+	})
+	require.Error(t, err)
+
+	found, err := repo.FindByID(ctx, todo.ID)
+	require.NoError(t, err)
+	assert.Nil(t, found.AssigneeID)
+}
+
+func TestTodoRepository_Shares(t *testing.T) {
+	t.Parallel()
+
+	client := common.SetupTestClient(t)
+
+	tenant := common.CreateTenant(t, client, common.DefaultTenantBuilder(client, ""))
+	owner := common.CreateUser(t, client, common.DefaultUserBuilder(client, "", tenant.ID))
+	member := common.CreateUser(t, client, common.DefaultUserBuilder(client, "", tenant.ID).SetEmail("member-share@example.com"))
+	todo := common.CreateTodo(t, client, common.DefaultTodoBuilder(client, "todo-shared", tenant.ID, owner.ID))
+	common.CreateTodo(t, client, common.DefaultTodoBuilder(client, "todo-not-shared", tenant.ID, owner.ID))
+
+	repo := NewTodoRepository(client)
+	ctx := database.WithTenantID(context.Background(), tenant.ID)
+
+	shared, err := repo.Share(ctx, &model.TodoShare{
+		ID: "share-1", TenantID: tenant.ID, TodoID: todo.ID, UserID: member.ID, Permission: model.TodoPermissionRead, CreatedBy: owner.ID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, model.TodoPermissionRead, shared.Permission)
+
+	// Sharing again changes the permission of the existing share
+	changed, err := repo.Share(ctx, &model.TodoShare{
+		ID: "share-2", TenantID: tenant.ID, TodoID: todo.ID, UserID: member.ID, Permission: model.TodoPermissionEdit, CreatedBy: owner.ID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "share-1", changed.ID)
+	assert.Equal(t, model.TodoPermissionEdit, changed.Permission)
+
+	shares, err := repo.FindShares(ctx, todo.ID)
+	require.NoError(t, err)
+	require.Len(t, shares, 1)
+	assert.Equal(t, member.ID, shares[0].UserID)
+
+	found, err := repo.FindByID(ctx, todo.ID)
+	require.NoError(t, err)
+	require.Len(t, found.Shares, 1)
+	assert.Equal(t, model.TodoPermissionEdit, found.Shares[0].Permission)
+
+	todos, err := repo.FindSharedWith(ctx, member.ID, nil, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, todos, 1)
+	assert.Equal(t, todo.ID, todos[0].ID)
+
+	count, err := repo.CountSharedWith(ctx, member.ID, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	require.NoError(t, repo.Unshare(ctx, todo.ID, member.ID))
+	require.NoError(t, repo.Unshare(ctx, todo.ID, member.ID))
+
+	count, err = repo.CountSharedWith(ctx, member.ID, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+}
@@ -2,10 +2,14 @@ package repository
 
 import (
 	"context"
+	"fmt"
 
 	"good-todo-go/internal/domain/model"
 	"good-todo-go/internal/domain/repository"
 	"good-todo-go/internal/ent"
+	"good-todo-go/internal/ent/project"
+	"good-todo-go/internal/ent/reminder"
+	"good-todo-go/internal/ent/todo"
 	"good-todo-go/internal/ent/user"
 	"good-todo-go/internal/infrastructure/database"
 )
@@ -66,6 +70,89 @@ func (r *UserRepository) FindByIDs(ctx context.Context, userIDs []string) ([]*mo
 	return result, nil
 }
 
+func (r *UserRepository) List(ctx context.Context, filter *model.UserFilter, offset, limit int) ([]*model.User, int, error) {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer tx.Rollback()
+
+	query := tx.User.Query().
+		Where(user.IsSystemEQ(filter != nil && filter.System))
+	if filter != nil {
+		if filter.Email != nil {
+			query = query.Where(user.EmailEqualFold(*filter.Email))
+		}
+		if filter.ExternalID != nil {
+			query = query.Where(user.ExternalIDEQ(*filter.ExternalID))
+		}
+		if filter.Role != nil {
+			query = query.Where(user.RoleEQ(user.Role(*filter.Role)))
+		}
+		if filter.IsActive != nil {
+			query = query.Where(user.IsActiveEQ(*filter.IsActive))
+		}
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	users, err := query.
+		Order(ent.Asc(user.FieldCreatedAt), ent.Asc(user.FieldID)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, 0, err
+	}
+
+	result := make([]*model.User, len(users))
+	for i, u := range users {
+		result[i] = toUserModel(u)
+	}
+	return result, total, nil
+}
+
+func (r *UserRepository) Create(ctx context.Context, u *model.User) (*model.User, error) {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	builder := tx.User.Create().
+		SetID(u.ID).
+		SetTenantID(u.TenantID).
+		SetEmail(u.Email).
+		SetPasswordHash(u.PasswordHash).
+		SetName(u.Name).
+		SetRole(user.Role(u.Role)).
+		SetEmailVerified(u.EmailVerified).
+		SetIsActive(u.IsActive).
+		SetIsSystem(u.IsSystem)
+
+	if u.ExternalID != nil {
+		builder.SetExternalID(*u.ExternalID)
+	}
+
+	created, err := builder.Save(ctx)
+	if err != nil {
+		return nil, userWriteError(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return toUserModel(created), nil
+}
+
 func (r *UserRepository) Update(ctx context.Context, u *model.User) (*model.User, error) {
 	tx, err := database.TenantScopedTx(ctx, r.client)
 	if err != nil {
@@ -86,3 +173,150 @@ func (r *UserRepository) Update(ctx context.Context, u *model.User) (*model.User
 
 	return toUserModel(updated), nil
 }
+
+func (r *UserRepository) FindByCalendarTokenHash(ctx context.Context, tokenHash string) (*model.User, error) {
+	u, err := r.client.User.Query().
+		Where(user.CalendarTokenHashEQ(tokenHash)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toUserModel(u), nil
+}
+
+// SetCalendarTokenHash writes directly to users table (RLS protected)
+func (r *UserRepository) SetCalendarTokenHash(ctx context.Context, userID string, tokenHash *string) error {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	builder := tx.User.UpdateOneID(userID)
+	if tokenHash != nil {
+		builder.SetCalendarTokenHash(*tokenHash)
+	} else {
+		builder.ClearCalendarTokenHash()
+	}
+	if err := builder.Exec(ctx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *UserRepository) UpdateAccount(ctx context.Context, u *model.User) (*model.User, error) {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	builder := tx.User.UpdateOneID(u.ID).
+		SetEmail(u.Email).
+		SetPasswordHash(u.PasswordHash).
+		SetName(u.Name).
+		SetRole(user.Role(u.Role)).
+		SetIsActive(u.IsActive)
+
+	if u.ExternalID != nil {
+		builder.SetExternalID(*u.ExternalID)
+	} else {
+		builder.ClearExternalID()
+	}
+
+	updated, err := builder.Save(ctx)
+	if err != nil {
+		return nil, userWriteError(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return toUserModel(updated), nil
+}
+
+func (r *UserRepository) Delete(ctx context.Context, userID, heirID string) error {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// The foreign keys would clear these references too, but unseen by the
+	// todo hooks: public subtasks of private todos become top-level todos, and
+	// todos assigned to the user are unassigned
+	if err := tx.Todo.Update().
+		Where(todo.IsPublicEQ(true), todo.HasParentWith(todo.UserIDEQ(userID), todo.IsPublicEQ(false))).
+		ClearParentID().
+		Exec(ctx); err != nil {
+		return err
+	}
+	if err := tx.Todo.Update().
+		Where(todo.AssigneeIDEQ(userID)).
+		ClearAssigneeID().
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	// Private todos are deleted; their history stays, ending with a purge revision
+	if _, err := tx.Todo.Delete().
+		Where(todo.UserIDEQ(userID), todo.IsPublicEQ(false)).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	// Reminders were set up by the deleted user and must not go to the heir
+	if _, err := tx.Reminder.Delete().
+		Where(reminder.HasTodoWith(todo.UserIDEQ(userID))).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	// The handover goes through the builder so that every todo records the new
+	// owner in its history
+	if err := tx.Todo.Update().
+		Where(todo.UserIDEQ(userID)).
+		SetUserID(heirID).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	// Projects follow the same rule; deleting a private project leaves its
+	// remaining (public) todos outside of any project
+	if err := tx.Todo.Update().
+		Where(todo.HasProjectWith(project.UserIDEQ(userID), project.IsPublicEQ(false))).
+		ClearProjectID().
+		Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.Project.Delete().
+		Where(project.UserIDEQ(userID), project.IsPublicEQ(false)).
+		Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx,
+		`UPDATE "projects" SET "user_id" = $1 WHERE "user_id" = $2`,
+		heirID, userID,
+	); err != nil {
+		return err
+	}
+
+	// Magic link tokens are removed by ON DELETE CASCADE
+	if err := tx.User.DeleteOneID(userID).Exec(ctx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// userWriteError reports a unique constraint violation as ErrUserConflict.
+// Callers check uniqueness first, so this is a concurrent write of the same
+// email or external ID.
+func userWriteError(err error) error {
+	if ent.IsConstraintError(err) {
+		return fmt.Errorf("%w: %v", repository.ErrUserConflict, err)
+	}
+	return err
+}
@@ -5,8 +5,10 @@ import (
 	"testing"
 
 	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
 	"good-todo-go/internal/infrastructure/database"
 	"good-todo-go/internal/integration_test/common"
+	"good-todo-go/internal/pkg"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -178,6 +180,188 @@ func TestUserRepository_Update(t *testing.T) {
 	}
 }
 
+func TestUserRepository_List(t *testing.T) {
+	t.Parallel()
+
+	client := common.SetupTestClient(t)
+
+	tenant := common.CreateTenant(t, client, common.DefaultTenantBuilder(client, ""))
+	otherTenant := common.CreateTenant(t, client, common.DefaultTenantBuilder(client, ""))
+	user := common.CreateUser(t, client, common.DefaultUserBuilder(client, "", tenant.ID).
+		SetEmail("list-alice@example.com").
+		SetExternalID("idp-alice"))
+	common.CreateUser(t, client, common.DefaultUserBuilder(client, "", tenant.ID).SetEmail("list-bob@example.com"))
+	common.CreateUser(t, client, common.DefaultUserBuilder(client, "", otherTenant.ID).SetEmail("list-alice@example.com"))
+	placeholder := common.CreateUser(t, client, common.DefaultUserBuilder(client, "", tenant.ID).
+		SetEmail("list-placeholder@deleted.invalid").
+		SetIsActive(false).
+		SetIsSystem(true))
+
+	repo := NewUserRepository(client)
+	ctx := database.WithTenantID(context.Background(), tenant.ID)
+
+	email := "LIST-ALICE@example.com"
+	externalID := "idp-alice"
+
+	tests := []struct {
+		name      string
+		filter    *model.UserFilter
+		wantTotal int
+		wantID    string
+	}{
+		{
+			name:      "success - no filter lists members oldest first",
+			filter:    nil,
+			wantTotal: 2,
+			wantID:    user.ID,
+		},
+		{
+			name:      "success - system users only when asked for",
+			filter:    &model.UserFilter{System: true},
+			wantTotal: 1,
+			wantID:    placeholder.ID,
+		},
+		{
+			name:      "success - email filter is case insensitive",
+			filter:    &model.UserFilter{Email: &email},
+			wantTotal: 1,
+			wantID:    user.ID,
+		},
+		{
+			name:      "success - external ID filter",
+			filter:    &model.UserFilter{ExternalID: &externalID},
+			wantTotal: 1,
+			wantID:    user.ID,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			users, total, err := repo.List(ctx, tt.filter, 0, 10)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantTotal, total)
+			require.Len(t, users, tt.wantTotal)
+			if tt.wantID != "" {
+				assert.Equal(t, tt.wantID, users[0].ID)
+			}
+		})
+	}
+}
+
+func TestUserRepository_CreateAndUpdateAccount(t *testing.T) {
+	t.Parallel()
+
+	client := common.SetupTestClient(t)
+
+	tenant := common.CreateTenant(t, client, common.DefaultTenantBuilder(client, ""))
+
+	repo := NewUserRepository(client)
+	ctx := database.WithTenantID(context.Background(), tenant.ID)
+
+	externalID := "idp-carol"
+	created, err := repo.Create(ctx, &model.User{
+		ID:            "scim-user-id",
+		TenantID:      tenant.ID,
+		Email:         "carol@example.com",
+		PasswordHash:  "hashed-password",
+		Name:          "Carol",
+		Role:          "member",
+		EmailVerified: true,
+		IsActive:      true,
+		ExternalID:    &externalID,
+	})
+	require.NoError(t, err)
+	assert.True(t, created.IsActive)
+	require.NotNil(t, created.ExternalID)
+	assert.Equal(t, externalID, *created.ExternalID)
+
+	created.Role = "admin"
+	created.IsActive = false
+	created.ExternalID = nil
+	updated, err := repo.UpdateAccount(ctx, created)
+	require.NoError(t, err)
+	assert.Equal(t, "admin", updated.Role)
+	assert.False(t, updated.IsActive)
+	assert.Nil(t, updated.ExternalID)
+
+	// A second user with the same email, e.g. from a concurrent create, conflicts
+	_, err = repo.Create(ctx, &model.User{
+		ID:           "scim-user-id-2",
+		TenantID:     tenant.ID,
+		Email:        "carol@example.com",
+		PasswordHash: "hashed-password",
+		Role:         "member",
+		IsActive:     true,
+	})
+	assert.ErrorIs(t, err, repository.ErrUserConflict)
+}
+
+func TestUserRepository_Delete(t *testing.T) {
+	t.Parallel()
+
+	client := common.SetupTestClient(t)
+
+	tenant := common.CreateTenant(t, client, common.DefaultTenantBuilder(client, ""))
+	leaving := common.CreateUser(t, client, common.DefaultUserBuilder(client, "", tenant.ID))
+	heir := common.CreateUser(t, client, common.DefaultUserBuilder(client, "", tenant.ID))
+	privateTodo := common.CreateTodo(t, client, common.DefaultTodoBuilder(client, "", tenant.ID, leaving.ID))
+	publicTodo := common.CreateTodo(t, client, common.PublicTodoBuilder(client, "", tenant.ID, leaving.ID))
+
+	repo := NewUserRepository(client)
+	ctx := pkg.WithActorID(database.WithTenantID(context.Background(), tenant.ID), leaving.ID)
+
+	err := repo.Delete(ctx, leaving.ID, heir.ID)
+	require.NoError(t, err)
+
+	_, err = repo.FindByID(ctx, leaving.ID)
+	require.Error(t, err)
+
+	_, err = client.Todo.Get(ctx, privateTodo.ID)
+	require.Error(t, err)
+
+	handedOver, err := client.Todo.Get(ctx, publicTodo.ID)
+	require.NoError(t, err)
+	assert.Equal(t, heir.ID, handedOver.UserID)
+
+	// The handover is part of the todo's history, made by the deleted user
+	revisions, _, err := NewTodoRevisionRepository(client).List(ctx, publicTodo.ID, 0, 10)
+	require.NoError(t, err)
+	require.NotEmpty(t, revisions)
+	assert.Equal(t, model.TodoRevisionUpdate, revisions[0].Operation)
+	require.NotNil(t, revisions[0].ActorID)
+	assert.Equal(t, leaving.ID, *revisions[0].ActorID)
+	assert.Equal(t, []*model.TodoFieldChange{
+		{Field: model.TodoFieldUserID, Old: leaving.ID, New: heir.ID},
+	}, revisions[0].Changes)
+}
+
+func TestUserRepository_CalendarToken(t *testing.T) {
+	t.Parallel()
+
+	client := common.SetupTestClient(t)
+
+	tenant := common.CreateTenant(t, client, common.DefaultTenantBuilder(client, ""))
+	u := common.CreateUser(t, client, common.DefaultUserBuilder(client, "", tenant.ID))
+
+	repo := NewUserRepository(client)
+	ctx := database.WithTenantID(context.Background(), tenant.ID)
+
+	tokenHash := "calendar-token-hash"
+	require.NoError(t, repo.SetCalendarTokenHash(ctx, u.ID, &tokenHash))
+
+	// The feed is requested without tenant context
+	found, err := repo.FindByCalendarTokenHash(context.Background(), tokenHash)
+	require.NoError(t, err)
+	assert.Equal(t, u.ID, found.ID)
+	assert.Equal(t, tenant.ID, found.TenantID)
+
+	// Revoked tokens no longer authenticate
+	require.NoError(t, repo.SetCalendarTokenHash(ctx, u.ID, nil))
+	_, err = repo.FindByCalendarTokenHash(context.Background(), tokenHash)
+	require.Error(t, err)
+}
+
 // =============================================================================
 // RLS (Row Level Security) Tenant Isolation Tests
 // =============================================================================
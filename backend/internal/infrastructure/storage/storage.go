@@ -0,0 +1,26 @@
+package storage
+
+import (
+	"fmt"
+
+	"good-todo-go/internal/infrastructure/environment"
+	"good-todo-go/internal/pkg"
+)
+
+// Blob store drivers
+const (
+	DriverLocal = "local"
+	DriverS3    = "s3"
+)
+
+// NewBlobStore creates the blob store selected by BLOB_STORE_DRIVER
+func NewBlobStore(cfg *environment.Config) (pkg.IBlobStore, error) {
+	switch cfg.BlobStoreDriver {
+	case DriverLocal:
+		return pkg.NewLocalBlobStore(cfg.BlobStoreLocalDir), nil
+	case DriverS3:
+		return pkg.NewS3BlobStore(cfg.S3Endpoint, cfg.S3Region, cfg.S3Bucket, cfg.S3AccessKeyID, cfg.S3SecretAccessKey)
+	default:
+		return nil, fmt.Errorf("unknown blob store driver %q", cfg.BlobStoreDriver)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"good-todo-go/internal/ent"
+	_ "good-todo-go/internal/ent/runtime"
 	"good-todo-go/internal/infrastructure/repository/test"
 
 	entsql "entgo.io/ent/dialect/sql"
@@ -68,7 +68,8 @@ func CreateTodo(t *testing.T, client *ent.Client, builder *ent.TodoCreate) *ent.
 	return todo
 }
 
-// DefaultTodoBuilder returns default todo builder for testing
+// DefaultTodoBuilder returns default todo builder for testing. All todos
+// share one position; tests of the manual order set their own.
 func DefaultTodoBuilder(client *ent.Client, id, tenantID, userID string) *ent.TodoCreate {
 	if id == "" {
 		id = uuid.New().String()
@@ -80,7 +81,8 @@ func DefaultTodoBuilder(client *ent.Client, id, tenantID, userID string) *ent.To
 		SetTitle("Test Todo").
 		SetDescription("Test Description").
 		SetCompleted(false).
-		SetIsPublic(false)
+		SetIsPublic(false).
+		SetPosition("V")
 }
 
 // PublicTodoBuilder returns todo builder for public todo
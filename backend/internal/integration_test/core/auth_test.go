@@ -5,12 +5,16 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
 
+	"good-todo-go/internal/ent/tenant"
 	"good-todo-go/internal/integration_test/common"
 	"good-todo-go/internal/presentation/public/api"
 
 	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -32,7 +36,7 @@ func TestAuth_Register(t *testing.T) {
 			name: "success - register new user with new tenant",
 			requestBody: api.RegisterRequest{
 				Email:      "newuser@example.com",
-				Password:   "password123",
+				Password:   "velvet-Orbit-canyon-93",
 				Name:       strPtr("New User"),
 				TenantSlug: "new-tenant",
 			},
@@ -50,7 +54,7 @@ func TestAuth_Register(t *testing.T) {
 			name: "success - register user with existing tenant",
 			requestBody: api.RegisterRequest{
 				Email:      "anotheruser@example.com",
-				Password:   "password123",
+				Password:   "velvet-Orbit-canyon-93",
 				Name:       strPtr("Another User"),
 				TenantSlug: "new-tenant", // Same tenant as above
 			},
@@ -73,11 +77,22 @@ func TestAuth_Register(t *testing.T) {
 			expectedStatus: http.StatusBadRequest,
 			wantErr:        true,
 		},
+		{
+			name: "fail - breached password",
+			requestBody: api.RegisterRequest{
+				Email:      "breached@example.com",
+				Password:   "password123",
+				Name:       strPtr("Breached Password"),
+				TenantSlug: "test-tenant",
+			},
+			expectedStatus: http.StatusBadRequest,
+			wantErr:        true,
+		},
 		{
 			name: "fail - missing tenant slug",
 			requestBody: api.RegisterRequest{
 				Email:      "test@example.com",
-				Password:   "password123",
+				Password:   "velvet-Orbit-canyon-93",
 				TenantSlug: "",
 			},
 			expectedStatus: http.StatusBadRequest,
@@ -130,7 +145,7 @@ func TestAuth_Register_DuplicateEmail(t *testing.T) {
 	// First registration
 	firstReq := api.RegisterRequest{
 		Email:      "duplicate@example.com",
-		Password:   "password123",
+		Password:   "velvet-Orbit-canyon-93",
 		Name:       strPtr("First User"),
 		TenantSlug: "dup-test-tenant",
 	}
@@ -167,7 +182,7 @@ func TestAuth_Login(t *testing.T) {
 	e := SetupEcho()
 	registerReq := api.RegisterRequest{
 		Email:      "login-test@example.com",
-		Password:   "password123",
+		Password:   "velvet-Orbit-canyon-93",
 		Name:       strPtr("Login Test User"),
 		TenantSlug: "login-test-tenant",
 	}
@@ -190,7 +205,7 @@ func TestAuth_Login(t *testing.T) {
 			name: "success - valid credentials",
 			requestBody: api.LoginRequest{
 				Email:      "login-test@example.com",
-				Password:   "password123",
+				Password:   "velvet-Orbit-canyon-93",
 				TenantSlug: "login-test-tenant",
 			},
 			expectedStatus: http.StatusOK,
@@ -216,7 +231,7 @@ func TestAuth_Login(t *testing.T) {
 			name: "fail - user not found",
 			requestBody: api.LoginRequest{
 				Email:      "nonexistent@example.com",
-				Password:   "password123",
+				Password:   "velvet-Orbit-canyon-93",
 				TenantSlug: "login-test-tenant",
 			},
 			wantErr: true,
@@ -225,7 +240,7 @@ func TestAuth_Login(t *testing.T) {
 			name: "fail - wrong tenant",
 			requestBody: api.LoginRequest{
 				Email:      "login-test@example.com",
-				Password:   "password123",
+				Password:   "velvet-Orbit-canyon-93",
 				TenantSlug: "wrong-tenant",
 			},
 			wantErr: true,
@@ -234,7 +249,7 @@ func TestAuth_Login(t *testing.T) {
 			name: "fail - missing email",
 			requestBody: api.LoginRequest{
 				Email:      "",
-				Password:   "password123",
+				Password:   "velvet-Orbit-canyon-93",
 				TenantSlug: "login-test-tenant",
 			},
 			wantErr: true,
@@ -290,7 +305,7 @@ func TestAuth_RefreshToken(t *testing.T) {
 	e := SetupEcho()
 	registerReq := api.RegisterRequest{
 		Email:      "refresh-test@example.com",
-		Password:   "password123",
+		Password:   "velvet-Orbit-canyon-93",
 		Name:       strPtr("Refresh Test User"),
 		TenantSlug: "refresh-test-tenant",
 	}
@@ -386,7 +401,7 @@ func TestAuth_VerifyEmail(t *testing.T) {
 	e := SetupEcho()
 	registerReq := api.RegisterRequest{
 		Email:      "verify-test@example.com",
-		Password:   "password123",
+		Password:   "velvet-Orbit-canyon-93",
 		Name:       strPtr("Verify Test User"),
 		TenantSlug: "verify-test-tenant",
 	}
@@ -467,6 +482,107 @@ func TestAuth_VerifyEmail(t *testing.T) {
 	}
 }
 
+func TestAuth_MagicLink(t *testing.T) {
+	t.Parallel()
+
+	adminClient, appClient := common.SetupTestClientWithRLS(t)
+	deps := BuildTestDependencies(appClient)
+
+	// Register a user; the new tenant starts with magic links disabled
+	e := SetupEcho()
+	registerReq := api.RegisterRequest{
+		Email:      "magic-test@example.com",
+		Password:   "velvet-Orbit-canyon-93",
+		Name:       strPtr("Magic Test User"),
+		TenantSlug: "magic-test-tenant",
+	}
+
+	body, _ := json.Marshal(registerReq)
+	req := httptest.NewRequest(http.MethodPost, "/auth/register", bytes.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	require.NoError(t, deps.AuthController.Register(c))
+
+	requestLink := func(email string) error {
+		body, _ := json.Marshal(api.MagicLinkRequest{
+			Email:      openapi_types.Email(email),
+			TenantSlug: "magic-test-tenant",
+		})
+		req := httptest.NewRequest(http.MethodPost, "/auth/magic-link", bytes.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		if err := deps.AuthController.RequestMagicLink(e.NewContext(req, rec)); err != nil {
+			return err
+		}
+		assert.Equal(t, http.StatusAccepted, rec.Code)
+		return nil
+	}
+
+	consume := func(token string) (*api.AuthResponse, error) {
+		body, _ := json.Marshal(api.ConsumeMagicLinkRequest{
+			TenantSlug: "magic-test-tenant",
+			Token:      token,
+		})
+		req := httptest.NewRequest(http.MethodPost, "/auth/magic-link/consume", bytes.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		if err := deps.AuthController.ConsumeMagicLink(e.NewContext(req, rec)); err != nil {
+			return nil, err
+		}
+		var response api.AuthResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		return &response, nil
+	}
+
+	t.Run("disabled for tenant is not revealed", func(t *testing.T) {
+		require.NoError(t, requestLink("magic-test@example.com"))
+		assert.Nil(t, deps.Mailer.Last())
+	})
+
+	_, err := adminClient.Tenant.Update().
+		Where(tenant.SlugEQ("magic-test-tenant")).
+		SetMagicLinkEnabled(true).
+		Save(req.Context())
+	require.NoError(t, err)
+
+	t.Run("unknown email is not revealed", func(t *testing.T) {
+		require.NoError(t, requestLink("nobody@example.com"))
+		assert.Nil(t, deps.Mailer.Last())
+	})
+
+	require.NoError(t, requestLink("magic-test@example.com"))
+	mail := deps.Mailer.Last()
+	require.NotNil(t, mail)
+	assert.Equal(t, "magic-test@example.com", mail.To)
+
+	var token string
+	for _, field := range strings.Fields(mail.Body) {
+		if u, err := url.Parse(field); err == nil && u.Query().Get("token") != "" {
+			token = u.Query().Get("token")
+		}
+	}
+	require.NotEmpty(t, token)
+
+	t.Run("success - consume verifies email", func(t *testing.T) {
+		response, err := consume(token)
+		require.NoError(t, err)
+		assert.NotEmpty(t, response.AccessToken)
+		assert.Equal(t, "magic-test@example.com", *response.User.Email)
+		assert.True(t, *response.User.EmailVerified)
+	})
+
+	t.Run("fail - token is single use", func(t *testing.T) {
+		_, err := consume(token)
+		require.Error(t, err)
+	})
+
+	t.Run("fail - invalid token", func(t *testing.T) {
+		_, err := consume("invalid-magic-link-token")
+		require.Error(t, err)
+	})
+}
+
 // =============================================================================
 // RLS (Row Level Security) Tenant Isolation Tests
 // =============================================================================
@@ -486,7 +602,7 @@ func TestAuth_RLS_TenantIsolation(t *testing.T) {
 		// First register a user in tenant1
 		registerReq := api.RegisterRequest{
 			Email:      "rls-user@tenant1.com",
-			Password:   "password123",
+			Password:   "velvet-Orbit-canyon-93",
 			Name:       strPtr("RLS User"),
 			TenantSlug: "rls-tenant-1",
 		}
@@ -501,7 +617,7 @@ func TestAuth_RLS_TenantIsolation(t *testing.T) {
 		// Try to login with the same email but wrong tenant
 		loginReq := api.LoginRequest{
 			Email:      "rls-user@tenant1.com",
-			Password:   "password123",
+			Password:   "velvet-Orbit-canyon-93",
 			TenantSlug: "rls-tenant-2", // Wrong tenant
 		}
 
@@ -521,7 +637,7 @@ func TestAuth_RLS_TenantIsolation(t *testing.T) {
 		// Register user in tenant A
 		registerReq1 := api.RegisterRequest{
 			Email:      "same-email@example.com",
-			Password:   "password123",
+			Password:   "velvet-Orbit-canyon-93",
 			Name:       strPtr("User in Tenant A"),
 			TenantSlug: "multi-tenant-a",
 		}
@@ -538,7 +654,7 @@ func TestAuth_RLS_TenantIsolation(t *testing.T) {
 		// Register same email in tenant B - should succeed
 		registerReq2 := api.RegisterRequest{
 			Email:      "same-email@example.com",
-			Password:   "password456",
+			Password:   "amber-Quartz-meadow-57",
 			Name:       strPtr("User in Tenant B"),
 			TenantSlug: "multi-tenant-b",
 		}
@@ -555,7 +671,7 @@ func TestAuth_RLS_TenantIsolation(t *testing.T) {
 		// Both users should be able to login to their respective tenants
 		loginReq1 := api.LoginRequest{
 			Email:      "same-email@example.com",
-			Password:   "password123",
+			Password:   "velvet-Orbit-canyon-93",
 			TenantSlug: "multi-tenant-a",
 		}
 		body, _ = json.Marshal(loginReq1)
@@ -568,7 +684,7 @@ func TestAuth_RLS_TenantIsolation(t *testing.T) {
 
 		loginReq2 := api.LoginRequest{
 			Email:      "same-email@example.com",
-			Password:   "password456",
+			Password:   "amber-Quartz-meadow-57",
 			TenantSlug: "multi-tenant-b",
 		}
 		body, _ = json.Marshal(loginReq2)
@@ -0,0 +1,177 @@
+package integration_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"good-todo-go/internal/infrastructure/database"
+	"good-todo-go/internal/integration_test/common"
+	"good-todo-go/internal/presentation/public/api"
+	"good-todo-go/internal/presentation/public/router/middleware"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupCalendarEcho mirrors router.RegisterCalendarHandlers so the auth middleware is exercised
+func setupCalendarEcho(deps *TestDependencies) *echo.Echo {
+	e := SetupEcho()
+	g := e.Group("/calendar", middleware.CalendarAuthMiddleware(deps.CalendarUsecase))
+	g.GET("/:token", deps.CalendarController.GetFeed)
+	return e
+}
+
+func calendarRequest(e *echo.Echo, path string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestCalendar_Feed(t *testing.T) {
+	t.Parallel()
+
+	adminClient, appClient := common.SetupTestClientWithRLS(t)
+	dataSet := common.CreateTestDataSet(t, adminClient)
+	deps := BuildTestDependencies(appClient)
+	e := setupCalendarEcho(deps)
+
+	dueDate := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second)
+	dated := common.CreateTodo(t, adminClient, common.DefaultTodoBuilder(adminClient, "", dataSet.Tenant1.ID, dataSet.User1.ID).
+		SetTitle("Dentist, 10am; bring card").
+		SetDueDate(dueDate))
+	othersTodo := common.CreateTodo(t, adminClient, common.DefaultTodoBuilder(adminClient, "", dataSet.Tenant1.ID, dataSet.User2.ID).
+		SetDueDate(dueDate))
+
+	ctx := database.WithTenantID(t.Context(), dataSet.Tenant1.ID)
+	issued, err := deps.CalendarUsecase.RotateToken(ctx, dataSet.User1.ID)
+	require.NoError(t, err)
+	require.Equal(t, "/calendar/"+issued.Token+".ics", issued.FeedPath)
+
+	t.Run("fail - wrong token", func(t *testing.T) {
+		rec := calendarRequest(e, "/calendar/cal_wrong.ics")
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("success - events of the token's user", func(t *testing.T) {
+		rec := calendarRequest(e, issued.FeedPath)
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "text/calendar; charset=utf-8", rec.Header().Get(echo.HeaderContentType))
+
+		body := rec.Body.String()
+		assert.Contains(t, body, "BEGIN:VCALENDAR\r\n")
+		assert.Contains(t, body, "UID:"+dated.ID+"@good-todo-go\r\n")
+		assert.Contains(t, body, `SUMMARY:Dentist\, 10am\; bring card`+"\r\n")
+		assert.Contains(t, body, "DTSTART:"+dueDate.Format("20060102T150405Z")+"\r\n")
+		// Undated todos and other members' todos stay out
+		assert.NotContains(t, body, dataSet.Todo1.ID)
+		assert.NotContains(t, body, othersTodo.ID)
+	})
+
+	t.Run("success - tasks", func(t *testing.T) {
+		rec := calendarRequest(e, issued.FeedPath+"?component=vtodo")
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		body := rec.Body.String()
+		assert.Contains(t, body, "BEGIN:VTODO\r\n")
+		assert.Contains(t, body, "DUE:"+dueDate.Format("20060102T150405Z")+"\r\n")
+		assert.Contains(t, body, "STATUS:NEEDS-ACTION\r\n")
+		assert.NotContains(t, body, "BEGIN:VEVENT")
+	})
+
+	t.Run("fail - unknown component", func(t *testing.T) {
+		rec := calendarRequest(e, issued.FeedPath+"?component=vjournal")
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("fail - rotated token", func(t *testing.T) {
+		rotated, err := deps.CalendarUsecase.RotateToken(ctx, dataSet.User1.ID)
+		require.NoError(t, err)
+
+		rec := calendarRequest(e, issued.FeedPath)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+		rec = calendarRequest(e, rotated.FeedPath)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		require.NoError(t, deps.CalendarUsecase.RevokeToken(ctx, dataSet.User1.ID))
+		rec = calendarRequest(e, rotated.FeedPath)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+}
+
+func TestCalendar_Export(t *testing.T) {
+	t.Parallel()
+
+	adminClient, appClient := common.SetupTestClientWithRLS(t)
+	dataSet := common.CreateTestDataSet(t, adminClient)
+	deps := BuildTestDependencies(appClient)
+
+	dueDate := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	dated := common.CreateTodo(t, adminClient, common.DefaultTodoBuilder(adminClient, "", dataSet.Tenant1.ID, dataSet.User1.ID).
+		SetTitle("File taxes").
+		SetDueDate(dueDate))
+
+	// A series due at 9:00 in Berlin, which is 7:00 UTC in summer
+	seriesDue := time.Date(2026, 7, 6, 7, 0, 0, 0, time.UTC)
+	series := common.CreateTodo(t, adminClient, common.DefaultTodoBuilder(adminClient, "", dataSet.Tenant1.ID, dataSet.User1.ID).
+		SetTitle("Weekly review").
+		SetDueDate(seriesDue).
+		SetRecurrenceRule("FREQ=WEEKLY").
+		SetRecurrenceTimezone("Europe/Berlin").
+		SetRecurrenceStart(seriesDue))
+
+	export := func(t *testing.T, params api.ExportTodosParams) *httptest.ResponseRecorder {
+		e := SetupEcho()
+		req := httptest.NewRequest(http.MethodGet, "/todos/export", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		SetAuthContext(c, dataSet.User1.ID, dataSet.Tenant1.ID)
+
+		require.NoError(t, deps.CalendarController.ExportTodos(c, params))
+		return rec
+	}
+
+	t.Run("success - events leave out undated todos", func(t *testing.T) {
+		rec := export(t, api.ExportTodosParams{})
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Header().Get(echo.HeaderContentDisposition), ".ics")
+
+		body := rec.Body.String()
+		assert.Contains(t, body, "UID:"+dated.ID+"@good-todo-go\r\n")
+		assert.NotContains(t, body, dataSet.Todo1.ID)
+	})
+
+	t.Run("success - due dates keep their time zone", func(t *testing.T) {
+		rec := export(t, api.ExportTodosParams{})
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		body := rec.Body.String()
+		assert.Contains(t, body, "UID:"+series.ID+"@good-todo-go\r\n")
+		assert.Contains(t, body, "DTSTART;TZID=Europe/Berlin:20260706T090000\r\n")
+		assert.Contains(t, body, "BEGIN:VTIMEZONE\r\nTZID:Europe/Berlin\r\n")
+		assert.Contains(t, body, "TZOFFSETTO:+0200\r\n")
+		// Todos without a time zone stay in UTC
+		assert.Contains(t, body, "DTSTART:"+dueDate.Format("20060102T150405Z")+"\r\n")
+	})
+
+	t.Run("success - tasks include undated todos", func(t *testing.T) {
+		component := api.Vtodo
+		rec := export(t, api.ExportTodosParams{Component: &component})
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		body := rec.Body.String()
+		assert.Contains(t, body, "UID:"+dated.ID+"@good-todo-go\r\n")
+		assert.Contains(t, body, "UID:"+dataSet.Todo1.ID+"@good-todo-go\r\n")
+	})
+
+	t.Run("success - filtered", func(t *testing.T) {
+		dueBefore := dueDate
+		rec := export(t, api.ExportTodosParams{DueBefore: &dueBefore})
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.NotContains(t, rec.Body.String(), dated.ID)
+	})
+}
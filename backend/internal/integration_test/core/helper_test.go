@@ -1,11 +1,15 @@
 package integration_test
 
 import (
+	"context"
+	"sync"
+
 	"good-todo-go/internal/ent"
 	"good-todo-go/internal/infrastructure/database"
 	"good-todo-go/internal/infrastructure/repository"
 	"good-todo-go/internal/pkg"
 	"good-todo-go/internal/pkg/cerror"
+	"good-todo-go/internal/pkg/passwordpolicy"
 	"good-todo-go/internal/presentation/public/controller"
 	"good-todo-go/internal/presentation/public/presenter"
 	"good-todo-go/internal/presentation/public/router/context_keys"
@@ -16,11 +20,40 @@ import (
 
 // TestDependencies holds all the dependencies for integration tests
 type TestDependencies struct {
-	Client         *ent.Client
-	AuthController *controller.AuthController
-	TodoController *controller.TodoController
-	UserController *controller.UserController
-	JWTService     *pkg.JWTService
+	Client             *ent.Client
+	AuthController     *controller.AuthController
+	TodoController     *controller.TodoController
+	UserController     *controller.UserController
+	ScimController     *controller.ScimController
+	ScimUsecase        usecase.IScimInteractor
+	CalendarController *controller.CalendarController
+	CalendarUsecase    usecase.ICalendarInteractor
+	AuditLogger        *usecase.AuditLogger
+	JWTService         *pkg.JWTService
+	Mailer             *MailRecorder
+}
+
+// MailRecorder is an IMailer that keeps sent mail in memory
+type MailRecorder struct {
+	mu   sync.Mutex
+	sent []*pkg.Mail
+}
+
+func (m *MailRecorder) Send(_ context.Context, mail *pkg.Mail) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.sent = append(m.sent, mail)
+	return nil
+}
+
+// Last returns the most recently sent mail, or nil if none was sent
+func (m *MailRecorder) Last() *pkg.Mail {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if len(m.sent) == 0 {
+		return nil
+	}
+	return m.sent[len(m.sent)-1]
 }
 
 // BuildTestDependencies creates all dependencies for integration tests
@@ -29,32 +62,54 @@ func BuildTestDependencies(client *ent.Client) *TestDependencies {
 	authRepo := repository.NewAuthRepository(client)
 	todoRepo := repository.NewTodoRepository(client)
 	userRepo := repository.NewUserRepository(client)
+	tenantRepo := repository.NewTenantRepository(client)
+	auditRepo := repository.NewAuditEventRepository(client)
+	projectRepo := repository.NewProjectRepository(client)
+	tagRepo := repository.NewTagRepository(client)
+	revisionRepo := repository.NewTodoRevisionRepository(client)
+	reminderRepo := repository.NewReminderRepository(client)
 
 	// Services
 	uuidGen := pkg.NewUUIDGenerator()
 	jwtService := pkg.NewJWTService("test-secret-key-for-integration-tests", 3600, 86400)
+	passwordPolicy := passwordpolicy.NewPolicy(8, 3)
+	mailer := &MailRecorder{}
+	linkBuilder := pkg.NewLinkBuilder("http://localhost:3000")
+	auditLogger := usecase.NewAuditLogger(auditRepo, uuidGen)
 
 	// Usecases
-	authInteractor := usecase.NewAuthInteractor(authRepo, jwtService, uuidGen)
-	todoInteractor := usecase.NewTodoInteractor(todoRepo, userRepo, uuidGen)
-	userInteractor := usecase.NewUserInteractor(userRepo)
+	authInteractor := usecase.NewAuthInteractor(authRepo, jwtService, uuidGen, passwordPolicy, mailer, linkBuilder, auditLogger)
+	todoInteractor := usecase.NewTodoInteractor(todoRepo, userRepo, projectRepo, tagRepo, revisionRepo, reminderRepo, uuidGen)
+	userInteractor := usecase.NewUserInteractor(userRepo, todoRepo, tenantRepo, uuidGen, auditLogger)
+	scimInteractor := usecase.NewScimInteractor(userRepo, tenantRepo, uuidGen, passwordPolicy, auditLogger)
+	calendarInteractor := usecase.NewCalendarInteractor(userRepo, todoRepo)
 
 	// Presenters
 	authPresenter := presenter.NewAuthPresenter()
 	todoPresenter := presenter.NewTodoPresenter()
 	userPresenter := presenter.NewUserPresenter()
+	scimPresenter := presenter.NewScimPresenter()
+	calendarPresenter := presenter.NewCalendarPresenter()
 
 	// Controllers
 	authController := controller.NewAuthController(authInteractor, authPresenter)
 	todoController := controller.NewTodoController(todoInteractor, todoPresenter)
 	userController := controller.NewUserController(userInteractor, userPresenter)
+	scimController := controller.NewScimController(scimInteractor, scimPresenter)
+	calendarController := controller.NewCalendarController(calendarInteractor, calendarPresenter)
 
 	return &TestDependencies{
-		Client:         client,
-		AuthController: authController,
-		TodoController: todoController,
-		UserController: userController,
-		JWTService:     jwtService,
+		Client:             client,
+		AuthController:     authController,
+		TodoController:     todoController,
+		UserController:     userController,
+		ScimController:     scimController,
+		ScimUsecase:        scimInteractor,
+		CalendarController: calendarController,
+		CalendarUsecase:    calendarInteractor,
+		AuditLogger:        auditLogger,
+		JWTService:         jwtService,
+		Mailer:             mailer,
 	}
 }
 
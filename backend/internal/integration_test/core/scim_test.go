@@ -0,0 +1,147 @@
+package integration_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"good-todo-go/internal/infrastructure/repository"
+	"good-todo-go/internal/integration_test/common"
+	"good-todo-go/internal/presentation/public/router/middleware"
+	"good-todo-go/internal/presentation/public/scim"
+	"good-todo-go/internal/usecase"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupScimEcho mirrors router.RegisterScimHandlers so the auth middleware is exercised
+func setupScimEcho(deps *TestDependencies) *echo.Echo {
+	e := SetupEcho()
+	g := e.Group("/scim/v2", middleware.ScimAuthMiddleware(deps.ScimUsecase))
+	g.GET("/Users", deps.ScimController.ListUsers)
+	g.POST("/Users", deps.ScimController.CreateUser)
+	g.GET("/Users/:id", deps.ScimController.GetUser)
+	g.PATCH("/Users/:id", deps.ScimController.PatchUser)
+	g.DELETE("/Users/:id", deps.ScimController.DeleteUser)
+	return e
+}
+
+func scimRequest(t *testing.T, e *echo.Echo, method, path, token string, body interface{}) *httptest.ResponseRecorder {
+	t.Helper()
+
+	var reader *bytes.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		require.NoError(t, err)
+		reader = bytes.NewReader(b)
+	} else {
+		reader = bytes.NewReader(nil)
+	}
+
+	req := httptest.NewRequest(method, path, reader)
+	req.Header.Set(echo.HeaderContentType, scim.MIMEApplicationScimJSON)
+	if token != "" {
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestScim_UserLifecycle(t *testing.T) {
+	t.Parallel()
+
+	adminClient, appClient := common.SetupTestClientWithRLS(t)
+	dataSet := common.CreateTestDataSet(t, adminClient)
+	deps := BuildTestDependencies(appClient)
+	e := setupScimEcho(deps)
+
+	// Issue a SCIM token for Tenant1
+	tenantUsecase := usecase.NewTenantInteractor(repository.NewTenantRepository(appClient), deps.AuditLogger)
+	issued, err := tenantUsecase.RotateScimToken(t.Context(), &input.ScimTokenInput{TenantID: dataSet.Tenant1.ID, Role: "admin"})
+	require.NoError(t, err)
+	token := issued.Token
+
+	t.Run("fail - missing token", func(t *testing.T) {
+		rec := scimRequest(t, e, http.MethodGet, "/scim/v2/Users", "", nil)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("fail - wrong token", func(t *testing.T) {
+		rec := scimRequest(t, e, http.MethodGet, "/scim/v2/Users", "scim_wrong", nil)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("success - list is scoped to the token's tenant", func(t *testing.T) {
+		rec := scimRequest(t, e, http.MethodGet, "/scim/v2/Users", token, nil)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var list scim.ListResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &list))
+		assert.Equal(t, 2, list.TotalResults)
+		for _, u := range list.Resources {
+			assert.NotEqual(t, dataSet.User3.ID, u.ID)
+		}
+	})
+
+	t.Run("fail - other tenant's user is not found", func(t *testing.T) {
+		rec := scimRequest(t, e, http.MethodGet, "/scim/v2/Users/"+dataSet.User3.ID, token, nil)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	var created scim.User
+	t.Run("success - create user", func(t *testing.T) {
+		rec := scimRequest(t, e, http.MethodPost, "/scim/v2/Users", token, map[string]interface{}{
+			"schemas":    []string{scim.SchemaUser},
+			"userName":   "provisioned@tenant1.com",
+			"externalId": "idp-123",
+			"name":       map[string]string{"givenName": "Pro", "familyName": "Visioned"},
+			"active":     true,
+		})
+		require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+		assert.Equal(t, "provisioned@tenant1.com", created.UserName)
+		assert.Equal(t, "Pro Visioned", created.DisplayName)
+		assert.True(t, created.Active)
+	})
+
+	t.Run("fail - duplicate userName", func(t *testing.T) {
+		rec := scimRequest(t, e, http.MethodPost, "/scim/v2/Users", token, map[string]interface{}{
+			"userName": "provisioned@tenant1.com",
+		})
+		assert.Equal(t, http.StatusConflict, rec.Code)
+
+		var scimErr scim.Error
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &scimErr))
+		assert.Equal(t, "uniqueness", scimErr.ScimType)
+	})
+
+	t.Run("success - patch promotes to admin", func(t *testing.T) {
+		rec := scimRequest(t, e, http.MethodPatch, "/scim/v2/Users/"+created.ID, token, map[string]interface{}{
+			"schemas": []string{scim.SchemaPatchOp},
+			"Operations": []map[string]interface{}{
+				{"op": "replace", "path": "roles", "value": []map[string]interface{}{{"value": "admin", "primary": true}}},
+			},
+		})
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+		var patched scim.User
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &patched))
+		require.Len(t, patched.Roles, 1)
+		assert.Equal(t, "admin", patched.Roles[0].Value)
+	})
+
+	t.Run("success - delete deactivates", func(t *testing.T) {
+		rec := scimRequest(t, e, http.MethodDelete, "/scim/v2/Users/"+created.ID, token, nil)
+		require.Equal(t, http.StatusNoContent, rec.Code)
+
+		u, err := adminClient.User.Get(t.Context(), created.ID)
+		require.NoError(t, err)
+		assert.False(t, u.IsActive)
+	})
+}
@@ -362,7 +362,7 @@ func TestTodo_Update(t *testing.T) {
 			c.SetParamValues(tt.todoID)
 			SetAuthContext(c, tt.userID, tt.tenantID)
 
-			err = deps.TodoController.UpdateTodo(c, tt.todoID)
+			err = deps.TodoController.UpdateTodo(c, tt.todoID, api.UpdateTodoParams{})
 
 			if tt.wantErr {
 				require.Error(t, err)
@@ -386,6 +386,85 @@ func TestTodo_Update(t *testing.T) {
 	}
 }
 
+func TestTodo_Patch(t *testing.T) {
+	t.Parallel()
+
+	adminClient, appClient := common.SetupTestClientWithRLS(t)
+	dataSet := common.CreateTestDataSet(t, adminClient)
+	deps := BuildTestDependencies(appClient)
+
+	tests := []struct {
+		name           string
+		body           string
+		contentType    string
+		ifMatch        *string
+		expectedStatus int
+		wantErr        bool
+		errContains    string
+	}{
+		{
+			name:           "success - null clears the due date",
+			body:           `{"title": "Patched", "due_date": null}`,
+			contentType:    "application/merge-patch+json",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:        "fail - unknown field",
+			body:        `{"title": "Patched", "owner": "someone"}`,
+			contentType: "application/merge-patch+json",
+			wantErr:     true,
+			errContains: "invalid todo patch",
+		},
+		{
+			name:        "fail - not a merge patch",
+			body:        `{"title": "Patched"}`,
+			contentType: echo.MIMEApplicationJSON,
+			wantErr:     true,
+			errContains: "merge-patch",
+		},
+		{
+			name:        "fail - stale If-Match",
+			body:        `{"title": "Patched"}`,
+			contentType: "application/merge-patch+json",
+			ifMatch:     strPtr(`"999"`),
+			wantErr:     true,
+			errContains: "changed since it was read",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := SetupEcho()
+
+			req := httptest.NewRequest(http.MethodPatch, "/todos/"+dataSet.Todo1.ID, bytes.NewReader([]byte(tt.body)))
+			req.Header.Set(echo.HeaderContentType, tt.contentType)
+			rec := httptest.NewRecorder()
+
+			c := e.NewContext(req, rec)
+			c.SetParamNames("todoId")
+			c.SetParamValues(dataSet.Todo1.ID)
+			SetAuthContext(c, dataSet.User1.ID, dataSet.Tenant1.ID)
+
+			err := deps.TodoController.PatchTodo(c, dataSet.Todo1.ID, api.PatchTodoParams{IfMatch: tt.ifMatch})
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			assert.NotEmpty(t, rec.Header().Get("ETag"))
+
+			var response api.TodoResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+			assert.Equal(t, "Patched", *response.Title)
+			assert.Nil(t, response.DueDate)
+		})
+	}
+}
+
 func TestTodo_Delete(t *testing.T) {
 	t.Parallel()
 
@@ -432,7 +511,7 @@ func TestTodo_Delete(t *testing.T) {
 			c.SetParamValues(tt.todoID)
 			SetAuthContext(c, tt.userID, tt.tenantID)
 
-			err := deps.TodoController.DeleteTodo(c, tt.todoID)
+			err := deps.TodoController.DeleteTodo(c, tt.todoID, api.DeleteTodoParams{})
 
 			if tt.wantErr {
 				require.Error(t, err)
@@ -608,9 +687,9 @@ func TestTodo_RLS_CrossTenantModification(t *testing.T) {
 
 			var err error
 			if tt.operation == "update" {
-				err = deps.TodoController.UpdateTodo(c, tt.todoID)
+				err = deps.TodoController.UpdateTodo(c, tt.todoID, api.UpdateTodoParams{})
 			} else {
-				err = deps.TodoController.DeleteTodo(c, tt.todoID)
+				err = deps.TodoController.DeleteTodo(c, tt.todoID, api.DeleteTodoParams{})
 			}
 
 			require.Error(t, err, tt.description)
@@ -7,7 +7,11 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"good-todo-go/internal/ent/tenant"
+	"good-todo-go/internal/ent/todo"
+	"good-todo-go/internal/ent/user"
 	"good-todo-go/internal/integration_test/common"
+	"good-todo-go/internal/pkg"
 	"good-todo-go/internal/presentation/public/api"
 
 	"github.com/labstack/echo/v4"
@@ -285,3 +289,148 @@ func TestUser_RLS_UpdateIsolation(t *testing.T) {
 		})
 	}
 }
+
+func TestUser_ExportMe(t *testing.T) {
+	t.Parallel()
+
+	adminClient, appClient := common.SetupTestClientWithRLS(t)
+	dataSet := common.CreateTestDataSet(t, adminClient)
+	deps := BuildTestDependencies(appClient)
+
+	e := SetupEcho()
+	req := httptest.NewRequest(http.MethodGet, "/me/export", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	SetAuthContext(c, dataSet.User1.ID, dataSet.Tenant1.ID)
+
+	err := deps.UserController.ExportMe(c)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Header().Get(echo.HeaderContentDisposition), "attachment")
+
+	var response api.AccountExportResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, dataSet.User1.Email, *response.User.Email)
+
+	// User1 owns Todo1 (private) and Todo2 (public), and nothing else
+	ids := make([]string, 0, len(response.Todos))
+	for _, item := range response.Todos {
+		ids = append(ids, *item.Id)
+	}
+	assert.ElementsMatch(t, []string{dataSet.Todo1.ID, dataSet.Todo2.ID}, ids)
+}
+
+func TestUser_DeleteMe(t *testing.T) {
+	t.Parallel()
+
+	adminClient, appClient := common.SetupTestClientWithRLS(t)
+	dataSet := common.CreateTestDataSet(t, adminClient)
+	deps := BuildTestDependencies(appClient)
+
+	const password = "velvet-Orbit-canyon-93"
+	passwordHash, err := pkg.HashPassword(password)
+	require.NoError(t, err)
+
+	leaving := common.CreateUser(t, adminClient,
+		common.DefaultUserBuilder(adminClient, "", dataSet.Tenant1.ID).
+			SetEmail("leaving@tenant1.com").
+			SetPasswordHash(passwordHash))
+	privateTodo := common.CreateTodo(t, adminClient, common.DefaultTodoBuilder(adminClient, "", dataSet.Tenant1.ID, leaving.ID))
+	publicTodo := common.CreateTodo(t, adminClient, common.PublicTodoBuilder(adminClient, "", dataSet.Tenant1.ID, leaving.ID))
+
+	deleteMe := func(password string) (*httptest.ResponseRecorder, error) {
+		body, _ := json.Marshal(api.DeleteAccountRequest{Password: password})
+		req := httptest.NewRequest(http.MethodDelete, "/me", bytes.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := SetupEcho().NewContext(req, rec)
+		SetAuthContext(c, leaving.ID, dataSet.Tenant1.ID)
+		return rec, deps.UserController.DeleteMe(c)
+	}
+
+	t.Run("fail - wrong password", func(t *testing.T) {
+		_, err := deleteMe("not-my-password")
+		require.Error(t, err)
+
+		exists, err := adminClient.User.Query().Where(user.IDEQ(leaving.ID)).Exist(t.Context())
+		require.NoError(t, err)
+		assert.True(t, exists)
+	})
+
+	t.Run("success - private todos deleted, public todos anonymized", func(t *testing.T) {
+		rec, err := deleteMe(password)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+
+		exists, err := adminClient.User.Query().Where(user.IDEQ(leaving.ID)).Exist(t.Context())
+		require.NoError(t, err)
+		assert.False(t, exists)
+
+		exists, err = adminClient.Todo.Query().Where(todo.IDEQ(privateTodo.ID)).Exist(t.Context())
+		require.NoError(t, err)
+		assert.False(t, exists)
+
+		handedOver, err := adminClient.Todo.Query().Where(todo.IDEQ(publicTodo.ID)).WithUser().Only(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, "Deleted user", handedOver.Edges.User.Name)
+		assert.False(t, handedOver.Edges.User.IsActive)
+
+		// Other members' todos are untouched
+		exists, err = adminClient.Todo.Query().Where(todo.IDEQ(dataSet.Todo3.ID)).Exist(t.Context())
+		require.NoError(t, err)
+		assert.True(t, exists)
+	})
+
+	t.Run("success - the email can be registered again", func(t *testing.T) {
+		body, _ := json.Marshal(api.RegisterRequest{
+			Email:      "leaving@tenant1.com",
+			Password:   password,
+			TenantSlug: dataSet.Tenant1.Slug,
+		})
+		req := httptest.NewRequest(http.MethodPost, "/auth/register", bytes.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := SetupEcho().NewContext(req, rec)
+
+		err := deps.AuthController.Register(c)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+	})
+}
+
+func TestUser_DeleteMe_ReassignPolicy(t *testing.T) {
+	t.Parallel()
+
+	adminClient, appClient := common.SetupTestClientWithRLS(t)
+	dataSet := common.CreateTestDataSet(t, adminClient)
+	deps := BuildTestDependencies(appClient)
+
+	require.NoError(t, adminClient.Tenant.UpdateOneID(dataSet.Tenant1.ID).
+		SetAccountDeletionPolicy(tenant.AccountDeletionPolicyReassign).
+		Exec(t.Context()))
+	require.NoError(t, adminClient.User.UpdateOneID(dataSet.User2.ID).
+		SetRole(user.RoleAdmin).
+		Exec(t.Context()))
+
+	const password = "velvet-Orbit-canyon-93"
+	passwordHash, err := pkg.HashPassword(password)
+	require.NoError(t, err)
+	require.NoError(t, adminClient.User.UpdateOneID(dataSet.User1.ID).
+		SetPasswordHash(passwordHash).
+		Exec(t.Context()))
+
+	body, _ := json.Marshal(api.DeleteAccountRequest{Password: password})
+	req := httptest.NewRequest(http.MethodDelete, "/me", bytes.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := SetupEcho().NewContext(req, rec)
+	SetAuthContext(c, dataSet.User1.ID, dataSet.Tenant1.ID)
+
+	require.NoError(t, deps.UserController.DeleteMe(c))
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+
+	// Todo2 was User1's public todo and now belongs to the admin
+	handedOver, err := adminClient.Todo.Get(t.Context(), dataSet.Todo2.ID)
+	require.NoError(t, err)
+	assert.Equal(t, dataSet.User2.ID, handedOver.UserID)
+}
@@ -96,7 +96,7 @@ func TestRLS_FindByUserID(t *testing.T) {
 	t.Run("tenant1 context - find todos for user1", func(t *testing.T) {
 		ctx := database.WithTenantID(context.Background(), data.Tenant1.ID)
 
-		todos, err := repo.FindByUserID(ctx, data.User1.ID, 10, 0)
+		todos, err := repo.FindByUserID(ctx, data.User1.ID, nil, nil, &model.TodoPage{Limit: 10})
 		require.NoError(t, err)
 		assert.Len(t, todos, 2) // Todo1 and Todo2 belong to User1
 
@@ -109,7 +109,7 @@ func TestRLS_FindByUserID(t *testing.T) {
 	t.Run("tenant2 context - cannot find tenant1 user's todos", func(t *testing.T) {
 		ctx := database.WithTenantID(context.Background(), data.Tenant2.ID)
 
-		todos, err := repo.FindByUserID(ctx, data.User1.ID, 10, 0)
+		todos, err := repo.FindByUserID(ctx, data.User1.ID, nil, nil, &model.TodoPage{Limit: 10})
 		require.NoError(t, err)
 		assert.Len(t, todos, 0, "Should not find any todos for tenant1's user from tenant2 context")
 	})
@@ -127,7 +127,7 @@ func TestRLS_FindPublic(t *testing.T) {
 	t.Run("tenant1 context - find public todos only in tenant1", func(t *testing.T) {
 		ctx := database.WithTenantID(context.Background(), data.Tenant1.ID)
 
-		todos, err := repo.FindPublic(ctx, 10, 0)
+		todos, err := repo.FindPublic(ctx, nil, &model.TodoPage{Limit: 10})
 		require.NoError(t, err)
 		assert.Len(t, todos, 1) // Only Todo2 is public in tenant1
 
@@ -140,7 +140,7 @@ func TestRLS_FindPublic(t *testing.T) {
 	t.Run("tenant2 context - find public todos only in tenant2", func(t *testing.T) {
 		ctx := database.WithTenantID(context.Background(), data.Tenant2.ID)
 
-		todos, err := repo.FindPublic(ctx, 10, 0)
+		todos, err := repo.FindPublic(ctx, nil, &model.TodoPage{Limit: 10})
 		require.NoError(t, err)
 		assert.Len(t, todos, 1) // Only Todo5 is public in tenant2
 
@@ -194,7 +194,7 @@ func TestRLS_CountByUserID(t *testing.T) {
 	t.Run("tenant1 context - count todos for user1", func(t *testing.T) {
 		ctx := database.WithTenantID(context.Background(), data.Tenant1.ID)
 
-		count, err := repo.CountByUserID(ctx, data.User1.ID)
+		count, err := repo.CountByUserID(ctx, data.User1.ID, nil)
 		require.NoError(t, err)
 		assert.Equal(t, 2, count) // User1 has 2 todos
 	})
@@ -202,7 +202,7 @@ func TestRLS_CountByUserID(t *testing.T) {
 	t.Run("tenant2 context - count returns 0 for tenant1 user", func(t *testing.T) {
 		ctx := database.WithTenantID(context.Background(), data.Tenant2.ID)
 
-		count, err := repo.CountByUserID(ctx, data.User1.ID)
+		count, err := repo.CountByUserID(ctx, data.User1.ID, nil)
 		require.NoError(t, err)
 		assert.Equal(t, 0, count, "Should count 0 todos for tenant1's user from tenant2 context")
 	})
@@ -220,7 +220,7 @@ func TestRLS_CountPublic(t *testing.T) {
 	t.Run("tenant1 context - count public todos", func(t *testing.T) {
 		ctx := database.WithTenantID(context.Background(), data.Tenant1.ID)
 
-		count, err := repo.CountPublic(ctx)
+		count, err := repo.CountPublic(ctx, nil)
 		require.NoError(t, err)
 		assert.Equal(t, 1, count) // Only Todo2 is public in tenant1
 	})
@@ -228,7 +228,7 @@ func TestRLS_CountPublic(t *testing.T) {
 	t.Run("tenant2 context - count public todos", func(t *testing.T) {
 		ctx := database.WithTenantID(context.Background(), data.Tenant2.ID)
 
-		count, err := repo.CountPublic(ctx)
+		count, err := repo.CountPublic(ctx, nil)
 		require.NoError(t, err)
 		assert.Equal(t, 1, count) // Only Todo5 is public in tenant2
 	})
@@ -266,7 +266,7 @@ func TestRLS_DeleteCrossTenant(t *testing.T) {
 		// Context is tenant2, but trying to delete tenant1's todo
 		ctx := database.WithTenantID(context.Background(), data.Tenant2.ID)
 
-		err := repo.Delete(ctx, data.Todo1.ID)
+		err := repo.Delete(ctx, data.Todo1.ID, data.Todo1.Version)
 		// This should fail because RLS won't find the row to delete
 		assert.Error(t, err, "Should not be able to delete tenant1's todo from tenant2 context")
 
@@ -0,0 +1,17 @@
+package pkg
+
+import "context"
+
+type actorIDKey struct{}
+
+// WithActorID adds the ID of the signed-in user making the request to context
+func WithActorID(ctx context.Context, userID string) context.Context {
+	return context.WithValue(ctx, actorIDKey{}, userID)
+}
+
+// ActorIDFrom gets the signed-in user from context. It is empty outside of an
+// authenticated request, like in the scheduler.
+func ActorIDFrom(ctx context.Context) string {
+	userID, _ := ctx.Value(actorIDKey{}).(string)
+	return userID
+}
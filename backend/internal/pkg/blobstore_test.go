@@ -0,0 +1,108 @@
+package pkg
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeS3 is a minimal in-memory stand-in for an S3 bucket with path-style URLs
+type fakeS3 struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	types   map[string]string
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	auth := r.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=AKID/") ||
+		!strings.Contains(auth, "/us-east-1/s3/aws4_request") ||
+		!strings.Contains(auth, "SignedHeaders=") ||
+		r.Header.Get("X-Amz-Date") == "" {
+		http.Error(w, "AccessDenied", http.StatusForbidden)
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	switch r.Method {
+	case http.MethodPut:
+		body, _ := io.ReadAll(r.Body)
+		f.objects[r.URL.Path] = body
+		f.types[r.URL.Path] = r.Header.Get("Content-Type")
+	case http.MethodGet:
+		body, ok := f.objects[r.URL.Path]
+		if !ok {
+			http.Error(w, "NoSuchKey", http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(body)
+	case http.MethodDelete:
+		delete(f.objects, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func TestBlobStores(t *testing.T) {
+	t.Parallel()
+
+	fake := &fakeS3{objects: map[string][]byte{}, types: map[string]string{}}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	s3Store, err := NewS3BlobStore(server.URL, "us-east-1", "attachments", "AKID", "secret")
+	require.NoError(t, err)
+
+	stores := map[string]IBlobStore{
+		"local": NewLocalBlobStore(t.TempDir()),
+		"s3":    s3Store,
+	}
+
+	for name, store := range stores {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			content := []byte("%PDF-1.7 fake document")
+			require.NoError(t, store.Put(ctx, "tenant-1/blob-1", bytes.NewReader(content), int64(len(content)), "application/pdf"))
+
+			r, err := store.Get(ctx, "tenant-1/blob-1")
+			require.NoError(t, err)
+			got, err := io.ReadAll(r)
+			require.NoError(t, err)
+			require.NoError(t, r.Close())
+			assert.Equal(t, content, got)
+
+			require.NoError(t, store.Delete(ctx, "tenant-1/blob-1"))
+			_, err = store.Get(ctx, "tenant-1/blob-1")
+			assert.True(t, errors.Is(err, ErrBlobNotFound))
+
+			// Deleting again is fine
+			require.NoError(t, store.Delete(ctx, "tenant-1/blob-1"))
+
+			assert.Error(t, store.Put(ctx, "../escape", strings.NewReader("x"), 1, ""))
+		})
+	}
+
+	assert.Equal(t, "application/pdf", fake.types["/attachments/tenant-1/blob-1"])
+}
+
+func TestLocalBlobStore_RejectsShortBody(t *testing.T) {
+	t.Parallel()
+
+	store := NewLocalBlobStore(t.TempDir())
+
+	err := store.Put(context.Background(), "tenant-1/blob-1", strings.NewReader("abc"), 10, "")
+
+	require.Error(t, err)
+	_, err = store.Get(context.Background(), "tenant-1/blob-1")
+	assert.True(t, errors.Is(err, ErrBlobNotFound))
+}
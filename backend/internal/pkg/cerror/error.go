@@ -35,6 +35,8 @@ const (
 	ErrCodeForbidden           ErrorCode = "FORBIDDEN"
 	ErrCodeNotFound            ErrorCode = "NOT_FOUND"
 	ErrCodeConflict            ErrorCode = "CONFLICT"
+	ErrCodePreconditionFailed  ErrorCode = "PRECONDITION_FAILED"
+	ErrCodePayloadTooLarge     ErrorCode = "PAYLOAD_TOO_LARGE"
 	ErrCodeInternalServerError ErrorCode = "INTERNAL_SERVER_ERROR"
 	ErrCodeValidationError     ErrorCode = "VALIDATION_ERROR"
 )
@@ -84,6 +86,24 @@ func NewConflict(message string, err error) *AppError {
 	}
 }
 
+func NewPreconditionFailed(message string, err error) *AppError {
+	return &AppError{
+		Code:       ErrCodePreconditionFailed,
+		Message:    message,
+		HTTPStatus: http.StatusPreconditionFailed,
+		Err:        err,
+	}
+}
+
+func NewPayloadTooLarge(message string, err error) *AppError {
+	return &AppError{
+		Code:       ErrCodePayloadTooLarge,
+		Message:    message,
+		HTTPStatus: http.StatusRequestEntityTooLarge,
+		Err:        err,
+	}
+}
+
 func NewInternalServerError(message string, err error) *AppError {
 	return &AppError{
 		Code:       ErrCodeInternalServerError,
@@ -0,0 +1,125 @@
+// Package fracindex generates fractional index keys: strings that sort in the
+// order of the items they label, so an item can be moved between two others
+// by giving it a key that sorts between theirs, without touching any other
+// item.
+//
+// Keys use the digits 0-9A-Za-z and compare byte by byte, so a database
+// column holding them needs the "C" collation. A key never ends in '0'; that
+// keeps room for another key between any two different keys.
+package fracindex
+
+import (
+	"errors"
+	"strings"
+)
+
+const digits = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+const base = len(digits)
+
+var (
+	ErrInvalidKey = errors.New("fracindex: invalid key")
+	// ErrOutOfOrder is returned when the lower key does not sort before the upper one
+	ErrOutOfOrder = errors.New("fracindex: keys are not in order")
+)
+
+// Between returns a key that sorts strictly between a and b. An empty a
+// stands for the start of the list and an empty b for its end.
+func Between(a, b string) (string, error) {
+	if a != "" && !Valid(a) || b != "" && !Valid(b) {
+		return "", ErrInvalidKey
+	}
+	if a != "" && b != "" && a >= b {
+		return "", ErrOutOfOrder
+	}
+	return midpoint(a, b), nil
+}
+
+// Spread returns n evenly spaced keys in ascending order, leaving room before,
+// between and after them. It is used to rebalance keys that have grown long.
+func Spread(n int) []string {
+	// The gap between two keys must be at least one full digit, so that each
+	// key keeps a distinct prefix once trailing zeros are cut off
+	width, span := 1, base
+	for span/base < n+1 {
+		width++
+		span *= base
+	}
+	step := span / (n + 1)
+
+	keys := make([]string, n)
+	for i := range keys {
+		keys[i] = strings.TrimRight(encode(step*(i+1), width), "0")
+	}
+	return keys
+}
+
+// Valid reports whether key is a well-formed key
+func Valid(key string) bool {
+	if key == "" || key[len(key)-1] == '0' {
+		return false
+	}
+	for i := 0; i < len(key); i++ {
+		if strings.IndexByte(digits, key[i]) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// midpoint finds a key between a and b, which are valid and in order; b may
+// be empty for no upper bound. Missing trailing digits of a count as zeros.
+func midpoint(a, b string) string {
+	if b != "" {
+		n := 0
+		for n < len(b) && digitAt(a, n) == b[n] {
+			n++
+		}
+		if n > 0 {
+			return b[:n] + midpoint(suffix(a, n), b[n:])
+		}
+	}
+
+	lo := 0
+	if a != "" {
+		lo = strings.IndexByte(digits, a[0])
+	}
+	hi := base
+	if b != "" {
+		hi = strings.IndexByte(digits, b[0])
+	}
+	if hi-lo > 1 {
+		return string(digits[(lo+hi)/2])
+	}
+
+	// The first digits are adjacent. A longer b sorts after its own first
+	// digit; otherwise the key continues after a's first digit.
+	if len(b) > 1 {
+		return b[:1]
+	}
+	return string(digits[lo]) + midpoint(suffix(a, 1), "")
+}
+
+func digitAt(key string, i int) byte {
+	if i < len(key) {
+		return key[i]
+	}
+	return digits[0]
+}
+
+func suffix(key string, n int) string {
+	if n >= len(key) {
+		return ""
+	}
+	return key[n:]
+}
+
+// encode writes v in base 62, padded with zeros to width digits
+func encode(v, width int) string {
+	buf := make([]byte, width)
+	for i := width - 1; i >= 0; i-- {
+		buf[i] = digits[v%base]
+		v /= base
+	}
+	return string(buf)
+}
@@ -0,0 +1,105 @@
+package fracindex
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBetween(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		a       string
+		b       string
+		want    string
+		wantErr error
+	}{
+		{name: "success - empty list", want: "V"},
+		{name: "success - before the first", b: "V", want: "F"},
+		{name: "success - after the last", a: "V", want: "k"},
+		{name: "success - after the largest digit", a: "z", want: "zV"},
+		{name: "success - between distant keys", a: "A", b: "a", want: "N"},
+		{name: "success - between adjacent digits", a: "A", b: "B", want: "AV"},
+		{name: "success - shared prefix", a: "AB", b: "AC", want: "ABV"},
+		{name: "success - upper key is longer", a: "A", b: "B5", want: "B"},
+		{name: "success - lower key is a prefix", a: "A", b: "A1", want: "A0V"},
+		{name: "fail - out of order", a: "b", b: "a", wantErr: ErrOutOfOrder},
+		{name: "fail - equal keys", a: "a", b: "a", wantErr: ErrOutOfOrder},
+		{name: "fail - trailing zero", a: "a0", wantErr: ErrInvalidKey},
+		{name: "fail - bad digit", b: "a-b", wantErr: ErrInvalidKey},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Between(tt.a, tt.b)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestBetween_RandomInsertsStayOrdered(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewSource(1))
+	keys := []string{}
+	for i := 0; i < 2000; i++ {
+		at := rng.Intn(len(keys) + 1)
+		var lo, hi string
+		if at > 0 {
+			lo = keys[at-1]
+		}
+		if at < len(keys) {
+			hi = keys[at]
+		}
+
+		key, err := Between(lo, hi)
+		require.NoError(t, err)
+		require.True(t, Valid(key), key)
+		if lo != "" {
+			require.Less(t, lo, key)
+		}
+		if hi != "" {
+			require.Less(t, key, hi)
+		}
+
+		keys = append(keys, "")
+		copy(keys[at+1:], keys[at:])
+		keys[at] = key
+	}
+	assert.True(t, sort.StringsAreSorted(keys))
+}
+
+func TestSpread(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{0, 1, 2, 61, 62, 100, 5000} {
+		keys := Spread(n)
+		require.Len(t, keys, n)
+		for i, key := range keys {
+			require.True(t, Valid(key), key)
+			if i > 0 {
+				require.Less(t, keys[i-1], key)
+			}
+		}
+		if n > 0 {
+			// There is room at both ends
+			_, err := Between("", keys[0])
+			require.NoError(t, err)
+			_, err = Between(keys[n-1], "")
+			require.NoError(t, err)
+		}
+	}
+	assert.Equal(t, []string{"V"}, Spread(1))
+}
@@ -0,0 +1,217 @@
+// Package ical writes iCalendar (RFC 5545) streams. It only covers what the
+// todo calendar needs: components, text and date-time properties, time zone
+// definitions, and line folding.
+package ical
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// ContentType is the media type of an iCalendar stream
+const ContentType = "text/calendar; charset=utf-8"
+
+// Components written by the todo calendar
+const (
+	Calendar = "VCALENDAR"
+	Event    = "VEVENT"
+	Todo     = "VTODO"
+	TimeZone = "VTIMEZONE"
+	// Standard and Daylight are the observances of a VTIMEZONE
+	Standard = "STANDARD"
+	Daylight = "DAYLIGHT"
+)
+
+// maxLineLength is how many octets a content line may have before it is
+// folded, not counting the line break
+const maxLineLength = 75
+
+// dateTimeFormat is a DATE-TIME in UTC ("form #2" of RFC 5545 section 3.3.5)
+const dateTimeFormat = "20060102T150405Z"
+
+// localDateTimeFormat is a DATE-TIME on the wall clock of a time zone given
+// by a TZID parameter ("form #3")
+const localDateTimeFormat = "20060102T150405"
+
+// timeZoneLookback is how far before the first time in a zone its
+// definition starts, so that the observance in effect then is included
+const timeZoneLookback = 366 * 24 * time.Hour
+
+var textEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	";", `\;`,
+	",", `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+	"\r", `\n`,
+)
+
+// Writer builds an iCalendar stream line by line. Components must be
+// balanced by the caller.
+type Writer struct {
+	b strings.Builder
+}
+
+func NewWriter() *Writer {
+	return &Writer{}
+}
+
+// Begin opens a component such as VCALENDAR or VEVENT
+func (w *Writer) Begin(component string) {
+	w.line("BEGIN:" + component)
+}
+
+// End closes a component opened with Begin
+func (w *Writer) End(component string) {
+	w.line("END:" + component)
+}
+
+// Property writes a value that is already in iCalendar form, such as a
+// number or one of the enumerated values of STATUS
+func (w *Writer) Property(name, value string) {
+	w.line(name + ":" + value)
+}
+
+// Text writes a TEXT value, escaping the characters that have a meaning in
+// iCalendar and dropping control characters that may not appear in it
+func (w *Writer) Text(name, value string) {
+	w.line(name + ":" + EscapeText(value))
+}
+
+// Time writes a DATE-TIME value in UTC, which calendars show in the time
+// zone of whoever looks at them
+func (w *Writer) Time(name string, t time.Time) {
+	w.line(name + ":" + FormatTime(t))
+}
+
+// ZonedTime writes a DATE-TIME on the wall clock of t's location, so that
+// calendars keep it there across daylight saving changes. The location has to
+// be defined in the stream with DefineTimeZone. Times in UTC are written like
+// Time.
+func (w *Writer) ZonedTime(name string, t time.Time) {
+	if isUTC(t.Location()) {
+		w.Time(name, t)
+		return
+	}
+	w.line(name + ";TZID=" + t.Location().String() + ":" + t.Format(localDateTimeFormat))
+}
+
+// DefineTimeZone writes a VTIMEZONE for loc that is valid for the times
+// between from and to. The observances are the offset in effect before from
+// and every transition after it, found by looking at the location day by day.
+func (w *Writer) DefineTimeZone(loc *time.Location, from, to time.Time) {
+	start := from.Add(-timeZoneLookback).In(loc)
+
+	w.Begin(TimeZone)
+	w.Property("TZID", loc.String())
+	w.observance("19700101T000000", start, start)
+	prev := start
+	for day := start.AddDate(0, 0, 1); !prev.After(to); day = day.AddDate(0, 0, 1) {
+		if sameZone(prev, day) {
+			prev = day
+			continue
+		}
+		// Narrow the change down to the second it happens
+		lo, hi := prev, day
+		for hi.Sub(lo) > time.Second {
+			mid := lo.Add(hi.Sub(lo) / 2)
+			if sameZone(lo, mid) {
+				lo = mid
+			} else {
+				hi = mid
+			}
+		}
+		// The onset is given on the clock in effect until then
+		_, offset := lo.Zone()
+		w.observance(hi.In(time.FixedZone("", offset)).Format(localDateTimeFormat), lo, hi)
+		prev = day
+	}
+	w.End(TimeZone)
+}
+
+// observance writes the onset of the offset in effect at after, coming from
+// the one in effect at before
+func (w *Writer) observance(onset string, before, after time.Time) {
+	name, offsetTo := after.Zone()
+	_, offsetFrom := before.Zone()
+
+	component := Standard
+	if after.IsDST() {
+		component = Daylight
+	}
+	w.Begin(component)
+	w.Property("DTSTART", onset)
+	w.Property("TZOFFSETFROM", formatUTCOffset(offsetFrom))
+	w.Property("TZOFFSETTO", formatUTCOffset(offsetTo))
+	w.Text("TZNAME", name)
+	w.End(component)
+}
+
+// String returns the stream written so far
+func (w *Writer) String() string {
+	return w.b.String()
+}
+
+// line writes a content line, folded so that no line is longer than
+// maxLineLength octets. Continuation lines start with a space, and folds never
+// split a UTF-8 sequence.
+func (w *Writer) line(s string) {
+	limit := maxLineLength
+	for len(s) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(s[cut]) {
+			cut--
+		}
+		w.b.WriteString(s[:cut])
+		w.b.WriteString("\r\n ")
+		s = s[cut:]
+		// The leading space counts towards the length of continuation lines
+		limit = maxLineLength - 1
+	}
+	w.b.WriteString(s)
+	w.b.WriteString("\r\n")
+}
+
+// EscapeText escapes a TEXT value (RFC 5545 section 3.3.11)
+func EscapeText(s string) string {
+	s = strings.Map(func(r rune) rune {
+		if r < 0x20 && r != '\t' && r != '\n' && r != '\r' || r == 0x7f {
+			return -1
+		}
+		return r
+	}, s)
+	return textEscaper.Replace(s)
+}
+
+// formatUTCOffset formats an offset in seconds east of UTC as a UTC-OFFSET
+// (RFC 5545 section 3.3.14); seconds are only written when there are any
+func formatUTCOffset(offset int) string {
+	sign := "+"
+	if offset < 0 {
+		sign = "-"
+		offset = -offset
+	}
+	s := fmt.Sprintf("%s%02d%02d", sign, offset/3600, offset/60%60)
+	if offset%60 != 0 {
+		s += fmt.Sprintf("%02d", offset%60)
+	}
+	return s
+}
+
+// sameZone reports whether a and b are in the same observance of their location
+func sameZone(a, b time.Time) bool {
+	aName, aOffset := a.Zone()
+	bName, bOffset := b.Zone()
+	return aName == bName && aOffset == bOffset && a.IsDST() == b.IsDST()
+}
+
+func isUTC(loc *time.Location) bool {
+	return loc == time.UTC || loc.String() == "UTC"
+}
+
+// FormatTime formats t as a DATE-TIME in UTC
+func FormatTime(t time.Time) string {
+	return t.UTC().Format(dateTimeFormat)
+}
@@ -0,0 +1,139 @@
+package ical
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEscapeText(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{name: "plain", in: "Buy milk", want: "Buy milk"},
+		{name: "separators", in: `a;b,c\d`, want: `a\;b\,c\\d`},
+		{name: "line breaks", in: "one\r\ntwo\nthree", want: `one\ntwo\nthree`},
+		{name: "control characters", in: "a\x02b\x03c\td", want: "abc\td"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, EscapeText(tt.in))
+		})
+	}
+}
+
+func TestWriter(t *testing.T) {
+	t.Parallel()
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w := NewWriter()
+	w.Begin(Event)
+	w.Property("SEQUENCE", "2")
+	w.Time("DTSTART", time.Date(2026, 1, 5, 9, 30, 0, 0, berlin))
+	w.Text("SUMMARY", "Plan; review, ship")
+	w.End(Event)
+
+	assert.Equal(t, "BEGIN:VEVENT\r\n"+
+		"SEQUENCE:2\r\n"+
+		"DTSTART:20260105T083000Z\r\n"+
+		`SUMMARY:Plan\; review\, ship`+"\r\n"+
+		"END:VEVENT\r\n", w.String())
+}
+
+func TestWriter_Folding(t *testing.T) {
+	t.Parallel()
+
+	w := NewWriter()
+	w.Text("DESCRIPTION", strings.Repeat("ä", 100))
+
+	out := w.String()
+	lines := strings.Split(strings.TrimSuffix(out, "\r\n"), "\r\n")
+	assert.Greater(t, len(lines), 1)
+	for i, line := range lines {
+		assert.LessOrEqual(t, len(line), maxLineLength)
+		if i > 0 {
+			assert.True(t, strings.HasPrefix(line, " "))
+		}
+	}
+
+	// Unfolding restores the line without splitting any character
+	unfolded := strings.ReplaceAll(strings.TrimSuffix(out, "\r\n"), "\r\n ", "")
+	assert.Equal(t, "DESCRIPTION:"+strings.Repeat("ä", 100), unfolded)
+}
+
+func TestWriter_ZonedTime(t *testing.T) {
+	t.Parallel()
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w := NewWriter()
+	w.ZonedTime("DUE", time.Date(2026, 7, 1, 9, 30, 0, 0, berlin))
+	w.ZonedTime("DUE", time.Date(2026, 7, 1, 9, 30, 0, 0, time.UTC))
+
+	assert.Equal(t, "DUE;TZID=Europe/Berlin:20260701T093000\r\n"+
+		"DUE:20260701T093000Z\r\n", w.String())
+}
+
+func TestWriter_DefineTimeZone(t *testing.T) {
+	t.Parallel()
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w := NewWriter()
+	w.DefineTimeZone(berlin, time.Date(2026, 5, 1, 0, 0, 0, 0, berlin), time.Date(2026, 11, 1, 0, 0, 0, 0, berlin))
+
+	// The offset in effect a year before, then each change up to the last time
+	assert.Equal(t, "BEGIN:VTIMEZONE\r\n"+
+		"TZID:Europe/Berlin\r\n"+
+		"BEGIN:DAYLIGHT\r\n"+
+		"DTSTART:19700101T000000\r\n"+
+		"TZOFFSETFROM:+0200\r\n"+
+		"TZOFFSETTO:+0200\r\n"+
+		"TZNAME:CEST\r\n"+
+		"END:DAYLIGHT\r\n"+
+		"BEGIN:STANDARD\r\n"+
+		"DTSTART:20251026T030000\r\n"+
+		"TZOFFSETFROM:+0200\r\n"+
+		"TZOFFSETTO:+0100\r\n"+
+		"TZNAME:CET\r\n"+
+		"END:STANDARD\r\n"+
+		"BEGIN:DAYLIGHT\r\n"+
+		"DTSTART:20260329T020000\r\n"+
+		"TZOFFSETFROM:+0100\r\n"+
+		"TZOFFSETTO:+0200\r\n"+
+		"TZNAME:CEST\r\n"+
+		"END:DAYLIGHT\r\n"+
+		"BEGIN:STANDARD\r\n"+
+		"DTSTART:20261025T030000\r\n"+
+		"TZOFFSETFROM:+0200\r\n"+
+		"TZOFFSETTO:+0100\r\n"+
+		"TZNAME:CET\r\n"+
+		"END:STANDARD\r\n"+
+		"END:VTIMEZONE\r\n", w.String())
+}
+
+func TestFormatUTCOffset(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "+0530", formatUTCOffset(5*3600+30*60))
+	assert.Equal(t, "-0330", formatUTCOffset(-(3*3600 + 30*60)))
+	assert.Equal(t, "+001730", formatUTCOffset(17*60+30))
+}
@@ -0,0 +1,37 @@
+package pkg
+
+import (
+	"net/url"
+	"strings"
+)
+
+// LinkBuilder builds links into the frontend that are sent to users by email
+type LinkBuilder struct {
+	baseURL string
+}
+
+func NewLinkBuilder(baseURL string) *LinkBuilder {
+	return &LinkBuilder{baseURL: strings.TrimRight(baseURL, "/")}
+}
+
+// MagicLink returns the frontend page that consumes a magic-link token
+func (b *LinkBuilder) MagicLink(tenantSlug, token string) string {
+	q := url.Values{}
+	q.Set("tenant", tenantSlug)
+	q.Set("token", token)
+	return b.baseURL + "/auth/magic-link?" + q.Encode()
+}
+
+// PasswordReset returns the frontend page where a user sets a new password
+// with a reset token
+func (b *LinkBuilder) PasswordReset(tenantSlug, token string) string {
+	q := url.Values{}
+	q.Set("tenant", tenantSlug)
+	q.Set("token", token)
+	return b.baseURL + "/auth/reset-password?" + q.Encode()
+}
+
+// Todos returns the frontend page listing the user's todos
+func (b *LinkBuilder) Todos() string {
+	return b.baseURL + "/todos"
+}
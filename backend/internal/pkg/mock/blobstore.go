@@ -0,0 +1,85 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: blobstore.go
+//
+// Generated by this command:
+//
+//	mockgen -source=blobstore.go -destination=mock/blobstore.go -package=mock_pkg
+//
+
+// Package mock_pkg is a generated GoMock package.
+package mock_pkg
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIBlobStore is a mock of IBlobStore interface.
+type MockIBlobStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockIBlobStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockIBlobStoreMockRecorder is the mock recorder for MockIBlobStore.
+type MockIBlobStoreMockRecorder struct {
+	mock *MockIBlobStore
+}
+
+// NewMockIBlobStore creates a new mock instance.
+func NewMockIBlobStore(ctrl *gomock.Controller) *MockIBlobStore {
+	mock := &MockIBlobStore{ctrl: ctrl}
+	mock.recorder = &MockIBlobStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIBlobStore) EXPECT() *MockIBlobStoreMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockIBlobStore) Delete(ctx context.Context, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockIBlobStoreMockRecorder) Delete(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIBlobStore)(nil).Delete), ctx, key)
+}
+
+// Get mocks base method.
+func (m *MockIBlobStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, key)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockIBlobStoreMockRecorder) Get(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockIBlobStore)(nil).Get), ctx, key)
+}
+
+// Put mocks base method.
+func (m *MockIBlobStore) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Put", ctx, key, r, size, contentType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Put indicates an expected call of Put.
+func (mr *MockIBlobStoreMockRecorder) Put(ctx, key, r, size, contentType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockIBlobStore)(nil).Put), ctx, key, r, size, contentType)
+}
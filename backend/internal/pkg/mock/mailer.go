@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: mailer.go
+//
+// Generated by this command:
+//
+//	mockgen -source=mailer.go -destination=mock/mailer.go -package=mock_pkg
+//
+
+// Package mock_pkg is a generated GoMock package.
+package mock_pkg
+
+import (
+	context "context"
+	pkg "good-todo-go/internal/pkg"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIMailer is a mock of IMailer interface.
+type MockIMailer struct {
+	ctrl     *gomock.Controller
+	recorder *MockIMailerMockRecorder
+	isgomock struct{}
+}
+
+// MockIMailerMockRecorder is the mock recorder for MockIMailer.
+type MockIMailerMockRecorder struct {
+	mock *MockIMailer
+}
+
+// NewMockIMailer creates a new mock instance.
+func NewMockIMailer(ctrl *gomock.Controller) *MockIMailer {
+	mock := &MockIMailer{ctrl: ctrl}
+	mock.recorder = &MockIMailerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIMailer) EXPECT() *MockIMailerMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method.
+func (m *MockIMailer) Send(ctx context.Context, mail *pkg.Mail) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, mail)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockIMailerMockRecorder) Send(ctx, mail any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockIMailer)(nil).Send), ctx, mail)
+}
@@ -0,0 +1,109 @@
+package passwordpolicy
+
+import (
+	"bufio"
+	"crypto/sha1"
+	_ "embed"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+)
+
+// hashPrefixLength is the number of hex characters used as the range key,
+// the same split the k-anonymity range API uses.
+const hashPrefixLength = 5
+
+//go:embed data/breached_sha1.txt
+var embeddedBreachedList string
+
+// BreachedList is an offline set of breached password hashes.
+// Hashes are grouped by their 5 character prefix so a lookup only ever
+// touches one range, and the plaintext password never leaves the process.
+type BreachedList struct {
+	ranges map[string]map[string]struct{}
+}
+
+var (
+	defaultBreachedList     *BreachedList
+	defaultBreachedListOnce sync.Once
+)
+
+// DefaultBreachedList returns the list bundled with the binary
+func DefaultBreachedList() *BreachedList {
+	defaultBreachedListOnce.Do(func() {
+		list, err := LoadBreachedList(strings.NewReader(embeddedBreachedList))
+		if err != nil {
+			// The embedded list is validated by tests, so this is a build problem
+			panic("passwordpolicy: invalid embedded breached password list: " + err.Error())
+		}
+		defaultBreachedList = list
+	})
+	return defaultBreachedList
+}
+
+// LoadBreachedList reads one uppercase or lowercase SHA-1 hex hash per line.
+// Blank lines and lines starting with '#' are ignored. A trailing ":count"
+// (as found in downloaded range dumps) is accepted and discarded.
+func LoadBreachedList(r io.Reader) (*BreachedList, error) {
+	list := &BreachedList{ranges: make(map[string]map[string]struct{})}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if idx := strings.IndexByte(line, ':'); idx >= 0 {
+			line = line[:idx]
+		}
+		line = strings.ToUpper(line)
+		if len(line) != sha1.Size*2 {
+			return nil, fmt.Errorf("invalid SHA-1 hash: %q", line)
+		}
+		if _, err := hex.DecodeString(line); err != nil {
+			return nil, fmt.Errorf("invalid SHA-1 hash: %q", line)
+		}
+		list.add(line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (l *BreachedList) add(hash string) {
+	prefix, suffix := hash[:hashPrefixLength], hash[hashPrefixLength:]
+	suffixes, ok := l.ranges[prefix]
+	if !ok {
+		suffixes = make(map[string]struct{})
+		l.ranges[prefix] = suffixes
+	}
+	suffixes[suffix] = struct{}{}
+}
+
+// Contains reports whether the password appears in the list
+func (l *BreachedList) Contains(password string) bool {
+	if l == nil {
+		return false
+	}
+	sum := sha1.Sum([]byte(password))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+
+	suffixes, ok := l.ranges[hash[:hashPrefixLength]]
+	if !ok {
+		return false
+	}
+	_, found := suffixes[hash[hashPrefixLength:]]
+	return found
+}
+
+// Len returns the number of hashes in the list
+func (l *BreachedList) Len() int {
+	n := 0
+	for _, suffixes := range l.ranges {
+		n += len(suffixes)
+	}
+	return n
+}
@@ -0,0 +1,95 @@
+package passwordpolicy
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// MaxLength is the longest password bcrypt can hash without truncation
+const MaxLength = 72
+
+type Violation string
+
+const (
+	ViolationTooShort          Violation = "too_short"
+	ViolationTooLong           Violation = "too_long"
+	ViolationTooWeak           Violation = "too_weak"
+	ViolationSimilarToUserInfo Violation = "similar_to_user_info"
+	ViolationBreached          Violation = "breached"
+)
+
+// minSimilarTokenLength avoids flagging passwords that merely contain
+// a short fragment of the user's name (e.g. "al" in "alpine")
+const minSimilarTokenLength = 4
+
+// Policy validates passwords for registration and password changes
+type Policy struct {
+	MinLength int
+	MinScore  int
+	breached  *BreachedList
+}
+
+// NewPolicy creates a policy backed by the bundled breached password list
+func NewPolicy(minLength, minScore int) *Policy {
+	return &Policy{
+		MinLength: minLength,
+		MinScore:  minScore,
+		breached:  DefaultBreachedList(),
+	}
+}
+
+// Result holds the outcome of a policy check
+type Result struct {
+	Score      int
+	Violations []Violation
+}
+
+// OK reports whether the password satisfies the policy
+func (r *Result) OK() bool {
+	return len(r.Violations) == 0
+}
+
+// Check validates the password. userInputs are the user's email, name and
+// tenant slug, which must not be the basis of the password.
+func (p *Policy) Check(password string, userInputs ...string) *Result {
+	result := &Result{}
+
+	length := utf8.RuneCountInString(password)
+	if length < p.MinLength {
+		result.Violations = append(result.Violations, ViolationTooShort)
+	}
+	if len(password) > MaxLength {
+		result.Violations = append(result.Violations, ViolationTooLong)
+	}
+
+	strength := EstimateStrength(password, userInputs...)
+	result.Score = strength.Score
+	if strength.Score < p.MinScore {
+		result.Violations = append(result.Violations, ViolationTooWeak)
+	}
+
+	if similarToUserInfo(password, userInputs) {
+		result.Violations = append(result.Violations, ViolationSimilarToUserInfo)
+	}
+
+	if p.breached.Contains(password) {
+		result.Violations = append(result.Violations, ViolationBreached)
+	}
+
+	return result
+}
+
+func similarToUserInfo(password string, userInputs []string) bool {
+	lower := strings.ToLower(password)
+	for _, in := range userInputs {
+		for _, token := range userInfoTokens(in) {
+			if utf8.RuneCountInString(token) < minSimilarTokenLength {
+				continue
+			}
+			if strings.Contains(lower, token) || strings.Contains(token, lower) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,129 @@
+package passwordpolicy
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicy_Check(t *testing.T) {
+	t.Parallel()
+
+	policy := NewPolicy(8, 3)
+
+	tests := []struct {
+		name           string
+		password       string
+		userInputs     []string
+		wantOK         bool
+		wantViolations []Violation
+	}{
+		{
+			name:     "success - long random passphrase",
+			password: "velvet-Orbit-canyon-93",
+			wantOK:   true,
+		},
+		{
+			name:           "fail - too short",
+			password:       "x7#Qp",
+			wantViolations: []Violation{ViolationTooShort},
+		},
+		{
+			name:           "fail - too long for bcrypt",
+			password:       strings.Repeat("velvet-orbit-", 6),
+			wantViolations: []Violation{ViolationTooLong},
+		},
+		{
+			name:           "fail - common password",
+			password:       "password123",
+			wantViolations: []Violation{ViolationTooWeak, ViolationBreached},
+		},
+		{
+			name:           "fail - l33t common password",
+			password:       "P@ssw0rd",
+			wantViolations: []Violation{ViolationTooWeak},
+		},
+		{
+			name:           "fail - keyboard walk",
+			password:       "qwertyuiopasdf",
+			wantViolations: []Violation{ViolationTooWeak},
+		},
+		{
+			name:           "fail - based on email",
+			password:       "kazuki.tanaka2024",
+			userInputs:     []string{"kazuki.tanaka@example.com", "Kazuki Tanaka", "acme"},
+			wantViolations: []Violation{ViolationSimilarToUserInfo},
+		},
+		{
+			name:           "fail - based on tenant slug",
+			password:       "AcmeCorp-velvet-1",
+			userInputs:     []string{"someone@example.com", "Someone", "acmecorp"},
+			wantViolations: []Violation{ViolationSimilarToUserInfo},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			result := policy.Check(tt.password, tt.userInputs...)
+
+			if tt.wantOK {
+				assert.True(t, result.OK(), "violations: %v", result.Violations)
+				return
+			}
+			assert.False(t, result.OK())
+			for _, v := range tt.wantViolations {
+				assert.Contains(t, result.Violations, v)
+			}
+		})
+	}
+}
+
+func TestEstimateStrength(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		password string
+		maxScore int
+		minScore int
+	}{
+		{password: "password", maxScore: 0},
+		{password: "123456789", maxScore: 0},
+		{password: "abcdefgh", maxScore: 1},
+		{password: "aaaaaaaaaaaa", maxScore: 1},
+		{password: "iloveyou2024", maxScore: 2},
+		{password: "Tr0ub4dor&3", minScore: 3, maxScore: 4},
+		{password: "velvet-Orbit-canyon-93", minScore: 4, maxScore: 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.password, func(t *testing.T) {
+			t.Parallel()
+
+			got := EstimateStrength(tt.password)
+			assert.GreaterOrEqual(t, got.Score, tt.minScore)
+			assert.LessOrEqual(t, got.Score, tt.maxScore)
+		})
+	}
+}
+
+func TestBreachedList(t *testing.T) {
+	t.Parallel()
+
+	list := DefaultBreachedList()
+	require.NotZero(t, list.Len())
+
+	assert.True(t, list.Contains("password123"))
+	assert.True(t, list.Contains("Welcome1"))
+	assert.False(t, list.Contains("velvet-Orbit-canyon-93"))
+
+	_, err := LoadBreachedList(strings.NewReader("not-a-hash\n"))
+	assert.Error(t, err)
+
+	custom, err := LoadBreachedList(strings.NewReader("# comment\n5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD8:42\n"))
+	require.NoError(t, err)
+	assert.True(t, custom.Contains("password"))
+}
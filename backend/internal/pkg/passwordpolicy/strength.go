@@ -0,0 +1,479 @@
+package passwordpolicy
+
+import (
+	"bufio"
+	_ "embed"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// The estimator follows the approach of zxcvbn: find every pattern a guessing
+// attacker would try first (dictionary words, l33t and reversed words,
+// keyboard walks, sequences, repeats and years), then pick the segmentation
+// of the password that needs the fewest guesses. The score is derived from
+// the order of magnitude of that guess count.
+
+//go:embed data/common_passwords.txt
+var embeddedCommonPasswords string
+
+const (
+	// minGuessesBeforeGrowingSequence penalises splitting a password into
+	// many patterns, since the attacker also has to guess the structure.
+	minGuessesBeforeGrowingSequence = 10000
+	bruteforceCardinality           = 10
+	referenceYear                   = 2025
+	minYearSpace                    = 20
+	keyboardStartingPositions       = 47
+	keyboardAverageDegree           = 4.6
+)
+
+var (
+	commonPasswordRanks     map[string]int
+	commonPasswordRanksOnce sync.Once
+)
+
+func rankedCommonPasswords() map[string]int {
+	commonPasswordRanksOnce.Do(func() {
+		commonPasswordRanks = make(map[string]int)
+		scanner := bufio.NewScanner(strings.NewReader(embeddedCommonPasswords))
+		rank := 1
+		for scanner.Scan() {
+			word := strings.TrimSpace(scanner.Text())
+			if word == "" || strings.HasPrefix(word, "#") {
+				continue
+			}
+			if _, ok := commonPasswordRanks[word]; !ok {
+				commonPasswordRanks[word] = rank
+				rank++
+			}
+		}
+	})
+	return commonPasswordRanks
+}
+
+var l33tTable = map[rune][]rune{
+	'4': {'a'},
+	'@': {'a'},
+	'8': {'b'},
+	'(': {'c'},
+	'3': {'e'},
+	'6': {'g'},
+	'1': {'i', 'l'},
+	'!': {'i'},
+	'|': {'i', 'l'},
+	'0': {'o'},
+	'$': {'s'},
+	'5': {'s'},
+	'7': {'t'},
+	'+': {'t'},
+	'2': {'z'},
+}
+
+var keyboardRows = []string{
+	"`1234567890-=",
+	"qwertyuiop[]\\",
+	"asdfghjkl;'",
+	"zxcvbnm,./",
+}
+
+// match is a pattern covering password[i:j+1]
+type match struct {
+	i, j    int
+	guesses float64
+}
+
+// Strength is the result of estimating how guessable a password is
+type Strength struct {
+	// Score is 0 (too guessable) to 4 (very unguessable)
+	Score int
+	// Guesses is the estimated number of guesses needed to crack the password
+	Guesses float64
+}
+
+// EstimateStrength estimates password strength. userInputs are extra words
+// (email, name, tenant slug, ...) that an attacker targeting this user would try first.
+func EstimateStrength(password string, userInputs ...string) Strength {
+	runes := []rune(password)
+	if len(runes) == 0 {
+		return Strength{Score: 0, Guesses: 1}
+	}
+
+	dictionaries := []map[string]int{rankedCommonPasswords(), userInputDictionary(userInputs)}
+
+	var matches []match
+	matches = append(matches, dictionaryMatches(runes, dictionaries)...)
+	matches = append(matches, sequenceMatches(runes)...)
+	matches = append(matches, repeatMatches(runes)...)
+	matches = append(matches, keyboardMatches(runes)...)
+	matches = append(matches, yearMatches(runes)...)
+
+	guesses := mostGuessableMatchSequence(len(runes), matches)
+	return Strength{Score: guessesToScore(guesses), Guesses: guesses}
+}
+
+func guessesToScore(guesses float64) int {
+	const delta = 5
+	switch {
+	case guesses < 1e3+delta:
+		return 0
+	case guesses < 1e6+delta:
+		return 1
+	case guesses < 1e8+delta:
+		return 2
+	case guesses < 1e10+delta:
+		return 3
+	default:
+		return 4
+	}
+}
+
+func userInputDictionary(userInputs []string) map[string]int {
+	dict := make(map[string]int)
+	rank := 1
+	for _, in := range userInputs {
+		for _, token := range userInfoTokens(in) {
+			if _, ok := dict[token]; !ok {
+				dict[token] = rank
+				rank++
+			}
+		}
+	}
+	return dict
+}
+
+// userInfoTokens splits a piece of user info (an email, a name, a slug) into
+// lowercase tokens, keeping the whole value as well as its parts.
+func userInfoTokens(value string) []string {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if value == "" {
+		return nil
+	}
+	tokens := []string{value}
+	if at := strings.IndexByte(value, '@'); at > 0 {
+		tokens = append(tokens, value[:at])
+	}
+	parts := strings.FieldsFunc(value, func(r rune) bool {
+		return strings.ContainsRune(" @.-_+", r)
+	})
+	for _, p := range parts {
+		if p != value {
+			tokens = append(tokens, p)
+		}
+	}
+	return tokens
+}
+
+func dictionaryMatches(runes []rune, dictionaries []map[string]int) []match {
+	var matches []match
+	lower := []rune(strings.ToLower(string(runes)))
+	n := len(runes)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			original := runes[i : j+1]
+			word := string(lower[i : j+1])
+			for _, dict := range dictionaries {
+				if rank, ok := dict[word]; ok {
+					matches = append(matches, match{i: i, j: j, guesses: float64(rank) * uppercaseVariations(original)})
+				}
+				if rank, ok := dict[reverse(word)]; ok && j > i {
+					matches = append(matches, match{i: i, j: j, guesses: float64(rank) * uppercaseVariations(original) * 2})
+				}
+				if rank, subs, ok := l33tLookup(lower[i:j+1], dict); ok {
+					matches = append(matches, match{i: i, j: j, guesses: float64(rank) * uppercaseVariations(original) * math.Pow(2, float64(subs))})
+				}
+			}
+		}
+	}
+	return matches
+}
+
+// l33tLookup undoes common character substitutions and looks the result up
+func l33tLookup(word []rune, dict map[string]int) (rank, subs int, found bool) {
+	candidates := [][]rune{{}}
+	subs = 0
+	for _, r := range word {
+		replacements, ok := l33tTable[r]
+		if !ok {
+			for k := range candidates {
+				candidates[k] = append(candidates[k], r)
+			}
+			continue
+		}
+		subs++
+		var next [][]rune
+		for _, c := range candidates {
+			for _, rep := range replacements {
+				extended := append(append([]rune{}, c...), rep)
+				next = append(next, extended)
+			}
+		}
+		candidates = next
+		if len(candidates) > 64 {
+			return 0, 0, false
+		}
+	}
+	if subs == 0 {
+		return 0, 0, false
+	}
+	best := 0
+	for _, c := range candidates {
+		if r, ok := dict[string(c)]; ok && (best == 0 || r < best) {
+			best = r
+		}
+	}
+	return best, subs, best > 0
+}
+
+func uppercaseVariations(word []rune) float64 {
+	upper, lower := 0, 0
+	for _, r := range word {
+		switch {
+		case r >= 'A' && r <= 'Z':
+			upper++
+		case r >= 'a' && r <= 'z':
+			lower++
+		}
+	}
+	if upper == 0 {
+		return 1
+	}
+	// All caps, or only the first letter capitalised, are the usual variations
+	if lower == 0 || (upper == 1 && word[0] >= 'A' && word[0] <= 'Z') {
+		return 2
+	}
+	variations := 0.0
+	for k := 1; k <= min(upper, lower); k++ {
+		variations += binomial(upper+lower, k)
+	}
+	return variations
+}
+
+func sequenceMatches(runes []rune) []match {
+	var matches []match
+	n := len(runes)
+	i := 0
+	for i < n-2 {
+		delta := runes[i+1] - runes[i]
+		if delta == 0 || delta > 5 || delta < -5 || charClass(runes[i]) != charClass(runes[i+1]) {
+			i++
+			continue
+		}
+		j := i + 1
+		for j+1 < n && runes[j+1]-runes[j] == delta && charClass(runes[j+1]) == charClass(runes[i]) {
+			j++
+		}
+		if j-i+1 >= 3 {
+			var base float64
+			switch {
+			case strings.ContainsRune("aAzZ019", runes[i]):
+				base = 4
+			case charClass(runes[i]) == classDigit:
+				base = 10
+			default:
+				base = 26
+			}
+			if delta < 0 {
+				base *= 2
+			}
+			matches = append(matches, match{i: i, j: j, guesses: base * float64(j-i+1)})
+		}
+		i = j
+	}
+	return matches
+}
+
+func repeatMatches(runes []rune) []match {
+	var matches []match
+	n := len(runes)
+	for i := 0; i < n; i++ {
+		for unit := 1; unit <= (n-i)/2; unit++ {
+			count := 1
+			for i+(count+1)*unit <= n && string(runes[i+count*unit:i+(count+1)*unit]) == string(runes[i:i+unit]) {
+				count++
+			}
+			if count >= 2 && count*unit >= 3 {
+				base := EstimateStrength(string(runes[i : i+unit])).Guesses
+				matches = append(matches, match{i: i, j: i + count*unit - 1, guesses: base * float64(count)})
+			}
+		}
+	}
+	return matches
+}
+
+func keyboardMatches(runes []rune) []match {
+	position := make(map[rune][2]int)
+	for row, keys := range keyboardRows {
+		for col, k := range keys {
+			position[k] = [2]int{row, col}
+		}
+	}
+
+	adjacent := func(a, b rune) (int, bool) {
+		pa, okA := position[toLowerRune(a)]
+		pb, okB := position[toLowerRune(b)]
+		if !okA || !okB {
+			return 0, false
+		}
+		dr, dc := pb[0]-pa[0], pb[1]-pa[1]
+		if dr < -1 || dr > 1 || dc < -1 || dc > 1 || (dr == 0 && dc == 0) {
+			return 0, false
+		}
+		return dr*3 + dc, true
+	}
+
+	var matches []match
+	n := len(runes)
+	i := 0
+	for i < n-1 {
+		dir, ok := adjacent(runes[i], runes[i+1])
+		if !ok {
+			i++
+			continue
+		}
+		turns := 1
+		j := i + 1
+		for j+1 < n {
+			next, ok := adjacent(runes[j], runes[j+1])
+			if !ok {
+				break
+			}
+			if next != dir {
+				turns++
+				dir = next
+			}
+			j++
+		}
+		if length := j - i + 1; length >= 4 {
+			guesses := float64(length) * keyboardStartingPositions * math.Pow(keyboardAverageDegree, float64(turns))
+			matches = append(matches, match{i: i, j: j, guesses: guesses})
+		}
+		i = j
+	}
+	return matches
+}
+
+func yearMatches(runes []rune) []match {
+	var matches []match
+	for i := 0; i+4 <= len(runes); i++ {
+		year, err := strconv.Atoi(string(runes[i : i+4]))
+		if err != nil || year < 1900 || year > 2099 {
+			continue
+		}
+		space := math.Max(math.Abs(float64(year-referenceYear)), minYearSpace)
+		matches = append(matches, match{i: i, j: i + 3, guesses: space})
+	}
+	return matches
+}
+
+// mostGuessableMatchSequence finds the segmentation of the password into
+// matches and bruteforce runs with the lowest overall guess count.
+func mostGuessableMatchSequence(n int, matches []match) float64 {
+	byEnd := make([][]match, n)
+	for _, m := range matches {
+		byEnd[m.j] = append(byEnd[m.j], m)
+	}
+	// Any span can also be bruteforced
+	for j := 0; j < n; j++ {
+		for i := 0; i <= j; i++ {
+			byEnd[j] = append(byEnd[j], match{i: i, j: j, guesses: bruteforceGuesses(j - i + 1)})
+		}
+	}
+
+	// best[k][j] is the lowest product of guesses covering password[:j+1] with k+1 matches
+	best := make([][]float64, n)
+	for k := range best {
+		best[k] = make([]float64, n)
+		for j := range best[k] {
+			best[k][j] = math.Inf(1)
+		}
+	}
+	for j := 0; j < n; j++ {
+		for _, m := range byEnd[j] {
+			if m.i == 0 {
+				best[0][j] = math.Min(best[0][j], m.guesses)
+				continue
+			}
+			for k := 1; k < n; k++ {
+				if prev := best[k-1][m.i-1]; !math.IsInf(prev, 1) {
+					best[k][j] = math.Min(best[k][j], prev*m.guesses)
+				}
+			}
+		}
+	}
+
+	result := math.Inf(1)
+	for k := 0; k < n; k++ {
+		if math.IsInf(best[k][n-1], 1) {
+			continue
+		}
+		count := float64(k + 1)
+		guesses := factorial(count)*best[k][n-1] + math.Pow(minGuessesBeforeGrowingSequence, count-1)
+		result = math.Min(result, guesses)
+	}
+	return result
+}
+
+func bruteforceGuesses(length int) float64 {
+	guesses := math.Pow(bruteforceCardinality, float64(length))
+	if length == 1 {
+		return math.Max(guesses, 11)
+	}
+	return math.Max(guesses, 51)
+}
+
+const (
+	classLower = iota
+	classUpper
+	classDigit
+	classOther
+)
+
+func charClass(r rune) int {
+	switch {
+	case r >= 'a' && r <= 'z':
+		return classLower
+	case r >= 'A' && r <= 'Z':
+		return classUpper
+	case r >= '0' && r <= '9':
+		return classDigit
+	default:
+		return classOther
+	}
+}
+
+func toLowerRune(r rune) rune {
+	if r >= 'A' && r <= 'Z' {
+		return r + ('a' - 'A')
+	}
+	return r
+}
+
+func reverse(s string) string {
+	runes := []rune(s)
+	for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+		runes[i], runes[j] = runes[j], runes[i]
+	}
+	return string(runes)
+}
+
+func factorial(n float64) float64 {
+	result := 1.0
+	for i := 2.0; i <= n; i++ {
+		result *= i
+	}
+	return result
+}
+
+func binomial(n, k int) float64 {
+	if k > n {
+		return 0
+	}
+	result := 1.0
+	for d := 1; d <= k; d++ {
+		result *= float64(n - k + d)
+		result /= float64(d)
+	}
+	return result
+}
@@ -0,0 +1,24 @@
+package pkg
+
+import "context"
+
+// RequestInfo describes where a request came from. It is attached to the
+// context by the HTTP layer and recorded with audit events.
+type RequestInfo struct {
+	IP        string
+	UserAgent string
+	RequestID string
+}
+
+type requestInfoKey struct{}
+
+// WithRequestInfo adds request info to context
+func WithRequestInfo(ctx context.Context, info RequestInfo) context.Context {
+	return context.WithValue(ctx, requestInfoKey{}, info)
+}
+
+// RequestInfoFrom gets request info from context. It is empty outside of an HTTP request.
+func RequestInfoFrom(ctx context.Context) RequestInfo {
+	info, _ := ctx.Value(requestInfoKey{}).(RequestInfo)
+	return info
+}
@@ -0,0 +1,339 @@
+// Package rrule implements the subset of RFC 5545 recurrence rules that todos
+// support: FREQ (DAILY, WEEKLY, MONTHLY, YEARLY), INTERVAL, BYDAY, COUNT and
+// UNTIL. Weeks start on Monday.
+package rrule
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Freq string
+
+const (
+	Daily   Freq = "DAILY"
+	Weekly  Freq = "WEEKLY"
+	Monthly Freq = "MONTHLY"
+	Yearly  Freq = "YEARLY"
+)
+
+// maxPeriods bounds how many periods Next walks through before giving up,
+// so a rule that never produces another date cannot loop forever
+const maxPeriods = 100000
+
+// WeekdayNum is a BYDAY entry. N selects the nth weekday of the month
+// (negative counts from the end) and is only allowed with MONTHLY; 0 means every one.
+type WeekdayNum struct {
+	N       int
+	Weekday time.Weekday
+}
+
+// Rule is a parsed recurrence rule
+type Rule struct {
+	Freq     Freq
+	Interval int
+	ByDay    []WeekdayNum
+	// Count limits the series to this many occurrences, including the first; 0 means no limit
+	Count int
+	// Until is the last moment an occurrence may start. When UntilDate is
+	// set it was given as a date and covers that whole day in the series timezone.
+	Until     *time.Time
+	UntilDate bool
+}
+
+var weekdayCodes = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+var weekdayNames = [...]string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// Parse reads a rule such as "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE". An
+// "RRULE:" prefix is accepted. Parts outside the supported subset are errors
+// rather than being ignored, so a series never silently behaves differently
+// from what the client asked for.
+func Parse(s string) (*Rule, error) {
+	s = strings.TrimSpace(s)
+	s = strings.TrimPrefix(strings.ToUpper(s), "RRULE:")
+	if s == "" {
+		return nil, errors.New("rule is empty")
+	}
+
+	r := &Rule{Interval: 1}
+	seen := map[string]bool{}
+	for _, part := range strings.Split(s, ";") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok || value == "" {
+			return nil, fmt.Errorf("invalid rule part %q", part)
+		}
+		if seen[key] {
+			return nil, fmt.Errorf("%s is given more than once", key)
+		}
+		seen[key] = true
+
+		switch key {
+		case "FREQ":
+			switch f := Freq(value); f {
+			case Daily, Weekly, Monthly, Yearly:
+				r.Freq = f
+			default:
+				return nil, fmt.Errorf("unsupported FREQ %q", value)
+			}
+		case "INTERVAL":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("INTERVAL must be a positive integer")
+			}
+			r.Interval = n
+		case "COUNT":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("COUNT must be a positive integer")
+			}
+			r.Count = n
+		case "UNTIL":
+			until, date, err := parseUntil(value)
+			if err != nil {
+				return nil, err
+			}
+			r.Until = &until
+			r.UntilDate = date
+		case "BYDAY":
+			for _, code := range strings.Split(value, ",") {
+				wd, err := parseWeekdayNum(code)
+				if err != nil {
+					return nil, err
+				}
+				r.ByDay = append(r.ByDay, wd)
+			}
+		default:
+			return nil, fmt.Errorf("unsupported rule part %s", key)
+		}
+	}
+
+	if r.Freq == "" {
+		return nil, errors.New("FREQ is required")
+	}
+	if r.Count > 0 && r.Until != nil {
+		return nil, errors.New("COUNT and UNTIL cannot be combined")
+	}
+	if len(r.ByDay) > 0 && r.Freq == Yearly {
+		return nil, errors.New("BYDAY is not supported with FREQ=YEARLY")
+	}
+	for _, wd := range r.ByDay {
+		if wd.N != 0 && r.Freq != Monthly {
+			return nil, errors.New("numbered BYDAY entries require FREQ=MONTHLY")
+		}
+	}
+	return r, nil
+}
+
+func parseUntil(value string) (time.Time, bool, error) {
+	if t, err := time.Parse("20060102T150405Z", value); err == nil {
+		return t, false, nil
+	}
+	if t, err := time.Parse("20060102", value); err == nil {
+		return t, true, nil
+	}
+	return time.Time{}, false, fmt.Errorf("UNTIL must look like 20060102 or 20060102T150405Z")
+}
+
+func parseWeekdayNum(code string) (WeekdayNum, error) {
+	if len(code) < 2 {
+		return WeekdayNum{}, fmt.Errorf("invalid BYDAY entry %q", code)
+	}
+	wd, ok := weekdayCodes[code[len(code)-2:]]
+	if !ok {
+		return WeekdayNum{}, fmt.Errorf("invalid BYDAY entry %q", code)
+	}
+	n := 0
+	if prefix := code[:len(code)-2]; prefix != "" {
+		var err error
+		n, err = strconv.Atoi(prefix)
+		if err != nil || n == 0 || n < -5 || n > 5 {
+			return WeekdayNum{}, fmt.Errorf("invalid BYDAY entry %q", code)
+		}
+	}
+	return WeekdayNum{N: n, Weekday: wd}, nil
+}
+
+// String formats the rule in canonical form, without the RRULE: prefix
+func (r *Rule) String() string {
+	parts := []string{"FREQ=" + string(r.Freq)}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if len(r.ByDay) > 0 {
+		codes := make([]string, len(r.ByDay))
+		for i, wd := range r.ByDay {
+			codes[i] = weekdayNames[wd.Weekday]
+			if wd.N != 0 {
+				codes[i] = strconv.Itoa(wd.N) + codes[i]
+			}
+		}
+		parts = append(parts, "BYDAY="+strings.Join(codes, ","))
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	if r.Until != nil {
+		if r.UntilDate {
+			parts = append(parts, "UNTIL="+r.Until.Format("20060102"))
+		} else {
+			parts = append(parts, "UNTIL="+r.Until.UTC().Format("20060102T150405Z"))
+		}
+	}
+	return strings.Join(parts, ";")
+}
+
+// Next returns the first occurrence of the series starting at start that is
+// later than after. start is always the first occurrence. Dates are computed
+// on the wall clock of loc, so a series keeps its local time across DST
+// changes; dates that do not exist, such as February 30, are skipped. ok is
+// false when the series has ended.
+func (r *Rule) Next(start, after time.Time, loc *time.Location) (next time.Time, ok bool) {
+	start = start.In(loc)
+	until := r.untilIn(loc)
+
+	count := 1
+	if start.After(after) {
+		return start, until == nil || !start.After(*until)
+	}
+
+	for period := 0; period < maxPeriods; period++ {
+		for _, candidate := range r.periodDates(start, period, loc) {
+			if !candidate.After(start) {
+				continue
+			}
+			count++
+			if r.Count > 0 && count > r.Count {
+				return time.Time{}, false
+			}
+			if until != nil && candidate.After(*until) {
+				return time.Time{}, false
+			}
+			if candidate.After(after) {
+				return candidate, true
+			}
+		}
+	}
+	return time.Time{}, false
+}
+
+func (r *Rule) untilIn(loc *time.Location) *time.Time {
+	if r.Until == nil {
+		return nil
+	}
+	if !r.UntilDate {
+		return r.Until
+	}
+	y, m, d := r.Until.Date()
+	until := time.Date(y, m, d, 23, 59, 59, 0, loc)
+	return &until
+}
+
+// periodDates lists the candidate dates of the nth period after start's, in order
+func (r *Rule) periodDates(start time.Time, period int, loc *time.Location) []time.Time {
+	y, m, d := start.Date()
+	hh, mm, ss := start.Clock()
+	at := func(y int, m time.Month, d int) (time.Time, bool) {
+		t := time.Date(y, m, d, hh, mm, ss, 0, loc)
+		// time.Date normalizes overflowing days into the next month
+		return t, t.Day() == d
+	}
+	step := period * r.Interval
+
+	switch r.Freq {
+	case Daily:
+		t, _ := at(y, m, d+step)
+		if len(r.ByDay) > 0 && !r.hasWeekday(t.Weekday()) {
+			return nil
+		}
+		return []time.Time{t}
+	case Weekly:
+		// Monday of start's week, then step weeks on
+		monday := d - (int(start.Weekday())+6)%7 + 7*step
+		if len(r.ByDay) == 0 {
+			t, _ := at(y, m, monday+(int(start.Weekday())+6)%7)
+			return []time.Time{t}
+		}
+		var dates []time.Time
+		for offset := 0; offset < 7; offset++ {
+			t, _ := at(y, m, monday+offset)
+			if r.hasWeekday(t.Weekday()) {
+				dates = append(dates, t)
+			}
+		}
+		return dates
+	case Monthly:
+		first := time.Date(y, m+time.Month(step), 1, 0, 0, 0, 0, loc)
+		if len(r.ByDay) == 0 {
+			if t, ok := at(first.Year(), first.Month(), d); ok {
+				return []time.Time{t}
+			}
+			return nil
+		}
+		return r.monthlyByDay(first.Year(), first.Month(), at)
+	case Yearly:
+		if t, ok := at(y+step, m, d); ok {
+			return []time.Time{t}
+		}
+		return nil
+	}
+	return nil
+}
+
+func (r *Rule) monthlyByDay(y int, m time.Month, at func(int, time.Month, int) (time.Time, bool)) []time.Time {
+	daysInMonth := time.Date(y, m+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	firstWeekday := time.Date(y, m, 1, 0, 0, 0, 0, time.UTC).Weekday()
+
+	days := map[int]bool{}
+	for _, wd := range r.ByDay {
+		// Day of month of the first such weekday
+		first := 1 + (int(wd.Weekday)-int(firstWeekday)+7)%7
+		var matches []int
+		for day := first; day <= daysInMonth; day += 7 {
+			matches = append(matches, day)
+		}
+		switch {
+		case wd.N == 0:
+			for _, day := range matches {
+				days[day] = true
+			}
+		case wd.N > 0 && wd.N <= len(matches):
+			days[matches[wd.N-1]] = true
+		case wd.N < 0 && -wd.N <= len(matches):
+			days[matches[len(matches)+wd.N]] = true
+		}
+	}
+
+	sorted := make([]int, 0, len(days))
+	for day := range days {
+		sorted = append(sorted, day)
+	}
+	sort.Ints(sorted)
+
+	dates := make([]time.Time, len(sorted))
+	for i, day := range sorted {
+		dates[i], _ = at(y, m, day)
+	}
+	return dates
+}
+
+func (r *Rule) hasWeekday(wd time.Weekday) bool {
+	for _, b := range r.ByDay {
+		if b.Weekday == wd {
+			return true
+		}
+	}
+	return false
+}
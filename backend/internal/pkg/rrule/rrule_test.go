@@ -0,0 +1,169 @@
+package rrule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		rule        string
+		want        string
+		errContains string
+	}{
+		{name: "success - daily", rule: "FREQ=DAILY", want: "FREQ=DAILY"},
+		{name: "success - prefix and lower case", rule: "rrule:freq=weekly;interval=2;byday=mo,we", want: "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE"},
+		{name: "success - interval 1 is dropped", rule: "FREQ=MONTHLY;INTERVAL=1;COUNT=3", want: "FREQ=MONTHLY;COUNT=3"},
+		{name: "success - numbered weekday", rule: "FREQ=MONTHLY;BYDAY=-1FR", want: "FREQ=MONTHLY;BYDAY=-1FR"},
+		{name: "success - until date", rule: "FREQ=YEARLY;UNTIL=20301231", want: "FREQ=YEARLY;UNTIL=20301231"},
+		{name: "success - until time", rule: "FREQ=DAILY;UNTIL=20300101T120000Z", want: "FREQ=DAILY;UNTIL=20300101T120000Z"},
+		{name: "fail - empty", rule: "", errContains: "empty"},
+		{name: "fail - no freq", rule: "INTERVAL=2", errContains: "FREQ is required"},
+		{name: "fail - hourly", rule: "FREQ=HOURLY", errContains: "unsupported FREQ"},
+		{name: "fail - unknown part", rule: "FREQ=DAILY;BYMONTH=1", errContains: "unsupported rule part"},
+		{name: "fail - zero interval", rule: "FREQ=DAILY;INTERVAL=0", errContains: "INTERVAL"},
+		{name: "fail - count and until", rule: "FREQ=DAILY;COUNT=2;UNTIL=20300101", errContains: "cannot be combined"},
+		{name: "fail - numbered weekday outside monthly", rule: "FREQ=WEEKLY;BYDAY=1MO", errContains: "MONTHLY"},
+		{name: "fail - bad weekday", rule: "FREQ=WEEKLY;BYDAY=XX", errContains: "BYDAY"},
+		{name: "fail - duplicate part", rule: "FREQ=DAILY;FREQ=WEEKLY", errContains: "more than once"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r, err := Parse(tt.rule)
+			if tt.errContains != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, r.String())
+		})
+	}
+}
+
+func TestRule_Next(t *testing.T) {
+	t.Parallel()
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		rule  string
+		start time.Time
+		loc   *time.Location
+		// want lists the occurrences that follow start, in order
+		want []time.Time
+	}{
+		{
+			name:  "daily every other day",
+			rule:  "FREQ=DAILY;INTERVAL=2",
+			start: time.Date(2025, 1, 30, 9, 0, 0, 0, time.UTC),
+			loc:   time.UTC,
+			want: []time.Time{
+				time.Date(2025, 2, 1, 9, 0, 0, 0, time.UTC),
+				time.Date(2025, 2, 3, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:  "weekly on monday and wednesday",
+			rule:  "FREQ=WEEKLY;BYDAY=MO,WE",
+			start: time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC), // Wednesday
+			loc:   time.UTC,
+			want: []time.Time{
+				time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC),
+				time.Date(2025, 1, 8, 9, 0, 0, 0, time.UTC),
+				time.Date(2025, 1, 13, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:  "monthly on the 31st skips short months",
+			rule:  "FREQ=MONTHLY",
+			start: time.Date(2025, 1, 31, 9, 0, 0, 0, time.UTC),
+			loc:   time.UTC,
+			want: []time.Time{
+				time.Date(2025, 3, 31, 9, 0, 0, 0, time.UTC),
+				time.Date(2025, 5, 31, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:  "monthly on the last friday",
+			rule:  "FREQ=MONTHLY;BYDAY=-1FR",
+			start: time.Date(2025, 1, 31, 17, 0, 0, 0, time.UTC),
+			loc:   time.UTC,
+			want: []time.Time{
+				time.Date(2025, 2, 28, 17, 0, 0, 0, time.UTC),
+				time.Date(2025, 3, 28, 17, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:  "yearly on february 29",
+			rule:  "FREQ=YEARLY",
+			start: time.Date(2024, 2, 29, 9, 0, 0, 0, time.UTC),
+			loc:   time.UTC,
+			want: []time.Time{
+				time.Date(2028, 2, 29, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:  "count includes the first occurrence",
+			rule:  "FREQ=DAILY;COUNT=3",
+			start: time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC),
+			loc:   time.UTC,
+			want: []time.Time{
+				time.Date(2025, 1, 2, 9, 0, 0, 0, time.UTC),
+				time.Date(2025, 1, 3, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:  "until date covers the whole day",
+			rule:  "FREQ=DAILY;UNTIL=20250102",
+			start: time.Date(2025, 1, 1, 22, 0, 0, 0, time.UTC),
+			loc:   time.UTC,
+			want: []time.Time{
+				time.Date(2025, 1, 2, 22, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:  "local time is kept across DST",
+			rule:  "FREQ=WEEKLY",
+			start: time.Date(2025, 3, 24, 9, 0, 0, 0, berlin),
+			loc:   berlin,
+			want: []time.Time{
+				time.Date(2025, 3, 31, 9, 0, 0, 0, berlin),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r, err := Parse(tt.rule)
+			require.NoError(t, err)
+
+			current := tt.start
+			for _, want := range tt.want {
+				next, ok := r.Next(tt.start, current, tt.loc)
+				require.True(t, ok)
+				assert.True(t, want.Equal(next), "want %s, got %s", want, next)
+				current = next
+			}
+
+			// Rules with an end stop after the listed occurrences
+			if r.Count > 0 || r.Until != nil {
+				_, ok := r.Next(tt.start, current, tt.loc)
+				assert.False(t, ok)
+			}
+		})
+	}
+}
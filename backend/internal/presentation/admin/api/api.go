@@ -26,83 +26,77 @@ const (
 	BearerScopes = "Bearer.Scopes"
 )
 
-// Defines values for UserResponseRole.
+// Defines values for AuditEventResponseActorType.
 const (
-	Admin  UserResponseRole = "admin"
-	Member UserResponseRole = "member"
+	AuditEventResponseActorTypeAnonymous AuditEventResponseActorType = "anonymous"
+	AuditEventResponseActorTypeScim      AuditEventResponseActorType = "scim"
+	AuditEventResponseActorTypeUser      AuditEventResponseActorType = "user"
 )
 
-// CreateTenantRequest defines model for CreateTenantRequest.
-type CreateTenantRequest struct {
-	Name string `json:"name"`
-	Slug string `json:"slug"`
-}
+// Defines values for AuditEventResponseTargetType.
+const (
+	AuditEventResponseTargetTypeTenant AuditEventResponseTargetType = "tenant"
+	AuditEventResponseTargetTypeUser   AuditEventResponseTargetType = "user"
+)
 
-// ErrorResponse defines model for ErrorResponse.
-type ErrorResponse struct {
-	Code    *string                 `json:"code,omitempty"`
-	Details *map[string]interface{} `json:"details,omitempty"`
-	Message *string                 `json:"message,omitempty"`
+// AuditEventListResponse defines model for AuditEventListResponse.
+type AuditEventListResponse struct {
+	Events []AuditEventResponse `json:"events"`
+	Total  int                  `json:"total"`
 }
 
-// TenantListResponse defines model for TenantListResponse.
-type TenantListResponse struct {
-	Tenants *[]TenantResponse `json:"tenants,omitempty"`
-	Total   *int              `json:"total,omitempty"`
-}
+// AuditEventResponse defines model for AuditEventResponse.
+type AuditEventResponse struct {
+	// Action What happened, e.g. auth.login_failed or user.role_changed
+	Action string `json:"action"`
 
-// TenantResponse defines model for TenantResponse.
-type TenantResponse struct {
-	CreatedAt *time.Time `json:"created_at,omitempty"`
-	Id        *string    `json:"id,omitempty"`
-	Name      *string    `json:"name,omitempty"`
-	Slug      *string    `json:"slug,omitempty"`
-	UpdatedAt *time.Time `json:"updated_at,omitempty"`
-}
+	// ActorId The acting user, when the actor is a user
+	ActorId *string `json:"actor_id,omitempty"`
 
-// UpdateTenantRequest defines model for UpdateTenantRequest.
-type UpdateTenantRequest struct {
-	Name *string `json:"name,omitempty"`
-}
+	// ActorType A signed-in user, the tenant's identity provider, or an unauthenticated caller
+	ActorType AuditEventResponseActorType `json:"actor_type"`
+	CreatedAt time.Time                   `json:"created_at"`
+	Id        string                      `json:"id"`
+	Ip        string                      `json:"ip"`
 
-// UserListResponse defines model for UserListResponse.
-type UserListResponse struct {
-	Total *int            `json:"total,omitempty"`
-	Users *[]UserResponse `json:"users,omitempty"`
+	// Metadata Action-specific details, e.g. the old and new role
+	Metadata   map[string]string             `json:"metadata"`
+	RequestId  string                        `json:"request_id"`
+	TargetId   *string                       `json:"target_id,omitempty"`
+	TargetType *AuditEventResponseTargetType `json:"target_type,omitempty"`
+	TenantId   string                        `json:"tenant_id"`
+	UserAgent  string                        `json:"user_agent"`
 }
 
-// UserResponse defines model for UserResponse.
-type UserResponse struct {
-	CreatedAt     *time.Time        `json:"created_at,omitempty"`
-	Email         *string           `json:"email,omitempty"`
-	EmailVerified *bool             `json:"email_verified,omitempty"`
-	Id            *string           `json:"id,omitempty"`
-	Name          *string           `json:"name,omitempty"`
-	Role          *UserResponseRole `json:"role,omitempty"`
-	TenantId      *string           `json:"tenant_id,omitempty"`
-	UpdatedAt     *time.Time        `json:"updated_at,omitempty"`
-}
+// AuditEventResponseActorType A signed-in user, the tenant's identity provider, or an unauthenticated caller
+type AuditEventResponseActorType string
 
-// UserResponseRole defines model for UserResponse.Role.
-type UserResponseRole string
+// AuditEventResponseTargetType defines model for AuditEventResponse.TargetType.
+type AuditEventResponseTargetType string
 
-// GetTenantsParams defines parameters for GetTenants.
-type GetTenantsParams struct {
-	Limit  *int `form:"limit,omitempty" json:"limit,omitempty"`
-	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
-}
+// ErrorResponse defines model for ErrorResponse.
+type ErrorResponse struct {
+	Code *string `json:"code,omitempty"`
 
-// GetTenantUsersParams defines parameters for GetTenantUsers.
-type GetTenantUsersParams struct {
-	Limit  *int `form:"limit,omitempty" json:"limit,omitempty"`
-	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+	// Details Structured information about the error. For VALIDATION_ERROR responses on
+	// passwords this contains `field`, `violations` (too_short, too_long, too_weak,
+	// similar_to_user_info, breached), `score`, `min_score` and `min_length`.
+	Details *map[string]interface{} `json:"details,omitempty"`
+	Message *string                 `json:"message,omitempty"`
 }
 
-// CreateTenantJSONRequestBody defines body for CreateTenant for application/json ContentType.
-type CreateTenantJSONRequestBody = CreateTenantRequest
-
-// UpdateTenantJSONRequestBody defines body for UpdateTenant for application/json ContentType.
-type UpdateTenantJSONRequestBody = UpdateTenantRequest
+// GetAuditEventsParams defines parameters for GetAuditEvents.
+type GetAuditEventsParams struct {
+	// TenantId Only events of this tenant
+	TenantId *string    `form:"tenant_id,omitempty" json:"tenant_id,omitempty"`
+	ActorId  *string    `form:"actor_id,omitempty" json:"actor_id,omitempty"`
+	Action   *string    `form:"action,omitempty" json:"action,omitempty"`
+	TargetId *string    `form:"target_id,omitempty" json:"target_id,omitempty"`
+	From     *time.Time `form:"from,omitempty" json:"from,omitempty"`
+	To       *time.Time `form:"to,omitempty" json:"to,omitempty"`
+	Limit    *int       `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset   *int       `form:"offset,omitempty" json:"offset,omitempty"`
+}
 
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
@@ -177,31 +171,15 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// GetAuditEvents request
+	GetAuditEvents(ctx context.Context, params *GetAuditEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// HealthCheck request
 	HealthCheck(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
-
-	// GetTenants request
-	GetTenants(ctx context.Context, params *GetTenantsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
-
-	// CreateTenantWithBody request with any body
-	CreateTenantWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
-
-	CreateTenant(ctx context.Context, body CreateTenantJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
-
-	// GetTenant request
-	GetTenant(ctx context.Context, tenantId string, reqEditors ...RequestEditorFn) (*http.Response, error)
-
-	// UpdateTenantWithBody request with any body
-	UpdateTenantWithBody(ctx context.Context, tenantId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
-
-	UpdateTenant(ctx context.Context, tenantId string, body UpdateTenantJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
-
-	// GetTenantUsers request
-	GetTenantUsers(ctx context.Context, tenantId string, params *GetTenantUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
-func (c *Client) HealthCheck(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewHealthCheckRequest(c.Server)
+func (c *Client) GetAuditEvents(ctx context.Context, params *GetAuditEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAuditEventsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -212,8 +190,8 @@ func (c *Client) HealthCheck(ctx context.Context, reqEditors ...RequestEditorFn)
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetTenants(ctx context.Context, params *GetTenantsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetTenantsRequest(c.Server, params)
+func (c *Client) HealthCheck(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHealthCheckRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -224,126 +202,123 @@ func (c *Client) GetTenants(ctx context.Context, params *GetTenantsParams, reqEd
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateTenantWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateTenantRequestWithBody(c.Server, contentType, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
-}
+// NewGetAuditEventsRequest generates requests for GetAuditEvents
+func NewGetAuditEventsRequest(server string, params *GetAuditEventsParams) (*http.Request, error) {
+	var err error
 
-func (c *Client) CreateTenant(ctx context.Context, body CreateTenantJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateTenantRequest(c.Server, body)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
-}
 
-func (c *Client) GetTenant(ctx context.Context, tenantId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetTenantRequest(c.Server, tenantId)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+	operationPath := fmt.Sprintf("/audit-events")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return c.Client.Do(req)
-}
 
-func (c *Client) UpdateTenantWithBody(ctx context.Context, tenantId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateTenantRequestWithBody(c.Server, tenantId, contentType, body)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
-}
 
-func (c *Client) UpdateTenant(ctx context.Context, tenantId string, body UpdateTenantJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateTenantRequest(c.Server, tenantId, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-func (c *Client) GetTenantUsers(ctx context.Context, tenantId string, params *GetTenantUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetTenantUsersRequest(c.Server, tenantId, params)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
-}
+		if params.TenantId != nil {
 
-// NewHealthCheckRequest generates requests for HealthCheck
-func NewHealthCheckRequest(server string) (*http.Request, error) {
-	var err error
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tenant_id", runtime.ParamLocationQuery, *params.TenantId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+		}
 
-	operationPath := fmt.Sprintf("/health")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+		if params.ActorId != nil {
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "actor_id", runtime.ParamLocationQuery, *params.ActorId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+		}
 
-	return req, nil
-}
+		if params.Action != nil {
 
-// NewGetTenantsRequest generates requests for GetTenants
-func NewGetTenantsRequest(server string, params *GetTenantsParams) (*http.Request, error) {
-	var err error
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "action", runtime.ParamLocationQuery, *params.Action); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+		}
 
-	operationPath := fmt.Sprintf("/tenants")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+		if params.TargetId != nil {
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "target_id", runtime.ParamLocationQuery, *params.TargetId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+		}
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
 
 		if params.Limit != nil {
 
@@ -388,19 +363,8 @@ func NewGetTenantsRequest(server string, params *GetTenantsParams) (*http.Reques
 	return req, nil
 }
 
-// NewCreateTenantRequest calls the generic CreateTenant builder with application/json body
-func NewCreateTenantRequest(server string, body CreateTenantJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateTenantRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewCreateTenantRequestWithBody generates requests for CreateTenant with any type of body
-func NewCreateTenantRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewHealthCheckRequest generates requests for HealthCheck
+func NewHealthCheckRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -408,7 +372,7 @@ func NewCreateTenantRequestWithBody(server string, contentType string, body io.R
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/tenants")
+	operationPath := fmt.Sprintf("/health")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -418,268 +382,73 @@ func NewCreateTenantRequestWithBody(server string, contentType string, body io.R
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewGetTenantRequest generates requests for GetTenant
-func NewGetTenantRequest(server string, tenantId string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenantId", runtime.ParamLocationPath, tenantId)
-	if err != nil {
-		return nil, err
-	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
 	}
-
-	operationPath := fmt.Sprintf("/tenants/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
 	}
+	return nil
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return &ClientWithResponses{client}, nil
 }
 
-// NewUpdateTenantRequest calls the generic UpdateTenant builder with application/json body
-func NewUpdateTenantRequest(server string, tenantId string, body UpdateTenantJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateTenantRequestWithBody(server, tenantId, "application/json", bodyReader)
 }
 
-// NewUpdateTenantRequestWithBody generates requests for UpdateTenant with any type of body
-func NewUpdateTenantRequestWithBody(server string, tenantId string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetAuditEventsWithResponse request
+	GetAuditEventsWithResponse(ctx context.Context, params *GetAuditEventsParams, reqEditors ...RequestEditorFn) (*GetAuditEventsResponse, error)
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenantId", runtime.ParamLocationPath, tenantId)
-	if err != nil {
-		return nil, err
-	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/tenants/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
-}
-
-// NewGetTenantUsersRequest generates requests for GetTenantUsers
-func NewGetTenantUsersRequest(server string, tenantId string, params *GetTenantUsersParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenantId", runtime.ParamLocationPath, tenantId)
-	if err != nil {
-		return nil, err
-	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/tenants/%s/users", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Offset != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
-
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
-
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return &ClientWithResponses{client}, nil
-}
-
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
-	}
-}
-
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
 	// HealthCheckWithResponse request
 	HealthCheckWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthCheckResponse, error)
-
-	// GetTenantsWithResponse request
-	GetTenantsWithResponse(ctx context.Context, params *GetTenantsParams, reqEditors ...RequestEditorFn) (*GetTenantsResponse, error)
-
-	// CreateTenantWithBodyWithResponse request with any body
-	CreateTenantWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTenantResponse, error)
-
-	CreateTenantWithResponse(ctx context.Context, body CreateTenantJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTenantResponse, error)
-
-	// GetTenantWithResponse request
-	GetTenantWithResponse(ctx context.Context, tenantId string, reqEditors ...RequestEditorFn) (*GetTenantResponse, error)
-
-	// UpdateTenantWithBodyWithResponse request with any body
-	UpdateTenantWithBodyWithResponse(ctx context.Context, tenantId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTenantResponse, error)
-
-	UpdateTenantWithResponse(ctx context.Context, tenantId string, body UpdateTenantJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTenantResponse, error)
-
-	// GetTenantUsersWithResponse request
-	GetTenantUsersWithResponse(ctx context.Context, tenantId string, params *GetTenantUsersParams, reqEditors ...RequestEditorFn) (*GetTenantUsersResponse, error)
-}
-
-type HealthCheckResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Status *string `json:"status,omitempty"`
-	}
-}
-
-// Status returns HTTPResponse.Status
-func (r HealthCheckResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
-
-// StatusCode returns HTTPResponse.StatusCode
-func (r HealthCheckResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
 }
 
-type GetTenantsResponse struct {
+type GetAuditEventsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *TenantListResponse
+	JSON200      *AuditEventListResponse
 	JSON401      *ErrorResponse
 }
 
 // Status returns HTTPResponse.Status
-func (r GetTenantsResponse) Status() string {
+func (r GetAuditEventsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -687,69 +456,23 @@ func (r GetTenantsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetTenantsResponse) StatusCode() int {
+func (r GetAuditEventsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CreateTenantResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *TenantResponse
-	JSON400      *ErrorResponse
-	JSON409      *ErrorResponse
-}
-
-// Status returns HTTPResponse.Status
-func (r CreateTenantResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
-
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateTenantResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
-
-type GetTenantResponse struct {
+type HealthCheckResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *TenantResponse
-	JSON404      *ErrorResponse
-}
-
-// Status returns HTTPResponse.Status
-func (r GetTenantResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
-
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetTenantResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	JSON200      *struct {
+		Status *string `json:"status,omitempty"`
 	}
-	return 0
-}
-
-type UpdateTenantResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TenantResponse
-	JSON404      *ErrorResponse
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdateTenantResponse) Status() string {
+func (r HealthCheckResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -757,34 +480,20 @@ func (r UpdateTenantResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpdateTenantResponse) StatusCode() int {
+func (r HealthCheckResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetTenantUsersResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *UserListResponse
-	JSON404      *ErrorResponse
-}
-
-// Status returns HTTPResponse.Status
-func (r GetTenantUsersResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
-
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetTenantUsersResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetAuditEventsWithResponse request returning *GetAuditEventsResponse
+func (c *ClientWithResponses) GetAuditEventsWithResponse(ctx context.Context, params *GetAuditEventsParams, reqEditors ...RequestEditorFn) (*GetAuditEventsResponse, error) {
+	rsp, err := c.GetAuditEvents(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGetAuditEventsResponse(rsp)
 }
 
 // HealthCheckWithResponse request returning *HealthCheckResponse
@@ -796,111 +505,22 @@ func (c *ClientWithResponses) HealthCheckWithResponse(ctx context.Context, reqEd
 	return ParseHealthCheckResponse(rsp)
 }
 
-// GetTenantsWithResponse request returning *GetTenantsResponse
-func (c *ClientWithResponses) GetTenantsWithResponse(ctx context.Context, params *GetTenantsParams, reqEditors ...RequestEditorFn) (*GetTenantsResponse, error) {
-	rsp, err := c.GetTenants(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetTenantsResponse(rsp)
-}
-
-// CreateTenantWithBodyWithResponse request with arbitrary body returning *CreateTenantResponse
-func (c *ClientWithResponses) CreateTenantWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTenantResponse, error) {
-	rsp, err := c.CreateTenantWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseCreateTenantResponse(rsp)
-}
-
-func (c *ClientWithResponses) CreateTenantWithResponse(ctx context.Context, body CreateTenantJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTenantResponse, error) {
-	rsp, err := c.CreateTenant(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseCreateTenantResponse(rsp)
-}
-
-// GetTenantWithResponse request returning *GetTenantResponse
-func (c *ClientWithResponses) GetTenantWithResponse(ctx context.Context, tenantId string, reqEditors ...RequestEditorFn) (*GetTenantResponse, error) {
-	rsp, err := c.GetTenant(ctx, tenantId, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetTenantResponse(rsp)
-}
-
-// UpdateTenantWithBodyWithResponse request with arbitrary body returning *UpdateTenantResponse
-func (c *ClientWithResponses) UpdateTenantWithBodyWithResponse(ctx context.Context, tenantId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTenantResponse, error) {
-	rsp, err := c.UpdateTenantWithBody(ctx, tenantId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseUpdateTenantResponse(rsp)
-}
-
-func (c *ClientWithResponses) UpdateTenantWithResponse(ctx context.Context, tenantId string, body UpdateTenantJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTenantResponse, error) {
-	rsp, err := c.UpdateTenant(ctx, tenantId, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseUpdateTenantResponse(rsp)
-}
-
-// GetTenantUsersWithResponse request returning *GetTenantUsersResponse
-func (c *ClientWithResponses) GetTenantUsersWithResponse(ctx context.Context, tenantId string, params *GetTenantUsersParams, reqEditors ...RequestEditorFn) (*GetTenantUsersResponse, error) {
-	rsp, err := c.GetTenantUsers(ctx, tenantId, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetTenantUsersResponse(rsp)
-}
-
-// ParseHealthCheckResponse parses an HTTP response from a HealthCheckWithResponse call
-func ParseHealthCheckResponse(rsp *http.Response) (*HealthCheckResponse, error) {
+// ParseGetAuditEventsResponse parses an HTTP response from a GetAuditEventsWithResponse call
+func ParseGetAuditEventsResponse(rsp *http.Response) (*GetAuditEventsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &HealthCheckResponse{
+	response := &GetAuditEventsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			Status *string `json:"status,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	}
-
-	return response, nil
-}
-
-// ParseGetTenantsResponse parses an HTTP response from a GetTenantsWithResponse call
-func ParseGetTenantsResponse(rsp *http.Response) (*GetTenantsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &GetTenantsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TenantListResponse
+		var dest AuditEventListResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -918,140 +538,29 @@ func ParseGetTenantsResponse(rsp *http.Response) (*GetTenantsResponse, error) {
 	return response, nil
 }
 
-// ParseCreateTenantResponse parses an HTTP response from a CreateTenantWithResponse call
-func ParseCreateTenantResponse(rsp *http.Response) (*CreateTenantResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &CreateTenantResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest TenantResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
-
-	}
-
-	return response, nil
-}
-
-// ParseGetTenantResponse parses an HTTP response from a GetTenantWithResponse call
-func ParseGetTenantResponse(rsp *http.Response) (*GetTenantResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &GetTenantResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TenantResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
-	}
-
-	return response, nil
-}
-
-// ParseUpdateTenantResponse parses an HTTP response from a UpdateTenantWithResponse call
-func ParseUpdateTenantResponse(rsp *http.Response) (*UpdateTenantResponse, error) {
+// ParseHealthCheckResponse parses an HTTP response from a HealthCheckWithResponse call
+func ParseHealthCheckResponse(rsp *http.Response) (*HealthCheckResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateTenantResponse{
+	response := &HealthCheckResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TenantResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+		var dest struct {
+			Status *string `json:"status,omitempty"`
 		}
-		response.JSON404 = &dest
-
-	}
-
-	return response, nil
-}
-
-// ParseGetTenantUsersResponse parses an HTTP response from a GetTenantUsersWithResponse call
-func ParseGetTenantUsersResponse(rsp *http.Response) (*GetTenantUsersResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &GetTenantUsersResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest UserListResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	}
 
 	return response, nil
@@ -1059,24 +568,12 @@ func ParseGetTenantUsersResponse(rsp *http.Response) (*GetTenantUsersResponse, e
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Get audit events across all tenants
+	// (GET /audit-events)
+	GetAuditEvents(ctx echo.Context, params GetAuditEventsParams) error
 	// Health check
 	// (GET /health)
 	HealthCheck(ctx echo.Context) error
-	// Get all tenants
-	// (GET /tenants)
-	GetTenants(ctx echo.Context, params GetTenantsParams) error
-	// Create a new tenant
-	// (POST /tenants)
-	CreateTenant(ctx echo.Context) error
-	// Get a tenant by ID
-	// (GET /tenants/{tenantId})
-	GetTenant(ctx echo.Context, tenantId string) error
-	// Update a tenant
-	// (PUT /tenants/{tenantId})
-	UpdateTenant(ctx echo.Context, tenantId string) error
-	// Get users in a tenant
-	// (GET /tenants/{tenantId}/users)
-	GetTenantUsers(ctx echo.Context, tenantId string, params GetTenantUsersParams) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -1084,104 +581,56 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
-// HealthCheck converts echo context to params.
-func (w *ServerInterfaceWrapper) HealthCheck(ctx echo.Context) error {
-	var err error
-
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.HealthCheck(ctx)
-	return err
-}
-
-// GetTenants converts echo context to params.
-func (w *ServerInterfaceWrapper) GetTenants(ctx echo.Context) error {
+// GetAuditEvents converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAuditEvents(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(BearerScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetTenantsParams
-	// ------------- Optional query parameter "limit" -------------
+	var params GetAuditEventsParams
+	// ------------- Optional query parameter "tenant_id" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	err = runtime.BindQueryParameter("form", true, false, "tenant_id", ctx.QueryParams(), &params.TenantId)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant_id: %s", err))
 	}
 
-	// ------------- Optional query parameter "offset" -------------
+	// ------------- Optional query parameter "actor_id" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	err = runtime.BindQueryParameter("form", true, false, "actor_id", ctx.QueryParams(), &params.ActorId)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter actor_id: %s", err))
 	}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetTenants(ctx, params)
-	return err
-}
-
-// CreateTenant converts echo context to params.
-func (w *ServerInterfaceWrapper) CreateTenant(ctx echo.Context) error {
-	var err error
-
-	ctx.Set(BearerScopes, []string{})
-
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.CreateTenant(ctx)
-	return err
-}
-
-// GetTenant converts echo context to params.
-func (w *ServerInterfaceWrapper) GetTenant(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "tenantId" -------------
-	var tenantId string
+	// ------------- Optional query parameter "action" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "tenantId", ctx.Param("tenantId"), &tenantId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "action", ctx.QueryParams(), &params.Action)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenantId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter action: %s", err))
 	}
 
-	ctx.Set(BearerScopes, []string{})
+	// ------------- Optional query parameter "target_id" -------------
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetTenant(ctx, tenantId)
-	return err
-}
+	err = runtime.BindQueryParameter("form", true, false, "target_id", ctx.QueryParams(), &params.TargetId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter target_id: %s", err))
+	}
 
-// UpdateTenant converts echo context to params.
-func (w *ServerInterfaceWrapper) UpdateTenant(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "tenantId" -------------
-	var tenantId string
+	// ------------- Optional query parameter "from" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "tenantId", ctx.Param("tenantId"), &tenantId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "from", ctx.QueryParams(), &params.From)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenantId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
 	}
 
-	ctx.Set(BearerScopes, []string{})
+	// ------------- Optional query parameter "to" -------------
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UpdateTenant(ctx, tenantId)
-	return err
-}
-
-// GetTenantUsers converts echo context to params.
-func (w *ServerInterfaceWrapper) GetTenantUsers(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "tenantId" -------------
-	var tenantId string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "tenantId", ctx.Param("tenantId"), &tenantId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "to", ctx.QueryParams(), &params.To)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenantId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
 	}
 
-	ctx.Set(BearerScopes, []string{})
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetTenantUsersParams
 	// ------------- Optional query parameter "limit" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
@@ -1197,7 +646,16 @@ func (w *ServerInterfaceWrapper) GetTenantUsers(ctx echo.Context) error {
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetTenantUsers(ctx, tenantId, params)
+	err = w.Handler.GetAuditEvents(ctx, params)
+	return err
+}
+
+// HealthCheck converts echo context to params.
+func (w *ServerInterfaceWrapper) HealthCheck(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.HealthCheck(ctx)
 	return err
 }
 
@@ -1229,35 +687,33 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.GET(baseURL+"/audit-events", wrapper.GetAuditEvents)
 	router.GET(baseURL+"/health", wrapper.HealthCheck)
-	router.GET(baseURL+"/tenants", wrapper.GetTenants)
-	router.POST(baseURL+"/tenants", wrapper.CreateTenant)
-	router.GET(baseURL+"/tenants/:tenantId", wrapper.GetTenant)
-	router.PUT(baseURL+"/tenants/:tenantId", wrapper.UpdateTenant)
-	router.GET(baseURL+"/tenants/:tenantId/users", wrapper.GetTenantUsers)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+RXUW/bNhD+K8StDxumRErbh1VvSbdl2Qas6BLsIfACWjzZbClSIamsbqD/Phwl2UpM",
-	"O27nZCj6FMY63h2/7+On0y0UpqqNRu0d5LfgijlWPCxfW+Qez1Fz7d/idYPO08+1NTVaLzEEaV4h/fWL",
-	"GiEH563UM2gTcKqZhXDuPVoNOfx9yQ8+ZgevDibfP4Pk/o42AYvXjbQoIL/s8vZZJstgM32Hhaf0P1lr",
-	"7Ft0tdEO19sqjIi3JdBzqUIMF0J6aTRXb0Z7vW0wUq9C5/gslrONRHeg/S6d39yiDzFhKT1WYfHMYgk5",
-	"fJOuSEl7RtKBiD7fqiy3li/C/8ZzNepQao8ztNta3IJgYF9c8UB6aWxFKxDc44GXgZw1cKWIYv6gRtYe",
-	"NLX4xOKxI16ELJ8n4Gg+h/YBTjcxkEDj0O5ONpXaTPWm5vbLJlZcqig94cnVDVpZShxzPjVGIdefowVr",
-	"VHiAuqnIALiopAa6eNUU7cgDVlu6G3S1odI+NEQaxaKx0i/+JGY6ME+QW7S0mobVz0PuX/86J88KkYRG",
-	"F7fMOve+hpaSSl0a2i/QFVbW5EKQwzEdmR2/OWOlsezUGMHOjTCM17WSBaco9m0nZ1ZxzWdYofbfUQHp",
-	"CT1Y7aEsB+y4B/EGretqZIfZ4RHBY2rUvJaQw4vD7PAFJOTU83C+dI5c+TktZxjQIymF+mcCcvglPH49",
-	"x+I9kGt3ogtbn2dZZ7/aow5bR82n7xz1MLxk1lXqPPdNWOEHXtXhSOb9TkQFax+D+cdvHX1NVXG7WHbN",
-	"ir5tz2eOdNb9DBMKTkeeHD35KfrzPoTwsrxCH6715S1IqnrdoF3AIHNQspJ+kATvGC95ozzkz7Mk4tPx",
-	"NKYsHW7IE0sz+Y+sPPwaumODEfTpOTMlGwBtE3iZHe2tibtv/0j9C80bPzdWfkRx5xoHqoYLfDkhqFYS",
-	"OUXPuFLLrlcq6U4NkzaB2riIMsbDEnSjDDp/YsRib4eOzWPt3bmJhpd2jfyjPZO/Dfjenvr3DXNNUaBz",
-	"ZaPUohNB9nQiOOGC2QEoqv3q6Wr3ONCEw7iyyMWC4Qfp6C7sKseOcMaZxn96TcYkOTKu9LZbnIn2YQ/b",
-	"YGH0GlhZz5AP7stsbEb37fnx/WcH6IdJPzD/8smZ18az0jT6U+2nJ5pNF+zsxw0O1ERoHQ+7j8zs/q0t",
-	"NqnvZG3/g676uTJibV+EyDqolzrb3VDS5RfMdlu5CGGPpsDkq5m01j43t8xZgRsm9cDql2V6y+63qjJk",
-	"tDeDoO4BYQqumMAbVKamzyLWxUICjVX9x1eepori5sb5/IcsO4J20v4bAAD//wEFldcCEwAA",
+	"H4sIAAAAAAAC/6xWUW/bNhD+KwQ3YC2g2O66AYPfsq3dshVNkWbrQxs4F/EssaV46vHkzAv83weSchzZ",
+	"MtZ1faN0vI/f3Xd35J0uqWnJo5eg53c6lDU2kJannbHybIVeXtggFxha8gGjpWVqkcVi2oerrbMVbNLi",
+	"a8alnuuvpjvwaY883cHeQ24KLesW9VwDM6zTNwm4CNUbrBeskPVmU2jGj51lNHr+dnv41uHqHolu3mMp",
+	"EWrkwIMYoBRLPq4MhpJtmz/1mxpE1dC26NEUCifVREEn9cRRZf1iCdahUcSqC8gTJoeLsgZfodGFxr+g",
+	"aV0kM2bteQZh66vIE0ohXlhzyOKyRhUZ+iqdU6jbGr2S/JdY2aAgWY7D5t/7wKcq2MqjObG+R46ggh68",
+	"fBOUNejFylq1TCtrop1YgVedj0mIxhIEjSrBuXQ6+q6JsvRkQmkbXWjw5NcNdUFfjRAsGSPIAiQSXBI3",
+	"caUNCJ6IbXAsqJylw9/t6O8GBQwIJKWNsTF6cK8GFXDgtJepVCAnocXSLm2pDApYF/qSiFkjZxR4ozze",
+	"qii1HinFWLkYZHGEvgBX+G/WrZJ7qc6ijSY4m47BRv8FVOhlxLzXbdboh3CD4iq2TZRkGOAOAn8gx0D7",
+	"sdZ9xkx8vGtLMnhEu6TOcb2FO9yX+LVwV0rHaJT1uQwteQU31ElSGCObiXpOrP48fXH28+nl2fnLxbOL",
+	"i/MLxT3JoMi/8y2EcEtsgpLaBlWSF7A+qOulRWeuC3W9suQSfrhWj4RoEWpiKVRcOvJVXt0ifCje+WAb",
+	"64AXQouU1UivUDeMUNZoHhfqOpTEGHEb6xf5IxVj+nboK6mvJ+/8WE02GAJUOC7+3u5NoQOWHVtZv47j",
+	"PMvwIwIjx9VNWj3ftvBvby7TEIg79by37jjUIq3eRNAY0Mh0Mo316vTVmVoSq1+IjLokQwra1tkyq/Mo",
+	"agpCHBSUTCH00ys8judYSeN35xrBTlQC1oVeIYd81GwymzyJ2aAWPbRWz/XTyWzyVBe6BalTmFOIF8nJ",
+	"7rqrUA5Jv8RbDKLyLrW0HGSiEy4nxmcmEkLZ3UohHcLQoCAHPX+7D3nu3XoLSMtcUn27x9Tpuf7YIa91",
+	"oT2kRD/s0Hztjqp7N+p8fw19nm+eAP/Zczf6PsN5ydQM/D7lEjnKhL4YlLONlQGawSV0TvT8+1kx8rQZ",
+	"h6HlMuARnDGYq0Lfj6O4+dvZLE9LL/2Uf9BB0/chP3x24J/2ehs8ClMX73Vv3NmXbeys72ZPvhiL4b0w",
+	"cvgf6YFCbP9GMxhbqb22A+vtVcxV6JoGeJ3bUsED2tuRAs5tx0oUH6rYpTlAfRXRpzWCk/rBUBj2+6/J",
+	"/FON5Qf9P8UZ3oBBQLq02j026cNYhR6M8oOcnf+eM3WfjsxalT3tbdz5dww85ZVX41PrBZXglMEVOmob",
+	"9KLyXl3ojl0//ufTqYv7agoy/2E2e6I3V5t/BgD00jojlAwAAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,64 @@
+package controller
+
+import (
+	"net/http"
+
+	"good-todo-go/internal/pkg/cerror"
+	"good-todo-go/internal/presentation/admin/api"
+	"good-todo-go/internal/presentation/admin/presenter"
+	"good-todo-go/internal/usecase"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/labstack/echo/v4"
+)
+
+type AuditController struct {
+	auditUsecase   usecase.IAuditEventInteractor
+	auditPresenter presenter.IAuditPresenter
+}
+
+func NewAuditController(
+	auditUsecase usecase.IAuditEventInteractor,
+	auditPresenter presenter.IAuditPresenter,
+) *AuditController {
+	return &AuditController{
+		auditUsecase:   auditUsecase,
+		auditPresenter: auditPresenter,
+	}
+}
+
+func (c *AuditController) GetAuditEvents(ctx echo.Context, params api.GetAuditEventsParams) error {
+	limit := 50
+	offset := 0
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	if params.Offset != nil {
+		offset = *params.Offset
+	}
+
+	in := &input.ListAllAuditEventsInput{
+		TenantID: params.TenantId,
+		ActorID:  params.ActorId,
+		Action:   params.Action,
+		TargetID: params.TargetId,
+		From:     params.From,
+		To:       params.To,
+		Limit:    limit,
+		Offset:   offset,
+	}
+
+	out, err := c.auditUsecase.ListAllEvents(ctx.Request().Context(), in)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.auditPresenter.GetAuditEvents(ctx, out)
+}
+
+func handleError(err error) error {
+	if appErr, ok := err.(*cerror.AppError); ok {
+		return echo.NewHTTPError(appErr.HTTPStatus, appErr.Message)
+	}
+	return echo.NewHTTPError(http.StatusInternalServerError, "internal server error")
+}
@@ -0,0 +1,63 @@
+package presenter
+
+import (
+	"net/http"
+	"time"
+
+	"good-todo-go/internal/presentation/admin/api"
+	"good-todo-go/internal/usecase/output"
+
+	"github.com/labstack/echo/v4"
+)
+
+type IAuditPresenter interface {
+	GetAuditEvents(ctx echo.Context, out *output.AuditEventListOutput) error
+}
+
+type AuditPresenter struct{}
+
+func NewAuditPresenter() IAuditPresenter {
+	return &AuditPresenter{}
+}
+
+func (p *AuditPresenter) GetAuditEvents(ctx echo.Context, out *output.AuditEventListOutput) error {
+	events := make([]api.AuditEventResponse, len(out.Events))
+	for i, e := range out.Events {
+		events[i] = toAuditEventResponse(e)
+	}
+
+	return ctx.JSON(http.StatusOK, &api.AuditEventListResponse{
+		Events: events,
+		Total:  out.Total,
+	})
+}
+
+func toAuditEventResponse(out *output.AuditEventOutput) api.AuditEventResponse {
+	createdAt, _ := time.Parse(time.RFC3339, out.CreatedAt)
+
+	metadata := out.Metadata
+	if metadata == nil {
+		metadata = map[string]string{}
+	}
+
+	var targetType *api.AuditEventResponseTargetType
+	if out.TargetType != nil {
+		t := api.AuditEventResponseTargetType(*out.TargetType)
+		targetType = &t
+	}
+
+	return api.AuditEventResponse{
+		Id:         out.ID,
+		TenantId:   out.TenantID,
+		ActorType:  api.AuditEventResponseActorType(out.ActorType),
+		ActorId:    out.ActorID,
+		Action:     out.Action,
+		TargetType: targetType,
+		TargetId:   out.TargetID,
+		Ip:         out.IP,
+		UserAgent:  out.UserAgent,
+		RequestId:  out.RequestID,
+		Metadata:   metadata,
+		CreatedAt:  createdAt,
+	}
+}
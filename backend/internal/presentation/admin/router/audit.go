@@ -0,0 +1,11 @@
+package router
+
+import (
+	"good-todo-go/internal/presentation/admin/api"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (s *Server) GetAuditEvents(c echo.Context, params api.GetAuditEventsParams) error {
+	return s.auditController.GetAuditEvents(c, params)
+}
@@ -0,0 +1,33 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// AdminAuthMiddleware authenticates operators with the admin API token. The
+// health check is the only route that needs no token.
+func AdminAuthMiddleware(token string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if c.Request().Method == http.MethodOptions || c.Request().URL.Path == "/health" {
+				return next(c)
+			}
+
+			authHeader := c.Request().Header.Get("Authorization")
+			given := strings.TrimPrefix(authHeader, "Bearer ")
+			if authHeader == "" || given == authHeader {
+				return echo.NewHTTPError(http.StatusUnauthorized, "missing or invalid authorization header")
+			}
+
+			if subtle.ConstantTimeCompare([]byte(given), []byte(token)) != 1 {
+				return echo.NewHTTPError(http.StatusUnauthorized, "invalid admin token")
+			}
+
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package router
+
+import (
+	"context"
+	"errors"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"good-todo-go/internal/ent"
+	"good-todo-go/internal/infrastructure/database"
+	"good-todo-go/internal/infrastructure/environment"
+	"good-todo-go/internal/infrastructure/repository"
+	"good-todo-go/internal/pkg/cerror"
+	"good-todo-go/internal/presentation/admin/api"
+	"good-todo-go/internal/presentation/admin/controller"
+	"good-todo-go/internal/presentation/admin/presenter"
+	"good-todo-go/internal/presentation/admin/router/middleware"
+	"good-todo-go/internal/usecase"
+
+	"github.com/labstack/echo/v4"
+	echoMiddleware "github.com/labstack/echo/v4/middleware"
+)
+
+// Server serves the admin API. It is run by operators of the service, not by
+// tenants, and sees the data of every tenant.
+type Server struct {
+	auditController *controller.AuditController
+}
+
+func NewServer(auditController *controller.AuditController) *Server {
+	return &Server{
+		auditController: auditController,
+	}
+}
+
+func NewRouter() (*echo.Echo, *environment.Config, *ent.Client, error) {
+	cfg, err := environment.LoadConfig()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if cfg.AdminAPIToken == "" {
+		return nil, nil, nil, errors.New("ADMIN_API_TOKEN is not set")
+	}
+
+	client, err := database.NewEntClient(cfg)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	e := echo.New()
+	e.HTTPErrorHandler = cerror.CustomHTTPErrorHandler
+
+	e.Use(echoMiddleware.Recover())
+	e.Use(echoMiddleware.RequestID())
+	e.Use(middleware.AdminAuthMiddleware(cfg.AdminAPIToken))
+
+	server := NewServer(
+		controller.NewAuditController(
+			usecase.NewAuditEventInteractor(repository.NewAuditEventRepository(client)),
+			presenter.NewAuditPresenter(),
+		),
+	)
+	api.RegisterHandlers(e, server)
+
+	gracefulShutdown(e)
+
+	return e, cfg, client, nil
+}
+
+func gracefulShutdown(e *echo.Echo) {
+	shutdownCh := make(chan os.Signal, 1)
+	signal.Notify(shutdownCh, syscall.SIGINT, syscall.SIGTERM)
+
+	go func() {
+		sig := <-shutdownCh
+		log.Printf("Received signal %s, shutting down...", sig)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		if err := e.Shutdown(ctx); err != nil {
+			log.Printf("failed to shut down echo server gracefully: %v", err)
+			if err := e.Close(); err != nil {
+				log.Printf("failed to force close echo server: %v", err)
+			}
+		}
+	}()
+}
@@ -27,12 +27,256 @@ const (
 	BearerScopes = "Bearer.Scopes"
 )
 
+// Defines values for AccountDeletionPolicy.
+const (
+	Anonymize AccountDeletionPolicy = "anonymize"
+	Reassign  AccountDeletionPolicy = "reassign"
+)
+
+// Defines values for AuditEventResponseActorType.
+const (
+	AuditEventResponseActorTypeAnonymous AuditEventResponseActorType = "anonymous"
+	AuditEventResponseActorTypeScim      AuditEventResponseActorType = "scim"
+	AuditEventResponseActorTypeUser      AuditEventResponseActorType = "user"
+)
+
+// Defines values for AuditEventResponseTargetType.
+const (
+	AuditEventResponseTargetTypeTenant AuditEventResponseTargetType = "tenant"
+	AuditEventResponseTargetTypeUser   AuditEventResponseTargetType = "user"
+)
+
+// Defines values for BulkTodoRequestChildren.
+const (
+	BulkTodoRequestChildrenDelete BulkTodoRequestChildren = "delete"
+	BulkTodoRequestChildrenDetach BulkTodoRequestChildren = "detach"
+)
+
+// Defines values for BulkTodoRequestOperation.
+const (
+	BulkTodoRequestOperationAddTag        BulkTodoRequestOperation = "add_tag"
+	BulkTodoRequestOperationComplete      BulkTodoRequestOperation = "complete"
+	BulkTodoRequestOperationDelete        BulkTodoRequestOperation = "delete"
+	BulkTodoRequestOperationMoveProject   BulkTodoRequestOperation = "move_project"
+	BulkTodoRequestOperationReopen        BulkTodoRequestOperation = "reopen"
+	BulkTodoRequestOperationSetVisibility BulkTodoRequestOperation = "set_visibility"
+)
+
+// Defines values for CompletionStatsResponsePeriod.
+const (
+	CompletionStatsResponsePeriodToday CompletionStatsResponsePeriod = "today"
+	CompletionStatsResponsePeriodWeek  CompletionStatsResponsePeriod = "week"
+)
+
+// Defines values for CreateReminderRequestChannel.
+const (
+	CreateReminderRequestChannelEmail CreateReminderRequestChannel = "email"
+	CreateReminderRequestChannelInApp CreateReminderRequestChannel = "in_app"
+)
+
+// Defines values for ReminderResponseChannel.
+const (
+	ReminderResponseChannelEmail ReminderResponseChannel = "email"
+	ReminderResponseChannelInApp ReminderResponseChannel = "in_app"
+)
+
+// Defines values for ReminderResponseStatus.
+const (
+	Failed  ReminderResponseStatus = "failed"
+	Pending ReminderResponseStatus = "pending"
+	Sent    ReminderResponseStatus = "sent"
+)
+
+// Defines values for SearchLanguage.
+const (
+	Danish     SearchLanguage = "danish"
+	Dutch      SearchLanguage = "dutch"
+	English    SearchLanguage = "english"
+	Finnish    SearchLanguage = "finnish"
+	French     SearchLanguage = "french"
+	German     SearchLanguage = "german"
+	Hungarian  SearchLanguage = "hungarian"
+	Italian    SearchLanguage = "italian"
+	Norwegian  SearchLanguage = "norwegian"
+	Portuguese SearchLanguage = "portuguese"
+	Romanian   SearchLanguage = "romanian"
+	Russian    SearchLanguage = "russian"
+	Simple     SearchLanguage = "simple"
+	Spanish    SearchLanguage = "spanish"
+	Swedish    SearchLanguage = "swedish"
+	Turkish    SearchLanguage = "turkish"
+)
+
+// Defines values for TodoPermission.
+const (
+	Edit TodoPermission = "edit"
+	Read TodoPermission = "read"
+)
+
+// Defines values for TodoPriority.
+const (
+	High   TodoPriority = "high"
+	Low    TodoPriority = "low"
+	Medium TodoPriority = "medium"
+	None   TodoPriority = "none"
+	Urgent TodoPriority = "urgent"
+)
+
+// Defines values for TodoRevisionOperation.
+const (
+	TodoRevisionOperationCreate  TodoRevisionOperation = "create"
+	TodoRevisionOperationDelete  TodoRevisionOperation = "delete"
+	TodoRevisionOperationPurge   TodoRevisionOperation = "purge"
+	TodoRevisionOperationRestore TodoRevisionOperation = "restore"
+	TodoRevisionOperationUpdate  TodoRevisionOperation = "update"
+)
+
 // Defines values for UserResponseRole.
 const (
 	Admin  UserResponseRole = "admin"
 	Member UserResponseRole = "member"
 )
 
+// Defines values for GetTodosParamsSort.
+const (
+	GetTodosParamsSortCreatedAt GetTodosParamsSort = "created_at"
+	GetTodosParamsSortDueDate   GetTodosParamsSort = "due_date"
+	GetTodosParamsSortPosition  GetTodosParamsSort = "position"
+	GetTodosParamsSortPriority  GetTodosParamsSort = "priority"
+	GetTodosParamsSortTitle     GetTodosParamsSort = "title"
+	GetTodosParamsSortUpdatedAt GetTodosParamsSort = "updated_at"
+)
+
+// Defines values for GetTodosParamsOrder.
+const (
+	GetTodosParamsOrderAsc  GetTodosParamsOrder = "asc"
+	GetTodosParamsOrderDesc GetTodosParamsOrder = "desc"
+)
+
+// Defines values for GetAssignedTodosParamsSort.
+const (
+	GetAssignedTodosParamsSortCreatedAt GetAssignedTodosParamsSort = "created_at"
+	GetAssignedTodosParamsSortDueDate   GetAssignedTodosParamsSort = "due_date"
+	GetAssignedTodosParamsSortPosition  GetAssignedTodosParamsSort = "position"
+	GetAssignedTodosParamsSortPriority  GetAssignedTodosParamsSort = "priority"
+	GetAssignedTodosParamsSortTitle     GetAssignedTodosParamsSort = "title"
+	GetAssignedTodosParamsSortUpdatedAt GetAssignedTodosParamsSort = "updated_at"
+)
+
+// Defines values for GetAssignedTodosParamsOrder.
+const (
+	GetAssignedTodosParamsOrderAsc  GetAssignedTodosParamsOrder = "asc"
+	GetAssignedTodosParamsOrderDesc GetAssignedTodosParamsOrder = "desc"
+)
+
+// Defines values for GetTodoCompletionStatsParamsPeriod.
+const (
+	GetTodoCompletionStatsParamsPeriodToday GetTodoCompletionStatsParamsPeriod = "today"
+	GetTodoCompletionStatsParamsPeriodWeek  GetTodoCompletionStatsParamsPeriod = "week"
+)
+
+// Defines values for ExportTodosParamsComponent.
+const (
+	Vevent ExportTodosParamsComponent = "vevent"
+	Vtodo  ExportTodosParamsComponent = "vtodo"
+)
+
+// Defines values for GetSharedTodosParamsSort.
+const (
+	CreatedAt GetSharedTodosParamsSort = "created_at"
+	DueDate   GetSharedTodosParamsSort = "due_date"
+	Position  GetSharedTodosParamsSort = "position"
+	Priority  GetSharedTodosParamsSort = "priority"
+	Title     GetSharedTodosParamsSort = "title"
+	UpdatedAt GetSharedTodosParamsSort = "updated_at"
+)
+
+// Defines values for GetSharedTodosParamsOrder.
+const (
+	Asc  GetSharedTodosParamsOrder = "asc"
+	Desc GetSharedTodosParamsOrder = "desc"
+)
+
+// Defines values for DeleteTodoParamsChildren.
+const (
+	DeleteTodoParamsChildrenDelete DeleteTodoParamsChildren = "delete"
+	DeleteTodoParamsChildrenDetach DeleteTodoParamsChildren = "detach"
+)
+
+// AccountDeletionPolicy Who takes over a deleted member's public todos. Private todos are always deleted.
+// anonymize hands them to a "Deleted user" placeholder; reassign hands them to
+// another tenant admin, falling back to the placeholder if there is none.
+type AccountDeletionPolicy string
+
+// AccountExportResponse defines model for AccountExportResponse.
+type AccountExportResponse struct {
+	ExportedAt time.Time      `json:"exported_at"`
+	Todos      []TodoResponse `json:"todos"`
+	User       UserResponse   `json:"user"`
+}
+
+// AssignTodoRequest defines model for AssignTodoRequest.
+type AssignTodoRequest struct {
+	// AssigneeId Member of the same tenant; null unassigns the todo
+	AssigneeId *string `json:"assignee_id"`
+}
+
+// AttachmentListResponse defines model for AttachmentListResponse.
+type AttachmentListResponse struct {
+	Attachments []AttachmentResponse `json:"attachments"`
+}
+
+// AttachmentResponse defines model for AttachmentResponse.
+type AttachmentResponse struct {
+	// ContentType Detected from the file contents
+	ContentType string    `json:"content_type"`
+	CreatedAt   time.Time `json:"created_at"`
+	FileName    string    `json:"file_name"`
+	Id          string    `json:"id"`
+
+	// Size Size in bytes
+	Size   int64  `json:"size"`
+	TodoId string `json:"todo_id"`
+
+	// UploadedBy ID of the user who uploaded the file
+	UploadedBy string `json:"uploaded_by"`
+}
+
+// AuditEventListResponse defines model for AuditEventListResponse.
+type AuditEventListResponse struct {
+	Events []AuditEventResponse `json:"events"`
+	Total  int                  `json:"total"`
+}
+
+// AuditEventResponse defines model for AuditEventResponse.
+type AuditEventResponse struct {
+	// Action What happened, e.g. auth.login_failed or user.role_changed
+	Action string `json:"action"`
+
+	// ActorId The acting user, when the actor is a user
+	ActorId *string `json:"actor_id,omitempty"`
+
+	// ActorType A signed-in user, the tenant's identity provider, or an unauthenticated caller
+	ActorType AuditEventResponseActorType `json:"actor_type"`
+	CreatedAt time.Time                   `json:"created_at"`
+	Id        string                      `json:"id"`
+	Ip        string                      `json:"ip"`
+
+	// Metadata Action-specific details, e.g. the old and new role
+	Metadata   map[string]string             `json:"metadata"`
+	RequestId  string                        `json:"request_id"`
+	TargetId   *string                       `json:"target_id,omitempty"`
+	TargetType *AuditEventResponseTargetType `json:"target_type,omitempty"`
+	TenantId   string                        `json:"tenant_id"`
+	UserAgent  string                        `json:"user_agent"`
+}
+
+// AuditEventResponseActorType A signed-in user, the tenant's identity provider, or an unauthenticated caller
+type AuditEventResponseActorType string
+
+// AuditEventResponseTargetType defines model for AuditEventResponse.TargetType.
+type AuditEventResponseTargetType string
+
 // AuthResponse defines model for AuthResponse.
 type AuthResponse struct {
 	AccessToken *string `json:"access_token,omitempty"`
@@ -44,19 +288,204 @@ type AuthResponse struct {
 	User         *UserResponse `json:"user,omitempty"`
 }
 
+// BulkTodoFilter Matches the caller's own todos like the todo list filters
+type BulkTodoFilter struct {
+	Completed *bool      `json:"completed,omitempty"`
+	DueAfter  *time.Time `json:"due_after,omitempty"`
+	DueBefore *time.Time `json:"due_before,omitempty"`
+	IsPublic  *bool      `json:"is_public,omitempty"`
+	Overdue   *bool      `json:"overdue,omitempty"`
+	ProjectId *string    `json:"project_id,omitempty"`
+	Q         *string    `json:"q,omitempty"`
+
+	// Tags Only match todos that carry every listed tag
+	Tags *[]string `json:"tags,omitempty"`
+}
+
+// BulkTodoRequest Exactly one of todo_ids and filter must be set
+type BulkTodoRequest struct {
+	// CascadeCompletion With complete, also complete all open subtasks of each todo
+	CascadeCompletion *bool `json:"cascade_completion,omitempty"`
+
+	// Children How delete handles subtasks, like on DELETE /todos/{todoId}
+	Children *BulkTodoRequestChildren `json:"children,omitempty"`
+
+	// Filter Matches the caller's own todos like the todo list filters
+	Filter *BulkTodoFilter `json:"filter,omitempty"`
+
+	// IsPublic Required by set_visibility
+	IsPublic  *bool                    `json:"is_public,omitempty"`
+	Operation BulkTodoRequestOperation `json:"operation"`
+
+	// ProjectId Destination project for move_project; null or absent takes the todos out of their project
+	ProjectId *string `json:"project_id"`
+
+	// TagId Required by add_tag
+	TagId   *string   `json:"tag_id,omitempty"`
+	TodoIds *[]string `json:"todo_ids,omitempty"`
+}
+
+// BulkTodoRequestChildren How delete handles subtasks, like on DELETE /todos/{todoId}
+type BulkTodoRequestChildren string
+
+// BulkTodoRequestOperation defines model for BulkTodoRequest.Operation.
+type BulkTodoRequestOperation string
+
+// BulkTodoResponse defines model for BulkTodoResponse.
+type BulkTodoResponse struct {
+	Failed int `json:"failed"`
+
+	// Results One entry per todo, in request order for todo_ids and newest first for filter
+	Results   []BulkTodoResult `json:"results"`
+	Succeeded int              `json:"succeeded"`
+}
+
+// BulkTodoResult defines model for BulkTodoResult.
+type BulkTodoResult struct {
+	Error  *ErrorResponse `json:"error,omitempty"`
+	TodoId string         `json:"todo_id"`
+}
+
+// CalendarTokenResponse defines model for CalendarTokenResponse.
+type CalendarTokenResponse struct {
+	// FeedPath Path of the feed relative to the API's base URL, e.g.
+	// /calendar/cal_....ics. Add ?component=vtodo to get tasks instead of
+	// events.
+	FeedPath string `json:"feed_path"`
+
+	// Token Secret of the calendar feed URL. It cannot be retrieved again.
+	Token string `json:"token"`
+}
+
+// ChangePasswordRequest defines model for ChangePasswordRequest.
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password"`
+
+	// NewPassword Must satisfy the password policy (length, strength, not based on user info, not breached)
+	NewPassword string `json:"new_password"`
+}
+
+// CommentAuthor defines model for CommentAuthor.
+type CommentAuthor struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// CommentListResponse defines model for CommentListResponse.
+type CommentListResponse struct {
+	Comments []CommentResponse `json:"comments"`
+}
+
+// CommentRequest defines model for CommentRequest.
+type CommentRequest struct {
+	// Body Markdown
+	Body string `json:"body"`
+}
+
+// CommentResponse defines model for CommentResponse.
+type CommentResponse struct {
+	Author *CommentAuthor `json:"author,omitempty"`
+
+	// Body Markdown; empty when the comment was deleted
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// Deleted Deleted comments keep their place in the thread
+	Deleted  bool       `json:"deleted"`
+	Edited   bool       `json:"edited"`
+	EditedAt *time.Time `json:"edited_at,omitempty"`
+	Id       string     `json:"id"`
+	TodoId   string     `json:"todo_id"`
+}
+
+// CompletionStatsResponse defines model for CompletionStatsResponse.
+type CompletionStatsResponse struct {
+	Days   []DailyCompletion             `json:"days"`
+	From   time.Time                     `json:"from"`
+	Period CompletionStatsResponsePeriod `json:"period"`
+	To     time.Time                     `json:"to"`
+	Total  int                           `json:"total"`
+}
+
+// CompletionStatsResponsePeriod defines model for CompletionStatsResponse.Period.
+type CompletionStatsResponsePeriod string
+
+// ConsumeMagicLinkRequest defines model for ConsumeMagicLinkRequest.
+type ConsumeMagicLinkRequest struct {
+	TenantSlug string `json:"tenant_slug"`
+	Token      string `json:"token"`
+}
+
+// CreateProjectRequest defines model for CreateProjectRequest.
+type CreateProjectRequest struct {
+	Description *string `json:"description,omitempty"`
+
+	// IsPublic If true, visible to all users in the same tenant
+	IsPublic *bool  `json:"is_public,omitempty"`
+	Name     string `json:"name"`
+}
+
+// CreateReminderRequest Exactly one of remind_at and offset_minutes must be set
+type CreateReminderRequest struct {
+	// Channel Defaults to in_app
+	Channel *CreateReminderRequestChannel `json:"channel,omitempty"`
+
+	// OffsetMinutes Minutes before the due date; moves with the due date
+	OffsetMinutes *int `json:"offset_minutes,omitempty"`
+
+	// RemindAt Absolute time in the future
+	RemindAt *time.Time `json:"remind_at,omitempty"`
+}
+
+// CreateReminderRequestChannel Defaults to in_app
+type CreateReminderRequestChannel string
+
+// CreateTagRequest defines model for CreateTagRequest.
+type CreateTagRequest struct {
+	// Color Defaults to "#808080"
+	Color *string `json:"color,omitempty"`
+
+	// Name Unique within the tenant, ignoring case
+	Name string `json:"name"`
+}
+
 // CreateTodoRequest defines model for CreateTodoRequest.
 type CreateTodoRequest struct {
 	Description *string    `json:"description,omitempty"`
 	DueDate     *time.Time `json:"due_date,omitempty"`
 
 	// IsPublic If true, visible to all users in the same tenant
-	IsPublic *bool  `json:"is_public,omitempty"`
-	Title    string `json:"title"`
+	IsPublic *bool `json:"is_public,omitempty"`
+
+	// ParentId Another of the current user's todos to nest this one under
+	ParentId *string       `json:"parent_id,omitempty"`
+	Priority *TodoPriority `json:"priority,omitempty"`
+
+	// ProjectId One of the current user's active projects
+	ProjectId  *string                `json:"project_id,omitempty"`
+	Recurrence *TodoRecurrenceRequest `json:"recurrence,omitempty"`
+	Title      string                 `json:"title"`
+}
+
+// DailyCompletion defines model for DailyCompletion.
+type DailyCompletion struct {
+	Count int                `json:"count"`
+	Date  openapi_types.Date `json:"date"`
+}
+
+// DeleteAccountRequest defines model for DeleteAccountRequest.
+type DeleteAccountRequest struct {
+	// Password The current password, to confirm the deletion
+	Password string `json:"password"`
 }
 
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
-	Code    *string                 `json:"code,omitempty"`
+	Code *string `json:"code,omitempty"`
+
+	// Details Structured information about the error. For VALIDATION_ERROR responses on
+	// passwords this contains `field`, `violations` (too_short, too_long, too_weak,
+	// similar_to_user_info, breached), `score`, `min_score` and `min_length`.
 	Details *map[string]interface{} `json:"details,omitempty"`
 	Message *string                 `json:"message,omitempty"`
 }
@@ -68,6 +497,67 @@ type LoginRequest struct {
 	TenantSlug string              `json:"tenant_slug"`
 }
 
+// MagicLinkRequest defines model for MagicLinkRequest.
+type MagicLinkRequest struct {
+	Email      openapi_types.Email `json:"email"`
+	TenantSlug string              `json:"tenant_slug"`
+}
+
+// MoveTodoRequest defines model for MoveTodoRequest.
+type MoveTodoRequest struct {
+	// ProjectId Destination project; null takes the todo out of its project
+	ProjectId *string `json:"project_id"`
+}
+
+// NotificationListResponse defines model for NotificationListResponse.
+type NotificationListResponse struct {
+	Notifications []NotificationResponse `json:"notifications"`
+}
+
+// NotificationResponse defines model for NotificationResponse.
+type NotificationResponse struct {
+	Body      string     `json:"body"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	Id        string     `json:"id"`
+	ReadAt    *time.Time `json:"read_at,omitempty"`
+	Title     string     `json:"title"`
+	TodoId    *string    `json:"todo_id,omitempty"`
+}
+
+// PasswordResetRequest defines model for PasswordResetRequest.
+type PasswordResetRequest struct {
+	Email      openapi_types.Email `json:"email"`
+	TenantSlug string              `json:"tenant_slug"`
+}
+
+// ProjectListResponse defines model for ProjectListResponse.
+type ProjectListResponse struct {
+	Projects []ProjectResponse `json:"projects"`
+}
+
+// ProjectResponse defines model for ProjectResponse.
+type ProjectResponse struct {
+	Archived   bool       `json:"archived"`
+	ArchivedAt *time.Time `json:"archived_at"`
+
+	// CompletedCount Completed todos in the project that the current user can see
+	CompletedCount int        `json:"completed_count"`
+	CreatedAt      *time.Time `json:"created_at,omitempty"`
+	Description    string     `json:"description"`
+	Id             string     `json:"id"`
+
+	// IsPublic If true, visible to all users in the same tenant
+	IsPublic bool   `json:"is_public"`
+	Name     string `json:"name"`
+
+	// OpenCount Incomplete todos in the project that the current user can see
+	OpenCount int        `json:"open_count"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+
+	// UserId The ID of the user who owns this project
+	UserId string `json:"user_id"`
+}
+
 // RefreshTokenRequest defines model for RefreshTokenRequest.
 type RefreshTokenRequest struct {
 	RefreshToken string `json:"refresh_token"`
@@ -75,2265 +565,14152 @@ type RefreshTokenRequest struct {
 
 // RegisterRequest defines model for RegisterRequest.
 type RegisterRequest struct {
-	Email    openapi_types.Email `json:"email"`
-	Name     *string             `json:"name,omitempty"`
-	Password string              `json:"password"`
+	Email openapi_types.Email `json:"email"`
+	Name  *string             `json:"name,omitempty"`
+
+	// Password Must satisfy the password policy (length, strength, not based on user info, not breached)
+	Password string `json:"password"`
 
 	// TenantSlug Tenant identifier (slug)
 	TenantSlug string `json:"tenant_slug"`
 }
 
-// TodoCreator defines model for TodoCreator.
-type TodoCreator struct {
-	Id   string `json:"id"`
-	Name string `json:"name"`
+// ReminderListResponse defines model for ReminderListResponse.
+type ReminderListResponse struct {
+	Reminders []ReminderResponse `json:"reminders"`
 }
 
-// TodoListResponse defines model for TodoListResponse.
-type TodoListResponse struct {
-	Todos *[]TodoResponse `json:"todos,omitempty"`
-	Total *int            `json:"total,omitempty"`
-}
+// ReminderResponse defines model for ReminderResponse.
+type ReminderResponse struct {
+	Channel   ReminderResponseChannel `json:"channel"`
+	CreatedAt *time.Time              `json:"created_at,omitempty"`
+	Id        string                  `json:"id"`
 
-// TodoResponse defines model for TodoResponse.
-type TodoResponse struct {
-	Completed   *bool        `json:"completed,omitempty"`
-	CompletedAt *time.Time   `json:"completed_at"`
-	CreatedAt   *time.Time   `json:"created_at,omitempty"`
-	CreatedBy   *TodoCreator `json:"created_by,omitempty"`
-	Description *string      `json:"description,omitempty"`
-	DueDate     *time.Time   `json:"due_date"`
-	Id          *string      `json:"id,omitempty"`
+	// OffsetMinutes Set when the reminder follows the todo's due date
+	OffsetMinutes *int                   `json:"offset_minutes,omitempty"`
+	RemindAt      time.Time              `json:"remind_at"`
+	SentAt        *time.Time             `json:"sent_at,omitempty"`
+	Status        ReminderResponseStatus `json:"status"`
+	TodoId        string                 `json:"todo_id"`
+}
 
-	// IsPublic If true, visible to all users in the same tenant
-	IsPublic  *bool      `json:"is_public,omitempty"`
-	Title     *string    `json:"title,omitempty"`
-	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+// ReminderResponseChannel defines model for ReminderResponse.Channel.
+type ReminderResponseChannel string
 
-	// UserId The ID of the user who created this todo
-	UserId *string `json:"user_id,omitempty"`
-}
+// ReminderResponseStatus defines model for ReminderResponse.Status.
+type ReminderResponseStatus string
 
-// UpdateTodoRequest defines model for UpdateTodoRequest.
-type UpdateTodoRequest struct {
-	Completed   *bool      `json:"completed,omitempty"`
-	Description *string    `json:"description,omitempty"`
-	DueDate     *time.Time `json:"due_date"`
+// ReorderTodoRequest Exactly one of before and after must be set
+type ReorderTodoRequest struct {
+	// After Place the todo directly after this one of the caller's todos
+	After *string `json:"after,omitempty"`
 
-	// IsPublic If true, visible to all users in the same tenant
-	IsPublic *bool   `json:"is_public,omitempty"`
-	Title    *string `json:"title,omitempty"`
+	// Before Place the todo directly before this one of the caller's todos
+	Before *string `json:"before,omitempty"`
 }
 
-// UpdateUserRequest defines model for UpdateUserRequest.
-type UpdateUserRequest struct {
-	Name *string `json:"name,omitempty"`
+// ResetPasswordRequest defines model for ResetPasswordRequest.
+type ResetPasswordRequest struct {
+	// NewPassword Must satisfy the password policy (length, strength, not based on user info, not breached)
+	NewPassword string `json:"new_password"`
+	TenantSlug  string `json:"tenant_slug"`
+	Token       string `json:"token"`
 }
 
-// UserResponse defines model for UserResponse.
-type UserResponse struct {
-	CreatedAt     *time.Time        `json:"created_at,omitempty"`
-	Email         *string           `json:"email,omitempty"`
-	EmailVerified *bool             `json:"email_verified,omitempty"`
-	Id            *string           `json:"id,omitempty"`
-	Name          *string           `json:"name,omitempty"`
-	Role          *UserResponseRole `json:"role,omitempty"`
-	TenantId      *string           `json:"tenant_id,omitempty"`
-	UpdatedAt     *time.Time        `json:"updated_at,omitempty"`
+// ScimTokenResponse defines model for ScimTokenResponse.
+type ScimTokenResponse struct {
+	// Token Bearer token for /scim/v2. It cannot be retrieved again.
+	Token string `json:"token"`
 }
 
-// UserResponseRole defines model for UserResponse.Role.
-type UserResponseRole string
+// SearchLanguage Text search configuration for todo search. simple matches words as written;
+// the others add stemming and stop words for that language. Changing it
+// re-indexes all of the tenant's todos.
+type SearchLanguage string
 
-// VerifyEmailRequest defines model for VerifyEmailRequest.
-type VerifyEmailRequest struct {
-	Token string `json:"token"`
+// SetTodoParentRequest defines model for SetTodoParentRequest.
+type SetTodoParentRequest struct {
+	// ParentId New parent todo; null makes the todo a top-level todo
+	ParentId *string `json:"parent_id"`
 }
 
-// GetTodosParams defines parameters for GetTodos.
-type GetTodosParams struct {
-	// Completed Filter by completion status
-	Completed *bool `form:"completed,omitempty" json:"completed,omitempty"`
-	Limit     *int  `form:"limit,omitempty" json:"limit,omitempty"`
-	Offset    *int  `form:"offset,omitempty" json:"offset,omitempty"`
+// ShareTodoRequest defines model for ShareTodoRequest.
+type ShareTodoRequest struct {
+	// Permission read lets the member view the todo; edit also lets them change its title,
+	// description, completion, due date and priority
+	Permission TodoPermission `json:"permission"`
 }
 
-// GetPublicTodosParams defines parameters for GetPublicTodos.
-type GetPublicTodosParams struct {
-	Limit  *int `form:"limit,omitempty" json:"limit,omitempty"`
-	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+// TagListResponse defines model for TagListResponse.
+type TagListResponse struct {
+	Tags []TagResponse `json:"tags"`
 }
 
-// LoginJSONRequestBody defines body for Login for application/json ContentType.
-type LoginJSONRequestBody = LoginRequest
+// TagResponse defines model for TagResponse.
+type TagResponse struct {
+	// Color Hex color, e.g. "#808080"
+	Color     string     `json:"color"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	Id        string     `json:"id"`
+	Name      string     `json:"name"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
 
-// RefreshTokenJSONRequestBody defines body for RefreshToken for application/json ContentType.
-type RefreshTokenJSONRequestBody = RefreshTokenRequest
+// TenantSettingsResponse defines model for TenantSettingsResponse.
+type TenantSettingsResponse struct {
+	// AccountDeletionPolicy Who takes over a deleted member's public todos. Private todos are always deleted.
+	// anonymize hands them to a "Deleted user" placeholder; reassign hands them to
+	// another tenant admin, falling back to the placeholder if there is none.
+	AccountDeletionPolicy AccountDeletionPolicy `json:"account_deletion_policy"`
 
-// RegisterJSONRequestBody defines body for Register for application/json ContentType.
-type RegisterJSONRequestBody = RegisterRequest
+	// MagicLinkEnabled Whether members can sign in with an emailed magic link
+	MagicLinkEnabled bool `json:"magic_link_enabled"`
 
-// VerifyEmailJSONRequestBody defines body for VerifyEmail for application/json ContentType.
-type VerifyEmailJSONRequestBody = VerifyEmailRequest
+	// SearchLanguage Text search configuration for todo search. simple matches words as written;
+	// the others add stemming and stop words for that language. Changing it
+	// re-indexes all of the tenant's todos.
+	SearchLanguage SearchLanguage `json:"search_language"`
 
-// UpdateMeJSONRequestBody defines body for UpdateMe for application/json ContentType.
-type UpdateMeJSONRequestBody = UpdateUserRequest
+	// TrashRetentionDays Days a deleted todo stays in the trash before it is removed for good
+	TrashRetentionDays int `json:"trash_retention_days"`
+}
 
-// CreateTodoJSONRequestBody defines body for CreateTodo for application/json ContentType.
-type CreateTodoJSONRequestBody = CreateTodoRequest
+// TodoAssignment defines model for TodoAssignment.
+type TodoAssignment struct {
+	// AssigneeId Null when the todo was unassigned
+	AssigneeId *string `json:"assignee_id"`
 
-// UpdateTodoJSONRequestBody defines body for UpdateTodo for application/json ContentType.
-type UpdateTodoJSONRequestBody = UpdateTodoRequest
+	// ChangedBy The user who made the change
+	ChangedBy          string    `json:"changed_by"`
+	CreatedAt          time.Time `json:"created_at"`
+	Id                 string    `json:"id"`
+	PreviousAssigneeId *string   `json:"previous_assignee_id"`
+}
 
-// RequestEditorFn  is the function signature for the RequestEditor callback function
-type RequestEditorFn func(ctx context.Context, req *http.Request) error
+// TodoAssignmentListResponse defines model for TodoAssignmentListResponse.
+type TodoAssignmentListResponse struct {
+	Assignments []TodoAssignment `json:"assignments"`
+}
 
-// Doer performs HTTP requests.
-//
-// The standard http.Client implements this interface.
-type HttpRequestDoer interface {
-	Do(req *http.Request) (*http.Response, error)
+// TodoCreator defines model for TodoCreator.
+type TodoCreator struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
 }
 
-// Client which conforms to the OpenAPI3 specification for this service.
-type Client struct {
-	// The endpoint of the server conforming to this interface, with scheme,
-	// https://api.deepmap.com for example. This can contain a path relative
-	// to the server, such as https://api.deepmap.com/dev-test, and all the
-	// paths in the swagger spec will be appended to the server.
-	Server string
+// TodoFieldChange defines model for TodoFieldChange.
+type TodoFieldChange struct {
+	// Field One of user_id, title, description, completed, completed_at,
+	// is_public, priority, due_date, assignee_id, project_id, parent_id,
+	// recurrence_rule, recurrence_timezone or recurrence_start. user_id
+	// only changes when the owner's account is deleted and the todo goes
+	// to another member
+	Field string `json:"field"`
 
-	// Doer for performing requests, typically a *http.Client with any
-	// customized settings, such as certificate chains.
-	Client HttpRequestDoer
+	// New Value after the change; null when unset
+	New interface{} `json:"new"`
 
-	// A list of callbacks for modifying requests which are generated before sending over
-	// the network.
-	RequestEditors []RequestEditorFn
+	// Old Value before the change; null when unset and on create
+	Old interface{} `json:"old"`
 }
 
-// ClientOption allows setting custom parameters during construction
-type ClientOption func(*Client) error
+// TodoListResponse defines model for TodoListResponse.
+type TodoListResponse struct {
+	// NextCursor Pass as cursor to fetch the next page; absent on the last page
+	NextCursor *string         `json:"next_cursor"`
+	Todos      *[]TodoResponse `json:"todos,omitempty"`
 
-// Creates a new Client, with reasonable defaults
-func NewClient(server string, opts ...ClientOption) (*Client, error) {
-	// create a client with sane default values
-	client := Client{
-		Server: server,
-	}
-	// mutate client and add all optional params
-	for _, o := range opts {
-		if err := o(&client); err != nil {
-			return nil, err
-		}
-	}
-	// ensure the server URL always has a trailing slash
-	if !strings.HasSuffix(client.Server, "/") {
-		client.Server += "/"
-	}
-	// create httpClient, if not already present
-	if client.Client == nil {
-		client.Client = &http.Client{}
-	}
-	return &client, nil
+	// Total Number of matching todos; omitted unless requested with include_total
+	Total *int `json:"total,omitempty"`
 }
 
-// WithHTTPClient allows overriding the default Doer, which is
-// automatically created using http.Client. This is useful for tests.
-func WithHTTPClient(doer HttpRequestDoer) ClientOption {
-	return func(c *Client) error {
-		c.Client = doer
-		return nil
-	}
-}
+// TodoMergePatch JSON merge patch (RFC 7396) of a todo. Fields left out stay as they are;
+// null clears a nullable field. Any other field is rejected.
+type TodoMergePatch struct {
+	// Completed Completing a recurring todo creates its next occurrence
+	Completed   *bool   `json:"completed,omitempty"`
+	Description *string `json:"description,omitempty"`
 
-// WithRequestEditorFn allows setting up a callback function, which will be
-// called right before sending the request. This can be used to mutate the request.
-func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
-	return func(c *Client) error {
-		c.RequestEditors = append(c.RequestEditors, fn)
-		return nil
-	}
+	// DueDate Cannot be cleared while the todo recurs
+	DueDate  *time.Time `json:"due_date"`
+	IsPublic *bool      `json:"is_public,omitempty"`
+
+	// ParentId Null makes the todo a top-level todo
+	ParentId  *string       `json:"parent_id"`
+	Priority  *TodoPriority `json:"priority,omitempty"`
+	ProjectId *string       `json:"project_id"`
+	Title     *string       `json:"title,omitempty"`
 }
 
-// The interface specification for the client above.
-type ClientInterface interface {
-	// LoginWithBody request with any body
-	LoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+// TodoPermission read lets the member view the todo; edit also lets them change its title,
+// description, completion, due date and priority
+type TodoPermission string
 
-	Login(ctx context.Context, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+// TodoPriority defines model for TodoPriority.
+type TodoPriority string
 
-	// RefreshTokenWithBody request with any body
-	RefreshTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+// TodoProgress Completion of the direct subtasks; absent when the todo has none
+type TodoProgress struct {
+	Completed int `json:"completed"`
 
-	RefreshToken(ctx context.Context, body RefreshTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Ratio completed / total, between 0 and 1
+	Ratio float64 `json:"ratio"`
+	Total int     `json:"total"`
+}
 
-	// RegisterWithBody request with any body
-	RegisterWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+// TodoRecurrence Present while the todo is the open occurrence of a recurring series
+type TodoRecurrence struct {
+	// Rule RFC 5545 RRULE in canonical form
+	Rule string `json:"rule"`
 
-	Register(ctx context.Context, body RegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Start Due date of the first occurrence; the rule is anchored on it
+	Start time.Time `json:"start"`
 
-	// VerifyEmailWithBody request with any body
-	VerifyEmailWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Timezone IANA timezone the rule is evaluated in
+	Timezone string `json:"timezone"`
+}
 
-	VerifyEmail(ctx context.Context, body VerifyEmailJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+// TodoRecurrenceRequest defines model for TodoRecurrenceRequest.
+type TodoRecurrenceRequest struct {
+	// Rule RFC 5545 RRULE. Supported parts are FREQ (DAILY, WEEKLY, MONTHLY, YEARLY),
+	// INTERVAL, BYDAY (numbered entries like -1FR only with MONTHLY), and COUNT or UNTIL.
+	// The todo's due date is the first occurrence.
+	Rule string `json:"rule"`
 
-	// HealthCheck request
-	HealthCheck(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Timezone IANA timezone whose wall clock the rule is evaluated in
+	Timezone *string `json:"timezone,omitempty"`
+}
 
-	// GetMe request
-	GetMe(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+// TodoResponse defines model for TodoResponse.
+type TodoResponse struct {
+	// AssigneeId Member of the tenant the todo is assigned to; they may view and complete it
+	AssigneeId *string `json:"assignee_id"`
 
-	// UpdateMeWithBody request with any body
-	UpdateMeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Children Direct subtasks, oldest first; only included in the todo detail
+	Children *[]TodoResponse `json:"children,omitempty"`
 
-	UpdateMe(ctx context.Context, body UpdateMeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// CommentCount Number of comments, not counting deleted ones
+	CommentCount *int         `json:"comment_count,omitempty"`
+	Completed    *bool        `json:"completed,omitempty"`
+	CompletedAt  *time.Time   `json:"completed_at"`
+	CreatedAt    *time.Time   `json:"created_at,omitempty"`
+	CreatedBy    *TodoCreator `json:"created_by,omitempty"`
 
-	// GetTodos request
-	GetTodos(ctx context.Context, params *GetTodosParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// DeletedAt When the todo was moved to the trash; only set on todos in the trash
+	DeletedAt   *time.Time `json:"deleted_at"`
+	Description *string    `json:"description,omitempty"`
+	DueDate     *time.Time `json:"due_date"`
+	Id          *string    `json:"id,omitempty"`
 
-	// CreateTodoWithBody request with any body
-	CreateTodoWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// IsPublic If true, visible to all users in the same tenant
+	IsPublic       *bool         `json:"is_public,omitempty"`
+	NextOccurrence *TodoResponse `json:"next_occurrence,omitempty"`
 
-	CreateTodo(ctx context.Context, body CreateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// ParentId The todo this one is a subtask of, if any
+	ParentId *string `json:"parent_id"`
 
-	// GetPublicTodos request
-	GetPublicTodos(ctx context.Context, params *GetPublicTodosParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Position Key of the todo in its owner's manual order; compare keys byte by byte
+	Position *string       `json:"position,omitempty"`
+	Priority *TodoPriority `json:"priority,omitempty"`
 
-	// DeleteTodo request
-	DeleteTodo(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Progress Completion of the direct subtasks; absent when the todo has none
+	Progress *TodoProgress `json:"progress,omitempty"`
 
-	// GetTodo request
-	GetTodo(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// ProjectId The project this todo belongs to, if any
+	ProjectId *string `json:"project_id"`
 
-	// UpdateTodoWithBody request with any body
-	UpdateTodoWithBody(ctx context.Context, todoId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Recurrence Present while the todo is the open occurrence of a recurring series
+	Recurrence *TodoRecurrence `json:"recurrence,omitempty"`
 
-	UpdateTodo(ctx context.Context, todoId string, body UpdateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
-}
+	// Tags Attached tags sorted by name
+	Tags      *[]TagResponse `json:"tags,omitempty"`
+	Title     *string        `json:"title,omitempty"`
+	UpdatedAt *time.Time     `json:"updated_at,omitempty"`
 
-func (c *Client) LoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewLoginRequestWithBody(c.Server, contentType, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+	// UserId The ID of the user who created this todo
+	UserId *string `json:"user_id,omitempty"`
+
+	// Version Goes up with every change; the ETag of the todo is this number in quotes
+	Version *int `json:"version,omitempty"`
 }
 
-func (c *Client) Login(ctx context.Context, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewLoginRequest(c.Server, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// TodoRevision defines model for TodoRevision.
+type TodoRevision struct {
+	// ActorId The user who made the change; null for changes made by the system
+	ActorId   *string           `json:"actor_id"`
+	Changes   []TodoFieldChange `json:"changes"`
+	CreatedAt time.Time         `json:"created_at"`
+	Id        string            `json:"id"`
+
+	// Operation delete and restore move the todo to and from the trash; purge deletes
+	// it for good. The revisions of a purged todo are kept.
+	Operation TodoRevisionOperation `json:"operation"`
 }
 
-func (c *Client) RefreshTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRefreshTokenRequestWithBody(c.Server, contentType, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// TodoRevisionOperation delete and restore move the todo to and from the trash; purge deletes
+// it for good. The revisions of a purged todo are kept.
+type TodoRevisionOperation string
+
+// TodoRevisionListResponse defines model for TodoRevisionListResponse.
+type TodoRevisionListResponse struct {
+	Revisions []TodoRevision `json:"revisions"`
+	Total     int            `json:"total"`
 }
 
-func (c *Client) RefreshToken(ctx context.Context, body RefreshTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRefreshTokenRequest(c.Server, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// TodoSearchResponse defines model for TodoSearchResponse.
+type TodoSearchResponse struct {
+	Results []TodoSearchResult `json:"results"`
+	Total   int                `json:"total"`
 }
 
-func (c *Client) RegisterWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRegisterRequestWithBody(c.Server, contentType, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// TodoSearchResult defines model for TodoSearchResult.
+type TodoSearchResult struct {
+	// Rank Relevance; higher is better. Title matches weigh more than description matches.
+	Rank float64 `json:"rank"`
+
+	// Snippet HTML-escaped excerpt of the description with matched words wrapped in <mark>
+	Snippet string `json:"snippet"`
+
+	// TitleHighlight HTML-escaped title with matched words wrapped in <mark>
+	TitleHighlight string       `json:"title_highlight"`
+	Todo           TodoResponse `json:"todo"`
 }
 
-func (c *Client) Register(ctx context.Context, body RegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRegisterRequest(c.Server, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// TodoShare defines model for TodoShare.
+type TodoShare struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// CreatedBy The user who shared the todo
+	CreatedBy string `json:"created_by"`
+
+	// Permission read lets the member view the todo; edit also lets them change its title,
+	// description, completion, due date and priority
+	Permission TodoPermission `json:"permission"`
+	UpdatedAt  time.Time      `json:"updated_at"`
+
+	// UserId The member the todo is shared with
+	UserId string `json:"user_id"`
 }
 
-func (c *Client) VerifyEmailWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewVerifyEmailRequestWithBody(c.Server, contentType, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// TodoShareListResponse defines model for TodoShareListResponse.
+type TodoShareListResponse struct {
+	Shares []TodoShare `json:"shares"`
 }
 
-func (c *Client) VerifyEmail(ctx context.Context, body VerifyEmailJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewVerifyEmailRequest(c.Server, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// UpdateProjectRequest defines model for UpdateProjectRequest.
+type UpdateProjectRequest struct {
+	// Archived Archived projects are hidden from the list by default and accept no new todos
+	Archived    *bool   `json:"archived,omitempty"`
+	Description *string `json:"description,omitempty"`
+	IsPublic    *bool   `json:"is_public,omitempty"`
+	Name        *string `json:"name,omitempty"`
 }
 
-func (c *Client) HealthCheck(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewHealthCheckRequest(c.Server)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// UpdateTagRequest defines model for UpdateTagRequest.
+type UpdateTagRequest struct {
+	Color *string `json:"color,omitempty"`
+
+	// Name Unique within the tenant, ignoring case
+	Name *string `json:"name,omitempty"`
 }
 
-func (c *Client) GetMe(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetMeRequest(c.Server)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// UpdateTenantSettingsRequest defines model for UpdateTenantSettingsRequest.
+type UpdateTenantSettingsRequest struct {
+	// AccountDeletionPolicy Who takes over a deleted member's public todos. Private todos are always deleted.
+	// anonymize hands them to a "Deleted user" placeholder; reassign hands them to
+	// another tenant admin, falling back to the placeholder if there is none.
+	AccountDeletionPolicy *AccountDeletionPolicy `json:"account_deletion_policy,omitempty"`
+	MagicLinkEnabled      *bool                  `json:"magic_link_enabled,omitempty"`
+
+	// SearchLanguage Text search configuration for todo search. simple matches words as written;
+	// the others add stemming and stop words for that language. Changing it
+	// re-indexes all of the tenant's todos.
+	SearchLanguage     *SearchLanguage `json:"search_language,omitempty"`
+	TrashRetentionDays *int            `json:"trash_retention_days,omitempty"`
 }
 
-func (c *Client) UpdateMeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateMeRequestWithBody(c.Server, contentType, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// UpdateTodoRequest defines model for UpdateTodoRequest.
+type UpdateTodoRequest struct {
+	// CascadeCompletion When completing the todo, also complete all of its open subtasks
+	CascadeCompletion *bool `json:"cascade_completion,omitempty"`
+
+	// Completed Completing a recurring todo creates its next occurrence
+	Completed   *bool      `json:"completed,omitempty"`
+	Description *string    `json:"description,omitempty"`
+	DueDate     *time.Time `json:"due_date"`
+
+	// IsPublic If true, visible to all users in the same tenant
+	IsPublic *bool         `json:"is_public,omitempty"`
+	Priority *TodoPriority `json:"priority,omitempty"`
+	Title    *string       `json:"title,omitempty"`
 }
 
-func (c *Client) UpdateMe(ctx context.Context, body UpdateMeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateMeRequest(c.Server, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// UpdateUserRequest defines model for UpdateUserRequest.
+type UpdateUserRequest struct {
+	Name *string `json:"name,omitempty"`
 }
 
-func (c *Client) GetTodos(ctx context.Context, params *GetTodosParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetTodosRequest(c.Server, params)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// UploadAttachmentRequest defines model for UploadAttachmentRequest.
+type UploadAttachmentRequest struct {
+	// File An image (PNG, JPEG, GIF, WebP), a PDF or plain text
+	File openapi_types.File `json:"file"`
 }
 
-func (c *Client) CreateTodoWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateTodoRequestWithBody(c.Server, contentType, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// UserResponse defines model for UserResponse.
+type UserResponse struct {
+	CreatedAt     *time.Time        `json:"created_at,omitempty"`
+	Email         *string           `json:"email,omitempty"`
+	EmailVerified *bool             `json:"email_verified,omitempty"`
+	Id            *string           `json:"id,omitempty"`
+	Name          *string           `json:"name,omitempty"`
+	Role          *UserResponseRole `json:"role,omitempty"`
+	TenantId      *string           `json:"tenant_id,omitempty"`
+	UpdatedAt     *time.Time        `json:"updated_at,omitempty"`
 }
 
-func (c *Client) CreateTodo(ctx context.Context, body CreateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateTodoRequest(c.Server, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
-}
+// UserResponseRole defines model for UserResponse.Role.
+type UserResponseRole string
 
-func (c *Client) GetPublicTodos(ctx context.Context, params *GetPublicTodosParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetPublicTodosRequest(c.Server, params)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// VerifyEmailRequest defines model for VerifyEmailRequest.
+type VerifyEmailRequest struct {
+	Token string `json:"token"`
 }
 
-func (c *Client) DeleteTodo(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteTodoRequest(c.Server, todoId)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
-}
+// GetAuditEventsParams defines parameters for GetAuditEvents.
+type GetAuditEventsParams struct {
+	// ActorId Only events performed by this user
+	ActorId *string `form:"actor_id,omitempty" json:"actor_id,omitempty"`
 
-func (c *Client) GetTodo(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetTodoRequest(c.Server, todoId)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
-}
+	// Action Only events of this action, e.g. auth.login_failed
+	Action *string `form:"action,omitempty" json:"action,omitempty"`
 
-func (c *Client) UpdateTodoWithBody(ctx context.Context, todoId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateTodoRequestWithBody(c.Server, todoId, contentType, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+	// TargetId Only events affecting this user or tenant
+	TargetId *string `form:"target_id,omitempty" json:"target_id,omitempty"`
+
+	// From Only events at or after this time
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Only events before this time
+	To     *time.Time `form:"to,omitempty" json:"to,omitempty"`
+	Limit  *int       `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int       `form:"offset,omitempty" json:"offset,omitempty"`
 }
 
-func (c *Client) UpdateTodo(ctx context.Context, todoId string, body UpdateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateTodoRequest(c.Server, todoId, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// GetNotificationsParams defines parameters for GetNotifications.
+type GetNotificationsParams struct {
+	// Unread Only return unread notifications
+	Unread *bool `form:"unread,omitempty" json:"unread,omitempty"`
+
+	// Limit Defaults to 50; larger values are capped at 100
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
-// NewLoginRequest calls the generic Login builder with application/json body
-func NewLoginRequest(server string, body LoginJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewLoginRequestWithBody(server, "application/json", bodyReader)
+// GetProjectsParams defines parameters for GetProjects.
+type GetProjectsParams struct {
+	IncludeArchived *bool `form:"include_archived,omitempty" json:"include_archived,omitempty"`
 }
 
-// NewLoginRequestWithBody generates requests for Login with any type of body
-func NewLoginRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+// GetTodosParams defines parameters for GetTodos.
+type GetTodosParams struct {
+	// Completed Filter by completion status
+	Completed *bool `form:"completed,omitempty" json:"completed,omitempty"`
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	// Overdue Only incomplete todos whose due date has passed (or, when false, all others)
+	Overdue *bool `form:"overdue,omitempty" json:"overdue,omitempty"`
 
-	operationPath := fmt.Sprintf("/auth/login")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	// DueBefore Only todos due before this time
+	DueBefore *time.Time `form:"due_before,omitempty" json:"due_before,omitempty"`
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	// DueAfter Only todos due at or after this time
+	DueAfter *time.Time `form:"due_after,omitempty" json:"due_after,omitempty"`
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+	// CreatedBefore Only todos created before this time
+	CreatedBefore *time.Time `form:"created_before,omitempty" json:"created_before,omitempty"`
 
-	req.Header.Add("Content-Type", contentType)
+	// CreatedAfter Only todos created at or after this time
+	CreatedAfter *time.Time `form:"created_after,omitempty" json:"created_after,omitempty"`
 
-	return req, nil
-}
+	// CompletedBefore Only todos completed before this time
+	CompletedBefore *time.Time `form:"completed_before,omitempty" json:"completed_before,omitempty"`
 
-// NewRefreshTokenRequest calls the generic RefreshToken builder with application/json body
-func NewRefreshTokenRequest(server string, body RefreshTokenJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewRefreshTokenRequestWithBody(server, "application/json", bodyReader)
-}
+	// CompletedAfter Only todos completed at or after this time
+	CompletedAfter *time.Time `form:"completed_after,omitempty" json:"completed_after,omitempty"`
 
-// NewRefreshTokenRequestWithBody generates requests for RefreshToken with any type of body
-func NewRefreshTokenRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+	// IsPublic Filter by visibility
+	IsPublic *bool `form:"is_public,omitempty" json:"is_public,omitempty"`
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	// Q Case-insensitive match on the title
+	Q *string `form:"q,omitempty" json:"q,omitempty"`
 
-	operationPath := fmt.Sprintf("/auth/refresh")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	// Tag Tag ID; repeat to require several tags
+	Tag *[]string `form:"tag,omitempty" json:"tag,omitempty"`
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	// ProjectId Only todos in this project
+	ProjectId *string `form:"project_id,omitempty" json:"project_id,omitempty"`
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+	// Sort Field to sort by. Todos without a due date sort last. position is the
+	// owner's manual order.
+	Sort *GetTodosParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
 
-	req.Header.Add("Content-Type", contentType)
+	// Order Sort direction; asc for position and desc for every other field by default
+	Order *GetTodosParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+	Limit *int                 `form:"limit,omitempty" json:"limit,omitempty"`
 
-	return req, nil
-}
+	// Cursor Opaque next_cursor from a previous page. Takes precedence over offset.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
 
-// NewRegisterRequest calls the generic Register builder with application/json body
-func NewRegisterRequest(server string, body RegisterJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewRegisterRequestWithBody(server, "application/json", bodyReader)
+	// IncludeTotal Whether to count matching todos. Defaults to true with offset and false with cursor.
+	IncludeTotal *bool `form:"include_total,omitempty" json:"include_total,omitempty"`
+	Offset       *int  `form:"offset,omitempty" json:"offset,omitempty"`
 }
 
-// NewRegisterRequestWithBody generates requests for Register with any type of body
-func NewRegisterRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+// GetTodosParamsSort defines parameters for GetTodos.
+type GetTodosParamsSort string
 
-	operationPath := fmt.Sprintf("/auth/register")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+// GetTodosParamsOrder defines parameters for GetTodos.
+type GetTodosParamsOrder string
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+// GetPublicTodosParams defines parameters for GetPublicTodos.
+type GetPublicTodosParams struct {
+	// Tag Tag ID; repeat to require several tags
+	Tag *[]string `form:"tag,omitempty" json:"tag,omitempty"`
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+	// ProjectId Only todos in this project
+	ProjectId *string `form:"project_id,omitempty" json:"project_id,omitempty"`
+	Limit     *int    `form:"limit,omitempty" json:"limit,omitempty"`
 
-	req.Header.Add("Content-Type", contentType)
+	// Cursor Opaque next_cursor from a previous page. Takes precedence over offset.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
 
-	return req, nil
+	// IncludeTotal Whether to count matching todos. Defaults to true with offset and false with cursor.
+	IncludeTotal *bool `form:"include_total,omitempty" json:"include_total,omitempty"`
+	Offset       *int  `form:"offset,omitempty" json:"offset,omitempty"`
 }
 
-// NewVerifyEmailRequest calls the generic VerifyEmail builder with application/json body
-func NewVerifyEmailRequest(server string, body VerifyEmailJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewVerifyEmailRequestWithBody(server, "application/json", bodyReader)
-}
+// GetAssignedTodosParams defines parameters for GetAssignedTodos.
+type GetAssignedTodosParams struct {
+	// Completed Filter by completion status
+	Completed *bool `form:"completed,omitempty" json:"completed,omitempty"`
 
-// NewVerifyEmailRequestWithBody generates requests for VerifyEmail with any type of body
-func NewVerifyEmailRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+	// Overdue Only incomplete todos whose due date has passed (or, when false, all others)
+	Overdue *bool `form:"overdue,omitempty" json:"overdue,omitempty"`
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	// Sort Field to sort by. Todos without a due date sort last. position is the
+	// owner's manual order.
+	Sort *GetAssignedTodosParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
 
-	operationPath := fmt.Sprintf("/auth/verify-email")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	// Order Sort direction; asc for position and desc for every other field by default
+	Order *GetAssignedTodosParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+	Limit *int                         `form:"limit,omitempty" json:"limit,omitempty"`
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	// Cursor Opaque next_cursor from a previous page. Takes precedence over offset.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+	// IncludeTotal Whether to count matching todos. Defaults to true with offset and false with cursor.
+	IncludeTotal *bool `form:"include_total,omitempty" json:"include_total,omitempty"`
+	Offset       *int  `form:"offset,omitempty" json:"offset,omitempty"`
+}
 
-	req.Header.Add("Content-Type", contentType)
+// GetAssignedTodosParamsSort defines parameters for GetAssignedTodos.
+type GetAssignedTodosParamsSort string
 
-	return req, nil
-}
+// GetAssignedTodosParamsOrder defines parameters for GetAssignedTodos.
+type GetAssignedTodosParamsOrder string
 
-// NewHealthCheckRequest generates requests for HealthCheck
-func NewHealthCheckRequest(server string) (*http.Request, error) {
-	var err error
+// GetTodoCompletionStatsParams defines parameters for GetTodoCompletionStats.
+type GetTodoCompletionStatsParams struct {
+	Period *GetTodoCompletionStatsParamsPeriod `form:"period,omitempty" json:"period,omitempty"`
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	// Tz IANA timezone used for day boundaries, e.g. Europe/Berlin
+	Tz *string `form:"tz,omitempty" json:"tz,omitempty"`
+}
 
-	operationPath := fmt.Sprintf("/health")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+// GetTodoCompletionStatsParamsPeriod defines parameters for GetTodoCompletionStats.
+type GetTodoCompletionStatsParamsPeriod string
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+// ExportTodosParams defines parameters for ExportTodos.
+type ExportTodosParams struct {
+	// Component Whether todos become events or tasks
+	Component *ExportTodosParamsComponent `form:"component,omitempty" json:"component,omitempty"`
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	// Completed Filter by completion status
+	Completed *bool `form:"completed,omitempty" json:"completed,omitempty"`
 
-	return req, nil
-}
+	// Overdue Only incomplete todos whose due date has passed (or, when false, all others)
+	Overdue *bool `form:"overdue,omitempty" json:"overdue,omitempty"`
 
-// NewGetMeRequest generates requests for GetMe
-func NewGetMeRequest(server string) (*http.Request, error) {
-	var err error
+	// DueBefore Only todos due before this time
+	DueBefore *time.Time `form:"due_before,omitempty" json:"due_before,omitempty"`
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	// DueAfter Only todos due at or after this time
+	DueAfter *time.Time `form:"due_after,omitempty" json:"due_after,omitempty"`
 
-	operationPath := fmt.Sprintf("/me")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	// IsPublic Filter by visibility
+	IsPublic *bool `form:"is_public,omitempty" json:"is_public,omitempty"`
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	// Q Case-insensitive match on the title
+	Q *string `form:"q,omitempty" json:"q,omitempty"`
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	// Tag Tag ID; repeat to require several tags
+	Tag *[]string `form:"tag,omitempty" json:"tag,omitempty"`
 
-	return req, nil
+	// ProjectId Only todos in this project
+	ProjectId *string `form:"project_id,omitempty" json:"project_id,omitempty"`
 }
 
-// NewUpdateMeRequest calls the generic UpdateMe builder with application/json body
-func NewUpdateMeRequest(server string, body UpdateMeJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateMeRequestWithBody(server, "application/json", bodyReader)
-}
+// ExportTodosParamsComponent defines parameters for ExportTodos.
+type ExportTodosParamsComponent string
 
-// NewUpdateMeRequestWithBody generates requests for UpdateMe with any type of body
-func NewUpdateMeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+// SearchTodosParams defines parameters for SearchTodos.
+type SearchTodosParams struct {
+	Q      string `form:"q" json:"q"`
+	Limit  *int   `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int   `form:"offset,omitempty" json:"offset,omitempty"`
+}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+// GetSharedTodosParams defines parameters for GetSharedTodos.
+type GetSharedTodosParams struct {
+	// Completed Filter by completion status
+	Completed *bool `form:"completed,omitempty" json:"completed,omitempty"`
 
-	operationPath := fmt.Sprintf("/me")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	// Overdue Only incomplete todos whose due date has passed (or, when false, all others)
+	Overdue *bool `form:"overdue,omitempty" json:"overdue,omitempty"`
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	// Sort Field to sort by. Todos without a due date sort last. position is the
+	// owner's manual order.
+	Sort *GetSharedTodosParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+	// Order Sort direction; asc for position and desc for every other field by default
+	Order *GetSharedTodosParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+	Limit *int                       `form:"limit,omitempty" json:"limit,omitempty"`
 
-	req.Header.Add("Content-Type", contentType)
+	// Cursor Opaque next_cursor from a previous page. Takes precedence over offset.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
 
-	return req, nil
+	// IncludeTotal Whether to count matching todos. Defaults to true with offset and false with cursor.
+	IncludeTotal *bool `form:"include_total,omitempty" json:"include_total,omitempty"`
+	Offset       *int  `form:"offset,omitempty" json:"offset,omitempty"`
 }
 
-// NewGetTodosRequest generates requests for GetTodos
-func NewGetTodosRequest(server string, params *GetTodosParams) (*http.Request, error) {
-	var err error
+// GetSharedTodosParamsSort defines parameters for GetSharedTodos.
+type GetSharedTodosParamsSort string
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+// GetSharedTodosParamsOrder defines parameters for GetSharedTodos.
+type GetSharedTodosParamsOrder string
 
-	operationPath := fmt.Sprintf("/todos")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+// GetTodoTrashParams defines parameters for GetTodoTrash.
+type GetTodoTrashParams struct {
+	Limit  *int `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+// DeleteTodoParams defines parameters for DeleteTodo.
+type DeleteTodoParams struct {
+	// Children Required when the todo has subtasks. delete moves them to the trash as well, detach makes them top-level todos.
+	Children *DeleteTodoParamsChildren `form:"children,omitempty" json:"children,omitempty"`
 
-	if params != nil {
-		queryValues := queryURL.Query()
+	// IfMatch ETags of the versions the request may apply to. When the todo is at none of them, nothing changes and 412 is returned.
+	IfMatch *string `json:"If-Match,omitempty"`
+}
 
-		if params.Completed != nil {
+// DeleteTodoParamsChildren defines parameters for DeleteTodo.
+type DeleteTodoParamsChildren string
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "completed", runtime.ParamLocationQuery, *params.Completed); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// PatchTodoParams defines parameters for PatchTodo.
+type PatchTodoParams struct {
+	// IfMatch ETags of the versions the request may apply to. When the todo is at none of them, nothing changes and 412 is returned.
+	IfMatch *string `json:"If-Match,omitempty"`
+}
 
-		}
+// UpdateTodoParams defines parameters for UpdateTodo.
+type UpdateTodoParams struct {
+	// IfMatch ETags of the versions the request may apply to. When the todo is at none of them, nothing changes and 412 is returned.
+	IfMatch *string `json:"If-Match,omitempty"`
+}
 
-		if params.Limit != nil {
+// GetTodoHistoryParams defines parameters for GetTodoHistory.
+type GetTodoHistoryParams struct {
+	Limit  *int `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// LoginJSONRequestBody defines body for Login for application/json ContentType.
+type LoginJSONRequestBody = LoginRequest
 
-		}
+// RequestMagicLinkJSONRequestBody defines body for RequestMagicLink for application/json ContentType.
+type RequestMagicLinkJSONRequestBody = MagicLinkRequest
 
-		if params.Offset != nil {
+// ConsumeMagicLinkJSONRequestBody defines body for ConsumeMagicLink for application/json ContentType.
+type ConsumeMagicLinkJSONRequestBody = ConsumeMagicLinkRequest
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// RequestPasswordResetJSONRequestBody defines body for RequestPasswordReset for application/json ContentType.
+type RequestPasswordResetJSONRequestBody = PasswordResetRequest
 
-		}
+// ResetPasswordJSONRequestBody defines body for ResetPassword for application/json ContentType.
+type ResetPasswordJSONRequestBody = ResetPasswordRequest
 
-		queryURL.RawQuery = queryValues.Encode()
-	}
+// RefreshTokenJSONRequestBody defines body for RefreshToken for application/json ContentType.
+type RefreshTokenJSONRequestBody = RefreshTokenRequest
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+// RegisterJSONRequestBody defines body for Register for application/json ContentType.
+type RegisterJSONRequestBody = RegisterRequest
 
-	return req, nil
-}
+// VerifyEmailJSONRequestBody defines body for VerifyEmail for application/json ContentType.
+type VerifyEmailJSONRequestBody = VerifyEmailRequest
 
-// NewCreateTodoRequest calls the generic CreateTodo builder with application/json body
-func NewCreateTodoRequest(server string, body CreateTodoJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateTodoRequestWithBody(server, "application/json", bodyReader)
-}
+// DeleteMeJSONRequestBody defines body for DeleteMe for application/json ContentType.
+type DeleteMeJSONRequestBody = DeleteAccountRequest
 
-// NewCreateTodoRequestWithBody generates requests for CreateTodo with any type of body
-func NewCreateTodoRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+// UpdateMeJSONRequestBody defines body for UpdateMe for application/json ContentType.
+type UpdateMeJSONRequestBody = UpdateUserRequest
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+// ChangePasswordJSONRequestBody defines body for ChangePassword for application/json ContentType.
+type ChangePasswordJSONRequestBody = ChangePasswordRequest
 
-	operationPath := fmt.Sprintf("/todos")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+// CreateProjectJSONRequestBody defines body for CreateProject for application/json ContentType.
+type CreateProjectJSONRequestBody = CreateProjectRequest
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+// UpdateProjectJSONRequestBody defines body for UpdateProject for application/json ContentType.
+type UpdateProjectJSONRequestBody = UpdateProjectRequest
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+// CreateTagJSONRequestBody defines body for CreateTag for application/json ContentType.
+type CreateTagJSONRequestBody = CreateTagRequest
 
-	req.Header.Add("Content-Type", contentType)
+// UpdateTagJSONRequestBody defines body for UpdateTag for application/json ContentType.
+type UpdateTagJSONRequestBody = UpdateTagRequest
 
-	return req, nil
-}
+// UpdateTenantSettingsJSONRequestBody defines body for UpdateTenantSettings for application/json ContentType.
+type UpdateTenantSettingsJSONRequestBody = UpdateTenantSettingsRequest
 
-// NewGetPublicTodosRequest generates requests for GetPublicTodos
-func NewGetPublicTodosRequest(server string, params *GetPublicTodosParams) (*http.Request, error) {
-	var err error
+// CreateTodoJSONRequestBody defines body for CreateTodo for application/json ContentType.
+type CreateTodoJSONRequestBody = CreateTodoRequest
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+// BulkTodosJSONRequestBody defines body for BulkTodos for application/json ContentType.
+type BulkTodosJSONRequestBody = BulkTodoRequest
 
-	operationPath := fmt.Sprintf("/todos-public")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+// PatchTodoApplicationMergePatchPlusJSONRequestBody defines body for PatchTodo for application/merge-patch+json ContentType.
+type PatchTodoApplicationMergePatchPlusJSONRequestBody = TodoMergePatch
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+// UpdateTodoJSONRequestBody defines body for UpdateTodo for application/json ContentType.
+type UpdateTodoJSONRequestBody = UpdateTodoRequest
 
-	if params != nil {
-		queryValues := queryURL.Query()
+// AssignTodoJSONRequestBody defines body for AssignTodo for application/json ContentType.
+type AssignTodoJSONRequestBody = AssignTodoRequest
 
-		if params.Limit != nil {
+// UploadTodoAttachmentMultipartRequestBody defines body for UploadTodoAttachment for multipart/form-data ContentType.
+type UploadTodoAttachmentMultipartRequestBody = UploadAttachmentRequest
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// CreateTodoCommentJSONRequestBody defines body for CreateTodoComment for application/json ContentType.
+type CreateTodoCommentJSONRequestBody = CommentRequest
 
-		}
+// UpdateTodoCommentJSONRequestBody defines body for UpdateTodoComment for application/json ContentType.
+type UpdateTodoCommentJSONRequestBody = CommentRequest
 
-		if params.Offset != nil {
+// SetTodoParentJSONRequestBody defines body for SetTodoParent for application/json ContentType.
+type SetTodoParentJSONRequestBody = SetTodoParentRequest
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// ReorderTodoJSONRequestBody defines body for ReorderTodo for application/json ContentType.
+type ReorderTodoJSONRequestBody = ReorderTodoRequest
 
-		}
+// MoveTodoJSONRequestBody defines body for MoveTodo for application/json ContentType.
+type MoveTodoJSONRequestBody = MoveTodoRequest
 
-		queryURL.RawQuery = queryValues.Encode()
-	}
+// SetTodoRecurrenceJSONRequestBody defines body for SetTodoRecurrence for application/json ContentType.
+type SetTodoRecurrenceJSONRequestBody = TodoRecurrenceRequest
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+// CreateTodoReminderJSONRequestBody defines body for CreateTodoReminder for application/json ContentType.
+type CreateTodoReminderJSONRequestBody = CreateReminderRequest
 
-	return req, nil
-}
+// ShareTodoJSONRequestBody defines body for ShareTodo for application/json ContentType.
+type ShareTodoJSONRequestBody = ShareTodoRequest
 
-// NewDeleteTodoRequest generates requests for DeleteTodo
-func NewDeleteTodoRequest(server string, todoId string) (*http.Request, error) {
-	var err error
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
-	var pathParam0 string
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "todoId", runtime.ParamLocationPath, todoId)
-	if err != nil {
-		return nil, err
-	}
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
 
-	operationPath := fmt.Sprintf("/todos/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
 	}
-
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// GetAuditEvents request
+	GetAuditEvents(ctx context.Context, params *GetAuditEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LoginWithBody request with any body
+	LoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	Login(ctx context.Context, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RequestMagicLinkWithBody request with any body
+	RequestMagicLinkWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RequestMagicLink(ctx context.Context, body RequestMagicLinkJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ConsumeMagicLinkWithBody request with any body
+	ConsumeMagicLinkWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ConsumeMagicLink(ctx context.Context, body ConsumeMagicLinkJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RequestPasswordResetWithBody request with any body
+	RequestPasswordResetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RequestPasswordReset(ctx context.Context, body RequestPasswordResetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ResetPasswordWithBody request with any body
+	ResetPasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ResetPassword(ctx context.Context, body ResetPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RefreshTokenWithBody request with any body
+	RefreshTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RefreshToken(ctx context.Context, body RefreshTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RegisterWithBody request with any body
+	RegisterWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	Register(ctx context.Context, body RegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// VerifyEmailWithBody request with any body
+	VerifyEmailWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	VerifyEmail(ctx context.Context, body VerifyEmailJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// HealthCheck request
+	HealthCheck(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteMeWithBody request with any body
+	DeleteMeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DeleteMe(ctx context.Context, body DeleteMeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetMe request
+	GetMe(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateMeWithBody request with any body
+	UpdateMeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateMe(ctx context.Context, body UpdateMeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RevokeCalendarToken request
+	RevokeCalendarToken(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RotateCalendarToken request
+	RotateCalendarToken(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExportMe request
+	ExportMe(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ChangePasswordWithBody request with any body
+	ChangePasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ChangePassword(ctx context.Context, body ChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetNotifications request
+	GetNotifications(ctx context.Context, params *GetNotificationsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// MarkNotificationRead request
+	MarkNotificationRead(ctx context.Context, notificationId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetProjects request
+	GetProjects(ctx context.Context, params *GetProjectsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateProjectWithBody request with any body
+	CreateProjectWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateProject(ctx context.Context, body CreateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteProject request
+	DeleteProject(ctx context.Context, projectId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetProject request
+	GetProject(ctx context.Context, projectId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateProjectWithBody request with any body
+	UpdateProjectWithBody(ctx context.Context, projectId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateProject(ctx context.Context, projectId string, body UpdateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTags request
+	GetTags(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateTagWithBody request with any body
+	CreateTagWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateTag(ctx context.Context, body CreateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteTag request
+	DeleteTag(ctx context.Context, tagId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateTagWithBody request with any body
+	UpdateTagWithBody(ctx context.Context, tagId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateTag(ctx context.Context, tagId string, body UpdateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RevokeScimToken request
+	RevokeScimToken(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RotateScimToken request
+	RotateScimToken(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTenantSettings request
+	GetTenantSettings(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateTenantSettingsWithBody request with any body
+	UpdateTenantSettingsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateTenantSettings(ctx context.Context, body UpdateTenantSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTodos request
+	GetTodos(ctx context.Context, params *GetTodosParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateTodoWithBody request with any body
+	CreateTodoWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateTodo(ctx context.Context, body CreateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPublicTodos request
+	GetPublicTodos(ctx context.Context, params *GetPublicTodosParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAssignedTodos request
+	GetAssignedTodos(ctx context.Context, params *GetAssignedTodosParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// BulkTodosWithBody request with any body
+	BulkTodosWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	BulkTodos(ctx context.Context, body BulkTodosJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTodoCompletionStats request
+	GetTodoCompletionStats(ctx context.Context, params *GetTodoCompletionStatsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExportTodos request
+	ExportTodos(ctx context.Context, params *ExportTodosParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SearchTodos request
+	SearchTodos(ctx context.Context, params *SearchTodosParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSharedTodos request
+	GetSharedTodos(ctx context.Context, params *GetSharedTodosParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTodoTrash request
+	GetTodoTrash(ctx context.Context, params *GetTodoTrashParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteTodo request
+	DeleteTodo(ctx context.Context, todoId string, params *DeleteTodoParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTodo request
+	GetTodo(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchTodoWithBody request with any body
+	PatchTodoWithBody(ctx context.Context, todoId string, params *PatchTodoParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchTodoWithApplicationMergePatchPlusJSONBody(ctx context.Context, todoId string, params *PatchTodoParams, body PatchTodoApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateTodoWithBody request with any body
+	UpdateTodoWithBody(ctx context.Context, todoId string, params *UpdateTodoParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateTodo(ctx context.Context, todoId string, params *UpdateTodoParams, body UpdateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AssignTodoWithBody request with any body
+	AssignTodoWithBody(ctx context.Context, todoId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AssignTodo(ctx context.Context, todoId string, body AssignTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTodoAssignments request
+	GetTodoAssignments(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTodoAttachments request
+	GetTodoAttachments(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UploadTodoAttachmentWithBody request with any body
+	UploadTodoAttachmentWithBody(ctx context.Context, todoId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteTodoAttachment request
+	DeleteTodoAttachment(ctx context.Context, todoId string, attachmentId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTodoAttachmentContent request
+	GetTodoAttachmentContent(ctx context.Context, todoId string, attachmentId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTodoComments request
+	GetTodoComments(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateTodoCommentWithBody request with any body
+	CreateTodoCommentWithBody(ctx context.Context, todoId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateTodoComment(ctx context.Context, todoId string, body CreateTodoCommentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteTodoComment request
+	DeleteTodoComment(ctx context.Context, todoId string, commentId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateTodoCommentWithBody request with any body
+	UpdateTodoCommentWithBody(ctx context.Context, todoId string, commentId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateTodoComment(ctx context.Context, todoId string, commentId string, body UpdateTodoCommentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTodoHistory request
+	GetTodoHistory(ctx context.Context, todoId string, params *GetTodoHistoryParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RevertTodo request
+	RevertTodo(ctx context.Context, todoId string, revisionId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetTodoParentWithBody request with any body
+	SetTodoParentWithBody(ctx context.Context, todoId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetTodoParent(ctx context.Context, todoId string, body SetTodoParentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReorderTodoWithBody request with any body
+	ReorderTodoWithBody(ctx context.Context, todoId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ReorderTodo(ctx context.Context, todoId string, body ReorderTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// MoveTodoWithBody request with any body
+	MoveTodoWithBody(ctx context.Context, todoId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	MoveTodo(ctx context.Context, todoId string, body MoveTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StopTodoRecurrence request
+	StopTodoRecurrence(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetTodoRecurrenceWithBody request with any body
+	SetTodoRecurrenceWithBody(ctx context.Context, todoId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetTodoRecurrence(ctx context.Context, todoId string, body SetTodoRecurrenceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SkipTodoOccurrence request
+	SkipTodoOccurrence(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTodoReminders request
+	GetTodoReminders(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateTodoReminderWithBody request with any body
+	CreateTodoReminderWithBody(ctx context.Context, todoId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateTodoReminder(ctx context.Context, todoId string, body CreateTodoReminderJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteTodoReminder request
+	DeleteTodoReminder(ctx context.Context, todoId string, reminderId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RestoreTodo request
+	RestoreTodo(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTodoShares request
+	GetTodoShares(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UnshareTodo request
+	UnshareTodo(ctx context.Context, todoId string, userId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ShareTodoWithBody request with any body
+	ShareTodoWithBody(ctx context.Context, todoId string, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ShareTodo(ctx context.Context, todoId string, userId string, body ShareTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DetachTodoTag request
+	DetachTodoTag(ctx context.Context, todoId string, tagId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AttachTodoTag request
+	AttachTodoTag(ctx context.Context, todoId string, tagId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) GetAuditEvents(ctx context.Context, params *GetAuditEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAuditEventsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) LoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLoginRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Login(ctx context.Context, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLoginRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RequestMagicLinkWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRequestMagicLinkRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RequestMagicLink(ctx context.Context, body RequestMagicLinkJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRequestMagicLinkRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ConsumeMagicLinkWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConsumeMagicLinkRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ConsumeMagicLink(ctx context.Context, body ConsumeMagicLinkJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConsumeMagicLinkRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RequestPasswordResetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRequestPasswordResetRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RequestPasswordReset(ctx context.Context, body RequestPasswordResetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRequestPasswordResetRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ResetPasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResetPasswordRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ResetPassword(ctx context.Context, body ResetPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResetPasswordRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RefreshTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRefreshTokenRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RefreshToken(ctx context.Context, body RefreshTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRefreshTokenRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RegisterWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRegisterRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Register(ctx context.Context, body RegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRegisterRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) VerifyEmailWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVerifyEmailRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) VerifyEmail(ctx context.Context, body VerifyEmailJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVerifyEmailRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) HealthCheck(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHealthCheckRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteMeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteMeRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteMe(ctx context.Context, body DeleteMeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteMeRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetMe(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMeRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateMeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateMeRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateMe(ctx context.Context, body UpdateMeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateMeRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RevokeCalendarToken(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRevokeCalendarTokenRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RotateCalendarToken(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRotateCalendarTokenRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ExportMe(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportMeRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ChangePasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewChangePasswordRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ChangePassword(ctx context.Context, body ChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewChangePasswordRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetNotifications(ctx context.Context, params *GetNotificationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetNotificationsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) MarkNotificationRead(ctx context.Context, notificationId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMarkNotificationReadRequest(c.Server, notificationId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetProjects(ctx context.Context, params *GetProjectsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetProjectsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateProjectWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateProjectRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateProject(ctx context.Context, body CreateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateProjectRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteProject(ctx context.Context, projectId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteProjectRequest(c.Server, projectId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetProject(ctx context.Context, projectId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetProjectRequest(c.Server, projectId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateProjectWithBody(ctx context.Context, projectId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateProjectRequestWithBody(c.Server, projectId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateProject(ctx context.Context, projectId string, body UpdateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateProjectRequest(c.Server, projectId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTags(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTagsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateTagWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTagRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateTag(ctx context.Context, body CreateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTagRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteTag(ctx context.Context, tagId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteTagRequest(c.Server, tagId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateTagWithBody(ctx context.Context, tagId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTagRequestWithBody(c.Server, tagId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateTag(ctx context.Context, tagId string, body UpdateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTagRequest(c.Server, tagId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RevokeScimToken(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRevokeScimTokenRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RotateScimToken(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRotateScimTokenRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTenantSettings(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTenantSettingsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateTenantSettingsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTenantSettingsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateTenantSettings(ctx context.Context, body UpdateTenantSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTenantSettingsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTodos(ctx context.Context, params *GetTodosParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTodosRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateTodoWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTodoRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateTodo(ctx context.Context, body CreateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTodoRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetPublicTodos(ctx context.Context, params *GetPublicTodosParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPublicTodosRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAssignedTodos(ctx context.Context, params *GetAssignedTodosParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAssignedTodosRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) BulkTodosWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBulkTodosRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) BulkTodos(ctx context.Context, body BulkTodosJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBulkTodosRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTodoCompletionStats(ctx context.Context, params *GetTodoCompletionStatsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTodoCompletionStatsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ExportTodos(ctx context.Context, params *ExportTodosParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportTodosRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SearchTodos(ctx context.Context, params *SearchTodosParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchTodosRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSharedTodos(ctx context.Context, params *GetSharedTodosParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSharedTodosRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTodoTrash(ctx context.Context, params *GetTodoTrashParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTodoTrashRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteTodo(ctx context.Context, todoId string, params *DeleteTodoParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteTodoRequest(c.Server, todoId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTodo(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTodoRequest(c.Server, todoId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchTodoWithBody(ctx context.Context, todoId string, params *PatchTodoParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchTodoRequestWithBody(c.Server, todoId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchTodoWithApplicationMergePatchPlusJSONBody(ctx context.Context, todoId string, params *PatchTodoParams, body PatchTodoApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchTodoRequestWithApplicationMergePatchPlusJSONBody(c.Server, todoId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateTodoWithBody(ctx context.Context, todoId string, params *UpdateTodoParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTodoRequestWithBody(c.Server, todoId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateTodo(ctx context.Context, todoId string, params *UpdateTodoParams, body UpdateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTodoRequest(c.Server, todoId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AssignTodoWithBody(ctx context.Context, todoId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAssignTodoRequestWithBody(c.Server, todoId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AssignTodo(ctx context.Context, todoId string, body AssignTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAssignTodoRequest(c.Server, todoId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTodoAssignments(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTodoAssignmentsRequest(c.Server, todoId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTodoAttachments(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTodoAttachmentsRequest(c.Server, todoId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UploadTodoAttachmentWithBody(ctx context.Context, todoId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUploadTodoAttachmentRequestWithBody(c.Server, todoId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteTodoAttachment(ctx context.Context, todoId string, attachmentId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteTodoAttachmentRequest(c.Server, todoId, attachmentId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTodoAttachmentContent(ctx context.Context, todoId string, attachmentId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTodoAttachmentContentRequest(c.Server, todoId, attachmentId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTodoComments(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTodoCommentsRequest(c.Server, todoId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateTodoCommentWithBody(ctx context.Context, todoId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTodoCommentRequestWithBody(c.Server, todoId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateTodoComment(ctx context.Context, todoId string, body CreateTodoCommentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTodoCommentRequest(c.Server, todoId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteTodoComment(ctx context.Context, todoId string, commentId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteTodoCommentRequest(c.Server, todoId, commentId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateTodoCommentWithBody(ctx context.Context, todoId string, commentId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTodoCommentRequestWithBody(c.Server, todoId, commentId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateTodoComment(ctx context.Context, todoId string, commentId string, body UpdateTodoCommentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTodoCommentRequest(c.Server, todoId, commentId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTodoHistory(ctx context.Context, todoId string, params *GetTodoHistoryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTodoHistoryRequest(c.Server, todoId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RevertTodo(ctx context.Context, todoId string, revisionId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRevertTodoRequest(c.Server, todoId, revisionId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetTodoParentWithBody(ctx context.Context, todoId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetTodoParentRequestWithBody(c.Server, todoId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetTodoParent(ctx context.Context, todoId string, body SetTodoParentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetTodoParentRequest(c.Server, todoId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReorderTodoWithBody(ctx context.Context, todoId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReorderTodoRequestWithBody(c.Server, todoId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReorderTodo(ctx context.Context, todoId string, body ReorderTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReorderTodoRequest(c.Server, todoId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) MoveTodoWithBody(ctx context.Context, todoId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMoveTodoRequestWithBody(c.Server, todoId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) MoveTodo(ctx context.Context, todoId string, body MoveTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMoveTodoRequest(c.Server, todoId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) StopTodoRecurrence(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStopTodoRecurrenceRequest(c.Server, todoId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetTodoRecurrenceWithBody(ctx context.Context, todoId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetTodoRecurrenceRequestWithBody(c.Server, todoId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetTodoRecurrence(ctx context.Context, todoId string, body SetTodoRecurrenceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetTodoRecurrenceRequest(c.Server, todoId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SkipTodoOccurrence(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSkipTodoOccurrenceRequest(c.Server, todoId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTodoReminders(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTodoRemindersRequest(c.Server, todoId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateTodoReminderWithBody(ctx context.Context, todoId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTodoReminderRequestWithBody(c.Server, todoId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateTodoReminder(ctx context.Context, todoId string, body CreateTodoReminderJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTodoReminderRequest(c.Server, todoId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteTodoReminder(ctx context.Context, todoId string, reminderId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteTodoReminderRequest(c.Server, todoId, reminderId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RestoreTodo(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRestoreTodoRequest(c.Server, todoId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTodoShares(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTodoSharesRequest(c.Server, todoId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UnshareTodo(ctx context.Context, todoId string, userId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUnshareTodoRequest(c.Server, todoId, userId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ShareTodoWithBody(ctx context.Context, todoId string, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewShareTodoRequestWithBody(c.Server, todoId, userId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ShareTodo(ctx context.Context, todoId string, userId string, body ShareTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewShareTodoRequest(c.Server, todoId, userId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DetachTodoTag(ctx context.Context, todoId string, tagId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDetachTodoTagRequest(c.Server, todoId, tagId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AttachTodoTag(ctx context.Context, todoId string, tagId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAttachTodoTagRequest(c.Server, todoId, tagId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetAuditEventsRequest generates requests for GetAuditEvents
+func NewGetAuditEventsRequest(server string, params *GetAuditEventsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/audit-events")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ActorId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "actor_id", runtime.ParamLocationQuery, *params.ActorId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Action != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "action", runtime.ParamLocationQuery, *params.Action); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.TargetId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "target_id", runtime.ParamLocationQuery, *params.TargetId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewLoginRequest calls the generic Login builder with application/json body
+func NewLoginRequest(server string, body LoginJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLoginRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewLoginRequestWithBody generates requests for Login with any type of body
+func NewLoginRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/login")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRequestMagicLinkRequest calls the generic RequestMagicLink builder with application/json body
+func NewRequestMagicLinkRequest(server string, body RequestMagicLinkJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRequestMagicLinkRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewRequestMagicLinkRequestWithBody generates requests for RequestMagicLink with any type of body
+func NewRequestMagicLinkRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/magic-link")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewConsumeMagicLinkRequest calls the generic ConsumeMagicLink builder with application/json body
+func NewConsumeMagicLinkRequest(server string, body ConsumeMagicLinkJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewConsumeMagicLinkRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewConsumeMagicLinkRequestWithBody generates requests for ConsumeMagicLink with any type of body
+func NewConsumeMagicLinkRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/magic-link/consume")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRequestPasswordResetRequest calls the generic RequestPasswordReset builder with application/json body
+func NewRequestPasswordResetRequest(server string, body RequestPasswordResetJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRequestPasswordResetRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewRequestPasswordResetRequestWithBody generates requests for RequestPasswordReset with any type of body
+func NewRequestPasswordResetRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/password-reset")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewResetPasswordRequest calls the generic ResetPassword builder with application/json body
+func NewResetPasswordRequest(server string, body ResetPasswordJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewResetPasswordRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewResetPasswordRequestWithBody generates requests for ResetPassword with any type of body
+func NewResetPasswordRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/password-reset/confirm")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRefreshTokenRequest calls the generic RefreshToken builder with application/json body
+func NewRefreshTokenRequest(server string, body RefreshTokenJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRefreshTokenRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewRefreshTokenRequestWithBody generates requests for RefreshToken with any type of body
+func NewRefreshTokenRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/refresh")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRegisterRequest calls the generic Register builder with application/json body
+func NewRegisterRequest(server string, body RegisterJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRegisterRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewRegisterRequestWithBody generates requests for Register with any type of body
+func NewRegisterRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/register")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewVerifyEmailRequest calls the generic VerifyEmail builder with application/json body
+func NewVerifyEmailRequest(server string, body VerifyEmailJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewVerifyEmailRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewVerifyEmailRequestWithBody generates requests for VerifyEmail with any type of body
+func NewVerifyEmailRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/verify-email")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewHealthCheckRequest generates requests for HealthCheck
+func NewHealthCheckRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/health")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteMeRequest calls the generic DeleteMe builder with application/json body
+func NewDeleteMeRequest(server string, body DeleteMeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDeleteMeRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewDeleteMeRequestWithBody generates requests for DeleteMe with any type of body
+func NewDeleteMeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetMeRequest generates requests for GetMe
+func NewGetMeRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateMeRequest calls the generic UpdateMe builder with application/json body
+func NewUpdateMeRequest(server string, body UpdateMeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateMeRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUpdateMeRequestWithBody generates requests for UpdateMe with any type of body
+func NewUpdateMeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRevokeCalendarTokenRequest generates requests for RevokeCalendarToken
+func NewRevokeCalendarTokenRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me/calendar-token")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRotateCalendarTokenRequest generates requests for RotateCalendarToken
+func NewRotateCalendarTokenRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me/calendar-token")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewExportMeRequest generates requests for ExportMe
+func NewExportMeRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me/export")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewChangePasswordRequest calls the generic ChangePassword builder with application/json body
+func NewChangePasswordRequest(server string, body ChangePasswordJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewChangePasswordRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewChangePasswordRequestWithBody generates requests for ChangePassword with any type of body
+func NewChangePasswordRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me/password")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetNotificationsRequest generates requests for GetNotifications
+func NewGetNotificationsRequest(server string, params *GetNotificationsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/notifications")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Unread != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "unread", runtime.ParamLocationQuery, *params.Unread); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewMarkNotificationReadRequest generates requests for MarkNotificationRead
+func NewMarkNotificationReadRequest(server string, notificationId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "notificationId", runtime.ParamLocationPath, notificationId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/notifications/%s/read", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetProjectsRequest generates requests for GetProjects
+func NewGetProjectsRequest(server string, params *GetProjectsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.IncludeArchived != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "include_archived", runtime.ParamLocationQuery, *params.IncludeArchived); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateProjectRequest calls the generic CreateProject builder with application/json body
+func NewCreateProjectRequest(server string, body CreateProjectJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateProjectRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateProjectRequestWithBody generates requests for CreateProject with any type of body
+func NewCreateProjectRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteProjectRequest generates requests for DeleteProject
+func NewDeleteProjectRequest(server string, projectId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectId", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetProjectRequest generates requests for GetProject
+func NewGetProjectRequest(server string, projectId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectId", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateProjectRequest calls the generic UpdateProject builder with application/json body
+func NewUpdateProjectRequest(server string, projectId string, body UpdateProjectJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateProjectRequestWithBody(server, projectId, "application/json", bodyReader)
+}
+
+// NewUpdateProjectRequestWithBody generates requests for UpdateProject with any type of body
+func NewUpdateProjectRequestWithBody(server string, projectId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectId", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetTagsRequest generates requests for GetTags
+func NewGetTagsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tags")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateTagRequest calls the generic CreateTag builder with application/json body
+func NewCreateTagRequest(server string, body CreateTagJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateTagRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateTagRequestWithBody generates requests for CreateTag with any type of body
+func NewCreateTagRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tags")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteTagRequest generates requests for DeleteTag
+func NewDeleteTagRequest(server string, tagId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tagId", runtime.ParamLocationPath, tagId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tags/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateTagRequest calls the generic UpdateTag builder with application/json body
+func NewUpdateTagRequest(server string, tagId string, body UpdateTagJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateTagRequestWithBody(server, tagId, "application/json", bodyReader)
+}
+
+// NewUpdateTagRequestWithBody generates requests for UpdateTag with any type of body
+func NewUpdateTagRequestWithBody(server string, tagId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tagId", runtime.ParamLocationPath, tagId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tags/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRevokeScimTokenRequest generates requests for RevokeScimToken
+func NewRevokeScimTokenRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tenant/scim-token")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRotateScimTokenRequest generates requests for RotateScimToken
+func NewRotateScimTokenRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tenant/scim-token")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTenantSettingsRequest generates requests for GetTenantSettings
+func NewGetTenantSettingsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tenant/settings")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateTenantSettingsRequest calls the generic UpdateTenantSettings builder with application/json body
+func NewUpdateTenantSettingsRequest(server string, body UpdateTenantSettingsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateTenantSettingsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUpdateTenantSettingsRequestWithBody generates requests for UpdateTenantSettings with any type of body
+func NewUpdateTenantSettingsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tenant/settings")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetTodosRequest generates requests for GetTodos
+func NewGetTodosRequest(server string, params *GetTodosParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Completed != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "completed", runtime.ParamLocationQuery, *params.Completed); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Overdue != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "overdue", runtime.ParamLocationQuery, *params.Overdue); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DueBefore != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "due_before", runtime.ParamLocationQuery, *params.DueBefore); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DueAfter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "due_after", runtime.ParamLocationQuery, *params.DueAfter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CreatedBefore != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "created_before", runtime.ParamLocationQuery, *params.CreatedBefore); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CreatedAfter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "created_after", runtime.ParamLocationQuery, *params.CreatedAfter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CompletedBefore != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "completed_before", runtime.ParamLocationQuery, *params.CompletedBefore); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CompletedAfter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "completed_after", runtime.ParamLocationQuery, *params.CompletedAfter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.IsPublic != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "is_public", runtime.ParamLocationQuery, *params.IsPublic); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Q != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "q", runtime.ParamLocationQuery, *params.Q); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Tag != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tag", runtime.ParamLocationQuery, *params.Tag); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ProjectId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "project_id", runtime.ParamLocationQuery, *params.ProjectId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Order != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order", runtime.ParamLocationQuery, *params.Order); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.IncludeTotal != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "include_total", runtime.ParamLocationQuery, *params.IncludeTotal); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateTodoRequest calls the generic CreateTodo builder with application/json body
+func NewCreateTodoRequest(server string, body CreateTodoJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateTodoRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateTodoRequestWithBody generates requests for CreateTodo with any type of body
+func NewCreateTodoRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetPublicTodosRequest generates requests for GetPublicTodos
+func NewGetPublicTodosRequest(server string, params *GetPublicTodosParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos-public")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Tag != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tag", runtime.ParamLocationQuery, *params.Tag); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ProjectId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "project_id", runtime.ParamLocationQuery, *params.ProjectId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.IncludeTotal != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "include_total", runtime.ParamLocationQuery, *params.IncludeTotal); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAssignedTodosRequest generates requests for GetAssignedTodos
+func NewGetAssignedTodosRequest(server string, params *GetAssignedTodosParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/assigned")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Completed != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "completed", runtime.ParamLocationQuery, *params.Completed); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Overdue != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "overdue", runtime.ParamLocationQuery, *params.Overdue); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Order != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order", runtime.ParamLocationQuery, *params.Order); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.IncludeTotal != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "include_total", runtime.ParamLocationQuery, *params.IncludeTotal); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewBulkTodosRequest calls the generic BulkTodos builder with application/json body
+func NewBulkTodosRequest(server string, body BulkTodosJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewBulkTodosRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewBulkTodosRequestWithBody generates requests for BulkTodos with any type of body
+func NewBulkTodosRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/bulk")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetTodoCompletionStatsRequest generates requests for GetTodoCompletionStats
+func NewGetTodoCompletionStatsRequest(server string, params *GetTodoCompletionStatsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/completion-stats")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Period != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "period", runtime.ParamLocationQuery, *params.Period); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Tz != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tz", runtime.ParamLocationQuery, *params.Tz); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewExportTodosRequest generates requests for ExportTodos
+func NewExportTodosRequest(server string, params *ExportTodosParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/export")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Component != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "component", runtime.ParamLocationQuery, *params.Component); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Completed != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "completed", runtime.ParamLocationQuery, *params.Completed); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Overdue != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "overdue", runtime.ParamLocationQuery, *params.Overdue); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DueBefore != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "due_before", runtime.ParamLocationQuery, *params.DueBefore); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DueAfter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "due_after", runtime.ParamLocationQuery, *params.DueAfter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.IsPublic != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "is_public", runtime.ParamLocationQuery, *params.IsPublic); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Q != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "q", runtime.ParamLocationQuery, *params.Q); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Tag != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tag", runtime.ParamLocationQuery, *params.Tag); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ProjectId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "project_id", runtime.ParamLocationQuery, *params.ProjectId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSearchTodosRequest generates requests for SearchTodos
+func NewSearchTodosRequest(server string, params *SearchTodosParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/search")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "q", runtime.ParamLocationQuery, params.Q); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSharedTodosRequest generates requests for GetSharedTodos
+func NewGetSharedTodosRequest(server string, params *GetSharedTodosParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/shared")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Completed != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "completed", runtime.ParamLocationQuery, *params.Completed); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Overdue != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "overdue", runtime.ParamLocationQuery, *params.Overdue); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Order != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order", runtime.ParamLocationQuery, *params.Order); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.IncludeTotal != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "include_total", runtime.ParamLocationQuery, *params.IncludeTotal); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTodoTrashRequest generates requests for GetTodoTrash
+func NewGetTodoTrashRequest(server string, params *GetTodoTrashParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/trash")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteTodoRequest generates requests for DeleteTodo
+func NewDeleteTodoRequest(server string, todoId string, params *DeleteTodoParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "todoId", runtime.ParamLocationPath, todoId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Children != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "children", runtime.ParamLocationQuery, *params.Children); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetTodoRequest generates requests for GetTodo
+func NewGetTodoRequest(server string, todoId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "todoId", runtime.ParamLocationPath, todoId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchTodoRequestWithApplicationMergePatchPlusJSONBody calls the generic PatchTodo builder with application/merge-patch+json body
+func NewPatchTodoRequestWithApplicationMergePatchPlusJSONBody(server string, todoId string, params *PatchTodoParams, body PatchTodoApplicationMergePatchPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchTodoRequestWithBody(server, todoId, params, "application/merge-patch+json", bodyReader)
+}
+
+// NewPatchTodoRequestWithBody generates requests for PatchTodo with any type of body
+func NewPatchTodoRequestWithBody(server string, todoId string, params *PatchTodoParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "todoId", runtime.ParamLocationPath, todoId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewUpdateTodoRequest calls the generic UpdateTodo builder with application/json body
+func NewUpdateTodoRequest(server string, todoId string, params *UpdateTodoParams, body UpdateTodoJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateTodoRequestWithBody(server, todoId, params, "application/json", bodyReader)
+}
+
+// NewUpdateTodoRequestWithBody generates requests for UpdateTodo with any type of body
+func NewUpdateTodoRequestWithBody(server string, todoId string, params *UpdateTodoParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "todoId", runtime.ParamLocationPath, todoId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewAssignTodoRequest calls the generic AssignTodo builder with application/json body
+func NewAssignTodoRequest(server string, todoId string, body AssignTodoJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAssignTodoRequestWithBody(server, todoId, "application/json", bodyReader)
+}
+
+// NewAssignTodoRequestWithBody generates requests for AssignTodo with any type of body
+func NewAssignTodoRequestWithBody(server string, todoId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "todoId", runtime.ParamLocationPath, todoId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s/assignee", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetTodoAssignmentsRequest generates requests for GetTodoAssignments
+func NewGetTodoAssignmentsRequest(server string, todoId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "todoId", runtime.ParamLocationPath, todoId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s/assignments", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTodoAttachmentsRequest generates requests for GetTodoAttachments
+func NewGetTodoAttachmentsRequest(server string, todoId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "todoId", runtime.ParamLocationPath, todoId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s/attachments", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUploadTodoAttachmentRequestWithBody generates requests for UploadTodoAttachment with any type of body
+func NewUploadTodoAttachmentRequestWithBody(server string, todoId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "todoId", runtime.ParamLocationPath, todoId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s/attachments", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteTodoAttachmentRequest generates requests for DeleteTodoAttachment
+func NewDeleteTodoAttachmentRequest(server string, todoId string, attachmentId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "todoId", runtime.ParamLocationPath, todoId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "attachmentId", runtime.ParamLocationPath, attachmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s/attachments/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTodoAttachmentContentRequest generates requests for GetTodoAttachmentContent
+func NewGetTodoAttachmentContentRequest(server string, todoId string, attachmentId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "todoId", runtime.ParamLocationPath, todoId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "attachmentId", runtime.ParamLocationPath, attachmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s/attachments/%s/content", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTodoCommentsRequest generates requests for GetTodoComments
+func NewGetTodoCommentsRequest(server string, todoId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "todoId", runtime.ParamLocationPath, todoId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s/comments", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateTodoCommentRequest calls the generic CreateTodoComment builder with application/json body
+func NewCreateTodoCommentRequest(server string, todoId string, body CreateTodoCommentJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateTodoCommentRequestWithBody(server, todoId, "application/json", bodyReader)
+}
+
+// NewCreateTodoCommentRequestWithBody generates requests for CreateTodoComment with any type of body
+func NewCreateTodoCommentRequestWithBody(server string, todoId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "todoId", runtime.ParamLocationPath, todoId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s/comments", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteTodoCommentRequest generates requests for DeleteTodoComment
+func NewDeleteTodoCommentRequest(server string, todoId string, commentId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "todoId", runtime.ParamLocationPath, todoId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "commentId", runtime.ParamLocationPath, commentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s/comments/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateTodoCommentRequest calls the generic UpdateTodoComment builder with application/json body
+func NewUpdateTodoCommentRequest(server string, todoId string, commentId string, body UpdateTodoCommentJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateTodoCommentRequestWithBody(server, todoId, commentId, "application/json", bodyReader)
+}
+
+// NewUpdateTodoCommentRequestWithBody generates requests for UpdateTodoComment with any type of body
+func NewUpdateTodoCommentRequestWithBody(server string, todoId string, commentId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "todoId", runtime.ParamLocationPath, todoId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "commentId", runtime.ParamLocationPath, commentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s/comments/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetTodoHistoryRequest generates requests for GetTodoHistory
+func NewGetTodoHistoryRequest(server string, todoId string, params *GetTodoHistoryParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "todoId", runtime.ParamLocationPath, todoId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s/history", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRevertTodoRequest generates requests for RevertTodo
+func NewRevertTodoRequest(server string, todoId string, revisionId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "todoId", runtime.ParamLocationPath, todoId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "revisionId", runtime.ParamLocationPath, revisionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s/history/%s/revert", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSetTodoParentRequest calls the generic SetTodoParent builder with application/json body
+func NewSetTodoParentRequest(server string, todoId string, body SetTodoParentJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetTodoParentRequestWithBody(server, todoId, "application/json", bodyReader)
+}
+
+// NewSetTodoParentRequestWithBody generates requests for SetTodoParent with any type of body
+func NewSetTodoParentRequestWithBody(server string, todoId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "todoId", runtime.ParamLocationPath, todoId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s/parent", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewReorderTodoRequest calls the generic ReorderTodo builder with application/json body
+func NewReorderTodoRequest(server string, todoId string, body ReorderTodoJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewReorderTodoRequestWithBody(server, todoId, "application/json", bodyReader)
+}
+
+// NewReorderTodoRequestWithBody generates requests for ReorderTodo with any type of body
+func NewReorderTodoRequestWithBody(server string, todoId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "todoId", runtime.ParamLocationPath, todoId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s/position", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewMoveTodoRequest calls the generic MoveTodo builder with application/json body
+func NewMoveTodoRequest(server string, todoId string, body MoveTodoJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewMoveTodoRequestWithBody(server, todoId, "application/json", bodyReader)
+}
+
+// NewMoveTodoRequestWithBody generates requests for MoveTodo with any type of body
+func NewMoveTodoRequestWithBody(server string, todoId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "todoId", runtime.ParamLocationPath, todoId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s/project", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewStopTodoRecurrenceRequest generates requests for StopTodoRecurrence
+func NewStopTodoRecurrenceRequest(server string, todoId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "todoId", runtime.ParamLocationPath, todoId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s/recurrence", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSetTodoRecurrenceRequest calls the generic SetTodoRecurrence builder with application/json body
+func NewSetTodoRecurrenceRequest(server string, todoId string, body SetTodoRecurrenceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetTodoRecurrenceRequestWithBody(server, todoId, "application/json", bodyReader)
+}
+
+// NewSetTodoRecurrenceRequestWithBody generates requests for SetTodoRecurrence with any type of body
+func NewSetTodoRecurrenceRequestWithBody(server string, todoId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "todoId", runtime.ParamLocationPath, todoId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s/recurrence", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSkipTodoOccurrenceRequest generates requests for SkipTodoOccurrence
+func NewSkipTodoOccurrenceRequest(server string, todoId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "todoId", runtime.ParamLocationPath, todoId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s/recurrence/skip", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTodoRemindersRequest generates requests for GetTodoReminders
+func NewGetTodoRemindersRequest(server string, todoId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "todoId", runtime.ParamLocationPath, todoId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s/reminders", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateTodoReminderRequest calls the generic CreateTodoReminder builder with application/json body
+func NewCreateTodoReminderRequest(server string, todoId string, body CreateTodoReminderJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateTodoReminderRequestWithBody(server, todoId, "application/json", bodyReader)
+}
+
+// NewCreateTodoReminderRequestWithBody generates requests for CreateTodoReminder with any type of body
+func NewCreateTodoReminderRequestWithBody(server string, todoId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "todoId", runtime.ParamLocationPath, todoId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s/reminders", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteTodoReminderRequest generates requests for DeleteTodoReminder
+func NewDeleteTodoReminderRequest(server string, todoId string, reminderId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "todoId", runtime.ParamLocationPath, todoId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "reminderId", runtime.ParamLocationPath, reminderId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s/reminders/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRestoreTodoRequest generates requests for RestoreTodo
+func NewRestoreTodoRequest(server string, todoId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "todoId", runtime.ParamLocationPath, todoId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s/restore", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTodoSharesRequest generates requests for GetTodoShares
+func NewGetTodoSharesRequest(server string, todoId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "todoId", runtime.ParamLocationPath, todoId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s/shares", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUnshareTodoRequest generates requests for UnshareTodo
+func NewUnshareTodoRequest(server string, todoId string, userId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "todoId", runtime.ParamLocationPath, todoId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s/shares/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewShareTodoRequest calls the generic ShareTodo builder with application/json body
+func NewShareTodoRequest(server string, todoId string, userId string, body ShareTodoJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewShareTodoRequestWithBody(server, todoId, userId, "application/json", bodyReader)
+}
+
+// NewShareTodoRequestWithBody generates requests for ShareTodo with any type of body
+func NewShareTodoRequestWithBody(server string, todoId string, userId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "todoId", runtime.ParamLocationPath, todoId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s/shares/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDetachTodoTagRequest generates requests for DetachTodoTag
+func NewDetachTodoTagRequest(server string, todoId string, tagId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "todoId", runtime.ParamLocationPath, todoId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "tagId", runtime.ParamLocationPath, tagId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s/tags/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAttachTodoTagRequest generates requests for AttachTodoTag
+func NewAttachTodoTagRequest(server string, todoId string, tagId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "todoId", runtime.ParamLocationPath, todoId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "tagId", runtime.ParamLocationPath, tagId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s/tags/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
 	return req, nil
 }
 
-// NewGetTodoRequest generates requests for GetTodo
-func NewGetTodoRequest(server string, todoId string) (*http.Request, error) {
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetAuditEventsWithResponse request
+	GetAuditEventsWithResponse(ctx context.Context, params *GetAuditEventsParams, reqEditors ...RequestEditorFn) (*GetAuditEventsResponse, error)
+
+	// LoginWithBodyWithResponse request with any body
+	LoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginResponse, error)
+
+	LoginWithResponse(ctx context.Context, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*LoginResponse, error)
+
+	// RequestMagicLinkWithBodyWithResponse request with any body
+	RequestMagicLinkWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RequestMagicLinkResponse, error)
+
+	RequestMagicLinkWithResponse(ctx context.Context, body RequestMagicLinkJSONRequestBody, reqEditors ...RequestEditorFn) (*RequestMagicLinkResponse, error)
+
+	// ConsumeMagicLinkWithBodyWithResponse request with any body
+	ConsumeMagicLinkWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConsumeMagicLinkResponse, error)
+
+	ConsumeMagicLinkWithResponse(ctx context.Context, body ConsumeMagicLinkJSONRequestBody, reqEditors ...RequestEditorFn) (*ConsumeMagicLinkResponse, error)
+
+	// RequestPasswordResetWithBodyWithResponse request with any body
+	RequestPasswordResetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RequestPasswordResetResponse, error)
+
+	RequestPasswordResetWithResponse(ctx context.Context, body RequestPasswordResetJSONRequestBody, reqEditors ...RequestEditorFn) (*RequestPasswordResetResponse, error)
+
+	// ResetPasswordWithBodyWithResponse request with any body
+	ResetPasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ResetPasswordResponse, error)
+
+	ResetPasswordWithResponse(ctx context.Context, body ResetPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*ResetPasswordResponse, error)
+
+	// RefreshTokenWithBodyWithResponse request with any body
+	RefreshTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RefreshTokenResponse, error)
+
+	RefreshTokenWithResponse(ctx context.Context, body RefreshTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*RefreshTokenResponse, error)
+
+	// RegisterWithBodyWithResponse request with any body
+	RegisterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegisterResponse, error)
+
+	RegisterWithResponse(ctx context.Context, body RegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*RegisterResponse, error)
+
+	// VerifyEmailWithBodyWithResponse request with any body
+	VerifyEmailWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VerifyEmailResponse, error)
+
+	VerifyEmailWithResponse(ctx context.Context, body VerifyEmailJSONRequestBody, reqEditors ...RequestEditorFn) (*VerifyEmailResponse, error)
+
+	// HealthCheckWithResponse request
+	HealthCheckWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthCheckResponse, error)
+
+	// DeleteMeWithBodyWithResponse request with any body
+	DeleteMeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteMeResponse, error)
+
+	DeleteMeWithResponse(ctx context.Context, body DeleteMeJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteMeResponse, error)
+
+	// GetMeWithResponse request
+	GetMeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetMeResponse, error)
+
+	// UpdateMeWithBodyWithResponse request with any body
+	UpdateMeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMeResponse, error)
+
+	UpdateMeWithResponse(ctx context.Context, body UpdateMeJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMeResponse, error)
+
+	// RevokeCalendarTokenWithResponse request
+	RevokeCalendarTokenWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RevokeCalendarTokenResponse, error)
+
+	// RotateCalendarTokenWithResponse request
+	RotateCalendarTokenWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RotateCalendarTokenResponse, error)
+
+	// ExportMeWithResponse request
+	ExportMeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ExportMeResponse, error)
+
+	// ChangePasswordWithBodyWithResponse request with any body
+	ChangePasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ChangePasswordResponse, error)
+
+	ChangePasswordWithResponse(ctx context.Context, body ChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*ChangePasswordResponse, error)
+
+	// GetNotificationsWithResponse request
+	GetNotificationsWithResponse(ctx context.Context, params *GetNotificationsParams, reqEditors ...RequestEditorFn) (*GetNotificationsResponse, error)
+
+	// MarkNotificationReadWithResponse request
+	MarkNotificationReadWithResponse(ctx context.Context, notificationId string, reqEditors ...RequestEditorFn) (*MarkNotificationReadResponse, error)
+
+	// GetProjectsWithResponse request
+	GetProjectsWithResponse(ctx context.Context, params *GetProjectsParams, reqEditors ...RequestEditorFn) (*GetProjectsResponse, error)
+
+	// CreateProjectWithBodyWithResponse request with any body
+	CreateProjectWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectResponse, error)
+
+	CreateProjectWithResponse(ctx context.Context, body CreateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectResponse, error)
+
+	// DeleteProjectWithResponse request
+	DeleteProjectWithResponse(ctx context.Context, projectId string, reqEditors ...RequestEditorFn) (*DeleteProjectResponse, error)
+
+	// GetProjectWithResponse request
+	GetProjectWithResponse(ctx context.Context, projectId string, reqEditors ...RequestEditorFn) (*GetProjectResponse, error)
+
+	// UpdateProjectWithBodyWithResponse request with any body
+	UpdateProjectWithBodyWithResponse(ctx context.Context, projectId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectResponse, error)
+
+	UpdateProjectWithResponse(ctx context.Context, projectId string, body UpdateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectResponse, error)
+
+	// GetTagsWithResponse request
+	GetTagsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTagsResponse, error)
+
+	// CreateTagWithBodyWithResponse request with any body
+	CreateTagWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTagResponse, error)
+
+	CreateTagWithResponse(ctx context.Context, body CreateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTagResponse, error)
+
+	// DeleteTagWithResponse request
+	DeleteTagWithResponse(ctx context.Context, tagId string, reqEditors ...RequestEditorFn) (*DeleteTagResponse, error)
+
+	// UpdateTagWithBodyWithResponse request with any body
+	UpdateTagWithBodyWithResponse(ctx context.Context, tagId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTagResponse, error)
+
+	UpdateTagWithResponse(ctx context.Context, tagId string, body UpdateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTagResponse, error)
+
+	// RevokeScimTokenWithResponse request
+	RevokeScimTokenWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RevokeScimTokenResponse, error)
+
+	// RotateScimTokenWithResponse request
+	RotateScimTokenWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RotateScimTokenResponse, error)
+
+	// GetTenantSettingsWithResponse request
+	GetTenantSettingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTenantSettingsResponse, error)
+
+	// UpdateTenantSettingsWithBodyWithResponse request with any body
+	UpdateTenantSettingsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTenantSettingsResponse, error)
+
+	UpdateTenantSettingsWithResponse(ctx context.Context, body UpdateTenantSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTenantSettingsResponse, error)
+
+	// GetTodosWithResponse request
+	GetTodosWithResponse(ctx context.Context, params *GetTodosParams, reqEditors ...RequestEditorFn) (*GetTodosResponse, error)
+
+	// CreateTodoWithBodyWithResponse request with any body
+	CreateTodoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTodoResponse, error)
+
+	CreateTodoWithResponse(ctx context.Context, body CreateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTodoResponse, error)
+
+	// GetPublicTodosWithResponse request
+	GetPublicTodosWithResponse(ctx context.Context, params *GetPublicTodosParams, reqEditors ...RequestEditorFn) (*GetPublicTodosResponse, error)
+
+	// GetAssignedTodosWithResponse request
+	GetAssignedTodosWithResponse(ctx context.Context, params *GetAssignedTodosParams, reqEditors ...RequestEditorFn) (*GetAssignedTodosResponse, error)
+
+	// BulkTodosWithBodyWithResponse request with any body
+	BulkTodosWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BulkTodosResponse, error)
+
+	BulkTodosWithResponse(ctx context.Context, body BulkTodosJSONRequestBody, reqEditors ...RequestEditorFn) (*BulkTodosResponse, error)
+
+	// GetTodoCompletionStatsWithResponse request
+	GetTodoCompletionStatsWithResponse(ctx context.Context, params *GetTodoCompletionStatsParams, reqEditors ...RequestEditorFn) (*GetTodoCompletionStatsResponse, error)
+
+	// ExportTodosWithResponse request
+	ExportTodosWithResponse(ctx context.Context, params *ExportTodosParams, reqEditors ...RequestEditorFn) (*ExportTodosResponse, error)
+
+	// SearchTodosWithResponse request
+	SearchTodosWithResponse(ctx context.Context, params *SearchTodosParams, reqEditors ...RequestEditorFn) (*SearchTodosResponse, error)
+
+	// GetSharedTodosWithResponse request
+	GetSharedTodosWithResponse(ctx context.Context, params *GetSharedTodosParams, reqEditors ...RequestEditorFn) (*GetSharedTodosResponse, error)
+
+	// GetTodoTrashWithResponse request
+	GetTodoTrashWithResponse(ctx context.Context, params *GetTodoTrashParams, reqEditors ...RequestEditorFn) (*GetTodoTrashResponse, error)
+
+	// DeleteTodoWithResponse request
+	DeleteTodoWithResponse(ctx context.Context, todoId string, params *DeleteTodoParams, reqEditors ...RequestEditorFn) (*DeleteTodoResponse, error)
+
+	// GetTodoWithResponse request
+	GetTodoWithResponse(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*GetTodoResponse, error)
+
+	// PatchTodoWithBodyWithResponse request with any body
+	PatchTodoWithBodyWithResponse(ctx context.Context, todoId string, params *PatchTodoParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchTodoResponse, error)
+
+	PatchTodoWithApplicationMergePatchPlusJSONBodyWithResponse(ctx context.Context, todoId string, params *PatchTodoParams, body PatchTodoApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchTodoResponse, error)
+
+	// UpdateTodoWithBodyWithResponse request with any body
+	UpdateTodoWithBodyWithResponse(ctx context.Context, todoId string, params *UpdateTodoParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTodoResponse, error)
+
+	UpdateTodoWithResponse(ctx context.Context, todoId string, params *UpdateTodoParams, body UpdateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTodoResponse, error)
+
+	// AssignTodoWithBodyWithResponse request with any body
+	AssignTodoWithBodyWithResponse(ctx context.Context, todoId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AssignTodoResponse, error)
+
+	AssignTodoWithResponse(ctx context.Context, todoId string, body AssignTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*AssignTodoResponse, error)
+
+	// GetTodoAssignmentsWithResponse request
+	GetTodoAssignmentsWithResponse(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*GetTodoAssignmentsResponse, error)
+
+	// GetTodoAttachmentsWithResponse request
+	GetTodoAttachmentsWithResponse(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*GetTodoAttachmentsResponse, error)
+
+	// UploadTodoAttachmentWithBodyWithResponse request with any body
+	UploadTodoAttachmentWithBodyWithResponse(ctx context.Context, todoId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadTodoAttachmentResponse, error)
+
+	// DeleteTodoAttachmentWithResponse request
+	DeleteTodoAttachmentWithResponse(ctx context.Context, todoId string, attachmentId string, reqEditors ...RequestEditorFn) (*DeleteTodoAttachmentResponse, error)
+
+	// GetTodoAttachmentContentWithResponse request
+	GetTodoAttachmentContentWithResponse(ctx context.Context, todoId string, attachmentId string, reqEditors ...RequestEditorFn) (*GetTodoAttachmentContentResponse, error)
+
+	// GetTodoCommentsWithResponse request
+	GetTodoCommentsWithResponse(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*GetTodoCommentsResponse, error)
+
+	// CreateTodoCommentWithBodyWithResponse request with any body
+	CreateTodoCommentWithBodyWithResponse(ctx context.Context, todoId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTodoCommentResponse, error)
+
+	CreateTodoCommentWithResponse(ctx context.Context, todoId string, body CreateTodoCommentJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTodoCommentResponse, error)
+
+	// DeleteTodoCommentWithResponse request
+	DeleteTodoCommentWithResponse(ctx context.Context, todoId string, commentId string, reqEditors ...RequestEditorFn) (*DeleteTodoCommentResponse, error)
+
+	// UpdateTodoCommentWithBodyWithResponse request with any body
+	UpdateTodoCommentWithBodyWithResponse(ctx context.Context, todoId string, commentId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTodoCommentResponse, error)
+
+	UpdateTodoCommentWithResponse(ctx context.Context, todoId string, commentId string, body UpdateTodoCommentJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTodoCommentResponse, error)
+
+	// GetTodoHistoryWithResponse request
+	GetTodoHistoryWithResponse(ctx context.Context, todoId string, params *GetTodoHistoryParams, reqEditors ...RequestEditorFn) (*GetTodoHistoryResponse, error)
+
+	// RevertTodoWithResponse request
+	RevertTodoWithResponse(ctx context.Context, todoId string, revisionId string, reqEditors ...RequestEditorFn) (*RevertTodoResponse, error)
+
+	// SetTodoParentWithBodyWithResponse request with any body
+	SetTodoParentWithBodyWithResponse(ctx context.Context, todoId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetTodoParentResponse, error)
+
+	SetTodoParentWithResponse(ctx context.Context, todoId string, body SetTodoParentJSONRequestBody, reqEditors ...RequestEditorFn) (*SetTodoParentResponse, error)
+
+	// ReorderTodoWithBodyWithResponse request with any body
+	ReorderTodoWithBodyWithResponse(ctx context.Context, todoId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReorderTodoResponse, error)
+
+	ReorderTodoWithResponse(ctx context.Context, todoId string, body ReorderTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*ReorderTodoResponse, error)
+
+	// MoveTodoWithBodyWithResponse request with any body
+	MoveTodoWithBodyWithResponse(ctx context.Context, todoId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MoveTodoResponse, error)
+
+	MoveTodoWithResponse(ctx context.Context, todoId string, body MoveTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*MoveTodoResponse, error)
+
+	// StopTodoRecurrenceWithResponse request
+	StopTodoRecurrenceWithResponse(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*StopTodoRecurrenceResponse, error)
+
+	// SetTodoRecurrenceWithBodyWithResponse request with any body
+	SetTodoRecurrenceWithBodyWithResponse(ctx context.Context, todoId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetTodoRecurrenceResponse, error)
+
+	SetTodoRecurrenceWithResponse(ctx context.Context, todoId string, body SetTodoRecurrenceJSONRequestBody, reqEditors ...RequestEditorFn) (*SetTodoRecurrenceResponse, error)
+
+	// SkipTodoOccurrenceWithResponse request
+	SkipTodoOccurrenceWithResponse(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*SkipTodoOccurrenceResponse, error)
+
+	// GetTodoRemindersWithResponse request
+	GetTodoRemindersWithResponse(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*GetTodoRemindersResponse, error)
+
+	// CreateTodoReminderWithBodyWithResponse request with any body
+	CreateTodoReminderWithBodyWithResponse(ctx context.Context, todoId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTodoReminderResponse, error)
+
+	CreateTodoReminderWithResponse(ctx context.Context, todoId string, body CreateTodoReminderJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTodoReminderResponse, error)
+
+	// DeleteTodoReminderWithResponse request
+	DeleteTodoReminderWithResponse(ctx context.Context, todoId string, reminderId string, reqEditors ...RequestEditorFn) (*DeleteTodoReminderResponse, error)
+
+	// RestoreTodoWithResponse request
+	RestoreTodoWithResponse(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*RestoreTodoResponse, error)
+
+	// GetTodoSharesWithResponse request
+	GetTodoSharesWithResponse(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*GetTodoSharesResponse, error)
+
+	// UnshareTodoWithResponse request
+	UnshareTodoWithResponse(ctx context.Context, todoId string, userId string, reqEditors ...RequestEditorFn) (*UnshareTodoResponse, error)
+
+	// ShareTodoWithBodyWithResponse request with any body
+	ShareTodoWithBodyWithResponse(ctx context.Context, todoId string, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ShareTodoResponse, error)
+
+	ShareTodoWithResponse(ctx context.Context, todoId string, userId string, body ShareTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*ShareTodoResponse, error)
+
+	// DetachTodoTagWithResponse request
+	DetachTodoTagWithResponse(ctx context.Context, todoId string, tagId string, reqEditors ...RequestEditorFn) (*DetachTodoTagResponse, error)
+
+	// AttachTodoTagWithResponse request
+	AttachTodoTagWithResponse(ctx context.Context, todoId string, tagId string, reqEditors ...RequestEditorFn) (*AttachTodoTagResponse, error)
+}
+
+type GetAuditEventsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuditEventListResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAuditEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAuditEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LoginResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuthResponse
+	JSON401      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r LoginResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LoginResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RequestMagicLinkResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r RequestMagicLinkResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RequestMagicLinkResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ConsumeMagicLinkResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuthResponse
+	JSON401      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ConsumeMagicLinkResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ConsumeMagicLinkResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RequestPasswordResetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r RequestPasswordResetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RequestPasswordResetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ResetPasswordResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ResetPasswordResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ResetPasswordResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RefreshTokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuthResponse
+	JSON401      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RefreshTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RefreshTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RegisterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *AuthResponse
+	JSON400      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RegisterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RegisterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type VerifyEmailResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Message *string `json:"message,omitempty"`
+	}
+	JSON400 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r VerifyEmailResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r VerifyEmailResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HealthCheckResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Status *string `json:"status,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r HealthCheckResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HealthCheckResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteMeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteMeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteMeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserResponse
+	JSON401      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateMeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserResponse
+	JSON401      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateMeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateMeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RevokeCalendarTokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RevokeCalendarTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RevokeCalendarTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RotateCalendarTokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CalendarTokenResponse
+	JSON401      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RotateCalendarTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RotateCalendarTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExportMeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AccountExportResponse
+	JSON401      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportMeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportMeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ChangePasswordResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ChangePasswordResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ChangePasswordResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetNotificationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NotificationListResponse
+	JSON401      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetNotificationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetNotificationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type MarkNotificationReadResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r MarkNotificationReadResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MarkNotificationReadResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetProjectsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProjectListResponse
+	JSON401      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProjectsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProjectsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateProjectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ProjectResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateProjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateProjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteProjectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteProjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteProjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetProjectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProjectResponse
+	JSON401      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateProjectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProjectResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateProjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateProjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTagsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TagListResponse
+	JSON401      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTagsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTagsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateTagResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *TagResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateTagResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateTagResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteTagResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteTagResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteTagResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateTagResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TagResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateTagResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateTagResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RevokeScimTokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RevokeScimTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RevokeScimTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RotateScimTokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ScimTokenResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RotateScimTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RotateScimTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTenantSettingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TenantSettingsResponse
+	JSON401      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTenantSettingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTenantSettingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateTenantSettingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TenantSettingsResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateTenantSettingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateTenantSettingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTodosResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TodoListResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTodosResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTodosResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateTodoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *TodoResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateTodoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateTodoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPublicTodosResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TodoListResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPublicTodosResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPublicTodosResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAssignedTodosResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TodoListResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAssignedTodosResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAssignedTodosResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type BulkTodosResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BulkTodoResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r BulkTodosResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r BulkTodosResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTodoCompletionStatsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CompletionStatsResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTodoCompletionStatsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTodoCompletionStatsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExportTodosResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportTodosResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportTodosResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SearchTodosResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TodoSearchResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r SearchTodosResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SearchTodosResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSharedTodosResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TodoListResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSharedTodosResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSharedTodosResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTodoTrashResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TodoListResponse
+	JSON401      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTodoTrashResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTodoTrashResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteTodoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+	JSON412      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteTodoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteTodoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTodoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TodoResponse
+	JSON401      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTodoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTodoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchTodoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TodoResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+	JSON412      *ErrorResponse
+	JSON415      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchTodoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchTodoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateTodoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TodoResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+	JSON412      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateTodoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateTodoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AssignTodoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TodoResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AssignTodoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AssignTodoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTodoAssignmentsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TodoAssignmentListResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTodoAssignmentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTodoAssignmentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTodoAttachmentsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AttachmentListResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTodoAttachmentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTodoAttachmentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UploadTodoAttachmentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *AttachmentResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON413      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UploadTodoAttachmentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UploadTodoAttachmentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteTodoAttachmentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteTodoAttachmentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteTodoAttachmentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTodoAttachmentContentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTodoAttachmentContentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTodoAttachmentContentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTodoCommentsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CommentListResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTodoCommentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTodoCommentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateTodoCommentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CommentResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateTodoCommentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateTodoCommentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteTodoCommentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteTodoCommentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteTodoCommentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateTodoCommentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CommentResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateTodoCommentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateTodoCommentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTodoHistoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TodoRevisionListResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTodoHistoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTodoHistoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RevertTodoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TodoResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RevertTodoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RevertTodoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetTodoParentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TodoResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r SetTodoParentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetTodoParentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReorderTodoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TodoResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ReorderTodoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReorderTodoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type MoveTodoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TodoResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r MoveTodoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MoveTodoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StopTodoRecurrenceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TodoResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r StopTodoRecurrenceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StopTodoRecurrenceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetTodoRecurrenceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TodoResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r SetTodoRecurrenceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetTodoRecurrenceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SkipTodoOccurrenceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TodoResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r SkipTodoOccurrenceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SkipTodoOccurrenceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTodoRemindersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ReminderListResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTodoRemindersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTodoRemindersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateTodoReminderResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ReminderResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateTodoReminderResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateTodoReminderResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteTodoReminderResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteTodoReminderResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteTodoReminderResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RestoreTodoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TodoResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RestoreTodoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RestoreTodoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTodoSharesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TodoShareListResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTodoSharesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTodoSharesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UnshareTodoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UnshareTodoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UnshareTodoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ShareTodoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TodoShare
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ShareTodoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ShareTodoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DetachTodoTagResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DetachTodoTagResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DetachTodoTagResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AttachTodoTagResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TodoResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AttachTodoTagResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AttachTodoTagResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetAuditEventsWithResponse request returning *GetAuditEventsResponse
+func (c *ClientWithResponses) GetAuditEventsWithResponse(ctx context.Context, params *GetAuditEventsParams, reqEditors ...RequestEditorFn) (*GetAuditEventsResponse, error) {
+	rsp, err := c.GetAuditEvents(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAuditEventsResponse(rsp)
+}
+
+// LoginWithBodyWithResponse request with arbitrary body returning *LoginResponse
+func (c *ClientWithResponses) LoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginResponse, error) {
+	rsp, err := c.LoginWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLoginResponse(rsp)
+}
+
+func (c *ClientWithResponses) LoginWithResponse(ctx context.Context, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*LoginResponse, error) {
+	rsp, err := c.Login(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLoginResponse(rsp)
+}
+
+// RequestMagicLinkWithBodyWithResponse request with arbitrary body returning *RequestMagicLinkResponse
+func (c *ClientWithResponses) RequestMagicLinkWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RequestMagicLinkResponse, error) {
+	rsp, err := c.RequestMagicLinkWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRequestMagicLinkResponse(rsp)
+}
+
+func (c *ClientWithResponses) RequestMagicLinkWithResponse(ctx context.Context, body RequestMagicLinkJSONRequestBody, reqEditors ...RequestEditorFn) (*RequestMagicLinkResponse, error) {
+	rsp, err := c.RequestMagicLink(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRequestMagicLinkResponse(rsp)
+}
+
+// ConsumeMagicLinkWithBodyWithResponse request with arbitrary body returning *ConsumeMagicLinkResponse
+func (c *ClientWithResponses) ConsumeMagicLinkWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConsumeMagicLinkResponse, error) {
+	rsp, err := c.ConsumeMagicLinkWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConsumeMagicLinkResponse(rsp)
+}
+
+func (c *ClientWithResponses) ConsumeMagicLinkWithResponse(ctx context.Context, body ConsumeMagicLinkJSONRequestBody, reqEditors ...RequestEditorFn) (*ConsumeMagicLinkResponse, error) {
+	rsp, err := c.ConsumeMagicLink(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConsumeMagicLinkResponse(rsp)
+}
+
+// RequestPasswordResetWithBodyWithResponse request with arbitrary body returning *RequestPasswordResetResponse
+func (c *ClientWithResponses) RequestPasswordResetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RequestPasswordResetResponse, error) {
+	rsp, err := c.RequestPasswordResetWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRequestPasswordResetResponse(rsp)
+}
+
+func (c *ClientWithResponses) RequestPasswordResetWithResponse(ctx context.Context, body RequestPasswordResetJSONRequestBody, reqEditors ...RequestEditorFn) (*RequestPasswordResetResponse, error) {
+	rsp, err := c.RequestPasswordReset(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRequestPasswordResetResponse(rsp)
+}
+
+// ResetPasswordWithBodyWithResponse request with arbitrary body returning *ResetPasswordResponse
+func (c *ClientWithResponses) ResetPasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ResetPasswordResponse, error) {
+	rsp, err := c.ResetPasswordWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResetPasswordResponse(rsp)
+}
+
+func (c *ClientWithResponses) ResetPasswordWithResponse(ctx context.Context, body ResetPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*ResetPasswordResponse, error) {
+	rsp, err := c.ResetPassword(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResetPasswordResponse(rsp)
+}
+
+// RefreshTokenWithBodyWithResponse request with arbitrary body returning *RefreshTokenResponse
+func (c *ClientWithResponses) RefreshTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RefreshTokenResponse, error) {
+	rsp, err := c.RefreshTokenWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRefreshTokenResponse(rsp)
+}
+
+func (c *ClientWithResponses) RefreshTokenWithResponse(ctx context.Context, body RefreshTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*RefreshTokenResponse, error) {
+	rsp, err := c.RefreshToken(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRefreshTokenResponse(rsp)
+}
+
+// RegisterWithBodyWithResponse request with arbitrary body returning *RegisterResponse
+func (c *ClientWithResponses) RegisterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegisterResponse, error) {
+	rsp, err := c.RegisterWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRegisterResponse(rsp)
+}
+
+func (c *ClientWithResponses) RegisterWithResponse(ctx context.Context, body RegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*RegisterResponse, error) {
+	rsp, err := c.Register(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRegisterResponse(rsp)
+}
+
+// VerifyEmailWithBodyWithResponse request with arbitrary body returning *VerifyEmailResponse
+func (c *ClientWithResponses) VerifyEmailWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VerifyEmailResponse, error) {
+	rsp, err := c.VerifyEmailWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVerifyEmailResponse(rsp)
+}
+
+func (c *ClientWithResponses) VerifyEmailWithResponse(ctx context.Context, body VerifyEmailJSONRequestBody, reqEditors ...RequestEditorFn) (*VerifyEmailResponse, error) {
+	rsp, err := c.VerifyEmail(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVerifyEmailResponse(rsp)
+}
+
+// HealthCheckWithResponse request returning *HealthCheckResponse
+func (c *ClientWithResponses) HealthCheckWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthCheckResponse, error) {
+	rsp, err := c.HealthCheck(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHealthCheckResponse(rsp)
+}
+
+// DeleteMeWithBodyWithResponse request with arbitrary body returning *DeleteMeResponse
+func (c *ClientWithResponses) DeleteMeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteMeResponse, error) {
+	rsp, err := c.DeleteMeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteMeResponse(rsp)
+}
+
+func (c *ClientWithResponses) DeleteMeWithResponse(ctx context.Context, body DeleteMeJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteMeResponse, error) {
+	rsp, err := c.DeleteMe(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteMeResponse(rsp)
+}
+
+// GetMeWithResponse request returning *GetMeResponse
+func (c *ClientWithResponses) GetMeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetMeResponse, error) {
+	rsp, err := c.GetMe(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMeResponse(rsp)
+}
+
+// UpdateMeWithBodyWithResponse request with arbitrary body returning *UpdateMeResponse
+func (c *ClientWithResponses) UpdateMeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMeResponse, error) {
+	rsp, err := c.UpdateMeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateMeResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateMeWithResponse(ctx context.Context, body UpdateMeJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMeResponse, error) {
+	rsp, err := c.UpdateMe(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateMeResponse(rsp)
+}
+
+// RevokeCalendarTokenWithResponse request returning *RevokeCalendarTokenResponse
+func (c *ClientWithResponses) RevokeCalendarTokenWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RevokeCalendarTokenResponse, error) {
+	rsp, err := c.RevokeCalendarToken(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRevokeCalendarTokenResponse(rsp)
+}
+
+// RotateCalendarTokenWithResponse request returning *RotateCalendarTokenResponse
+func (c *ClientWithResponses) RotateCalendarTokenWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RotateCalendarTokenResponse, error) {
+	rsp, err := c.RotateCalendarToken(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRotateCalendarTokenResponse(rsp)
+}
+
+// ExportMeWithResponse request returning *ExportMeResponse
+func (c *ClientWithResponses) ExportMeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ExportMeResponse, error) {
+	rsp, err := c.ExportMe(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportMeResponse(rsp)
+}
+
+// ChangePasswordWithBodyWithResponse request with arbitrary body returning *ChangePasswordResponse
+func (c *ClientWithResponses) ChangePasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ChangePasswordResponse, error) {
+	rsp, err := c.ChangePasswordWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseChangePasswordResponse(rsp)
+}
+
+func (c *ClientWithResponses) ChangePasswordWithResponse(ctx context.Context, body ChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*ChangePasswordResponse, error) {
+	rsp, err := c.ChangePassword(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseChangePasswordResponse(rsp)
+}
+
+// GetNotificationsWithResponse request returning *GetNotificationsResponse
+func (c *ClientWithResponses) GetNotificationsWithResponse(ctx context.Context, params *GetNotificationsParams, reqEditors ...RequestEditorFn) (*GetNotificationsResponse, error) {
+	rsp, err := c.GetNotifications(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetNotificationsResponse(rsp)
+}
+
+// MarkNotificationReadWithResponse request returning *MarkNotificationReadResponse
+func (c *ClientWithResponses) MarkNotificationReadWithResponse(ctx context.Context, notificationId string, reqEditors ...RequestEditorFn) (*MarkNotificationReadResponse, error) {
+	rsp, err := c.MarkNotificationRead(ctx, notificationId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMarkNotificationReadResponse(rsp)
+}
+
+// GetProjectsWithResponse request returning *GetProjectsResponse
+func (c *ClientWithResponses) GetProjectsWithResponse(ctx context.Context, params *GetProjectsParams, reqEditors ...RequestEditorFn) (*GetProjectsResponse, error) {
+	rsp, err := c.GetProjects(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProjectsResponse(rsp)
+}
+
+// CreateProjectWithBodyWithResponse request with arbitrary body returning *CreateProjectResponse
+func (c *ClientWithResponses) CreateProjectWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectResponse, error) {
+	rsp, err := c.CreateProjectWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateProjectResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateProjectWithResponse(ctx context.Context, body CreateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectResponse, error) {
+	rsp, err := c.CreateProject(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateProjectResponse(rsp)
+}
+
+// DeleteProjectWithResponse request returning *DeleteProjectResponse
+func (c *ClientWithResponses) DeleteProjectWithResponse(ctx context.Context, projectId string, reqEditors ...RequestEditorFn) (*DeleteProjectResponse, error) {
+	rsp, err := c.DeleteProject(ctx, projectId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteProjectResponse(rsp)
+}
+
+// GetProjectWithResponse request returning *GetProjectResponse
+func (c *ClientWithResponses) GetProjectWithResponse(ctx context.Context, projectId string, reqEditors ...RequestEditorFn) (*GetProjectResponse, error) {
+	rsp, err := c.GetProject(ctx, projectId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProjectResponse(rsp)
+}
+
+// UpdateProjectWithBodyWithResponse request with arbitrary body returning *UpdateProjectResponse
+func (c *ClientWithResponses) UpdateProjectWithBodyWithResponse(ctx context.Context, projectId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectResponse, error) {
+	rsp, err := c.UpdateProjectWithBody(ctx, projectId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProjectResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateProjectWithResponse(ctx context.Context, projectId string, body UpdateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectResponse, error) {
+	rsp, err := c.UpdateProject(ctx, projectId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProjectResponse(rsp)
+}
+
+// GetTagsWithResponse request returning *GetTagsResponse
+func (c *ClientWithResponses) GetTagsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTagsResponse, error) {
+	rsp, err := c.GetTags(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTagsResponse(rsp)
+}
+
+// CreateTagWithBodyWithResponse request with arbitrary body returning *CreateTagResponse
+func (c *ClientWithResponses) CreateTagWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTagResponse, error) {
+	rsp, err := c.CreateTagWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTagResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateTagWithResponse(ctx context.Context, body CreateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTagResponse, error) {
+	rsp, err := c.CreateTag(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTagResponse(rsp)
+}
+
+// DeleteTagWithResponse request returning *DeleteTagResponse
+func (c *ClientWithResponses) DeleteTagWithResponse(ctx context.Context, tagId string, reqEditors ...RequestEditorFn) (*DeleteTagResponse, error) {
+	rsp, err := c.DeleteTag(ctx, tagId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteTagResponse(rsp)
+}
+
+// UpdateTagWithBodyWithResponse request with arbitrary body returning *UpdateTagResponse
+func (c *ClientWithResponses) UpdateTagWithBodyWithResponse(ctx context.Context, tagId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTagResponse, error) {
+	rsp, err := c.UpdateTagWithBody(ctx, tagId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTagResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateTagWithResponse(ctx context.Context, tagId string, body UpdateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTagResponse, error) {
+	rsp, err := c.UpdateTag(ctx, tagId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTagResponse(rsp)
+}
+
+// RevokeScimTokenWithResponse request returning *RevokeScimTokenResponse
+func (c *ClientWithResponses) RevokeScimTokenWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RevokeScimTokenResponse, error) {
+	rsp, err := c.RevokeScimToken(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRevokeScimTokenResponse(rsp)
+}
+
+// RotateScimTokenWithResponse request returning *RotateScimTokenResponse
+func (c *ClientWithResponses) RotateScimTokenWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RotateScimTokenResponse, error) {
+	rsp, err := c.RotateScimToken(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRotateScimTokenResponse(rsp)
+}
+
+// GetTenantSettingsWithResponse request returning *GetTenantSettingsResponse
+func (c *ClientWithResponses) GetTenantSettingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTenantSettingsResponse, error) {
+	rsp, err := c.GetTenantSettings(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTenantSettingsResponse(rsp)
+}
+
+// UpdateTenantSettingsWithBodyWithResponse request with arbitrary body returning *UpdateTenantSettingsResponse
+func (c *ClientWithResponses) UpdateTenantSettingsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTenantSettingsResponse, error) {
+	rsp, err := c.UpdateTenantSettingsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTenantSettingsResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateTenantSettingsWithResponse(ctx context.Context, body UpdateTenantSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTenantSettingsResponse, error) {
+	rsp, err := c.UpdateTenantSettings(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTenantSettingsResponse(rsp)
+}
+
+// GetTodosWithResponse request returning *GetTodosResponse
+func (c *ClientWithResponses) GetTodosWithResponse(ctx context.Context, params *GetTodosParams, reqEditors ...RequestEditorFn) (*GetTodosResponse, error) {
+	rsp, err := c.GetTodos(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTodosResponse(rsp)
+}
+
+// CreateTodoWithBodyWithResponse request with arbitrary body returning *CreateTodoResponse
+func (c *ClientWithResponses) CreateTodoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTodoResponse, error) {
+	rsp, err := c.CreateTodoWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTodoResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateTodoWithResponse(ctx context.Context, body CreateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTodoResponse, error) {
+	rsp, err := c.CreateTodo(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTodoResponse(rsp)
+}
+
+// GetPublicTodosWithResponse request returning *GetPublicTodosResponse
+func (c *ClientWithResponses) GetPublicTodosWithResponse(ctx context.Context, params *GetPublicTodosParams, reqEditors ...RequestEditorFn) (*GetPublicTodosResponse, error) {
+	rsp, err := c.GetPublicTodos(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPublicTodosResponse(rsp)
+}
+
+// GetAssignedTodosWithResponse request returning *GetAssignedTodosResponse
+func (c *ClientWithResponses) GetAssignedTodosWithResponse(ctx context.Context, params *GetAssignedTodosParams, reqEditors ...RequestEditorFn) (*GetAssignedTodosResponse, error) {
+	rsp, err := c.GetAssignedTodos(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAssignedTodosResponse(rsp)
+}
+
+// BulkTodosWithBodyWithResponse request with arbitrary body returning *BulkTodosResponse
+func (c *ClientWithResponses) BulkTodosWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BulkTodosResponse, error) {
+	rsp, err := c.BulkTodosWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBulkTodosResponse(rsp)
+}
+
+func (c *ClientWithResponses) BulkTodosWithResponse(ctx context.Context, body BulkTodosJSONRequestBody, reqEditors ...RequestEditorFn) (*BulkTodosResponse, error) {
+	rsp, err := c.BulkTodos(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBulkTodosResponse(rsp)
+}
+
+// GetTodoCompletionStatsWithResponse request returning *GetTodoCompletionStatsResponse
+func (c *ClientWithResponses) GetTodoCompletionStatsWithResponse(ctx context.Context, params *GetTodoCompletionStatsParams, reqEditors ...RequestEditorFn) (*GetTodoCompletionStatsResponse, error) {
+	rsp, err := c.GetTodoCompletionStats(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTodoCompletionStatsResponse(rsp)
+}
+
+// ExportTodosWithResponse request returning *ExportTodosResponse
+func (c *ClientWithResponses) ExportTodosWithResponse(ctx context.Context, params *ExportTodosParams, reqEditors ...RequestEditorFn) (*ExportTodosResponse, error) {
+	rsp, err := c.ExportTodos(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportTodosResponse(rsp)
+}
+
+// SearchTodosWithResponse request returning *SearchTodosResponse
+func (c *ClientWithResponses) SearchTodosWithResponse(ctx context.Context, params *SearchTodosParams, reqEditors ...RequestEditorFn) (*SearchTodosResponse, error) {
+	rsp, err := c.SearchTodos(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchTodosResponse(rsp)
+}
+
+// GetSharedTodosWithResponse request returning *GetSharedTodosResponse
+func (c *ClientWithResponses) GetSharedTodosWithResponse(ctx context.Context, params *GetSharedTodosParams, reqEditors ...RequestEditorFn) (*GetSharedTodosResponse, error) {
+	rsp, err := c.GetSharedTodos(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSharedTodosResponse(rsp)
+}
+
+// GetTodoTrashWithResponse request returning *GetTodoTrashResponse
+func (c *ClientWithResponses) GetTodoTrashWithResponse(ctx context.Context, params *GetTodoTrashParams, reqEditors ...RequestEditorFn) (*GetTodoTrashResponse, error) {
+	rsp, err := c.GetTodoTrash(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTodoTrashResponse(rsp)
+}
+
+// DeleteTodoWithResponse request returning *DeleteTodoResponse
+func (c *ClientWithResponses) DeleteTodoWithResponse(ctx context.Context, todoId string, params *DeleteTodoParams, reqEditors ...RequestEditorFn) (*DeleteTodoResponse, error) {
+	rsp, err := c.DeleteTodo(ctx, todoId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteTodoResponse(rsp)
+}
+
+// GetTodoWithResponse request returning *GetTodoResponse
+func (c *ClientWithResponses) GetTodoWithResponse(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*GetTodoResponse, error) {
+	rsp, err := c.GetTodo(ctx, todoId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTodoResponse(rsp)
+}
+
+// PatchTodoWithBodyWithResponse request with arbitrary body returning *PatchTodoResponse
+func (c *ClientWithResponses) PatchTodoWithBodyWithResponse(ctx context.Context, todoId string, params *PatchTodoParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchTodoResponse, error) {
+	rsp, err := c.PatchTodoWithBody(ctx, todoId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchTodoResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchTodoWithApplicationMergePatchPlusJSONBodyWithResponse(ctx context.Context, todoId string, params *PatchTodoParams, body PatchTodoApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchTodoResponse, error) {
+	rsp, err := c.PatchTodoWithApplicationMergePatchPlusJSONBody(ctx, todoId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchTodoResponse(rsp)
+}
+
+// UpdateTodoWithBodyWithResponse request with arbitrary body returning *UpdateTodoResponse
+func (c *ClientWithResponses) UpdateTodoWithBodyWithResponse(ctx context.Context, todoId string, params *UpdateTodoParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTodoResponse, error) {
+	rsp, err := c.UpdateTodoWithBody(ctx, todoId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTodoResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateTodoWithResponse(ctx context.Context, todoId string, params *UpdateTodoParams, body UpdateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTodoResponse, error) {
+	rsp, err := c.UpdateTodo(ctx, todoId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTodoResponse(rsp)
+}
+
+// AssignTodoWithBodyWithResponse request with arbitrary body returning *AssignTodoResponse
+func (c *ClientWithResponses) AssignTodoWithBodyWithResponse(ctx context.Context, todoId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AssignTodoResponse, error) {
+	rsp, err := c.AssignTodoWithBody(ctx, todoId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAssignTodoResponse(rsp)
+}
+
+func (c *ClientWithResponses) AssignTodoWithResponse(ctx context.Context, todoId string, body AssignTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*AssignTodoResponse, error) {
+	rsp, err := c.AssignTodo(ctx, todoId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAssignTodoResponse(rsp)
+}
+
+// GetTodoAssignmentsWithResponse request returning *GetTodoAssignmentsResponse
+func (c *ClientWithResponses) GetTodoAssignmentsWithResponse(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*GetTodoAssignmentsResponse, error) {
+	rsp, err := c.GetTodoAssignments(ctx, todoId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTodoAssignmentsResponse(rsp)
+}
+
+// GetTodoAttachmentsWithResponse request returning *GetTodoAttachmentsResponse
+func (c *ClientWithResponses) GetTodoAttachmentsWithResponse(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*GetTodoAttachmentsResponse, error) {
+	rsp, err := c.GetTodoAttachments(ctx, todoId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTodoAttachmentsResponse(rsp)
+}
+
+// UploadTodoAttachmentWithBodyWithResponse request with arbitrary body returning *UploadTodoAttachmentResponse
+func (c *ClientWithResponses) UploadTodoAttachmentWithBodyWithResponse(ctx context.Context, todoId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadTodoAttachmentResponse, error) {
+	rsp, err := c.UploadTodoAttachmentWithBody(ctx, todoId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUploadTodoAttachmentResponse(rsp)
+}
+
+// DeleteTodoAttachmentWithResponse request returning *DeleteTodoAttachmentResponse
+func (c *ClientWithResponses) DeleteTodoAttachmentWithResponse(ctx context.Context, todoId string, attachmentId string, reqEditors ...RequestEditorFn) (*DeleteTodoAttachmentResponse, error) {
+	rsp, err := c.DeleteTodoAttachment(ctx, todoId, attachmentId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteTodoAttachmentResponse(rsp)
+}
+
+// GetTodoAttachmentContentWithResponse request returning *GetTodoAttachmentContentResponse
+func (c *ClientWithResponses) GetTodoAttachmentContentWithResponse(ctx context.Context, todoId string, attachmentId string, reqEditors ...RequestEditorFn) (*GetTodoAttachmentContentResponse, error) {
+	rsp, err := c.GetTodoAttachmentContent(ctx, todoId, attachmentId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTodoAttachmentContentResponse(rsp)
+}
+
+// GetTodoCommentsWithResponse request returning *GetTodoCommentsResponse
+func (c *ClientWithResponses) GetTodoCommentsWithResponse(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*GetTodoCommentsResponse, error) {
+	rsp, err := c.GetTodoComments(ctx, todoId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTodoCommentsResponse(rsp)
+}
+
+// CreateTodoCommentWithBodyWithResponse request with arbitrary body returning *CreateTodoCommentResponse
+func (c *ClientWithResponses) CreateTodoCommentWithBodyWithResponse(ctx context.Context, todoId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTodoCommentResponse, error) {
+	rsp, err := c.CreateTodoCommentWithBody(ctx, todoId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTodoCommentResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateTodoCommentWithResponse(ctx context.Context, todoId string, body CreateTodoCommentJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTodoCommentResponse, error) {
+	rsp, err := c.CreateTodoComment(ctx, todoId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTodoCommentResponse(rsp)
+}
+
+// DeleteTodoCommentWithResponse request returning *DeleteTodoCommentResponse
+func (c *ClientWithResponses) DeleteTodoCommentWithResponse(ctx context.Context, todoId string, commentId string, reqEditors ...RequestEditorFn) (*DeleteTodoCommentResponse, error) {
+	rsp, err := c.DeleteTodoComment(ctx, todoId, commentId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteTodoCommentResponse(rsp)
+}
+
+// UpdateTodoCommentWithBodyWithResponse request with arbitrary body returning *UpdateTodoCommentResponse
+func (c *ClientWithResponses) UpdateTodoCommentWithBodyWithResponse(ctx context.Context, todoId string, commentId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTodoCommentResponse, error) {
+	rsp, err := c.UpdateTodoCommentWithBody(ctx, todoId, commentId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTodoCommentResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateTodoCommentWithResponse(ctx context.Context, todoId string, commentId string, body UpdateTodoCommentJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTodoCommentResponse, error) {
+	rsp, err := c.UpdateTodoComment(ctx, todoId, commentId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTodoCommentResponse(rsp)
+}
+
+// GetTodoHistoryWithResponse request returning *GetTodoHistoryResponse
+func (c *ClientWithResponses) GetTodoHistoryWithResponse(ctx context.Context, todoId string, params *GetTodoHistoryParams, reqEditors ...RequestEditorFn) (*GetTodoHistoryResponse, error) {
+	rsp, err := c.GetTodoHistory(ctx, todoId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTodoHistoryResponse(rsp)
+}
+
+// RevertTodoWithResponse request returning *RevertTodoResponse
+func (c *ClientWithResponses) RevertTodoWithResponse(ctx context.Context, todoId string, revisionId string, reqEditors ...RequestEditorFn) (*RevertTodoResponse, error) {
+	rsp, err := c.RevertTodo(ctx, todoId, revisionId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRevertTodoResponse(rsp)
+}
+
+// SetTodoParentWithBodyWithResponse request with arbitrary body returning *SetTodoParentResponse
+func (c *ClientWithResponses) SetTodoParentWithBodyWithResponse(ctx context.Context, todoId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetTodoParentResponse, error) {
+	rsp, err := c.SetTodoParentWithBody(ctx, todoId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetTodoParentResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetTodoParentWithResponse(ctx context.Context, todoId string, body SetTodoParentJSONRequestBody, reqEditors ...RequestEditorFn) (*SetTodoParentResponse, error) {
+	rsp, err := c.SetTodoParent(ctx, todoId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetTodoParentResponse(rsp)
+}
+
+// ReorderTodoWithBodyWithResponse request with arbitrary body returning *ReorderTodoResponse
+func (c *ClientWithResponses) ReorderTodoWithBodyWithResponse(ctx context.Context, todoId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReorderTodoResponse, error) {
+	rsp, err := c.ReorderTodoWithBody(ctx, todoId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReorderTodoResponse(rsp)
+}
+
+func (c *ClientWithResponses) ReorderTodoWithResponse(ctx context.Context, todoId string, body ReorderTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*ReorderTodoResponse, error) {
+	rsp, err := c.ReorderTodo(ctx, todoId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReorderTodoResponse(rsp)
+}
+
+// MoveTodoWithBodyWithResponse request with arbitrary body returning *MoveTodoResponse
+func (c *ClientWithResponses) MoveTodoWithBodyWithResponse(ctx context.Context, todoId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MoveTodoResponse, error) {
+	rsp, err := c.MoveTodoWithBody(ctx, todoId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMoveTodoResponse(rsp)
+}
+
+func (c *ClientWithResponses) MoveTodoWithResponse(ctx context.Context, todoId string, body MoveTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*MoveTodoResponse, error) {
+	rsp, err := c.MoveTodo(ctx, todoId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMoveTodoResponse(rsp)
+}
+
+// StopTodoRecurrenceWithResponse request returning *StopTodoRecurrenceResponse
+func (c *ClientWithResponses) StopTodoRecurrenceWithResponse(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*StopTodoRecurrenceResponse, error) {
+	rsp, err := c.StopTodoRecurrence(ctx, todoId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStopTodoRecurrenceResponse(rsp)
+}
+
+// SetTodoRecurrenceWithBodyWithResponse request with arbitrary body returning *SetTodoRecurrenceResponse
+func (c *ClientWithResponses) SetTodoRecurrenceWithBodyWithResponse(ctx context.Context, todoId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetTodoRecurrenceResponse, error) {
+	rsp, err := c.SetTodoRecurrenceWithBody(ctx, todoId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetTodoRecurrenceResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetTodoRecurrenceWithResponse(ctx context.Context, todoId string, body SetTodoRecurrenceJSONRequestBody, reqEditors ...RequestEditorFn) (*SetTodoRecurrenceResponse, error) {
+	rsp, err := c.SetTodoRecurrence(ctx, todoId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetTodoRecurrenceResponse(rsp)
+}
+
+// SkipTodoOccurrenceWithResponse request returning *SkipTodoOccurrenceResponse
+func (c *ClientWithResponses) SkipTodoOccurrenceWithResponse(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*SkipTodoOccurrenceResponse, error) {
+	rsp, err := c.SkipTodoOccurrence(ctx, todoId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSkipTodoOccurrenceResponse(rsp)
+}
+
+// GetTodoRemindersWithResponse request returning *GetTodoRemindersResponse
+func (c *ClientWithResponses) GetTodoRemindersWithResponse(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*GetTodoRemindersResponse, error) {
+	rsp, err := c.GetTodoReminders(ctx, todoId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTodoRemindersResponse(rsp)
+}
+
+// CreateTodoReminderWithBodyWithResponse request with arbitrary body returning *CreateTodoReminderResponse
+func (c *ClientWithResponses) CreateTodoReminderWithBodyWithResponse(ctx context.Context, todoId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTodoReminderResponse, error) {
+	rsp, err := c.CreateTodoReminderWithBody(ctx, todoId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTodoReminderResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateTodoReminderWithResponse(ctx context.Context, todoId string, body CreateTodoReminderJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTodoReminderResponse, error) {
+	rsp, err := c.CreateTodoReminder(ctx, todoId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTodoReminderResponse(rsp)
+}
+
+// DeleteTodoReminderWithResponse request returning *DeleteTodoReminderResponse
+func (c *ClientWithResponses) DeleteTodoReminderWithResponse(ctx context.Context, todoId string, reminderId string, reqEditors ...RequestEditorFn) (*DeleteTodoReminderResponse, error) {
+	rsp, err := c.DeleteTodoReminder(ctx, todoId, reminderId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteTodoReminderResponse(rsp)
+}
+
+// RestoreTodoWithResponse request returning *RestoreTodoResponse
+func (c *ClientWithResponses) RestoreTodoWithResponse(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*RestoreTodoResponse, error) {
+	rsp, err := c.RestoreTodo(ctx, todoId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRestoreTodoResponse(rsp)
+}
+
+// GetTodoSharesWithResponse request returning *GetTodoSharesResponse
+func (c *ClientWithResponses) GetTodoSharesWithResponse(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*GetTodoSharesResponse, error) {
+	rsp, err := c.GetTodoShares(ctx, todoId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTodoSharesResponse(rsp)
+}
+
+// UnshareTodoWithResponse request returning *UnshareTodoResponse
+func (c *ClientWithResponses) UnshareTodoWithResponse(ctx context.Context, todoId string, userId string, reqEditors ...RequestEditorFn) (*UnshareTodoResponse, error) {
+	rsp, err := c.UnshareTodo(ctx, todoId, userId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUnshareTodoResponse(rsp)
+}
+
+// ShareTodoWithBodyWithResponse request with arbitrary body returning *ShareTodoResponse
+func (c *ClientWithResponses) ShareTodoWithBodyWithResponse(ctx context.Context, todoId string, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ShareTodoResponse, error) {
+	rsp, err := c.ShareTodoWithBody(ctx, todoId, userId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseShareTodoResponse(rsp)
+}
+
+func (c *ClientWithResponses) ShareTodoWithResponse(ctx context.Context, todoId string, userId string, body ShareTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*ShareTodoResponse, error) {
+	rsp, err := c.ShareTodo(ctx, todoId, userId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseShareTodoResponse(rsp)
+}
+
+// DetachTodoTagWithResponse request returning *DetachTodoTagResponse
+func (c *ClientWithResponses) DetachTodoTagWithResponse(ctx context.Context, todoId string, tagId string, reqEditors ...RequestEditorFn) (*DetachTodoTagResponse, error) {
+	rsp, err := c.DetachTodoTag(ctx, todoId, tagId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDetachTodoTagResponse(rsp)
+}
+
+// AttachTodoTagWithResponse request returning *AttachTodoTagResponse
+func (c *ClientWithResponses) AttachTodoTagWithResponse(ctx context.Context, todoId string, tagId string, reqEditors ...RequestEditorFn) (*AttachTodoTagResponse, error) {
+	rsp, err := c.AttachTodoTag(ctx, todoId, tagId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAttachTodoTagResponse(rsp)
+}
+
+// ParseGetAuditEventsResponse parses an HTTP response from a GetAuditEventsWithResponse call
+func ParseGetAuditEventsResponse(rsp *http.Response) (*GetAuditEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAuditEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuditEventListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLoginResponse parses an HTTP response from a LoginWithResponse call
+func ParseLoginResponse(rsp *http.Response) (*LoginResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LoginResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuthResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRequestMagicLinkResponse parses an HTTP response from a RequestMagicLinkWithResponse call
+func ParseRequestMagicLinkResponse(rsp *http.Response) (*RequestMagicLinkResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RequestMagicLinkResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseConsumeMagicLinkResponse parses an HTTP response from a ConsumeMagicLinkWithResponse call
+func ParseConsumeMagicLinkResponse(rsp *http.Response) (*ConsumeMagicLinkResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ConsumeMagicLinkResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuthResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRequestPasswordResetResponse parses an HTTP response from a RequestPasswordResetWithResponse call
+func ParseRequestPasswordResetResponse(rsp *http.Response) (*RequestPasswordResetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RequestPasswordResetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseResetPasswordResponse parses an HTTP response from a ResetPasswordWithResponse call
+func ParseResetPasswordResponse(rsp *http.Response) (*ResetPasswordResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ResetPasswordResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRefreshTokenResponse parses an HTTP response from a RefreshTokenWithResponse call
+func ParseRefreshTokenResponse(rsp *http.Response) (*RefreshTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RefreshTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuthResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRegisterResponse parses an HTTP response from a RegisterWithResponse call
+func ParseRegisterResponse(rsp *http.Response) (*RegisterResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RegisterResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest AuthResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseVerifyEmailResponse parses an HTTP response from a VerifyEmailWithResponse call
+func ParseVerifyEmailResponse(rsp *http.Response) (*VerifyEmailResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &VerifyEmailResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Message *string `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseHealthCheckResponse parses an HTTP response from a HealthCheckWithResponse call
+func ParseHealthCheckResponse(rsp *http.Response) (*HealthCheckResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HealthCheckResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Status *string `json:"status,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteMeResponse parses an HTTP response from a DeleteMeWithResponse call
+func ParseDeleteMeResponse(rsp *http.Response) (*DeleteMeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteMeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetMeResponse parses an HTTP response from a GetMeWithResponse call
+func ParseGetMeResponse(rsp *http.Response) (*GetMeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetMeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateMeResponse parses an HTTP response from a UpdateMeWithResponse call
+func ParseUpdateMeResponse(rsp *http.Response) (*UpdateMeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateMeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRevokeCalendarTokenResponse parses an HTTP response from a RevokeCalendarTokenWithResponse call
+func ParseRevokeCalendarTokenResponse(rsp *http.Response) (*RevokeCalendarTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RevokeCalendarTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRotateCalendarTokenResponse parses an HTTP response from a RotateCalendarTokenWithResponse call
+func ParseRotateCalendarTokenResponse(rsp *http.Response) (*RotateCalendarTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RotateCalendarTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CalendarTokenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExportMeResponse parses an HTTP response from a ExportMeWithResponse call
+func ParseExportMeResponse(rsp *http.Response) (*ExportMeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExportMeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AccountExportResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseChangePasswordResponse parses an HTTP response from a ChangePasswordWithResponse call
+func ParseChangePasswordResponse(rsp *http.Response) (*ChangePasswordResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ChangePasswordResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetNotificationsResponse parses an HTTP response from a GetNotificationsWithResponse call
+func ParseGetNotificationsResponse(rsp *http.Response) (*GetNotificationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetNotificationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NotificationListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseMarkNotificationReadResponse parses an HTTP response from a MarkNotificationReadWithResponse call
+func ParseMarkNotificationReadResponse(rsp *http.Response) (*MarkNotificationReadResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MarkNotificationReadResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetProjectsResponse parses an HTTP response from a GetProjectsWithResponse call
+func ParseGetProjectsResponse(rsp *http.Response) (*GetProjectsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetProjectsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProjectListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateProjectResponse parses an HTTP response from a CreateProjectWithResponse call
+func ParseCreateProjectResponse(rsp *http.Response) (*CreateProjectResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateProjectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ProjectResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteProjectResponse parses an HTTP response from a DeleteProjectWithResponse call
+func ParseDeleteProjectResponse(rsp *http.Response) (*DeleteProjectResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteProjectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetProjectResponse parses an HTTP response from a GetProjectWithResponse call
+func ParseGetProjectResponse(rsp *http.Response) (*GetProjectResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetProjectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProjectResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateProjectResponse parses an HTTP response from a UpdateProjectWithResponse call
+func ParseUpdateProjectResponse(rsp *http.Response) (*UpdateProjectResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateProjectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProjectResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTagsResponse parses an HTTP response from a GetTagsWithResponse call
+func ParseGetTagsResponse(rsp *http.Response) (*GetTagsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTagsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TagListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateTagResponse parses an HTTP response from a CreateTagWithResponse call
+func ParseCreateTagResponse(rsp *http.Response) (*CreateTagResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateTagResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest TagResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteTagResponse parses an HTTP response from a DeleteTagWithResponse call
+func ParseDeleteTagResponse(rsp *http.Response) (*DeleteTagResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteTagResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateTagResponse parses an HTTP response from a UpdateTagWithResponse call
+func ParseUpdateTagResponse(rsp *http.Response) (*UpdateTagResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateTagResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TagResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRevokeScimTokenResponse parses an HTTP response from a RevokeScimTokenWithResponse call
+func ParseRevokeScimTokenResponse(rsp *http.Response) (*RevokeScimTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RevokeScimTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRotateScimTokenResponse parses an HTTP response from a RotateScimTokenWithResponse call
+func ParseRotateScimTokenResponse(rsp *http.Response) (*RotateScimTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RotateScimTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ScimTokenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTenantSettingsResponse parses an HTTP response from a GetTenantSettingsWithResponse call
+func ParseGetTenantSettingsResponse(rsp *http.Response) (*GetTenantSettingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTenantSettingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TenantSettingsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateTenantSettingsResponse parses an HTTP response from a UpdateTenantSettingsWithResponse call
+func ParseUpdateTenantSettingsResponse(rsp *http.Response) (*UpdateTenantSettingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateTenantSettingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TenantSettingsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTodosResponse parses an HTTP response from a GetTodosWithResponse call
+func ParseGetTodosResponse(rsp *http.Response) (*GetTodosResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTodosResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TodoListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateTodoResponse parses an HTTP response from a CreateTodoWithResponse call
+func ParseCreateTodoResponse(rsp *http.Response) (*CreateTodoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateTodoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest TodoResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPublicTodosResponse parses an HTTP response from a GetPublicTodosWithResponse call
+func ParseGetPublicTodosResponse(rsp *http.Response) (*GetPublicTodosResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPublicTodosResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TodoListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAssignedTodosResponse parses an HTTP response from a GetAssignedTodosWithResponse call
+func ParseGetAssignedTodosResponse(rsp *http.Response) (*GetAssignedTodosResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAssignedTodosResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TodoListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseBulkTodosResponse parses an HTTP response from a BulkTodosWithResponse call
+func ParseBulkTodosResponse(rsp *http.Response) (*BulkTodosResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &BulkTodosResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BulkTodoResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTodoCompletionStatsResponse parses an HTTP response from a GetTodoCompletionStatsWithResponse call
+func ParseGetTodoCompletionStatsResponse(rsp *http.Response) (*GetTodoCompletionStatsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTodoCompletionStatsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CompletionStatsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExportTodosResponse parses an HTTP response from a ExportTodosWithResponse call
+func ParseExportTodosResponse(rsp *http.Response) (*ExportTodosResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExportTodosResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSearchTodosResponse parses an HTTP response from a SearchTodosWithResponse call
+func ParseSearchTodosResponse(rsp *http.Response) (*SearchTodosResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SearchTodosResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TodoSearchResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSharedTodosResponse parses an HTTP response from a GetSharedTodosWithResponse call
+func ParseGetSharedTodosResponse(rsp *http.Response) (*GetSharedTodosResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSharedTodosResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TodoListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTodoTrashResponse parses an HTTP response from a GetTodoTrashWithResponse call
+func ParseGetTodoTrashResponse(rsp *http.Response) (*GetTodoTrashResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTodoTrashResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TodoListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteTodoResponse parses an HTTP response from a DeleteTodoWithResponse call
+func ParseDeleteTodoResponse(rsp *http.Response) (*DeleteTodoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteTodoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTodoResponse parses an HTTP response from a GetTodoWithResponse call
+func ParseGetTodoResponse(rsp *http.Response) (*GetTodoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTodoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TodoResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchTodoResponse parses an HTTP response from a PatchTodoWithResponse call
+func ParsePatchTodoResponse(rsp *http.Response) (*PatchTodoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchTodoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TodoResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON415 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateTodoResponse parses an HTTP response from a UpdateTodoWithResponse call
+func ParseUpdateTodoResponse(rsp *http.Response) (*UpdateTodoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateTodoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TodoResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAssignTodoResponse parses an HTTP response from a AssignTodoWithResponse call
+func ParseAssignTodoResponse(rsp *http.Response) (*AssignTodoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AssignTodoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TodoResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTodoAssignmentsResponse parses an HTTP response from a GetTodoAssignmentsWithResponse call
+func ParseGetTodoAssignmentsResponse(rsp *http.Response) (*GetTodoAssignmentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTodoAssignmentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TodoAssignmentListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTodoAttachmentsResponse parses an HTTP response from a GetTodoAttachmentsWithResponse call
+func ParseGetTodoAttachmentsResponse(rsp *http.Response) (*GetTodoAttachmentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTodoAttachmentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AttachmentListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUploadTodoAttachmentResponse parses an HTTP response from a UploadTodoAttachmentWithResponse call
+func ParseUploadTodoAttachmentResponse(rsp *http.Response) (*UploadTodoAttachmentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UploadTodoAttachmentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest AttachmentResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteTodoAttachmentResponse parses an HTTP response from a DeleteTodoAttachmentWithResponse call
+func ParseDeleteTodoAttachmentResponse(rsp *http.Response) (*DeleteTodoAttachmentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteTodoAttachmentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTodoAttachmentContentResponse parses an HTTP response from a GetTodoAttachmentContentWithResponse call
+func ParseGetTodoAttachmentContentResponse(rsp *http.Response) (*GetTodoAttachmentContentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTodoAttachmentContentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTodoCommentsResponse parses an HTTP response from a GetTodoCommentsWithResponse call
+func ParseGetTodoCommentsResponse(rsp *http.Response) (*GetTodoCommentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTodoCommentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CommentListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateTodoCommentResponse parses an HTTP response from a CreateTodoCommentWithResponse call
+func ParseCreateTodoCommentResponse(rsp *http.Response) (*CreateTodoCommentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateTodoCommentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CommentResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteTodoCommentResponse parses an HTTP response from a DeleteTodoCommentWithResponse call
+func ParseDeleteTodoCommentResponse(rsp *http.Response) (*DeleteTodoCommentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteTodoCommentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateTodoCommentResponse parses an HTTP response from a UpdateTodoCommentWithResponse call
+func ParseUpdateTodoCommentResponse(rsp *http.Response) (*UpdateTodoCommentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateTodoCommentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CommentResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTodoHistoryResponse parses an HTTP response from a GetTodoHistoryWithResponse call
+func ParseGetTodoHistoryResponse(rsp *http.Response) (*GetTodoHistoryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTodoHistoryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TodoRevisionListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRevertTodoResponse parses an HTTP response from a RevertTodoWithResponse call
+func ParseRevertTodoResponse(rsp *http.Response) (*RevertTodoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RevertTodoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TodoResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetTodoParentResponse parses an HTTP response from a SetTodoParentWithResponse call
+func ParseSetTodoParentResponse(rsp *http.Response) (*SetTodoParentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetTodoParentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TodoResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseReorderTodoResponse parses an HTTP response from a ReorderTodoWithResponse call
+func ParseReorderTodoResponse(rsp *http.Response) (*ReorderTodoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReorderTodoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TodoResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseMoveTodoResponse parses an HTTP response from a MoveTodoWithResponse call
+func ParseMoveTodoResponse(rsp *http.Response) (*MoveTodoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MoveTodoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TodoResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseStopTodoRecurrenceResponse parses an HTTP response from a StopTodoRecurrenceWithResponse call
+func ParseStopTodoRecurrenceResponse(rsp *http.Response) (*StopTodoRecurrenceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StopTodoRecurrenceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TodoResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetTodoRecurrenceResponse parses an HTTP response from a SetTodoRecurrenceWithResponse call
+func ParseSetTodoRecurrenceResponse(rsp *http.Response) (*SetTodoRecurrenceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetTodoRecurrenceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TodoResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSkipTodoOccurrenceResponse parses an HTTP response from a SkipTodoOccurrenceWithResponse call
+func ParseSkipTodoOccurrenceResponse(rsp *http.Response) (*SkipTodoOccurrenceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SkipTodoOccurrenceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TodoResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTodoRemindersResponse parses an HTTP response from a GetTodoRemindersWithResponse call
+func ParseGetTodoRemindersResponse(rsp *http.Response) (*GetTodoRemindersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTodoRemindersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ReminderListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateTodoReminderResponse parses an HTTP response from a CreateTodoReminderWithResponse call
+func ParseCreateTodoReminderResponse(rsp *http.Response) (*CreateTodoReminderResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateTodoReminderResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ReminderResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteTodoReminderResponse parses an HTTP response from a DeleteTodoReminderWithResponse call
+func ParseDeleteTodoReminderResponse(rsp *http.Response) (*DeleteTodoReminderResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteTodoReminderResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRestoreTodoResponse parses an HTTP response from a RestoreTodoWithResponse call
+func ParseRestoreTodoResponse(rsp *http.Response) (*RestoreTodoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RestoreTodoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TodoResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTodoSharesResponse parses an HTTP response from a GetTodoSharesWithResponse call
+func ParseGetTodoSharesResponse(rsp *http.Response) (*GetTodoSharesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTodoSharesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TodoShareListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUnshareTodoResponse parses an HTTP response from a UnshareTodoWithResponse call
+func ParseUnshareTodoResponse(rsp *http.Response) (*UnshareTodoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UnshareTodoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseShareTodoResponse parses an HTTP response from a ShareTodoWithResponse call
+func ParseShareTodoResponse(rsp *http.Response) (*ShareTodoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ShareTodoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TodoShare
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDetachTodoTagResponse parses an HTTP response from a DetachTodoTagWithResponse call
+func ParseDetachTodoTagResponse(rsp *http.Response) (*DetachTodoTagResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DetachTodoTagResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAttachTodoTagResponse parses an HTTP response from a AttachTodoTagWithResponse call
+func ParseAttachTodoTagResponse(rsp *http.Response) (*AttachTodoTagResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AttachTodoTagResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TodoResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Get the tenant's security audit log
+	// (GET /audit-events)
+	GetAuditEvents(ctx echo.Context, params GetAuditEventsParams) error
+	// Login with email and password
+	// (POST /auth/login)
+	Login(ctx echo.Context) error
+	// Request a magic sign-in link by email
+	// (POST /auth/magic-link)
+	RequestMagicLink(ctx echo.Context) error
+	// Sign in with a magic link token
+	// (POST /auth/magic-link/consume)
+	ConsumeMagicLink(ctx echo.Context) error
+	// Request a password reset link by email
+	// (POST /auth/password-reset)
+	RequestPasswordReset(ctx echo.Context) error
+	// Set a new password with a password reset token
+	// (POST /auth/password-reset/confirm)
+	ResetPassword(ctx echo.Context) error
+	// Refresh access token
+	// (POST /auth/refresh)
+	RefreshToken(ctx echo.Context) error
+	// Register a new user
+	// (POST /auth/register)
+	Register(ctx echo.Context) error
+	// Verify email with token
+	// (POST /auth/verify-email)
+	VerifyEmail(ctx echo.Context) error
+	// Health check
+	// (GET /health)
+	HealthCheck(ctx echo.Context) error
+	// Delete the current user's account
+	// (DELETE /me)
+	DeleteMe(ctx echo.Context) error
+	// Get current user info
+	// (GET /me)
+	GetMe(ctx echo.Context) error
+	// Update current user info
+	// (PUT /me)
+	UpdateMe(ctx echo.Context) error
+	// Revoke the calendar feed URL
+	// (DELETE /me/calendar-token)
+	RevokeCalendarToken(ctx echo.Context) error
+	// Issue a new calendar feed URL
+	// (POST /me/calendar-token)
+	RotateCalendarToken(ctx echo.Context) error
+	// Export the current user's personal data
+	// (GET /me/export)
+	ExportMe(ctx echo.Context) error
+	// Change the current user's password
+	// (PUT /me/password)
+	ChangePassword(ctx echo.Context) error
+	// List the caller's in-app notifications, newest first
+	// (GET /notifications)
+	GetNotifications(ctx echo.Context, params GetNotificationsParams) error
+	// Mark a notification as read
+	// (POST /notifications/{notificationId}/read)
+	MarkNotificationRead(ctx echo.Context, notificationId string) error
+	// List the current user's own and shared projects
+	// (GET /projects)
+	GetProjects(ctx echo.Context, params GetProjectsParams) error
+	// Create a project
+	// (POST /projects)
+	CreateProject(ctx echo.Context) error
+	// Delete a project (owner only)
+	// (DELETE /projects/{projectId})
+	DeleteProject(ctx echo.Context, projectId string) error
+	// Get a project by ID
+	// (GET /projects/{projectId})
+	GetProject(ctx echo.Context, projectId string) error
+	// Update or archive a project (owner only)
+	// (PATCH /projects/{projectId})
+	UpdateProject(ctx echo.Context, projectId string) error
+	// List the tenant's tags
+	// (GET /tags)
+	GetTags(ctx echo.Context) error
+	// Create a tag
+	// (POST /tags)
+	CreateTag(ctx echo.Context) error
+	// Delete a tag and detach it from all todos (tenant admins only)
+	// (DELETE /tags/{tagId})
+	DeleteTag(ctx echo.Context, tagId string) error
+	// Rename or recolor a tag (tenant admins only)
+	// (PATCH /tags/{tagId})
+	UpdateTag(ctx echo.Context, tagId string) error
+	// Revoke the SCIM provisioning token
+	// (DELETE /tenant/scim-token)
+	RevokeScimToken(ctx echo.Context) error
+	// Issue a new SCIM provisioning token
+	// (POST /tenant/scim-token)
+	RotateScimToken(ctx echo.Context) error
+	// Get the current tenant's settings
+	// (GET /tenant/settings)
+	GetTenantSettings(ctx echo.Context) error
+	// Update the current tenant's settings
+	// (PUT /tenant/settings)
+	UpdateTenantSettings(ctx echo.Context) error
+	// Get all todos for current user
+	// (GET /todos)
+	GetTodos(ctx echo.Context, params GetTodosParams) error
+	// Create a new todo
+	// (POST /todos)
+	CreateTodo(ctx echo.Context) error
+	// Get public todos in the same tenant
+	// (GET /todos-public)
+	GetPublicTodos(ctx echo.Context, params GetPublicTodosParams) error
+	// Get the todos assigned to the current user
+	// (GET /todos/assigned)
+	GetAssignedTodos(ctx echo.Context, params GetAssignedTodosParams) error
+	// Apply one operation to many todos
+	// (POST /todos/bulk)
+	BulkTodos(ctx echo.Context) error
+	// Count the current user's completed todos per day
+	// (GET /todos/completion-stats)
+	GetTodoCompletionStats(ctx echo.Context, params GetTodoCompletionStatsParams) error
+	// Export the current user's todos as an iCalendar file
+	// (GET /todos/export)
+	ExportTodos(ctx echo.Context, params ExportTodosParams) error
+	// Full-text search over the current user's own and public todos
+	// (GET /todos/search)
+	SearchTodos(ctx echo.Context, params SearchTodosParams) error
+	// Get the todos other members shared with the current user
+	// (GET /todos/shared)
+	GetSharedTodos(ctx echo.Context, params GetSharedTodosParams) error
+	// List the caller's todos in the trash
+	// (GET /todos/trash)
+	GetTodoTrash(ctx echo.Context, params GetTodoTrashParams) error
+	// Delete a todo
+	// (DELETE /todos/{todoId})
+	DeleteTodo(ctx echo.Context, todoId string, params DeleteTodoParams) error
+	// Get a todo by ID
+	// (GET /todos/{todoId})
+	GetTodo(ctx echo.Context, todoId string) error
+	// Patch a todo
+	// (PATCH /todos/{todoId})
+	PatchTodo(ctx echo.Context, todoId string, params PatchTodoParams) error
+	// Update a todo
+	// (PUT /todos/{todoId})
+	UpdateTodo(ctx echo.Context, todoId string, params UpdateTodoParams) error
+	// Assign a todo to a member of the tenant, or unassign it
+	// (PUT /todos/{todoId}/assignee)
+	AssignTodo(ctx echo.Context, todoId string) error
+	// Get the assignment history of a todo, oldest first
+	// (GET /todos/{todoId}/assignments)
+	GetTodoAssignments(ctx echo.Context, todoId string) error
+	// List the attachments of a todo
+	// (GET /todos/{todoId}/attachments)
+	GetTodoAttachments(ctx echo.Context, todoId string) error
+	// Attach a file to a todo
+	// (POST /todos/{todoId}/attachments)
+	UploadTodoAttachment(ctx echo.Context, todoId string) error
+	// Delete an attachment
+	// (DELETE /todos/{todoId}/attachments/{attachmentId})
+	DeleteTodoAttachment(ctx echo.Context, todoId string, attachmentId string) error
+	// Download an attachment
+	// (GET /todos/{todoId}/attachments/{attachmentId}/content)
+	GetTodoAttachmentContent(ctx echo.Context, todoId string, attachmentId string) error
+	// List the comments on a todo
+	// (GET /todos/{todoId}/comments)
+	GetTodoComments(ctx echo.Context, todoId string) error
+	// Comment on a todo
+	// (POST /todos/{todoId}/comments)
+	CreateTodoComment(ctx echo.Context, todoId string) error
+	// Delete a comment
+	// (DELETE /todos/{todoId}/comments/{commentId})
+	DeleteTodoComment(ctx echo.Context, todoId string, commentId string) error
+	// Edit a comment
+	// (PUT /todos/{todoId}/comments/{commentId})
+	UpdateTodoComment(ctx echo.Context, todoId string, commentId string) error
+	// Get the revision history of a todo, newest first
+	// (GET /todos/{todoId}/history)
+	GetTodoHistory(ctx echo.Context, todoId string, params GetTodoHistoryParams) error
+	// Revert a todo to a prior revision
+	// (POST /todos/{todoId}/history/{revisionId}/revert)
+	RevertTodo(ctx echo.Context, todoId string, revisionId string) error
+	// Make a todo a subtask of another todo, or a top-level todo
+	// (PUT /todos/{todoId}/parent)
+	SetTodoParent(ctx echo.Context, todoId string) error
+	// Move a todo in the manual order
+	// (PUT /todos/{todoId}/position)
+	ReorderTodo(ctx echo.Context, todoId string) error
+	// Move a todo into a project, or out of its project
+	// (PUT /todos/{todoId}/project)
+	MoveTodo(ctx echo.Context, todoId string) error
+	// Stop a recurring series
+	// (DELETE /todos/{todoId}/recurrence)
+	StopTodoRecurrence(ctx echo.Context, todoId string) error
+	// Make a todo recur, or change its recurrence rule
+	// (PUT /todos/{todoId}/recurrence)
+	SetTodoRecurrence(ctx echo.Context, todoId string) error
+	// Skip the current occurrence of a recurring todo
+	// (POST /todos/{todoId}/recurrence/skip)
+	SkipTodoOccurrence(ctx echo.Context, todoId string) error
+	// List the reminders of a todo
+	// (GET /todos/{todoId}/reminders)
+	GetTodoReminders(ctx echo.Context, todoId string) error
+	// Add a reminder to a todo
+	// (POST /todos/{todoId}/reminders)
+	CreateTodoReminder(ctx echo.Context, todoId string) error
+	// Delete a reminder
+	// (DELETE /todos/{todoId}/reminders/{reminderId})
+	DeleteTodoReminder(ctx echo.Context, todoId string, reminderId string) error
+	// Restore a todo from the trash
+	// (POST /todos/{todoId}/restore)
+	RestoreTodo(ctx echo.Context, todoId string) error
+	// Get who a todo is shared with, oldest share first
+	// (GET /todos/{todoId}/shares)
+	GetTodoShares(ctx echo.Context, todoId string) error
+	// Stop sharing a todo with a member
+	// (DELETE /todos/{todoId}/shares/{userId})
+	UnshareTodo(ctx echo.Context, todoId string, userId string) error
+	// Share a todo with a member of the tenant, or change their permission
+	// (PUT /todos/{todoId}/shares/{userId})
+	ShareTodo(ctx echo.Context, todoId string, userId string) error
+	// Detach a tag from a todo
+	// (DELETE /todos/{todoId}/tags/{tagId})
+	DetachTodoTag(ctx echo.Context, todoId string, tagId string) error
+	// Attach a tag to a todo
+	// (PUT /todos/{todoId}/tags/{tagId})
+	AttachTodoTag(ctx echo.Context, todoId string, tagId string) error
+}
+
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+// GetAuditEvents converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAuditEvents(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAuditEventsParams
+	// ------------- Optional query parameter "actor_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "actor_id", ctx.QueryParams(), &params.ActorId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter actor_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "action" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "action", ctx.QueryParams(), &params.Action)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter action: %s", err))
+	}
+
+	// ------------- Optional query parameter "target_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "target_id", ctx.QueryParams(), &params.TargetId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter target_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAuditEvents(ctx, params)
+	return err
+}
+
+// Login converts echo context to params.
+func (w *ServerInterfaceWrapper) Login(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.Login(ctx)
+	return err
+}
+
+// RequestMagicLink converts echo context to params.
+func (w *ServerInterfaceWrapper) RequestMagicLink(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RequestMagicLink(ctx)
+	return err
+}
+
+// ConsumeMagicLink converts echo context to params.
+func (w *ServerInterfaceWrapper) ConsumeMagicLink(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ConsumeMagicLink(ctx)
+	return err
+}
+
+// RequestPasswordReset converts echo context to params.
+func (w *ServerInterfaceWrapper) RequestPasswordReset(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RequestPasswordReset(ctx)
+	return err
+}
+
+// ResetPassword converts echo context to params.
+func (w *ServerInterfaceWrapper) ResetPassword(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ResetPassword(ctx)
+	return err
+}
+
+// RefreshToken converts echo context to params.
+func (w *ServerInterfaceWrapper) RefreshToken(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RefreshToken(ctx)
+	return err
+}
+
+// Register converts echo context to params.
+func (w *ServerInterfaceWrapper) Register(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.Register(ctx)
+	return err
+}
+
+// VerifyEmail converts echo context to params.
+func (w *ServerInterfaceWrapper) VerifyEmail(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.VerifyEmail(ctx)
+	return err
+}
+
+// HealthCheck converts echo context to params.
+func (w *ServerInterfaceWrapper) HealthCheck(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.HealthCheck(ctx)
+	return err
+}
+
+// DeleteMe converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteMe(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteMe(ctx)
+	return err
+}
+
+// GetMe converts echo context to params.
+func (w *ServerInterfaceWrapper) GetMe(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetMe(ctx)
+	return err
+}
+
+// UpdateMe converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateMe(ctx echo.Context) error {
 	var err error
 
-	var pathParam0 string
+	ctx.Set(BearerScopes, []string{})
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "todoId", runtime.ParamLocationPath, todoId)
-	if err != nil {
-		return nil, err
-	}
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateMe(ctx)
+	return err
+}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+// RevokeCalendarToken converts echo context to params.
+func (w *ServerInterfaceWrapper) RevokeCalendarToken(ctx echo.Context) error {
+	var err error
 
-	operationPath := fmt.Sprintf("/todos/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	ctx.Set(BearerScopes, []string{})
 
-	queryURL, err := serverURL.Parse(operationPath)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RevokeCalendarToken(ctx)
+	return err
+}
+
+// RotateCalendarToken converts echo context to params.
+func (w *ServerInterfaceWrapper) RotateCalendarToken(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RotateCalendarToken(ctx)
+	return err
+}
+
+// ExportMe converts echo context to params.
+func (w *ServerInterfaceWrapper) ExportMe(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ExportMe(ctx)
+	return err
+}
+
+// ChangePassword converts echo context to params.
+func (w *ServerInterfaceWrapper) ChangePassword(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ChangePassword(ctx)
+	return err
+}
+
+// GetNotifications converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNotifications(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetNotificationsParams
+	// ------------- Optional query parameter "unread" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "unread", ctx.QueryParams(), &params.Unread)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter unread: %s", err))
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
-	return req, nil
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetNotifications(ctx, params)
+	return err
 }
 
-// NewUpdateTodoRequest calls the generic UpdateTodo builder with application/json body
-func NewUpdateTodoRequest(server string, todoId string, body UpdateTodoJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// MarkNotificationRead converts echo context to params.
+func (w *ServerInterfaceWrapper) MarkNotificationRead(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "notificationId" -------------
+	var notificationId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "notificationId", ctx.Param("notificationId"), &notificationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter notificationId: %s", err))
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateTodoRequestWithBody(server, todoId, "application/json", bodyReader)
+
+	ctx.Set(BearerScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.MarkNotificationRead(ctx, notificationId)
+	return err
 }
 
-// NewUpdateTodoRequestWithBody generates requests for UpdateTodo with any type of body
-func NewUpdateTodoRequestWithBody(server string, todoId string, contentType string, body io.Reader) (*http.Request, error) {
+// GetProjects converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProjects(ctx echo.Context) error {
 	var err error
 
-	var pathParam0 string
+	ctx.Set(BearerScopes, []string{})
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "todoId", runtime.ParamLocationPath, todoId)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProjectsParams
+	// ------------- Optional query parameter "include_archived" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_archived", ctx.QueryParams(), &params.IncludeArchived)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include_archived: %s", err))
 	}
 
-	serverURL, err := url.Parse(server)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetProjects(ctx, params)
+	return err
+}
+
+// CreateProject converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateProject(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateProject(ctx)
+	return err
+}
+
+// DeleteProject converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteProject(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectId" -------------
+	var projectId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", ctx.Param("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectId: %s", err))
 	}
 
-	operationPath := fmt.Sprintf("/todos/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	ctx.Set(BearerScopes, []string{})
 
-	queryURL, err := serverURL.Parse(operationPath)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteProject(ctx, projectId)
+	return err
+}
+
+// GetProject converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProject(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectId" -------------
+	var projectId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", ctx.Param("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectId: %s", err))
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	ctx.Set(BearerScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetProject(ctx, projectId)
+	return err
+}
+
+// UpdateProject converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateProject(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectId" -------------
+	var projectId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", ctx.Param("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectId: %s", err))
 	}
 
-	req.Header.Add("Content-Type", contentType)
+	ctx.Set(BearerScopes, []string{})
 
-	return req, nil
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateProject(ctx, projectId)
+	return err
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	return nil
+// GetTags converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTags(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTags(ctx)
+	return err
 }
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
+// CreateTag converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateTag(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateTag(ctx)
+	return err
 }
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+// DeleteTag converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTag(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tagId" -------------
+	var tagId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tagId", ctx.Param("tagId"), &tagId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tagId: %s", err))
 	}
-	return &ClientWithResponses{client}, nil
+
+	ctx.Set(BearerScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTag(ctx, tagId)
+	return err
 }
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+// UpdateTag converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateTag(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tagId" -------------
+	var tagId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tagId", ctx.Param("tagId"), &tagId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tagId: %s", err))
 	}
+
+	ctx.Set(BearerScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateTag(ctx, tagId)
+	return err
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// LoginWithBodyWithResponse request with any body
-	LoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginResponse, error)
+// RevokeScimToken converts echo context to params.
+func (w *ServerInterfaceWrapper) RevokeScimToken(ctx echo.Context) error {
+	var err error
 
-	LoginWithResponse(ctx context.Context, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*LoginResponse, error)
+	ctx.Set(BearerScopes, []string{})
 
-	// RefreshTokenWithBodyWithResponse request with any body
-	RefreshTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RefreshTokenResponse, error)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RevokeScimToken(ctx)
+	return err
+}
 
-	RefreshTokenWithResponse(ctx context.Context, body RefreshTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*RefreshTokenResponse, error)
+// RotateScimToken converts echo context to params.
+func (w *ServerInterfaceWrapper) RotateScimToken(ctx echo.Context) error {
+	var err error
 
-	// RegisterWithBodyWithResponse request with any body
-	RegisterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegisterResponse, error)
+	ctx.Set(BearerScopes, []string{})
 
-	RegisterWithResponse(ctx context.Context, body RegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*RegisterResponse, error)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RotateScimToken(ctx)
+	return err
+}
 
-	// VerifyEmailWithBodyWithResponse request with any body
-	VerifyEmailWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VerifyEmailResponse, error)
+// GetTenantSettings converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTenantSettings(ctx echo.Context) error {
+	var err error
 
-	VerifyEmailWithResponse(ctx context.Context, body VerifyEmailJSONRequestBody, reqEditors ...RequestEditorFn) (*VerifyEmailResponse, error)
+	ctx.Set(BearerScopes, []string{})
 
-	// HealthCheckWithResponse request
-	HealthCheckWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthCheckResponse, error)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTenantSettings(ctx)
+	return err
+}
 
-	// GetMeWithResponse request
-	GetMeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetMeResponse, error)
+// UpdateTenantSettings converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateTenantSettings(ctx echo.Context) error {
+	var err error
 
-	// UpdateMeWithBodyWithResponse request with any body
-	UpdateMeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMeResponse, error)
+	ctx.Set(BearerScopes, []string{})
 
-	UpdateMeWithResponse(ctx context.Context, body UpdateMeJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMeResponse, error)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateTenantSettings(ctx)
+	return err
+}
 
-	// GetTodosWithResponse request
-	GetTodosWithResponse(ctx context.Context, params *GetTodosParams, reqEditors ...RequestEditorFn) (*GetTodosResponse, error)
+// GetTodos converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTodos(ctx echo.Context) error {
+	var err error
 
-	// CreateTodoWithBodyWithResponse request with any body
-	CreateTodoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTodoResponse, error)
+	ctx.Set(BearerScopes, []string{})
 
-	CreateTodoWithResponse(ctx context.Context, body CreateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTodoResponse, error)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTodosParams
+	// ------------- Optional query parameter "completed" -------------
 
-	// GetPublicTodosWithResponse request
-	GetPublicTodosWithResponse(ctx context.Context, params *GetPublicTodosParams, reqEditors ...RequestEditorFn) (*GetPublicTodosResponse, error)
+	err = runtime.BindQueryParameter("form", true, false, "completed", ctx.QueryParams(), &params.Completed)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter completed: %s", err))
+	}
 
-	// DeleteTodoWithResponse request
-	DeleteTodoWithResponse(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*DeleteTodoResponse, error)
+	// ------------- Optional query parameter "overdue" -------------
 
-	// GetTodoWithResponse request
-	GetTodoWithResponse(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*GetTodoResponse, error)
+	err = runtime.BindQueryParameter("form", true, false, "overdue", ctx.QueryParams(), &params.Overdue)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter overdue: %s", err))
+	}
 
-	// UpdateTodoWithBodyWithResponse request with any body
-	UpdateTodoWithBodyWithResponse(ctx context.Context, todoId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTodoResponse, error)
+	// ------------- Optional query parameter "due_before" -------------
 
-	UpdateTodoWithResponse(ctx context.Context, todoId string, body UpdateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTodoResponse, error)
-}
+	err = runtime.BindQueryParameter("form", true, false, "due_before", ctx.QueryParams(), &params.DueBefore)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter due_before: %s", err))
+	}
 
-type LoginResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AuthResponse
-	JSON401      *ErrorResponse
-}
+	// ------------- Optional query parameter "due_after" -------------
 
-// Status returns HTTPResponse.Status
-func (r LoginResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	err = runtime.BindQueryParameter("form", true, false, "due_after", ctx.QueryParams(), &params.DueAfter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter due_after: %s", err))
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r LoginResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	// ------------- Optional query parameter "created_before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_before", ctx.QueryParams(), &params.CreatedBefore)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter created_before: %s", err))
 	}
-	return 0
-}
 
-type RefreshTokenResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AuthResponse
-	JSON401      *ErrorResponse
-}
+	// ------------- Optional query parameter "created_after" -------------
 
-// Status returns HTTPResponse.Status
-func (r RefreshTokenResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	err = runtime.BindQueryParameter("form", true, false, "created_after", ctx.QueryParams(), &params.CreatedAfter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter created_after: %s", err))
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r RefreshTokenResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	// ------------- Optional query parameter "completed_before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "completed_before", ctx.QueryParams(), &params.CompletedBefore)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter completed_before: %s", err))
 	}
-	return 0
-}
 
-type RegisterResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *AuthResponse
-	JSON400      *ErrorResponse
-	JSON409      *ErrorResponse
-}
+	// ------------- Optional query parameter "completed_after" -------------
 
-// Status returns HTTPResponse.Status
-func (r RegisterResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	err = runtime.BindQueryParameter("form", true, false, "completed_after", ctx.QueryParams(), &params.CompletedAfter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter completed_after: %s", err))
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r RegisterResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	// ------------- Optional query parameter "is_public" -------------
 
-type VerifyEmailResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Message *string `json:"message,omitempty"`
+	err = runtime.BindQueryParameter("form", true, false, "is_public", ctx.QueryParams(), &params.IsPublic)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter is_public: %s", err))
 	}
-	JSON400 *ErrorResponse
-}
 
-// Status returns HTTPResponse.Status
-func (r VerifyEmailResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	// ------------- Optional query parameter "q" -------------
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r VerifyEmailResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	err = runtime.BindQueryParameter("form", true, false, "q", ctx.QueryParams(), &params.Q)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter q: %s", err))
 	}
-	return 0
-}
 
-type HealthCheckResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Status *string `json:"status,omitempty"`
-	}
-}
+	// ------------- Optional query parameter "tag" -------------
 
-// Status returns HTTPResponse.Status
-func (r HealthCheckResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	err = runtime.BindQueryParameter("form", true, false, "tag", ctx.QueryParams(), &params.Tag)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tag: %s", err))
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r HealthCheckResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	// ------------- Optional query parameter "project_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "project_id", ctx.QueryParams(), &params.ProjectId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_id: %s", err))
 	}
-	return 0
-}
 
-type GetMeResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *UserResponse
-	JSON401      *ErrorResponse
-}
+	// ------------- Optional query parameter "sort" -------------
 
-// Status returns HTTPResponse.Status
-func (r GetMeResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetMeResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", ctx.QueryParams(), &params.Order)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter order: %s", err))
 	}
-	return 0
-}
 
-type UpdateMeResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *UserResponse
-	JSON401      *ErrorResponse
-}
+	// ------------- Optional query parameter "limit" -------------
 
-// Status returns HTTPResponse.Status
-func (r UpdateMeResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateMeResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
 	}
-	return 0
-}
 
-type GetTodosResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TodoListResponse
-	JSON401      *ErrorResponse
-}
+	// ------------- Optional query parameter "include_total" -------------
 
-// Status returns HTTPResponse.Status
-func (r GetTodosResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	err = runtime.BindQueryParameter("form", true, false, "include_total", ctx.QueryParams(), &params.IncludeTotal)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include_total: %s", err))
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetTodosResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
-	return 0
-}
 
-type CreateTodoResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *TodoResponse
-	JSON400      *ErrorResponse
-	JSON401      *ErrorResponse
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTodos(ctx, params)
+	return err
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateTodoResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+// CreateTodo converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateTodo(ctx echo.Context) error {
+	var err error
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateTodoResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	ctx.Set(BearerScopes, []string{})
 
-type GetPublicTodosResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TodoListResponse
-	JSON401      *ErrorResponse
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateTodo(ctx)
+	return err
 }
 
-// Status returns HTTPResponse.Status
-func (r GetPublicTodosResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+// GetPublicTodos converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPublicTodos(ctx echo.Context) error {
+	var err error
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetPublicTodosResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	ctx.Set(BearerScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPublicTodosParams
+	// ------------- Optional query parameter "tag" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag", ctx.QueryParams(), &params.Tag)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tag: %s", err))
 	}
-	return 0
-}
 
-type DeleteTodoResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON401      *ErrorResponse
-	JSON404      *ErrorResponse
-}
+	// ------------- Optional query parameter "project_id" -------------
 
-// Status returns HTTPResponse.Status
-func (r DeleteTodoResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	err = runtime.BindQueryParameter("form", true, false, "project_id", ctx.QueryParams(), &params.ProjectId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteTodoResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
 	}
-	return 0
-}
 
-type GetTodoResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TodoResponse
-	JSON401      *ErrorResponse
-	JSON404      *ErrorResponse
-}
+	// ------------- Optional query parameter "include_total" -------------
 
-// Status returns HTTPResponse.Status
-func (r GetTodoResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	err = runtime.BindQueryParameter("form", true, false, "include_total", ctx.QueryParams(), &params.IncludeTotal)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include_total: %s", err))
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetTodoResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
-	return 0
-}
 
-type UpdateTodoResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TodoResponse
-	JSON400      *ErrorResponse
-	JSON401      *ErrorResponse
-	JSON404      *ErrorResponse
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPublicTodos(ctx, params)
+	return err
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateTodoResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetAssignedTodos converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAssignedTodos(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAssignedTodosParams
+	// ------------- Optional query parameter "completed" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "completed", ctx.QueryParams(), &params.Completed)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter completed: %s", err))
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateTodoResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	// ------------- Optional query parameter "overdue" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "overdue", ctx.QueryParams(), &params.Overdue)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter overdue: %s", err))
 	}
-	return 0
-}
 
-// LoginWithBodyWithResponse request with arbitrary body returning *LoginResponse
-func (c *ClientWithResponses) LoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginResponse, error) {
-	rsp, err := c.LoginWithBody(ctx, contentType, body, reqEditors...)
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
 	}
-	return ParseLoginResponse(rsp)
-}
 
-func (c *ClientWithResponses) LoginWithResponse(ctx context.Context, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*LoginResponse, error) {
-	rsp, err := c.Login(ctx, body, reqEditors...)
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", ctx.QueryParams(), &params.Order)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter order: %s", err))
 	}
-	return ParseLoginResponse(rsp)
-}
 
-// RefreshTokenWithBodyWithResponse request with arbitrary body returning *RefreshTokenResponse
-func (c *ClientWithResponses) RefreshTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RefreshTokenResponse, error) {
-	rsp, err := c.RefreshTokenWithBody(ctx, contentType, body, reqEditors...)
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
-	return ParseRefreshTokenResponse(rsp)
-}
 
-func (c *ClientWithResponses) RefreshTokenWithResponse(ctx context.Context, body RefreshTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*RefreshTokenResponse, error) {
-	rsp, err := c.RefreshToken(ctx, body, reqEditors...)
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
 	}
-	return ParseRefreshTokenResponse(rsp)
-}
 
-// RegisterWithBodyWithResponse request with arbitrary body returning *RegisterResponse
-func (c *ClientWithResponses) RegisterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegisterResponse, error) {
-	rsp, err := c.RegisterWithBody(ctx, contentType, body, reqEditors...)
+	// ------------- Optional query parameter "include_total" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_total", ctx.QueryParams(), &params.IncludeTotal)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include_total: %s", err))
 	}
-	return ParseRegisterResponse(rsp)
-}
 
-func (c *ClientWithResponses) RegisterWithResponse(ctx context.Context, body RegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*RegisterResponse, error) {
-	rsp, err := c.Register(ctx, body, reqEditors...)
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
-	return ParseRegisterResponse(rsp)
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAssignedTodos(ctx, params)
+	return err
 }
 
-// VerifyEmailWithBodyWithResponse request with arbitrary body returning *VerifyEmailResponse
-func (c *ClientWithResponses) VerifyEmailWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VerifyEmailResponse, error) {
-	rsp, err := c.VerifyEmailWithBody(ctx, contentType, body, reqEditors...)
+// BulkTodos converts echo context to params.
+func (w *ServerInterfaceWrapper) BulkTodos(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.BulkTodos(ctx)
+	return err
+}
+
+// GetTodoCompletionStats converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTodoCompletionStats(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTodoCompletionStatsParams
+	// ------------- Optional query parameter "period" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "period", ctx.QueryParams(), &params.Period)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter period: %s", err))
 	}
-	return ParseVerifyEmailResponse(rsp)
-}
 
-func (c *ClientWithResponses) VerifyEmailWithResponse(ctx context.Context, body VerifyEmailJSONRequestBody, reqEditors ...RequestEditorFn) (*VerifyEmailResponse, error) {
-	rsp, err := c.VerifyEmail(ctx, body, reqEditors...)
+	// ------------- Optional query parameter "tz" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tz", ctx.QueryParams(), &params.Tz)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tz: %s", err))
 	}
-	return ParseVerifyEmailResponse(rsp)
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTodoCompletionStats(ctx, params)
+	return err
 }
 
-// HealthCheckWithResponse request returning *HealthCheckResponse
-func (c *ClientWithResponses) HealthCheckWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthCheckResponse, error) {
-	rsp, err := c.HealthCheck(ctx, reqEditors...)
+// ExportTodos converts echo context to params.
+func (w *ServerInterfaceWrapper) ExportTodos(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportTodosParams
+	// ------------- Optional query parameter "component" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "component", ctx.QueryParams(), &params.Component)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter component: %s", err))
 	}
-	return ParseHealthCheckResponse(rsp)
-}
 
-// GetMeWithResponse request returning *GetMeResponse
-func (c *ClientWithResponses) GetMeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetMeResponse, error) {
-	rsp, err := c.GetMe(ctx, reqEditors...)
+	// ------------- Optional query parameter "completed" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "completed", ctx.QueryParams(), &params.Completed)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter completed: %s", err))
 	}
-	return ParseGetMeResponse(rsp)
-}
 
-// UpdateMeWithBodyWithResponse request with arbitrary body returning *UpdateMeResponse
-func (c *ClientWithResponses) UpdateMeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMeResponse, error) {
-	rsp, err := c.UpdateMeWithBody(ctx, contentType, body, reqEditors...)
+	// ------------- Optional query parameter "overdue" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "overdue", ctx.QueryParams(), &params.Overdue)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter overdue: %s", err))
 	}
-	return ParseUpdateMeResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateMeWithResponse(ctx context.Context, body UpdateMeJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMeResponse, error) {
-	rsp, err := c.UpdateMe(ctx, body, reqEditors...)
+	// ------------- Optional query parameter "due_before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "due_before", ctx.QueryParams(), &params.DueBefore)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter due_before: %s", err))
 	}
-	return ParseUpdateMeResponse(rsp)
-}
 
-// GetTodosWithResponse request returning *GetTodosResponse
-func (c *ClientWithResponses) GetTodosWithResponse(ctx context.Context, params *GetTodosParams, reqEditors ...RequestEditorFn) (*GetTodosResponse, error) {
-	rsp, err := c.GetTodos(ctx, params, reqEditors...)
+	// ------------- Optional query parameter "due_after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "due_after", ctx.QueryParams(), &params.DueAfter)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter due_after: %s", err))
 	}
-	return ParseGetTodosResponse(rsp)
-}
 
-// CreateTodoWithBodyWithResponse request with arbitrary body returning *CreateTodoResponse
-func (c *ClientWithResponses) CreateTodoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTodoResponse, error) {
-	rsp, err := c.CreateTodoWithBody(ctx, contentType, body, reqEditors...)
+	// ------------- Optional query parameter "is_public" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "is_public", ctx.QueryParams(), &params.IsPublic)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter is_public: %s", err))
 	}
-	return ParseCreateTodoResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateTodoWithResponse(ctx context.Context, body CreateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTodoResponse, error) {
-	rsp, err := c.CreateTodo(ctx, body, reqEditors...)
+	// ------------- Optional query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "q", ctx.QueryParams(), &params.Q)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter q: %s", err))
 	}
-	return ParseCreateTodoResponse(rsp)
-}
 
-// GetPublicTodosWithResponse request returning *GetPublicTodosResponse
-func (c *ClientWithResponses) GetPublicTodosWithResponse(ctx context.Context, params *GetPublicTodosParams, reqEditors ...RequestEditorFn) (*GetPublicTodosResponse, error) {
-	rsp, err := c.GetPublicTodos(ctx, params, reqEditors...)
+	// ------------- Optional query parameter "tag" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag", ctx.QueryParams(), &params.Tag)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tag: %s", err))
 	}
-	return ParseGetPublicTodosResponse(rsp)
-}
 
-// DeleteTodoWithResponse request returning *DeleteTodoResponse
-func (c *ClientWithResponses) DeleteTodoWithResponse(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*DeleteTodoResponse, error) {
-	rsp, err := c.DeleteTodo(ctx, todoId, reqEditors...)
+	// ------------- Optional query parameter "project_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "project_id", ctx.QueryParams(), &params.ProjectId)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_id: %s", err))
 	}
-	return ParseDeleteTodoResponse(rsp)
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ExportTodos(ctx, params)
+	return err
 }
 
-// GetTodoWithResponse request returning *GetTodoResponse
-func (c *ClientWithResponses) GetTodoWithResponse(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*GetTodoResponse, error) {
-	rsp, err := c.GetTodo(ctx, todoId, reqEditors...)
+// SearchTodos converts echo context to params.
+func (w *ServerInterfaceWrapper) SearchTodos(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchTodosParams
+	// ------------- Required query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "q", ctx.QueryParams(), &params.Q)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter q: %s", err))
 	}
-	return ParseGetTodoResponse(rsp)
-}
 
-// UpdateTodoWithBodyWithResponse request with arbitrary body returning *UpdateTodoResponse
-func (c *ClientWithResponses) UpdateTodoWithBodyWithResponse(ctx context.Context, todoId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTodoResponse, error) {
-	rsp, err := c.UpdateTodoWithBody(ctx, todoId, contentType, body, reqEditors...)
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
-	return ParseUpdateTodoResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateTodoWithResponse(ctx context.Context, todoId string, body UpdateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTodoResponse, error) {
-	rsp, err := c.UpdateTodo(ctx, todoId, body, reqEditors...)
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
-	return ParseUpdateTodoResponse(rsp)
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SearchTodos(ctx, params)
+	return err
 }
 
-// ParseLoginResponse parses an HTTP response from a LoginWithResponse call
-func ParseLoginResponse(rsp *http.Response) (*LoginResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// GetSharedTodos converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSharedTodos(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSharedTodosParams
+	// ------------- Optional query parameter "completed" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "completed", ctx.QueryParams(), &params.Completed)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter completed: %s", err))
 	}
 
-	response := &LoginResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// ------------- Optional query parameter "overdue" -------------
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AuthResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	err = runtime.BindQueryParameter("form", true, false, "overdue", ctx.QueryParams(), &params.Overdue)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter overdue: %s", err))
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	// ------------- Optional query parameter "sort" -------------
 
+	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
 	}
 
-	return response, nil
-}
+	// ------------- Optional query parameter "order" -------------
 
-// ParseRefreshTokenResponse parses an HTTP response from a RefreshTokenWithResponse call
-func ParseRefreshTokenResponse(rsp *http.Response) (*RefreshTokenResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindQueryParameter("form", true, false, "order", ctx.QueryParams(), &params.Order)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter order: %s", err))
 	}
 
-	response := &RefreshTokenResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// ------------- Optional query parameter "limit" -------------
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AuthResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	// ------------- Optional query parameter "cursor" -------------
 
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
 	}
 
-	return response, nil
-}
+	// ------------- Optional query parameter "include_total" -------------
 
-// ParseRegisterResponse parses an HTTP response from a RegisterWithResponse call
-func ParseRegisterResponse(rsp *http.Response) (*RegisterResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindQueryParameter("form", true, false, "include_total", ctx.QueryParams(), &params.IncludeTotal)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include_total: %s", err))
 	}
 
-	response := &RegisterResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest AuthResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetSharedTodos(ctx, params)
+	return err
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+// GetTodoTrash converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTodoTrash(ctx echo.Context) error {
+	var err error
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
+	ctx.Set(BearerScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTodoTrashParams
+	// ------------- Optional query parameter "limit" -------------
 
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
-	return response, nil
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTodoTrash(ctx, params)
+	return err
 }
 
-// ParseVerifyEmailResponse parses an HTTP response from a VerifyEmailWithResponse call
-func ParseVerifyEmailResponse(rsp *http.Response) (*VerifyEmailResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// DeleteTodo converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTodo(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "todoId" -------------
+	var todoId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "todoId", ctx.Param("todoId"), &todoId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter todoId: %s", err))
 	}
 
-	response := &VerifyEmailResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	ctx.Set(BearerScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteTodoParams
+	// ------------- Optional query parameter "children" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "children", ctx.QueryParams(), &params.Children)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter children: %s", err))
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			Message *string `json:"message,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
 		}
-		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
 		}
-		response.JSON400 = &dest
 
+		params.IfMatch = &IfMatch
 	}
 
-	return response, nil
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTodo(ctx, todoId, params)
+	return err
 }
 
-// ParseHealthCheckResponse parses an HTTP response from a HealthCheckWithResponse call
-func ParseHealthCheckResponse(rsp *http.Response) (*HealthCheckResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// GetTodo converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTodo(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "todoId" -------------
+	var todoId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "todoId", ctx.Param("todoId"), &todoId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter todoId: %s", err))
 	}
 
-	response := &HealthCheckResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	ctx.Set(BearerScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTodo(ctx, todoId)
+	return err
+}
+
+// PatchTodo converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchTodo(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "todoId" -------------
+	var todoId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "todoId", ctx.Param("todoId"), &todoId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter todoId: %s", err))
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			Status *string `json:"status,omitempty"`
+	ctx.Set(BearerScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchTodoParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
 		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
 		}
-		response.JSON200 = &dest
 
+		params.IfMatch = &IfMatch
 	}
 
-	return response, nil
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchTodo(ctx, todoId, params)
+	return err
 }
 
-// ParseGetMeResponse parses an HTTP response from a GetMeWithResponse call
-func ParseGetMeResponse(rsp *http.Response) (*GetMeResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// UpdateTodo converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateTodo(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "todoId" -------------
+	var todoId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "todoId", ctx.Param("todoId"), &todoId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter todoId: %s", err))
 	}
 
-	response := &GetMeResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	ctx.Set(BearerScopes, []string{})
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest UserResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateTodoParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
 		}
-		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
 		}
-		response.JSON401 = &dest
 
+		params.IfMatch = &IfMatch
 	}
 
-	return response, nil
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateTodo(ctx, todoId, params)
+	return err
 }
 
-// ParseUpdateMeResponse parses an HTTP response from a UpdateMeWithResponse call
-func ParseUpdateMeResponse(rsp *http.Response) (*UpdateMeResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// AssignTodo converts echo context to params.
+func (w *ServerInterfaceWrapper) AssignTodo(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "todoId" -------------
+	var todoId string
 
-	response := &UpdateMeResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	err = runtime.BindStyledParameterWithOptions("simple", "todoId", ctx.Param("todoId"), &todoId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter todoId: %s", err))
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest UserResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	ctx.Set(BearerScopes, []string{})
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AssignTodo(ctx, todoId)
+	return err
+}
+
+// GetTodoAssignments converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTodoAssignments(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "todoId" -------------
+	var todoId string
 
+	err = runtime.BindStyledParameterWithOptions("simple", "todoId", ctx.Param("todoId"), &todoId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter todoId: %s", err))
 	}
 
-	return response, nil
+	ctx.Set(BearerScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTodoAssignments(ctx, todoId)
+	return err
 }
 
-// ParseGetTodosResponse parses an HTTP response from a GetTodosWithResponse call
-func ParseGetTodosResponse(rsp *http.Response) (*GetTodosResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// GetTodoAttachments converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTodoAttachments(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "todoId" -------------
+	var todoId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "todoId", ctx.Param("todoId"), &todoId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter todoId: %s", err))
 	}
 
-	response := &GetTodosResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	ctx.Set(BearerScopes, []string{})
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TodoListResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTodoAttachments(ctx, todoId)
+	return err
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+// UploadTodoAttachment converts echo context to params.
+func (w *ServerInterfaceWrapper) UploadTodoAttachment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "todoId" -------------
+	var todoId string
 
+	err = runtime.BindStyledParameterWithOptions("simple", "todoId", ctx.Param("todoId"), &todoId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter todoId: %s", err))
 	}
 
-	return response, nil
+	ctx.Set(BearerScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UploadTodoAttachment(ctx, todoId)
+	return err
 }
 
-// ParseCreateTodoResponse parses an HTTP response from a CreateTodoWithResponse call
-func ParseCreateTodoResponse(rsp *http.Response) (*CreateTodoResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// DeleteTodoAttachment converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTodoAttachment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "todoId" -------------
+	var todoId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "todoId", ctx.Param("todoId"), &todoId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter todoId: %s", err))
 	}
 
-	response := &CreateTodoResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// ------------- Path parameter "attachmentId" -------------
+	var attachmentId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "attachmentId", ctx.Param("attachmentId"), &attachmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter attachmentId: %s", err))
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest TodoResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+	ctx.Set(BearerScopes, []string{})
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTodoAttachment(ctx, todoId, attachmentId)
+	return err
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+// GetTodoAttachmentContent converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTodoAttachmentContent(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "todoId" -------------
+	var todoId string
 
+	err = runtime.BindStyledParameterWithOptions("simple", "todoId", ctx.Param("todoId"), &todoId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter todoId: %s", err))
 	}
 
-	return response, nil
-}
+	// ------------- Path parameter "attachmentId" -------------
+	var attachmentId string
 
-// ParseGetPublicTodosResponse parses an HTTP response from a GetPublicTodosWithResponse call
-func ParseGetPublicTodosResponse(rsp *http.Response) (*GetPublicTodosResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindStyledParameterWithOptions("simple", "attachmentId", ctx.Param("attachmentId"), &attachmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter attachmentId: %s", err))
 	}
 
-	response := &GetPublicTodosResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	ctx.Set(BearerScopes, []string{})
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TodoListResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTodoAttachmentContent(ctx, todoId, attachmentId)
+	return err
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+// GetTodoComments converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTodoComments(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "todoId" -------------
+	var todoId string
 
+	err = runtime.BindStyledParameterWithOptions("simple", "todoId", ctx.Param("todoId"), &todoId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter todoId: %s", err))
 	}
 
-	return response, nil
+	ctx.Set(BearerScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTodoComments(ctx, todoId)
+	return err
 }
 
-// ParseDeleteTodoResponse parses an HTTP response from a DeleteTodoWithResponse call
-func ParseDeleteTodoResponse(rsp *http.Response) (*DeleteTodoResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// CreateTodoComment converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateTodoComment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "todoId" -------------
+	var todoId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "todoId", ctx.Param("todoId"), &todoId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter todoId: %s", err))
 	}
 
-	response := &DeleteTodoResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	ctx.Set(BearerScopes, []string{})
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateTodoComment(ctx, todoId)
+	return err
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+// DeleteTodoComment converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTodoComment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "todoId" -------------
+	var todoId string
 
+	err = runtime.BindStyledParameterWithOptions("simple", "todoId", ctx.Param("todoId"), &todoId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter todoId: %s", err))
 	}
 
-	return response, nil
-}
+	// ------------- Path parameter "commentId" -------------
+	var commentId string
 
-// ParseGetTodoResponse parses an HTTP response from a GetTodoWithResponse call
-func ParseGetTodoResponse(rsp *http.Response) (*GetTodoResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindStyledParameterWithOptions("simple", "commentId", ctx.Param("commentId"), &commentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
-	}
-
-	response := &GetTodoResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter commentId: %s", err))
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TodoResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	ctx.Set(BearerScopes, []string{})
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTodoComment(ctx, todoId, commentId)
+	return err
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+// UpdateTodoComment converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateTodoComment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "todoId" -------------
+	var todoId string
 
+	err = runtime.BindStyledParameterWithOptions("simple", "todoId", ctx.Param("todoId"), &todoId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter todoId: %s", err))
 	}
 
-	return response, nil
-}
+	// ------------- Path parameter "commentId" -------------
+	var commentId string
 
-// ParseUpdateTodoResponse parses an HTTP response from a UpdateTodoWithResponse call
-func ParseUpdateTodoResponse(rsp *http.Response) (*UpdateTodoResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindStyledParameterWithOptions("simple", "commentId", ctx.Param("commentId"), &commentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter commentId: %s", err))
 	}
 
-	response := &UpdateTodoResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	ctx.Set(BearerScopes, []string{})
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TodoResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateTodoComment(ctx, todoId, commentId)
+	return err
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+// GetTodoHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTodoHistory(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "todoId" -------------
+	var todoId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "todoId", ctx.Param("todoId"), &todoId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter todoId: %s", err))
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	ctx.Set(BearerScopes, []string{})
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTodoHistoryParams
+	// ------------- Optional query parameter "limit" -------------
 
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
-	return response, nil
-}
+	// ------------- Optional query parameter "offset" -------------
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Login with email and password
-	// (POST /auth/login)
-	Login(ctx echo.Context) error
-	// Refresh access token
-	// (POST /auth/refresh)
-	RefreshToken(ctx echo.Context) error
-	// Register a new user
-	// (POST /auth/register)
-	Register(ctx echo.Context) error
-	// Verify email with token
-	// (POST /auth/verify-email)
-	VerifyEmail(ctx echo.Context) error
-	// Health check
-	// (GET /health)
-	HealthCheck(ctx echo.Context) error
-	// Get current user info
-	// (GET /me)
-	GetMe(ctx echo.Context) error
-	// Update current user info
-	// (PUT /me)
-	UpdateMe(ctx echo.Context) error
-	// Get all todos for current user
-	// (GET /todos)
-	GetTodos(ctx echo.Context, params GetTodosParams) error
-	// Create a new todo
-	// (POST /todos)
-	CreateTodo(ctx echo.Context) error
-	// Get public todos in the same tenant
-	// (GET /todos-public)
-	GetPublicTodos(ctx echo.Context, params GetPublicTodosParams) error
-	// Delete a todo
-	// (DELETE /todos/{todoId})
-	DeleteTodo(ctx echo.Context, todoId string) error
-	// Get a todo by ID
-	// (GET /todos/{todoId})
-	GetTodo(ctx echo.Context, todoId string) error
-	// Update a todo
-	// (PUT /todos/{todoId})
-	UpdateTodo(ctx echo.Context, todoId string) error
-}
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
 
-// ServerInterfaceWrapper converts echo contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler ServerInterface
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTodoHistory(ctx, todoId, params)
+	return err
 }
 
-// Login converts echo context to params.
-func (w *ServerInterfaceWrapper) Login(ctx echo.Context) error {
+// RevertTodo converts echo context to params.
+func (w *ServerInterfaceWrapper) RevertTodo(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "todoId" -------------
+	var todoId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "todoId", ctx.Param("todoId"), &todoId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter todoId: %s", err))
+	}
+
+	// ------------- Path parameter "revisionId" -------------
+	var revisionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "revisionId", ctx.Param("revisionId"), &revisionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter revisionId: %s", err))
+	}
+
+	ctx.Set(BearerScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.Login(ctx)
+	err = w.Handler.RevertTodo(ctx, todoId, revisionId)
 	return err
 }
 
-// RefreshToken converts echo context to params.
-func (w *ServerInterfaceWrapper) RefreshToken(ctx echo.Context) error {
+// SetTodoParent converts echo context to params.
+func (w *ServerInterfaceWrapper) SetTodoParent(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "todoId" -------------
+	var todoId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "todoId", ctx.Param("todoId"), &todoId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter todoId: %s", err))
+	}
+
+	ctx.Set(BearerScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.RefreshToken(ctx)
+	err = w.Handler.SetTodoParent(ctx, todoId)
 	return err
 }
 
-// Register converts echo context to params.
-func (w *ServerInterfaceWrapper) Register(ctx echo.Context) error {
+// ReorderTodo converts echo context to params.
+func (w *ServerInterfaceWrapper) ReorderTodo(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "todoId" -------------
+	var todoId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "todoId", ctx.Param("todoId"), &todoId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter todoId: %s", err))
+	}
+
+	ctx.Set(BearerScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.Register(ctx)
+	err = w.Handler.ReorderTodo(ctx, todoId)
 	return err
 }
 
-// VerifyEmail converts echo context to params.
-func (w *ServerInterfaceWrapper) VerifyEmail(ctx echo.Context) error {
+// MoveTodo converts echo context to params.
+func (w *ServerInterfaceWrapper) MoveTodo(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "todoId" -------------
+	var todoId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "todoId", ctx.Param("todoId"), &todoId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter todoId: %s", err))
+	}
+
+	ctx.Set(BearerScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.VerifyEmail(ctx)
+	err = w.Handler.MoveTodo(ctx, todoId)
 	return err
 }
 
-// HealthCheck converts echo context to params.
-func (w *ServerInterfaceWrapper) HealthCheck(ctx echo.Context) error {
+// StopTodoRecurrence converts echo context to params.
+func (w *ServerInterfaceWrapper) StopTodoRecurrence(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "todoId" -------------
+	var todoId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "todoId", ctx.Param("todoId"), &todoId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter todoId: %s", err))
+	}
+
+	ctx.Set(BearerScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.HealthCheck(ctx)
+	err = w.Handler.StopTodoRecurrence(ctx, todoId)
 	return err
 }
 
-// GetMe converts echo context to params.
-func (w *ServerInterfaceWrapper) GetMe(ctx echo.Context) error {
+// SetTodoRecurrence converts echo context to params.
+func (w *ServerInterfaceWrapper) SetTodoRecurrence(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "todoId" -------------
+	var todoId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "todoId", ctx.Param("todoId"), &todoId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter todoId: %s", err))
+	}
 
 	ctx.Set(BearerScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetMe(ctx)
+	err = w.Handler.SetTodoRecurrence(ctx, todoId)
 	return err
 }
 
-// UpdateMe converts echo context to params.
-func (w *ServerInterfaceWrapper) UpdateMe(ctx echo.Context) error {
+// SkipTodoOccurrence converts echo context to params.
+func (w *ServerInterfaceWrapper) SkipTodoOccurrence(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "todoId" -------------
+	var todoId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "todoId", ctx.Param("todoId"), &todoId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter todoId: %s", err))
+	}
 
 	ctx.Set(BearerScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UpdateMe(ctx)
+	err = w.Handler.SkipTodoOccurrence(ctx, todoId)
 	return err
 }
 
-// GetTodos converts echo context to params.
-func (w *ServerInterfaceWrapper) GetTodos(ctx echo.Context) error {
+// GetTodoReminders converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTodoReminders(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "todoId" -------------
+	var todoId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "todoId", ctx.Param("todoId"), &todoId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter todoId: %s", err))
+	}
 
 	ctx.Set(BearerScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetTodosParams
-	// ------------- Optional query parameter "completed" -------------
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTodoReminders(ctx, todoId)
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "completed", ctx.QueryParams(), &params.Completed)
+// CreateTodoReminder converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateTodoReminder(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "todoId" -------------
+	var todoId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "todoId", ctx.Param("todoId"), &todoId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter completed: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter todoId: %s", err))
 	}
 
-	// ------------- Optional query parameter "limit" -------------
+	ctx.Set(BearerScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateTodoReminder(ctx, todoId)
+	return err
+}
+
+// DeleteTodoReminder converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTodoReminder(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "todoId" -------------
+	var todoId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "todoId", ctx.Param("todoId"), &todoId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter todoId: %s", err))
 	}
 
-	// ------------- Optional query parameter "offset" -------------
+	// ------------- Path parameter "reminderId" -------------
+	var reminderId string
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	err = runtime.BindStyledParameterWithOptions("simple", "reminderId", ctx.Param("reminderId"), &reminderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter reminderId: %s", err))
 	}
 
+	ctx.Set(BearerScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetTodos(ctx, params)
+	err = w.Handler.DeleteTodoReminder(ctx, todoId, reminderId)
 	return err
 }
 
-// CreateTodo converts echo context to params.
-func (w *ServerInterfaceWrapper) CreateTodo(ctx echo.Context) error {
+// RestoreTodo converts echo context to params.
+func (w *ServerInterfaceWrapper) RestoreTodo(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "todoId" -------------
+	var todoId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "todoId", ctx.Param("todoId"), &todoId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter todoId: %s", err))
+	}
 
 	ctx.Set(BearerScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.CreateTodo(ctx)
+	err = w.Handler.RestoreTodo(ctx, todoId)
 	return err
 }
 
-// GetPublicTodos converts echo context to params.
-func (w *ServerInterfaceWrapper) GetPublicTodos(ctx echo.Context) error {
+// GetTodoShares converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTodoShares(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "todoId" -------------
+	var todoId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "todoId", ctx.Param("todoId"), &todoId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter todoId: %s", err))
+	}
 
 	ctx.Set(BearerScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetPublicTodosParams
-	// ------------- Optional query parameter "limit" -------------
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTodoShares(ctx, todoId)
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+// UnshareTodo converts echo context to params.
+func (w *ServerInterfaceWrapper) UnshareTodo(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "todoId" -------------
+	var todoId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "todoId", ctx.Param("todoId"), &todoId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter todoId: %s", err))
 	}
 
-	// ------------- Optional query parameter "offset" -------------
+	// ------------- Path parameter "userId" -------------
+	var userId string
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", ctx.Param("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userId: %s", err))
 	}
 
+	ctx.Set(BearerScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetPublicTodos(ctx, params)
+	err = w.Handler.UnshareTodo(ctx, todoId, userId)
 	return err
 }
 
-// DeleteTodo converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteTodo(ctx echo.Context) error {
+// ShareTodo converts echo context to params.
+func (w *ServerInterfaceWrapper) ShareTodo(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "todoId" -------------
 	var todoId string
@@ -2343,15 +14720,23 @@ func (w *ServerInterfaceWrapper) DeleteTodo(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter todoId: %s", err))
 	}
 
+	// ------------- Path parameter "userId" -------------
+	var userId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", ctx.Param("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userId: %s", err))
+	}
+
 	ctx.Set(BearerScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteTodo(ctx, todoId)
+	err = w.Handler.ShareTodo(ctx, todoId, userId)
 	return err
 }
 
-// GetTodo converts echo context to params.
-func (w *ServerInterfaceWrapper) GetTodo(ctx echo.Context) error {
+// DetachTodoTag converts echo context to params.
+func (w *ServerInterfaceWrapper) DetachTodoTag(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "todoId" -------------
 	var todoId string
@@ -2361,15 +14746,23 @@ func (w *ServerInterfaceWrapper) GetTodo(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter todoId: %s", err))
 	}
 
+	// ------------- Path parameter "tagId" -------------
+	var tagId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tagId", ctx.Param("tagId"), &tagId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tagId: %s", err))
+	}
+
 	ctx.Set(BearerScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetTodo(ctx, todoId)
+	err = w.Handler.DetachTodoTag(ctx, todoId, tagId)
 	return err
 }
 
-// UpdateTodo converts echo context to params.
-func (w *ServerInterfaceWrapper) UpdateTodo(ctx echo.Context) error {
+// AttachTodoTag converts echo context to params.
+func (w *ServerInterfaceWrapper) AttachTodoTag(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "todoId" -------------
 	var todoId string
@@ -2379,10 +14772,18 @@ func (w *ServerInterfaceWrapper) UpdateTodo(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter todoId: %s", err))
 	}
 
+	// ------------- Path parameter "tagId" -------------
+	var tagId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tagId", ctx.Param("tagId"), &tagId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tagId: %s", err))
+	}
+
 	ctx.Set(BearerScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UpdateTodo(ctx, todoId)
+	err = w.Handler.AttachTodoTag(ctx, todoId, tagId)
 	return err
 }
 
@@ -2414,54 +14815,298 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.GET(baseURL+"/audit-events", wrapper.GetAuditEvents)
 	router.POST(baseURL+"/auth/login", wrapper.Login)
+	router.POST(baseURL+"/auth/magic-link", wrapper.RequestMagicLink)
+	router.POST(baseURL+"/auth/magic-link/consume", wrapper.ConsumeMagicLink)
+	router.POST(baseURL+"/auth/password-reset", wrapper.RequestPasswordReset)
+	router.POST(baseURL+"/auth/password-reset/confirm", wrapper.ResetPassword)
 	router.POST(baseURL+"/auth/refresh", wrapper.RefreshToken)
 	router.POST(baseURL+"/auth/register", wrapper.Register)
 	router.POST(baseURL+"/auth/verify-email", wrapper.VerifyEmail)
 	router.GET(baseURL+"/health", wrapper.HealthCheck)
+	router.DELETE(baseURL+"/me", wrapper.DeleteMe)
 	router.GET(baseURL+"/me", wrapper.GetMe)
 	router.PUT(baseURL+"/me", wrapper.UpdateMe)
+	router.DELETE(baseURL+"/me/calendar-token", wrapper.RevokeCalendarToken)
+	router.POST(baseURL+"/me/calendar-token", wrapper.RotateCalendarToken)
+	router.GET(baseURL+"/me/export", wrapper.ExportMe)
+	router.PUT(baseURL+"/me/password", wrapper.ChangePassword)
+	router.GET(baseURL+"/notifications", wrapper.GetNotifications)
+	router.POST(baseURL+"/notifications/:notificationId/read", wrapper.MarkNotificationRead)
+	router.GET(baseURL+"/projects", wrapper.GetProjects)
+	router.POST(baseURL+"/projects", wrapper.CreateProject)
+	router.DELETE(baseURL+"/projects/:projectId", wrapper.DeleteProject)
+	router.GET(baseURL+"/projects/:projectId", wrapper.GetProject)
+	router.PATCH(baseURL+"/projects/:projectId", wrapper.UpdateProject)
+	router.GET(baseURL+"/tags", wrapper.GetTags)
+	router.POST(baseURL+"/tags", wrapper.CreateTag)
+	router.DELETE(baseURL+"/tags/:tagId", wrapper.DeleteTag)
+	router.PATCH(baseURL+"/tags/:tagId", wrapper.UpdateTag)
+	router.DELETE(baseURL+"/tenant/scim-token", wrapper.RevokeScimToken)
+	router.POST(baseURL+"/tenant/scim-token", wrapper.RotateScimToken)
+	router.GET(baseURL+"/tenant/settings", wrapper.GetTenantSettings)
+	router.PUT(baseURL+"/tenant/settings", wrapper.UpdateTenantSettings)
 	router.GET(baseURL+"/todos", wrapper.GetTodos)
 	router.POST(baseURL+"/todos", wrapper.CreateTodo)
 	router.GET(baseURL+"/todos-public", wrapper.GetPublicTodos)
+	router.GET(baseURL+"/todos/assigned", wrapper.GetAssignedTodos)
+	router.POST(baseURL+"/todos/bulk", wrapper.BulkTodos)
+	router.GET(baseURL+"/todos/completion-stats", wrapper.GetTodoCompletionStats)
+	router.GET(baseURL+"/todos/export", wrapper.ExportTodos)
+	router.GET(baseURL+"/todos/search", wrapper.SearchTodos)
+	router.GET(baseURL+"/todos/shared", wrapper.GetSharedTodos)
+	router.GET(baseURL+"/todos/trash", wrapper.GetTodoTrash)
 	router.DELETE(baseURL+"/todos/:todoId", wrapper.DeleteTodo)
 	router.GET(baseURL+"/todos/:todoId", wrapper.GetTodo)
+	router.PATCH(baseURL+"/todos/:todoId", wrapper.PatchTodo)
 	router.PUT(baseURL+"/todos/:todoId", wrapper.UpdateTodo)
+	router.PUT(baseURL+"/todos/:todoId/assignee", wrapper.AssignTodo)
+	router.GET(baseURL+"/todos/:todoId/assignments", wrapper.GetTodoAssignments)
+	router.GET(baseURL+"/todos/:todoId/attachments", wrapper.GetTodoAttachments)
+	router.POST(baseURL+"/todos/:todoId/attachments", wrapper.UploadTodoAttachment)
+	router.DELETE(baseURL+"/todos/:todoId/attachments/:attachmentId", wrapper.DeleteTodoAttachment)
+	router.GET(baseURL+"/todos/:todoId/attachments/:attachmentId/content", wrapper.GetTodoAttachmentContent)
+	router.GET(baseURL+"/todos/:todoId/comments", wrapper.GetTodoComments)
+	router.POST(baseURL+"/todos/:todoId/comments", wrapper.CreateTodoComment)
+	router.DELETE(baseURL+"/todos/:todoId/comments/:commentId", wrapper.DeleteTodoComment)
+	router.PUT(baseURL+"/todos/:todoId/comments/:commentId", wrapper.UpdateTodoComment)
+	router.GET(baseURL+"/todos/:todoId/history", wrapper.GetTodoHistory)
+	router.POST(baseURL+"/todos/:todoId/history/:revisionId/revert", wrapper.RevertTodo)
+	router.PUT(baseURL+"/todos/:todoId/parent", wrapper.SetTodoParent)
+	router.PUT(baseURL+"/todos/:todoId/position", wrapper.ReorderTodo)
+	router.PUT(baseURL+"/todos/:todoId/project", wrapper.MoveTodo)
+	router.DELETE(baseURL+"/todos/:todoId/recurrence", wrapper.StopTodoRecurrence)
+	router.PUT(baseURL+"/todos/:todoId/recurrence", wrapper.SetTodoRecurrence)
+	router.POST(baseURL+"/todos/:todoId/recurrence/skip", wrapper.SkipTodoOccurrence)
+	router.GET(baseURL+"/todos/:todoId/reminders", wrapper.GetTodoReminders)
+	router.POST(baseURL+"/todos/:todoId/reminders", wrapper.CreateTodoReminder)
+	router.DELETE(baseURL+"/todos/:todoId/reminders/:reminderId", wrapper.DeleteTodoReminder)
+	router.POST(baseURL+"/todos/:todoId/restore", wrapper.RestoreTodo)
+	router.GET(baseURL+"/todos/:todoId/shares", wrapper.GetTodoShares)
+	router.DELETE(baseURL+"/todos/:todoId/shares/:userId", wrapper.UnshareTodo)
+	router.PUT(baseURL+"/todos/:todoId/shares/:userId", wrapper.ShareTodo)
+	router.DELETE(baseURL+"/todos/:todoId/tags/:tagId", wrapper.DetachTodoTag)
+	router.PUT(baseURL+"/todos/:todoId/tags/:tagId", wrapper.AttachTodoTag)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xa32/bOBL+VwjePdwBSuy0fejprb8vd1ls0U13H4rAoKWxxZYiVZJK4g38vy+GlGXJ",
-	"Iu0kjd0U26fGEskZfvPxmxmqNzRTZaUkSGtoekNNVkDJ3J8valt8AFMpaQB/V1pVoC0H95ZlGRgzseoL",
-	"SPxtFxXQlBqruZzTZULhuuIazIS71zmYTPPKciVpSl+4ycRNJm4gwzfE8hIIl8RApmRuaLJalksLc9C4",
-	"roaZBlNssezeTPzjGwrXrKwEjngJTINeL7qeURvQOPafGmY0pf8YrUEZNYiMPhrQLRzLZbuKmn6GzOIq",
-	"rzQwC+cqVx/gaw3GDlHrwRBwPa9hkjPrHJ8pXTJLU4oPjhCakOvcTKp6Knjml5+xWliazpgwkGygfjoj",
-	"VteQkEtu+FQAsYowIQju3iDstgBiWAnEgmTSrs1NlRLApAOXW+HcK7k8Azm3BU1PBn65OH2tuYacpp+a",
-	"SRcBzN5orXScZZnKIQwUWMaFZ2Kec9whE+87c91Oh/ZKMIbNQ2uGInqm5lxGgwkl46IXKf8kEKWKGXOl",
-	"dB7mq0N7YkQ9D/vVhXJlol2xPz+E8Qd/Ys7xWEQ3s+tYbbjRHx62OufG4pn5dvgkK8M06OLaIeTzZCfK",
-	"/aNx7l4SnoO0fMZBk3/hwH/T5OHDgQLhpELpISg8TJEIABvOcDTvhsbsnnFj46fNqlx5LyyUZpcgeqFb",
-	"CWJrkGnNFl6GLRMdp1sNX0ac2yYDKOEWuuB0NKl9PWE2qpyyFoJNUbt60rDGOHPyvXWN6Jzp4jZoraK+",
-	"TL49EezcToRJG/lif/lhmGSr/M74oumJ38nGgS2AnL4mauacwmHkqlCkiQexBcfyIlfBAzxg30fn2tbM",
-	"vYOCB4jnoQK3K7FH0PP1UQS9uH4NV+vWWcMw3OOQtokm/GZyCRo1PxLZuylyQrXyMIKsSxRllpdcUqw8",
-	"yinojjIPUlPE0t3PTQjW33GTize44WiUbpn7Yzl/mVADWa25XfyGqucXbaru9IZO3V9vVxv43x/nNPEN",
-	"h4N8ozovrK3oEhflcqaGpH/vDgN58f6UzJQm75TKCZ5gwqpK8Mw1FLSlNV2/xxlHxE+nCb0EbfyK4+Px",
-	"8QlipSqQrOI0pU+Px8dPXXq3hdvNiNW2GAmsCx1+yuOIKDqLpzlNfdlIPWhg7EuVL7yESAvSje/4OPps",
-	"vGb4TLErj/RK0mU/NCgC7oE/Pc7hJ+Pxg9nudYTOdj8kzjdiatfdzWqBWD4bnzyY/X6zEHDgVF4ywXNM",
-	"A66SY8J4WtZlyfSidfGK24K4w0+YzEm3eGNzgxzHrdILnOsj3tS78Zh3i+w9hT5Uxz8yBjjfSAMW5B0u",
-	"iMV3Y0Pjjr9u2OBDgylhnRuJrTTwTc02HjQj9sWBfld1q/ifHCz+mLzbImwY/PHhgv+SYeAbkND2fw5n",
-	"+41XFqGB5QsC19xYM+CdjyNhRMKVq9S20M5VKIujtpAJU6+T4vfEvkARsQcB6hclnRub9VWeR3hVuPWZ",
-	"dotyKBay8IKHpu5Kt5T2d6PYzwSkywejSWMuo21RrwKYsC59zSFAnf+6168KyL7QB42esczWph889eV+",
-	"Mfr1/xsIeK9J1ri92rZ/3GzcV+nBTb8D+wvQPWbLjSvjwYZe1VqDtL59xSoXK2N8dehM+VGizCjN/4S8",
-	"V8fT9NO6gv90sbzowv8OLMk2t9CJA26fXiwTWtUB9H3X2ATg4aVq2JQeuFTaFXyXKpve7vvWSfeLvgf4",
-	"NgTAY9heLcZO4rkbgJ2WZiVY0MZZ73v6lgvMmdMFaa5juJKkkRjsE2lKv9agF6tr0LRzbZN04Nrs8pfJ",
-	"TXC24CW3vZntF5Yn44SW7JqX2OWfjPEXl82vJHDlGTagZjMDEQvdJceBJS/2SN7BXXGo2+PGugs4F7kf",
-	"R7KYEN5nd2/Q5W+HugiA165gqbX+1Lcn+Rp+Szxwpd+/3Q91erl6pJX+o2ehD25T+q9uqfvEazXzaH3p",
-	"G5NOf5UVEdCfsnZPWfO4/3Dq1nU7cvUfYdroBv85zZc+XJg1h3R77Z43wheiWsVssSaCX5FuClcgF7eN",
-	"wDD+zwKff1B9vI+PqnhC488OZ9zBIJUlM1XLOxDFR5GwiPgkW6u0wwV+fNhstvo/JT8pdNtKyvEHy/HT",
-	"18HaKd737Z1I++oo71ySHZjE8Y7yb1mS/SCnqWmmY4Ls19GX4a74TGVMkBwuQaiqxGbGj6UJrbVoPqWm",
-	"o5HAcYUyNn0+Ho/p8mL5VwAAAP//GAs2av4pAAA=",
+	"H4sIAAAAAAAC/+x9a3PbtrboX8HonJmmc2nZ6WOfs5M5c8dNnNZ753Ucp53Odq8Lk8sStimABUA7aib/",
+	"/c5aAPiQQIpyLNtqPP1QRyTxWFhY78fHUapmhZIgrRk9+Tgy6RRmnP7cT1NVSvsccrBCybcqF+kcH2Rg",
+	"Ui0K/HH0ZPTLVDHLL8AwdQmacZbhB5CxGczOQH9lWFGe5SJlVmXKjNlbLS65BfdPxjUwnl/xuQnfjU8k",
+	"l0rOZ+JPYFMuM8PsFGbMKsbZyei5H700oE9GrMh5ClOVZ6CfMg3cGDGR7c9oPDsFzSxILi3j2UzIhJ3z",
+	"PBdyws54eoGj2yk0h2PiHH/SwIRhUkkYn8hRMgJZzkZP/jWq1jhKRmHe0W/JyM4LGD0ZGauFnIw+JQGM",
+	"Bx8Kpe0RmEJJAwjGQqsCtBVA0AZ6Dtkpt/jPc6Vn+Nco4xZ2rJjBKDI2wRBfFxZm9Md/ajgfPRn9x259",
+	"rLv+THePVaaqBXyqhuNa8zn+G0G6aoz3BnQ9xifc+x+l0JAhTJp78MOFNdaQUWf/htQSZAhoblV/lGDs",
+	"MlQcXAFORbaMea8IwZiic2KGz8Cf8FMmyzxnpXSfEyYQvo2SET7hZzmMnlhdwhJMF3bUnD+6BWt5Op2B",
+	"tC+F6TldXr03/LjqsbsPbXG5jWn6l9u91FRJC9Keuo8XYf4cLKR4/861mhFcz0UOzH9kYkiaauDrIjYO",
+	"eir5jFaw9FRk0Z+N+DOy4ndIR4RkZ3MLuL5qAULav31XTy6khQnocK1OOyYpi1zxDLLTswgtPHwesBGR",
+	"n11NFQvvV7AarcI5kY3qJbQnbAImaZ+U334L3FEUKDNhDy5XYixcroes1bB9FMYqy/MGWCuYLxKSy4BM",
+	"9EH/PnpuXerOZZllccumvChAQpYwGE/GjJd2Os7VRMjTcy5yyJjSdIpjrXI4TadcTgDPAz7wWYH0YxR7",
+	"uoQvPLVKR8nX8RQYrlBOaJ6EXU1BEprQN8h2OAtEND5s/I7uMyJa2Y6QfmQ7DaTxK8NEBtIKO2eFVpci",
+	"w+dKMy6RXpZ2ig9TxCCW8jwH3eB5fjEmFbNR4jmgKk2U7V3n1ndcOVFEf56B5Rm3nE46ywTunudvWxiw",
+	"9NECpAhBdkwBqTgXKcvAcpEbjxIINZVnjMuMSbhieNSjCCpqx726KIblegKrnoaTXAC1O7QogN2jTjJl",
+	"QJ/yCUgbeRwlONVwLeRKwiWiY2iN29p44zgGkCA77bu0KRhzatUFyOjW4EMhNJhTEbnZ+/Qxo48Zvcjx",
+	"CUOMQyZgIFUyM1Gqr+Fcg5n2zExP6qOqCMEPwHX8ml5LpFoC2A9lfoFi0guRW9DLu37FbToFJ+a4S/uV",
+	"YepKehk7FxdQiUAsF8YiI7KgEQ6LzB+3ZKGJVGdK5cAl3Z4STvm5X8OwO42fnMG50jD8G2FOnc4QXwXq",
+	"GVkJ8YeFVgi2rnvxR8clnJhlsL6R+ZzNELYekBb5Rsq1njO4BD0nWCJr55NRUvPJ5eEXZbbOE24Iwu21",
+	"HHzgqc3nTEkgEcOJB4ZokztMNiuNZWfADNjlc+Um5Rmc+vOtuOI5L3M7enLOcwOLxPEXYafMfwAJ47lR",
+	"1T8Zz3OmCpDMlGeWmwuDqwLuQTVKIieTTkWeaYjc2p/Uldf+SHPLwVTDJg57lWTPD14eHB+wXTqK3Y/4",
+	"v8PsU4M7uRFGuA2UcqNE87y6QX03cuG+LWJke/FHnpKyszmC/vRSGHEmcmHnUSjgqfBwAGHpAaxEUxGs",
+	"tAv/y9KgM3UJpx7PRwnyvlPEwNh+27dhUZQ3VkhHH/177Fxp1hzea1IoH5wZkNZr+oGYGKZK60VeoVm9",
+	"phVaFt246KKa0Awb61B8Ef9b8unSWzP+4dA9fLy3t0J1qs/lt9772cW2nNAYk21xHlPmNkphgIG0es4K",
+	"0ATRBLmU56tM6Qw0nUnrvku4AqLh2rgT81idDBPVG3vByx8R002ZpgBZfDsLcAt7a36VBGisACXRnkVA",
+	"gtZq5Q09wJdamkanyraw3vBibGnPeA4y4/oY+XzPUQNkpwW30+UDfcvtNKiA+BrTkHMrLiEYmPbfHn5l",
+	"2Bk3wN4fvXSC5oncTf3M+MfpeDwei9SM2X6Wsf9b7f5/Lol/W8UmYJkjukIaCzxj6vxEOqXJWanioktE",
+	"OYZUQ7jBLKzCLf390csxO0SGJ6UizqLBagGXkDE+4UKOV6qybtakAbEo2El7esuNuVI667QFpaXWqO4W",
+	"/sXolZdw1XphQVBCFmm4FeZ8TjsOr7KCrJvsUQ5yYqcJM1b7v2jv3ECGTIjUeiHPlf9dI8uD7OsREZuX",
+	"9MnoyX99k4xmQoZ//vcqMC3tbGEfUaCp2QykRVla6WVgdYg/HSaVmEJAr/bM3G8+SN1Lww0IftTBpq5q",
+	"gp4ldqLSmcrmMTFaX2TqSrYP8/HeXoN5dECMBuxdSae6Ux3gAOj40/6UrNjBUwazws5ri4KHFrvilZ39",
+	"pkx1YbiIfEEPwtyGXQAUQVJAOzsTbnF2qoFnUWkJMtGpjLhnN2FgGMw8FkxzdAjVImtQrNSAn1Vi+DvL",
+	"renGjozPh1+h51zk83roGGdHq+1wcBWghcqagqpVGcctXwFcxK0Sah3vxTBroF+GXz3NET5OHITiMJam",
+	"nMErPhHpSyEvOomBt3yYvJx0a/0DBIvGMOGj6LoINd46UblzUa2r9HGFityvxx2eMxLCGSkROYkiqL4h",
+	"LzPhDja8J9GLGBhHmzC22NzjVUSym6MQSI5gJmQGeqgOrOn9U25JKFbn56gozYQsLZh+ZXjKpYQ8RrEI",
+	"jgYhJOQpL4qGegkzLhDh/IMY8rfXECHPfnHOHkJwz0pgeEmektpl2BUq3c0HjhmJGS7iu2+/8TB3/96L",
+	"m7E8WCLWsTOj8tJCZQ4jMbW0pYamY6Tn0n7qPL1jPumW3FSudD+4T0b/8d97+N/JCM+LWwsa3/l///Gv",
+	"vZ2/853z/Z0Xv33826f/jBGSgJzt4d9L8UcJBNHAaAi/EyYmUuG3LOUG2tz++5vF6V7n5qo7jqYzQoHr",
+	"Gc5ugSoUnOTWmCK/733uQbVwIi4N/5UJ9jTFJBjL7FQYutUl3v8oJ9JCaWHnQ1zcb8O7K+wfbyR0rA5N",
+	"3pcQzBlRp6YG900Kw7zu4e2ADHiRhM0dTV0D39xHMYRb5P6RW1i2vAENmhHFspXanX/JjRtdEslDPgCi",
+	"8xp0q2vHjaMJbyWINamS50I7/3Pm41NWLrdXnWobFCKwy+KuaO8x6vZB0SVb1LqtLlOkuhnpknrmjHD8",
+	"DK1puCeygYzZC6XZz/svD5/vHx++eX16cHT05ohpv0i8MScybMq4S5QqabmQhv1+LiDPfk/Y75dC5TS+",
+	"+Z09skqdmqnSFsGoTnMlJ+6vK+AXyYk0YiZyrk+tOiVPj1N1KzU3Yb+bVGnAcWdCnrp/EP+lfzvt+feW",
+	"DaKG8QyM4ZMO/XPp7Zfoi+1EGseNmygb+PMy7eizF/QLfwsYFKZo6OnN72N4tVr8XGMn11rsyhWqy342",
+	"taYN2ZuM24biYCcW1gy3Ei/e33odsX28VhZ9uLSWftuEbLw5XLtqjj/YStGeatWyu5cc1P1Nutk18PXG",
+	"qRjY5yrUNE7SbUSpTYMG7L29SF6l68e9SqYYinaVojgQ46oJepbYvTyu06m47LK4hKd9WLLS81O5mE8r",
+	"gaRNU56FF7yU6GVRvzHnhF0U2tBIzQxA1LF/PavWCv07G6KWb0wNX5pYFSC7AHooK7ftDUG0LLK1Ierk",
+	"iQ4pLxI4p66kF2pqhjGAmIRpPKzaJ9k8oBqdRy3wLWNo7CYduWAR7ynqoEirIkqWXGnN1+OzToSxoDtn",
+	"XIMGdqLS/XefLBHwBYSihz7O7VyAZo/wxa9XItA15LtgtOqn+tq/NZzs18awgXS/nqJvmT0aTm0UW8Pi",
+	"dYPixyrj2TuwtT8j7JadqzxXV7Wg+ZVpWs5WWMeGrdegiWOtDyy3pWlCsgCZ4UM3WMw//vnOiHpnSXWY",
+	"1VriOEHxBeuEHXnDJep7FAbWa2atAsUWXOTk+qkUg0xooCncgJUpSJ23w9nw5aglpg4uGzZPZXxdb6JP",
+	"UQgasCv91tvhkk426QcZ4M9+l4rZiriLjhgGF33pYz4xHGYXA5R3L7+5meiF6GIBZYeXXE5Kb9BY5EAf",
+	"LDP0krNVTUofiBqCefzTMTMChQ0XZoj2f7LlcMOutLAW5NMTiUhBtlSDEVHMWJjN0HiNt9BYVfhvaGSU",
+	"5HK/rDGjwAp8VdgTqWEHSeYHMC5477wdFe6ys1opTm5p5GOTwkzxj9KmU3pjkrtfzoX0z841SHo4AT3j",
+	"OM60lBOuBf0tLM/dX1LpK5i4vwulbTkpweAsWs24dL/r0hj3lynC3OYKMveXLfUF/hWjnu/Akg2YDNM9",
+	"JsdOu/VruGLuMYHEWzNmbWsGZ1YVOzlcQn69vKJ6AVH0mnK9wiYDeiaM8drJSot4/XbEtxoexVZyzCf9",
+	"kk2InB2WgMYn1Uir5BkauGNJfXbaqKfpJ/jA6JGP7G97mzYp1XRK2eurUJ1BOonfdhRadL/fgcU8E9Mb",
+	"dY+6zmmwpp8WVbpnb9pPNEeUQi8nIj3Nhbw4BYn3Ioul4QC5iFyiqHEaJ6ZvCun8oFwykkEhYzQew/Gi",
+	"erGjpqd5gyL3LXuBfiMuam6mpxosSNp+CLxYMHdinmqd4eoIueXzSqWmYYKIISwTBkVVhUwH6fNEqSwi",
+	"li6cbAR2SecBLe+9YzNR7FCZclmYM58pskb65WskjJVETrDA8KKQdQnZaqroJNVJRzbdcdMcMOOZk+bc",
+	"Fxu+toWGS6FKc7oAgfWoPF3Rxg5XxgW1z2NFSmn13noZwPX4q9NJG1N0LZf83Lccgehi8yHPXOTo8tzk",
+	"/Or0+HoTUcLI8JywxktJleGQNf485TY5kZXpKGHBHZ2w4KNPWANTElb7KhJW8foEhbDgCD7VJc7d+AFR",
+	"9E/SSHTzZ2O5tuOw6BOpMCvFIZWpr5+6kt51TWQCCU+gUignVjd0osCcSKtYyIl3xDceNCzhahmIP/O8",
+	"hEpbC/fRy0m0nlI6dbB9WVDFz7Ou8RohMR0D0j6UZO4KLQ+/gEEOBbqwZ4WDCD7Y07TUJiZLoL6HArp7",
+	"zqxi50ApQlNg+CErOK7e50sodzw5N+7BoNyIG83qr6LsFql3SJ4n1QO1BJr3KVMzYanCgszBmJCMAJnj",
+	"yUKmeZnBaYi/i/CyKMRfgcYYb9QfOn3l0UCVf7x785rN8GtW4Ofs0dGLZ+y/vv37377G1XNa9pgRPTAs",
+	"h3NLzkZky3hMdgpzxjU8PZGEUGkOHDUpFo6BEaaM2b6cOzXL/eBY978p2d2pRT2ZclEHBulo/iYH8Hrs",
+	"NeQIJWxRabjpUcFmnRihhVVUii9tGc9vKvKGRYRWZroCv1Zi6YokvT4V60Y0qpsKClp9HYfG6UTx/m1L",
+	"U2sDQgPPWA7WwcFRYnYp4KqCy1MGmbAu9y68OPMUknDIsbATGeNh9HewhhL5DBBrqfk++BoniqrULfA1",
+	"jJpSSRglo1xdUfZvJsrZKBlNxWQ6Skalphzh7vHURIMx3ZdHyWCicJa7KiOwIq1t0XPKXZWWQSmtTYMw",
+	"t0ItL6P6iu0yInUJOwN7BSDZHoHyceviqPKsmRsuibiuE+NcrzJ8E5bWxcKOWhFoCzxKgwdR68YLh2iU",
+	"t1nTHUdFazplQCPoFuGI8sryTEiLv//+u+/Z0dH7lweoBqVcKilSnqPGM2sVTHhxdPC///PLwcE/X/76",
+	"9Idfn+//+j+v3iTHP3XY0HXEHv08YHPIsaIcuHovT+lXXCpulst0qrQzkwo7MMQ1GQVBLOJJ3X+9z8Lj",
+	"1lRwyfOSqiaI1ZFo+NGoMU/Y7uqj7nY1DjidMXtXFq5CDwql1lVfwkNhj57vH778NWHucBL26s3r45/w",
+	"j18P9o9e/vp1ciIPXx8fHP28/zJhdHTskUNyyCiDUYDPM995/OKIkYxK4oIf6OuELs2zN+9fH6N8+/71",
+	"8eHL8Yk8XnbbBCxdPFpvm2wjkx/eYxPOvfpAfVTs6P3xs1HSe8BXU2WAXXESG1R68Zkn3n28/TreoPJH",
+	"vrZV8677rzNm1VMnB8343PEXPIwqKEDYYZp6V/r28zaBTpjKsypB9alDBi82ZpWhBNfogieH5q2uEnR9",
+	"nlFXFEQt8PoXjXOY0OtI94K+pCTEC0SsKI7QVBc/IzzmGkaM8M3ZIFEoaOx17lY0WeCXJcuOs2P5RFay",
+	"MfnDNeAUnWZwCT2/tnD52aHxK2e4qwAeVC0bMv+aON8jVQdSWns2qYCQv5VMnSdY0Y7L+SDRWhkRr530",
+	"T5hXJIfojCQ5NNgfZlyWPHeZ60+JxCCPuYC5oQJcmNaP/7/hGP9Kllz9nX93RW7AcSs8Sjj3GDsDDJjG",
+	"f6wDy+umCnQXJnFl3FzhEcOM4+hnc+a9AZ/vi+mL7byN2C9P0GrQxwa9BB1Xq35UYFhZOAHEFWoJNiWc",
+	"5eCYT9oo7OPMnECDGP1HqWyUCXzqZOFIFGJZF/31v7rM2976hT6DYOqjN85cjICZGwuz4fb19axJTdNq",
+	"jM/eYMxPsxhKGzq+HgwKKhqMVRqI/9SnRjbMRh1Cz5AK1Dw9LzcnUtjK8TJmxxQ55E7KOLWHXveeHEep",
+	"Ctv2glf2Rof4zcosfmGjZETDRLTdmFm73nR9PIM8AwHJVsWa+Q2uaUF0X31O1b565r7CfTib87/17aEq",
+	"mDJ4B9WYHUVNhu/CzT14D9EqJprLi4g+BjlcctJT0U4CVOTvDKwFPWbHSHLrQBAQkymbOaM4l00nRXhn",
+	"PMwEYaQoCohIeD8dv3q5AyblBWQMPqSgi6oUSHM6oqNuzswHm1xpXhROmj8p9/a+TWdcX9Bf3ZkCp7jl",
+	"XEymq5ZCb9/ErCpT6wlYkVoxZIdxXueFTdSQ7USRKdexIIXPFvF7GImZkq23UfE2ltZ/zeCRDXB/b/Vs",
+	"MmO/BUSAlYp1He7d2FULXC3S2tpA77H1E1la4pr0CT9Z6XL1A8eW9p5WvqpwQDODY0Fq9E+qvFZieFOR",
+	"ZSBrNkrlAc/mzNtJXKxnmkJhmVRUBnMhQHINZ8UKl8F1awx0QGpQRvr9zjTv2tlCUE8XKtxKTM8tBuNU",
+	"hRC+/dv3jTIIj4fJ6R52fWF11yqTiHaStHb5BUoWrZjoMiBbhROjN+keehdvwEu4iQoE1zQaXN+p59DI",
+	"FW3tivjujHGJjJYrnjXrsneMSaXDl2m6ZGLGJ8AevX39Y8L+8fbgx4T9ePgiYb/A2Vu0urO3z18wRRWX",
+	"EKTwoeUHOROS6/lKLkuTR3mS6c0puYagU2UwxZ+cXoLGnJ4O0rNuFKZWeavsMrWGIHcmSc/XqLt8nbDO",
+	"JbD+jJucH+CGOxFiaDh+RxA70em0xPvwDu+IG9RXMsaMY/rrRdjAP345HiWuOwiBfKHi8dTaYvQJB8VM",
+	"hIhD0rUA2X97SOr4j0pl7Jh07qLIffZzlQn8ZFQ/xy922NuQslfZfEZ7473x45D5yAsxejL6drw3/tZV",
+	"j5nSbnZ5mQm7U5eyn8TUoNeunKZ7yzktxoxq/zb7hbjwVPLYU4l2HJnlajJuavSHGS4ebF2cnvyoXPMZ",
+	"WNBm9ORf0SLDfu4CNGKMM+aRScqXARf45h8l0FV1yFwblvyx8Cgu9E1Hqp5w5U6U7KqD3z29O7NrTs7P",
+	"zyH1DNNvFMlURepjc9aV1K8/rcVZGrlG/lLGpvPlxuqZhl3nvumbyUc9M1t1rXljQ+ViJmxrtEqcIQk0",
+	"iFTtylJRkSo+gcvbi8+woljVp9/Ihkbcg+7nN3t7jRYk+GeDPuz+2zgxpZ5oWEeKlipHRGqBidJd9lTi",
+	"UzL6bu/xja1ioVDt8uTvpasAKf6EzE3+7e1N/lpZxltkrsUYiFwFlvCv3/C4TDmbocRAZK6dQRS+qmnj",
+	"KDgw/uVgPPoNR9/FDe8SiSGuphx3axNRqv5Stxj4wde/uBGwtCrLfGozTB+pukGsbPQ+iJwIrY1RKWVj",
+	"zsv81vHxUF7yXGQs1UD50zw3Dieqg3dLdM4VlFBcZFkjZbo6cqz3W584qY87lMLROPaFfFMckNyWQk5y",
+	"2CkNUEoItjLBL12Gm+8A4cn44++ZTxr2ASXh8EIkCekQVz7ZRGny+ltqs+JipeGDMJZKa5/IyGvherhE",
+	"4zoRhREKO2dBG3k9blW1fzaEx0u1hQbh8jdxOxztSOAtlta3PosAaABAFrDFr41x/2LrNM/mrKpPsBJr",
+	"dlNX0bMbe3zJzxBWivmguGavMpjKx/iVCZibZRqMWZbhFouHbugEu2qUPhClKFFK8PSoMZOjAJk7ZOpf",
+	"tIiGlAEhDJmpfG5swNwFDH3XSjlrDhSSl7twMxC9HQ0GbDdexqian4ClU6UMYDw6JZ66ASlzuU3luGRT",
+	"VerPInE9tKpVYmlD2B4t47RZmtVJjAKkGR3dYGrUPvFdX4ew++SPp9A6WApTtqov4X/M9qvEg+rZicwU",
+	"GDpWRJ6yqElc/FAbhQk2dJrR4geDTvO7eFINwSB0UiMKs3evKAxfrsVYhaBLuKprMbLqrGbgheSFMz5Z",
+	"5JJY3KRNAgI5WkDUVSTJ1xDqFqybhYs2hhnLtZHuGUejtTEPLMgavC2f35nI7Zfjz3iRcrlnvNHgrBcN",
+	"XKGoPjzwb2wKB9qVqgad/+NbO//3VOHMR5ktH/4tEp4feBZS7Nzcf7+9uQ+cEJxr4Nm8i2O6c/TUKbQl",
+	"7EA7krTnO5UNP456Dev2hrAvYj/fAAFq2+Mb1W3rJAUH4eCzaGPaAE9A15HFB7wjnrnELRdQyB2G17iI",
+	"q/VQrynw3E4btvo26vxEj59NIXUq2Y2dXqNmV3V46uJ6Z/TmnwsQcKtmqV922Lb72W88xBXkYKGrl0pL",
+	"gy3afdRlVnc9F7rVc50as59IlIx15nzFbctdkJlDoICXUqhvrPQFLOq5vQrS0qDJOUI1lhy5CEWWnPJy",
+	"xXVmXPhlNScmDpxIX2mdTI+hVJODgd+HX1pMyHUweQUboiHR4uXXlW/3mxC+A/G2kq8FetZTpbX3NN6x",
+	"rfsWmV0VJedV5hYmsiYiDreBP69QdbmFQKgmGm47ihyj3z4lccL2I1iPyRuSiBe6zC6B51lj/c2q9HeM",
+	"JOt4I9LFLcSgX5QR6LuYjo2RkuWQkVtWh1YdPj5nPnThbnWh652+A/AQBHCstuq3uNOoKRg476KedKku",
+	"oNUZcjSE4gcNE7/OtgSMbq/xVpDRuxS1eR2BLbUkc6frLvn+6GVonewH5UXhy32VZ/jpGQV/oWxIpTvE",
+	"RAo5OZFCOqGB1kD1OHi248i1zChStSUROWHH201COnGVZIzHQcHF2BibBlEyDQkm1I8Opag5C6WfguXH",
+	"qNAI/kTiToxVBdVpvBByEjW+KcvtKoy5OVyINy3ttHkIY8qtQchDXKxXPQdgpL/c8KFQrnjABHrxk0rL",
+	"+IDpZg4aSdcKg95c+HNVLlNoXydz6dAPaM7NsnAvRLqpeqU90EZJnuMF4MyDIxlNgYca1M/cenaeC9Od",
+	"6vmunEyAHH+MYCHRyRBcKR5svZE4n7YDyxxAY1Jc0QRkJ741i/tGxYt2g9tN+RKjXXRvyiB/t5aGZwtN",
+	"oFpqDPMY2bKeu65HXmtdsL5vCfFzBxpFy+VgiwZGLrW36VI4XrdeHBKnqIl0slJSHKRc+D4WHeZejVGJ",
+	"Kmr3U9LXGPD7vacs53qClZF4XoLLWkldIhi37PHe3ii5TuTbrQajdTYnigdlNcC6HbiKe2qXURdyhxdF",
+	"G0eSVuv6BvY2txzD4t2PzX8eZp92Cas6LczYB7ndWIlnywhOSENdySucaU8zWiSdfczutyFktbkohtmL",
+	"iMROsL0H1pjvbjXysAaEVJilXco1EA5PGAXD5jABkL141WzA1EUY34Z34iizQGdCRcRGO5kIyfG5Q0vE",
+	"b5NUJ9aRKiYu+u0uVpLYNtLTZpKo5lGJfJdN2mzm6bHD77ulyi4Ibs1ezZuS22L9oG/ZXbrUZKwTSe6p",
+	"0/T+C3MENoznqJBpGQ2b9Gn3o//rMPvU5xlqVKqpTCChkAXhv1QMq9aA9uVrXOGMefho3OFXqZF+Ndes",
+	"VnrzDDOgXSjOdY+Mk7ccq/+MJKs6tgjpW6OL261z8HAy12De3m1SXQb2iMpHkVXu6w4CvYJX3yqe7t0F",
+	"4Q2dhr8oMfEzkOxHCqkLGHY2Z4fPu3h/qEodcwrdEn5tyul0HbniTtC72/v0RcoVD8zluvfe+wKVriz7",
+	"a/AZlMDcr93a4TE+3+ClWey5FHPlROr+bZm2Vjcec+AMZ3HMJysVMnxnk8pYo3LMLStiraqM0XO/HwpY",
+	"CP0jl5DSrrnWlxVPtI+Y6+MZqXokgiIWS7uWemj5ZOkyBKK0+9HyyZI6GFPe3AVZLbDQeDevtCGaPihs",
+	"nqcKx1EX06xvmaHikXyOpoa4jtaEDCxPp0xYVzEMXePO5vCoXSxjkcVWZL1P1t4w2m5Kxl6XXezdJru4",
+	"F3L1fWUXD9SAqME2c84jCEilgdDKE6tB9Ig4K71HPYOHRgNWvYq3LhJwi+qLNIIR3z07fIU6nCsv7DIY",
+	"2hkkriLioKDEs8V20TiDoFoXdu5myeg5+bLrZtKfF0c4PpEdJaVmXPIJrMjnpZDCHrS7OYRa7sN9v0MJ",
+	"twijm9GMa6B0k0j5kp+95olWcdCNGirivYVj+OLgVS1/e3IKmo7lRqWjCrgxAlTaWOvR6OX3ndzCgMvO",
+	"uFjB141mKcRry962XHtdzLqPSQxbRKG87XRtpCcSFbqWdhImemFFmOELkVt0Vc8bTQyZT86Mh/k1O+et",
+	"E2T4xrel8p+H7AFq+VX1IptyF20JGXukdOIKPrhQHhcWbqegzdcdS8O8y6yE6yzMrSZrdsbtLxmIpXrd",
+	"qzdVsrBewjpFE3Ed9ObNLiNYPQdCo6r/vgGIhKWsA5Wwnk1AJuD/YNiEDzYDnWo5a8GnWtNNQagmJFRe",
+	"WuRI++KT1zWq17uoz7iBHSENSCMoe5S6VYSWz66WbXzGP9YrYIrq+uHzp0xDAdyiauJZMTNwCZrnwYkC",
+	"H4pcZVU17ngN1Ulr8qp/wXKh44UGKsbOKS0ez2PUiwNUu1uYRqxTbCWN/ltrQYPaEyEQjNLo2B+z4yrx",
+	"S5W2kfvl3sg5FvQNaS4+/fdExjqWOd0rtljjUmgigZ3t9hKhkHRVOb27/UQod9woYt5ovPbbABx/h9tz",
+	"7XGFkk8ZNylptNVencXW/+o6YTVbXNdtHroYGAKlte2wP25S3zyzY6VrRuR/06xF+3hILdoF7Cs4tmBo",
+	"dG73BupaDy/4BMbsmPpOFxpSyFz320vqCnlugGLhotSJBlwPS3/xxdisch0mF9qsj1kzyQGvqzOIuYW4",
+	"zlYoZrhf3QK6lrfYkr2fhm1TDd+lVv2x4oTCuK5FCNY7s2yfE7tJHMVR2p/YFim6tSfnXOlK+l+o94Pn",
+	"sdo777smbc4932jhcdv++Xa7qIhhLFMPIdKfGSIdmgwtI16lZ+7UTUU6A0PpjUFK55cuXz3w6gdefau8",
+	"ulmd6s5Y9tax6CbUOvojdZDL3dAKvo9g7vt3Hux0wxb2oIc+6KEPvO2Btz3ooTftcPVZjJ4fhXKV/Tpp",
+	"zevOyrynw8g+btrXBalYYJjCTZwLY12XYfz3qciMazDgWhGfzQOQTyS3bKYMFaHwFYJmY3aAAZKhly3V",
+	"/AQsVnUBVQMA76XEUV2g0dMT6aamMlnYd8vtGD926ZwaChfujzfSXIiigCypOnJoMNb7knGkibvyQjIl",
+	"qTG6NK5dVyy25IcyvwgsfxM6exj/jlzJ9fTd2PqmtKnytY0gnN6dXeHqeBImQYSODmeKqHKNkkSa/W1H",
+	"9Kw6hSMyNojQl+L3PnZdy62Q7kpXmX+U9Wxc2rOTOoiC3FVKk9LMtsMvh5JHJF1zutItwjXDaLOqKXMX",
+	"VazF9B1jeU87xGcoiCC4Mj4PpZUC6b0CuGCPjOWaOve9UjLj86+DLjIRlyDJy/enkhBtjIjLelat5B0t",
+	"ZFCRjQK0UB2lNUa4rIbMS0sfJe7nIfLr4f7r/WrdrgMEkgIEwBkeEdcCjO+PeFBqVcDuD6Bz0SWk2z87",
+	"Fvr++FnEd7lJyWUB2L0RypXn1vGiAggEd0YH3ZETCvqj2RZDJsnZkZIkaQeEe67tylqGMqPb2iy9RMmb",
+	"NEEt60/BMwuUq9jPBz8fvD5mIC1iNuP2RLrqhkFjdQ1PDPv5+M3zN9V7PmQbX6zJyZi5RqcsB36J+mxp",
+	"G5U424rwmB2LmS/hJSR7f/yM2JjTCPEjdgFQeMWYTBvvD58znmpljK9jWLchaxWEjEk2rq7fIHNGrSXh",
+	"ws+AxIHQJlWz0Ki7y65BeNVx5y9pmAZ5qn64tHTaawUzPERFffFRUQ+RLX8Rz8tqvo/t2qta1W2+tjjY",
+	"Eu8Szyr6KHK46fKvVVHZVdVf79AGojQT3t4Fpq0g3Q8N6fML1AZLCeOSLR14p1BhgOt02ilUHBA7poKp",
+	"s9J4CCbM66KiQQSCftD43hklSIt2jet4blR1CLhSVmg4Fx/G7BelMycLGAuzGWSVgNGMOsalspzLSYl2",
+	"0wibf0evdLD5LhrVnTo6E/IlyImdNi2+t2pR3jbLqDuAPrR/1bI5J+wMAlr5UqC3TSr+F6HLcC4upGFS",
+	"eUTDdqGEt9tCGl6Ueb6DbCLcFHIj9JRDbLle+2gElU3s8xe+ozcevIUP3sIHb+GDt/DBW/jgLbxLb6Gj",
+	"EDOYnYE2oehvJU8O9R1azU23YPxKGcs0pCBtPq8q3JAE02ntPqYRB8mlX7QQubLkWTPqyR3TtpbGt8tb",
+	"6UHJj/i/FUWAX1UNGvHlypuNQ1Mr+ao7o7EK70Uprcjbiha9fCI1ICDJf+bM77WslHhb19VUuApAwvje",
+	"kM5dMlEq6+7T6KPCBxT3oe2uVd0nWTaLu0/rBt0EF9yLKc/Imjv295dVzS1nLbihqnoFeZ6EkkczflG/",
+	"V+zkcAl53Q0oyoCnIs80yKgo4o8yGbnhB0lOB1T3zzcqQi+dUNIdu3fcsxmfM05uQqvG7JfW7oVBk6Uk",
+	"/yENMUsYOkSRu7vYAWdb/+7xN+5obaklZNXunP2o3t7h+Q7pdaPPrxeGy+suGHYXsaH+6FzPkS+r5i6d",
+	"xt1VBzqO3VZCzOpQrrirokQO52Dnwx9DsyJuG8GxwhfHfPzNHWxCmEYBdo5qnr+3bvHIBap7dI2qbNEs",
+	"iaQ3BX9TRHjTAsJKlA0FsluWbiSZkRgHLw6Gs/AkFXf9lBmQGSLUGU8vmueD7GExcMsVAKKoLT/WfW6I",
+	"dseEZL3K3XR9Fst21wlooY5gPLrP9/abgZ4Ao3fZo6MXz9h/ffv3v309Zu8lxeO9fX+cMOHEozQHrk+k",
+	"LPOcjIBkazC4AF/4ohIR8BVyeut5RXDobSbMieMf3IboPDQniUrbgtwbvKtwvhCsFxOc3uK6b1Vu2lYB",
+	"Y0jUIuHCDuHC/1mf+rzCr996Mn27tXCG0L7CB6e2hKd7Twj3bpchOzpQVWAkCuG6+OM+UNwIN1VpVsoL",
+	"NLb6S/sFlhDKc3Xlgq/TqhGhqehiA1HuhK8kVXyn0qzghMT2ngiufZLoU2b1nPEJF3JLhFJc5Pe3u8gz",
+	"lc3DPTV4sug27iPlg0ULouA9gnNPd/gHRnxDjPhaVenuLoFgEP+Nlp174L/3tbPKF2hN+WsxpTULGvJV",
+	"9SQqK3vIlIZGM+9YNCDa8zVwS4lhc5+rVt3dMcNthaHojVK6f1Kk9cxAfgmmrUU6Upwqnfnks2qIGZKI",
+	"qTBW6XlMUXSZ2xs37tw8ca8Xfk+J+344Qk/gR3eht1R4FFQXyXjqwnLJ3VlxDVcG4Essq483jEAT7qEw",
+	"d6ebXCu3yy2cVw48Hj3cxKmmfpvCrkHSkIisrM+633h1S+3E9RZWuZT3l4jrF313LgVcbeHNCWEgy6wS",
+	"r467UAlTeRZrPN9zaSy6ZRcvzQICybmSgNGFZEI2ALUOhj9gIjkT1rDGYJ2BIvuNCbfw6tXLX3nt6o0u",
+	"nMuXePtSLp2ZA+7WoHadq1eFtjTwu750jWtWH3lPiw4UdSi8NanCuCh+CzJhGSe9usr9q0SiajYEJBZk",
+	"wIkoAcI4IRzvADlmMrCQUryWVjMaxAPXPGVixidgEvb2+Qs3R5FzlMAxrpproNkLS1mGL3Bo+pHCtZyo",
+	"bsSfwB493mOvxA+N+NSvaax6fWzKsR2lPZFUNeKbvRZhiAj274tc8axNHe5ExJ+VuRUF13YXc6R2Mm75",
+	"OiYc3EW9gzuq3dhcwBDqdD/qOL4SBouGJAxmhaWE/EyYYJxHPCeR0CpfB6DJtr7g/lq2piVcEgVROiQr",
+	"NWnHvSG5aHW5RWghFWMk6SmWcz2BNTQVR2J9UiBpKn3Uvl+02v1Y/2NFjGPFHprnVjGEkmiM46fBJIp6",
+	"cjX6uCcucfPENYkO1Nz7zXfhbFCyh2acDfLg8xgrFHJIJZVuIdLdUASlGyj7OQ07m6h/I1dztwGFaylD",
+	"mbqSCNprKEQ+XXlrL2efuKBSC3bHWA181samqjrAmZCc4opX5nwfuzIftWibMAMaA7Qp8TYcwaBk8Hsd",
+	"xfYF62mfTyLCRbwukUjV7PPsIho8GQgjjZmjW1n1y4k0ls+DG4QMKaQMcukFYYwRiKlMdZGprTWl+LWv",
+	"sqOELT4YUf4aRpSA+kzJZZnaH3aP+WTVpfPD4+giIg3XrRXCVNvlSvSrviPbQjX7yut6P6wKB8GYQAqg",
+	"khOipw+0Y8tox7P6TvdQjB4WvvvR/zVA+XZHVWncy7p4U/UO5KZP7d4woYmL9dV+b17hDqfxoG3HtW2P",
+	"QEHTrpXvOxOkA0/8DEU7jNHFq3tDiDxEUm+j7Ls2dRzktl2b+8Hr9+6C13d3I37g9XfsHXCL2UbKc9Ci",
+	"FEM5fohw6S/65oTTxCNuUlvSs1A8oMXxBVqVsFINxWmigk7hjVRmgzSRGc+ACVsJDT57RIRq9dmYHQHV",
+	"68HYdHc8PVr9T34TGyZ9D3U5mtGR7nRXWSPCew+hVNsfSqUXzrIZSCXhar1AKj/G7scwKv6osayX7e7S",
+	"8ba0jXomlACB/7ri85Dqr8Vkaqvqu401n2GsdaaEnJxIVzws5/hSyGJTZHmkImZJu3BlXZsuaZTWTU5k",
+	"qHSW1DXaKEjEZaC1g72TkI3maKYrPJQCQ3PmieS0qznjGtxnDhCt0G/eIqrqnNarrqLNO47o8w2nJkUG",
+	"qs9yu2oEOHDfURw3Qv+rqnawy8Lgtj5q5KY6nYpLyL4s6lnpQ86mgFTU34s7zjGtb6EjMp1Hdw1i665u",
+	"K+Kb6Ew16CDq6ohNI1llsTYuEYe37q3tsui21n5P80Pc4u40OyQX8oJdqTLPvPSOusE8dcWZJfLqqoiO",
+	"VYplAMUXKZtlCpz2R+0xKvuTv9jugtyVNuimvw4VecUvQnob4+GkSV7zvY58+LtmfKFs2TDy0ggIiJqy",
+	"3uY8bZaec3Vd83lo0FB1SAjrUeeRYnjjE1nxAFdQjgbzucpxwYe0xi3MeWus/D5nNNMx3DpBe73c8Mwj",
+	"EkVSO0yi7hAuFappiSgQFTMqpuulfMjPH0jdHYtQzUOyyh0S6nD+mJJrET11WRE9HyHSrIc9jLI5Ua5T",
+	"csI5tpC6hGU/kJZoBSAvv3+xmtYgWcjfjDsThvwhfTZh8DoVjUaESJU22FPqBj6rSUVtxVnlIqeJ0dLj",
+	"O50oCTvq/PxpZVpCI7dt1DlwEruppaM0TLXsAXxnVeHuV7Wcv2Apx3dAreaMVUXxcDvl9sWkIJqSAROx",
+	"FBHe0IkOrrbk1f5bwvKb58Dtxd9TPnzUMEyDvbMyy7rModngsy0uTqnMb21zV+TArTvDPNCGbaMNTWsF",
+	"EQg68FD+xpqmwwRxY032vIuN0budSgtV8uu276SL1GjmJARHtqr+PC0OHmHOF4KY85v0r8yc692FHvR3",
+	"5kgJsRw1n1G+DadrlUQNlBry1AO12D5J4kIUre4t9Wk6j3R99oMNmhpmQobMp75KOEfVi1t4j8PiVwdt",
+	"+E1SByCg3loOQtjq6+HGbG0uSYXm0XIc4dj7skmqITDUg3qEU77YmVF5aV3ZQO9WkGWoUzUTsrRg6ubM",
+	"cCK907vuAP7G9QqrV3iusJoBLTu85fq2VFFi5kSS+VcDyzTphdULwrjK7fEO4HVSS7XlLctqoQ2Exd9R",
+	"cks9/WpKcj/SW4J+4bHUYZyXNCXk7ZIZNUt4IHjbRvD2s4zx6gSj1SgaxK5PHtj9GP5cSoXpyl/ZNE3p",
+	"Cr0Ky7z5DJbqFj+ksNy/60GhQf58PidhRddoO/SOUOR3t2L9rgowwaCoK0A+7REo9DdptPbGvbgeKL4b",
+	"HT0SGPd9WMeWf2VCVIQwzFhBfVTq9myub4uagXHRodwsBTjEwwZozr90H6QA14f7ek/Y2ULXyuEBgnSO",
+	"wV5Wla0b3DHS9fDuLtOwEHbZTOzAEMyqeqUbprNOyzs3y5ZeJ1r9Kj3ZbXG5YNiXfbm2Mb0Bk5J4ZUBs",
+	"tAmuqmjQb2vkN9D7ZvdjaSKiY1cJMx/irNAcLue+3uVXxpe5fFpXf8YXsf49K8kUJjS1gMcOZjgvmr78",
+	"boj1hp4pC92P/VgkzEEWrzRJ48HtZxI4sN28KOuRJDja4yD5opOUSnfkW3iRycW8gP90suHa9DibV2Ye",
+	"BKCETg6vmrVoqXJRAXomDMX94weY7HUihX0aKVu78CrPjWq6uyJZSCeymYa0kG8U0pDOShceg2M0MpUa",
+	"PbFOpJOeY3f93Xbd9A1kFgQA3KF3ntbQeSkcubozu1mNtpVH3vMQYRrXRemHfhR/SUmJkDNKWSPtKOr2",
+	"gEI3MGeQ8IRv7H60fLLS6Ga564WKbbRulWzR4jYgn/CJ7yz/oKlvoeHZISReET5xqvlyjyk+6RY8XI1F",
+	"L7/wSSW+8xxFjFDHG7JGzx/AvSxr4W6krb0at2gcm0KDoJH4hSf1cPfu2qqN+H8d349tXMGY58ddQDee",
+	"vgwXor2GlyrlOcvQaKwKqsji3h0lo1LnoyejqbXFk93dHN+bKmOf/Pfe3t7o02+f/v8AhuuKTDp1AQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
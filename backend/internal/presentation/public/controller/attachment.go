@@ -0,0 +1,135 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"good-todo-go/internal/presentation/public/presenter"
+	"good-todo-go/internal/presentation/public/router/context_keys"
+	"good-todo-go/internal/usecase"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/labstack/echo/v4"
+)
+
+// multipartOverhead leaves room for the multipart boundaries and headers
+// around the file when the request body is capped
+const multipartOverhead = 64 << 10
+
+type AttachmentController struct {
+	attachmentUsecase   usecase.IAttachmentInteractor
+	attachmentPresenter presenter.IAttachmentPresenter
+	limits              usecase.AttachmentLimits
+}
+
+func NewAttachmentController(
+	attachmentUsecase usecase.IAttachmentInteractor,
+	attachmentPresenter presenter.IAttachmentPresenter,
+	limits usecase.AttachmentLimits,
+) *AttachmentController {
+	return &AttachmentController{
+		attachmentUsecase:   attachmentUsecase,
+		attachmentPresenter: attachmentPresenter,
+		limits:              limits,
+	}
+}
+
+func (c *AttachmentController) GetTodoAttachments(ctx echo.Context, todoID string) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	out, err := c.attachmentUsecase.ListAttachments(ctx.Request().Context(), todoID, userID)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.attachmentPresenter.GetTodoAttachments(ctx, out)
+}
+
+func (c *AttachmentController) UploadTodoAttachment(ctx echo.Context, todoID string) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	tenantID, ok := ctx.Get(context_keys.TenantIDContextKey).(string)
+	if !ok || tenantID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	// Cap the body so that an oversized upload is cut off instead of being
+	// spooled to disk while the multipart form is parsed
+	req := ctx.Request()
+	req.Body = http.MaxBytesReader(ctx.Response(), req.Body, c.limits.MaxBytes+multipartOverhead)
+
+	fileHeader, err := ctx.FormFile("file")
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			return echo.NewHTTPError(http.StatusRequestEntityTooLarge, "file is too large")
+		}
+		return echo.NewHTTPError(http.StatusBadRequest, "file is required")
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid file")
+	}
+	defer file.Close()
+
+	in := &input.UploadAttachmentInput{
+		TodoID:   todoID,
+		UserID:   userID,
+		TenantID: tenantID,
+		FileName: fileHeader.Filename,
+		Size:     fileHeader.Size,
+		Content:  file,
+	}
+
+	out, err := c.attachmentUsecase.UploadAttachment(req.Context(), in)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.attachmentPresenter.UploadTodoAttachment(ctx, out)
+}
+
+func (c *AttachmentController) DeleteTodoAttachment(ctx echo.Context, todoID, attachmentID string) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	in := &input.DeleteAttachmentInput{
+		TodoID:       todoID,
+		AttachmentID: attachmentID,
+		UserID:       userID,
+	}
+
+	if err := c.attachmentUsecase.DeleteAttachment(ctx.Request().Context(), in); err != nil {
+		return handleError(err)
+	}
+
+	return c.attachmentPresenter.DeleteTodoAttachment(ctx)
+}
+
+func (c *AttachmentController) GetTodoAttachmentContent(ctx echo.Context, todoID, attachmentID string) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	in := &input.GetAttachmentContentInput{
+		TodoID:       todoID,
+		AttachmentID: attachmentID,
+		UserID:       userID,
+	}
+
+	out, err := c.attachmentUsecase.GetAttachmentContent(ctx.Request().Context(), in)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.attachmentPresenter.GetTodoAttachmentContent(ctx, out)
+}
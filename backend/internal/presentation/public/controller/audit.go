@@ -0,0 +1,65 @@
+package controller
+
+import (
+	"net/http"
+
+	"good-todo-go/internal/presentation/public/api"
+	"good-todo-go/internal/presentation/public/presenter"
+	"good-todo-go/internal/presentation/public/router/context_keys"
+	"good-todo-go/internal/usecase"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/labstack/echo/v4"
+)
+
+type AuditController struct {
+	auditUsecase   usecase.IAuditEventInteractor
+	auditPresenter presenter.IAuditPresenter
+}
+
+func NewAuditController(
+	auditUsecase usecase.IAuditEventInteractor,
+	auditPresenter presenter.IAuditPresenter,
+) *AuditController {
+	return &AuditController{
+		auditUsecase:   auditUsecase,
+		auditPresenter: auditPresenter,
+	}
+}
+
+func (c *AuditController) GetAuditEvents(ctx echo.Context, params api.GetAuditEventsParams) error {
+	tenantID, ok := ctx.Get(context_keys.TenantIDContextKey).(string)
+	if !ok || tenantID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	role, _ := ctx.Get(context_keys.RoleContextKey).(string)
+
+	limit := 50
+	offset := 0
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	if params.Offset != nil {
+		offset = *params.Offset
+	}
+
+	in := &input.ListAuditEventsInput{
+		TenantID: tenantID,
+		Role:     role,
+		ActorID:  params.ActorId,
+		Action:   params.Action,
+		TargetID: params.TargetId,
+		From:     params.From,
+		To:       params.To,
+		Limit:    limit,
+		Offset:   offset,
+	}
+
+	out, err := c.auditUsecase.ListEvents(ctx.Request().Context(), in)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.auditPresenter.GetAuditEvents(ctx, out)
+}
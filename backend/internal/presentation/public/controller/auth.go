@@ -6,6 +6,7 @@ import (
 	"good-todo-go/internal/pkg/cerror"
 	"good-todo-go/internal/presentation/public/api"
 	"good-todo-go/internal/presentation/public/presenter"
+	"good-todo-go/internal/presentation/public/router/context_keys"
 	"good-todo-go/internal/usecase"
 	"good-todo-go/internal/usecase/input"
 
@@ -37,9 +38,7 @@ func (c *AuthController) Register(ctx echo.Context) error {
 	if string(req.Email) == "" || req.Password == "" || req.TenantSlug == "" {
 		return echo.NewHTTPError(http.StatusBadRequest, "email, password and tenant_slug are required")
 	}
-	if len(req.Password) < 8 {
-		return echo.NewHTTPError(http.StatusBadRequest, "password must be at least 8 characters")
-	}
+	// Password length and strength are checked by the password policy in the usecase
 
 	name := ""
 	if req.Name != nil {
@@ -129,8 +128,137 @@ func (c *AuthController) RefreshToken(ctx echo.Context) error {
 	return c.authPresenter.RefreshToken(ctx, out)
 }
 
+func (c *AuthController) ChangePassword(ctx echo.Context) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	tenantID, ok := ctx.Get(context_keys.TenantIDContextKey).(string)
+	if !ok || tenantID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	var req api.ChangePasswordRequest
+	if err := ctx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	if req.CurrentPassword == "" || req.NewPassword == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "current_password and new_password are required")
+	}
+
+	in := &input.ChangePasswordInput{
+		UserID:          userID,
+		TenantID:        tenantID,
+		CurrentPassword: req.CurrentPassword,
+		NewPassword:     req.NewPassword,
+	}
+
+	if err := c.authUsecase.ChangePassword(ctx.Request().Context(), in); err != nil {
+		return handleError(err)
+	}
+
+	return c.authPresenter.ChangePassword(ctx)
+}
+
+func (c *AuthController) RequestMagicLink(ctx echo.Context) error {
+	var req api.MagicLinkRequest
+	if err := ctx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	if string(req.Email) == "" || req.TenantSlug == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "email and tenant_slug are required")
+	}
+
+	in := &input.RequestMagicLinkInput{
+		Email:      string(req.Email),
+		TenantSlug: req.TenantSlug,
+	}
+
+	if err := c.authUsecase.RequestMagicLink(ctx.Request().Context(), in); err != nil {
+		return handleError(err)
+	}
+
+	return c.authPresenter.RequestMagicLink(ctx)
+}
+
+func (c *AuthController) ConsumeMagicLink(ctx echo.Context) error {
+	var req api.ConsumeMagicLinkRequest
+	if err := ctx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	if req.TenantSlug == "" || req.Token == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "tenant_slug and token are required")
+	}
+
+	in := &input.ConsumeMagicLinkInput{
+		TenantSlug: req.TenantSlug,
+		Token:      req.Token,
+	}
+
+	out, err := c.authUsecase.ConsumeMagicLink(ctx.Request().Context(), in)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.authPresenter.ConsumeMagicLink(ctx, out)
+}
+
+func (c *AuthController) RequestPasswordReset(ctx echo.Context) error {
+	var req api.PasswordResetRequest
+	if err := ctx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	if string(req.Email) == "" || req.TenantSlug == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "email and tenant_slug are required")
+	}
+
+	in := &input.RequestPasswordResetInput{
+		Email:      string(req.Email),
+		TenantSlug: req.TenantSlug,
+	}
+
+	if err := c.authUsecase.RequestPasswordReset(ctx.Request().Context(), in); err != nil {
+		return handleError(err)
+	}
+
+	return c.authPresenter.RequestPasswordReset(ctx)
+}
+
+func (c *AuthController) ResetPassword(ctx echo.Context) error {
+	var req api.ResetPasswordRequest
+	if err := ctx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	if req.TenantSlug == "" || req.Token == "" || req.NewPassword == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "tenant_slug, token and new_password are required")
+	}
+
+	in := &input.ResetPasswordInput{
+		TenantSlug:  req.TenantSlug,
+		Token:       req.Token,
+		NewPassword: req.NewPassword,
+	}
+
+	if err := c.authUsecase.ResetPassword(ctx.Request().Context(), in); err != nil {
+		return handleError(err)
+	}
+
+	return c.authPresenter.ResetPassword(ctx)
+}
+
 func handleError(err error) error {
 	if appErr, ok := err.(*cerror.AppError); ok {
+		// Validation errors carry structured details for the client,
+		// so they are rendered as-is by cerror.CustomHTTPErrorHandler
+		if appErr.Code == cerror.ErrCodeValidationError {
+			return appErr
+		}
 		return echo.NewHTTPError(appErr.HTTPStatus, appErr.Message)
 	}
 	return echo.NewHTTPError(http.StatusInternalServerError, "internal server error")
@@ -0,0 +1,109 @@
+package controller
+
+import (
+	"net/http"
+
+	"good-todo-go/internal/presentation/public/api"
+	"good-todo-go/internal/presentation/public/presenter"
+	"good-todo-go/internal/presentation/public/router/context_keys"
+	"good-todo-go/internal/usecase"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/labstack/echo/v4"
+)
+
+type CalendarController struct {
+	calendarUsecase   usecase.ICalendarInteractor
+	calendarPresenter presenter.ICalendarPresenter
+}
+
+func NewCalendarController(
+	calendarUsecase usecase.ICalendarInteractor,
+	calendarPresenter presenter.ICalendarPresenter,
+) *CalendarController {
+	return &CalendarController{
+		calendarUsecase:   calendarUsecase,
+		calendarPresenter: calendarPresenter,
+	}
+}
+
+func (c *CalendarController) RotateToken(ctx echo.Context) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	out, err := c.calendarUsecase.RotateToken(ctx.Request().Context(), userID)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.calendarPresenter.RotateToken(ctx, out)
+}
+
+func (c *CalendarController) RevokeToken(ctx echo.Context) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	if err := c.calendarUsecase.RevokeToken(ctx.Request().Context(), userID); err != nil {
+		return handleError(err)
+	}
+
+	return c.calendarPresenter.RevokeToken(ctx)
+}
+
+// GetFeed serves the feed of the user whose token is in the URL; the
+// calendar auth middleware has already resolved them
+func (c *CalendarController) GetFeed(ctx echo.Context) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	in := &input.GetCalendarFeedInput{
+		UserID:    userID,
+		Component: ctx.QueryParam("component"),
+	}
+
+	out, err := c.calendarUsecase.GetFeed(ctx.Request().Context(), in)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.calendarPresenter.Feed(ctx, out)
+}
+
+func (c *CalendarController) ExportTodos(ctx echo.Context, params api.ExportTodosParams) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	in := &input.ExportCalendarInput{
+		UserID: userID,
+		Filter: &input.TodoFilterInput{
+			Completed: params.Completed,
+			Overdue:   params.Overdue,
+			DueBefore: params.DueBefore,
+			DueAfter:  params.DueAfter,
+			IsPublic:  params.IsPublic,
+			Search:    params.Q,
+			ProjectID: params.ProjectId,
+		},
+	}
+	if params.Component != nil {
+		in.Component = string(*params.Component)
+	}
+	if params.Tag != nil {
+		in.Filter.TagIDs = *params.Tag
+	}
+
+	out, err := c.calendarUsecase.ExportTodos(ctx.Request().Context(), in)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.calendarPresenter.Export(ctx, out)
+}
@@ -0,0 +1,118 @@
+package controller
+
+import (
+	"net/http"
+
+	"good-todo-go/internal/presentation/public/api"
+	"good-todo-go/internal/presentation/public/presenter"
+	"good-todo-go/internal/presentation/public/router/context_keys"
+	"good-todo-go/internal/usecase"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/labstack/echo/v4"
+)
+
+type CommentController struct {
+	commentUsecase   usecase.ICommentInteractor
+	commentPresenter presenter.ICommentPresenter
+}
+
+func NewCommentController(
+	commentUsecase usecase.ICommentInteractor,
+	commentPresenter presenter.ICommentPresenter,
+) *CommentController {
+	return &CommentController{
+		commentUsecase:   commentUsecase,
+		commentPresenter: commentPresenter,
+	}
+}
+
+func (c *CommentController) GetTodoComments(ctx echo.Context, todoID string) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	out, err := c.commentUsecase.ListComments(ctx.Request().Context(), todoID, userID)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.commentPresenter.GetTodoComments(ctx, out)
+}
+
+func (c *CommentController) CreateTodoComment(ctx echo.Context, todoID string) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	tenantID, ok := ctx.Get(context_keys.TenantIDContextKey).(string)
+	if !ok || tenantID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	var req api.CommentRequest
+	if err := ctx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	in := &input.CreateCommentInput{
+		TodoID:   todoID,
+		UserID:   userID,
+		TenantID: tenantID,
+		Body:     req.Body,
+	}
+
+	out, err := c.commentUsecase.CreateComment(ctx.Request().Context(), in)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.commentPresenter.CreateTodoComment(ctx, out)
+}
+
+func (c *CommentController) UpdateTodoComment(ctx echo.Context, todoID, commentID string) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	var req api.CommentRequest
+	if err := ctx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	in := &input.UpdateCommentInput{
+		TodoID:    todoID,
+		CommentID: commentID,
+		UserID:    userID,
+		Body:      req.Body,
+	}
+
+	out, err := c.commentUsecase.UpdateComment(ctx.Request().Context(), in)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.commentPresenter.UpdateTodoComment(ctx, out)
+}
+
+func (c *CommentController) DeleteTodoComment(ctx echo.Context, todoID, commentID string) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	in := &input.DeleteCommentInput{
+		TodoID:    todoID,
+		CommentID: commentID,
+		UserID:    userID,
+	}
+
+	if err := c.commentUsecase.DeleteComment(ctx.Request().Context(), in); err != nil {
+		return handleError(err)
+	}
+
+	return c.commentPresenter.DeleteTodoComment(ctx)
+}
@@ -0,0 +1,65 @@
+package controller
+
+import (
+	"net/http"
+
+	"good-todo-go/internal/presentation/public/api"
+	"good-todo-go/internal/presentation/public/presenter"
+	"good-todo-go/internal/presentation/public/router/context_keys"
+	"good-todo-go/internal/usecase"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/labstack/echo/v4"
+)
+
+type NotificationController struct {
+	notificationUsecase   usecase.INotificationInteractor
+	notificationPresenter presenter.INotificationPresenter
+}
+
+func NewNotificationController(
+	notificationUsecase usecase.INotificationInteractor,
+	notificationPresenter presenter.INotificationPresenter,
+) *NotificationController {
+	return &NotificationController{
+		notificationUsecase:   notificationUsecase,
+		notificationPresenter: notificationPresenter,
+	}
+}
+
+func (c *NotificationController) GetNotifications(ctx echo.Context, params api.GetNotificationsParams) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	in := &input.GetNotificationsInput{
+		UserID: userID,
+	}
+	if params.Unread != nil {
+		in.UnreadOnly = *params.Unread
+	}
+	if params.Limit != nil {
+		in.Limit = *params.Limit
+	}
+
+	out, err := c.notificationUsecase.GetNotifications(ctx.Request().Context(), in)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.notificationPresenter.GetNotifications(ctx, out)
+}
+
+func (c *NotificationController) MarkNotificationRead(ctx echo.Context, notificationID string) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	if err := c.notificationUsecase.MarkNotificationRead(ctx.Request().Context(), notificationID, userID); err != nil {
+		return handleError(err)
+	}
+
+	return c.notificationPresenter.MarkNotificationRead(ctx)
+}
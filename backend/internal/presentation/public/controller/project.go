@@ -0,0 +1,140 @@
+package controller
+
+import (
+	"net/http"
+
+	"good-todo-go/internal/presentation/public/api"
+	"good-todo-go/internal/presentation/public/presenter"
+	"good-todo-go/internal/presentation/public/router/context_keys"
+	"good-todo-go/internal/usecase"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ProjectController struct {
+	projectUsecase   usecase.IProjectInteractor
+	projectPresenter presenter.IProjectPresenter
+}
+
+func NewProjectController(
+	projectUsecase usecase.IProjectInteractor,
+	projectPresenter presenter.IProjectPresenter,
+) *ProjectController {
+	return &ProjectController{
+		projectUsecase:   projectUsecase,
+		projectPresenter: projectPresenter,
+	}
+}
+
+func (c *ProjectController) GetProjects(ctx echo.Context, params api.GetProjectsParams) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	in := &input.GetProjectsInput{
+		UserID: userID,
+	}
+	if params.IncludeArchived != nil {
+		in.IncludeArchived = *params.IncludeArchived
+	}
+
+	out, err := c.projectUsecase.GetProjects(ctx.Request().Context(), in)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.projectPresenter.GetProjects(ctx, out)
+}
+
+func (c *ProjectController) GetProject(ctx echo.Context, projectID string) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	out, err := c.projectUsecase.GetProject(ctx.Request().Context(), projectID, userID)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.projectPresenter.GetProject(ctx, out)
+}
+
+func (c *ProjectController) CreateProject(ctx echo.Context) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	tenantID, ok := ctx.Get(context_keys.TenantIDContextKey).(string)
+	if !ok || tenantID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	var req api.CreateProjectRequest
+	if err := ctx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	in := &input.CreateProjectInput{
+		TenantID: tenantID,
+		UserID:   userID,
+		Name:     req.Name,
+	}
+	if req.Description != nil {
+		in.Description = *req.Description
+	}
+	if req.IsPublic != nil {
+		in.IsPublic = *req.IsPublic
+	}
+
+	out, err := c.projectUsecase.CreateProject(ctx.Request().Context(), in)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.projectPresenter.CreateProject(ctx, out)
+}
+
+func (c *ProjectController) UpdateProject(ctx echo.Context, projectID string) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	var req api.UpdateProjectRequest
+	if err := ctx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	in := &input.UpdateProjectInput{
+		ProjectID:   projectID,
+		UserID:      userID,
+		Name:        req.Name,
+		Description: req.Description,
+		IsPublic:    req.IsPublic,
+		Archived:    req.Archived,
+	}
+
+	out, err := c.projectUsecase.UpdateProject(ctx.Request().Context(), in)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.projectPresenter.UpdateProject(ctx, out)
+}
+
+func (c *ProjectController) DeleteProject(ctx echo.Context, projectID string) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	if err := c.projectUsecase.DeleteProject(ctx.Request().Context(), projectID, userID); err != nil {
+		return handleError(err)
+	}
+
+	return c.projectPresenter.DeleteProject(ctx)
+}
@@ -0,0 +1,96 @@
+package controller
+
+import (
+	"net/http"
+
+	"good-todo-go/internal/presentation/public/api"
+	"good-todo-go/internal/presentation/public/presenter"
+	"good-todo-go/internal/presentation/public/router/context_keys"
+	"good-todo-go/internal/usecase"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ReminderController struct {
+	reminderUsecase   usecase.IReminderInteractor
+	reminderPresenter presenter.IReminderPresenter
+}
+
+func NewReminderController(
+	reminderUsecase usecase.IReminderInteractor,
+	reminderPresenter presenter.IReminderPresenter,
+) *ReminderController {
+	return &ReminderController{
+		reminderUsecase:   reminderUsecase,
+		reminderPresenter: reminderPresenter,
+	}
+}
+
+func (c *ReminderController) GetTodoReminders(ctx echo.Context, todoID string) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	out, err := c.reminderUsecase.ListReminders(ctx.Request().Context(), todoID, userID)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.reminderPresenter.GetTodoReminders(ctx, out)
+}
+
+func (c *ReminderController) CreateTodoReminder(ctx echo.Context, todoID string) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	tenantID, ok := ctx.Get(context_keys.TenantIDContextKey).(string)
+	if !ok || tenantID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	var req api.CreateReminderRequest
+	if err := ctx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	in := &input.CreateReminderInput{
+		TodoID:        todoID,
+		UserID:        userID,
+		TenantID:      tenantID,
+		RemindAt:      req.RemindAt,
+		OffsetMinutes: req.OffsetMinutes,
+	}
+	if req.Channel != nil {
+		in.Channel = string(*req.Channel)
+	}
+
+	out, err := c.reminderUsecase.CreateReminder(ctx.Request().Context(), in)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.reminderPresenter.CreateTodoReminder(ctx, out)
+}
+
+func (c *ReminderController) DeleteTodoReminder(ctx echo.Context, todoID, reminderID string) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	in := &input.DeleteReminderInput{
+		TodoID:     todoID,
+		ReminderID: reminderID,
+		UserID:     userID,
+	}
+
+	if err := c.reminderUsecase.DeleteReminder(ctx.Request().Context(), in); err != nil {
+		return handleError(err)
+	}
+
+	return c.reminderPresenter.DeleteTodoReminder(ctx)
+}
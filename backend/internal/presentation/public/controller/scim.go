@@ -0,0 +1,182 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"good-todo-go/internal/pkg/cerror"
+	"good-todo-go/internal/presentation/public/presenter"
+	"good-todo-go/internal/presentation/public/router/context_keys"
+	"good-todo-go/internal/presentation/public/scim"
+	"good-todo-go/internal/usecase"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ScimController struct {
+	scimUsecase   usecase.IScimInteractor
+	scimPresenter presenter.IScimPresenter
+}
+
+func NewScimController(
+	scimUsecase usecase.IScimInteractor,
+	scimPresenter presenter.IScimPresenter,
+) *ScimController {
+	return &ScimController{
+		scimUsecase:   scimUsecase,
+		scimPresenter: scimPresenter,
+	}
+}
+
+func (c *ScimController) GetServiceProviderConfig(ctx echo.Context) error {
+	return c.scimPresenter.GetServiceProviderConfig(ctx)
+}
+
+func (c *ScimController) ListUsers(ctx echo.Context) error {
+	tenantID, ok := ctx.Get(context_keys.TenantIDContextKey).(string)
+	if !ok || tenantID == "" {
+		return c.scimPresenter.Error(ctx, cerror.NewUnauthorized("unauthorized", nil))
+	}
+
+	in := &input.ListScimUsersInput{
+		TenantID: tenantID,
+		Filter:   ctx.QueryParam("filter"),
+	}
+	if v := ctx.QueryParam("startIndex"); v != "" {
+		in.StartIndex, _ = strconv.Atoi(v)
+	}
+	if v := ctx.QueryParam("count"); v != "" {
+		in.Count, _ = strconv.Atoi(v)
+	}
+
+	out, err := c.scimUsecase.ListUsers(ctx.Request().Context(), in)
+	if err != nil {
+		return c.scimPresenter.Error(ctx, err)
+	}
+
+	return c.scimPresenter.ListUsers(ctx, out)
+}
+
+func (c *ScimController) GetUser(ctx echo.Context) error {
+	out, err := c.scimUsecase.GetUser(ctx.Request().Context(), ctx.Param("id"))
+	if err != nil {
+		return c.scimPresenter.Error(ctx, err)
+	}
+
+	return c.scimPresenter.GetUser(ctx, out)
+}
+
+func (c *ScimController) CreateUser(ctx echo.Context) error {
+	tenantID, ok := ctx.Get(context_keys.TenantIDContextKey).(string)
+	if !ok || tenantID == "" {
+		return c.scimPresenter.Error(ctx, cerror.NewUnauthorized("unauthorized", nil))
+	}
+
+	var req scim.UserRequest
+	if err := scim.Bind(ctx, &req); err != nil {
+		return c.invalidSyntax(ctx)
+	}
+
+	in := &input.CreateScimUserInput{
+		TenantID: tenantID,
+		User:     toScimUserInput(&req),
+	}
+
+	out, err := c.scimUsecase.CreateUser(ctx.Request().Context(), in)
+	if err != nil {
+		return c.scimPresenter.Error(ctx, err)
+	}
+
+	return c.scimPresenter.CreateUser(ctx, out)
+}
+
+func (c *ScimController) ReplaceUser(ctx echo.Context) error {
+	tenantID, ok := ctx.Get(context_keys.TenantIDContextKey).(string)
+	if !ok || tenantID == "" {
+		return c.scimPresenter.Error(ctx, cerror.NewUnauthorized("unauthorized", nil))
+	}
+
+	var req scim.UserRequest
+	if err := scim.Bind(ctx, &req); err != nil {
+		return c.invalidSyntax(ctx)
+	}
+
+	in := &input.ReplaceScimUserInput{
+		TenantID: tenantID,
+		UserID:   ctx.Param("id"),
+		User:     toScimUserInput(&req),
+	}
+
+	out, err := c.scimUsecase.ReplaceUser(ctx.Request().Context(), in)
+	if err != nil {
+		return c.scimPresenter.Error(ctx, err)
+	}
+
+	return c.scimPresenter.UpdateUser(ctx, out)
+}
+
+func (c *ScimController) PatchUser(ctx echo.Context) error {
+	tenantID, ok := ctx.Get(context_keys.TenantIDContextKey).(string)
+	if !ok || tenantID == "" {
+		return c.scimPresenter.Error(ctx, cerror.NewUnauthorized("unauthorized", nil))
+	}
+
+	var req scim.PatchRequest
+	if err := scim.Bind(ctx, &req); err != nil {
+		return c.invalidSyntax(ctx)
+	}
+
+	in := &input.PatchScimUserInput{
+		TenantID:   tenantID,
+		UserID:     ctx.Param("id"),
+		Operations: make([]input.ScimPatchOperation, len(req.Operations)),
+	}
+	for i, op := range req.Operations {
+		in.Operations[i] = input.ScimPatchOperation{
+			Op:    op.Op,
+			Path:  op.Path,
+			Value: op.Value,
+		}
+	}
+
+	out, err := c.scimUsecase.PatchUser(ctx.Request().Context(), in)
+	if err != nil {
+		return c.scimPresenter.Error(ctx, err)
+	}
+
+	return c.scimPresenter.UpdateUser(ctx, out)
+}
+
+func (c *ScimController) DeleteUser(ctx echo.Context) error {
+	if err := c.scimUsecase.DeleteUser(ctx.Request().Context(), ctx.Param("id")); err != nil {
+		return c.scimPresenter.Error(ctx, err)
+	}
+
+	return c.scimPresenter.DeleteUser(ctx)
+}
+
+func (c *ScimController) invalidSyntax(ctx echo.Context) error {
+	return scim.JSON(ctx, http.StatusBadRequest, scim.NewError(http.StatusBadRequest, "invalidSyntax", "invalid request body"))
+}
+
+func toScimUserInput(req *scim.UserRequest) input.ScimUserInput {
+	in := input.ScimUserInput{
+		UserName:   req.UserName,
+		Name:       req.DisplayName,
+		ExternalID: req.ExternalID,
+		Active:     req.Active,
+		Password:   req.Password,
+	}
+	if in.Name == "" && req.Name != nil {
+		in.Name = req.Name.Formatted
+		if in.Name == "" {
+			in.Name = strings.TrimSpace(req.Name.GivenName + " " + req.Name.FamilyName)
+		}
+	}
+	if role, ok := usecase.ScimRoleValue(req.Roles); ok {
+		in.Role = &role
+	}
+	return in
+}
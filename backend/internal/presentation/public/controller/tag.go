@@ -0,0 +1,161 @@
+package controller
+
+import (
+	"net/http"
+
+	"good-todo-go/internal/presentation/public/api"
+	"good-todo-go/internal/presentation/public/presenter"
+	"good-todo-go/internal/presentation/public/router/context_keys"
+	"good-todo-go/internal/usecase"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/labstack/echo/v4"
+)
+
+type TagController struct {
+	tagUsecase   usecase.ITagInteractor
+	tagPresenter presenter.ITagPresenter
+}
+
+func NewTagController(
+	tagUsecase usecase.ITagInteractor,
+	tagPresenter presenter.ITagPresenter,
+) *TagController {
+	return &TagController{
+		tagUsecase:   tagUsecase,
+		tagPresenter: tagPresenter,
+	}
+}
+
+func (c *TagController) GetTags(ctx echo.Context) error {
+	tenantID, ok := ctx.Get(context_keys.TenantIDContextKey).(string)
+	if !ok || tenantID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	out, err := c.tagUsecase.ListTags(ctx.Request().Context())
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.tagPresenter.GetTags(ctx, out)
+}
+
+func (c *TagController) CreateTag(ctx echo.Context) error {
+	tenantID, ok := ctx.Get(context_keys.TenantIDContextKey).(string)
+	if !ok || tenantID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	var req api.CreateTagRequest
+	if err := ctx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	in := &input.CreateTagInput{
+		TenantID: tenantID,
+		Name:     req.Name,
+		Color:    req.Color,
+	}
+
+	out, err := c.tagUsecase.CreateTag(ctx.Request().Context(), in)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.tagPresenter.CreateTag(ctx, out)
+}
+
+func (c *TagController) UpdateTag(ctx echo.Context, tagID string) error {
+	tenantID, ok := ctx.Get(context_keys.TenantIDContextKey).(string)
+	if !ok || tenantID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	role, _ := ctx.Get(context_keys.RoleContextKey).(string)
+
+	var req api.UpdateTagRequest
+	if err := ctx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	in := &input.UpdateTagInput{
+		TagID: tagID,
+		Role:  role,
+		Name:  req.Name,
+		Color: req.Color,
+	}
+
+	out, err := c.tagUsecase.UpdateTag(ctx.Request().Context(), in)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.tagPresenter.UpdateTag(ctx, out)
+}
+
+func (c *TagController) DeleteTag(ctx echo.Context, tagID string) error {
+	tenantID, ok := ctx.Get(context_keys.TenantIDContextKey).(string)
+	if !ok || tenantID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	role, _ := ctx.Get(context_keys.RoleContextKey).(string)
+
+	in := &input.DeleteTagInput{
+		TagID: tagID,
+		Role:  role,
+	}
+
+	if err := c.tagUsecase.DeleteTag(ctx.Request().Context(), in); err != nil {
+		return handleError(err)
+	}
+
+	return c.tagPresenter.DeleteTag(ctx)
+}
+
+func (c *TagController) AttachTodoTag(ctx echo.Context, todoID, tagID string) error {
+	in, err := todoTagInput(ctx, todoID, tagID)
+	if err != nil {
+		return err
+	}
+
+	out, err := c.tagUsecase.AttachTag(ctx.Request().Context(), in)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.tagPresenter.AttachTodoTag(ctx, out)
+}
+
+func (c *TagController) DetachTodoTag(ctx echo.Context, todoID, tagID string) error {
+	in, err := todoTagInput(ctx, todoID, tagID)
+	if err != nil {
+		return err
+	}
+
+	if err := c.tagUsecase.DetachTag(ctx.Request().Context(), in); err != nil {
+		return handleError(err)
+	}
+
+	return c.tagPresenter.DetachTodoTag(ctx)
+}
+
+func todoTagInput(ctx echo.Context, todoID, tagID string) (*input.TodoTagInput, error) {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return nil, echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	tenantID, ok := ctx.Get(context_keys.TenantIDContextKey).(string)
+	if !ok || tenantID == "" {
+		return nil, echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	return &input.TodoTagInput{
+		TenantID: tenantID,
+		UserID:   userID,
+		TodoID:   todoID,
+		TagID:    tagID,
+	}, nil
+}
@@ -0,0 +1,125 @@
+package controller
+
+import (
+	"net/http"
+
+	"good-todo-go/internal/presentation/public/api"
+	"good-todo-go/internal/presentation/public/presenter"
+	"good-todo-go/internal/presentation/public/router/context_keys"
+	"good-todo-go/internal/usecase"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/labstack/echo/v4"
+)
+
+type TenantController struct {
+	tenantUsecase   usecase.ITenantInteractor
+	tenantPresenter presenter.ITenantPresenter
+}
+
+func NewTenantController(
+	tenantUsecase usecase.ITenantInteractor,
+	tenantPresenter presenter.ITenantPresenter,
+) *TenantController {
+	return &TenantController{
+		tenantUsecase:   tenantUsecase,
+		tenantPresenter: tenantPresenter,
+	}
+}
+
+func (c *TenantController) GetSettings(ctx echo.Context) error {
+	tenantID, ok := ctx.Get(context_keys.TenantIDContextKey).(string)
+	if !ok || tenantID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	out, err := c.tenantUsecase.GetSettings(ctx.Request().Context(), tenantID)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.tenantPresenter.GetSettings(ctx, out)
+}
+
+func (c *TenantController) UpdateSettings(ctx echo.Context) error {
+	tenantID, ok := ctx.Get(context_keys.TenantIDContextKey).(string)
+	if !ok || tenantID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	userID, _ := ctx.Get(context_keys.UserIDContextKey).(string)
+	role, _ := ctx.Get(context_keys.RoleContextKey).(string)
+
+	var req api.UpdateTenantSettingsRequest
+	if err := ctx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	in := &input.UpdateTenantSettingsInput{
+		TenantID:           tenantID,
+		UserID:             userID,
+		Role:               role,
+		MagicLinkEnabled:   req.MagicLinkEnabled,
+		TrashRetentionDays: req.TrashRetentionDays,
+	}
+	if req.AccountDeletionPolicy != nil {
+		policy := string(*req.AccountDeletionPolicy)
+		in.AccountDeletionPolicy = &policy
+	}
+	if req.SearchLanguage != nil {
+		language := string(*req.SearchLanguage)
+		in.SearchLanguage = &language
+	}
+
+	out, err := c.tenantUsecase.UpdateSettings(ctx.Request().Context(), in)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.tenantPresenter.UpdateSettings(ctx, out)
+}
+
+func (c *TenantController) RotateScimToken(ctx echo.Context) error {
+	tenantID, ok := ctx.Get(context_keys.TenantIDContextKey).(string)
+	if !ok || tenantID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	userID, _ := ctx.Get(context_keys.UserIDContextKey).(string)
+	role, _ := ctx.Get(context_keys.RoleContextKey).(string)
+
+	in := &input.ScimTokenInput{
+		TenantID: tenantID,
+		UserID:   userID,
+		Role:     role,
+	}
+
+	out, err := c.tenantUsecase.RotateScimToken(ctx.Request().Context(), in)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.tenantPresenter.RotateScimToken(ctx, out)
+}
+
+func (c *TenantController) RevokeScimToken(ctx echo.Context) error {
+	tenantID, ok := ctx.Get(context_keys.TenantIDContextKey).(string)
+	if !ok || tenantID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	userID, _ := ctx.Get(context_keys.UserIDContextKey).(string)
+	role, _ := ctx.Get(context_keys.RoleContextKey).(string)
+
+	in := &input.ScimTokenInput{
+		TenantID: tenantID,
+		UserID:   userID,
+		Role:     role,
+	}
+
+	if err := c.tenantUsecase.RevokeScimToken(ctx.Request().Context(), in); err != nil {
+		return handleError(err)
+	}
+
+	return c.tenantPresenter.RevokeScimToken(ctx)
+}
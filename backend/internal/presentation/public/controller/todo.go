@@ -1,7 +1,12 @@
 package controller
 
 import (
+	"encoding/json"
+	"io"
+	"mime"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"good-todo-go/internal/presentation/public/api"
@@ -44,9 +49,33 @@ func (c *TodoController) GetTodos(ctx echo.Context, params api.GetTodosParams) e
 	}
 
 	in := &input.GetTodosInput{
-		UserID: userID,
-		Limit:  limit,
-		Offset: offset,
+		UserID:          userID,
+		Completed:       params.Completed,
+		Overdue:         params.Overdue,
+		DueBefore:       params.DueBefore,
+		DueAfter:        params.DueAfter,
+		CreatedBefore:   params.CreatedBefore,
+		CreatedAfter:    params.CreatedAfter,
+		CompletedBefore: params.CompletedBefore,
+		CompletedAfter:  params.CompletedAfter,
+		IsPublic:        params.IsPublic,
+		Search:          params.Q,
+		ProjectID:       params.ProjectId,
+		IncludeTotal:    params.IncludeTotal,
+		Limit:           limit,
+		Offset:          offset,
+	}
+	if params.Cursor != nil {
+		in.Cursor = *params.Cursor
+	}
+	if params.Tag != nil {
+		in.TagIDs = *params.Tag
+	}
+	if params.Sort != nil {
+		in.Sort = string(*params.Sort)
+	}
+	if params.Order != nil {
+		in.Order = string(*params.Order)
 	}
 
 	out, err := c.todoUsecase.GetTodos(ctx.Request().Context(), in)
@@ -57,6 +86,55 @@ func (c *TodoController) GetTodos(ctx echo.Context, params api.GetTodosParams) e
 	return c.todoPresenter.GetTodos(ctx, out)
 }
 
+func (c *TodoController) GetTodoCompletionStats(ctx echo.Context, params api.GetTodoCompletionStatsParams) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	in := &input.GetCompletionStatsInput{
+		UserID: userID,
+	}
+	if params.Period != nil {
+		in.Period = string(*params.Period)
+	}
+	if params.Tz != nil {
+		in.Timezone = *params.Tz
+	}
+
+	out, err := c.todoUsecase.GetCompletionStats(ctx.Request().Context(), in)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.todoPresenter.GetCompletionStats(ctx, out)
+}
+
+func (c *TodoController) SearchTodos(ctx echo.Context, params api.SearchTodosParams) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	in := &input.SearchTodosInput{
+		UserID: userID,
+		Query:  params.Q,
+	}
+	if params.Limit != nil {
+		in.Limit = *params.Limit
+	}
+	if params.Offset != nil {
+		in.Offset = *params.Offset
+	}
+
+	out, err := c.todoUsecase.SearchTodos(ctx.Request().Context(), in)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.todoPresenter.SearchTodos(ctx, out)
+}
+
 func (c *TodoController) GetPublicTodos(ctx echo.Context, params api.GetPublicTodosParams) error {
 	limit := 20
 	offset := 0
@@ -68,8 +146,16 @@ func (c *TodoController) GetPublicTodos(ctx echo.Context, params api.GetPublicTo
 	}
 
 	in := &input.GetPublicTodosInput{
-		Limit:  limit,
-		Offset: offset,
+		ProjectID:    params.ProjectId,
+		IncludeTotal: params.IncludeTotal,
+		Limit:        limit,
+		Offset:       offset,
+	}
+	if params.Cursor != nil {
+		in.Cursor = *params.Cursor
+	}
+	if params.Tag != nil {
+		in.TagIDs = *params.Tag
 	}
 
 	out, err := c.todoUsecase.GetPublicTodos(ctx.Request().Context(), in)
@@ -80,6 +166,42 @@ func (c *TodoController) GetPublicTodos(ctx echo.Context, params api.GetPublicTo
 	return c.todoPresenter.GetTodos(ctx, out)
 }
 
+func (c *TodoController) GetTodoTrash(ctx echo.Context, params api.GetTodoTrashParams) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	in := &input.GetTrashInput{UserID: userID}
+	if params.Limit != nil {
+		in.Limit = *params.Limit
+	}
+	if params.Offset != nil {
+		in.Offset = *params.Offset
+	}
+
+	out, err := c.todoUsecase.GetTrash(ctx.Request().Context(), in)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.todoPresenter.GetTodos(ctx, out)
+}
+
+func (c *TodoController) RestoreTodo(ctx echo.Context, todoID string) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	out, err := c.todoUsecase.RestoreTodo(ctx.Request().Context(), todoID, userID)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.todoPresenter.UpdateTodo(ctx, out)
+}
+
 func (c *TodoController) GetTodo(ctx echo.Context, todoID string) error {
 	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
 	if !ok || userID == "" {
@@ -136,6 +258,14 @@ func (c *TodoController) CreateTodo(ctx echo.Context) error {
 		Description: description,
 		IsPublic:    isPublic,
 		DueDate:     dueDate,
+		ProjectID:   req.ProjectId,
+		ParentID:    req.ParentId,
+	}
+	if req.Recurrence != nil {
+		in.Recurrence = toTodoRecurrenceInput(req.Recurrence)
+	}
+	if req.Priority != nil {
+		in.Priority = string(*req.Priority)
 	}
 
 	out, err := c.todoUsecase.CreateTodo(ctx.Request().Context(), in)
@@ -146,7 +276,7 @@ func (c *TodoController) CreateTodo(ctx echo.Context) error {
 	return c.todoPresenter.CreateTodo(ctx, out)
 }
 
-func (c *TodoController) UpdateTodo(ctx echo.Context, todoID string) error {
+func (c *TodoController) UpdateTodo(ctx echo.Context, todoID string, params api.UpdateTodoParams) error {
 	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
 	if !ok || userID == "" {
 		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
@@ -165,6 +295,14 @@ func (c *TodoController) UpdateTodo(ctx echo.Context, todoID string) error {
 		Completed:   req.Completed,
 		IsPublic:    req.IsPublic,
 		DueDate:     req.DueDate,
+		IfMatch:     parseTodoIfMatch(params.IfMatch),
+	}
+	if req.CascadeCompletion != nil {
+		in.CascadeCompletion = *req.CascadeCompletion
+	}
+	if req.Priority != nil {
+		priority := string(*req.Priority)
+		in.Priority = &priority
 	}
 
 	out, err := c.todoUsecase.UpdateTodo(ctx.Request().Context(), in)
@@ -175,15 +313,487 @@ func (c *TodoController) UpdateTodo(ctx echo.Context, todoID string) error {
 	return c.todoPresenter.UpdateTodo(ctx, out)
 }
 
-func (c *TodoController) DeleteTodo(ctx echo.Context, todoID string) error {
+// PatchTodo reads the merge patch itself: the generated request type cannot
+// tell a field set to null from a missing one
+func (c *TodoController) PatchTodo(ctx echo.Context, todoID string, params api.PatchTodoParams) error {
 	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
 	if !ok || userID == "" {
 		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
 	}
 
-	if err := c.todoUsecase.DeleteTodo(ctx.Request().Context(), todoID, userID); err != nil {
+	mediaType, _, err := mime.ParseMediaType(ctx.Request().Header.Get(echo.HeaderContentType))
+	if err != nil || mediaType != "application/merge-patch+json" {
+		return echo.NewHTTPError(http.StatusUnsupportedMediaType, "request body must be application/merge-patch+json")
+	}
+	body, err := io.ReadAll(ctx.Request().Body)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+	var patch map[string]interface{}
+	if err := json.Unmarshal(body, &patch); err != nil || patch == nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "request body must be a JSON object")
+	}
+
+	out, err := c.todoUsecase.PatchTodo(ctx.Request().Context(), &input.PatchTodoInput{
+		TodoID:  todoID,
+		UserID:  userID,
+		Patch:   patch,
+		IfMatch: parseTodoIfMatch(params.IfMatch),
+	})
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.todoPresenter.UpdateTodo(ctx, out)
+}
+
+func (c *TodoController) DeleteTodo(ctx echo.Context, todoID string, params api.DeleteTodoParams) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	in := &input.DeleteTodoInput{
+		TodoID:  todoID,
+		UserID:  userID,
+		IfMatch: parseTodoIfMatch(params.IfMatch),
+	}
+	if params.Children != nil {
+		in.Children = string(*params.Children)
+	}
+
+	if err := c.todoUsecase.DeleteTodo(ctx.Request().Context(), in); err != nil {
 		return handleError(err)
 	}
 
 	return c.todoPresenter.DeleteTodo(ctx)
 }
+
+func (c *TodoController) MoveTodo(ctx echo.Context, todoID string) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	var req api.MoveTodoRequest
+	if err := ctx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	in := &input.MoveTodoInput{
+		TodoID:    todoID,
+		UserID:    userID,
+		ProjectID: req.ProjectId,
+	}
+
+	out, err := c.todoUsecase.MoveTodo(ctx.Request().Context(), in)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.todoPresenter.UpdateTodo(ctx, out)
+}
+
+func (c *TodoController) ReorderTodo(ctx echo.Context, todoID string) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	var req api.ReorderTodoRequest
+	if err := ctx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	in := &input.ReorderTodoInput{
+		TodoID: todoID,
+		UserID: userID,
+		Before: req.Before,
+		After:  req.After,
+	}
+
+	out, err := c.todoUsecase.ReorderTodo(ctx.Request().Context(), in)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.todoPresenter.UpdateTodo(ctx, out)
+}
+
+func (c *TodoController) BulkTodos(ctx echo.Context) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	var req api.BulkTodoRequest
+	if err := ctx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	in := &input.BulkTodosInput{
+		UserID:    userID,
+		Operation: string(req.Operation),
+		IsPublic:  req.IsPublic,
+		ProjectID: req.ProjectId,
+	}
+	if req.TodoIds != nil {
+		in.TodoIDs = *req.TodoIds
+	}
+	if req.Filter != nil {
+		in.Filter = &input.TodoFilterInput{
+			Completed: req.Filter.Completed,
+			Overdue:   req.Filter.Overdue,
+			DueBefore: req.Filter.DueBefore,
+			DueAfter:  req.Filter.DueAfter,
+			IsPublic:  req.Filter.IsPublic,
+			Search:    req.Filter.Q,
+			ProjectID: req.Filter.ProjectId,
+		}
+		if req.Filter.Tags != nil {
+			in.Filter.TagIDs = *req.Filter.Tags
+		}
+	}
+	if req.TagId != nil {
+		in.TagID = *req.TagId
+	}
+	if req.Children != nil {
+		in.Children = string(*req.Children)
+	}
+	if req.CascadeCompletion != nil {
+		in.CascadeCompletion = *req.CascadeCompletion
+	}
+
+	out, err := c.todoUsecase.BulkUpdateTodos(ctx.Request().Context(), in)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.todoPresenter.BulkTodos(ctx, out)
+}
+
+func (c *TodoController) SetTodoParent(ctx echo.Context, todoID string) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	var req api.SetTodoParentRequest
+	if err := ctx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	in := &input.SetTodoParentInput{
+		TodoID:   todoID,
+		UserID:   userID,
+		ParentID: req.ParentId,
+	}
+
+	out, err := c.todoUsecase.SetTodoParent(ctx.Request().Context(), in)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.todoPresenter.UpdateTodo(ctx, out)
+}
+
+func (c *TodoController) SetTodoRecurrence(ctx echo.Context, todoID string) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	var req api.TodoRecurrenceRequest
+	if err := ctx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	in := &input.SetTodoRecurrenceInput{
+		TodoID:     todoID,
+		UserID:     userID,
+		Recurrence: *toTodoRecurrenceInput(&req),
+	}
+
+	out, err := c.todoUsecase.SetTodoRecurrence(ctx.Request().Context(), in)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.todoPresenter.UpdateTodo(ctx, out)
+}
+
+func (c *TodoController) SkipTodoOccurrence(ctx echo.Context, todoID string) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	out, err := c.todoUsecase.SkipTodoOccurrence(ctx.Request().Context(), todoID, userID)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.todoPresenter.UpdateTodo(ctx, out)
+}
+
+func (c *TodoController) StopTodoRecurrence(ctx echo.Context, todoID string) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	out, err := c.todoUsecase.StopTodoRecurrence(ctx.Request().Context(), todoID, userID)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.todoPresenter.UpdateTodo(ctx, out)
+}
+
+func (c *TodoController) GetAssignedTodos(ctx echo.Context, params api.GetAssignedTodosParams) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	limit := 20
+	offset := 0
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	if params.Offset != nil {
+		offset = *params.Offset
+	}
+
+	in := &input.GetTodosInput{
+		UserID:       userID,
+		Completed:    params.Completed,
+		Overdue:      params.Overdue,
+		IncludeTotal: params.IncludeTotal,
+		Limit:        limit,
+		Offset:       offset,
+	}
+	if params.Cursor != nil {
+		in.Cursor = *params.Cursor
+	}
+	if params.Sort != nil {
+		in.Sort = string(*params.Sort)
+	}
+	if params.Order != nil {
+		in.Order = string(*params.Order)
+	}
+
+	out, err := c.todoUsecase.GetAssignedTodos(ctx.Request().Context(), in)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.todoPresenter.GetTodos(ctx, out)
+}
+
+func (c *TodoController) AssignTodo(ctx echo.Context, todoID string) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	tenantID, ok := ctx.Get(context_keys.TenantIDContextKey).(string)
+	if !ok || tenantID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	var req api.AssignTodoRequest
+	if err := ctx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	in := &input.AssignTodoInput{
+		TodoID:     todoID,
+		UserID:     userID,
+		TenantID:   tenantID,
+		AssigneeID: req.AssigneeId,
+	}
+
+	out, err := c.todoUsecase.AssignTodo(ctx.Request().Context(), in)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.todoPresenter.UpdateTodo(ctx, out)
+}
+
+func (c *TodoController) GetTodoAssignments(ctx echo.Context, todoID string) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	out, err := c.todoUsecase.GetTodoAssignments(ctx.Request().Context(), todoID, userID)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.todoPresenter.GetTodoAssignments(ctx, out)
+}
+
+func (c *TodoController) GetSharedTodos(ctx echo.Context, params api.GetSharedTodosParams) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	limit := 20
+	offset := 0
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	if params.Offset != nil {
+		offset = *params.Offset
+	}
+
+	in := &input.GetTodosInput{
+		UserID:       userID,
+		Completed:    params.Completed,
+		Overdue:      params.Overdue,
+		IncludeTotal: params.IncludeTotal,
+		Limit:        limit,
+		Offset:       offset,
+	}
+	if params.Cursor != nil {
+		in.Cursor = *params.Cursor
+	}
+	if params.Sort != nil {
+		in.Sort = string(*params.Sort)
+	}
+	if params.Order != nil {
+		in.Order = string(*params.Order)
+	}
+
+	out, err := c.todoUsecase.GetSharedTodos(ctx.Request().Context(), in)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.todoPresenter.GetTodos(ctx, out)
+}
+
+func (c *TodoController) GetTodoShares(ctx echo.Context, todoID string) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	out, err := c.todoUsecase.GetTodoShares(ctx.Request().Context(), todoID, userID)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.todoPresenter.GetTodoShares(ctx, out)
+}
+
+func (c *TodoController) ShareTodo(ctx echo.Context, todoID, memberID string) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	var req api.ShareTodoRequest
+	if err := ctx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	in := &input.ShareTodoInput{
+		TodoID:     todoID,
+		UserID:     userID,
+		MemberID:   memberID,
+		Permission: string(req.Permission),
+	}
+
+	out, err := c.todoUsecase.ShareTodo(ctx.Request().Context(), in)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.todoPresenter.ShareTodo(ctx, out)
+}
+
+func (c *TodoController) UnshareTodo(ctx echo.Context, todoID, memberID string) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	if err := c.todoUsecase.UnshareTodo(ctx.Request().Context(), todoID, userID, memberID); err != nil {
+		return handleError(err)
+	}
+
+	return c.todoPresenter.UnshareTodo(ctx)
+}
+
+func (c *TodoController) GetTodoHistory(ctx echo.Context, todoID string, params api.GetTodoHistoryParams) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	in := &input.GetTodoHistoryInput{TodoID: todoID, UserID: userID}
+	if params.Limit != nil {
+		in.Limit = *params.Limit
+	}
+	if params.Offset != nil {
+		in.Offset = *params.Offset
+	}
+
+	out, err := c.todoUsecase.GetTodoHistory(ctx.Request().Context(), in)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.todoPresenter.GetTodoHistory(ctx, out)
+}
+
+func (c *TodoController) RevertTodo(ctx echo.Context, todoID, revisionID string) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	out, err := c.todoUsecase.RevertTodo(ctx.Request().Context(), &input.RevertTodoInput{
+		TodoID:     todoID,
+		UserID:     userID,
+		RevisionID: revisionID,
+	})
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.todoPresenter.UpdateTodo(ctx, out)
+}
+
+// parseTodoIfMatch reads the todo versions listed in an If-Match header. A
+// missing header or * sets no condition. ETags are compared strongly, so weak
+// and malformed tags match no version.
+func parseTodoIfMatch(header *string) []int {
+	if header == nil || strings.TrimSpace(*header) == "" || strings.TrimSpace(*header) == "*" {
+		return nil
+	}
+	versions := []int{}
+	for _, tag := range strings.Split(*header, ",") {
+		tag = strings.TrimSpace(tag)
+		if len(tag) < 2 || tag[0] != '"' || tag[len(tag)-1] != '"' {
+			continue
+		}
+		if version, err := strconv.Atoi(tag[1 : len(tag)-1]); err == nil {
+			versions = append(versions, version)
+		}
+	}
+	return versions
+}
+
+func toTodoRecurrenceInput(req *api.TodoRecurrenceRequest) *input.TodoRecurrenceInput {
+	in := &input.TodoRecurrenceInput{Rule: req.Rule}
+	if req.Timezone != nil {
+		in.Timezone = *req.Timezone
+	}
+	return in
+}
@@ -64,3 +64,40 @@ func (c *UserController) UpdateMe(ctx echo.Context) error {
 
 	return c.userPresenter.UpdateMe(ctx, out)
 }
+
+func (c *UserController) ExportMe(ctx echo.Context) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	out, err := c.userUsecase.ExportMe(ctx.Request().Context(), userID)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.userPresenter.ExportMe(ctx, out)
+}
+
+func (c *UserController) DeleteMe(ctx echo.Context) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	var req api.DeleteAccountRequest
+	if err := ctx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	in := &input.DeleteMeInput{
+		UserID:   userID,
+		Password: req.Password,
+	}
+
+	if err := c.userUsecase.DeleteMe(ctx.Request().Context(), in); err != nil {
+		return handleError(err)
+	}
+
+	return c.userPresenter.DeleteMe(ctx)
+}
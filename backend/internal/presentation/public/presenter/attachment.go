@@ -0,0 +1,78 @@
+package presenter
+
+import (
+	"mime"
+	"net/http"
+	"strconv"
+	"time"
+
+	"good-todo-go/internal/presentation/public/api"
+	"good-todo-go/internal/usecase/output"
+
+	"github.com/labstack/echo/v4"
+)
+
+type IAttachmentPresenter interface {
+	GetTodoAttachments(ctx echo.Context, out *output.AttachmentListOutput) error
+	UploadTodoAttachment(ctx echo.Context, out *output.AttachmentOutput) error
+	DeleteTodoAttachment(ctx echo.Context) error
+	GetTodoAttachmentContent(ctx echo.Context, out *output.AttachmentContentOutput) error
+}
+
+type AttachmentPresenter struct{}
+
+func NewAttachmentPresenter() IAttachmentPresenter {
+	return &AttachmentPresenter{}
+}
+
+func (p *AttachmentPresenter) GetTodoAttachments(ctx echo.Context, out *output.AttachmentListOutput) error {
+	attachments := make([]api.AttachmentResponse, len(out.Attachments))
+	for i, a := range out.Attachments {
+		attachments[i] = toAttachmentResponse(a)
+	}
+	return ctx.JSON(http.StatusOK, api.AttachmentListResponse{
+		Attachments: attachments,
+	})
+}
+
+func (p *AttachmentPresenter) UploadTodoAttachment(ctx echo.Context, out *output.AttachmentOutput) error {
+	return ctx.JSON(http.StatusCreated, toAttachmentResponse(out))
+}
+
+func (p *AttachmentPresenter) DeleteTodoAttachment(ctx echo.Context) error {
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// GetTodoAttachmentContent streams the file as a download. Uploaded files
+// must never run as a page of this origin, so browsers are told not to
+// sniff or render them.
+func (p *AttachmentPresenter) GetTodoAttachmentContent(ctx echo.Context, out *output.AttachmentContentOutput) error {
+	defer out.Content.Close()
+
+	disposition := mime.FormatMediaType("attachment", map[string]string{"filename": out.Attachment.FileName})
+	if disposition == "" {
+		disposition = "attachment"
+	}
+
+	header := ctx.Response().Header()
+	header.Set(echo.HeaderContentDisposition, disposition)
+	header.Set(echo.HeaderContentLength, strconv.FormatInt(out.Attachment.Size, 10))
+	header.Set(echo.HeaderXContentTypeOptions, "nosniff")
+	header.Set(echo.HeaderContentSecurityPolicy, "default-src 'none'; sandbox")
+
+	return ctx.Stream(http.StatusOK, out.Attachment.ContentType, out.Content)
+}
+
+func toAttachmentResponse(out *output.AttachmentOutput) api.AttachmentResponse {
+	createdAt, _ := time.Parse(time.RFC3339, out.CreatedAt)
+
+	return api.AttachmentResponse{
+		Id:          out.ID,
+		TodoId:      out.TodoID,
+		UploadedBy:  out.UploadedBy,
+		FileName:    out.FileName,
+		ContentType: out.ContentType,
+		Size:        out.Size,
+		CreatedAt:   createdAt,
+	}
+}
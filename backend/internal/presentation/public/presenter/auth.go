@@ -15,6 +15,11 @@ type IAuthPresenter interface {
 	Login(ctx echo.Context, out *output.AuthOutput) error
 	VerifyEmail(ctx echo.Context, out *output.VerifyEmailOutput) error
 	RefreshToken(ctx echo.Context, out *output.AuthOutput) error
+	ChangePassword(ctx echo.Context) error
+	RequestMagicLink(ctx echo.Context) error
+	ConsumeMagicLink(ctx echo.Context, out *output.AuthOutput) error
+	RequestPasswordReset(ctx echo.Context) error
+	ResetPassword(ctx echo.Context) error
 }
 
 type AuthPresenter struct{}
@@ -41,6 +46,26 @@ func (p *AuthPresenter) RefreshToken(ctx echo.Context, out *output.AuthOutput) e
 	return ctx.JSON(http.StatusOK, toAuthResponse(out))
 }
 
+func (p *AuthPresenter) ChangePassword(ctx echo.Context) error {
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (p *AuthPresenter) RequestMagicLink(ctx echo.Context) error {
+	return ctx.NoContent(http.StatusAccepted)
+}
+
+func (p *AuthPresenter) ConsumeMagicLink(ctx echo.Context, out *output.AuthOutput) error {
+	return ctx.JSON(http.StatusOK, toAuthResponse(out))
+}
+
+func (p *AuthPresenter) RequestPasswordReset(ctx echo.Context) error {
+	return ctx.NoContent(http.StatusAccepted)
+}
+
+func (p *AuthPresenter) ResetPassword(ctx echo.Context) error {
+	return ctx.NoContent(http.StatusNoContent)
+}
+
 func toAuthResponse(out *output.AuthOutput) *api.AuthResponse {
 	role := api.UserResponseRole(out.User.Role)
 	createdAt, _ := time.Parse(time.RFC3339, out.User.CreatedAt)
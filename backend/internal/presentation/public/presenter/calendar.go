@@ -0,0 +1,210 @@
+package presenter
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"good-todo-go/internal/pkg/ical"
+	"good-todo-go/internal/presentation/public/api"
+	"good-todo-go/internal/usecase/output"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ICalendarPresenter interface {
+	RotateToken(ctx echo.Context, out *output.CalendarTokenOutput) error
+	RevokeToken(ctx echo.Context) error
+	// Feed serves the calendar to an app subscribed to it
+	Feed(ctx echo.Context, out *output.CalendarOutput) error
+	// Export serves the calendar as a file download
+	Export(ctx echo.Context, out *output.CalendarOutput) error
+}
+
+const (
+	calendarProdID = "-//good-todo-go//Todos//EN"
+	calendarName   = "Todos"
+	// calendarUIDSuffix makes todo IDs globally unique UIDs. A todo keeps its
+	// UID across feeds and exports, so calendars update it instead of adding it again.
+	calendarUIDSuffix = "@good-todo-go"
+)
+
+// calendarPriorities maps todo priorities onto PRIORITY, where 1 is the
+// highest; todos without a priority leave it out
+var calendarPriorities = map[string]string{
+	"urgent": "1",
+	"high":   "3",
+	"medium": "5",
+	"low":    "7",
+}
+
+type CalendarPresenter struct{}
+
+func NewCalendarPresenter() ICalendarPresenter {
+	return &CalendarPresenter{}
+}
+
+func (p *CalendarPresenter) RotateToken(ctx echo.Context, out *output.CalendarTokenOutput) error {
+	return ctx.JSON(http.StatusCreated, &api.CalendarTokenResponse{
+		Token:    out.Token,
+		FeedPath: out.FeedPath,
+	})
+}
+
+func (p *CalendarPresenter) RevokeToken(ctx echo.Context) error {
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (p *CalendarPresenter) Feed(ctx echo.Context, out *output.CalendarOutput) error {
+	return ctx.Blob(http.StatusOK, ical.ContentType, []byte(renderCalendar(out)))
+}
+
+func (p *CalendarPresenter) Export(ctx echo.Context, out *output.CalendarOutput) error {
+	generatedAt, _ := time.Parse(time.RFC3339, out.GeneratedAt)
+
+	filename := fmt.Sprintf("good-todo-%s.ics", generatedAt.Format("20060102"))
+	ctx.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", filename))
+
+	return ctx.Blob(http.StatusOK, ical.ContentType, []byte(renderCalendar(out)))
+}
+
+func renderCalendar(out *output.CalendarOutput) string {
+	generatedAt, _ := time.Parse(time.RFC3339, out.GeneratedAt)
+	dueDates := calendarDueDates(out.Todos)
+
+	w := ical.NewWriter()
+	w.Begin(ical.Calendar)
+	w.Property("VERSION", "2.0")
+	w.Property("PRODID", calendarProdID)
+	w.Property("CALSCALE", "GREGORIAN")
+	w.Property("METHOD", "PUBLISH")
+	w.Text("X-WR-CALNAME", calendarName)
+	writeCalendarTimeZones(w, dueDates)
+	for _, t := range out.Todos {
+		dueDate, dated := dueDates[t.ID]
+		if out.Component == "vtodo" {
+			writeCalendarTodo(w, t, dueDate, dated, generatedAt)
+		} else if dated {
+			writeCalendarEvent(w, t, dueDate, generatedAt)
+		}
+	}
+	w.End(ical.Calendar)
+	return w.String()
+}
+
+// calendarDueDates returns the due dates of the dated todos by todo ID, on
+// the wall clock of the time zone their recurrence is evaluated in. Todos
+// without a recurrence, or with a time zone this system does not know, are
+// due in UTC.
+func calendarDueDates(todos []*output.TodoOutput) map[string]time.Time {
+	locations := map[string]*time.Location{}
+	dueDates := make(map[string]time.Time, len(todos))
+	for _, t := range todos {
+		if t.DueDate == nil {
+			continue
+		}
+		dueDate, _ := time.Parse(time.RFC3339, *t.DueDate)
+
+		loc := time.UTC
+		if t.Recurrence != nil && t.Recurrence.Timezone != "" {
+			zone, ok := locations[t.Recurrence.Timezone]
+			if !ok {
+				zone, _ = time.LoadLocation(t.Recurrence.Timezone)
+				locations[t.Recurrence.Timezone] = zone
+			}
+			if zone != nil {
+				loc = zone
+			}
+		}
+		dueDates[t.ID] = dueDate.In(loc)
+	}
+	return dueDates
+}
+
+// writeCalendarTimeZones defines every time zone other than UTC that a due
+// date is written in, covering the due dates in that zone
+func writeCalendarTimeZones(w *ical.Writer, dueDates map[string]time.Time) {
+	type span struct {
+		loc      *time.Location
+		from, to time.Time
+	}
+	spans := map[string]*span{}
+	for _, dueDate := range dueDates {
+		name := dueDate.Location().String()
+		if name == "UTC" {
+			continue
+		}
+		s, ok := spans[name]
+		if !ok {
+			spans[name] = &span{loc: dueDate.Location(), from: dueDate, to: dueDate}
+			continue
+		}
+		if dueDate.Before(s.from) {
+			s.from = dueDate
+		}
+		if dueDate.After(s.to) {
+			s.to = dueDate
+		}
+	}
+
+	// Sorted so that the stream does not change between requests
+	names := make([]string, 0, len(spans))
+	for name := range spans {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		s := spans[name]
+		w.DefineTimeZone(s.loc, s.from, s.to)
+	}
+}
+
+// writeCalendarEvent places the todo on the calendar at its due date, as a
+// moment that does not block time
+func writeCalendarEvent(w *ical.Writer, t *output.TodoOutput, dueDate, stamp time.Time) {
+	w.Begin(ical.Event)
+	writeCalendarCommon(w, t, stamp)
+	w.ZonedTime("DTSTART", dueDate)
+	w.Property("TRANSP", "TRANSPARENT")
+	w.End(ical.Event)
+}
+
+func writeCalendarTodo(w *ical.Writer, t *output.TodoOutput, dueDate time.Time, dated bool, stamp time.Time) {
+	w.Begin(ical.Todo)
+	writeCalendarCommon(w, t, stamp)
+	if dated {
+		w.ZonedTime("DUE", dueDate)
+	}
+	if t.Completed {
+		w.Property("STATUS", "COMPLETED")
+		if t.CompletedAt != nil {
+			completedAt, _ := time.Parse(time.RFC3339, *t.CompletedAt)
+			w.Time("COMPLETED", completedAt)
+		}
+	} else {
+		w.Property("STATUS", "NEEDS-ACTION")
+	}
+	w.End(ical.Todo)
+}
+
+// writeCalendarCommon writes the properties events and tasks share
+func writeCalendarCommon(w *ical.Writer, t *output.TodoOutput, stamp time.Time) {
+	createdAt, _ := time.Parse(time.RFC3339, t.CreatedAt)
+	updatedAt, _ := time.Parse(time.RFC3339, t.UpdatedAt)
+
+	w.Property("UID", t.ID+calendarUIDSuffix)
+	w.Time("DTSTAMP", stamp)
+	w.Time("CREATED", createdAt)
+	w.Time("LAST-MODIFIED", updatedAt)
+	// The version goes up with every change, as SEQUENCE has to
+	w.Property("SEQUENCE", strconv.Itoa(t.Version))
+	w.Text("SUMMARY", t.Title)
+	if t.Description != "" {
+		w.Text("DESCRIPTION", t.Description)
+	}
+	if priority, ok := calendarPriorities[t.Priority]; ok {
+		w.Property("PRIORITY", priority)
+	}
+}
@@ -0,0 +1,73 @@
+package presenter
+
+import (
+	"net/http"
+	"time"
+
+	"good-todo-go/internal/presentation/public/api"
+	"good-todo-go/internal/usecase/output"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ICommentPresenter interface {
+	GetTodoComments(ctx echo.Context, out *output.CommentListOutput) error
+	CreateTodoComment(ctx echo.Context, out *output.CommentOutput) error
+	UpdateTodoComment(ctx echo.Context, out *output.CommentOutput) error
+	DeleteTodoComment(ctx echo.Context) error
+}
+
+type CommentPresenter struct{}
+
+func NewCommentPresenter() ICommentPresenter {
+	return &CommentPresenter{}
+}
+
+func (p *CommentPresenter) GetTodoComments(ctx echo.Context, out *output.CommentListOutput) error {
+	comments := make([]api.CommentResponse, len(out.Comments))
+	for i, c := range out.Comments {
+		comments[i] = toCommentResponse(c)
+	}
+	return ctx.JSON(http.StatusOK, api.CommentListResponse{
+		Comments: comments,
+	})
+}
+
+func (p *CommentPresenter) CreateTodoComment(ctx echo.Context, out *output.CommentOutput) error {
+	return ctx.JSON(http.StatusCreated, toCommentResponse(out))
+}
+
+func (p *CommentPresenter) UpdateTodoComment(ctx echo.Context, out *output.CommentOutput) error {
+	return ctx.JSON(http.StatusOK, toCommentResponse(out))
+}
+
+func (p *CommentPresenter) DeleteTodoComment(ctx echo.Context) error {
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func toCommentResponse(out *output.CommentOutput) api.CommentResponse {
+	createdAt, _ := time.Parse(time.RFC3339, out.CreatedAt)
+
+	resp := api.CommentResponse{
+		Id:        out.ID,
+		TodoId:    out.TodoID,
+		Body:      out.Body,
+		Edited:    out.Edited,
+		Deleted:   out.Deleted,
+		CreatedAt: createdAt,
+	}
+
+	if out.Author != nil {
+		resp.Author = &api.CommentAuthor{
+			Id:   out.Author.ID,
+			Name: out.Author.Name,
+		}
+	}
+
+	if out.EditedAt != nil {
+		editedAt, _ := time.Parse(time.RFC3339, *out.EditedAt)
+		resp.EditedAt = &editedAt
+	}
+
+	return resp
+}
@@ -0,0 +1,55 @@
+package presenter
+
+import (
+	"net/http"
+	"time"
+
+	"good-todo-go/internal/presentation/public/api"
+	"good-todo-go/internal/usecase/output"
+
+	"github.com/labstack/echo/v4"
+)
+
+type INotificationPresenter interface {
+	GetNotifications(ctx echo.Context, out *output.NotificationListOutput) error
+	MarkNotificationRead(ctx echo.Context) error
+}
+
+type NotificationPresenter struct{}
+
+func NewNotificationPresenter() INotificationPresenter {
+	return &NotificationPresenter{}
+}
+
+func (p *NotificationPresenter) GetNotifications(ctx echo.Context, out *output.NotificationListOutput) error {
+	notifications := make([]api.NotificationResponse, len(out.Notifications))
+	for i, n := range out.Notifications {
+		notifications[i] = toNotificationResponse(n)
+	}
+	return ctx.JSON(http.StatusOK, api.NotificationListResponse{
+		Notifications: notifications,
+	})
+}
+
+func (p *NotificationPresenter) MarkNotificationRead(ctx echo.Context) error {
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func toNotificationResponse(out *output.NotificationOutput) api.NotificationResponse {
+	createdAt, _ := time.Parse(time.RFC3339, out.CreatedAt)
+
+	var readAt *time.Time
+	if out.ReadAt != nil {
+		t, _ := time.Parse(time.RFC3339, *out.ReadAt)
+		readAt = &t
+	}
+
+	return api.NotificationResponse{
+		Id:        out.ID,
+		TodoId:    out.TodoID,
+		Title:     out.Title,
+		Body:      out.Body,
+		ReadAt:    readAt,
+		CreatedAt: &createdAt,
+	}
+}
@@ -0,0 +1,77 @@
+package presenter
+
+import (
+	"net/http"
+	"time"
+
+	"good-todo-go/internal/presentation/public/api"
+	"good-todo-go/internal/usecase/output"
+
+	"github.com/labstack/echo/v4"
+)
+
+type IProjectPresenter interface {
+	GetProjects(ctx echo.Context, out *output.ProjectListOutput) error
+	GetProject(ctx echo.Context, out *output.ProjectOutput) error
+	CreateProject(ctx echo.Context, out *output.ProjectOutput) error
+	UpdateProject(ctx echo.Context, out *output.ProjectOutput) error
+	DeleteProject(ctx echo.Context) error
+}
+
+type ProjectPresenter struct{}
+
+func NewProjectPresenter() IProjectPresenter {
+	return &ProjectPresenter{}
+}
+
+func (p *ProjectPresenter) GetProjects(ctx echo.Context, out *output.ProjectListOutput) error {
+	projects := make([]api.ProjectResponse, len(out.Projects))
+	for i, project := range out.Projects {
+		projects[i] = *toProjectResponse(project)
+	}
+
+	return ctx.JSON(http.StatusOK, api.ProjectListResponse{
+		Projects: projects,
+	})
+}
+
+func (p *ProjectPresenter) GetProject(ctx echo.Context, out *output.ProjectOutput) error {
+	return ctx.JSON(http.StatusOK, toProjectResponse(out))
+}
+
+func (p *ProjectPresenter) CreateProject(ctx echo.Context, out *output.ProjectOutput) error {
+	return ctx.JSON(http.StatusCreated, toProjectResponse(out))
+}
+
+func (p *ProjectPresenter) UpdateProject(ctx echo.Context, out *output.ProjectOutput) error {
+	return ctx.JSON(http.StatusOK, toProjectResponse(out))
+}
+
+func (p *ProjectPresenter) DeleteProject(ctx echo.Context) error {
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func toProjectResponse(out *output.ProjectOutput) *api.ProjectResponse {
+	createdAt, _ := time.Parse(time.RFC3339, out.CreatedAt)
+	updatedAt, _ := time.Parse(time.RFC3339, out.UpdatedAt)
+
+	resp := &api.ProjectResponse{
+		Id:             out.ID,
+		UserId:         out.UserID,
+		Name:           out.Name,
+		Description:    out.Description,
+		IsPublic:       out.IsPublic,
+		Archived:       out.ArchivedAt != nil,
+		OpenCount:      out.OpenCount,
+		CompletedCount: out.CompletedCount,
+		CreatedAt:      &createdAt,
+		UpdatedAt:      &updatedAt,
+	}
+
+	if out.ArchivedAt != nil {
+		archivedAt, _ := time.Parse(time.RFC3339, *out.ArchivedAt)
+		resp.ArchivedAt = &archivedAt
+	}
+
+	return resp
+}
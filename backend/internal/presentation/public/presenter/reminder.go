@@ -0,0 +1,63 @@
+package presenter
+
+import (
+	"net/http"
+	"time"
+
+	"good-todo-go/internal/presentation/public/api"
+	"good-todo-go/internal/usecase/output"
+
+	"github.com/labstack/echo/v4"
+)
+
+type IReminderPresenter interface {
+	GetTodoReminders(ctx echo.Context, out *output.ReminderListOutput) error
+	CreateTodoReminder(ctx echo.Context, out *output.ReminderOutput) error
+	DeleteTodoReminder(ctx echo.Context) error
+}
+
+type ReminderPresenter struct{}
+
+func NewReminderPresenter() IReminderPresenter {
+	return &ReminderPresenter{}
+}
+
+func (p *ReminderPresenter) GetTodoReminders(ctx echo.Context, out *output.ReminderListOutput) error {
+	reminders := make([]api.ReminderResponse, len(out.Reminders))
+	for i, r := range out.Reminders {
+		reminders[i] = toReminderResponse(r)
+	}
+	return ctx.JSON(http.StatusOK, api.ReminderListResponse{
+		Reminders: reminders,
+	})
+}
+
+func (p *ReminderPresenter) CreateTodoReminder(ctx echo.Context, out *output.ReminderOutput) error {
+	return ctx.JSON(http.StatusCreated, toReminderResponse(out))
+}
+
+func (p *ReminderPresenter) DeleteTodoReminder(ctx echo.Context) error {
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func toReminderResponse(out *output.ReminderOutput) api.ReminderResponse {
+	remindAt, _ := time.Parse(time.RFC3339, out.RemindAt)
+	createdAt, _ := time.Parse(time.RFC3339, out.CreatedAt)
+
+	var sentAt *time.Time
+	if out.SentAt != nil {
+		t, _ := time.Parse(time.RFC3339, *out.SentAt)
+		sentAt = &t
+	}
+
+	return api.ReminderResponse{
+		Id:            out.ID,
+		TodoId:        out.TodoID,
+		RemindAt:      remindAt,
+		OffsetMinutes: out.OffsetMinutes,
+		Channel:       api.ReminderResponseChannel(out.Channel),
+		Status:        api.ReminderResponseStatus(out.Status),
+		SentAt:        sentAt,
+		CreatedAt:     &createdAt,
+	}
+}
@@ -0,0 +1,108 @@
+package presenter
+
+import (
+	"errors"
+	"net/http"
+
+	"good-todo-go/internal/pkg/cerror"
+	"good-todo-go/internal/presentation/public/scim"
+	"good-todo-go/internal/usecase"
+	"good-todo-go/internal/usecase/output"
+
+	"github.com/labstack/echo/v4"
+)
+
+type IScimPresenter interface {
+	GetServiceProviderConfig(ctx echo.Context) error
+	ListUsers(ctx echo.Context, out *output.ScimUserListOutput) error
+	GetUser(ctx echo.Context, out *output.UserOutput) error
+	CreateUser(ctx echo.Context, out *output.UserOutput) error
+	UpdateUser(ctx echo.Context, out *output.UserOutput) error
+	DeleteUser(ctx echo.Context) error
+	Error(ctx echo.Context, err error) error
+}
+
+type ScimPresenter struct{}
+
+func NewScimPresenter() IScimPresenter {
+	return &ScimPresenter{}
+}
+
+func (p *ScimPresenter) GetServiceProviderConfig(ctx echo.Context) error {
+	return scim.JSON(ctx, http.StatusOK, &scim.ServiceProviderConfig{
+		Schemas: []string{scim.SchemaServiceProviderConfig},
+		Patch:   scim.Supported{Supported: true},
+		Bulk:    scim.BulkSupported{Supported: false},
+		Filter:  scim.FilterSupported{Supported: true, MaxResults: 200},
+		AuthenticationSchemes: []scim.AuthenticationScheme{
+			{
+				Type:        "oauthbearertoken",
+				Name:        "Bearer Token",
+				Description: "Tenant SCIM token issued from the tenant settings",
+			},
+		},
+	})
+}
+
+func (p *ScimPresenter) ListUsers(ctx echo.Context, out *output.ScimUserListOutput) error {
+	resources := make([]*scim.User, len(out.Users))
+	for i, u := range out.Users {
+		resources[i] = toScimUser(ctx, u)
+	}
+	return scim.JSON(ctx, http.StatusOK, &scim.ListResponse{
+		Schemas:      []string{scim.SchemaListResponse},
+		TotalResults: out.TotalResults,
+		StartIndex:   out.StartIndex,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	})
+}
+
+func (p *ScimPresenter) GetUser(ctx echo.Context, out *output.UserOutput) error {
+	return scim.JSON(ctx, http.StatusOK, toScimUser(ctx, out))
+}
+
+func (p *ScimPresenter) CreateUser(ctx echo.Context, out *output.UserOutput) error {
+	user := toScimUser(ctx, out)
+	ctx.Response().Header().Set(echo.HeaderLocation, user.Meta.Location)
+	return scim.JSON(ctx, http.StatusCreated, user)
+}
+
+func (p *ScimPresenter) UpdateUser(ctx echo.Context, out *output.UserOutput) error {
+	return scim.JSON(ctx, http.StatusOK, toScimUser(ctx, out))
+}
+
+func (p *ScimPresenter) DeleteUser(ctx echo.Context) error {
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// Error renders err in the SCIM error format
+func (p *ScimPresenter) Error(ctx echo.Context, err error) error {
+	var appErr *cerror.AppError
+	if !errors.As(err, &appErr) {
+		return scim.JSON(ctx, http.StatusInternalServerError, scim.NewError(http.StatusInternalServerError, "", "internal server error"))
+	}
+
+	scimType, _ := appErr.Details[usecase.ScimTypeDetailKey].(string)
+	return scim.JSON(ctx, appErr.HTTPStatus, scim.NewError(appErr.HTTPStatus, scimType, appErr.Message))
+}
+
+func toScimUser(ctx echo.Context, out *output.UserOutput) *scim.User {
+	return &scim.User{
+		Schemas:     []string{scim.SchemaUser},
+		ID:          out.ID,
+		ExternalID:  out.ExternalID,
+		UserName:    out.Email,
+		Name:        &scim.Name{Formatted: out.Name},
+		DisplayName: out.Name,
+		Emails:      []scim.Email{{Value: out.Email, Type: "work", Primary: true}},
+		Active:      out.IsActive,
+		Roles:       []scim.Role{{Value: out.Role, Primary: true}},
+		Meta: &scim.Meta{
+			ResourceType: "User",
+			Created:      out.CreatedAt,
+			LastModified: out.UpdatedAt,
+			Location:     ctx.Scheme() + "://" + ctx.Request().Host + "/scim/v2/Users/" + out.ID,
+		},
+	}
+}
@@ -0,0 +1,73 @@
+package presenter
+
+import (
+	"net/http"
+	"time"
+
+	"good-todo-go/internal/presentation/public/api"
+	"good-todo-go/internal/usecase/output"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ITagPresenter interface {
+	GetTags(ctx echo.Context, out *output.TagListOutput) error
+	CreateTag(ctx echo.Context, out *output.TagOutput) error
+	UpdateTag(ctx echo.Context, out *output.TagOutput) error
+	DeleteTag(ctx echo.Context) error
+	AttachTodoTag(ctx echo.Context, out *output.TodoOutput) error
+	DetachTodoTag(ctx echo.Context) error
+}
+
+type TagPresenter struct{}
+
+func NewTagPresenter() ITagPresenter {
+	return &TagPresenter{}
+}
+
+func (p *TagPresenter) GetTags(ctx echo.Context, out *output.TagListOutput) error {
+	return ctx.JSON(http.StatusOK, api.TagListResponse{
+		Tags: toTagResponses(out.Tags),
+	})
+}
+
+func (p *TagPresenter) CreateTag(ctx echo.Context, out *output.TagOutput) error {
+	return ctx.JSON(http.StatusCreated, toTagResponse(out))
+}
+
+func (p *TagPresenter) UpdateTag(ctx echo.Context, out *output.TagOutput) error {
+	return ctx.JSON(http.StatusOK, toTagResponse(out))
+}
+
+func (p *TagPresenter) DeleteTag(ctx echo.Context) error {
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (p *TagPresenter) AttachTodoTag(ctx echo.Context, out *output.TodoOutput) error {
+	return ctx.JSON(http.StatusOK, toTodoResponse(out))
+}
+
+func (p *TagPresenter) DetachTodoTag(ctx echo.Context) error {
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func toTagResponse(out *output.TagOutput) api.TagResponse {
+	createdAt, _ := time.Parse(time.RFC3339, out.CreatedAt)
+	updatedAt, _ := time.Parse(time.RFC3339, out.UpdatedAt)
+
+	return api.TagResponse{
+		Id:        out.ID,
+		Name:      out.Name,
+		Color:     out.Color,
+		CreatedAt: &createdAt,
+		UpdatedAt: &updatedAt,
+	}
+}
+
+func toTagResponses(outs []*output.TagOutput) []api.TagResponse {
+	tags := make([]api.TagResponse, len(outs))
+	for i, t := range outs {
+		tags[i] = toTagResponse(t)
+	}
+	return tags
+}
@@ -0,0 +1,50 @@
+package presenter
+
+import (
+	"net/http"
+
+	"good-todo-go/internal/presentation/public/api"
+	"good-todo-go/internal/usecase/output"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ITenantPresenter interface {
+	GetSettings(ctx echo.Context, out *output.TenantSettingsOutput) error
+	UpdateSettings(ctx echo.Context, out *output.TenantSettingsOutput) error
+	RotateScimToken(ctx echo.Context, out *output.ScimTokenOutput) error
+	RevokeScimToken(ctx echo.Context) error
+}
+
+type TenantPresenter struct{}
+
+func NewTenantPresenter() ITenantPresenter {
+	return &TenantPresenter{}
+}
+
+func (p *TenantPresenter) GetSettings(ctx echo.Context, out *output.TenantSettingsOutput) error {
+	return ctx.JSON(http.StatusOK, toTenantSettingsResponse(out))
+}
+
+func (p *TenantPresenter) UpdateSettings(ctx echo.Context, out *output.TenantSettingsOutput) error {
+	return ctx.JSON(http.StatusOK, toTenantSettingsResponse(out))
+}
+
+func (p *TenantPresenter) RotateScimToken(ctx echo.Context, out *output.ScimTokenOutput) error {
+	return ctx.JSON(http.StatusCreated, &api.ScimTokenResponse{
+		Token: out.Token,
+	})
+}
+
+func (p *TenantPresenter) RevokeScimToken(ctx echo.Context) error {
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func toTenantSettingsResponse(out *output.TenantSettingsOutput) *api.TenantSettingsResponse {
+	return &api.TenantSettingsResponse{
+		MagicLinkEnabled:      out.MagicLinkEnabled,
+		AccountDeletionPolicy: api.AccountDeletionPolicy(out.AccountDeletionPolicy),
+		SearchLanguage:        api.SearchLanguage(out.SearchLanguage),
+		TrashRetentionDays:    out.TrashRetentionDays,
+	}
+}
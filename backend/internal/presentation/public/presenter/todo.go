@@ -2,12 +2,14 @@ package presenter
 
 import (
 	"net/http"
+	"strconv"
 	"time"
 
 	"good-todo-go/internal/presentation/public/api"
 	"good-todo-go/internal/usecase/output"
 
 	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 type ITodoPresenter interface {
@@ -16,6 +18,14 @@ type ITodoPresenter interface {
 	CreateTodo(ctx echo.Context, out *output.TodoOutput) error
 	UpdateTodo(ctx echo.Context, out *output.TodoOutput) error
 	DeleteTodo(ctx echo.Context) error
+	BulkTodos(ctx echo.Context, out *output.TodoBulkOutput) error
+	GetCompletionStats(ctx echo.Context, out *output.CompletionStatsOutput) error
+	SearchTodos(ctx echo.Context, out *output.TodoSearchOutput) error
+	GetTodoAssignments(ctx echo.Context, out *output.TodoAssignmentListOutput) error
+	GetTodoShares(ctx echo.Context, out *output.TodoShareListOutput) error
+	ShareTodo(ctx echo.Context, out *output.TodoShareOutput) error
+	UnshareTodo(ctx echo.Context) error
+	GetTodoHistory(ctx echo.Context, out *output.TodoRevisionListOutput) error
 }
 
 type TodoPresenter struct{}
@@ -31,12 +41,14 @@ func (p *TodoPresenter) GetTodos(ctx echo.Context, out *output.TodoListOutput) e
 	}
 
 	return ctx.JSON(http.StatusOK, api.TodoListResponse{
-		Todos: &todos,
-		Total: &out.Total,
+		Todos:      &todos,
+		Total:      out.Total,
+		NextCursor: out.NextCursor,
 	})
 }
 
 func (p *TodoPresenter) GetTodo(ctx echo.Context, out *output.TodoOutput) error {
+	ctx.Response().Header().Set("ETag", todoETag(out))
 	return ctx.JSON(http.StatusOK, toTodoResponse(out))
 }
 
@@ -45,6 +57,7 @@ func (p *TodoPresenter) CreateTodo(ctx echo.Context, out *output.TodoOutput) err
 }
 
 func (p *TodoPresenter) UpdateTodo(ctx echo.Context, out *output.TodoOutput) error {
+	ctx.Response().Header().Set("ETag", todoETag(out))
 	return ctx.JSON(http.StatusOK, toTodoResponse(out))
 }
 
@@ -52,25 +65,177 @@ func (p *TodoPresenter) DeleteTodo(ctx echo.Context) error {
 	return ctx.NoContent(http.StatusNoContent)
 }
 
+func (p *TodoPresenter) BulkTodos(ctx echo.Context, out *output.TodoBulkOutput) error {
+	results := make([]api.BulkTodoResult, len(out.Results))
+	for i, r := range out.Results {
+		results[i] = api.BulkTodoResult{TodoId: r.TodoID}
+		if r.Error != nil {
+			results[i].Error = &api.ErrorResponse{
+				Code:    &r.Error.Code,
+				Message: &r.Error.Message,
+			}
+		}
+	}
+	return ctx.JSON(http.StatusOK, api.BulkTodoResponse{
+		Results:   results,
+		Succeeded: out.Succeeded,
+		Failed:    out.Failed,
+	})
+}
+
+func (p *TodoPresenter) GetCompletionStats(ctx echo.Context, out *output.CompletionStatsOutput) error {
+	from, _ := time.Parse(time.RFC3339, out.From)
+	to, _ := time.Parse(time.RFC3339, out.To)
+
+	days := make([]api.DailyCompletion, len(out.Days))
+	for i, d := range out.Days {
+		date, _ := time.Parse("2006-01-02", d.Date)
+		days[i] = api.DailyCompletion{
+			Date:  openapi_types.Date{Time: date},
+			Count: d.Count,
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, api.CompletionStatsResponse{
+		Period: api.CompletionStatsResponsePeriod(out.Period),
+		From:   from,
+		To:     to,
+		Total:  out.Total,
+		Days:   days,
+	})
+}
+
+func (p *TodoPresenter) SearchTodos(ctx echo.Context, out *output.TodoSearchOutput) error {
+	results := make([]api.TodoSearchResult, len(out.Results))
+	for i, r := range out.Results {
+		results[i] = api.TodoSearchResult{
+			Todo:           *toTodoResponse(r.Todo),
+			Rank:           r.Rank,
+			TitleHighlight: r.TitleHighlight,
+			Snippet:        r.Snippet,
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, api.TodoSearchResponse{
+		Results: results,
+		Total:   out.Total,
+	})
+}
+
+func (p *TodoPresenter) GetTodoAssignments(ctx echo.Context, out *output.TodoAssignmentListOutput) error {
+	assignments := make([]api.TodoAssignment, len(out.Assignments))
+	for i, a := range out.Assignments {
+		createdAt, _ := time.Parse(time.RFC3339, a.CreatedAt)
+		assignments[i] = api.TodoAssignment{
+			Id:                 a.ID,
+			PreviousAssigneeId: a.PreviousAssigneeID,
+			AssigneeId:         a.AssigneeID,
+			ChangedBy:          a.ChangedBy,
+			CreatedAt:          createdAt,
+		}
+	}
+	return ctx.JSON(http.StatusOK, api.TodoAssignmentListResponse{
+		Assignments: assignments,
+	})
+}
+
+func (p *TodoPresenter) GetTodoShares(ctx echo.Context, out *output.TodoShareListOutput) error {
+	shares := make([]api.TodoShare, len(out.Shares))
+	for i, s := range out.Shares {
+		shares[i] = toTodoShareResponse(s)
+	}
+	return ctx.JSON(http.StatusOK, api.TodoShareListResponse{
+		Shares: shares,
+	})
+}
+
+func (p *TodoPresenter) ShareTodo(ctx echo.Context, out *output.TodoShareOutput) error {
+	return ctx.JSON(http.StatusOK, toTodoShareResponse(out))
+}
+
+func (p *TodoPresenter) UnshareTodo(ctx echo.Context) error {
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func toTodoShareResponse(s *output.TodoShareOutput) api.TodoShare {
+	createdAt, _ := time.Parse(time.RFC3339, s.CreatedAt)
+	updatedAt, _ := time.Parse(time.RFC3339, s.UpdatedAt)
+	return api.TodoShare{
+		UserId:     s.UserID,
+		Permission: api.TodoPermission(s.Permission),
+		CreatedBy:  s.CreatedBy,
+		CreatedAt:  createdAt,
+		UpdatedAt:  updatedAt,
+	}
+}
+
+func (p *TodoPresenter) GetTodoHistory(ctx echo.Context, out *output.TodoRevisionListOutput) error {
+	revisions := make([]api.TodoRevision, len(out.Revisions))
+	for i, r := range out.Revisions {
+		createdAt, _ := time.Parse(time.RFC3339, r.CreatedAt)
+		changes := make([]api.TodoFieldChange, len(r.Changes))
+		for n, c := range r.Changes {
+			changes[n] = api.TodoFieldChange{
+				Field: c.Field,
+				Old:   c.Old,
+				New:   c.New,
+			}
+		}
+		revisions[i] = api.TodoRevision{
+			Id:        r.ID,
+			ActorId:   r.ActorID,
+			Operation: api.TodoRevisionOperation(r.Operation),
+			Changes:   changes,
+			CreatedAt: createdAt,
+		}
+	}
+	return ctx.JSON(http.StatusOK, api.TodoRevisionListResponse{
+		Revisions: revisions,
+		Total:     out.Total,
+	})
+}
+
+// todoETag is the strong entity tag of the todo's current version
+func todoETag(out *output.TodoOutput) string {
+	return strconv.Quote(strconv.Itoa(out.Version))
+}
+
 func toTodoResponse(out *output.TodoOutput) *api.TodoResponse {
 	createdAt, _ := time.Parse(time.RFC3339, out.CreatedAt)
 	updatedAt, _ := time.Parse(time.RFC3339, out.UpdatedAt)
 
+	priority := api.TodoPriority(out.Priority)
 	resp := &api.TodoResponse{
-		Id:          &out.ID,
-		UserId:      &out.UserID,
-		Title:       &out.Title,
-		Description: &out.Description,
-		Completed:   &out.Completed,
-		IsPublic:    &out.IsPublic,
-		CreatedAt:   &createdAt,
-		UpdatedAt:   &updatedAt,
+		Id:           &out.ID,
+		UserId:       &out.UserID,
+		Title:        &out.Title,
+		Description:  &out.Description,
+		Completed:    &out.Completed,
+		IsPublic:     &out.IsPublic,
+		Priority:     &priority,
+		Position:     &out.Position,
+		ProjectId:    out.ProjectID,
+		ParentId:     out.ParentID,
+		AssigneeId:   out.AssigneeID,
+		CommentCount: &out.CommentCount,
+		Version:      &out.Version,
+		CreatedAt:    &createdAt,
+		UpdatedAt:    &updatedAt,
 	}
 
 	if out.DueDate != nil {
 		dueDate, _ := time.Parse(time.RFC3339, *out.DueDate)
 		resp.DueDate = &dueDate
 	}
+	if out.DeletedAt != nil {
+		deletedAt, _ := time.Parse(time.RFC3339, *out.DeletedAt)
+		resp.DeletedAt = &deletedAt
+	}
+
+	if out.CompletedAt != nil {
+		completedAt, _ := time.Parse(time.RFC3339, *out.CompletedAt)
+		resp.CompletedAt = &completedAt
+	}
 
 	if out.CreatedBy != nil {
 		resp.CreatedBy = &api.TodoCreator{
@@ -79,5 +244,39 @@ func toTodoResponse(out *output.TodoOutput) *api.TodoResponse {
 		}
 	}
 
+	if out.Tags != nil {
+		tags := toTagResponses(out.Tags)
+		resp.Tags = &tags
+	}
+
+	if out.Children != nil {
+		children := make([]api.TodoResponse, len(out.Children))
+		for i, c := range out.Children {
+			children[i] = *toTodoResponse(c)
+		}
+		resp.Children = &children
+	}
+
+	if out.Recurrence != nil {
+		start, _ := time.Parse(time.RFC3339, out.Recurrence.Start)
+		resp.Recurrence = &api.TodoRecurrence{
+			Rule:     out.Recurrence.Rule,
+			Timezone: out.Recurrence.Timezone,
+			Start:    start,
+		}
+	}
+
+	if out.NextOccurrence != nil {
+		resp.NextOccurrence = toTodoResponse(out.NextOccurrence)
+	}
+
+	if out.Progress != nil {
+		resp.Progress = &api.TodoProgress{
+			Completed: out.Progress.Completed,
+			Total:     out.Progress.Total,
+			Ratio:     out.Progress.Ratio,
+		}
+	}
+
 	return resp
 }
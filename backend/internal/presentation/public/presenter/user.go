@@ -1,6 +1,7 @@
 package presenter
 
 import (
+	"fmt"
 	"net/http"
 	"time"
 
@@ -13,6 +14,8 @@ import (
 type IUserPresenter interface {
 	GetMe(ctx echo.Context, out *output.UserOutput) error
 	UpdateMe(ctx echo.Context, out *output.UserOutput) error
+	ExportMe(ctx echo.Context, out *output.AccountExportOutput) error
+	DeleteMe(ctx echo.Context) error
 }
 
 type UserPresenter struct{}
@@ -29,6 +32,28 @@ func (p *UserPresenter) UpdateMe(ctx echo.Context, out *output.UserOutput) error
 	return ctx.JSON(http.StatusOK, toUserResponse(out))
 }
 
+func (p *UserPresenter) ExportMe(ctx echo.Context, out *output.AccountExportOutput) error {
+	exportedAt, _ := time.Parse(time.RFC3339, out.ExportedAt)
+
+	todos := make([]api.TodoResponse, len(out.Todos))
+	for i, t := range out.Todos {
+		todos[i] = *toTodoResponse(t)
+	}
+
+	filename := fmt.Sprintf("good-todo-export-%s.json", exportedAt.Format("20060102"))
+	ctx.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", filename))
+
+	return ctx.JSON(http.StatusOK, &api.AccountExportResponse{
+		ExportedAt: exportedAt,
+		User:       *toUserResponse(out.User),
+		Todos:      todos,
+	})
+}
+
+func (p *UserPresenter) DeleteMe(ctx echo.Context) error {
+	return ctx.NoContent(http.StatusNoContent)
+}
+
 func toUserResponse(out *output.UserOutput) *api.UserResponse {
 	role := api.UserResponseRole(out.Role)
 	createdAt, _ := time.Parse(time.RFC3339, out.CreatedAt)
@@ -0,0 +1,21 @@
+package router
+
+import (
+	"github.com/labstack/echo/v4"
+)
+
+func (s *Server) GetTodoAttachments(c echo.Context, todoId string) error {
+	return s.attachmentController.GetTodoAttachments(c, todoId)
+}
+
+func (s *Server) UploadTodoAttachment(c echo.Context, todoId string) error {
+	return s.attachmentController.UploadTodoAttachment(c, todoId)
+}
+
+func (s *Server) DeleteTodoAttachment(c echo.Context, todoId string, attachmentId string) error {
+	return s.attachmentController.DeleteTodoAttachment(c, todoId, attachmentId)
+}
+
+func (s *Server) GetTodoAttachmentContent(c echo.Context, todoId string, attachmentId string) error {
+	return s.attachmentController.GetTodoAttachmentContent(c, todoId, attachmentId)
+}
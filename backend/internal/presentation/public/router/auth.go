@@ -19,3 +19,19 @@ func (s *Server) Register(c echo.Context) error {
 func (s *Server) VerifyEmail(c echo.Context) error {
 	return s.authController.VerifyEmail(c)
 }
+
+func (s *Server) RequestMagicLink(c echo.Context) error {
+	return s.authController.RequestMagicLink(c)
+}
+
+func (s *Server) ConsumeMagicLink(c echo.Context) error {
+	return s.authController.ConsumeMagicLink(c)
+}
+
+func (s *Server) RequestPasswordReset(c echo.Context) error {
+	return s.authController.RequestPasswordReset(c)
+}
+
+func (s *Server) ResetPassword(c echo.Context) error {
+	return s.authController.ResetPassword(c)
+}
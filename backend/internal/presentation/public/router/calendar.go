@@ -0,0 +1,26 @@
+package router
+
+import (
+	"good-todo-go/internal/presentation/public/api"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (s *Server) RotateCalendarToken(c echo.Context) error {
+	return s.calendarController.RotateToken(c)
+}
+
+func (s *Server) RevokeCalendarToken(c echo.Context) error {
+	return s.calendarController.RevokeToken(c)
+}
+
+func (s *Server) ExportTodos(c echo.Context, params api.ExportTodosParams) error {
+	return s.calendarController.ExportTodos(c, params)
+}
+
+// RegisterCalendarHandlers registers the calendar feed, which calendar apps
+// fetch with the token in its URL, e.g. /calendar/cal_....ics. It is served
+// outside the generated OpenAPI server.
+func (s *Server) RegisterCalendarHandlers(g *echo.Group) {
+	g.GET("/:token", s.calendarController.GetFeed)
+}
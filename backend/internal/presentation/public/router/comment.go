@@ -0,0 +1,21 @@
+package router
+
+import (
+	"github.com/labstack/echo/v4"
+)
+
+func (s *Server) GetTodoComments(c echo.Context, todoId string) error {
+	return s.commentController.GetTodoComments(c, todoId)
+}
+
+func (s *Server) CreateTodoComment(c echo.Context, todoId string) error {
+	return s.commentController.CreateTodoComment(c, todoId)
+}
+
+func (s *Server) UpdateTodoComment(c echo.Context, todoId string, commentId string) error {
+	return s.commentController.UpdateTodoComment(c, todoId, commentId)
+}
+
+func (s *Server) DeleteTodoComment(c echo.Context, todoId string, commentId string) error {
+	return s.commentController.DeleteTodoComment(c, todoId, commentId)
+}
@@ -4,7 +4,9 @@ import (
 	"good-todo-go/internal/infrastructure/database"
 	"good-todo-go/internal/infrastructure/environment"
 	"good-todo-go/internal/infrastructure/repository"
+	"good-todo-go/internal/infrastructure/storage"
 	"good-todo-go/internal/pkg"
+	"good-todo-go/internal/pkg/passwordpolicy"
 	"good-todo-go/internal/presentation/public/controller"
 	"good-todo-go/internal/presentation/public/presenter"
 	"good-todo-go/internal/usecase"
@@ -26,26 +28,79 @@ func BuildContainer() *dig.Container {
 		return pkg.NewJWTService(cfg.JWTSecret, cfg.JWTExpiresIn, cfg.JWTRefreshExpiresIn)
 	})
 	container.Provide(pkg.NewUUIDGenerator)
+	container.Provide(func(cfg *environment.Config) *passwordpolicy.Policy {
+		return passwordpolicy.NewPolicy(cfg.PasswordMinLength, cfg.PasswordMinScore)
+	})
+	container.Provide(func(cfg *environment.Config) pkg.IMailer {
+		return pkg.NewSMTPMailer(cfg.SMTPHost, cfg.SMTPPort, cfg.SMTPUser, cfg.SMTPPassword, cfg.SMTPFrom)
+	})
+	container.Provide(func(cfg *environment.Config) *pkg.LinkBuilder {
+		return pkg.NewLinkBuilder(cfg.FrontendURL)
+	})
+	container.Provide(storage.NewBlobStore)
 
 	// repository
 	container.Provide(repository.NewAuthRepository)
 	container.Provide(repository.NewUserRepository)
 	container.Provide(repository.NewTodoRepository)
+	container.Provide(repository.NewTenantRepository)
+	container.Provide(repository.NewAuditEventRepository)
+	container.Provide(repository.NewTagRepository)
+	container.Provide(repository.NewProjectRepository)
+	container.Provide(repository.NewReminderRepository)
+	container.Provide(repository.NewNotificationRepository)
+	container.Provide(repository.NewCommentRepository)
+	container.Provide(repository.NewAttachmentRepository)
+	container.Provide(repository.NewTodoRevisionRepository)
 
 	// usecase
+	container.Provide(func(cfg *environment.Config) usecase.AttachmentLimits {
+		return usecase.AttachmentLimits{MaxBytes: cfg.AttachmentMaxBytes}
+	})
+	container.Provide(usecase.NewAuditLogger)
+	container.Provide(usecase.NewAuditEventInteractor)
 	container.Provide(usecase.NewAuthInteractor)
 	container.Provide(usecase.NewUserInteractor)
 	container.Provide(usecase.NewTodoInteractor)
+	container.Provide(usecase.NewTenantInteractor)
+	container.Provide(usecase.NewScimInteractor)
+	container.Provide(usecase.NewTagInteractor)
+	container.Provide(usecase.NewProjectInteractor)
+	container.Provide(usecase.NewReminderInteractor)
+	container.Provide(usecase.NewNotificationInteractor)
+	container.Provide(usecase.NewCommentInteractor)
+	container.Provide(usecase.NewAttachmentInteractor)
+	container.Provide(usecase.NewCalendarInteractor)
 
 	// presenter
 	container.Provide(presenter.NewAuthPresenter)
 	container.Provide(presenter.NewUserPresenter)
 	container.Provide(presenter.NewTodoPresenter)
+	container.Provide(presenter.NewTenantPresenter)
+	container.Provide(presenter.NewScimPresenter)
+	container.Provide(presenter.NewAuditPresenter)
+	container.Provide(presenter.NewTagPresenter)
+	container.Provide(presenter.NewProjectPresenter)
+	container.Provide(presenter.NewReminderPresenter)
+	container.Provide(presenter.NewNotificationPresenter)
+	container.Provide(presenter.NewCommentPresenter)
+	container.Provide(presenter.NewAttachmentPresenter)
+	container.Provide(presenter.NewCalendarPresenter)
 
 	// controller
 	container.Provide(controller.NewAuthController)
 	container.Provide(controller.NewUserController)
 	container.Provide(controller.NewTodoController)
+	container.Provide(controller.NewTenantController)
+	container.Provide(controller.NewScimController)
+	container.Provide(controller.NewAuditController)
+	container.Provide(controller.NewTagController)
+	container.Provide(controller.NewProjectController)
+	container.Provide(controller.NewReminderController)
+	container.Provide(controller.NewNotificationController)
+	container.Provide(controller.NewCommentController)
+	container.Provide(controller.NewAttachmentController)
+	container.Provide(controller.NewCalendarController)
 
 	return container
 }
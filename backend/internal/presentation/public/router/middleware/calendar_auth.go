@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"good-todo-go/internal/infrastructure/database"
+	"good-todo-go/internal/pkg"
+	"good-todo-go/internal/pkg/cerror"
+	"good-todo-go/internal/presentation/public/router/context_keys"
+	"good-todo-go/internal/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+// CalendarAuthMiddleware authenticates calendar apps with the secret token in
+// the feed URL and scopes the request to the token's user and their tenant
+func CalendarAuthMiddleware(calendarUsecase usecase.ICalendarInteractor) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			// Calendar apps expect the URL to end like a file name
+			token := strings.TrimSuffix(c.Param("token"), ".ics")
+
+			user, err := calendarUsecase.Authenticate(c.Request().Context(), token)
+			if err != nil {
+				status := http.StatusInternalServerError
+				var appErr *cerror.AppError
+				if errors.As(err, &appErr) {
+					status = appErr.HTTPStatus
+				}
+				return echo.NewHTTPError(status, "invalid calendar token")
+			}
+
+			c.Set(context_keys.UserIDContextKey, user.ID)
+			c.Set(context_keys.TenantIDContextKey, user.TenantID)
+
+			// Also set tenantID in request context for database layer
+			ctx := database.WithTenantID(c.Request().Context(), user.TenantID)
+			ctx = pkg.WithActorID(ctx, user.ID)
+			c.SetRequest(c.Request().WithContext(ctx))
+
+			return next(c)
+		}
+	}
+}
@@ -1,12 +1,15 @@
 package middleware
 
 import (
+	"errors"
 	"net/http"
 	"strings"
 
 	"good-todo-go/internal/infrastructure/database"
 	"good-todo-go/internal/pkg"
+	"good-todo-go/internal/pkg/cerror"
 	"good-todo-go/internal/presentation/public/router/context_keys"
+	"good-todo-go/internal/usecase"
 
 	"github.com/labstack/echo/v4"
 )
@@ -18,10 +21,23 @@ var publicRoutes = []string{
 	"/auth/login",
 	"/auth/verify-email",
 	"/auth/refresh",
+	"/auth/magic-link",
+	"/auth/magic-link/consume",
+	"/auth/password-reset",
+	"/auth/password-reset/confirm",
 }
 
-// JWTAuthMiddleware validates JWT tokens and sets user info in context
-func JWTAuthMiddleware(jwtService *pkg.JWTService) echo.MiddlewareFunc {
+// 独自の認証を持つルートのプレフィックス一覧 (SCIMはテナントのSCIMトークンで、
+// カレンダーフィードはURLに含まれるユーザーのトークンで認証する)
+var publicRoutePrefixes = []string{
+	"/scim/v2/",
+	"/calendar/",
+}
+
+// JWTAuthMiddleware validates JWT tokens and sets user info in context. The
+// user is loaded on every request, so deactivation and role changes take
+// effect before the token expires.
+func JWTAuthMiddleware(jwtService *pkg.JWTService, userUsecase usecase.IUserInteractor) echo.MiddlewareFunc {
 	// publicRoutesをmapに変換
 	publicRoutesMap := make(map[string]bool)
 	for _, route := range publicRoutes {
@@ -39,6 +55,11 @@ func JWTAuthMiddleware(jwtService *pkg.JWTService) echo.MiddlewareFunc {
 			if publicRoutesMap[c.Request().URL.Path] {
 				return next(c)
 			}
+			for _, prefix := range publicRoutePrefixes {
+				if strings.HasPrefix(c.Request().URL.Path, prefix) {
+					return next(c)
+				}
+			}
 
 			// Extract token from Authorization header
 			authHeader := c.Request().Header.Get("Authorization")
@@ -62,22 +83,35 @@ func JWTAuthMiddleware(jwtService *pkg.JWTService) echo.MiddlewareFunc {
 				return echo.NewHTTPError(http.StatusUnauthorized, "invalid token type")
 			}
 
-			// Set user info in echo context
-			c.Set(context_keys.UserIDContextKey, claims.UserID)
-			c.Set(context_keys.TenantIDContextKey, claims.TenantID)
-			c.Set(context_keys.EmailContextKey, claims.Email)
-			c.Set(context_keys.RoleContextKey, claims.Role)
-
-			// Also set tenantID in request context for database layer
+			// Also set tenantID in request context for database layer, and the
+			// user for the todo revisions recorded there
 			ctx := database.WithTenantID(c.Request().Context(), claims.TenantID)
+			ctx = pkg.WithActorID(ctx, claims.UserID)
 			c.SetRequest(c.Request().WithContext(ctx))
 
+			user, err := userUsecase.Authenticate(ctx, claims.UserID)
+			if err != nil {
+				status := http.StatusInternalServerError
+				var appErr *cerror.AppError
+				if errors.As(err, &appErr) {
+					status = appErr.HTTPStatus
+				}
+				return echo.NewHTTPError(status, "invalid or expired token")
+			}
+
+			// Set user info in echo context. Email and role come from the
+			// database as the claims may be stale.
+			c.Set(context_keys.UserIDContextKey, user.ID)
+			c.Set(context_keys.TenantIDContextKey, user.TenantID)
+			c.Set(context_keys.EmailContextKey, user.Email)
+			c.Set(context_keys.RoleContextKey, user.Role)
+
 			return next(c)
 		}
 	}
 }
 
 // JWTAuth は後方互換性のためのエイリアス
-func JWTAuth(jwtService *pkg.JWTService) echo.MiddlewareFunc {
-	return JWTAuthMiddleware(jwtService)
+func JWTAuth(jwtService *pkg.JWTService, userUsecase usecase.IUserInteractor) echo.MiddlewareFunc {
+	return JWTAuthMiddleware(jwtService, userUsecase)
 }
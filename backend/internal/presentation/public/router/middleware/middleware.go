@@ -18,4 +18,5 @@ func SetupMiddleware(e *echo.Echo) {
 	e.Use(middleware.Recover())
 	e.Use(middleware.CORS())
 	e.Use(middleware.RequestID())
+	e.Use(RequestInfoMiddleware())
 }
@@ -0,0 +1,30 @@
+package middleware
+
+import (
+	"good-todo-go/internal/pkg"
+
+	"github.com/labstack/echo/v4"
+)
+
+// RequestInfoMiddleware attaches the client IP, user agent and request ID to
+// the request context so the usecases can record them in the audit log.
+// It must run after the RequestID middleware.
+func RequestInfoMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			requestID := c.Request().Header.Get(echo.HeaderXRequestID)
+			if requestID == "" {
+				requestID = c.Response().Header().Get(echo.HeaderXRequestID)
+			}
+
+			ctx := pkg.WithRequestInfo(c.Request().Context(), pkg.RequestInfo{
+				IP:        c.RealIP(),
+				UserAgent: c.Request().UserAgent(),
+				RequestID: requestID,
+			})
+			c.SetRequest(c.Request().WithContext(ctx))
+
+			return next(c)
+		}
+	}
+}
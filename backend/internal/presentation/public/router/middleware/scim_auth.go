@@ -0,0 +1,47 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"good-todo-go/internal/infrastructure/database"
+	"good-todo-go/internal/pkg/cerror"
+	"good-todo-go/internal/presentation/public/router/context_keys"
+	"good-todo-go/internal/presentation/public/scim"
+	"good-todo-go/internal/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ScimAuthMiddleware authenticates identity providers with the tenant's SCIM
+// bearer token and scopes the request to that tenant
+func ScimAuthMiddleware(scimUsecase usecase.IScimInteractor) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			authHeader := c.Request().Header.Get("Authorization")
+			token := strings.TrimPrefix(authHeader, "Bearer ")
+			if authHeader == "" || token == authHeader {
+				return scim.JSON(c, http.StatusUnauthorized, scim.NewError(http.StatusUnauthorized, "", "missing or invalid authorization header"))
+			}
+
+			tenantID, err := scimUsecase.Authenticate(c.Request().Context(), token)
+			if err != nil {
+				status := http.StatusInternalServerError
+				var appErr *cerror.AppError
+				if errors.As(err, &appErr) {
+					status = appErr.HTTPStatus
+				}
+				return scim.JSON(c, status, scim.NewError(status, "", "invalid SCIM token"))
+			}
+
+			c.Set(context_keys.TenantIDContextKey, tenantID)
+
+			// Also set tenantID in request context for database layer
+			ctx := database.WithTenantID(c.Request().Context(), tenantID)
+			c.SetRequest(c.Request().WithContext(ctx))
+
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,15 @@
+package router
+
+import (
+	"good-todo-go/internal/presentation/public/api"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (s *Server) GetNotifications(c echo.Context, params api.GetNotificationsParams) error {
+	return s.notificationController.GetNotifications(c, params)
+}
+
+func (s *Server) MarkNotificationRead(c echo.Context, notificationId string) error {
+	return s.notificationController.MarkNotificationRead(c, notificationId)
+}
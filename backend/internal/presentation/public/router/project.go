@@ -0,0 +1,27 @@
+package router
+
+import (
+	"good-todo-go/internal/presentation/public/api"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (s *Server) GetProjects(c echo.Context, params api.GetProjectsParams) error {
+	return s.projectController.GetProjects(c, params)
+}
+
+func (s *Server) CreateProject(c echo.Context) error {
+	return s.projectController.CreateProject(c)
+}
+
+func (s *Server) GetProject(c echo.Context, projectId string) error {
+	return s.projectController.GetProject(c, projectId)
+}
+
+func (s *Server) UpdateProject(c echo.Context, projectId string) error {
+	return s.projectController.UpdateProject(c, projectId)
+}
+
+func (s *Server) DeleteProject(c echo.Context, projectId string) error {
+	return s.projectController.DeleteProject(c, projectId)
+}
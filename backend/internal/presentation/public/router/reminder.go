@@ -0,0 +1,17 @@
+package router
+
+import (
+	"github.com/labstack/echo/v4"
+)
+
+func (s *Server) GetTodoReminders(c echo.Context, todoId string) error {
+	return s.reminderController.GetTodoReminders(c, todoId)
+}
+
+func (s *Server) CreateTodoReminder(c echo.Context, todoId string) error {
+	return s.reminderController.CreateTodoReminder(c, todoId)
+}
+
+func (s *Server) DeleteTodoReminder(c echo.Context, todoId string, reminderId string) error {
+	return s.reminderController.DeleteTodoReminder(c, todoId, reminderId)
+}
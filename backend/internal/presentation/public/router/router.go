@@ -11,21 +11,33 @@ import (
 	"good-todo-go/internal/ent"
 	"good-todo-go/internal/infrastructure/environment"
 	"good-todo-go/internal/pkg"
+	"good-todo-go/internal/pkg/cerror"
 	"good-todo-go/internal/presentation/public/api"
 	"good-todo-go/internal/presentation/public/controller"
 	"good-todo-go/internal/presentation/public/router/dependency"
 	"good-todo-go/internal/presentation/public/router/middleware"
+	"good-todo-go/internal/usecase"
 
 	"github.com/labstack/echo/v4"
 	echoMiddleware "github.com/labstack/echo/v4/middleware"
 )
 
 type Server struct {
-	env            *environment.Config
-	jwtService     *pkg.JWTService
-	authController *controller.AuthController
-	userController *controller.UserController
-	todoController *controller.TodoController
+	env                    *environment.Config
+	jwtService             *pkg.JWTService
+	authController         *controller.AuthController
+	userController         *controller.UserController
+	todoController         *controller.TodoController
+	tenantController       *controller.TenantController
+	scimController         *controller.ScimController
+	auditController        *controller.AuditController
+	tagController          *controller.TagController
+	projectController      *controller.ProjectController
+	reminderController     *controller.ReminderController
+	notificationController *controller.NotificationController
+	commentController      *controller.CommentController
+	attachmentController   *controller.AttachmentController
+	calendarController     *controller.CalendarController
 }
 
 func NewServer(
@@ -34,18 +46,39 @@ func NewServer(
 	authController *controller.AuthController,
 	userController *controller.UserController,
 	todoController *controller.TodoController,
+	tenantController *controller.TenantController,
+	scimController *controller.ScimController,
+	auditController *controller.AuditController,
+	tagController *controller.TagController,
+	projectController *controller.ProjectController,
+	reminderController *controller.ReminderController,
+	notificationController *controller.NotificationController,
+	commentController *controller.CommentController,
+	attachmentController *controller.AttachmentController,
+	calendarController *controller.CalendarController,
 ) *Server {
 	return &Server{
-		env:            env,
-		jwtService:     jwtService,
-		authController: authController,
-		userController: userController,
-		todoController: todoController,
+		env:                    env,
+		jwtService:             jwtService,
+		authController:         authController,
+		userController:         userController,
+		todoController:         todoController,
+		tenantController:       tenantController,
+		scimController:         scimController,
+		auditController:        auditController,
+		tagController:          tagController,
+		projectController:      projectController,
+		reminderController:     reminderController,
+		notificationController: notificationController,
+		commentController:      commentController,
+		attachmentController:   attachmentController,
+		calendarController:     calendarController,
 	}
 }
 
 func NewRouter() (*echo.Echo, *environment.Config, *ent.Client, error) {
 	e := echo.New()
+	e.HTTPErrorHandler = cerror.CustomHTTPErrorHandler
 
 	// ミドルウェア設定
 	e.Use(echoMiddleware.RequestLoggerWithConfig(echoMiddleware.RequestLoggerConfig{
@@ -59,6 +92,8 @@ func NewRouter() (*echo.Echo, *environment.Config, *ent.Client, error) {
 	}))
 	e.Use(echoMiddleware.Recover())
 	e.Use(echoMiddleware.CORS())
+	e.Use(echoMiddleware.RequestID())
+	e.Use(middleware.RequestInfoMiddleware())
 
 	// DIコンテナを作成
 	container := dependency.BuildContainer()
@@ -68,6 +103,9 @@ func NewRouter() (*echo.Echo, *environment.Config, *ent.Client, error) {
 		server *Server
 		client *ent.Client
 		jwtSvc *pkg.JWTService
+		scimUc usecase.IScimInteractor
+		calUc  usecase.ICalendarInteractor
+		userUc usecase.IUserInteractor
 	)
 
 	if err := container.Invoke(func(s *Server) {
@@ -88,12 +126,36 @@ func NewRouter() (*echo.Echo, *environment.Config, *ent.Client, error) {
 		return nil, nil, nil, err
 	}
 
+	if err := container.Invoke(func(u usecase.IScimInteractor) {
+		scimUc = u
+	}); err != nil {
+		return nil, nil, nil, err
+	}
+
+	if err := container.Invoke(func(u usecase.ICalendarInteractor) {
+		calUc = u
+	}); err != nil {
+		return nil, nil, nil, err
+	}
+
+	if err := container.Invoke(func(u usecase.IUserInteractor) {
+		userUc = u
+	}); err != nil {
+		return nil, nil, nil, err
+	}
+
 	// JWT認証ミドルウェア
-	e.Use(middleware.JWTAuthMiddleware(jwtSvc))
+	e.Use(middleware.JWTAuthMiddleware(jwtSvc, userUc))
 
 	// 依存解決したハンドラーをルーティングに登録
 	api.RegisterHandlers(e, server)
 
+	// SCIMはテナントのSCIMトークンで認証する
+	server.RegisterScimHandlers(e.Group("/scim/v2", middleware.ScimAuthMiddleware(scimUc)))
+
+	// カレンダーフィードはURLに含まれるトークンで認証する
+	server.RegisterCalendarHandlers(e.Group("/calendar", middleware.CalendarAuthMiddleware(calUc)))
+
 	// グレースフルシャットダウンを仕込む
 	gracefulShutdown(e)
 
@@ -0,0 +1,17 @@
+package router
+
+import (
+	"github.com/labstack/echo/v4"
+)
+
+// RegisterScimHandlers registers the SCIM 2.0 endpoints, which are served
+// outside the generated OpenAPI server
+func (s *Server) RegisterScimHandlers(g *echo.Group) {
+	g.GET("/ServiceProviderConfig", s.scimController.GetServiceProviderConfig)
+	g.GET("/Users", s.scimController.ListUsers)
+	g.POST("/Users", s.scimController.CreateUser)
+	g.GET("/Users/:id", s.scimController.GetUser)
+	g.PUT("/Users/:id", s.scimController.ReplaceUser)
+	g.PATCH("/Users/:id", s.scimController.PatchUser)
+	g.DELETE("/Users/:id", s.scimController.DeleteUser)
+}
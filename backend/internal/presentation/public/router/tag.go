@@ -0,0 +1,29 @@
+package router
+
+import (
+	"github.com/labstack/echo/v4"
+)
+
+func (s *Server) GetTags(c echo.Context) error {
+	return s.tagController.GetTags(c)
+}
+
+func (s *Server) CreateTag(c echo.Context) error {
+	return s.tagController.CreateTag(c)
+}
+
+func (s *Server) UpdateTag(c echo.Context, tagId string) error {
+	return s.tagController.UpdateTag(c, tagId)
+}
+
+func (s *Server) DeleteTag(c echo.Context, tagId string) error {
+	return s.tagController.DeleteTag(c, tagId)
+}
+
+func (s *Server) AttachTodoTag(c echo.Context, todoId string, tagId string) error {
+	return s.tagController.AttachTodoTag(c, todoId, tagId)
+}
+
+func (s *Server) DetachTodoTag(c echo.Context, todoId string, tagId string) error {
+	return s.tagController.DetachTodoTag(c, todoId, tagId)
+}
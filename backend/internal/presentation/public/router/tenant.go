@@ -0,0 +1,21 @@
+package router
+
+import (
+	"github.com/labstack/echo/v4"
+)
+
+func (s *Server) GetTenantSettings(c echo.Context) error {
+	return s.tenantController.GetSettings(c)
+}
+
+func (s *Server) UpdateTenantSettings(c echo.Context) error {
+	return s.tenantController.UpdateSettings(c)
+}
+
+func (s *Server) RotateScimToken(c echo.Context) error {
+	return s.tenantController.RotateScimToken(c)
+}
+
+func (s *Server) RevokeScimToken(c echo.Context) error {
+	return s.tenantController.RevokeScimToken(c)
+}
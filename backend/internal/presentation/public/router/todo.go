@@ -10,6 +10,14 @@ func (s *Server) GetTodos(c echo.Context, params api.GetTodosParams) error {
 	return s.todoController.GetTodos(c, params)
 }
 
+func (s *Server) GetTodoCompletionStats(c echo.Context, params api.GetTodoCompletionStatsParams) error {
+	return s.todoController.GetTodoCompletionStats(c, params)
+}
+
+func (s *Server) SearchTodos(c echo.Context, params api.SearchTodosParams) error {
+	return s.todoController.SearchTodos(c, params)
+}
+
 func (s *Server) GetPublicTodos(c echo.Context, params api.GetPublicTodosParams) error {
 	return s.todoController.GetPublicTodos(c, params)
 }
@@ -18,14 +26,90 @@ func (s *Server) CreateTodo(c echo.Context) error {
 	return s.todoController.CreateTodo(c)
 }
 
-func (s *Server) DeleteTodo(c echo.Context, todoId string) error {
-	return s.todoController.DeleteTodo(c, todoId)
+func (s *Server) BulkTodos(c echo.Context) error {
+	return s.todoController.BulkTodos(c)
+}
+
+func (s *Server) GetTodoTrash(c echo.Context, params api.GetTodoTrashParams) error {
+	return s.todoController.GetTodoTrash(c, params)
+}
+
+func (s *Server) RestoreTodo(c echo.Context, todoId string) error {
+	return s.todoController.RestoreTodo(c, todoId)
+}
+
+func (s *Server) DeleteTodo(c echo.Context, todoId string, params api.DeleteTodoParams) error {
+	return s.todoController.DeleteTodo(c, todoId, params)
 }
 
 func (s *Server) GetTodo(c echo.Context, todoId string) error {
 	return s.todoController.GetTodo(c, todoId)
 }
 
-func (s *Server) UpdateTodo(c echo.Context, todoId string) error {
-	return s.todoController.UpdateTodo(c, todoId)
+func (s *Server) UpdateTodo(c echo.Context, todoId string, params api.UpdateTodoParams) error {
+	return s.todoController.UpdateTodo(c, todoId, params)
+}
+
+func (s *Server) PatchTodo(c echo.Context, todoId string, params api.PatchTodoParams) error {
+	return s.todoController.PatchTodo(c, todoId, params)
+}
+
+func (s *Server) MoveTodo(c echo.Context, todoId string) error {
+	return s.todoController.MoveTodo(c, todoId)
+}
+
+func (s *Server) ReorderTodo(c echo.Context, todoId string) error {
+	return s.todoController.ReorderTodo(c, todoId)
+}
+
+func (s *Server) SetTodoParent(c echo.Context, todoId string) error {
+	return s.todoController.SetTodoParent(c, todoId)
+}
+
+func (s *Server) SetTodoRecurrence(c echo.Context, todoId string) error {
+	return s.todoController.SetTodoRecurrence(c, todoId)
+}
+
+func (s *Server) StopTodoRecurrence(c echo.Context, todoId string) error {
+	return s.todoController.StopTodoRecurrence(c, todoId)
+}
+
+func (s *Server) SkipTodoOccurrence(c echo.Context, todoId string) error {
+	return s.todoController.SkipTodoOccurrence(c, todoId)
+}
+
+func (s *Server) GetAssignedTodos(c echo.Context, params api.GetAssignedTodosParams) error {
+	return s.todoController.GetAssignedTodos(c, params)
+}
+
+func (s *Server) AssignTodo(c echo.Context, todoId string) error {
+	return s.todoController.AssignTodo(c, todoId)
+}
+
+func (s *Server) GetTodoAssignments(c echo.Context, todoId string) error {
+	return s.todoController.GetTodoAssignments(c, todoId)
+}
+
+func (s *Server) GetSharedTodos(c echo.Context, params api.GetSharedTodosParams) error {
+	return s.todoController.GetSharedTodos(c, params)
+}
+
+func (s *Server) GetTodoShares(c echo.Context, todoId string) error {
+	return s.todoController.GetTodoShares(c, todoId)
+}
+
+func (s *Server) ShareTodo(c echo.Context, todoId string, userId string) error {
+	return s.todoController.ShareTodo(c, todoId, userId)
+}
+
+func (s *Server) UnshareTodo(c echo.Context, todoId string, userId string) error {
+	return s.todoController.UnshareTodo(c, todoId, userId)
+}
+
+func (s *Server) GetTodoHistory(c echo.Context, todoId string, params api.GetTodoHistoryParams) error {
+	return s.todoController.GetTodoHistory(c, todoId, params)
+}
+
+func (s *Server) RevertTodo(c echo.Context, todoId string, revisionId string) error {
+	return s.todoController.RevertTodo(c, todoId, revisionId)
 }
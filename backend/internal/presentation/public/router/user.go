@@ -11,3 +11,15 @@ func (s *Server) GetMe(c echo.Context) error {
 func (s *Server) UpdateMe(c echo.Context) error {
 	return s.userController.UpdateMe(c)
 }
+
+func (s *Server) DeleteMe(c echo.Context) error {
+	return s.userController.DeleteMe(c)
+}
+
+func (s *Server) ExportMe(c echo.Context) error {
+	return s.userController.ExportMe(c)
+}
+
+func (s *Server) ChangePassword(c echo.Context) error {
+	return s.authController.ChangePassword(c)
+}
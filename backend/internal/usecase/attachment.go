@@ -0,0 +1,238 @@
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_usecase
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+	"unicode"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/pkg"
+	"good-todo-go/internal/pkg/cerror"
+	"good-todo-go/internal/usecase/input"
+	"good-todo-go/internal/usecase/output"
+)
+
+// IAttachmentInteractor manages files attached to todos. Whoever can see a
+// todo can list and download its attachments; the owner, members with edit
+// access and the assignee can upload.
+type IAttachmentInteractor interface {
+	ListAttachments(ctx context.Context, todoID, userID string) (*output.AttachmentListOutput, error)
+	UploadAttachment(ctx context.Context, in *input.UploadAttachmentInput) (*output.AttachmentOutput, error)
+	GetAttachmentContent(ctx context.Context, in *input.GetAttachmentContentInput) (*output.AttachmentContentOutput, error)
+	DeleteAttachment(ctx context.Context, in *input.DeleteAttachmentInput) error
+}
+
+// AttachmentLimits bounds what can be uploaded
+type AttachmentLimits struct {
+	// MaxBytes is the largest file accepted
+	MaxBytes int64
+}
+
+const (
+	maxAttachmentsPerTodo = 20
+	// maxAttachmentNameLength is in characters
+	maxAttachmentNameLength = 255
+	// sniffLength is how much http.DetectContentType looks at
+	sniffLength = 512
+)
+
+// allowedAttachmentTypes are the sniffed media types that may be uploaded:
+// screenshots, PDFs and plain text
+var allowedAttachmentTypes = map[string]bool{
+	"image/png":       true,
+	"image/jpeg":      true,
+	"image/gif":       true,
+	"image/webp":      true,
+	"application/pdf": true,
+	"text/plain":      true,
+}
+
+type AttachmentInteractor struct {
+	attachmentRepo repository.IAttachmentRepository
+	todoRepo       repository.ITodoRepository
+	blobStore      pkg.IBlobStore
+	uuidGen        pkg.IUUIDGenerator
+	limits         AttachmentLimits
+}
+
+func NewAttachmentInteractor(
+	attachmentRepo repository.IAttachmentRepository,
+	todoRepo repository.ITodoRepository,
+	blobStore pkg.IBlobStore,
+	uuidGen pkg.IUUIDGenerator,
+	limits AttachmentLimits,
+) IAttachmentInteractor {
+	return &AttachmentInteractor{
+		attachmentRepo: attachmentRepo,
+		todoRepo:       todoRepo,
+		blobStore:      blobStore,
+		uuidGen:        uuidGen,
+		limits:         limits,
+	}
+}
+
+func (i *AttachmentInteractor) ListAttachments(ctx context.Context, todoID, userID string) (*output.AttachmentListOutput, error) {
+	if _, err := i.findVisibleTodo(ctx, todoID, userID); err != nil {
+		return nil, err
+	}
+
+	attachments, err := i.attachmentRepo.FindByTodoID(ctx, todoID)
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to get attachments", err)
+	}
+	return output.NewAttachmentListOutput(attachments), nil
+}
+
+func (i *AttachmentInteractor) UploadAttachment(ctx context.Context, in *input.UploadAttachmentInput) (*output.AttachmentOutput, error) {
+	todo, err := i.findVisibleTodo(ctx, in.TodoID, in.UserID)
+	if err != nil {
+		return nil, err
+	}
+	// Members with edit access may attach files like they may update the todo
+	if todo.UserID != in.UserID && !isTodoEditor(todo, in.UserID) && !isTodoAssignee(todo, in.UserID) {
+		return nil, cerror.NewForbidden("not allowed to attach files to this todo", nil)
+	}
+
+	if in.Size <= 0 {
+		return nil, cerror.NewBadRequest("file is empty", nil)
+	}
+	if in.Size > i.limits.MaxBytes {
+		return nil, cerror.NewPayloadTooLarge(fmt.Sprintf("file must be at most %d bytes", i.limits.MaxBytes), nil)
+	}
+
+	// The client's content type is not trusted; the type is sniffed from the first bytes
+	head := make([]byte, min(in.Size, sniffLength))
+	if _, err := io.ReadFull(in.Content, head); err != nil {
+		return nil, cerror.NewBadRequest("failed to read file", err)
+	}
+	contentType := http.DetectContentType(head)
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	if !allowedAttachmentTypes[mediaType] {
+		return nil, cerror.NewBadRequest("file type is not allowed; upload an image, a PDF or plain text", nil)
+	}
+
+	existing, err := i.attachmentRepo.FindByTodoID(ctx, todo.ID)
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to get attachments", err)
+	}
+	if len(existing) >= maxAttachmentsPerTodo {
+		return nil, cerror.NewBadRequest("a todo can have at most 20 attachments", nil)
+	}
+
+	id := i.uuidGen.Generate()
+	attachment := &model.Attachment{
+		ID:          id,
+		TenantID:    in.TenantID,
+		TodoID:      todo.ID,
+		UploadedBy:  in.UserID,
+		FileName:    sanitizeFileName(in.FileName),
+		ContentType: contentType,
+		Size:        in.Size,
+		StorageKey:  in.TenantID + "/" + id,
+	}
+
+	content := io.MultiReader(bytes.NewReader(head), in.Content)
+	if err := i.blobStore.Put(ctx, attachment.StorageKey, content, in.Size, contentType); err != nil {
+		return nil, cerror.NewInternalServerError("failed to store file", err)
+	}
+
+	created, err := i.attachmentRepo.Create(ctx, attachment)
+	if err != nil {
+		// Without its row nothing would ever clean up the blob
+		if delErr := i.blobStore.Delete(ctx, attachment.StorageKey); delErr != nil {
+			log.Printf("Failed to remove blob %s of failed upload: %v", attachment.StorageKey, delErr)
+		}
+		return nil, cerror.NewInternalServerError("failed to create attachment", err)
+	}
+
+	return output.NewAttachmentOutput(created), nil
+}
+
+func (i *AttachmentInteractor) GetAttachmentContent(ctx context.Context, in *input.GetAttachmentContentInput) (*output.AttachmentContentOutput, error) {
+	_, attachment, err := i.findAttachment(ctx, in.TodoID, in.AttachmentID, in.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := i.blobStore.Get(ctx, attachment.StorageKey)
+	if errors.Is(err, pkg.ErrBlobNotFound) {
+		return nil, cerror.NewNotFound("attachment content not found", err)
+	}
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to read file", err)
+	}
+
+	return &output.AttachmentContentOutput{
+		Attachment: output.NewAttachmentOutput(attachment),
+		Content:    content,
+	}, nil
+}
+
+func (i *AttachmentInteractor) DeleteAttachment(ctx context.Context, in *input.DeleteAttachmentInput) error {
+	todo, attachment, err := i.findAttachment(ctx, in.TodoID, in.AttachmentID, in.UserID)
+	if err != nil {
+		return err
+	}
+	if todo.UserID != in.UserID && attachment.UploadedBy != in.UserID {
+		return cerror.NewForbidden("not allowed to delete this attachment", nil)
+	}
+
+	// The blob is removed later by the scheduler
+	if err := i.attachmentRepo.Delete(ctx, attachment.ID); err != nil {
+		return cerror.NewInternalServerError("failed to delete attachment", err)
+	}
+	return nil
+}
+
+func (i *AttachmentInteractor) findVisibleTodo(ctx context.Context, todoID, userID string) (*model.Todo, error) {
+	todo, err := i.todoRepo.FindByID(ctx, todoID)
+	if err != nil {
+		return nil, cerror.NewNotFound("todo not found", err)
+	}
+	if !canViewTodo(todo, userID) {
+		return nil, cerror.NewForbidden("not allowed to access this todo", nil)
+	}
+	return todo, nil
+}
+
+func (i *AttachmentInteractor) findAttachment(ctx context.Context, todoID, attachmentID, userID string) (*model.Todo, *model.Attachment, error) {
+	todo, err := i.findVisibleTodo(ctx, todoID, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	attachment, err := i.attachmentRepo.FindByID(ctx, attachmentID)
+	if err != nil || attachment.TodoID != todo.ID {
+		return nil, nil, cerror.NewNotFound("attachment not found", err)
+	}
+	return todo, attachment, nil
+}
+
+// sanitizeFileName keeps the base name without control characters, which
+// would otherwise end up in the Content-Disposition of downloads
+func sanitizeFileName(name string) string {
+	name = path.Base(strings.ReplaceAll(name, "\\", "/"))
+	name = strings.TrimSpace(strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return -1
+		}
+		return r
+	}, name))
+	if runes := []rune(name); len(runes) > maxAttachmentNameLength {
+		name = string(runes[:maxAttachmentNameLength])
+	}
+	if name == "" || name == "." || name == "/" {
+		return "attachment"
+	}
+	return name
+}
@@ -0,0 +1,404 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"good-todo-go/internal/domain/model"
+	mock_repository "good-todo-go/internal/domain/repository/mock"
+	"good-todo-go/internal/pkg"
+	mock_pkg "good-todo-go/internal/pkg/mock"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// pngHeader is enough of a PNG file for content sniffing
+var pngHeader = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+func TestAttachmentInteractor_UploadAttachment(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		todo            *model.Todo
+		fileName        string
+		content         []byte
+		size            int64
+		existing        int
+		wantContentType string
+		wantFileName    string
+		wantErr         bool
+		errContains     string
+	}{
+		{
+			name:            "success - png screenshot",
+			todo:            &model.Todo{ID: "todo-1", UserID: "user-1"},
+			fileName:        "screenshot.png",
+			content:         pngHeader,
+			wantContentType: "image/png",
+			wantFileName:    "screenshot.png",
+		},
+		{
+			name:            "success - assignee uploads plain text with a path in its name",
+			todo:            &model.Todo{ID: "todo-1", UserID: "owner", AssigneeID: strPtr("user-1")},
+			fileName:        `C:\Users\alice\notes.txt`,
+			content:         []byte("meeting notes"),
+			wantContentType: "text/plain; charset=utf-8",
+			wantFileName:    "notes.txt",
+		},
+		{
+			name: "success - member with edit access",
+			todo: &model.Todo{
+				ID:     "todo-1",
+				UserID: "owner",
+				Shares: []*model.TodoShare{{UserID: "user-1", Permission: model.TodoPermissionEdit}},
+			},
+			fileName:        "screenshot.png",
+			content:         pngHeader,
+			wantContentType: "image/png",
+			wantFileName:    "screenshot.png",
+		},
+		{
+			name: "fail - member with read access",
+			todo: &model.Todo{
+				ID:     "todo-1",
+				UserID: "owner",
+				Shares: []*model.TodoShare{{UserID: "user-1", Permission: model.TodoPermissionRead}},
+			},
+			fileName:    "screenshot.png",
+			content:     pngHeader,
+			wantErr:     true,
+			errContains: "not allowed to attach",
+		},
+		{
+			name:        "fail - public todo of someone else",
+			todo:        &model.Todo{ID: "todo-1", UserID: "owner", IsPublic: true},
+			fileName:    "screenshot.png",
+			content:     pngHeader,
+			wantErr:     true,
+			errContains: "not allowed to attach",
+		},
+		{
+			name:        "fail - html is sniffed and rejected whatever the name",
+			todo:        &model.Todo{ID: "todo-1", UserID: "user-1"},
+			fileName:    "image.png",
+			content:     []byte("<html><script>alert(1)</script></html>"),
+			wantErr:     true,
+			errContains: "not allowed",
+		},
+		{
+			name:        "fail - empty file",
+			todo:        &model.Todo{ID: "todo-1", UserID: "user-1"},
+			fileName:    "empty.txt",
+			content:     []byte{},
+			wantErr:     true,
+			errContains: "empty",
+		},
+		{
+			name:        "fail - larger than the limit",
+			todo:        &model.Todo{ID: "todo-1", UserID: "user-1"},
+			fileName:    "big.txt",
+			content:     []byte("x"),
+			size:        1025,
+			wantErr:     true,
+			errContains: "at most 1024 bytes",
+		},
+		{
+			name:        "fail - too many attachments",
+			todo:        &model.Todo{ID: "todo-1", UserID: "user-1"},
+			fileName:    "screenshot.png",
+			content:     pngHeader,
+			existing:    maxAttachmentsPerTodo,
+			wantErr:     true,
+			errContains: "at most 20 attachments",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			attachmentRepo := mock_repository.NewMockIAttachmentRepository(ctrl)
+			todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+			blobStore := mock_pkg.NewMockIBlobStore(ctrl)
+			uuidGen := mock_pkg.NewMockIUUIDGenerator(ctrl)
+
+			size := tt.size
+			if size == 0 {
+				size = int64(len(tt.content))
+			}
+
+			todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(tt.todo, nil)
+			if tt.existing > 0 {
+				attachmentRepo.EXPECT().FindByTodoID(gomock.Any(), "todo-1").Return(make([]*model.Attachment, tt.existing), nil)
+			}
+
+			var stored []byte
+			if !tt.wantErr {
+				attachmentRepo.EXPECT().FindByTodoID(gomock.Any(), "todo-1").Return(nil, nil)
+				uuidGen.EXPECT().Generate().Return("attachment-1")
+				blobStore.EXPECT().
+					Put(gomock.Any(), "tenant-1/attachment-1", gomock.Any(), size, tt.wantContentType).
+					DoAndReturn(func(_ context.Context, _ string, r io.Reader, _ int64, _ string) error {
+						var err error
+						stored, err = io.ReadAll(r)
+						return err
+					})
+				attachmentRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, a *model.Attachment) (*model.Attachment, error) {
+						return a, nil
+					})
+			}
+
+			interactor := NewAttachmentInteractor(attachmentRepo, todoRepo, blobStore, uuidGen, AttachmentLimits{MaxBytes: 1024})
+
+			result, err := interactor.UploadAttachment(context.Background(), &input.UploadAttachmentInput{
+				TodoID:   "todo-1",
+				UserID:   "user-1",
+				TenantID: "tenant-1",
+				FileName: tt.fileName,
+				Size:     size,
+				Content:  bytes.NewReader(tt.content),
+			})
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "attachment-1", result.ID)
+			assert.Equal(t, tt.wantFileName, result.FileName)
+			assert.Equal(t, tt.wantContentType, result.ContentType)
+			assert.Equal(t, "user-1", result.UploadedBy)
+			// The sniffed head is put back in front of the rest of the file
+			assert.Equal(t, tt.content, stored)
+		})
+	}
+}
+
+func TestAttachmentInteractor_UploadAttachment_RemovesBlobWhenCreateFails(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	attachmentRepo := mock_repository.NewMockIAttachmentRepository(ctrl)
+	todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+	blobStore := mock_pkg.NewMockIBlobStore(ctrl)
+	uuidGen := mock_pkg.NewMockIUUIDGenerator(ctrl)
+
+	todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(&model.Todo{ID: "todo-1", UserID: "user-1"}, nil)
+	attachmentRepo.EXPECT().FindByTodoID(gomock.Any(), "todo-1").Return(nil, nil)
+	uuidGen.EXPECT().Generate().Return("attachment-1")
+	blobStore.EXPECT().Put(gomock.Any(), "tenant-1/attachment-1", gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	attachmentRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil, errors.New("db down"))
+	blobStore.EXPECT().Delete(gomock.Any(), "tenant-1/attachment-1").Return(nil)
+
+	interactor := NewAttachmentInteractor(attachmentRepo, todoRepo, blobStore, uuidGen, AttachmentLimits{MaxBytes: 1024})
+
+	_, err := interactor.UploadAttachment(context.Background(), &input.UploadAttachmentInput{
+		TodoID:   "todo-1",
+		UserID:   "user-1",
+		TenantID: "tenant-1",
+		FileName: "notes.txt",
+		Size:     5,
+		Content:  strings.NewReader("notes"),
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to create attachment")
+}
+
+func TestAttachmentInteractor_GetAttachmentContent(t *testing.T) {
+	t.Parallel()
+
+	attachment := &model.Attachment{ID: "attachment-1", TodoID: "todo-1", UploadedBy: "owner", FileName: "notes.txt", ContentType: "text/plain; charset=utf-8", Size: 5, StorageKey: "tenant-1/attachment-1"}
+
+	tests := []struct {
+		name        string
+		todo        *model.Todo
+		attachment  *model.Attachment
+		blobErr     error
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:       "success - public todo of someone else",
+			todo:       &model.Todo{ID: "todo-1", UserID: "owner", IsPublic: true},
+			attachment: attachment,
+		},
+		{
+			name:        "fail - private todo of someone else",
+			todo:        &model.Todo{ID: "todo-1", UserID: "owner"},
+			wantErr:     true,
+			errContains: "not allowed",
+		},
+		{
+			name:        "fail - attachment of another todo",
+			todo:        &model.Todo{ID: "todo-1", UserID: "user-1"},
+			attachment:  &model.Attachment{ID: "attachment-1", TodoID: "todo-2"},
+			wantErr:     true,
+			errContains: "attachment not found",
+		},
+		{
+			name:        "fail - blob is missing",
+			todo:        &model.Todo{ID: "todo-1", UserID: "user-1"},
+			attachment:  attachment,
+			blobErr:     pkg.ErrBlobNotFound,
+			wantErr:     true,
+			errContains: "content not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			attachmentRepo := mock_repository.NewMockIAttachmentRepository(ctrl)
+			todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+			blobStore := mock_pkg.NewMockIBlobStore(ctrl)
+			uuidGen := mock_pkg.NewMockIUUIDGenerator(ctrl)
+
+			todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(tt.todo, nil)
+			if tt.attachment != nil {
+				attachmentRepo.EXPECT().FindByID(gomock.Any(), "attachment-1").Return(tt.attachment, nil)
+			}
+			if tt.attachment != nil && tt.attachment.TodoID == "todo-1" {
+				if tt.blobErr != nil {
+					blobStore.EXPECT().Get(gomock.Any(), "tenant-1/attachment-1").Return(nil, tt.blobErr)
+				} else {
+					blobStore.EXPECT().Get(gomock.Any(), "tenant-1/attachment-1").Return(io.NopCloser(strings.NewReader("notes")), nil)
+				}
+			}
+
+			interactor := NewAttachmentInteractor(attachmentRepo, todoRepo, blobStore, uuidGen, AttachmentLimits{MaxBytes: 1024})
+
+			result, err := interactor.GetAttachmentContent(context.Background(), &input.GetAttachmentContentInput{
+				TodoID:       "todo-1",
+				AttachmentID: "attachment-1",
+				UserID:       "user-1",
+			})
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+			defer result.Content.Close()
+			body, err := io.ReadAll(result.Content)
+			require.NoError(t, err)
+			assert.Equal(t, "notes", string(body))
+			assert.Equal(t, "notes.txt", result.Attachment.FileName)
+		})
+	}
+}
+
+func TestAttachmentInteractor_DeleteAttachment(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		userID     string
+		todo       *model.Todo
+		wantDelete bool
+	}{
+		{
+			name:       "todo owner deletes someone else's upload",
+			userID:     "owner",
+			todo:       &model.Todo{ID: "todo-1", UserID: "owner"},
+			wantDelete: true,
+		},
+		{
+			name:       "uploader deletes their own upload",
+			userID:     "assignee",
+			todo:       &model.Todo{ID: "todo-1", UserID: "owner", AssigneeID: strPtr("assignee")},
+			wantDelete: true,
+		},
+		{
+			name:   "other viewer cannot delete",
+			userID: "viewer",
+			todo:   &model.Todo{ID: "todo-1", UserID: "owner", IsPublic: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			attachmentRepo := mock_repository.NewMockIAttachmentRepository(ctrl)
+			todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+			blobStore := mock_pkg.NewMockIBlobStore(ctrl)
+			uuidGen := mock_pkg.NewMockIUUIDGenerator(ctrl)
+
+			todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(tt.todo, nil)
+			attachmentRepo.EXPECT().
+				FindByID(gomock.Any(), "attachment-1").
+				Return(&model.Attachment{ID: "attachment-1", TodoID: "todo-1", UploadedBy: "assignee"}, nil)
+			if tt.wantDelete {
+				attachmentRepo.EXPECT().Delete(gomock.Any(), "attachment-1").Return(nil)
+			}
+
+			interactor := NewAttachmentInteractor(attachmentRepo, todoRepo, blobStore, uuidGen, AttachmentLimits{MaxBytes: 1024})
+
+			err := interactor.DeleteAttachment(context.Background(), &input.DeleteAttachmentInput{
+				TodoID:       "todo-1",
+				AttachmentID: "attachment-1",
+				UserID:       tt.userID,
+			})
+
+			if !tt.wantDelete {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "not allowed")
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestBlobCleaner_RunOnce(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tenantRepo := mock_repository.NewMockITenantRepository(ctrl)
+	attachmentRepo := mock_repository.NewMockIAttachmentRepository(ctrl)
+	blobStore := mock_pkg.NewMockIBlobStore(ctrl)
+
+	tenantRepo.EXPECT().ListIDs(gomock.Any()).Return([]string{"tenant-1", "tenant-2"}, nil)
+	attachmentRepo.EXPECT().PendingBlobDeletions(gomock.Any(), "tenant-1", 10).Return([]string{"tenant-1/a", "tenant-1/b"}, nil)
+	attachmentRepo.EXPECT().PendingBlobDeletions(gomock.Any(), "tenant-2", 10).Return(nil, nil)
+	blobStore.EXPECT().Delete(gomock.Any(), "tenant-1/a").Return(nil)
+	blobStore.EXPECT().Delete(gomock.Any(), "tenant-1/b").Return(errors.New("timeout"))
+	// The failed key stays queued for the next run
+	attachmentRepo.EXPECT().CompleteBlobDeletions(gomock.Any(), "tenant-1", []string{"tenant-1/a"}).Return(nil)
+	attachmentRepo.EXPECT().CompleteBlobDeletions(gomock.Any(), "tenant-2", []string{}).Return(nil)
+
+	cleaner := NewBlobCleaner(tenantRepo, attachmentRepo, blobStore, 10)
+
+	deleted, err := cleaner.RunOnce(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "tenant-1/b")
+	assert.Equal(t, 1, deleted)
+}
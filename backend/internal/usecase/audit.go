@@ -0,0 +1,140 @@
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_usecase
+package usecase
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/pkg"
+	"good-todo-go/internal/pkg/cerror"
+	"good-todo-go/internal/usecase/input"
+	"good-todo-go/internal/usecase/output"
+)
+
+type IAuditEventInteractor interface {
+	// ListEvents returns the tenant's audit log. Only tenant admins can read it.
+	ListEvents(ctx context.Context, in *input.ListAuditEventsInput) (*output.AuditEventListOutput, error)
+	// ListAllEvents returns the audit log of every tenant. It does no role
+	// check; the admin API authenticates its callers itself.
+	ListAllEvents(ctx context.Context, in *input.ListAllAuditEventsInput) (*output.AuditEventListOutput, error)
+}
+
+type AuditEventInteractor struct {
+	auditRepo repository.IAuditEventRepository
+}
+
+func NewAuditEventInteractor(auditRepo repository.IAuditEventRepository) IAuditEventInteractor {
+	return &AuditEventInteractor{
+		auditRepo: auditRepo,
+	}
+}
+
+func (i *AuditEventInteractor) ListEvents(ctx context.Context, in *input.ListAuditEventsInput) (*output.AuditEventListOutput, error) {
+	if in.Role != "admin" {
+		return nil, cerror.NewForbidden("only tenant admins can read the audit log", nil)
+	}
+
+	filter := &model.AuditEventFilter{
+		ActorID:  in.ActorID,
+		Action:   in.Action,
+		TargetID: in.TargetID,
+		From:     in.From,
+		To:       in.To,
+	}
+
+	events, total, err := i.auditRepo.List(ctx, filter, in.Offset, auditPageLimit(in.Limit))
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to list audit events", err)
+	}
+
+	return output.NewAuditEventListOutput(events, total), nil
+}
+
+func (i *AuditEventInteractor) ListAllEvents(ctx context.Context, in *input.ListAllAuditEventsInput) (*output.AuditEventListOutput, error) {
+	filter := &model.AuditEventFilter{
+		TenantID: in.TenantID,
+		ActorID:  in.ActorID,
+		Action:   in.Action,
+		TargetID: in.TargetID,
+		From:     in.From,
+		To:       in.To,
+	}
+
+	events, total, err := i.auditRepo.ListAll(ctx, filter, in.Offset, auditPageLimit(in.Limit))
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to list audit events", err)
+	}
+
+	return output.NewAuditEventListOutput(events, total), nil
+}
+
+// auditPageLimit applies the default and maximum page size of audit listings
+func auditPageLimit(limit int) int {
+	if limit <= 0 {
+		return 50
+	}
+	if limit > 200 {
+		return 200
+	}
+	return limit
+}
+
+// AuditLogger appends security-relevant events to the tenant's audit log.
+// Recording is best effort: a failure is logged and never fails the action
+// being audited, so an outage of the log cannot lock users out.
+type AuditLogger struct {
+	auditRepo repository.IAuditEventRepository
+	uuidGen   pkg.IUUIDGenerator
+}
+
+func NewAuditLogger(auditRepo repository.IAuditEventRepository, uuidGen pkg.IUUIDGenerator) *AuditLogger {
+	return &AuditLogger{
+		auditRepo: auditRepo,
+		uuidGen:   uuidGen,
+	}
+}
+
+// Record stores the event with the request's IP, user agent and request ID
+func (l *AuditLogger) Record(ctx context.Context, event *model.AuditEvent) {
+	info := pkg.RequestInfoFrom(ctx)
+	event.ID = l.uuidGen.Generate()
+	event.IP = info.IP
+	event.UserAgent = info.UserAgent
+	event.RequestID = info.RequestID
+	event.CreatedAt = time.Now().UTC()
+
+	if err := l.auditRepo.Create(ctx, event); err != nil {
+		log.Printf("failed to record audit event %s: %v", event.Action, err)
+	}
+}
+
+// userEvent builds an event performed by a signed-in user on a user account
+func userEvent(tenantID, actorID, action, targetUserID string, metadata map[string]string) *model.AuditEvent {
+	targetType := model.AuditTargetUser
+	return &model.AuditEvent{
+		TenantID:   tenantID,
+		ActorType:  model.AuditActorUser,
+		ActorID:    &actorID,
+		Action:     action,
+		TargetType: &targetType,
+		TargetID:   &targetUserID,
+		Metadata:   metadata,
+	}
+}
+
+// tenantEvent builds an administrative event performed by a user on the tenant itself
+func tenantEvent(tenantID, actorID, action string, metadata map[string]string) *model.AuditEvent {
+	targetType := model.AuditTargetTenant
+	return &model.AuditEvent{
+		TenantID:   tenantID,
+		ActorType:  model.AuditActorUser,
+		ActorID:    &actorID,
+		Action:     action,
+		TargetType: &targetType,
+		TargetID:   &tenantID,
+		Metadata:   metadata,
+	}
+}
@@ -0,0 +1,316 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	mock_repository "good-todo-go/internal/domain/repository/mock"
+	"good-todo-go/internal/pkg"
+	mock_pkg "good-todo-go/internal/pkg/mock"
+	"good-todo-go/internal/pkg/passwordpolicy"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// newNopAuditLogger returns an AuditLogger that accepts and discards any event
+func newNopAuditLogger(ctrl *gomock.Controller) *AuditLogger {
+	auditRepo := mock_repository.NewMockIAuditEventRepository(ctrl)
+	auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	return NewAuditLogger(auditRepo, pkg.NewUUIDGenerator())
+}
+
+// newRecordingAuditLogger returns an AuditLogger that keeps every event it is given
+func newRecordingAuditLogger(ctrl *gomock.Controller) (*AuditLogger, *[]*model.AuditEvent) {
+	events := &[]*model.AuditEvent{}
+	auditRepo := mock_repository.NewMockIAuditEventRepository(ctrl)
+	auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, e *model.AuditEvent) error {
+			*events = append(*events, e)
+			return nil
+		}).
+		AnyTimes()
+	return NewAuditLogger(auditRepo, pkg.NewUUIDGenerator()), events
+}
+
+func TestAuditLogger_Record(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success - request info is attached", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		logger, events := newRecordingAuditLogger(ctrl)
+		ctx := pkg.WithRequestInfo(context.Background(), pkg.RequestInfo{
+			IP:        "203.0.113.7",
+			UserAgent: "test-agent",
+			RequestID: "request-id",
+		})
+
+		logger.Record(ctx, userEvent("tenant-id", "user-id", model.AuditActionPasswordChanged, "user-id", nil))
+
+		require.Len(t, *events, 1)
+		event := (*events)[0]
+		assert.NotEmpty(t, event.ID)
+		assert.Equal(t, "203.0.113.7", event.IP)
+		assert.Equal(t, "test-agent", event.UserAgent)
+		assert.Equal(t, "request-id", event.RequestID)
+		assert.False(t, event.CreatedAt.IsZero())
+	})
+
+	t.Run("success - a storage failure is swallowed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		auditRepo := mock_repository.NewMockIAuditEventRepository(ctrl)
+		auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("database error"))
+		logger := NewAuditLogger(auditRepo, pkg.NewUUIDGenerator())
+
+		assert.NotPanics(t, func() {
+			logger.Record(context.Background(), userEvent("tenant-id", "user-id", model.AuditActionPasswordChanged, "user-id", nil))
+		})
+	})
+}
+
+func TestAuditEventInteractor_ListEvents(t *testing.T) {
+	t.Parallel()
+
+	action := model.AuditActionLoginFailed
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		input       *input.ListAuditEventsInput
+		setupMocks  func(auditRepo *mock_repository.MockIAuditEventRepository)
+		wantTotal   int
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name: "success - admin lists filtered events",
+			input: &input.ListAuditEventsInput{
+				TenantID: "tenant-id",
+				Role:     "admin",
+				Action:   &action,
+				From:     &from,
+				Limit:    1000,
+			},
+			setupMocks: func(auditRepo *mock_repository.MockIAuditEventRepository) {
+				auditRepo.EXPECT().
+					List(gomock.Any(), &model.AuditEventFilter{Action: &action, From: &from}, 0, 200).
+					Return([]*model.AuditEvent{{ID: "event-id", Action: action}}, 1, nil)
+			},
+			wantTotal: 1,
+		},
+		{
+			name: "fail - member cannot read the audit log",
+			input: &input.ListAuditEventsInput{
+				TenantID: "tenant-id",
+				Role:     "member",
+			},
+			setupMocks:  func(auditRepo *mock_repository.MockIAuditEventRepository) {},
+			wantErr:     true,
+			errContains: "only tenant admins",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			auditRepo := mock_repository.NewMockIAuditEventRepository(ctrl)
+			tt.setupMocks(auditRepo)
+
+			interactor := NewAuditEventInteractor(auditRepo)
+
+			result, err := interactor.ListEvents(context.Background(), tt.input)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				if tt.errContains != "" {
+					assert.Contains(t, err.Error(), tt.errContains)
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantTotal, result.Total)
+			assert.Len(t, result.Events, tt.wantTotal)
+		})
+	}
+}
+
+func TestAuditEventInteractor_ListAllEvents(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tenantID := "tenant-id"
+	auditRepo := mock_repository.NewMockIAuditEventRepository(ctrl)
+	auditRepo.EXPECT().
+		ListAll(gomock.Any(), &model.AuditEventFilter{TenantID: &tenantID}, 10, 50).
+		Return([]*model.AuditEvent{{ID: "event-id", TenantID: tenantID}}, 11, nil)
+
+	interactor := NewAuditEventInteractor(auditRepo)
+
+	result, err := interactor.ListAllEvents(context.Background(), &input.ListAllAuditEventsInput{
+		TenantID: &tenantID,
+		Offset:   10,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 11, result.Total)
+	require.Len(t, result.Events, 1)
+	assert.Equal(t, tenantID, result.Events[0].TenantID)
+}
+
+func TestAuthInteractor_Register_AuditTrail(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	authRepo := mock_repository.NewMockIAuthRepository(ctrl)
+	authRepo.EXPECT().FindTenantBySlug(gomock.Any(), "new-tenant").Return(nil, errors.New("not found"))
+	authRepo.EXPECT().CreateTenant(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, t *model.Tenant) (*model.Tenant, error) { return t, nil })
+	authRepo.EXPECT().FindUserByEmail(gomock.Any(), gomock.Any(), "test@example.com").Return(nil, errors.New("not found"))
+	authRepo.EXPECT().CreateUser(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, u *model.User) (*model.User, error) { return u, nil })
+
+	logger, events := newRecordingAuditLogger(ctrl)
+	interactor := NewAuthInteractor(authRepo, pkg.NewJWTService("test-secret", 3600, 86400), pkg.NewUUIDGenerator(), passwordpolicy.NewPolicy(8, 3), mock_pkg.NewMockIMailer(ctrl), pkg.NewLinkBuilder("http://localhost:3000"), logger)
+
+	_, err := interactor.Register(context.Background(), &input.RegisterInput{
+		Email:      "test@example.com",
+		Password:   "velvet-Orbit-canyon-93",
+		Name:       "Test User",
+		TenantSlug: "new-tenant",
+	})
+	require.NoError(t, err)
+
+	require.Len(t, *events, 2)
+	assert.Equal(t, model.AuditActionTenantCreated, (*events)[0].Action)
+	assert.Equal(t, "new-tenant", (*events)[0].Metadata["slug"])
+	assert.Equal(t, model.AuditActionUserCreated, (*events)[1].Action)
+	assert.Equal(t, map[string]string{"source": "signup", "email": "test@example.com", "role": "admin"}, (*events)[1].Metadata)
+}
+
+func TestAuthInteractor_Login_AuditTrail(t *testing.T) {
+	t.Parallel()
+
+	passwordHash, _ := pkg.HashPassword("password123")
+	tenant := &model.Tenant{ID: "tenant-id", Slug: "test-tenant"}
+	user := &model.User{
+		ID:           "user-id",
+		TenantID:     "tenant-id",
+		Email:        "test@example.com",
+		PasswordHash: passwordHash,
+		Role:         "member",
+		IsActive:     true,
+	}
+
+	tests := []struct {
+		name       string
+		password   string
+		findUser   func() (*model.User, error)
+		wantAction string
+		wantActor  string
+		wantReason string
+	}{
+		{
+			name:       "login success",
+			password:   "password123",
+			findUser:   func() (*model.User, error) { return user, nil },
+			wantAction: model.AuditActionLoginSucceeded,
+			wantActor:  model.AuditActorUser,
+		},
+		{
+			name:       "wrong password",
+			password:   "wrong-password",
+			findUser:   func() (*model.User, error) { return user, nil },
+			wantAction: model.AuditActionLoginFailed,
+			wantActor:  model.AuditActorAnonymous,
+			wantReason: "wrong_password",
+		},
+		{
+			name:       "unknown user",
+			password:   "password123",
+			findUser:   func() (*model.User, error) { return nil, errors.New("not found") },
+			wantAction: model.AuditActionLoginFailed,
+			wantActor:  model.AuditActorAnonymous,
+			wantReason: "unknown_user",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authRepo := mock_repository.NewMockIAuthRepository(ctrl)
+			authRepo.EXPECT().FindTenantBySlug(gomock.Any(), "test-tenant").Return(tenant, nil)
+			authRepo.EXPECT().FindUserByEmail(gomock.Any(), "tenant-id", "test@example.com").Return(tt.findUser())
+
+			logger, events := newRecordingAuditLogger(ctrl)
+			jwtService := pkg.NewJWTService("test-secret", 3600, 86400)
+			interactor := NewAuthInteractor(authRepo, jwtService, mock_pkg.NewMockIUUIDGenerator(ctrl), passwordpolicy.NewPolicy(8, 3), mock_pkg.NewMockIMailer(ctrl), pkg.NewLinkBuilder("http://localhost:3000"), logger)
+
+			_, _ = interactor.Login(context.Background(), &input.LoginInput{
+				Email:      "test@example.com",
+				Password:   tt.password,
+				TenantSlug: "test-tenant",
+			})
+
+			require.Len(t, *events, 1)
+			event := (*events)[0]
+			assert.Equal(t, "tenant-id", event.TenantID)
+			assert.Equal(t, tt.wantAction, event.Action)
+			assert.Equal(t, tt.wantActor, event.ActorType)
+			assert.Equal(t, tt.wantReason, event.Metadata["reason"])
+		})
+	}
+}
+
+func TestScimInteractor_PatchUser_AuditTrail(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepo := mock_repository.NewMockIUserRepository(ctrl)
+	userRepo.EXPECT().FindByID(gomock.Any(), "user-id").Return(&model.User{
+		ID:       "user-id",
+		TenantID: "tenant-id",
+		Email:    "test@example.com",
+		Role:     "member",
+		IsActive: true,
+	}, nil)
+	userRepo.EXPECT().List(gomock.Any(), gomock.Any(), 0, 1).Return([]*model.User{}, 0, nil)
+	userRepo.EXPECT().UpdateAccount(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, u *model.User) (*model.User, error) { return u, nil })
+
+	logger, events := newRecordingAuditLogger(ctrl)
+	interactor := NewScimInteractor(userRepo, mock_repository.NewMockITenantRepository(ctrl), mock_pkg.NewMockIUUIDGenerator(ctrl), passwordpolicy.NewPolicy(8, 3), logger)
+
+	_, err := interactor.PatchUser(context.Background(), &input.PatchScimUserInput{
+		UserID: "user-id",
+		Operations: []input.ScimPatchOperation{
+			{Op: "replace", Path: "roles", Value: []interface{}{map[string]interface{}{"value": "admin"}}},
+			{Op: "replace", Path: "active", Value: false},
+		},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, *events, 2)
+	assert.Equal(t, model.AuditActionRoleChanged, (*events)[0].Action)
+	assert.Equal(t, map[string]string{"from": "member", "to": "admin"}, (*events)[0].Metadata)
+	assert.Equal(t, model.AuditActorScim, (*events)[0].ActorType)
+	assert.Equal(t, model.AuditActionUserDeactivated, (*events)[1].Action)
+}
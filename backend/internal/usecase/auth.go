@@ -4,7 +4,9 @@ package usecase
 import (
 	"context"
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/hex"
+	"fmt"
 	"log"
 	"time"
 
@@ -12,6 +14,7 @@ import (
 	"good-todo-go/internal/domain/repository"
 	"good-todo-go/internal/pkg"
 	"good-todo-go/internal/pkg/cerror"
+	"good-todo-go/internal/pkg/passwordpolicy"
 	"good-todo-go/internal/usecase/input"
 	"good-todo-go/internal/usecase/output"
 )
@@ -21,30 +24,62 @@ type IAuthInteractor interface {
 	Login(ctx context.Context, in *input.LoginInput) (*output.AuthOutput, error)
 	VerifyEmail(ctx context.Context, in *input.VerifyEmailInput) (*output.VerifyEmailOutput, error)
 	RefreshToken(ctx context.Context, in *input.RefreshTokenInput) (*output.AuthOutput, error)
+	ChangePassword(ctx context.Context, in *input.ChangePasswordInput) error
+	RequestMagicLink(ctx context.Context, in *input.RequestMagicLinkInput) error
+	ConsumeMagicLink(ctx context.Context, in *input.ConsumeMagicLinkInput) (*output.AuthOutput, error)
+	RequestPasswordReset(ctx context.Context, in *input.RequestPasswordResetInput) error
+	ResetPassword(ctx context.Context, in *input.ResetPasswordInput) error
 }
 
+// magicLinkTTL is kept short because the link is a bearer credential sitting in an inbox
+const magicLinkTTL = 15 * time.Minute
+
+// passwordResetTTL is longer than magicLinkTTL as choosing a new password takes a while
+const passwordResetTTL = time.Hour
+
 type AuthInteractor struct {
-	authRepo   repository.IAuthRepository
-	jwtService *pkg.JWTService
-	uuidGen    pkg.IUUIDGenerator
+	authRepo       repository.IAuthRepository
+	jwtService     *pkg.JWTService
+	uuidGen        pkg.IUUIDGenerator
+	passwordPolicy *passwordpolicy.Policy
+	mailer         pkg.IMailer
+	linkBuilder    *pkg.LinkBuilder
+	auditLogger    *AuditLogger
 }
 
 func NewAuthInteractor(
 	authRepo repository.IAuthRepository,
 	jwtService *pkg.JWTService,
 	uuidGen pkg.IUUIDGenerator,
+	passwordPolicy *passwordpolicy.Policy,
+	mailer pkg.IMailer,
+	linkBuilder *pkg.LinkBuilder,
+	auditLogger *AuditLogger,
 ) IAuthInteractor {
 	return &AuthInteractor{
-		authRepo:   authRepo,
-		jwtService: jwtService,
-		uuidGen:    uuidGen,
+		authRepo:       authRepo,
+		jwtService:     jwtService,
+		uuidGen:        uuidGen,
+		passwordPolicy: passwordPolicy,
+		mailer:         mailer,
+		linkBuilder:    linkBuilder,
+		auditLogger:    auditLogger,
 	}
 }
 
 func (i *AuthInteractor) Register(ctx context.Context, in *input.RegisterInput) (*output.AuthOutput, error) {
-	// Find or create tenant
+	// Validate password before anything is created
+	if err := i.validatePassword(in.Password, in.Email, in.Name, in.TenantSlug); err != nil {
+		return nil, err
+	}
+
+	// Find or create tenant. Whoever creates the tenant becomes its first admin.
+	role := "member"
+	tenantCreated := false
 	tenant, err := i.authRepo.FindTenantBySlug(ctx, in.TenantSlug)
 	if err != nil {
+		role = "admin"
+		tenantCreated = true
 		// Create new tenant if not exists
 		tenant = &model.Tenant{
 			ID:   i.uuidGen.Generate(),
@@ -84,8 +119,9 @@ func (i *AuthInteractor) Register(ctx context.Context, in *input.RegisterInput)
 		Email:                      in.Email,
 		PasswordHash:               passwordHash,
 		Name:                       in.Name,
-		Role:                       "member",
+		Role:                       role,
 		EmailVerified:              false,
+		IsActive:                   true,
 		VerificationToken:          &verificationToken,
 		VerificationTokenExpiresAt: &tokenExpiry,
 	}
@@ -94,6 +130,16 @@ func (i *AuthInteractor) Register(ctx context.Context, in *input.RegisterInput)
 	if err != nil {
 		return nil, cerror.NewInternalServerError("failed to create user", err)
 	}
+	if tenantCreated {
+		i.auditLogger.Record(ctx, tenantEvent(tenant.ID, user.ID, model.AuditActionTenantCreated, map[string]string{
+			"slug": tenant.Slug,
+		}))
+	}
+	i.auditLogger.Record(ctx, userEvent(user.TenantID, user.ID, model.AuditActionUserCreated, user.ID, map[string]string{
+		"source": "signup",
+		"email":  user.Email,
+		"role":   user.Role,
+	}))
 
 	// Generate JWT tokens
 	tokenPair, err := i.jwtService.GenerateTokenPair(user.ID, user.TenantID, user.Email, user.Role)
@@ -122,19 +168,29 @@ func (i *AuthInteractor) Login(ctx context.Context, in *input.LoginInput) (*outp
 	// Find user
 	user, err := i.authRepo.FindUserByEmail(ctx, tenant.ID, in.Email)
 	if err != nil {
+		i.auditLogger.Record(ctx, loginFailedEvent(tenant.ID, nil, in.Email, "unknown_user"))
 		return nil, cerror.NewUnauthorized("invalid credentials", nil)
 	}
 
 	// Verify password
 	if !pkg.CheckPasswordHash(in.Password, user.PasswordHash) {
+		i.auditLogger.Record(ctx, loginFailedEvent(tenant.ID, &user.ID, in.Email, "wrong_password"))
 		return nil, cerror.NewUnauthorized("invalid credentials", nil)
 	}
 
+	if !user.IsActive {
+		i.auditLogger.Record(ctx, loginFailedEvent(tenant.ID, &user.ID, in.Email, "account_deactivated"))
+		return nil, cerror.NewForbidden("account is deactivated", nil)
+	}
+
 	// Generate JWT tokens
 	tokenPair, err := i.jwtService.GenerateTokenPair(user.ID, user.TenantID, user.Email, user.Role)
 	if err != nil {
 		return nil, cerror.NewInternalServerError("failed to generate tokens", err)
 	}
+	i.auditLogger.Record(ctx, userEvent(user.TenantID, user.ID, model.AuditActionLoginSucceeded, user.ID, map[string]string{
+		"method": "password",
+	}))
 
 	return &output.AuthOutput{
 		AccessToken:  tokenPair.AccessToken,
@@ -185,11 +241,17 @@ func (i *AuthInteractor) RefreshToken(ctx context.Context, in *input.RefreshToke
 		return nil, cerror.NewUnauthorized("user not found", nil)
 	}
 
+	// Deprovisioned users lose their session once the access token expires
+	if !user.IsActive {
+		return nil, cerror.NewUnauthorized("account is deactivated", nil)
+	}
+
 	// Generate new token pair
 	tokenPair, err := i.jwtService.GenerateTokenPair(user.ID, user.TenantID, user.Email, user.Role)
 	if err != nil {
 		return nil, cerror.NewInternalServerError("failed to generate tokens", err)
 	}
+	i.auditLogger.Record(ctx, userEvent(user.TenantID, user.ID, model.AuditActionTokenRefreshed, user.ID, nil))
 
 	return &output.AuthOutput{
 		AccessToken:  tokenPair.AccessToken,
@@ -200,6 +262,273 @@ func (i *AuthInteractor) RefreshToken(ctx context.Context, in *input.RefreshToke
 	}, nil
 }
 
+func (i *AuthInteractor) ChangePassword(ctx context.Context, in *input.ChangePasswordInput) error {
+	user, err := i.authRepo.FindUserByID(ctx, in.TenantID, in.UserID)
+	if err != nil {
+		return cerror.NewNotFound("user not found", err)
+	}
+
+	if !pkg.CheckPasswordHash(in.CurrentPassword, user.PasswordHash) {
+		return cerror.NewBadRequest("current password is incorrect", nil)
+	}
+
+	tenant, err := i.authRepo.FindTenantByID(ctx, user.TenantID)
+	if err != nil {
+		return cerror.NewInternalServerError("failed to get tenant", err)
+	}
+
+	if err := i.validatePassword(in.NewPassword, user.Email, user.Name, tenant.Slug); err != nil {
+		return err
+	}
+
+	passwordHash, err := pkg.HashPassword(in.NewPassword)
+	if err != nil {
+		return cerror.NewInternalServerError("failed to hash password", err)
+	}
+	user.PasswordHash = passwordHash
+
+	if _, err := i.authRepo.UpdateUser(ctx, user); err != nil {
+		return cerror.NewInternalServerError("failed to update user", err)
+	}
+	i.auditLogger.Record(ctx, userEvent(user.TenantID, user.ID, model.AuditActionPasswordChanged, user.ID, nil))
+
+	return nil
+}
+
+func (i *AuthInteractor) RequestMagicLink(ctx context.Context, in *input.RequestMagicLinkInput) error {
+	// Unknown tenants and emails are not reported, and neither is whether the
+	// tenant allows magic links, so the endpoint cannot be used to enumerate
+	// tenants, their settings or accounts
+	tenant, err := i.authRepo.FindTenantBySlug(ctx, in.TenantSlug)
+	if err != nil || !tenant.Settings.MagicLinkEnabled {
+		return nil
+	}
+
+	user, err := i.authRepo.FindUserByEmail(ctx, tenant.ID, in.Email)
+	if err != nil || !user.IsActive {
+		return nil
+	}
+
+	token, err := generateVerificationToken()
+	if err != nil {
+		return cerror.NewInternalServerError("failed to generate magic link token", err)
+	}
+
+	err = i.authRepo.CreateMagicLinkToken(ctx, &model.MagicLinkToken{
+		ID:        i.uuidGen.Generate(),
+		TenantID:  tenant.ID,
+		UserID:    user.ID,
+		TokenHash: hashToken(token),
+		ExpiresAt: time.Now().Add(magicLinkTTL),
+	})
+	if err != nil {
+		return cerror.NewInternalServerError("failed to create magic link token", err)
+	}
+
+	mail := &pkg.Mail{
+		To:      user.Email,
+		Subject: "Your sign-in link",
+		Body: fmt.Sprintf(
+			"Click the link below to sign in. It expires in %d minutes and can only be used once.\n\n%s\n\nIf you did not request this, you can ignore this email.\n",
+			int(magicLinkTTL.Minutes()),
+			i.linkBuilder.MagicLink(tenant.Slug, token),
+		),
+	}
+	// A delivery failure must not reveal that the account exists
+	if err := i.mailer.Send(ctx, mail); err != nil {
+		log.Printf("failed to send magic link email: %v", err)
+	}
+
+	return nil
+}
+
+func (i *AuthInteractor) ConsumeMagicLink(ctx context.Context, in *input.ConsumeMagicLinkInput) (*output.AuthOutput, error) {
+	// A disabled tenant answers like an invalid token, as RequestMagicLink does
+	tenant, err := i.authRepo.FindTenantBySlug(ctx, in.TenantSlug)
+	if err != nil || !tenant.Settings.MagicLinkEnabled {
+		return nil, cerror.NewUnauthorized("invalid or expired token", nil)
+	}
+
+	token, err := i.authRepo.ConsumeMagicLinkToken(ctx, tenant.ID, hashToken(in.Token), time.Now())
+	if err != nil {
+		i.auditLogger.Record(ctx, loginFailedEvent(tenant.ID, nil, "", "invalid_magic_link"))
+		return nil, cerror.NewUnauthorized("invalid or expired token", nil)
+	}
+
+	user, err := i.authRepo.FindUserByID(ctx, tenant.ID, token.UserID)
+	if err != nil || !user.IsActive {
+		i.auditLogger.Record(ctx, loginFailedEvent(tenant.ID, &token.UserID, "", "account_deactivated"))
+		return nil, cerror.NewUnauthorized("invalid or expired token", nil)
+	}
+
+	// Receiving the link proves ownership of the address
+	if !user.EmailVerified {
+		user.EmailVerified = true
+		user.VerificationToken = nil
+		user.VerificationTokenExpiresAt = nil
+
+		user, err = i.authRepo.UpdateUser(ctx, user)
+		if err != nil {
+			return nil, cerror.NewInternalServerError("failed to update user", err)
+		}
+	}
+
+	tokenPair, err := i.jwtService.GenerateTokenPair(user.ID, user.TenantID, user.Email, user.Role)
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to generate tokens", err)
+	}
+	i.auditLogger.Record(ctx, userEvent(user.TenantID, user.ID, model.AuditActionLoginSucceeded, user.ID, map[string]string{
+		"method": "magic_link",
+	}))
+
+	return &output.AuthOutput{
+		AccessToken:  tokenPair.AccessToken,
+		RefreshToken: tokenPair.RefreshToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    tokenPair.ExpiresIn,
+		User:         output.NewUserOutput(user),
+	}, nil
+}
+
+func (i *AuthInteractor) RequestPasswordReset(ctx context.Context, in *input.RequestPasswordResetInput) error {
+	// Unknown tenants and emails are not reported, like for magic links
+	tenant, err := i.authRepo.FindTenantBySlug(ctx, in.TenantSlug)
+	if err != nil {
+		return nil
+	}
+
+	user, err := i.authRepo.FindUserByEmail(ctx, tenant.ID, in.Email)
+	if err != nil || !user.IsActive {
+		return nil
+	}
+
+	token, err := generateVerificationToken()
+	if err != nil {
+		return cerror.NewInternalServerError("failed to generate password reset token", err)
+	}
+
+	err = i.authRepo.CreatePasswordResetToken(ctx, &model.PasswordResetToken{
+		ID:        i.uuidGen.Generate(),
+		TenantID:  tenant.ID,
+		UserID:    user.ID,
+		TokenHash: hashToken(token),
+		ExpiresAt: time.Now().Add(passwordResetTTL),
+	})
+	if err != nil {
+		return cerror.NewInternalServerError("failed to create password reset token", err)
+	}
+
+	mail := &pkg.Mail{
+		To:      user.Email,
+		Subject: "Reset your password",
+		Body: fmt.Sprintf(
+			"Click the link below to choose a new password. It expires in %d minutes and can only be used once.\n\n%s\n\nIf you did not request this, you can ignore this email.\n",
+			int(passwordResetTTL.Minutes()),
+			i.linkBuilder.PasswordReset(tenant.Slug, token),
+		),
+	}
+	// A delivery failure must not reveal that the account exists
+	if err := i.mailer.Send(ctx, mail); err != nil {
+		log.Printf("failed to send password reset email: %v", err)
+	}
+
+	return nil
+}
+
+func (i *AuthInteractor) ResetPassword(ctx context.Context, in *input.ResetPasswordInput) error {
+	tenant, err := i.authRepo.FindTenantBySlug(ctx, in.TenantSlug)
+	if err != nil {
+		return cerror.NewBadRequest("invalid or expired token", nil)
+	}
+
+	now := time.Now()
+	tokenHash := hashToken(in.Token)
+	token, err := i.authRepo.FindPasswordResetToken(ctx, tenant.ID, tokenHash, now)
+	if err != nil {
+		return cerror.NewBadRequest("invalid or expired token", nil)
+	}
+
+	user, err := i.authRepo.FindUserByID(ctx, tenant.ID, token.UserID)
+	if err != nil || !user.IsActive {
+		return cerror.NewBadRequest("invalid or expired token", nil)
+	}
+
+	// The token is only spent once the password is accepted, so a rejected
+	// password can be retried with the same link
+	if err := i.validatePassword(in.NewPassword, user.Email, user.Name, tenant.Slug); err != nil {
+		return err
+	}
+
+	// A concurrent reset with the same token loses here
+	if _, err := i.authRepo.ConsumePasswordResetToken(ctx, tenant.ID, tokenHash, now); err != nil {
+		return cerror.NewBadRequest("invalid or expired token", nil)
+	}
+
+	passwordHash, err := pkg.HashPassword(in.NewPassword)
+	if err != nil {
+		return cerror.NewInternalServerError("failed to hash password", err)
+	}
+	user.PasswordHash = passwordHash
+
+	// Receiving the link proves ownership of the address
+	if !user.EmailVerified {
+		user.EmailVerified = true
+		user.VerificationToken = nil
+		user.VerificationTokenExpiresAt = nil
+	}
+
+	if _, err := i.authRepo.UpdateUser(ctx, user); err != nil {
+		return cerror.NewInternalServerError("failed to update user", err)
+	}
+	i.auditLogger.Record(ctx, userEvent(user.TenantID, user.ID, model.AuditActionPasswordChanged, user.ID, map[string]string{
+		"method": "reset",
+	}))
+
+	return nil
+}
+
+// validatePassword checks the password against the policy and reports
+// every violated rule so the client can show all of them at once
+func (i *AuthInteractor) validatePassword(password string, userInputs ...string) error {
+	result := i.passwordPolicy.Check(password, userInputs...)
+	if result.OK() {
+		return nil
+	}
+
+	violations := make([]string, len(result.Violations))
+	for idx, v := range result.Violations {
+		violations[idx] = string(v)
+	}
+
+	return cerror.NewValidationError("password does not meet the password policy", map[string]interface{}{
+		"field":      "password",
+		"violations": violations,
+		"score":      result.Score,
+		"min_score":  i.passwordPolicy.MinScore,
+		"min_length": i.passwordPolicy.MinLength,
+	})
+}
+
+// loginFailedEvent records a failed sign-in. The caller is not
+// authenticated, so the account it targeted, if known, is the target.
+func loginFailedEvent(tenantID string, userID *string, email, reason string) *model.AuditEvent {
+	event := &model.AuditEvent{
+		TenantID:  tenantID,
+		ActorType: model.AuditActorAnonymous,
+		Action:    model.AuditActionLoginFailed,
+		Metadata:  map[string]string{"reason": reason},
+	}
+	if email != "" {
+		event.Metadata["email"] = email
+	}
+	if userID != nil {
+		targetType := model.AuditTargetUser
+		event.TargetType = &targetType
+		event.TargetID = userID
+	}
+	return event
+}
+
 func generateVerificationToken() (string, error) {
 	bytes := make([]byte, 32)
 	if _, err := rand.Read(bytes); err != nil {
@@ -207,3 +536,9 @@ func generateVerificationToken() (string, error) {
 	}
 	return hex.EncodeToString(bytes), nil
 }
+
+// hashToken returns the value stored for a token emailed to a user
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -9,7 +9,9 @@ import (
 	"good-todo-go/internal/domain/model"
 	mock_repository "good-todo-go/internal/domain/repository/mock"
 	"good-todo-go/internal/pkg"
+	"good-todo-go/internal/pkg/cerror"
 	mock_pkg "good-todo-go/internal/pkg/mock"
+	"good-todo-go/internal/pkg/passwordpolicy"
 	"good-todo-go/internal/usecase/input"
 
 	"github.com/stretchr/testify/assert"
@@ -31,7 +33,7 @@ func TestAuthInteractor_Register(t *testing.T) {
 			name: "success - new tenant and user",
 			input: &input.RegisterInput{
 				Email:      "test@example.com",
-				Password:   "password123",
+				Password:   "velvet-Orbit-canyon-93",
 				Name:       "Test User",
 				TenantSlug: "test-tenant",
 			},
@@ -58,16 +60,13 @@ func TestAuthInteractor_Register(t *testing.T) {
 
 				uuidGen.EXPECT().Generate().Return("user-uuid-1")
 
+				// The user who creates the tenant becomes its admin
 				authRepo.EXPECT().
 					CreateUser(gomock.Any(), gomock.Any()).
-					Return(&model.User{
-						ID:            "user-uuid-1",
-						TenantID:      "tenant-uuid-1",
-						Email:         "test@example.com",
-						Name:          "Test User",
-						Role:          "member",
-						EmailVerified: false,
-					}, nil)
+					DoAndReturn(func(_ context.Context, u *model.User) (*model.User, error) {
+						assert.Equal(t, "admin", u.Role)
+						return u, nil
+					})
 			},
 			wantErr: false,
 		},
@@ -75,7 +74,7 @@ func TestAuthInteractor_Register(t *testing.T) {
 			name: "success - existing tenant",
 			input: &input.RegisterInput{
 				Email:      "test2@example.com",
-				Password:   "password123",
+				Password:   "velvet-Orbit-canyon-93",
 				Name:       "Test User 2",
 				TenantSlug: "existing-tenant",
 			},
@@ -113,7 +112,7 @@ func TestAuthInteractor_Register(t *testing.T) {
 			name: "fail - email already exists",
 			input: &input.RegisterInput{
 				Email:      "existing@example.com",
-				Password:   "password123",
+				Password:   "velvet-Orbit-canyon-93",
 				Name:       "Existing User",
 				TenantSlug: "test-tenant",
 			},
@@ -137,6 +136,32 @@ func TestAuthInteractor_Register(t *testing.T) {
 			wantErr:     true,
 			errContains: "already exists",
 		},
+		{
+			name: "fail - breached password",
+			input: &input.RegisterInput{
+				Email:      "test@example.com",
+				Password:   "password123",
+				Name:       "Test User",
+				TenantSlug: "test-tenant",
+			},
+			setupMocks: func(authRepo *mock_repository.MockIAuthRepository, uuidGen *mock_pkg.MockIUUIDGenerator) {
+				// Rejected before any tenant or user lookup
+			},
+			wantErr:     true,
+			errContains: "password policy",
+		},
+		{
+			name: "fail - password based on tenant slug",
+			input: &input.RegisterInput{
+				Email:      "test@example.com",
+				Password:   "Acme-Widgets-velvet-93",
+				Name:       "Test User",
+				TenantSlug: "acme-widgets",
+			},
+			setupMocks:  func(authRepo *mock_repository.MockIAuthRepository, uuidGen *mock_pkg.MockIUUIDGenerator) {},
+			wantErr:     true,
+			errContains: "password policy",
+		},
 	}
 
 	for _, tt := range tests {
@@ -150,7 +175,7 @@ func TestAuthInteractor_Register(t *testing.T) {
 
 			tt.setupMocks(authRepo, uuidGen)
 
-			interactor := NewAuthInteractor(authRepo, jwtService, uuidGen)
+			interactor := NewAuthInteractor(authRepo, jwtService, uuidGen, passwordpolicy.NewPolicy(8, 3), mock_pkg.NewMockIMailer(ctrl), pkg.NewLinkBuilder("http://localhost:3000"), newNopAuditLogger(ctrl))
 
 			result, err := interactor.Register(context.Background(), tt.input)
 
@@ -208,10 +233,40 @@ func TestAuthInteractor_Login(t *testing.T) {
 						PasswordHash: passwordHash,
 						Name:         "Test User",
 						Role:         "member",
+						IsActive:     true,
 					}, nil)
 			},
 			wantErr: false,
 		},
+		{
+			name: "fail - deactivated user",
+			input: &input.LoginInput{
+				Email:      "test@example.com",
+				Password:   "password123",
+				TenantSlug: "test-tenant",
+			},
+			setupMocks: func(authRepo *mock_repository.MockIAuthRepository) {
+				authRepo.EXPECT().
+					FindTenantBySlug(gomock.Any(), "test-tenant").
+					Return(&model.Tenant{
+						ID:   "tenant-id",
+						Slug: "test-tenant",
+					}, nil)
+
+				authRepo.EXPECT().
+					FindUserByEmail(gomock.Any(), "tenant-id", "test@example.com").
+					Return(&model.User{
+						ID:           "user-id",
+						TenantID:     "tenant-id",
+						Email:        "test@example.com",
+						PasswordHash: passwordHash,
+						Role:         "member",
+						IsActive:     false,
+					}, nil)
+			},
+			wantErr:     true,
+			errContains: "deactivated",
+		},
 		{
 			name: "fail - tenant not found",
 			input: &input.LoginInput{
@@ -289,7 +344,7 @@ func TestAuthInteractor_Login(t *testing.T) {
 
 			tt.setupMocks(authRepo)
 
-			interactor := NewAuthInteractor(authRepo, jwtService, uuidGen)
+			interactor := NewAuthInteractor(authRepo, jwtService, uuidGen, passwordpolicy.NewPolicy(8, 3), mock_pkg.NewMockIMailer(ctrl), pkg.NewLinkBuilder("http://localhost:3000"), newNopAuditLogger(ctrl))
 
 			result, err := interactor.Login(context.Background(), tt.input)
 
@@ -398,7 +453,7 @@ func TestAuthInteractor_VerifyEmail(t *testing.T) {
 
 			tt.setupMocks(authRepo)
 
-			interactor := NewAuthInteractor(authRepo, jwtService, uuidGen)
+			interactor := NewAuthInteractor(authRepo, jwtService, uuidGen, passwordpolicy.NewPolicy(8, 3), mock_pkg.NewMockIMailer(ctrl), pkg.NewLinkBuilder("http://localhost:3000"), newNopAuditLogger(ctrl))
 
 			result, err := interactor.VerifyEmail(context.Background(), tt.input)
 
@@ -444,10 +499,29 @@ func TestAuthInteractor_RefreshToken(t *testing.T) {
 						TenantID: "tenant-id",
 						Email:    "test@example.com",
 						Role:     "member",
+						IsActive: true,
 					}, nil)
 			},
 			wantErr: false,
 		},
+		{
+			name: "fail - deactivated user",
+			input: &input.RefreshTokenInput{
+				RefreshToken: tokenPair.RefreshToken,
+			},
+			setupMocks: func(authRepo *mock_repository.MockIAuthRepository) {
+				authRepo.EXPECT().
+					FindUserByID(gomock.Any(), "tenant-id", "user-id").
+					Return(&model.User{
+						ID:       "user-id",
+						TenantID: "tenant-id",
+						Role:     "member",
+						IsActive: false,
+					}, nil)
+			},
+			wantErr:     true,
+			errContains: "deactivated",
+		},
 		{
 			name: "fail - invalid refresh token",
 			input: &input.RefreshTokenInput{
@@ -482,7 +556,7 @@ func TestAuthInteractor_RefreshToken(t *testing.T) {
 
 			tt.setupMocks(authRepo)
 
-			interactor := NewAuthInteractor(authRepo, jwtService, uuidGen)
+			interactor := NewAuthInteractor(authRepo, jwtService, uuidGen, passwordpolicy.NewPolicy(8, 3), mock_pkg.NewMockIMailer(ctrl), pkg.NewLinkBuilder("http://localhost:3000"), newNopAuditLogger(ctrl))
 
 			result, err := interactor.RefreshToken(context.Background(), tt.input)
 
@@ -500,3 +574,624 @@ func TestAuthInteractor_RefreshToken(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthInteractor_ChangePassword(t *testing.T) {
+	t.Parallel()
+
+	passwordHash, _ := pkg.HashPassword("velvet-Orbit-canyon-93")
+
+	existingUser := func() *model.User {
+		return &model.User{
+			ID:           "user-id",
+			TenantID:     "tenant-id",
+			Email:        "kazuki@example.com",
+			Name:         "Kazuki",
+			PasswordHash: passwordHash,
+			Role:         "member",
+		}
+	}
+
+	tests := []struct {
+		name        string
+		input       *input.ChangePasswordInput
+		setupMocks  func(authRepo *mock_repository.MockIAuthRepository)
+		wantErr     bool
+		wantCode    cerror.ErrorCode
+		errContains string
+	}{
+		{
+			name: "success - password changed",
+			input: &input.ChangePasswordInput{
+				UserID:          "user-id",
+				TenantID:        "tenant-id",
+				CurrentPassword: "velvet-Orbit-canyon-93",
+				NewPassword:     "amber-Quartz-meadow-57",
+			},
+			setupMocks: func(authRepo *mock_repository.MockIAuthRepository) {
+				authRepo.EXPECT().
+					FindUserByID(gomock.Any(), "tenant-id", "user-id").
+					Return(existingUser(), nil)
+
+				authRepo.EXPECT().
+					FindTenantByID(gomock.Any(), "tenant-id").
+					Return(&model.Tenant{ID: "tenant-id", Slug: "test-tenant"}, nil)
+
+				authRepo.EXPECT().
+					UpdateUser(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, u *model.User) (*model.User, error) {
+						assert.True(t, pkg.CheckPasswordHash("amber-Quartz-meadow-57", u.PasswordHash))
+						return u, nil
+					})
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail - wrong current password",
+			input: &input.ChangePasswordInput{
+				UserID:          "user-id",
+				TenantID:        "tenant-id",
+				CurrentPassword: "wrong-password",
+				NewPassword:     "amber-Quartz-meadow-57",
+			},
+			setupMocks: func(authRepo *mock_repository.MockIAuthRepository) {
+				authRepo.EXPECT().
+					FindUserByID(gomock.Any(), "tenant-id", "user-id").
+					Return(existingUser(), nil)
+			},
+			wantErr:     true,
+			wantCode:    cerror.ErrCodeBadRequest,
+			errContains: "current password is incorrect",
+		},
+		{
+			name: "fail - new password based on email",
+			input: &input.ChangePasswordInput{
+				UserID:          "user-id",
+				TenantID:        "tenant-id",
+				CurrentPassword: "velvet-Orbit-canyon-93",
+				NewPassword:     "kazuki-velvet-orbit",
+			},
+			setupMocks: func(authRepo *mock_repository.MockIAuthRepository) {
+				authRepo.EXPECT().
+					FindUserByID(gomock.Any(), "tenant-id", "user-id").
+					Return(existingUser(), nil)
+
+				authRepo.EXPECT().
+					FindTenantByID(gomock.Any(), "tenant-id").
+					Return(&model.Tenant{ID: "tenant-id", Slug: "test-tenant"}, nil)
+			},
+			wantErr:     true,
+			wantCode:    cerror.ErrCodeValidationError,
+			errContains: "password policy",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authRepo := mock_repository.NewMockIAuthRepository(ctrl)
+			uuidGen := mock_pkg.NewMockIUUIDGenerator(ctrl)
+			jwtService := pkg.NewJWTService("test-secret", 3600, 86400)
+
+			tt.setupMocks(authRepo)
+
+			interactor := NewAuthInteractor(authRepo, jwtService, uuidGen, passwordpolicy.NewPolicy(8, 3), mock_pkg.NewMockIMailer(ctrl), pkg.NewLinkBuilder("http://localhost:3000"), newNopAuditLogger(ctrl))
+
+			err := interactor.ChangePassword(context.Background(), tt.input)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				if tt.errContains != "" {
+					assert.Contains(t, err.Error(), tt.errContains)
+				}
+				var appErr *cerror.AppError
+				require.ErrorAs(t, err, &appErr)
+				assert.Equal(t, tt.wantCode, appErr.Code)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestAuthInteractor_RequestMagicLink(t *testing.T) {
+	t.Parallel()
+
+	enabledTenant := &model.Tenant{
+		ID:       "tenant-id",
+		Slug:     "test-tenant",
+		Settings: model.TenantSettings{MagicLinkEnabled: true},
+	}
+
+	tests := []struct {
+		name        string
+		input       *input.RequestMagicLinkInput
+		setupMocks  func(authRepo *mock_repository.MockIAuthRepository, uuidGen *mock_pkg.MockIUUIDGenerator, mailer *mock_pkg.MockIMailer)
+		wantErr     bool
+		wantCode    cerror.ErrorCode
+		errContains string
+	}{
+		{
+			name: "success - link emailed with hashed token stored",
+			input: &input.RequestMagicLinkInput{
+				Email:      "test@example.com",
+				TenantSlug: "test-tenant",
+			},
+			setupMocks: func(authRepo *mock_repository.MockIAuthRepository, uuidGen *mock_pkg.MockIUUIDGenerator, mailer *mock_pkg.MockIMailer) {
+				authRepo.EXPECT().
+					FindTenantBySlug(gomock.Any(), "test-tenant").
+					Return(enabledTenant, nil)
+
+				authRepo.EXPECT().
+					FindUserByEmail(gomock.Any(), "tenant-id", "test@example.com").
+					Return(&model.User{ID: "user-id", TenantID: "tenant-id", Email: "test@example.com", IsActive: true}, nil)
+
+				uuidGen.EXPECT().Generate().Return("token-uuid")
+
+				var storedHash string
+				authRepo.EXPECT().
+					CreateMagicLinkToken(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, token *model.MagicLinkToken) error {
+						assert.Equal(t, "user-id", token.UserID)
+						assert.WithinDuration(t, time.Now().Add(magicLinkTTL), token.ExpiresAt, time.Minute)
+						storedHash = token.TokenHash
+						return nil
+					})
+
+				mailer.EXPECT().
+					Send(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, mail *pkg.Mail) error {
+						assert.Equal(t, "test@example.com", mail.To)
+						assert.Contains(t, mail.Body, "http://localhost:3000/auth/magic-link?")
+						// Only the hash is persisted, never the emailed token
+						assert.NotContains(t, mail.Body, storedHash)
+						return nil
+					})
+			},
+			wantErr: false,
+		},
+		{
+			name: "success - unknown email sends nothing",
+			input: &input.RequestMagicLinkInput{
+				Email:      "nobody@example.com",
+				TenantSlug: "test-tenant",
+			},
+			setupMocks: func(authRepo *mock_repository.MockIAuthRepository, uuidGen *mock_pkg.MockIUUIDGenerator, mailer *mock_pkg.MockIMailer) {
+				authRepo.EXPECT().
+					FindTenantBySlug(gomock.Any(), "test-tenant").
+					Return(enabledTenant, nil)
+
+				authRepo.EXPECT().
+					FindUserByEmail(gomock.Any(), "tenant-id", "nobody@example.com").
+					Return(nil, errors.New("not found"))
+			},
+			wantErr: false,
+		},
+		{
+			name: "success - mail failure is not reported",
+			input: &input.RequestMagicLinkInput{
+				Email:      "test@example.com",
+				TenantSlug: "test-tenant",
+			},
+			setupMocks: func(authRepo *mock_repository.MockIAuthRepository, uuidGen *mock_pkg.MockIUUIDGenerator, mailer *mock_pkg.MockIMailer) {
+				authRepo.EXPECT().
+					FindTenantBySlug(gomock.Any(), "test-tenant").
+					Return(enabledTenant, nil)
+
+				authRepo.EXPECT().
+					FindUserByEmail(gomock.Any(), "tenant-id", "test@example.com").
+					Return(&model.User{ID: "user-id", TenantID: "tenant-id", Email: "test@example.com", IsActive: true}, nil)
+
+				uuidGen.EXPECT().Generate().Return("token-uuid")
+
+				authRepo.EXPECT().
+					CreateMagicLinkToken(gomock.Any(), gomock.Any()).
+					Return(nil)
+
+				mailer.EXPECT().
+					Send(gomock.Any(), gomock.Any()).
+					Return(errors.New("smtp unavailable"))
+			},
+			wantErr: false,
+		},
+		{
+			name: "success - disabled for tenant is not reported",
+			input: &input.RequestMagicLinkInput{
+				Email:      "test@example.com",
+				TenantSlug: "test-tenant",
+			},
+			setupMocks: func(authRepo *mock_repository.MockIAuthRepository, uuidGen *mock_pkg.MockIUUIDGenerator, mailer *mock_pkg.MockIMailer) {
+				// No user lookup and no email
+				authRepo.EXPECT().
+					FindTenantBySlug(gomock.Any(), "test-tenant").
+					Return(&model.Tenant{ID: "tenant-id", Slug: "test-tenant"}, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authRepo := mock_repository.NewMockIAuthRepository(ctrl)
+			uuidGen := mock_pkg.NewMockIUUIDGenerator(ctrl)
+			mailer := mock_pkg.NewMockIMailer(ctrl)
+			jwtService := pkg.NewJWTService("test-secret", 3600, 86400)
+
+			tt.setupMocks(authRepo, uuidGen, mailer)
+
+			interactor := NewAuthInteractor(authRepo, jwtService, uuidGen, passwordpolicy.NewPolicy(8, 3), mailer, pkg.NewLinkBuilder("http://localhost:3000"), newNopAuditLogger(ctrl))
+
+			err := interactor.RequestMagicLink(context.Background(), tt.input)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				if tt.errContains != "" {
+					assert.Contains(t, err.Error(), tt.errContains)
+				}
+				var appErr *cerror.AppError
+				require.ErrorAs(t, err, &appErr)
+				assert.Equal(t, tt.wantCode, appErr.Code)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestAuthInteractor_ConsumeMagicLink(t *testing.T) {
+	t.Parallel()
+
+	enabledTenant := &model.Tenant{
+		ID:       "tenant-id",
+		Slug:     "test-tenant",
+		Settings: model.TenantSettings{MagicLinkEnabled: true},
+	}
+	verificationToken := "pending-verification-token"
+
+	tests := []struct {
+		name        string
+		input       *input.ConsumeMagicLinkInput
+		setupMocks  func(authRepo *mock_repository.MockIAuthRepository)
+		wantErr     bool
+		wantCode    cerror.ErrorCode
+		errContains string
+	}{
+		{
+			name: "success - login and email verified",
+			input: &input.ConsumeMagicLinkInput{
+				TenantSlug: "test-tenant",
+				Token:      "emailed-token",
+			},
+			setupMocks: func(authRepo *mock_repository.MockIAuthRepository) {
+				authRepo.EXPECT().
+					FindTenantBySlug(gomock.Any(), "test-tenant").
+					Return(enabledTenant, nil)
+
+				authRepo.EXPECT().
+					ConsumeMagicLinkToken(gomock.Any(), "tenant-id", hashToken("emailed-token"), gomock.Any()).
+					Return(&model.MagicLinkToken{ID: "token-id", TenantID: "tenant-id", UserID: "user-id"}, nil)
+
+				authRepo.EXPECT().
+					FindUserByID(gomock.Any(), "tenant-id", "user-id").
+					Return(&model.User{
+						ID:                "user-id",
+						TenantID:          "tenant-id",
+						Email:             "test@example.com",
+						Role:              "member",
+						IsActive:          true,
+						VerificationToken: &verificationToken,
+					}, nil)
+
+				authRepo.EXPECT().
+					UpdateUser(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, u *model.User) (*model.User, error) {
+						assert.True(t, u.EmailVerified)
+						assert.Nil(t, u.VerificationToken)
+						return u, nil
+					})
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail - used or expired token",
+			input: &input.ConsumeMagicLinkInput{
+				TenantSlug: "test-tenant",
+				Token:      "used-token",
+			},
+			setupMocks: func(authRepo *mock_repository.MockIAuthRepository) {
+				authRepo.EXPECT().
+					FindTenantBySlug(gomock.Any(), "test-tenant").
+					Return(enabledTenant, nil)
+
+				authRepo.EXPECT().
+					ConsumeMagicLinkToken(gomock.Any(), "tenant-id", hashToken("used-token"), gomock.Any()).
+					Return(nil, errors.New("not found"))
+			},
+			wantErr:     true,
+			wantCode:    cerror.ErrCodeUnauthorized,
+			errContains: "invalid or expired token",
+		},
+		{
+			name: "fail - disabled for tenant",
+			input: &input.ConsumeMagicLinkInput{
+				TenantSlug: "test-tenant",
+				Token:      "emailed-token",
+			},
+			setupMocks: func(authRepo *mock_repository.MockIAuthRepository) {
+				authRepo.EXPECT().
+					FindTenantBySlug(gomock.Any(), "test-tenant").
+					Return(&model.Tenant{ID: "tenant-id", Slug: "test-tenant"}, nil)
+			},
+			wantErr:     true,
+			wantCode:    cerror.ErrCodeUnauthorized,
+			errContains: "invalid or expired token",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authRepo := mock_repository.NewMockIAuthRepository(ctrl)
+			uuidGen := mock_pkg.NewMockIUUIDGenerator(ctrl)
+			jwtService := pkg.NewJWTService("test-secret", 3600, 86400)
+
+			tt.setupMocks(authRepo)
+
+			interactor := NewAuthInteractor(authRepo, jwtService, uuidGen, passwordpolicy.NewPolicy(8, 3), mock_pkg.NewMockIMailer(ctrl), pkg.NewLinkBuilder("http://localhost:3000"), newNopAuditLogger(ctrl))
+
+			result, err := interactor.ConsumeMagicLink(context.Background(), tt.input)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				if tt.errContains != "" {
+					assert.Contains(t, err.Error(), tt.errContains)
+				}
+				var appErr *cerror.AppError
+				require.ErrorAs(t, err, &appErr)
+				assert.Equal(t, tt.wantCode, appErr.Code)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.NotEmpty(t, result.AccessToken)
+			assert.True(t, result.User.EmailVerified)
+		})
+	}
+}
+
+func TestAuthInteractor_RequestPasswordReset(t *testing.T) {
+	t.Parallel()
+
+	tenant := &model.Tenant{ID: "tenant-id", Slug: "test-tenant"}
+
+	tests := []struct {
+		name       string
+		input      *input.RequestPasswordResetInput
+		setupMocks func(authRepo *mock_repository.MockIAuthRepository, uuidGen *mock_pkg.MockIUUIDGenerator, mailer *mock_pkg.MockIMailer)
+	}{
+		{
+			name: "success - link emailed with hashed token stored",
+			input: &input.RequestPasswordResetInput{
+				Email:      "test@example.com",
+				TenantSlug: "test-tenant",
+			},
+			setupMocks: func(authRepo *mock_repository.MockIAuthRepository, uuidGen *mock_pkg.MockIUUIDGenerator, mailer *mock_pkg.MockIMailer) {
+				authRepo.EXPECT().
+					FindTenantBySlug(gomock.Any(), "test-tenant").
+					Return(tenant, nil)
+
+				authRepo.EXPECT().
+					FindUserByEmail(gomock.Any(), "tenant-id", "test@example.com").
+					Return(&model.User{ID: "user-id", TenantID: "tenant-id", Email: "test@example.com", IsActive: true}, nil)
+
+				uuidGen.EXPECT().Generate().Return("token-uuid")
+
+				var storedHash string
+				authRepo.EXPECT().
+					CreatePasswordResetToken(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, token *model.PasswordResetToken) error {
+						assert.Equal(t, "user-id", token.UserID)
+						assert.WithinDuration(t, time.Now().Add(passwordResetTTL), token.ExpiresAt, time.Minute)
+						storedHash = token.TokenHash
+						return nil
+					})
+
+				mailer.EXPECT().
+					Send(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, mail *pkg.Mail) error {
+						assert.Equal(t, "test@example.com", mail.To)
+						assert.Contains(t, mail.Body, "http://localhost:3000/auth/reset-password?")
+						assert.NotContains(t, mail.Body, storedHash)
+						return nil
+					})
+			},
+		},
+		{
+			name: "success - unknown email sends nothing",
+			input: &input.RequestPasswordResetInput{
+				Email:      "nobody@example.com",
+				TenantSlug: "test-tenant",
+			},
+			setupMocks: func(authRepo *mock_repository.MockIAuthRepository, uuidGen *mock_pkg.MockIUUIDGenerator, mailer *mock_pkg.MockIMailer) {
+				authRepo.EXPECT().
+					FindTenantBySlug(gomock.Any(), "test-tenant").
+					Return(tenant, nil)
+
+				authRepo.EXPECT().
+					FindUserByEmail(gomock.Any(), "tenant-id", "nobody@example.com").
+					Return(nil, errors.New("not found"))
+			},
+		},
+		{
+			name: "success - deactivated user is not sent a link",
+			input: &input.RequestPasswordResetInput{
+				Email:      "test@example.com",
+				TenantSlug: "test-tenant",
+			},
+			setupMocks: func(authRepo *mock_repository.MockIAuthRepository, uuidGen *mock_pkg.MockIUUIDGenerator, mailer *mock_pkg.MockIMailer) {
+				authRepo.EXPECT().
+					FindTenantBySlug(gomock.Any(), "test-tenant").
+					Return(tenant, nil)
+
+				authRepo.EXPECT().
+					FindUserByEmail(gomock.Any(), "tenant-id", "test@example.com").
+					Return(&model.User{ID: "user-id", TenantID: "tenant-id", Email: "test@example.com", IsActive: false}, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authRepo := mock_repository.NewMockIAuthRepository(ctrl)
+			uuidGen := mock_pkg.NewMockIUUIDGenerator(ctrl)
+			mailer := mock_pkg.NewMockIMailer(ctrl)
+			jwtService := pkg.NewJWTService("test-secret", 3600, 86400)
+
+			tt.setupMocks(authRepo, uuidGen, mailer)
+
+			interactor := NewAuthInteractor(authRepo, jwtService, uuidGen, passwordpolicy.NewPolicy(8, 3), mailer, pkg.NewLinkBuilder("http://localhost:3000"), newNopAuditLogger(ctrl))
+
+			err := interactor.RequestPasswordReset(context.Background(), tt.input)
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestAuthInteractor_ResetPassword(t *testing.T) {
+	t.Parallel()
+
+	tenant := &model.Tenant{ID: "tenant-id", Slug: "test-tenant"}
+	resetToken := &model.PasswordResetToken{ID: "token-id", TenantID: "tenant-id", UserID: "user-id"}
+	user := func() *model.User {
+		return &model.User{ID: "user-id", TenantID: "tenant-id", Email: "test@example.com", Name: "Test User", IsActive: true}
+	}
+
+	tests := []struct {
+		name        string
+		input       *input.ResetPasswordInput
+		setupMocks  func(authRepo *mock_repository.MockIAuthRepository)
+		wantErr     bool
+		wantCode    cerror.ErrorCode
+		errContains string
+	}{
+		{
+			name: "success - password changed and token used up",
+			input: &input.ResetPasswordInput{
+				TenantSlug:  "test-tenant",
+				Token:       "emailed-token",
+				NewPassword: "velvet-Orbit-canyon-93",
+			},
+			setupMocks: func(authRepo *mock_repository.MockIAuthRepository) {
+				authRepo.EXPECT().FindTenantBySlug(gomock.Any(), "test-tenant").Return(tenant, nil)
+				authRepo.EXPECT().
+					FindPasswordResetToken(gomock.Any(), "tenant-id", hashToken("emailed-token"), gomock.Any()).
+					Return(resetToken, nil)
+				authRepo.EXPECT().FindUserByID(gomock.Any(), "tenant-id", "user-id").Return(user(), nil)
+				authRepo.EXPECT().
+					ConsumePasswordResetToken(gomock.Any(), "tenant-id", hashToken("emailed-token"), gomock.Any()).
+					Return(resetToken, nil)
+				authRepo.EXPECT().
+					UpdateUser(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, u *model.User) (*model.User, error) {
+						assert.True(t, pkg.CheckPasswordHash("velvet-Orbit-canyon-93", u.PasswordHash))
+						assert.True(t, u.EmailVerified)
+						return u, nil
+					})
+			},
+		},
+		{
+			name: "fail - weak password keeps the token",
+			input: &input.ResetPasswordInput{
+				TenantSlug:  "test-tenant",
+				Token:       "emailed-token",
+				NewPassword: "password123",
+			},
+			setupMocks: func(authRepo *mock_repository.MockIAuthRepository) {
+				authRepo.EXPECT().FindTenantBySlug(gomock.Any(), "test-tenant").Return(tenant, nil)
+				authRepo.EXPECT().
+					FindPasswordResetToken(gomock.Any(), "tenant-id", hashToken("emailed-token"), gomock.Any()).
+					Return(resetToken, nil)
+				authRepo.EXPECT().FindUserByID(gomock.Any(), "tenant-id", "user-id").Return(user(), nil)
+			},
+			wantErr:     true,
+			wantCode:    cerror.ErrCodeValidationError,
+			errContains: "password policy",
+		},
+		{
+			name: "fail - used or expired token",
+			input: &input.ResetPasswordInput{
+				TenantSlug:  "test-tenant",
+				Token:       "used-token",
+				NewPassword: "velvet-Orbit-canyon-93",
+			},
+			setupMocks: func(authRepo *mock_repository.MockIAuthRepository) {
+				authRepo.EXPECT().FindTenantBySlug(gomock.Any(), "test-tenant").Return(tenant, nil)
+				authRepo.EXPECT().
+					FindPasswordResetToken(gomock.Any(), "tenant-id", hashToken("used-token"), gomock.Any()).
+					Return(nil, errors.New("not found"))
+			},
+			wantErr:     true,
+			wantCode:    cerror.ErrCodeBadRequest,
+			errContains: "invalid or expired token",
+		},
+		{
+			name: "fail - token used concurrently",
+			input: &input.ResetPasswordInput{
+				TenantSlug:  "test-tenant",
+				Token:       "emailed-token",
+				NewPassword: "velvet-Orbit-canyon-93",
+			},
+			setupMocks: func(authRepo *mock_repository.MockIAuthRepository) {
+				authRepo.EXPECT().FindTenantBySlug(gomock.Any(), "test-tenant").Return(tenant, nil)
+				authRepo.EXPECT().
+					FindPasswordResetToken(gomock.Any(), "tenant-id", hashToken("emailed-token"), gomock.Any()).
+					Return(resetToken, nil)
+				authRepo.EXPECT().FindUserByID(gomock.Any(), "tenant-id", "user-id").Return(user(), nil)
+				authRepo.EXPECT().
+					ConsumePasswordResetToken(gomock.Any(), "tenant-id", hashToken("emailed-token"), gomock.Any()).
+					Return(nil, errors.New("not found"))
+			},
+			wantErr:     true,
+			wantCode:    cerror.ErrCodeBadRequest,
+			errContains: "invalid or expired token",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authRepo := mock_repository.NewMockIAuthRepository(ctrl)
+			jwtService := pkg.NewJWTService("test-secret", 3600, 86400)
+
+			tt.setupMocks(authRepo)
+
+			interactor := NewAuthInteractor(authRepo, jwtService, mock_pkg.NewMockIUUIDGenerator(ctrl), passwordpolicy.NewPolicy(8, 3), mock_pkg.NewMockIMailer(ctrl), pkg.NewLinkBuilder("http://localhost:3000"), newNopAuditLogger(ctrl))
+
+			err := interactor.ResetPassword(context.Background(), tt.input)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				var appErr *cerror.AppError
+				require.ErrorAs(t, err, &appErr)
+				assert.Equal(t, tt.wantCode, appErr.Code)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
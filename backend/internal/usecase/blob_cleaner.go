@@ -0,0 +1,78 @@
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_usecase
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/pkg"
+)
+
+// IBlobCleaner deletes the blobs of deleted attachments from the blob store.
+// Attachments go away with their todo inside a database transaction, which
+// cannot include the blob store, so their blobs are queued and removed here.
+type IBlobCleaner interface {
+	// RunOnce deletes up to batchSize queued blobs per tenant and returns how many it deleted
+	RunOnce(ctx context.Context) (int, error)
+}
+
+type BlobCleaner struct {
+	tenantRepo     repository.ITenantRepository
+	attachmentRepo repository.IAttachmentRepository
+	blobStore      pkg.IBlobStore
+	batchSize      int
+}
+
+func NewBlobCleaner(
+	tenantRepo repository.ITenantRepository,
+	attachmentRepo repository.IAttachmentRepository,
+	blobStore pkg.IBlobStore,
+	batchSize int,
+) IBlobCleaner {
+	return &BlobCleaner{
+		tenantRepo:     tenantRepo,
+		attachmentRepo: attachmentRepo,
+		blobStore:      blobStore,
+		batchSize:      batchSize,
+	}
+}
+
+func (c *BlobCleaner) RunOnce(ctx context.Context) (int, error) {
+	tenantIDs, err := c.tenantRepo.ListIDs(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list tenants: %w", err)
+	}
+
+	deleted := 0
+	var errs []error
+	for _, tenantID := range tenantIDs {
+		if err := ctx.Err(); err != nil {
+			return deleted, err
+		}
+		keys, err := c.attachmentRepo.PendingBlobDeletions(ctx, tenantID, c.batchSize)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("tenant %s: %w", tenantID, err))
+			continue
+		}
+
+		// Deleting a blob twice is harmless, so a key is only taken off the
+		// queue once its blob is gone; failures are retried on the next run
+		done := make([]string, 0, len(keys))
+		for _, key := range keys {
+			if err := c.blobStore.Delete(ctx, key); err != nil {
+				errs = append(errs, fmt.Errorf("blob %s: %w", key, err))
+				continue
+			}
+			done = append(done, key)
+		}
+
+		if err := c.attachmentRepo.CompleteBlobDeletions(ctx, tenantID, done); err != nil {
+			errs = append(errs, fmt.Errorf("tenant %s: %w", tenantID, err))
+			continue
+		}
+		deleted += len(done)
+	}
+	return deleted, errors.Join(errs...)
+}
@@ -0,0 +1,184 @@
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_usecase
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/pkg/cerror"
+	"good-todo-go/internal/usecase/input"
+	"good-todo-go/internal/usecase/output"
+)
+
+// ICalendarInteractor publishes todos to calendar apps, either as a feed the
+// app subscribes to with a secret URL or as a one-off export
+type ICalendarInteractor interface {
+	// RotateToken issues a new calendar feed URL, invalidating the previous one
+	RotateToken(ctx context.Context, userID string) (*output.CalendarTokenOutput, error)
+	// RevokeToken turns the user's calendar feed off
+	RevokeToken(ctx context.Context, userID string) error
+	// Authenticate resolves a calendar feed token to the active user it belongs to
+	Authenticate(ctx context.Context, token string) (*output.UserOutput, error)
+	// GetFeed lists the user's todos with a due date for their calendar feed
+	GetFeed(ctx context.Context, in *input.GetCalendarFeedInput) (*output.CalendarOutput, error)
+	// ExportTodos lists the user's todos matching a filter for a one-off export
+	ExportTodos(ctx context.Context, in *input.ExportCalendarInput) (*output.CalendarOutput, error)
+}
+
+const (
+	// calendarTokenPrefix makes calendar feed tokens recognisable, e.g. to secret scanners
+	calendarTokenPrefix = "cal_"
+	// calendarFeedHistory is how long todos stay in the feed after they were due
+	calendarFeedHistory = 90 * 24 * time.Hour
+	// maxCalendarTodos bounds a feed or export; a feed keeps the upcoming
+	// todos first and fills up with the ones due most recently
+	maxCalendarTodos = 1000
+)
+
+type CalendarInteractor struct {
+	userRepo repository.IUserRepository
+	todoRepo repository.ITodoRepository
+}
+
+func NewCalendarInteractor(userRepo repository.IUserRepository, todoRepo repository.ITodoRepository) ICalendarInteractor {
+	return &CalendarInteractor{
+		userRepo: userRepo,
+		todoRepo: todoRepo,
+	}
+}
+
+func (i *CalendarInteractor) RotateToken(ctx context.Context, userID string) (*output.CalendarTokenOutput, error) {
+	random, err := generateVerificationToken()
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to generate calendar token", err)
+	}
+	token := calendarTokenPrefix + random
+
+	tokenHash := hashToken(token)
+	if err := i.userRepo.SetCalendarTokenHash(ctx, userID, &tokenHash); err != nil {
+		return nil, cerror.NewInternalServerError("failed to store calendar token", err)
+	}
+
+	return &output.CalendarTokenOutput{
+		Token:    token,
+		FeedPath: "/calendar/" + token + ".ics",
+	}, nil
+}
+
+func (i *CalendarInteractor) RevokeToken(ctx context.Context, userID string) error {
+	if err := i.userRepo.SetCalendarTokenHash(ctx, userID, nil); err != nil {
+		return cerror.NewInternalServerError("failed to revoke calendar token", err)
+	}
+	return nil
+}
+
+func (i *CalendarInteractor) Authenticate(ctx context.Context, token string) (*output.UserOutput, error) {
+	if token == "" {
+		return nil, cerror.NewUnauthorized("missing calendar token", nil)
+	}
+
+	user, err := i.userRepo.FindByCalendarTokenHash(ctx, hashToken(token))
+	if err != nil {
+		return nil, cerror.NewUnauthorized("invalid calendar token", nil)
+	}
+	// Deactivated users keep their token but may not read the feed
+	if !user.IsActive {
+		return nil, cerror.NewUnauthorized("invalid calendar token", nil)
+	}
+
+	return output.NewUserOutput(user), nil
+}
+
+func (i *CalendarInteractor) GetFeed(ctx context.Context, in *input.GetCalendarFeedInput) (*output.CalendarOutput, error) {
+	component, err := calendarComponent(in.Component)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	hasDueDate := true
+	from := now.UTC()
+	upcoming, err := i.todoRepo.FindByUserID(ctx, in.UserID,
+		&model.TodoFilter{HasDueDate: &hasDueDate, DueAfter: &from},
+		calendarSort(), &model.TodoPage{Limit: maxCalendarTodos})
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to get todos", err)
+	}
+	if len(upcoming) == maxCalendarTodos {
+		return output.NewCalendarOutput(component, upcoming, now), nil
+	}
+
+	// The room left goes to past todos, latest first, so that the oldest are
+	// the ones left out
+	historyStart := now.Add(-calendarFeedHistory).UTC()
+	past, err := i.todoRepo.FindByUserID(ctx, in.UserID,
+		&model.TodoFilter{HasDueDate: &hasDueDate, DueAfter: &historyStart, DueBefore: &from},
+		&model.TodoSort{Field: model.TodoSortDueDate, Desc: true}, &model.TodoPage{Limit: maxCalendarTodos - len(upcoming)})
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to get todos", err)
+	}
+
+	todos := make([]*model.Todo, 0, len(past)+len(upcoming))
+	for n := len(past) - 1; n >= 0; n-- {
+		todos = append(todos, past[n])
+	}
+	todos = append(todos, upcoming...)
+
+	return output.NewCalendarOutput(component, todos, now), nil
+}
+
+func (i *CalendarInteractor) ExportTodos(ctx context.Context, in *input.ExportCalendarInput) (*output.CalendarOutput, error) {
+	component, err := calendarComponent(in.Component)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := &model.TodoFilter{}
+	if in.Filter != nil {
+		filter = &model.TodoFilter{
+			Completed: in.Filter.Completed,
+			Overdue:   in.Filter.Overdue,
+			DueBefore: in.Filter.DueBefore,
+			DueAfter:  in.Filter.DueAfter,
+			IsPublic:  in.Filter.IsPublic,
+			Search:    in.Filter.Search,
+			TagIDs:    in.Filter.TagIDs,
+			ProjectID: in.Filter.ProjectID,
+		}
+	}
+	// An event needs a date to be placed at, while a task can be undated
+	if component == model.CalendarComponentEvent {
+		hasDueDate := true
+		filter.HasDueDate = &hasDueDate
+	}
+
+	todos, err := i.todoRepo.FindByUserID(ctx, in.UserID, filter, calendarSort(), &model.TodoPage{Limit: maxCalendarTodos + 1})
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to get todos", err)
+	}
+	if len(todos) > maxCalendarTodos {
+		return nil, cerror.NewBadRequest(fmt.Sprintf("filter matches more than %d todos", maxCalendarTodos), nil)
+	}
+
+	return output.NewCalendarOutput(component, todos, time.Now()), nil
+}
+
+// calendarComponent validates the requested component; empty means events
+func calendarComponent(component string) (string, error) {
+	switch component {
+	case "":
+		return model.CalendarComponentEvent, nil
+	case model.CalendarComponentEvent, model.CalendarComponentTodo:
+		return component, nil
+	default:
+		return "", cerror.NewBadRequest("component must be vevent or vtodo", nil)
+	}
+}
+
+// calendarSort lists todos by due date, soonest first and undated ones last
+func calendarSort() *model.TodoSort {
+	return &model.TodoSort{Field: model.TodoSortDueDate}
+}
@@ -0,0 +1,286 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	mock_repository "good-todo-go/internal/domain/repository/mock"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCalendarInteractor_RotateToken(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var stored *string
+	userRepo := mock_repository.NewMockIUserRepository(ctrl)
+	userRepo.EXPECT().
+		SetCalendarTokenHash(gomock.Any(), "user-1", gomock.Not(gomock.Nil())).
+		DoAndReturn(func(_ context.Context, _ string, tokenHash *string) error {
+			stored = tokenHash
+			return nil
+		})
+
+	interactor := NewCalendarInteractor(userRepo, nil)
+
+	result, err := interactor.RotateToken(context.Background(), "user-1")
+
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(result.Token, calendarTokenPrefix))
+	assert.Equal(t, "/calendar/"+result.Token+".ics", result.FeedPath)
+	// Only the hash is stored
+	require.NotNil(t, stored)
+	assert.Equal(t, hashToken(result.Token), *stored)
+}
+
+func TestCalendarInteractor_Authenticate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		token       string
+		user        *model.User
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:  "success - active user",
+			token: "cal_valid",
+			user:  &model.User{ID: "user-1", TenantID: "tenant-1", IsActive: true},
+		},
+		{
+			name:        "fail - empty token",
+			token:       "",
+			wantErr:     true,
+			errContains: "missing calendar token",
+		},
+		{
+			name:        "fail - unknown token",
+			token:       "cal_unknown",
+			wantErr:     true,
+			errContains: "invalid calendar token",
+		},
+		{
+			name:        "fail - deactivated user",
+			token:       "cal_valid",
+			user:        &model.User{ID: "user-1", TenantID: "tenant-1", IsActive: false},
+			wantErr:     true,
+			errContains: "invalid calendar token",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepo := mock_repository.NewMockIUserRepository(ctrl)
+			if tt.token != "" {
+				var err error
+				if tt.user == nil {
+					err = errors.New("not found")
+				}
+				userRepo.EXPECT().FindByCalendarTokenHash(gomock.Any(), hashToken(tt.token)).Return(tt.user, err)
+			}
+
+			interactor := NewCalendarInteractor(userRepo, nil)
+
+			result, err := interactor.Authenticate(context.Background(), tt.token)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "user-1", result.ID)
+			assert.Equal(t, "tenant-1", result.TenantID)
+		})
+	}
+}
+
+func TestCalendarInteractor_GetFeed(t *testing.T) {
+	t.Parallel()
+
+	dated := func(id string, dueDate time.Time) *model.Todo {
+		return &model.Todo{ID: id, UserID: "user-1", Title: id, DueDate: &dueDate}
+	}
+	now := time.Now()
+
+	t.Run("success - upcoming todos first, filled up with the latest past ones", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+		gomock.InOrder(
+			todoRepo.EXPECT().
+				FindByUserID(gomock.Any(), "user-1", gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ string, filter *model.TodoFilter, sort *model.TodoSort, page *model.TodoPage) ([]*model.Todo, error) {
+					require.NotNil(t, filter.HasDueDate)
+					assert.True(t, *filter.HasDueDate)
+					require.NotNil(t, filter.DueAfter)
+					assert.WithinDuration(t, now, *filter.DueAfter, time.Minute)
+					assert.Nil(t, filter.DueBefore)
+					assert.Equal(t, model.TodoSortDueDate, sort.Field)
+					assert.False(t, sort.Desc)
+					assert.Equal(t, maxCalendarTodos, page.Limit)
+					return []*model.Todo{dated("tomorrow", now.Add(24*time.Hour))}, nil
+				}),
+			todoRepo.EXPECT().
+				FindByUserID(gomock.Any(), "user-1", gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ string, filter *model.TodoFilter, sort *model.TodoSort, page *model.TodoPage) ([]*model.Todo, error) {
+					require.NotNil(t, filter.DueAfter)
+					assert.WithinDuration(t, now.Add(-calendarFeedHistory), *filter.DueAfter, time.Minute)
+					require.NotNil(t, filter.DueBefore)
+					assert.WithinDuration(t, now, *filter.DueBefore, time.Minute)
+					assert.Equal(t, model.TodoSortDueDate, sort.Field)
+					assert.True(t, sort.Desc)
+					assert.Equal(t, maxCalendarTodos-1, page.Limit)
+					return []*model.Todo{
+						dated("yesterday", now.Add(-24*time.Hour)),
+						dated("last-week", now.Add(-7*24*time.Hour)),
+					}, nil
+				}),
+		)
+
+		interactor := NewCalendarInteractor(nil, todoRepo)
+
+		result, err := interactor.GetFeed(context.Background(), &input.GetCalendarFeedInput{UserID: "user-1"})
+
+		require.NoError(t, err)
+		assert.Equal(t, model.CalendarComponentEvent, result.Component)
+		ids := make([]string, len(result.Todos))
+		for n, todo := range result.Todos {
+			ids[n] = todo.ID
+		}
+		// The feed is in due date order
+		assert.Equal(t, []string{"last-week", "yesterday", "tomorrow"}, ids)
+	})
+
+	t.Run("success - more upcoming todos than fit leave out the past ones", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		upcoming := make([]*model.Todo, maxCalendarTodos)
+		for n := range upcoming {
+			upcoming[n] = dated(fmt.Sprintf("todo-%d", n), now.Add(time.Duration(n+1)*time.Hour))
+		}
+
+		todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+		// Only the upcoming todos are asked for
+		todoRepo.EXPECT().
+			FindByUserID(gomock.Any(), "user-1", gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(upcoming, nil)
+
+		interactor := NewCalendarInteractor(nil, todoRepo)
+
+		result, err := interactor.GetFeed(context.Background(), &input.GetCalendarFeedInput{UserID: "user-1"})
+
+		require.NoError(t, err)
+		require.Len(t, result.Todos, maxCalendarTodos)
+		assert.Equal(t, "todo-0", result.Todos[0].ID)
+	})
+}
+
+func TestCalendarInteractor_ExportTodos(t *testing.T) {
+	t.Parallel()
+
+	completed := false
+
+	tests := []struct {
+		name      string
+		component string
+		// found is how many todos the repository returns; -1 means it is not called
+		found          int
+		wantHasDueDate bool
+		wantErr        bool
+		errContains    string
+	}{
+		{
+			name:           "success - events need a due date",
+			component:      "",
+			found:          2,
+			wantHasDueDate: true,
+		},
+		{
+			name:      "success - tasks may be undated",
+			component: model.CalendarComponentTodo,
+			found:     2,
+		},
+		{
+			name:        "fail - unknown component",
+			component:   "vjournal",
+			found:       -1,
+			wantErr:     true,
+			errContains: "vevent or vtodo",
+		},
+		{
+			name:        "fail - too many todos",
+			component:   model.CalendarComponentTodo,
+			found:       maxCalendarTodos + 1,
+			wantErr:     true,
+			errContains: "more than",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+			if tt.found >= 0 {
+				todoRepo.EXPECT().
+					FindByUserID(gomock.Any(), "user-1", gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, filter *model.TodoFilter, _ *model.TodoSort, page *model.TodoPage) ([]*model.Todo, error) {
+						assert.Equal(t, &completed, filter.Completed)
+						assert.Equal(t, tt.wantHasDueDate, filter.HasDueDate != nil && *filter.HasDueDate)
+						assert.Equal(t, maxCalendarTodos+1, page.Limit)
+
+						todos := make([]*model.Todo, tt.found)
+						for i := range todos {
+							todos[i] = &model.Todo{ID: "todo", UserID: "user-1"}
+						}
+						return todos, nil
+					})
+			}
+
+			interactor := NewCalendarInteractor(nil, todoRepo)
+
+			result, err := interactor.ExportTodos(context.Background(), &input.ExportCalendarInput{
+				UserID:    "user-1",
+				Component: tt.component,
+				Filter:    &input.TodoFilterInput{Completed: &completed},
+			})
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Len(t, result.Todos, tt.found)
+		})
+	}
+}
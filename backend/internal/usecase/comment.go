@@ -0,0 +1,213 @@
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_usecase
+package usecase
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/pkg"
+	"good-todo-go/internal/pkg/cerror"
+	"good-todo-go/internal/usecase/input"
+	"good-todo-go/internal/usecase/output"
+)
+
+// ICommentInteractor manages comment threads on todos. Whoever can see a todo
+// (see GetTodo) can read and write its comments.
+type ICommentInteractor interface {
+	ListComments(ctx context.Context, todoID, userID string) (*output.CommentListOutput, error)
+	CreateComment(ctx context.Context, in *input.CreateCommentInput) (*output.CommentOutput, error)
+	UpdateComment(ctx context.Context, in *input.UpdateCommentInput) (*output.CommentOutput, error)
+	DeleteComment(ctx context.Context, in *input.DeleteCommentInput) error
+}
+
+type CommentInteractor struct {
+	commentRepo repository.ICommentRepository
+	todoRepo    repository.ITodoRepository
+	userRepo    repository.IUserRepository
+	uuidGen     pkg.IUUIDGenerator
+}
+
+func NewCommentInteractor(
+	commentRepo repository.ICommentRepository,
+	todoRepo repository.ITodoRepository,
+	userRepo repository.IUserRepository,
+	uuidGen pkg.IUUIDGenerator,
+) ICommentInteractor {
+	return &CommentInteractor{
+		commentRepo: commentRepo,
+		todoRepo:    todoRepo,
+		userRepo:    userRepo,
+		uuidGen:     uuidGen,
+	}
+}
+
+func (i *CommentInteractor) ListComments(ctx context.Context, todoID, userID string) (*output.CommentListOutput, error) {
+	if _, err := i.findVisibleTodo(ctx, todoID, userID); err != nil {
+		return nil, err
+	}
+
+	comments, err := i.commentRepo.FindByTodoID(ctx, todoID)
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to get comments", err)
+	}
+
+	authors, err := i.findAuthors(ctx, comments...)
+	if err != nil {
+		return nil, err
+	}
+	return output.NewCommentListOutput(comments, authors), nil
+}
+
+func (i *CommentInteractor) CreateComment(ctx context.Context, in *input.CreateCommentInput) (*output.CommentOutput, error) {
+	todo, err := i.findVisibleTodo(ctx, in.TodoID, in.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := validateCommentBody(in.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := i.commentRepo.Create(ctx, &model.Comment{
+		ID:       i.uuidGen.Generate(),
+		TenantID: in.TenantID,
+		TodoID:   todo.ID,
+		AuthorID: &in.UserID,
+		Body:     body,
+	})
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to create comment", err)
+	}
+
+	return i.commentOutput(ctx, created)
+}
+
+func (i *CommentInteractor) UpdateComment(ctx context.Context, in *input.UpdateCommentInput) (*output.CommentOutput, error) {
+	_, comment, err := i.findComment(ctx, in.TodoID, in.CommentID, in.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !isCommentAuthor(comment, in.UserID) {
+		return nil, cerror.NewForbidden("not allowed to edit this comment", nil)
+	}
+
+	body, err := validateCommentBody(in.Body)
+	if err != nil {
+		return nil, err
+	}
+	if body == comment.Body {
+		return i.commentOutput(ctx, comment)
+	}
+
+	now := time.Now().UTC()
+	comment.Body = body
+	comment.EditedAt = &now
+
+	updated, err := i.commentRepo.Update(ctx, comment)
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to update comment", err)
+	}
+
+	return i.commentOutput(ctx, updated)
+}
+
+func (i *CommentInteractor) DeleteComment(ctx context.Context, in *input.DeleteCommentInput) error {
+	todo, comment, err := i.findComment(ctx, in.TodoID, in.CommentID, in.UserID)
+	if err != nil {
+		return err
+	}
+
+	// The todo's owner moderates the thread
+	if !isCommentAuthor(comment, in.UserID) && todo.UserID != in.UserID {
+		return cerror.NewForbidden("not allowed to delete this comment", nil)
+	}
+
+	now := time.Now().UTC()
+	comment.Body = ""
+	comment.DeletedAt = &now
+
+	if _, err := i.commentRepo.Update(ctx, comment); err != nil {
+		return cerror.NewInternalServerError("failed to delete comment", err)
+	}
+	return nil
+}
+
+func (i *CommentInteractor) findVisibleTodo(ctx context.Context, todoID, userID string) (*model.Todo, error) {
+	todo, err := i.todoRepo.FindByID(ctx, todoID)
+	if err != nil {
+		return nil, cerror.NewNotFound("todo not found", err)
+	}
+	if !canViewTodo(todo, userID) {
+		return nil, cerror.NewForbidden("not allowed to access this todo", nil)
+	}
+	return todo, nil
+}
+
+// findComment finds a comment that has not been deleted in a todo the user can see
+func (i *CommentInteractor) findComment(ctx context.Context, todoID, commentID, userID string) (*model.Todo, *model.Comment, error) {
+	todo, err := i.findVisibleTodo(ctx, todoID, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	comment, err := i.commentRepo.FindByID(ctx, commentID)
+	if err != nil || comment.TodoID != todo.ID || comment.DeletedAt != nil {
+		return nil, nil, cerror.NewNotFound("comment not found", err)
+	}
+	return todo, comment, nil
+}
+
+// findAuthors looks up the authors of comments by user ID
+func (i *CommentInteractor) findAuthors(ctx context.Context, comments ...*model.Comment) (map[string]*model.User, error) {
+	userIDs := make([]string, 0, len(comments))
+	seen := make(map[string]bool)
+	for _, c := range comments {
+		if c.AuthorID != nil && !seen[*c.AuthorID] {
+			seen[*c.AuthorID] = true
+			userIDs = append(userIDs, *c.AuthorID)
+		}
+	}
+	if len(userIDs) == 0 {
+		return map[string]*model.User{}, nil
+	}
+
+	users, err := i.userRepo.FindByIDs(ctx, userIDs)
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to get users", err)
+	}
+
+	authors := make(map[string]*model.User, len(users))
+	for _, u := range users {
+		authors[u.ID] = u
+	}
+	return authors, nil
+}
+
+func (i *CommentInteractor) commentOutput(ctx context.Context, comment *model.Comment) (*output.CommentOutput, error) {
+	authors, err := i.findAuthors(ctx, comment)
+	if err != nil {
+		return nil, err
+	}
+	return output.NewCommentOutput(comment, authors), nil
+}
+
+func isCommentAuthor(comment *model.Comment, userID string) bool {
+	return comment.AuthorID != nil && *comment.AuthorID == userID
+}
+
+// validateCommentBody trims surrounding whitespace and bounds the length
+func validateCommentBody(body string) (string, error) {
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return "", cerror.NewBadRequest("comment body is required", nil)
+	}
+	if utf8.RuneCountInString(body) > model.MaxCommentLength {
+		return "", cerror.NewBadRequest("comment body must be at most 10000 characters", nil)
+	}
+	return body, nil
+}
@@ -0,0 +1,250 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	mock_repository "good-todo-go/internal/domain/repository/mock"
+	mock_pkg "good-todo-go/internal/pkg/mock"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCommentInteractor_CreateComment(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		todo        *model.Todo
+		body        string
+		wantBody    string
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:     "success - on a public todo of someone else",
+			todo:     &model.Todo{ID: "todo-1", UserID: "owner", IsPublic: true},
+			body:     "  Looks **good**\n",
+			wantBody: "Looks **good**",
+		},
+		{
+			name:     "success - assignee comments on a private todo",
+			todo:     &model.Todo{ID: "todo-1", UserID: "owner", AssigneeID: strPtr("user-1")},
+			body:     "On it",
+			wantBody: "On it",
+		},
+		{
+			name:        "fail - private todo of someone else",
+			todo:        &model.Todo{ID: "todo-1", UserID: "owner"},
+			body:        "Hello",
+			wantErr:     true,
+			errContains: "not allowed",
+		},
+		{
+			name:        "fail - blank body",
+			todo:        &model.Todo{ID: "todo-1", UserID: "user-1"},
+			body:        " \n ",
+			wantErr:     true,
+			errContains: "required",
+		},
+		{
+			name:        "fail - body too long",
+			todo:        &model.Todo{ID: "todo-1", UserID: "user-1"},
+			body:        strings.Repeat("あ", model.MaxCommentLength+1),
+			wantErr:     true,
+			errContains: "at most 10000",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			commentRepo := mock_repository.NewMockICommentRepository(ctrl)
+			todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+			userRepo := mock_repository.NewMockIUserRepository(ctrl)
+			uuidGen := mock_pkg.NewMockIUUIDGenerator(ctrl)
+
+			todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(tt.todo, nil)
+			if !tt.wantErr {
+				uuidGen.EXPECT().Generate().Return("comment-1")
+				commentRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, c *model.Comment) (*model.Comment, error) {
+						return c, nil
+					})
+				userRepo.EXPECT().
+					FindByIDs(gomock.Any(), []string{"user-1"}).
+					Return([]*model.User{{ID: "user-1", Name: "Alice"}}, nil)
+			}
+
+			interactor := NewCommentInteractor(commentRepo, todoRepo, userRepo, uuidGen)
+
+			result, err := interactor.CreateComment(context.Background(), &input.CreateCommentInput{
+				TodoID:   "todo-1",
+				UserID:   "user-1",
+				TenantID: "tenant-1",
+				Body:     tt.body,
+			})
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "comment-1", result.ID)
+			assert.Equal(t, tt.wantBody, result.Body)
+			require.NotNil(t, result.Author)
+			assert.Equal(t, "Alice", result.Author.Name)
+			assert.False(t, result.Edited)
+		})
+	}
+}
+
+func TestCommentInteractor_UpdateAndDelete(t *testing.T) {
+	t.Parallel()
+
+	publicTodo := &model.Todo{ID: "todo-1", UserID: "owner", IsPublic: true}
+	comment := func() *model.Comment {
+		return &model.Comment{ID: "comment-1", TodoID: "todo-1", AuthorID: strPtr("author"), Body: "First", CreatedAt: time.Now()}
+	}
+
+	t.Run("author edits", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		commentRepo := mock_repository.NewMockICommentRepository(ctrl)
+		todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+		userRepo := mock_repository.NewMockIUserRepository(ctrl)
+
+		todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(publicTodo, nil)
+		commentRepo.EXPECT().FindByID(gomock.Any(), "comment-1").Return(comment(), nil)
+		commentRepo.EXPECT().
+			Update(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, c *model.Comment) (*model.Comment, error) {
+				return c, nil
+			})
+		userRepo.EXPECT().FindByIDs(gomock.Any(), []string{"author"}).Return([]*model.User{}, nil)
+
+		interactor := NewCommentInteractor(commentRepo, todoRepo, userRepo, nil)
+
+		result, err := interactor.UpdateComment(context.Background(), &input.UpdateCommentInput{
+			TodoID: "todo-1", CommentID: "comment-1", UserID: "author", Body: "Second",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Second", result.Body)
+		assert.True(t, result.Edited)
+		assert.NotNil(t, result.EditedAt)
+	})
+
+	t.Run("todo owner cannot edit others' comments", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		commentRepo := mock_repository.NewMockICommentRepository(ctrl)
+		todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+
+		todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(publicTodo, nil)
+		commentRepo.EXPECT().FindByID(gomock.Any(), "comment-1").Return(comment(), nil)
+
+		interactor := NewCommentInteractor(commentRepo, todoRepo, nil, nil)
+
+		_, err := interactor.UpdateComment(context.Background(), &input.UpdateCommentInput{
+			TodoID: "todo-1", CommentID: "comment-1", UserID: "owner", Body: "Rewritten",
+		})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not allowed")
+	})
+
+	t.Run("todo owner deletes a comment", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		commentRepo := mock_repository.NewMockICommentRepository(ctrl)
+		todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+
+		todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(publicTodo, nil)
+		commentRepo.EXPECT().FindByID(gomock.Any(), "comment-1").Return(comment(), nil)
+		commentRepo.EXPECT().
+			Update(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, c *model.Comment) (*model.Comment, error) {
+				assert.Empty(t, c.Body)
+				assert.NotNil(t, c.DeletedAt)
+				return c, nil
+			})
+
+		interactor := NewCommentInteractor(commentRepo, todoRepo, nil, nil)
+
+		err := interactor.DeleteComment(context.Background(), &input.DeleteCommentInput{
+			TodoID: "todo-1", CommentID: "comment-1", UserID: "owner",
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("other members cannot delete", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		commentRepo := mock_repository.NewMockICommentRepository(ctrl)
+		todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+
+		todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(publicTodo, nil)
+		commentRepo.EXPECT().FindByID(gomock.Any(), "comment-1").Return(comment(), nil)
+
+		interactor := NewCommentInteractor(commentRepo, todoRepo, nil, nil)
+
+		err := interactor.DeleteComment(context.Background(), &input.DeleteCommentInput{
+			TodoID: "todo-1", CommentID: "comment-1", UserID: "someone",
+		})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not allowed")
+	})
+
+	t.Run("deleted comment is not found", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		commentRepo := mock_repository.NewMockICommentRepository(ctrl)
+		todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+
+		deleted := comment()
+		deletedAt := time.Now()
+		deleted.DeletedAt = &deletedAt
+		todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(publicTodo, nil)
+		commentRepo.EXPECT().FindByID(gomock.Any(), "comment-1").Return(deleted, nil)
+
+		interactor := NewCommentInteractor(commentRepo, todoRepo, nil, nil)
+
+		_, err := interactor.UpdateComment(context.Background(), &input.UpdateCommentInput{
+			TodoID: "todo-1", CommentID: "comment-1", UserID: "author", Body: "Back",
+		})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not found")
+	})
+}
@@ -0,0 +1,25 @@
+package input
+
+import "io"
+
+type UploadAttachmentInput struct {
+	TodoID   string
+	UserID   string
+	TenantID string
+	// FileName is the name the client gave the file
+	FileName string
+	Size     int64
+	Content  io.Reader
+}
+
+type GetAttachmentContentInput struct {
+	TodoID       string
+	AttachmentID string
+	UserID       string
+}
+
+type DeleteAttachmentInput struct {
+	TodoID       string
+	AttachmentID string
+	UserID       string
+}
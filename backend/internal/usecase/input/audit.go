@@ -0,0 +1,27 @@
+package input
+
+import "time"
+
+type ListAuditEventsInput struct {
+	TenantID string
+	Role     string
+	ActorID  *string
+	Action   *string
+	TargetID *string
+	From     *time.Time
+	To       *time.Time
+	Limit    int
+	Offset   int
+}
+
+// ListAllAuditEventsInput lists the audit log across tenants for the admin API
+type ListAllAuditEventsInput struct {
+	TenantID *string
+	ActorID  *string
+	Action   *string
+	TargetID *string
+	From     *time.Time
+	To       *time.Time
+	Limit    int
+	Offset   int
+}
@@ -20,3 +20,31 @@ type VerifyEmailInput struct {
 type RefreshTokenInput struct {
 	RefreshToken string
 }
+
+type ChangePasswordInput struct {
+	UserID          string
+	TenantID        string
+	CurrentPassword string
+	NewPassword     string
+}
+
+type RequestMagicLinkInput struct {
+	Email      string
+	TenantSlug string
+}
+
+type ConsumeMagicLinkInput struct {
+	TenantSlug string
+	Token      string
+}
+
+type RequestPasswordResetInput struct {
+	Email      string
+	TenantSlug string
+}
+
+type ResetPasswordInput struct {
+	TenantSlug  string
+	Token       string
+	NewPassword string
+}
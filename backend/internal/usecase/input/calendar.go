@@ -0,0 +1,17 @@
+package input
+
+// GetCalendarFeedInput reads the calendar feed of UserID
+type GetCalendarFeedInput struct {
+	UserID string
+	// Component is vevent or vtodo; empty means vevent
+	Component string
+}
+
+// ExportCalendarInput selects the caller's own todos for a one-off calendar export
+type ExportCalendarInput struct {
+	UserID string
+	// Component is vevent or vtodo; empty means vevent
+	Component string
+	// Filter narrows the export like the todo listing; nil exports every todo
+	Filter *TodoFilterInput
+}
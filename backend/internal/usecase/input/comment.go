@@ -0,0 +1,21 @@
+package input
+
+type CreateCommentInput struct {
+	TodoID   string
+	UserID   string
+	TenantID string
+	Body     string
+}
+
+type UpdateCommentInput struct {
+	TodoID    string
+	CommentID string
+	UserID    string
+	Body      string
+}
+
+type DeleteCommentInput struct {
+	TodoID    string
+	CommentID string
+	UserID    string
+}
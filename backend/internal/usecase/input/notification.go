@@ -0,0 +1,8 @@
+package input
+
+type GetNotificationsInput struct {
+	UserID     string
+	UnreadOnly bool
+	// Limit defaults to 50
+	Limit int
+}
@@ -0,0 +1,30 @@
+package input
+
+type GetProjectsInput struct {
+	UserID          string
+	IncludeArchived bool
+}
+
+type CreateProjectInput struct {
+	TenantID    string
+	UserID      string
+	Name        string
+	Description string
+	IsPublic    bool
+}
+
+type UpdateProjectInput struct {
+	ProjectID   string
+	UserID      string
+	Name        *string
+	Description *string
+	IsPublic    *bool
+	Archived    *bool
+}
+
+type MoveTodoInput struct {
+	TodoID string
+	UserID string
+	// ProjectID is the destination; nil takes the todo out of its project
+	ProjectID *string
+}
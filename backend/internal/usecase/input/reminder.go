@@ -0,0 +1,21 @@
+package input
+
+import "time"
+
+type CreateReminderInput struct {
+	TodoID   string
+	UserID   string
+	TenantID string
+	// Exactly one of RemindAt and OffsetMinutes is set
+	RemindAt *time.Time
+	// OffsetMinutes reminds this many minutes before the todo's due date
+	OffsetMinutes *int
+	// Channel is email or in_app; empty means in_app
+	Channel string
+}
+
+type DeleteReminderInput struct {
+	TodoID     string
+	ReminderID string
+	UserID     string
+}
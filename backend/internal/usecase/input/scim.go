@@ -0,0 +1,45 @@
+package input
+
+type ListScimUsersInput struct {
+	TenantID string
+	// Filter is a SCIM filter expression; only `userName eq` and `externalId eq` are supported
+	Filter string
+	// StartIndex is 1-based as defined by SCIM
+	StartIndex int
+	Count      int
+}
+
+// ScimUserInput is the writable subset of a SCIM User resource
+type ScimUserInput struct {
+	UserName   string
+	Name       string
+	ExternalID *string
+	Active     *bool
+	Role       *string
+	Password   *string
+}
+
+type CreateScimUserInput struct {
+	TenantID string
+	User     ScimUserInput
+}
+
+type ReplaceScimUserInput struct {
+	TenantID string
+	UserID   string
+	User     ScimUserInput
+}
+
+// ScimPatchOperation is a single operation of a SCIM PatchOp request.
+// Value is the decoded JSON value.
+type ScimPatchOperation struct {
+	Op    string
+	Path  string
+	Value interface{}
+}
+
+type PatchScimUserInput struct {
+	TenantID   string
+	UserID     string
+	Operations []ScimPatchOperation
+}
@@ -0,0 +1,27 @@
+package input
+
+type CreateTagInput struct {
+	TenantID string
+	Name     string
+	// Color is a #rrggbb hex color; nil means the default gray
+	Color *string
+}
+
+type UpdateTagInput struct {
+	TagID string
+	Role  string
+	Name  *string
+	Color *string
+}
+
+type DeleteTagInput struct {
+	TagID string
+	Role  string
+}
+
+type TodoTagInput struct {
+	TenantID string
+	UserID   string
+	TodoID   string
+	TagID    string
+}
@@ -0,0 +1,17 @@
+package input
+
+type UpdateTenantSettingsInput struct {
+	TenantID              string
+	UserID                string
+	Role                  string
+	MagicLinkEnabled      *bool
+	AccountDeletionPolicy *string
+	SearchLanguage        *string
+	TrashRetentionDays    *int
+}
+
+type ScimTokenInput struct {
+	TenantID string
+	UserID   string
+	Role     string
+}
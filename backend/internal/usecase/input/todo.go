@@ -9,6 +9,27 @@ type CreateTodoInput struct {
 	Description string
 	IsPublic    bool
 	DueDate     *time.Time
+	// Priority is one of model.TodoPriorities; empty means none
+	Priority string
+	// ProjectID must name one of the user's active projects
+	ProjectID *string
+	// ParentID makes the new todo a subtask of another of the user's todos
+	ParentID *string
+	// Recurrence makes the todo repeat; it requires DueDate
+	Recurrence *TodoRecurrenceInput
+}
+
+type TodoRecurrenceInput struct {
+	// Rule is an RFC 5545 RRULE, with or without the RRULE: prefix
+	Rule string
+	// Timezone is an IANA zone name the rule is evaluated in; empty means UTC
+	Timezone string
+}
+
+type SetTodoRecurrenceInput struct {
+	TodoID     string
+	UserID     string
+	Recurrence TodoRecurrenceInput
 }
 
 type UpdateTodoInput struct {
@@ -19,15 +40,168 @@ type UpdateTodoInput struct {
 	Completed   *bool
 	IsPublic    *bool
 	DueDate     *time.Time
+	Priority    *string
+	// CascadeCompletion also completes every open subtask when Completed is true
+	CascadeCompletion bool
+	// IfMatch lists the versions the update may apply to; nil means any
+	IfMatch []int
+}
+
+// PatchTodoInput is a JSON merge patch (RFC 7396) of a todo. Patch is the
+// decoded document: a field set to nil clears it, a missing field stays.
+type PatchTodoInput struct {
+	TodoID string
+	UserID string
+	Patch  map[string]interface{}
+	// IfMatch lists the versions the patch may apply to; nil means any
+	IfMatch []int
+}
+
+type DeleteTodoInput struct {
+	TodoID string
+	UserID string
+	// Children is required when the todo has subtasks: delete removes them
+	// too, detach turns them into top-level todos
+	Children string
+	// IfMatch lists the versions the delete may apply to; nil means any
+	IfMatch []int
+}
+
+type SetTodoParentInput struct {
+	TodoID string
+	UserID string
+	// ParentID is the new parent; nil makes the todo a top-level todo
+	ParentID *string
+}
+
+// ReorderTodoInput moves a todo in its owner's manual order. Exactly one of
+// Before and After names the todo it is placed next to.
+type ReorderTodoInput struct {
+	TodoID string
+	UserID string
+	Before *string
+	After  *string
+}
+
+type AssignTodoInput struct {
+	TodoID   string
+	UserID   string
+	TenantID string
+	// AssigneeID is a member of the tenant; nil unassigns the todo
+	AssigneeID *string
+}
+
+// ShareTodoInput grants MemberID access to the todo, or changes the
+// permission they already have
+type ShareTodoInput struct {
+	TodoID   string
+	UserID   string
+	MemberID string
+	// Permission is read or edit
+	Permission string
+}
+
+// BulkTodosInput applies one operation to the todos named by TodoIDs or
+// matched by Filter; exactly one of the two is set
+type BulkTodosInput struct {
+	UserID    string
+	Operation string
+	TodoIDs   []string
+	Filter    *TodoFilterInput
+	// IsPublic is required by set_visibility
+	IsPublic *bool
+	// ProjectID is used by move_project; nil removes the todos from their project
+	ProjectID *string
+	// TagID is required by add_tag
+	TagID string
+	// Children is used by delete like in DeleteTodoInput
+	Children string
+	// CascadeCompletion is used by complete like in UpdateTodoInput
+	CascadeCompletion bool
+}
+
+// TodoFilterInput selects the user's own todos like GetTodosInput does
+type TodoFilterInput struct {
+	Completed *bool
+	Overdue   *bool
+	DueBefore *time.Time
+	DueAfter  *time.Time
+	IsPublic  *bool
+	Search    *string
+	// TagIDs only keeps todos that carry every listed tag
+	TagIDs    []string
+	ProjectID *string
 }
 
 type GetTodosInput struct {
+	UserID          string
+	Completed       *bool
+	Overdue         *bool
+	DueBefore       *time.Time
+	DueAfter        *time.Time
+	CreatedBefore   *time.Time
+	CreatedAfter    *time.Time
+	CompletedBefore *time.Time
+	CompletedAfter  *time.Time
+	IsPublic        *bool
+	Search          *string
+	// TagIDs only keeps todos that carry every listed tag
+	TagIDs    []string
+	ProjectID *string
+	// Sort is one of due_date, created_at, updated_at, title, priority or
+	// position; empty means created_at
+	Sort string
+	// Order is asc or desc; empty means asc for position and desc otherwise
+	Order string
+	// Cursor continues a previous listing; Offset is ignored when set
+	Cursor string
+	// IncludeTotal defaults to true in offset mode and false in cursor mode
+	IncludeTotal *bool
+	Limit        int
+	Offset       int
+}
+
+type GetTrashInput struct {
+	UserID string
+	Limit  int
+	Offset int
+}
+
+type GetTodoHistoryInput struct {
+	TodoID string
 	UserID string
 	Limit  int
 	Offset int
 }
 
+type RevertTodoInput struct {
+	TodoID string
+	UserID string
+	// RevisionID is the revision whose state the todo goes back to
+	RevisionID string
+}
+
 type GetPublicTodosInput struct {
+	// TagIDs only keeps todos that carry every listed tag
+	TagIDs       []string
+	ProjectID    *string
+	Cursor       string
+	IncludeTotal *bool
+	Limit        int
+	Offset       int
+}
+
+type GetCompletionStatsInput struct {
+	UserID string
+	// Period is today or week; empty means week
+	Period string
+	// Timezone is an IANA zone name used to determine day boundaries; empty means UTC
+	Timezone string
+}
+
+type SearchTodosInput struct {
+	UserID string
+	Query  string
 	Limit  int
 	Offset int
 }
@@ -4,3 +4,8 @@ type UpdateUserInput struct {
 	UserID string
 	Name   *string
 }
+
+type DeleteMeInput struct {
+	UserID   string
+	Password string
+}
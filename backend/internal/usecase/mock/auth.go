@@ -42,6 +42,35 @@ func (m *MockIAuthInteractor) EXPECT() *MockIAuthInteractorMockRecorder {
 	return m.recorder
 }
 
+// ChangePassword mocks base method.
+func (m *MockIAuthInteractor) ChangePassword(ctx context.Context, in *input.ChangePasswordInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangePassword", ctx, in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangePassword indicates an expected call of ChangePassword.
+func (mr *MockIAuthInteractorMockRecorder) ChangePassword(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockIAuthInteractor)(nil).ChangePassword), ctx, in)
+}
+
+// ConsumeMagicLink mocks base method.
+func (m *MockIAuthInteractor) ConsumeMagicLink(ctx context.Context, in *input.ConsumeMagicLinkInput) (*output.AuthOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeMagicLink", ctx, in)
+	ret0, _ := ret[0].(*output.AuthOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumeMagicLink indicates an expected call of ConsumeMagicLink.
+func (mr *MockIAuthInteractorMockRecorder) ConsumeMagicLink(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeMagicLink", reflect.TypeOf((*MockIAuthInteractor)(nil).ConsumeMagicLink), ctx, in)
+}
+
 // Login mocks base method.
 func (m *MockIAuthInteractor) Login(ctx context.Context, in *input.LoginInput) (*output.AuthOutput, error) {
 	m.ctrl.T.Helper()
@@ -87,6 +116,48 @@ func (mr *MockIAuthInteractorMockRecorder) Register(ctx, in any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockIAuthInteractor)(nil).Register), ctx, in)
 }
 
+// RequestMagicLink mocks base method.
+func (m *MockIAuthInteractor) RequestMagicLink(ctx context.Context, in *input.RequestMagicLinkInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestMagicLink", ctx, in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequestMagicLink indicates an expected call of RequestMagicLink.
+func (mr *MockIAuthInteractorMockRecorder) RequestMagicLink(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestMagicLink", reflect.TypeOf((*MockIAuthInteractor)(nil).RequestMagicLink), ctx, in)
+}
+
+// RequestPasswordReset mocks base method.
+func (m *MockIAuthInteractor) RequestPasswordReset(ctx context.Context, in *input.RequestPasswordResetInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestPasswordReset", ctx, in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequestPasswordReset indicates an expected call of RequestPasswordReset.
+func (mr *MockIAuthInteractorMockRecorder) RequestPasswordReset(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestPasswordReset", reflect.TypeOf((*MockIAuthInteractor)(nil).RequestPasswordReset), ctx, in)
+}
+
+// ResetPassword mocks base method.
+func (m *MockIAuthInteractor) ResetPassword(ctx context.Context, in *input.ResetPasswordInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPassword", ctx, in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetPassword indicates an expected call of ResetPassword.
+func (mr *MockIAuthInteractorMockRecorder) ResetPassword(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockIAuthInteractor)(nil).ResetPassword), ctx, in)
+}
+
 // VerifyEmail mocks base method.
 func (m *MockIAuthInteractor) VerifyEmail(ctx context.Context, in *input.VerifyEmailInput) (*output.VerifyEmailOutput, error) {
 	m.ctrl.T.Helper()
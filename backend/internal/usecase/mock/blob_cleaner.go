@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: blob_cleaner.go
+//
+// Generated by this command:
+//
+//	mockgen -source=blob_cleaner.go -destination=mock/blob_cleaner.go -package=mock_usecase
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIBlobCleaner is a mock of IBlobCleaner interface.
+type MockIBlobCleaner struct {
+	ctrl     *gomock.Controller
+	recorder *MockIBlobCleanerMockRecorder
+	isgomock struct{}
+}
+
+// MockIBlobCleanerMockRecorder is the mock recorder for MockIBlobCleaner.
+type MockIBlobCleanerMockRecorder struct {
+	mock *MockIBlobCleaner
+}
+
+// NewMockIBlobCleaner creates a new mock instance.
+func NewMockIBlobCleaner(ctrl *gomock.Controller) *MockIBlobCleaner {
+	mock := &MockIBlobCleaner{ctrl: ctrl}
+	mock.recorder = &MockIBlobCleanerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIBlobCleaner) EXPECT() *MockIBlobCleanerMockRecorder {
+	return m.recorder
+}
+
+// RunOnce mocks base method.
+func (m *MockIBlobCleaner) RunOnce(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunOnce", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunOnce indicates an expected call of RunOnce.
+func (mr *MockIBlobCleanerMockRecorder) RunOnce(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunOnce", reflect.TypeOf((*MockIBlobCleaner)(nil).RunOnce), ctx)
+}
@@ -0,0 +1,102 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: comment.go
+//
+// Generated by this command:
+//
+//	mockgen -source=comment.go -destination=mock/comment.go -package=mock_usecase
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	input "good-todo-go/internal/usecase/input"
+	output "good-todo-go/internal/usecase/output"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockICommentInteractor is a mock of ICommentInteractor interface.
+type MockICommentInteractor struct {
+	ctrl     *gomock.Controller
+	recorder *MockICommentInteractorMockRecorder
+	isgomock struct{}
+}
+
+// MockICommentInteractorMockRecorder is the mock recorder for MockICommentInteractor.
+type MockICommentInteractorMockRecorder struct {
+	mock *MockICommentInteractor
+}
+
+// NewMockICommentInteractor creates a new mock instance.
+func NewMockICommentInteractor(ctrl *gomock.Controller) *MockICommentInteractor {
+	mock := &MockICommentInteractor{ctrl: ctrl}
+	mock.recorder = &MockICommentInteractorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockICommentInteractor) EXPECT() *MockICommentInteractorMockRecorder {
+	return m.recorder
+}
+
+// CreateComment mocks base method.
+func (m *MockICommentInteractor) CreateComment(ctx context.Context, in *input.CreateCommentInput) (*output.CommentOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateComment", ctx, in)
+	ret0, _ := ret[0].(*output.CommentOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateComment indicates an expected call of CreateComment.
+func (mr *MockICommentInteractorMockRecorder) CreateComment(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateComment", reflect.TypeOf((*MockICommentInteractor)(nil).CreateComment), ctx, in)
+}
+
+// DeleteComment mocks base method.
+func (m *MockICommentInteractor) DeleteComment(ctx context.Context, in *input.DeleteCommentInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteComment", ctx, in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteComment indicates an expected call of DeleteComment.
+func (mr *MockICommentInteractorMockRecorder) DeleteComment(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteComment", reflect.TypeOf((*MockICommentInteractor)(nil).DeleteComment), ctx, in)
+}
+
+// ListComments mocks base method.
+func (m *MockICommentInteractor) ListComments(ctx context.Context, todoID, userID string) (*output.CommentListOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListComments", ctx, todoID, userID)
+	ret0, _ := ret[0].(*output.CommentListOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListComments indicates an expected call of ListComments.
+func (mr *MockICommentInteractorMockRecorder) ListComments(ctx, todoID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListComments", reflect.TypeOf((*MockICommentInteractor)(nil).ListComments), ctx, todoID, userID)
+}
+
+// UpdateComment mocks base method.
+func (m *MockICommentInteractor) UpdateComment(ctx context.Context, in *input.UpdateCommentInput) (*output.CommentOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateComment", ctx, in)
+	ret0, _ := ret[0].(*output.CommentOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateComment indicates an expected call of UpdateComment.
+func (mr *MockICommentInteractorMockRecorder) UpdateComment(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateComment", reflect.TypeOf((*MockICommentInteractor)(nil).UpdateComment), ctx, in)
+}
@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: notification.go
+//
+// Generated by this command:
+//
+//	mockgen -source=notification.go -destination=mock/notification.go -package=mock_usecase
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	input "good-todo-go/internal/usecase/input"
+	output "good-todo-go/internal/usecase/output"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockINotificationInteractor is a mock of INotificationInteractor interface.
+type MockINotificationInteractor struct {
+	ctrl     *gomock.Controller
+	recorder *MockINotificationInteractorMockRecorder
+	isgomock struct{}
+}
+
+// MockINotificationInteractorMockRecorder is the mock recorder for MockINotificationInteractor.
+type MockINotificationInteractorMockRecorder struct {
+	mock *MockINotificationInteractor
+}
+
+// NewMockINotificationInteractor creates a new mock instance.
+func NewMockINotificationInteractor(ctrl *gomock.Controller) *MockINotificationInteractor {
+	mock := &MockINotificationInteractor{ctrl: ctrl}
+	mock.recorder = &MockINotificationInteractorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockINotificationInteractor) EXPECT() *MockINotificationInteractorMockRecorder {
+	return m.recorder
+}
+
+// GetNotifications mocks base method.
+func (m *MockINotificationInteractor) GetNotifications(ctx context.Context, in *input.GetNotificationsInput) (*output.NotificationListOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotifications", ctx, in)
+	ret0, _ := ret[0].(*output.NotificationListOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotifications indicates an expected call of GetNotifications.
+func (mr *MockINotificationInteractorMockRecorder) GetNotifications(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotifications", reflect.TypeOf((*MockINotificationInteractor)(nil).GetNotifications), ctx, in)
+}
+
+// MarkNotificationRead mocks base method.
+func (m *MockINotificationInteractor) MarkNotificationRead(ctx context.Context, notificationID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkNotificationRead", ctx, notificationID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkNotificationRead indicates an expected call of MarkNotificationRead.
+func (mr *MockINotificationInteractorMockRecorder) MarkNotificationRead(ctx, notificationID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkNotificationRead", reflect.TypeOf((*MockINotificationInteractor)(nil).MarkNotificationRead), ctx, notificationID, userID)
+}
@@ -0,0 +1,117 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: project.go
+//
+// Generated by this command:
+//
+//	mockgen -source=project.go -destination=mock/project.go -package=mock_usecase
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	input "good-todo-go/internal/usecase/input"
+	output "good-todo-go/internal/usecase/output"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIProjectInteractor is a mock of IProjectInteractor interface.
+type MockIProjectInteractor struct {
+	ctrl     *gomock.Controller
+	recorder *MockIProjectInteractorMockRecorder
+	isgomock struct{}
+}
+
+// MockIProjectInteractorMockRecorder is the mock recorder for MockIProjectInteractor.
+type MockIProjectInteractorMockRecorder struct {
+	mock *MockIProjectInteractor
+}
+
+// NewMockIProjectInteractor creates a new mock instance.
+func NewMockIProjectInteractor(ctrl *gomock.Controller) *MockIProjectInteractor {
+	mock := &MockIProjectInteractor{ctrl: ctrl}
+	mock.recorder = &MockIProjectInteractorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIProjectInteractor) EXPECT() *MockIProjectInteractorMockRecorder {
+	return m.recorder
+}
+
+// CreateProject mocks base method.
+func (m *MockIProjectInteractor) CreateProject(ctx context.Context, in *input.CreateProjectInput) (*output.ProjectOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProject", ctx, in)
+	ret0, _ := ret[0].(*output.ProjectOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProject indicates an expected call of CreateProject.
+func (mr *MockIProjectInteractorMockRecorder) CreateProject(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProject", reflect.TypeOf((*MockIProjectInteractor)(nil).CreateProject), ctx, in)
+}
+
+// DeleteProject mocks base method.
+func (m *MockIProjectInteractor) DeleteProject(ctx context.Context, projectID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProject", ctx, projectID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProject indicates an expected call of DeleteProject.
+func (mr *MockIProjectInteractorMockRecorder) DeleteProject(ctx, projectID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProject", reflect.TypeOf((*MockIProjectInteractor)(nil).DeleteProject), ctx, projectID, userID)
+}
+
+// GetProject mocks base method.
+func (m *MockIProjectInteractor) GetProject(ctx context.Context, projectID, userID string) (*output.ProjectOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProject", ctx, projectID, userID)
+	ret0, _ := ret[0].(*output.ProjectOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProject indicates an expected call of GetProject.
+func (mr *MockIProjectInteractorMockRecorder) GetProject(ctx, projectID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProject", reflect.TypeOf((*MockIProjectInteractor)(nil).GetProject), ctx, projectID, userID)
+}
+
+// GetProjects mocks base method.
+func (m *MockIProjectInteractor) GetProjects(ctx context.Context, in *input.GetProjectsInput) (*output.ProjectListOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjects", ctx, in)
+	ret0, _ := ret[0].(*output.ProjectListOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjects indicates an expected call of GetProjects.
+func (mr *MockIProjectInteractorMockRecorder) GetProjects(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjects", reflect.TypeOf((*MockIProjectInteractor)(nil).GetProjects), ctx, in)
+}
+
+// UpdateProject mocks base method.
+func (m *MockIProjectInteractor) UpdateProject(ctx context.Context, in *input.UpdateProjectInput) (*output.ProjectOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProject", ctx, in)
+	ret0, _ := ret[0].(*output.ProjectOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProject indicates an expected call of UpdateProject.
+func (mr *MockIProjectInteractorMockRecorder) UpdateProject(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProject", reflect.TypeOf((*MockIProjectInteractor)(nil).UpdateProject), ctx, in)
+}
@@ -0,0 +1,109 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: reminder_scheduler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=reminder_scheduler.go -destination=mock/reminder_scheduler.go -package=mock_usecase
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	model "good-todo-go/internal/domain/model"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIReminderChannel is a mock of IReminderChannel interface.
+type MockIReminderChannel struct {
+	ctrl     *gomock.Controller
+	recorder *MockIReminderChannelMockRecorder
+	isgomock struct{}
+}
+
+// MockIReminderChannelMockRecorder is the mock recorder for MockIReminderChannel.
+type MockIReminderChannelMockRecorder struct {
+	mock *MockIReminderChannel
+}
+
+// NewMockIReminderChannel creates a new mock instance.
+func NewMockIReminderChannel(ctrl *gomock.Controller) *MockIReminderChannel {
+	mock := &MockIReminderChannel{ctrl: ctrl}
+	mock.recorder = &MockIReminderChannelMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIReminderChannel) EXPECT() *MockIReminderChannelMockRecorder {
+	return m.recorder
+}
+
+// Channel mocks base method.
+func (m *MockIReminderChannel) Channel() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Channel")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Channel indicates an expected call of Channel.
+func (mr *MockIReminderChannelMockRecorder) Channel() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Channel", reflect.TypeOf((*MockIReminderChannel)(nil).Channel))
+}
+
+// Deliver mocks base method.
+func (m *MockIReminderChannel) Deliver(ctx context.Context, reminder *model.DueReminder) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deliver", ctx, reminder)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Deliver indicates an expected call of Deliver.
+func (mr *MockIReminderChannelMockRecorder) Deliver(ctx, reminder any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deliver", reflect.TypeOf((*MockIReminderChannel)(nil).Deliver), ctx, reminder)
+}
+
+// MockIReminderScheduler is a mock of IReminderScheduler interface.
+type MockIReminderScheduler struct {
+	ctrl     *gomock.Controller
+	recorder *MockIReminderSchedulerMockRecorder
+	isgomock struct{}
+}
+
+// MockIReminderSchedulerMockRecorder is the mock recorder for MockIReminderScheduler.
+type MockIReminderSchedulerMockRecorder struct {
+	mock *MockIReminderScheduler
+}
+
+// NewMockIReminderScheduler creates a new mock instance.
+func NewMockIReminderScheduler(ctrl *gomock.Controller) *MockIReminderScheduler {
+	mock := &MockIReminderScheduler{ctrl: ctrl}
+	mock.recorder = &MockIReminderSchedulerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIReminderScheduler) EXPECT() *MockIReminderSchedulerMockRecorder {
+	return m.recorder
+}
+
+// RunOnce mocks base method.
+func (m *MockIReminderScheduler) RunOnce(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunOnce", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunOnce indicates an expected call of RunOnce.
+func (mr *MockIReminderSchedulerMockRecorder) RunOnce(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunOnce", reflect.TypeOf((*MockIReminderScheduler)(nil).RunOnce), ctx)
+}
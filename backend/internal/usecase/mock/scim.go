@@ -0,0 +1,147 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: scim.go
+//
+// Generated by this command:
+//
+//	mockgen -source=scim.go -destination=mock/scim.go -package=mock_usecase
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	input "good-todo-go/internal/usecase/input"
+	output "good-todo-go/internal/usecase/output"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIScimInteractor is a mock of IScimInteractor interface.
+type MockIScimInteractor struct {
+	ctrl     *gomock.Controller
+	recorder *MockIScimInteractorMockRecorder
+	isgomock struct{}
+}
+
+// MockIScimInteractorMockRecorder is the mock recorder for MockIScimInteractor.
+type MockIScimInteractorMockRecorder struct {
+	mock *MockIScimInteractor
+}
+
+// NewMockIScimInteractor creates a new mock instance.
+func NewMockIScimInteractor(ctrl *gomock.Controller) *MockIScimInteractor {
+	mock := &MockIScimInteractor{ctrl: ctrl}
+	mock.recorder = &MockIScimInteractorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIScimInteractor) EXPECT() *MockIScimInteractorMockRecorder {
+	return m.recorder
+}
+
+// Authenticate mocks base method.
+func (m *MockIScimInteractor) Authenticate(ctx context.Context, token string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authenticate", ctx, token)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authenticate indicates an expected call of Authenticate.
+func (mr *MockIScimInteractorMockRecorder) Authenticate(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockIScimInteractor)(nil).Authenticate), ctx, token)
+}
+
+// CreateUser mocks base method.
+func (m *MockIScimInteractor) CreateUser(ctx context.Context, in *input.CreateScimUserInput) (*output.UserOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUser", ctx, in)
+	ret0, _ := ret[0].(*output.UserOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUser indicates an expected call of CreateUser.
+func (mr *MockIScimInteractorMockRecorder) CreateUser(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockIScimInteractor)(nil).CreateUser), ctx, in)
+}
+
+// DeleteUser mocks base method.
+func (m *MockIScimInteractor) DeleteUser(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUser", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUser indicates an expected call of DeleteUser.
+func (mr *MockIScimInteractorMockRecorder) DeleteUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockIScimInteractor)(nil).DeleteUser), ctx, userID)
+}
+
+// GetUser mocks base method.
+func (m *MockIScimInteractor) GetUser(ctx context.Context, userID string) (*output.UserOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUser", ctx, userID)
+	ret0, _ := ret[0].(*output.UserOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *MockIScimInteractorMockRecorder) GetUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockIScimInteractor)(nil).GetUser), ctx, userID)
+}
+
+// ListUsers mocks base method.
+func (m *MockIScimInteractor) ListUsers(ctx context.Context, in *input.ListScimUsersInput) (*output.ScimUserListOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUsers", ctx, in)
+	ret0, _ := ret[0].(*output.ScimUserListOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUsers indicates an expected call of ListUsers.
+func (mr *MockIScimInteractorMockRecorder) ListUsers(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockIScimInteractor)(nil).ListUsers), ctx, in)
+}
+
+// PatchUser mocks base method.
+func (m *MockIScimInteractor) PatchUser(ctx context.Context, in *input.PatchScimUserInput) (*output.UserOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchUser", ctx, in)
+	ret0, _ := ret[0].(*output.UserOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PatchUser indicates an expected call of PatchUser.
+func (mr *MockIScimInteractorMockRecorder) PatchUser(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchUser", reflect.TypeOf((*MockIScimInteractor)(nil).PatchUser), ctx, in)
+}
+
+// ReplaceUser mocks base method.
+func (m *MockIScimInteractor) ReplaceUser(ctx context.Context, in *input.ReplaceScimUserInput) (*output.UserOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceUser", ctx, in)
+	ret0, _ := ret[0].(*output.UserOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceUser indicates an expected call of ReplaceUser.
+func (mr *MockIScimInteractorMockRecorder) ReplaceUser(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceUser", reflect.TypeOf((*MockIScimInteractor)(nil).ReplaceUser), ctx, in)
+}
@@ -0,0 +1,131 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: tag.go
+//
+// Generated by this command:
+//
+//	mockgen -source=tag.go -destination=mock/tag.go -package=mock_usecase
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	input "good-todo-go/internal/usecase/input"
+	output "good-todo-go/internal/usecase/output"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockITagInteractor is a mock of ITagInteractor interface.
+type MockITagInteractor struct {
+	ctrl     *gomock.Controller
+	recorder *MockITagInteractorMockRecorder
+	isgomock struct{}
+}
+
+// MockITagInteractorMockRecorder is the mock recorder for MockITagInteractor.
+type MockITagInteractorMockRecorder struct {
+	mock *MockITagInteractor
+}
+
+// NewMockITagInteractor creates a new mock instance.
+func NewMockITagInteractor(ctrl *gomock.Controller) *MockITagInteractor {
+	mock := &MockITagInteractor{ctrl: ctrl}
+	mock.recorder = &MockITagInteractorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockITagInteractor) EXPECT() *MockITagInteractorMockRecorder {
+	return m.recorder
+}
+
+// AttachTag mocks base method.
+func (m *MockITagInteractor) AttachTag(ctx context.Context, in *input.TodoTagInput) (*output.TodoOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachTag", ctx, in)
+	ret0, _ := ret[0].(*output.TodoOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AttachTag indicates an expected call of AttachTag.
+func (mr *MockITagInteractorMockRecorder) AttachTag(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachTag", reflect.TypeOf((*MockITagInteractor)(nil).AttachTag), ctx, in)
+}
+
+// CreateTag mocks base method.
+func (m *MockITagInteractor) CreateTag(ctx context.Context, in *input.CreateTagInput) (*output.TagOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTag", ctx, in)
+	ret0, _ := ret[0].(*output.TagOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTag indicates an expected call of CreateTag.
+func (mr *MockITagInteractorMockRecorder) CreateTag(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTag", reflect.TypeOf((*MockITagInteractor)(nil).CreateTag), ctx, in)
+}
+
+// DeleteTag mocks base method.
+func (m *MockITagInteractor) DeleteTag(ctx context.Context, in *input.DeleteTagInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTag", ctx, in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTag indicates an expected call of DeleteTag.
+func (mr *MockITagInteractorMockRecorder) DeleteTag(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTag", reflect.TypeOf((*MockITagInteractor)(nil).DeleteTag), ctx, in)
+}
+
+// DetachTag mocks base method.
+func (m *MockITagInteractor) DetachTag(ctx context.Context, in *input.TodoTagInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachTag", ctx, in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DetachTag indicates an expected call of DetachTag.
+func (mr *MockITagInteractorMockRecorder) DetachTag(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachTag", reflect.TypeOf((*MockITagInteractor)(nil).DetachTag), ctx, in)
+}
+
+// ListTags mocks base method.
+func (m *MockITagInteractor) ListTags(ctx context.Context) (*output.TagListOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTags", ctx)
+	ret0, _ := ret[0].(*output.TagListOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTags indicates an expected call of ListTags.
+func (mr *MockITagInteractorMockRecorder) ListTags(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTags", reflect.TypeOf((*MockITagInteractor)(nil).ListTags), ctx)
+}
+
+// UpdateTag mocks base method.
+func (m *MockITagInteractor) UpdateTag(ctx context.Context, in *input.UpdateTagInput) (*output.TagOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTag", ctx, in)
+	ret0, _ := ret[0].(*output.TagOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTag indicates an expected call of UpdateTag.
+func (mr *MockITagInteractorMockRecorder) UpdateTag(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTag", reflect.TypeOf((*MockITagInteractor)(nil).UpdateTag), ctx, in)
+}
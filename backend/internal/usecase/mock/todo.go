@@ -42,6 +42,36 @@ func (m *MockITodoInteractor) EXPECT() *MockITodoInteractorMockRecorder {
 	return m.recorder
 }
 
+// AssignTodo mocks base method.
+func (m *MockITodoInteractor) AssignTodo(ctx context.Context, in *input.AssignTodoInput) (*output.TodoOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignTodo", ctx, in)
+	ret0, _ := ret[0].(*output.TodoOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssignTodo indicates an expected call of AssignTodo.
+func (mr *MockITodoInteractorMockRecorder) AssignTodo(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignTodo", reflect.TypeOf((*MockITodoInteractor)(nil).AssignTodo), ctx, in)
+}
+
+// BulkUpdateTodos mocks base method.
+func (m *MockITodoInteractor) BulkUpdateTodos(ctx context.Context, in *input.BulkTodosInput) (*output.TodoBulkOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkUpdateTodos", ctx, in)
+	ret0, _ := ret[0].(*output.TodoBulkOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BulkUpdateTodos indicates an expected call of BulkUpdateTodos.
+func (mr *MockITodoInteractorMockRecorder) BulkUpdateTodos(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkUpdateTodos", reflect.TypeOf((*MockITodoInteractor)(nil).BulkUpdateTodos), ctx, in)
+}
+
 // CreateTodo mocks base method.
 func (m *MockITodoInteractor) CreateTodo(ctx context.Context, in *input.CreateTodoInput) (*output.TodoOutput, error) {
 	m.ctrl.T.Helper()
@@ -58,17 +88,47 @@ func (mr *MockITodoInteractorMockRecorder) CreateTodo(ctx, in any) *gomock.Call
 }
 
 // DeleteTodo mocks base method.
-func (m *MockITodoInteractor) DeleteTodo(ctx context.Context, todoID, userID string) error {
+func (m *MockITodoInteractor) DeleteTodo(ctx context.Context, in *input.DeleteTodoInput) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteTodo", ctx, todoID, userID)
+	ret := m.ctrl.Call(m, "DeleteTodo", ctx, in)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteTodo indicates an expected call of DeleteTodo.
-func (mr *MockITodoInteractorMockRecorder) DeleteTodo(ctx, todoID, userID any) *gomock.Call {
+func (mr *MockITodoInteractorMockRecorder) DeleteTodo(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTodo", reflect.TypeOf((*MockITodoInteractor)(nil).DeleteTodo), ctx, in)
+}
+
+// GetAssignedTodos mocks base method.
+func (m *MockITodoInteractor) GetAssignedTodos(ctx context.Context, in *input.GetTodosInput) (*output.TodoListOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssignedTodos", ctx, in)
+	ret0, _ := ret[0].(*output.TodoListOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAssignedTodos indicates an expected call of GetAssignedTodos.
+func (mr *MockITodoInteractorMockRecorder) GetAssignedTodos(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssignedTodos", reflect.TypeOf((*MockITodoInteractor)(nil).GetAssignedTodos), ctx, in)
+}
+
+// GetCompletionStats mocks base method.
+func (m *MockITodoInteractor) GetCompletionStats(ctx context.Context, in *input.GetCompletionStatsInput) (*output.CompletionStatsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCompletionStats", ctx, in)
+	ret0, _ := ret[0].(*output.CompletionStatsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCompletionStats indicates an expected call of GetCompletionStats.
+func (mr *MockITodoInteractorMockRecorder) GetCompletionStats(ctx, in any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTodo", reflect.TypeOf((*MockITodoInteractor)(nil).DeleteTodo), ctx, todoID, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompletionStats", reflect.TypeOf((*MockITodoInteractor)(nil).GetCompletionStats), ctx, in)
 }
 
 // GetPublicTodos mocks base method.
@@ -86,6 +146,21 @@ func (mr *MockITodoInteractorMockRecorder) GetPublicTodos(ctx, in any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublicTodos", reflect.TypeOf((*MockITodoInteractor)(nil).GetPublicTodos), ctx, in)
 }
 
+// GetSharedTodos mocks base method.
+func (m *MockITodoInteractor) GetSharedTodos(ctx context.Context, in *input.GetTodosInput) (*output.TodoListOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSharedTodos", ctx, in)
+	ret0, _ := ret[0].(*output.TodoListOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSharedTodos indicates an expected call of GetSharedTodos.
+func (mr *MockITodoInteractorMockRecorder) GetSharedTodos(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSharedTodos", reflect.TypeOf((*MockITodoInteractor)(nil).GetSharedTodos), ctx, in)
+}
+
 // GetTodo mocks base method.
 func (m *MockITodoInteractor) GetTodo(ctx context.Context, todoID, userID string) (*output.TodoOutput, error) {
 	m.ctrl.T.Helper()
@@ -101,6 +176,51 @@ func (mr *MockITodoInteractorMockRecorder) GetTodo(ctx, todoID, userID any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTodo", reflect.TypeOf((*MockITodoInteractor)(nil).GetTodo), ctx, todoID, userID)
 }
 
+// GetTodoAssignments mocks base method.
+func (m *MockITodoInteractor) GetTodoAssignments(ctx context.Context, todoID, userID string) (*output.TodoAssignmentListOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTodoAssignments", ctx, todoID, userID)
+	ret0, _ := ret[0].(*output.TodoAssignmentListOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTodoAssignments indicates an expected call of GetTodoAssignments.
+func (mr *MockITodoInteractorMockRecorder) GetTodoAssignments(ctx, todoID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTodoAssignments", reflect.TypeOf((*MockITodoInteractor)(nil).GetTodoAssignments), ctx, todoID, userID)
+}
+
+// GetTodoHistory mocks base method.
+func (m *MockITodoInteractor) GetTodoHistory(ctx context.Context, in *input.GetTodoHistoryInput) (*output.TodoRevisionListOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTodoHistory", ctx, in)
+	ret0, _ := ret[0].(*output.TodoRevisionListOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTodoHistory indicates an expected call of GetTodoHistory.
+func (mr *MockITodoInteractorMockRecorder) GetTodoHistory(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTodoHistory", reflect.TypeOf((*MockITodoInteractor)(nil).GetTodoHistory), ctx, in)
+}
+
+// GetTodoShares mocks base method.
+func (m *MockITodoInteractor) GetTodoShares(ctx context.Context, todoID, userID string) (*output.TodoShareListOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTodoShares", ctx, todoID, userID)
+	ret0, _ := ret[0].(*output.TodoShareListOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTodoShares indicates an expected call of GetTodoShares.
+func (mr *MockITodoInteractorMockRecorder) GetTodoShares(ctx, todoID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTodoShares", reflect.TypeOf((*MockITodoInteractor)(nil).GetTodoShares), ctx, todoID, userID)
+}
+
 // GetTodos mocks base method.
 func (m *MockITodoInteractor) GetTodos(ctx context.Context, in *input.GetTodosInput) (*output.TodoListOutput, error) {
 	m.ctrl.T.Helper()
@@ -116,6 +236,200 @@ func (mr *MockITodoInteractorMockRecorder) GetTodos(ctx, in any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTodos", reflect.TypeOf((*MockITodoInteractor)(nil).GetTodos), ctx, in)
 }
 
+// GetTrash mocks base method.
+func (m *MockITodoInteractor) GetTrash(ctx context.Context, in *input.GetTrashInput) (*output.TodoListOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrash", ctx, in)
+	ret0, _ := ret[0].(*output.TodoListOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrash indicates an expected call of GetTrash.
+func (mr *MockITodoInteractorMockRecorder) GetTrash(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrash", reflect.TypeOf((*MockITodoInteractor)(nil).GetTrash), ctx, in)
+}
+
+// MoveTodo mocks base method.
+func (m *MockITodoInteractor) MoveTodo(ctx context.Context, in *input.MoveTodoInput) (*output.TodoOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveTodo", ctx, in)
+	ret0, _ := ret[0].(*output.TodoOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveTodo indicates an expected call of MoveTodo.
+func (mr *MockITodoInteractorMockRecorder) MoveTodo(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveTodo", reflect.TypeOf((*MockITodoInteractor)(nil).MoveTodo), ctx, in)
+}
+
+// PatchTodo mocks base method.
+func (m *MockITodoInteractor) PatchTodo(ctx context.Context, in *input.PatchTodoInput) (*output.TodoOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchTodo", ctx, in)
+	ret0, _ := ret[0].(*output.TodoOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PatchTodo indicates an expected call of PatchTodo.
+func (mr *MockITodoInteractorMockRecorder) PatchTodo(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchTodo", reflect.TypeOf((*MockITodoInteractor)(nil).PatchTodo), ctx, in)
+}
+
+// ReorderTodo mocks base method.
+func (m *MockITodoInteractor) ReorderTodo(ctx context.Context, in *input.ReorderTodoInput) (*output.TodoOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderTodo", ctx, in)
+	ret0, _ := ret[0].(*output.TodoOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReorderTodo indicates an expected call of ReorderTodo.
+func (mr *MockITodoInteractorMockRecorder) ReorderTodo(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderTodo", reflect.TypeOf((*MockITodoInteractor)(nil).ReorderTodo), ctx, in)
+}
+
+// RestoreTodo mocks base method.
+func (m *MockITodoInteractor) RestoreTodo(ctx context.Context, todoID, userID string) (*output.TodoOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreTodo", ctx, todoID, userID)
+	ret0, _ := ret[0].(*output.TodoOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreTodo indicates an expected call of RestoreTodo.
+func (mr *MockITodoInteractorMockRecorder) RestoreTodo(ctx, todoID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreTodo", reflect.TypeOf((*MockITodoInteractor)(nil).RestoreTodo), ctx, todoID, userID)
+}
+
+// RevertTodo mocks base method.
+func (m *MockITodoInteractor) RevertTodo(ctx context.Context, in *input.RevertTodoInput) (*output.TodoOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevertTodo", ctx, in)
+	ret0, _ := ret[0].(*output.TodoOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevertTodo indicates an expected call of RevertTodo.
+func (mr *MockITodoInteractorMockRecorder) RevertTodo(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevertTodo", reflect.TypeOf((*MockITodoInteractor)(nil).RevertTodo), ctx, in)
+}
+
+// SearchTodos mocks base method.
+func (m *MockITodoInteractor) SearchTodos(ctx context.Context, in *input.SearchTodosInput) (*output.TodoSearchOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchTodos", ctx, in)
+	ret0, _ := ret[0].(*output.TodoSearchOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchTodos indicates an expected call of SearchTodos.
+func (mr *MockITodoInteractorMockRecorder) SearchTodos(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchTodos", reflect.TypeOf((*MockITodoInteractor)(nil).SearchTodos), ctx, in)
+}
+
+// SetTodoParent mocks base method.
+func (m *MockITodoInteractor) SetTodoParent(ctx context.Context, in *input.SetTodoParentInput) (*output.TodoOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTodoParent", ctx, in)
+	ret0, _ := ret[0].(*output.TodoOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTodoParent indicates an expected call of SetTodoParent.
+func (mr *MockITodoInteractorMockRecorder) SetTodoParent(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTodoParent", reflect.TypeOf((*MockITodoInteractor)(nil).SetTodoParent), ctx, in)
+}
+
+// SetTodoRecurrence mocks base method.
+func (m *MockITodoInteractor) SetTodoRecurrence(ctx context.Context, in *input.SetTodoRecurrenceInput) (*output.TodoOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTodoRecurrence", ctx, in)
+	ret0, _ := ret[0].(*output.TodoOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTodoRecurrence indicates an expected call of SetTodoRecurrence.
+func (mr *MockITodoInteractorMockRecorder) SetTodoRecurrence(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTodoRecurrence", reflect.TypeOf((*MockITodoInteractor)(nil).SetTodoRecurrence), ctx, in)
+}
+
+// ShareTodo mocks base method.
+func (m *MockITodoInteractor) ShareTodo(ctx context.Context, in *input.ShareTodoInput) (*output.TodoShareOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShareTodo", ctx, in)
+	ret0, _ := ret[0].(*output.TodoShareOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShareTodo indicates an expected call of ShareTodo.
+func (mr *MockITodoInteractorMockRecorder) ShareTodo(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShareTodo", reflect.TypeOf((*MockITodoInteractor)(nil).ShareTodo), ctx, in)
+}
+
+// SkipTodoOccurrence mocks base method.
+func (m *MockITodoInteractor) SkipTodoOccurrence(ctx context.Context, todoID, userID string) (*output.TodoOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SkipTodoOccurrence", ctx, todoID, userID)
+	ret0, _ := ret[0].(*output.TodoOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SkipTodoOccurrence indicates an expected call of SkipTodoOccurrence.
+func (mr *MockITodoInteractorMockRecorder) SkipTodoOccurrence(ctx, todoID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SkipTodoOccurrence", reflect.TypeOf((*MockITodoInteractor)(nil).SkipTodoOccurrence), ctx, todoID, userID)
+}
+
+// StopTodoRecurrence mocks base method.
+func (m *MockITodoInteractor) StopTodoRecurrence(ctx context.Context, todoID, userID string) (*output.TodoOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopTodoRecurrence", ctx, todoID, userID)
+	ret0, _ := ret[0].(*output.TodoOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StopTodoRecurrence indicates an expected call of StopTodoRecurrence.
+func (mr *MockITodoInteractorMockRecorder) StopTodoRecurrence(ctx, todoID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopTodoRecurrence", reflect.TypeOf((*MockITodoInteractor)(nil).StopTodoRecurrence), ctx, todoID, userID)
+}
+
+// UnshareTodo mocks base method.
+func (m *MockITodoInteractor) UnshareTodo(ctx context.Context, todoID, userID, memberID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnshareTodo", ctx, todoID, userID, memberID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnshareTodo indicates an expected call of UnshareTodo.
+func (mr *MockITodoInteractorMockRecorder) UnshareTodo(ctx, todoID, userID, memberID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnshareTodo", reflect.TypeOf((*MockITodoInteractor)(nil).UnshareTodo), ctx, todoID, userID, memberID)
+}
+
 // UpdateTodo mocks base method.
 func (m *MockITodoInteractor) UpdateTodo(ctx context.Context, in *input.UpdateTodoInput) (*output.TodoOutput, error) {
 	m.ctrl.T.Helper()
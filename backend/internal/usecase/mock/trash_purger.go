@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: trash_purger.go
+//
+// Generated by this command:
+//
+//	mockgen -source=trash_purger.go -destination=mock/trash_purger.go -package=mock_usecase
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockITrashPurger is a mock of ITrashPurger interface.
+type MockITrashPurger struct {
+	ctrl     *gomock.Controller
+	recorder *MockITrashPurgerMockRecorder
+	isgomock struct{}
+}
+
+// MockITrashPurgerMockRecorder is the mock recorder for MockITrashPurger.
+type MockITrashPurgerMockRecorder struct {
+	mock *MockITrashPurger
+}
+
+// NewMockITrashPurger creates a new mock instance.
+func NewMockITrashPurger(ctrl *gomock.Controller) *MockITrashPurger {
+	mock := &MockITrashPurger{ctrl: ctrl}
+	mock.recorder = &MockITrashPurgerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockITrashPurger) EXPECT() *MockITrashPurgerMockRecorder {
+	return m.recorder
+}
+
+// RunOnce mocks base method.
+func (m *MockITrashPurger) RunOnce(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunOnce", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunOnce indicates an expected call of RunOnce.
+func (mr *MockITrashPurgerMockRecorder) RunOnce(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunOnce", reflect.TypeOf((*MockITrashPurger)(nil).RunOnce), ctx)
+}
@@ -42,6 +42,50 @@ func (m *MockIUserInteractor) EXPECT() *MockIUserInteractorMockRecorder {
 	return m.recorder
 }
 
+// Authenticate mocks base method.
+func (m *MockIUserInteractor) Authenticate(ctx context.Context, userID string) (*output.UserOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authenticate", ctx, userID)
+	ret0, _ := ret[0].(*output.UserOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authenticate indicates an expected call of Authenticate.
+func (mr *MockIUserInteractorMockRecorder) Authenticate(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockIUserInteractor)(nil).Authenticate), ctx, userID)
+}
+
+// DeleteMe mocks base method.
+func (m *MockIUserInteractor) DeleteMe(ctx context.Context, in *input.DeleteMeInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMe", ctx, in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMe indicates an expected call of DeleteMe.
+func (mr *MockIUserInteractorMockRecorder) DeleteMe(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMe", reflect.TypeOf((*MockIUserInteractor)(nil).DeleteMe), ctx, in)
+}
+
+// ExportMe mocks base method.
+func (m *MockIUserInteractor) ExportMe(ctx context.Context, userID string) (*output.AccountExportOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportMe", ctx, userID)
+	ret0, _ := ret[0].(*output.AccountExportOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportMe indicates an expected call of ExportMe.
+func (mr *MockIUserInteractorMockRecorder) ExportMe(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportMe", reflect.TypeOf((*MockIUserInteractor)(nil).ExportMe), ctx, userID)
+}
+
 // GetMe mocks base method.
 func (m *MockIUserInteractor) GetMe(ctx context.Context, userID string) (*output.UserOutput, error) {
 	m.ctrl.T.Helper()
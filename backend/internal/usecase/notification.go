@@ -0,0 +1,57 @@
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_usecase
+package usecase
+
+import (
+	"context"
+
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/pkg/cerror"
+	"good-todo-go/internal/usecase/input"
+	"good-todo-go/internal/usecase/output"
+)
+
+type INotificationInteractor interface {
+	// GetNotifications lists the user's in-app notifications, newest first
+	GetNotifications(ctx context.Context, in *input.GetNotificationsInput) (*output.NotificationListOutput, error)
+	MarkNotificationRead(ctx context.Context, notificationID, userID string) error
+}
+
+const (
+	defaultNotificationLimit = 50
+	maxNotificationLimit     = 100
+)
+
+type NotificationInteractor struct {
+	notificationRepo repository.INotificationRepository
+}
+
+func NewNotificationInteractor(notificationRepo repository.INotificationRepository) INotificationInteractor {
+	return &NotificationInteractor{notificationRepo: notificationRepo}
+}
+
+func (i *NotificationInteractor) GetNotifications(ctx context.Context, in *input.GetNotificationsInput) (*output.NotificationListOutput, error) {
+	limit := in.Limit
+	if limit <= 0 {
+		limit = defaultNotificationLimit
+	}
+	if limit > maxNotificationLimit {
+		limit = maxNotificationLimit
+	}
+
+	notifications, err := i.notificationRepo.FindByUserID(ctx, in.UserID, in.UnreadOnly, limit)
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to get notifications", err)
+	}
+	return output.NewNotificationListOutput(notifications), nil
+}
+
+func (i *NotificationInteractor) MarkNotificationRead(ctx context.Context, notificationID, userID string) error {
+	found, err := i.notificationRepo.MarkRead(ctx, notificationID, userID)
+	if err != nil {
+		return cerror.NewInternalServerError("failed to update notification", err)
+	}
+	if !found {
+		return cerror.NewNotFound("notification not found", nil)
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package output
+
+import (
+	"io"
+
+	"good-todo-go/internal/domain/model"
+)
+
+type AttachmentOutput struct {
+	ID          string
+	TodoID      string
+	UploadedBy  string
+	FileName    string
+	ContentType string
+	Size        int64
+	CreatedAt   string
+}
+
+type AttachmentListOutput struct {
+	Attachments []*AttachmentOutput
+}
+
+// AttachmentContentOutput streams an attachment; the caller closes Content
+type AttachmentContentOutput struct {
+	Attachment *AttachmentOutput
+	Content    io.ReadCloser
+}
+
+func NewAttachmentOutput(attachment *model.Attachment) *AttachmentOutput {
+	return &AttachmentOutput{
+		ID:          attachment.ID,
+		TodoID:      attachment.TodoID,
+		UploadedBy:  attachment.UploadedBy,
+		FileName:    attachment.FileName,
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+		CreatedAt:   attachment.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+func NewAttachmentListOutput(attachments []*model.Attachment) *AttachmentListOutput {
+	outputs := make([]*AttachmentOutput, len(attachments))
+	for i, a := range attachments {
+		outputs[i] = NewAttachmentOutput(a)
+	}
+	return &AttachmentListOutput{Attachments: outputs}
+}
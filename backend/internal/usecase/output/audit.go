@@ -0,0 +1,52 @@
+package output
+
+import "good-todo-go/internal/domain/model"
+
+type AuditEventOutput struct {
+	ID         string
+	TenantID   string
+	ActorType  string
+	ActorID    *string
+	Action     string
+	TargetType *string
+	TargetID   *string
+	IP         string
+	UserAgent  string
+	RequestID  string
+	Metadata   map[string]string
+	CreatedAt  string
+}
+
+type AuditEventListOutput struct {
+	Events []*AuditEventOutput
+	Total  int
+}
+
+func NewAuditEventOutput(event *model.AuditEvent) *AuditEventOutput {
+	return &AuditEventOutput{
+		ID:         event.ID,
+		TenantID:   event.TenantID,
+		ActorType:  event.ActorType,
+		ActorID:    event.ActorID,
+		Action:     event.Action,
+		TargetType: event.TargetType,
+		TargetID:   event.TargetID,
+		IP:         event.IP,
+		UserAgent:  event.UserAgent,
+		RequestID:  event.RequestID,
+		Metadata:   event.Metadata,
+		CreatedAt:  event.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+func NewAuditEventListOutput(events []*model.AuditEvent, total int) *AuditEventListOutput {
+	outputs := make([]*AuditEventOutput, len(events))
+	for i, e := range events {
+		outputs[i] = NewAuditEventOutput(e)
+	}
+
+	return &AuditEventListOutput{
+		Events: outputs,
+		Total:  total,
+	}
+}
@@ -16,6 +16,8 @@ type UserOutput struct {
 	Name          string
 	Role          string
 	EmailVerified bool
+	IsActive      bool
+	ExternalID    *string
 	TenantID      string
 	CreatedAt     string
 	UpdatedAt     string
@@ -28,6 +30,8 @@ func NewUserOutput(user *model.User) *UserOutput {
 		Name:          user.Name,
 		Role:          user.Role,
 		EmailVerified: user.EmailVerified,
+		IsActive:      user.IsActive,
+		ExternalID:    user.ExternalID,
 		TenantID:      user.TenantID,
 		CreatedAt:     user.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		UpdatedAt:     user.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
@@ -0,0 +1,34 @@
+package output
+
+import (
+	"time"
+
+	"good-todo-go/internal/domain/model"
+)
+
+// CalendarOutput is a list of todos to render as an iCalendar stream
+type CalendarOutput struct {
+	// Component is model.CalendarComponentEvent or model.CalendarComponentTodo
+	Component   string
+	GeneratedAt string
+	Todos       []*TodoOutput
+}
+
+func NewCalendarOutput(component string, todos []*model.Todo, generatedAt time.Time) *CalendarOutput {
+	outputs := make([]*TodoOutput, len(todos))
+	for i, t := range todos {
+		outputs[i] = NewTodoOutput(t)
+	}
+	return &CalendarOutput{
+		Component:   component,
+		GeneratedAt: generatedAt.UTC().Format("2006-01-02T15:04:05Z07:00"),
+		Todos:       outputs,
+	}
+}
+
+type CalendarTokenOutput struct {
+	// Token is only returned once, when it is issued
+	Token string
+	// FeedPath is where the feed is served, relative to the API's base URL
+	FeedPath string
+}
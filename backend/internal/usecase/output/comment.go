@@ -0,0 +1,59 @@
+package output
+
+import "good-todo-go/internal/domain/model"
+
+type CommentAuthorOutput struct {
+	ID   string
+	Name string
+}
+
+type CommentOutput struct {
+	ID     string
+	TodoID string
+	// Author is nil once the author's account has been deleted
+	Author    *CommentAuthorOutput
+	Body      string
+	Edited    bool
+	EditedAt  *string
+	Deleted   bool
+	CreatedAt string
+}
+
+type CommentListOutput struct {
+	Comments []*CommentOutput
+}
+
+// NewCommentOutput names the author from authors, keyed by user ID
+func NewCommentOutput(comment *model.Comment, authors map[string]*model.User) *CommentOutput {
+	var author *CommentAuthorOutput
+	if comment.AuthorID != nil {
+		if u, ok := authors[*comment.AuthorID]; ok {
+			author = &CommentAuthorOutput{ID: u.ID, Name: u.Name}
+		}
+	}
+
+	var editedAt *string
+	if comment.EditedAt != nil {
+		formatted := comment.EditedAt.Format("2006-01-02T15:04:05Z07:00")
+		editedAt = &formatted
+	}
+
+	return &CommentOutput{
+		ID:        comment.ID,
+		TodoID:    comment.TodoID,
+		Author:    author,
+		Body:      comment.Body,
+		Edited:    comment.EditedAt != nil,
+		EditedAt:  editedAt,
+		Deleted:   comment.DeletedAt != nil,
+		CreatedAt: comment.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+func NewCommentListOutput(comments []*model.Comment, authors map[string]*model.User) *CommentListOutput {
+	outputs := make([]*CommentOutput, len(comments))
+	for i, c := range comments {
+		outputs[i] = NewCommentOutput(c, authors)
+	}
+	return &CommentListOutput{Comments: outputs}
+}
@@ -0,0 +1,41 @@
+package output
+
+import "good-todo-go/internal/domain/model"
+
+type NotificationOutput struct {
+	ID        string
+	TodoID    *string
+	Title     string
+	Body      string
+	ReadAt    *string
+	CreatedAt string
+}
+
+type NotificationListOutput struct {
+	Notifications []*NotificationOutput
+}
+
+func NewNotificationOutput(notification *model.Notification) *NotificationOutput {
+	var readAt *string
+	if notification.ReadAt != nil {
+		formatted := notification.ReadAt.Format("2006-01-02T15:04:05Z07:00")
+		readAt = &formatted
+	}
+
+	return &NotificationOutput{
+		ID:        notification.ID,
+		TodoID:    notification.TodoID,
+		Title:     notification.Title,
+		Body:      notification.Body,
+		ReadAt:    readAt,
+		CreatedAt: notification.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+func NewNotificationListOutput(notifications []*model.Notification) *NotificationListOutput {
+	outputs := make([]*NotificationOutput, len(notifications))
+	for i, n := range notifications {
+		outputs[i] = NewNotificationOutput(n)
+	}
+	return &NotificationListOutput{Notifications: outputs}
+}
@@ -0,0 +1,52 @@
+package output
+
+import "good-todo-go/internal/domain/model"
+
+type ProjectOutput struct {
+	ID             string
+	UserID         string
+	Name           string
+	Description    string
+	IsPublic       bool
+	ArchivedAt     *string
+	OpenCount      int
+	CompletedCount int
+	CreatedAt      string
+	UpdatedAt      string
+}
+
+type ProjectListOutput struct {
+	Projects []*ProjectOutput
+}
+
+func NewProjectOutput(project *model.Project, counts *model.ProjectTodoCounts) *ProjectOutput {
+	var archivedAt *string
+	if project.ArchivedAt != nil {
+		formatted := project.ArchivedAt.Format("2006-01-02T15:04:05Z07:00")
+		archivedAt = &formatted
+	}
+
+	out := &ProjectOutput{
+		ID:          project.ID,
+		UserID:      project.UserID,
+		Name:        project.Name,
+		Description: project.Description,
+		IsPublic:    project.IsPublic,
+		ArchivedAt:  archivedAt,
+		CreatedAt:   project.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:   project.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if counts != nil {
+		out.OpenCount = counts.Open
+		out.CompletedCount = counts.Completed
+	}
+	return out
+}
+
+func NewProjectListOutput(projects []*model.Project, counts map[string]*model.ProjectTodoCounts) *ProjectListOutput {
+	outputs := make([]*ProjectOutput, len(projects))
+	for i, p := range projects {
+		outputs[i] = NewProjectOutput(p, counts[p.ID])
+	}
+	return &ProjectListOutput{Projects: outputs}
+}
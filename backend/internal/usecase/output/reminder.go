@@ -0,0 +1,57 @@
+package output
+
+import "good-todo-go/internal/domain/model"
+
+type ReminderOutput struct {
+	ID            string
+	TodoID        string
+	RemindAt      string
+	OffsetMinutes *int
+	Channel       string
+	// Status is pending, sent or failed
+	Status    string
+	SentAt    *string
+	CreatedAt string
+}
+
+type ReminderListOutput struct {
+	Reminders []*ReminderOutput
+}
+
+// Reminder statuses
+const (
+	ReminderStatusPending = "pending"
+	ReminderStatusSent    = "sent"
+	ReminderStatusFailed  = "failed"
+)
+
+func NewReminderOutput(reminder *model.Reminder) *ReminderOutput {
+	status := ReminderStatusPending
+	var sentAt *string
+	if reminder.SentAt != nil {
+		status = ReminderStatusSent
+		formatted := reminder.SentAt.Format("2006-01-02T15:04:05Z07:00")
+		sentAt = &formatted
+	} else if reminder.FailedAt != nil {
+		status = ReminderStatusFailed
+	}
+
+	return &ReminderOutput{
+		ID:            reminder.ID,
+		TodoID:        reminder.TodoID,
+		RemindAt:      reminder.RemindAt.Format("2006-01-02T15:04:05Z07:00"),
+		OffsetMinutes: reminder.OffsetMinutes,
+		Channel:       reminder.Channel,
+		Status:        status,
+		SentAt:        sentAt,
+		CreatedAt:     reminder.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+func NewReminderListOutput(reminders []*model.Reminder) *ReminderListOutput {
+	outputs := make([]*ReminderOutput, len(reminders))
+	for i, r := range reminders {
+		outputs[i] = NewReminderOutput(r)
+	}
+	return &ReminderListOutput{Reminders: outputs}
+}
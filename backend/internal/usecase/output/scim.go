@@ -0,0 +1,12 @@
+package output
+
+type ScimUserListOutput struct {
+	Users        []*UserOutput
+	TotalResults int
+	StartIndex   int
+}
+
+type ScimTokenOutput struct {
+	// Token is only returned once, when it is issued
+	Token string
+}
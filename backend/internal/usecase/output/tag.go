@@ -0,0 +1,45 @@
+package output
+
+import "good-todo-go/internal/domain/model"
+
+type TagOutput struct {
+	ID        string
+	Name      string
+	Color     string
+	CreatedAt string
+	UpdatedAt string
+}
+
+type TagListOutput struct {
+	Tags []*TagOutput
+}
+
+func NewTagOutput(tag *model.Tag) *TagOutput {
+	return &TagOutput{
+		ID:        tag.ID,
+		Name:      tag.Name,
+		Color:     tag.Color,
+		CreatedAt: tag.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt: tag.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+// NewTagOutputs keeps nil as nil so that unloaded tags stay distinguishable
+func NewTagOutputs(tags []*model.Tag) []*TagOutput {
+	if tags == nil {
+		return nil
+	}
+	outputs := make([]*TagOutput, len(tags))
+	for i, t := range tags {
+		outputs[i] = NewTagOutput(t)
+	}
+	return outputs
+}
+
+func NewTagListOutput(tags []*model.Tag) *TagListOutput {
+	outputs := NewTagOutputs(tags)
+	if outputs == nil {
+		outputs = []*TagOutput{}
+	}
+	return &TagListOutput{Tags: outputs}
+}
@@ -0,0 +1,19 @@
+package output
+
+import "good-todo-go/internal/domain/model"
+
+type TenantSettingsOutput struct {
+	MagicLinkEnabled      bool
+	AccountDeletionPolicy string
+	SearchLanguage        string
+	TrashRetentionDays    int
+}
+
+func NewTenantSettingsOutput(tenant *model.Tenant) *TenantSettingsOutput {
+	return &TenantSettingsOutput{
+		MagicLinkEnabled:      tenant.Settings.MagicLinkEnabled,
+		AccountDeletionPolicy: tenant.Settings.AccountDeletionPolicy,
+		SearchLanguage:        tenant.Settings.SearchLanguage,
+		TrashRetentionDays:    tenant.Settings.TrashRetentionDays,
+	}
+}
@@ -1,6 +1,10 @@
 package output
 
 import (
+	"html"
+	"strings"
+	"time"
+
 	"good-todo-go/internal/domain/model"
 )
 
@@ -17,14 +21,48 @@ type TodoOutput struct {
 	Completed   bool
 	IsPublic    bool
 	DueDate     *string
-	CreatedBy   *TodoCreatorOutput
-	CreatedAt   string
-	UpdatedAt   string
+	CompletedAt *string
+	Priority    string
+	Position    string
+	ProjectID   *string
+	ParentID    *string
+	AssigneeID  *string
+	Recurrence  *TodoRecurrenceOutput
+	// NextOccurrence is the todo created by completing a recurring todo
+	NextOccurrence *TodoOutput
+	// Children and Progress are only filled in by the todo detail
+	Children     []*TodoOutput
+	Progress     *TodoProgressOutput
+	CreatedBy    *TodoCreatorOutput
+	Tags         []*TagOutput
+	CommentCount int
+	// DeletedAt is only set on todos in the trash
+	DeletedAt *string
+	Version   int
+	CreatedAt string
+	UpdatedAt string
+}
+
+type TodoRecurrenceOutput struct {
+	Rule     string
+	Timezone string
+	Start    string
+}
+
+// TodoProgressOutput summarizes how many direct subtasks are completed
+type TodoProgressOutput struct {
+	Completed int
+	Total     int
+	// Ratio is Completed / Total
+	Ratio float64
 }
 
 type TodoListOutput struct {
 	Todos []*TodoOutput
-	Total int
+	// Total is nil when the count was not requested
+	Total *int
+	// NextCursor is nil on the last page
+	NextCursor *string
 }
 
 func NewTodoOutput(todo *model.Todo) *TodoOutput {
@@ -34,17 +72,70 @@ func NewTodoOutput(todo *model.Todo) *TodoOutput {
 		dueDate = &formatted
 	}
 
+	var completedAt *string
+	if todo.CompletedAt != nil {
+		formatted := todo.CompletedAt.Format("2006-01-02T15:04:05Z07:00")
+		completedAt = &formatted
+	}
+
+	var deletedAt *string
+	if todo.DeletedAt != nil {
+		formatted := todo.DeletedAt.Format("2006-01-02T15:04:05Z07:00")
+		deletedAt = &formatted
+	}
+
+	var recurrence *TodoRecurrenceOutput
+	if todo.Recurrence != nil {
+		recurrence = &TodoRecurrenceOutput{
+			Rule:     todo.Recurrence.Rule,
+			Timezone: todo.Recurrence.Timezone,
+			Start:    todo.Recurrence.Start.Format("2006-01-02T15:04:05Z07:00"),
+		}
+	}
+
 	return &TodoOutput{
-		ID:          todo.ID,
-		UserID:      todo.UserID,
-		Title:       todo.Title,
-		Description: todo.Description,
-		Completed:   todo.Completed,
-		IsPublic:    todo.IsPublic,
-		DueDate:     dueDate,
-		CreatedAt:   todo.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-		UpdatedAt:   todo.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		ID:           todo.ID,
+		UserID:       todo.UserID,
+		Title:        todo.Title,
+		Description:  todo.Description,
+		Completed:    todo.Completed,
+		IsPublic:     todo.IsPublic,
+		DueDate:      dueDate,
+		CompletedAt:  completedAt,
+		Priority:     todo.Priority,
+		Position:     todo.Position,
+		ProjectID:    todo.ProjectID,
+		ParentID:     todo.ParentID,
+		AssigneeID:   todo.AssigneeID,
+		Recurrence:   recurrence,
+		Tags:         NewTagOutputs(todo.Tags),
+		CommentCount: todo.CommentCount,
+		DeletedAt:    deletedAt,
+		Version:      todo.Version,
+		CreatedAt:    todo.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:    todo.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+// NewTodoDetailOutput includes the todo's direct subtasks and the share of them that is completed
+func NewTodoDetailOutput(todo *model.Todo, children []*model.Todo) *TodoOutput {
+	output := NewTodoOutput(todo)
+	output.Children = make([]*TodoOutput, len(children))
+	completed := 0
+	for i, c := range children {
+		output.Children[i] = NewTodoOutput(c)
+		if c.Completed {
+			completed++
+		}
+	}
+	if len(children) > 0 {
+		output.Progress = &TodoProgressOutput{
+			Completed: completed,
+			Total:     len(children),
+			Ratio:     float64(completed) / float64(len(children)),
+		}
 	}
+	return output
 }
 
 func NewTodoOutputWithCreator(todo *model.Todo, creator *model.User) *TodoOutput {
@@ -58,26 +149,234 @@ func NewTodoOutputWithCreator(todo *model.Todo, creator *model.User) *TodoOutput
 	return output
 }
 
-func NewTodoListOutput(todos []*model.Todo, total int) *TodoListOutput {
+func NewTodoListOutput(todos []*model.Todo, total *int, nextCursor *string) *TodoListOutput {
 	outputs := make([]*TodoOutput, len(todos))
 	for i, t := range todos {
 		outputs[i] = NewTodoOutput(t)
 	}
 
 	return &TodoListOutput{
-		Todos: outputs,
-		Total: total,
+		Todos:      outputs,
+		Total:      total,
+		NextCursor: nextCursor,
 	}
 }
 
-func NewTodoListOutputWithCreators(todos []*model.Todo, total int, userMap map[string]*model.User) *TodoListOutput {
+func NewTodoListOutputWithCreators(todos []*model.Todo, total *int, nextCursor *string, userMap map[string]*model.User) *TodoListOutput {
 	outputs := make([]*TodoOutput, len(todos))
 	for i, t := range todos {
 		outputs[i] = NewTodoOutputWithCreator(t, userMap[t.UserID])
 	}
 
 	return &TodoListOutput{
-		Todos: outputs,
-		Total: total,
+		Todos:      outputs,
+		Total:      total,
+		NextCursor: nextCursor,
+	}
+}
+
+type DailyCompletionOutput struct {
+	Date  string
+	Count int
+}
+
+type CompletionStatsOutput struct {
+	Period string
+	From   string
+	To     string
+	Total  int
+	Days   []*DailyCompletionOutput
+}
+
+// NewCompletionStatsOutput buckets completion times into consecutive days
+// starting at from, using from's location for day boundaries
+func NewCompletionStatsOutput(period string, from time.Time, days int, completions []time.Time) *CompletionStatsOutput {
+	buckets := make([]*DailyCompletionOutput, days)
+	index := make(map[string]*DailyCompletionOutput, days)
+	for d := 0; d < days; d++ {
+		date := from.AddDate(0, 0, d).Format("2006-01-02")
+		buckets[d] = &DailyCompletionOutput{Date: date}
+		index[date] = buckets[d]
+	}
+
+	total := 0
+	for _, c := range completions {
+		if bucket, ok := index[c.In(from.Location()).Format("2006-01-02")]; ok {
+			bucket.Count++
+			total++
+		}
+	}
+
+	return &CompletionStatsOutput{
+		Period: period,
+		From:   from.Format("2006-01-02T15:04:05Z07:00"),
+		To:     from.AddDate(0, 0, days).Format("2006-01-02T15:04:05Z07:00"),
+		Total:  total,
+		Days:   buckets,
+	}
+}
+
+type TodoSearchResultOutput struct {
+	Todo *TodoOutput
+	Rank float64
+	// TitleHighlight and Snippet are HTML-escaped with matches wrapped in <mark>
+	TitleHighlight string
+	Snippet        string
+}
+
+type TodoSearchOutput struct {
+	Results []*TodoSearchResultOutput
+	Total   int
+}
+
+// highlightReplacer turns the repository's highlight markers into <mark> tags
+var highlightReplacer = strings.NewReplacer(
+	model.SearchHighlightStart, "<mark>",
+	model.SearchHighlightEnd, "</mark>",
+)
+
+// toHighlightHTML escapes user text before the markers become markup
+func toHighlightHTML(text string) string {
+	return highlightReplacer.Replace(html.EscapeString(text))
+}
+
+func NewTodoSearchOutput(hits []*model.TodoSearchHit, total int) *TodoSearchOutput {
+	results := make([]*TodoSearchResultOutput, len(hits))
+	for i, hit := range hits {
+		results[i] = &TodoSearchResultOutput{
+			Todo:           NewTodoOutput(hit.Todo),
+			Rank:           hit.Rank,
+			TitleHighlight: toHighlightHTML(hit.TitleHighlight),
+			Snippet:        toHighlightHTML(hit.Snippet),
+		}
+	}
+
+	return &TodoSearchOutput{
+		Results: results,
+		Total:   total,
+	}
+}
+
+type TodoAssignmentOutput struct {
+	ID                 string
+	PreviousAssigneeID *string
+	AssigneeID         *string
+	ChangedBy          string
+	CreatedAt          string
+}
+
+type TodoAssignmentListOutput struct {
+	Assignments []*TodoAssignmentOutput
+}
+
+func NewTodoAssignmentListOutput(assignments []*model.TodoAssignment) *TodoAssignmentListOutput {
+	outputs := make([]*TodoAssignmentOutput, len(assignments))
+	for i, a := range assignments {
+		outputs[i] = &TodoAssignmentOutput{
+			ID:                 a.ID,
+			PreviousAssigneeID: a.PreviousAssigneeID,
+			AssigneeID:         a.AssigneeID,
+			ChangedBy:          a.ChangedBy,
+			CreatedAt:          a.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		}
+	}
+	return &TodoAssignmentListOutput{Assignments: outputs}
+}
+
+type TodoShareOutput struct {
+	UserID     string
+	Permission string
+	CreatedBy  string
+	CreatedAt  string
+	UpdatedAt  string
+}
+
+func NewTodoShareOutput(share *model.TodoShare) *TodoShareOutput {
+	return &TodoShareOutput{
+		UserID:     share.UserID,
+		Permission: share.Permission,
+		CreatedBy:  share.CreatedBy,
+		CreatedAt:  share.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:  share.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+type TodoShareListOutput struct {
+	Shares []*TodoShareOutput
+}
+
+func NewTodoShareListOutput(shares []*model.TodoShare) *TodoShareListOutput {
+	outputs := make([]*TodoShareOutput, len(shares))
+	for i, s := range shares {
+		outputs[i] = NewTodoShareOutput(s)
 	}
+	return &TodoShareListOutput{Shares: outputs}
+}
+
+// TodoFieldChangeOutput holds the old and new value of a field; times are
+// formatted, everything else is a string, bool or nil
+type TodoFieldChangeOutput struct {
+	Field string
+	Old   any
+	New   any
+}
+
+type TodoRevisionOutput struct {
+	ID        string
+	ActorID   *string
+	Operation string
+	Changes   []*TodoFieldChangeOutput
+	CreatedAt string
+}
+
+type TodoRevisionListOutput struct {
+	Revisions []*TodoRevisionOutput
+	Total     int
+}
+
+func NewTodoRevisionListOutput(revisions []*model.TodoRevision, total int) *TodoRevisionListOutput {
+	outputs := make([]*TodoRevisionOutput, len(revisions))
+	for i, rev := range revisions {
+		changes := make([]*TodoFieldChangeOutput, len(rev.Changes))
+		for n, c := range rev.Changes {
+			changes[n] = &TodoFieldChangeOutput{
+				Field: c.Field,
+				Old:   todoFieldValueOutput(c.Old),
+				New:   todoFieldValueOutput(c.New),
+			}
+		}
+		outputs[i] = &TodoRevisionOutput{
+			ID:        rev.ID,
+			ActorID:   rev.ActorID,
+			Operation: rev.Operation,
+			Changes:   changes,
+			CreatedAt: rev.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		}
+	}
+	return &TodoRevisionListOutput{Revisions: outputs, Total: total}
+}
+
+func todoFieldValueOutput(value any) any {
+	if t, ok := value.(time.Time); ok {
+		return t.Format("2006-01-02T15:04:05Z07:00")
+	}
+	return value
+}
+
+// TodoBulkResultOutput is the outcome of a bulk operation for one todo;
+// Error is nil when the todo was changed
+type TodoBulkResultOutput struct {
+	TodoID string
+	Error  *TodoBulkErrorOutput
+}
+
+type TodoBulkErrorOutput struct {
+	Code    string
+	Message string
+}
+
+type TodoBulkOutput struct {
+	Results   []*TodoBulkResultOutput
+	Succeeded int
+	Failed    int
 }
@@ -0,0 +1,27 @@
+package output
+
+import (
+	"time"
+
+	"good-todo-go/internal/domain/model"
+)
+
+// AccountExportOutput is a user's personal data export
+type AccountExportOutput struct {
+	ExportedAt string
+	User       *UserOutput
+	Todos      []*TodoOutput
+}
+
+func NewAccountExportOutput(user *model.User, todos []*model.Todo, exportedAt time.Time) *AccountExportOutput {
+	outputs := make([]*TodoOutput, len(todos))
+	for i, t := range todos {
+		outputs[i] = NewTodoOutput(t)
+	}
+
+	return &AccountExportOutput{
+		ExportedAt: exportedAt.UTC().Format("2006-01-02T15:04:05Z07:00"),
+		User:       NewUserOutput(user),
+		Todos:      outputs,
+	}
+}
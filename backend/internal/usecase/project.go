@@ -0,0 +1,204 @@
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_usecase
+package usecase
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/pkg"
+	"good-todo-go/internal/pkg/cerror"
+	"good-todo-go/internal/usecase/input"
+	"good-todo-go/internal/usecase/output"
+)
+
+type IProjectInteractor interface {
+	// GetProjects lists the user's own and shared projects with their todo counts
+	GetProjects(ctx context.Context, in *input.GetProjectsInput) (*output.ProjectListOutput, error)
+	GetProject(ctx context.Context, projectID, userID string) (*output.ProjectOutput, error)
+	CreateProject(ctx context.Context, in *input.CreateProjectInput) (*output.ProjectOutput, error)
+	// UpdateProject, including archiving, and DeleteProject are limited to the owner
+	UpdateProject(ctx context.Context, in *input.UpdateProjectInput) (*output.ProjectOutput, error)
+	DeleteProject(ctx context.Context, projectID, userID string) error
+}
+
+const projectNameMaxLength = 100
+
+type ProjectInteractor struct {
+	projectRepo repository.IProjectRepository
+	uuidGen     pkg.IUUIDGenerator
+}
+
+func NewProjectInteractor(
+	projectRepo repository.IProjectRepository,
+	uuidGen pkg.IUUIDGenerator,
+) IProjectInteractor {
+	return &ProjectInteractor{
+		projectRepo: projectRepo,
+		uuidGen:     uuidGen,
+	}
+}
+
+func (i *ProjectInteractor) GetProjects(ctx context.Context, in *input.GetProjectsInput) (*output.ProjectListOutput, error) {
+	projects, err := i.projectRepo.FindVisible(ctx, in.UserID, in.IncludeArchived)
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to get projects", err)
+	}
+
+	ids := make([]string, len(projects))
+	for idx, p := range projects {
+		ids[idx] = p.ID
+	}
+	counts, err := i.projectRepo.CountTodos(ctx, in.UserID, ids)
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to count todos", err)
+	}
+
+	return output.NewProjectListOutput(projects, counts), nil
+}
+
+func (i *ProjectInteractor) GetProject(ctx context.Context, projectID, userID string) (*output.ProjectOutput, error) {
+	project, err := findVisibleProject(ctx, i.projectRepo, projectID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := i.projectRepo.CountTodos(ctx, userID, []string{project.ID})
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to count todos", err)
+	}
+
+	return output.NewProjectOutput(project, counts[project.ID]), nil
+}
+
+func (i *ProjectInteractor) CreateProject(ctx context.Context, in *input.CreateProjectInput) (*output.ProjectOutput, error) {
+	name, err := normalizeProjectName(in.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	project := &model.Project{
+		ID:          i.uuidGen.Generate(),
+		TenantID:    in.TenantID,
+		UserID:      in.UserID,
+		Name:        name,
+		Description: in.Description,
+		IsPublic:    in.IsPublic,
+	}
+
+	created, err := i.projectRepo.Create(ctx, project)
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to create project", err)
+	}
+
+	return output.NewProjectOutput(created, nil), nil
+}
+
+func (i *ProjectInteractor) UpdateProject(ctx context.Context, in *input.UpdateProjectInput) (*output.ProjectOutput, error) {
+	project, err := findVisibleProject(ctx, i.projectRepo, in.ProjectID, in.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only owner can update
+	if project.UserID != in.UserID {
+		return nil, cerror.NewForbidden("not allowed to update this project", nil)
+	}
+
+	if in.Name != nil {
+		name, err := normalizeProjectName(*in.Name)
+		if err != nil {
+			return nil, err
+		}
+		project.Name = name
+	}
+	if in.Description != nil {
+		project.Description = *in.Description
+	}
+	if in.IsPublic != nil {
+		project.IsPublic = *in.IsPublic
+	}
+	if in.Archived != nil {
+		// Archiving an archived project keeps the original timestamp
+		if *in.Archived && project.ArchivedAt == nil {
+			archivedAt := time.Now().UTC()
+			project.ArchivedAt = &archivedAt
+		} else if !*in.Archived {
+			project.ArchivedAt = nil
+		}
+	}
+
+	updated, err := i.projectRepo.Update(ctx, project)
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to update project", err)
+	}
+
+	counts, err := i.projectRepo.CountTodos(ctx, in.UserID, []string{updated.ID})
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to count todos", err)
+	}
+
+	return output.NewProjectOutput(updated, counts[updated.ID]), nil
+}
+
+func (i *ProjectInteractor) DeleteProject(ctx context.Context, projectID, userID string) error {
+	project, err := findVisibleProject(ctx, i.projectRepo, projectID, userID)
+	if err != nil {
+		return err
+	}
+
+	// Only owner can delete
+	if project.UserID != userID {
+		return cerror.NewForbidden("not allowed to delete this project", nil)
+	}
+
+	if err := i.projectRepo.Delete(ctx, projectID); err != nil {
+		return cerror.NewInternalServerError("failed to delete project", err)
+	}
+
+	return nil
+}
+
+// findVisibleProject loads a project the user owns or that is shared with the
+// tenant. Other users' private projects are reported as not found.
+func findVisibleProject(ctx context.Context, projectRepo repository.IProjectRepository, projectID, userID string) (*model.Project, error) {
+	project, err := projectRepo.FindByID(ctx, projectID)
+	if err != nil {
+		return nil, cerror.NewNotFound("project not found", err)
+	}
+	if project.UserID != userID && !project.IsPublic {
+		return nil, cerror.NewNotFound("project not found", nil)
+	}
+	return project, nil
+}
+
+// checkTodoProject verifies that the user may put their todos into the project:
+// it must be their own and not archived
+func checkTodoProject(ctx context.Context, projectRepo repository.IProjectRepository, projectID, userID string) error {
+	project, err := findVisibleProject(ctx, projectRepo, projectID, userID)
+	if err != nil {
+		return err
+	}
+	if project.UserID != userID {
+		return cerror.NewForbidden("not allowed to add todos to this project", nil)
+	}
+	if project.ArchivedAt != nil {
+		return cerror.NewBadRequest("project is archived", nil)
+	}
+	return nil
+}
+
+// normalizeProjectName trims surrounding whitespace and checks the length
+func normalizeProjectName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", cerror.NewBadRequest("project name is required", nil)
+	}
+	if utf8.RuneCountInString(name) > projectNameMaxLength {
+		return "", cerror.NewBadRequest("project name must be at most 100 characters", nil)
+	}
+	return name, nil
+}
@@ -0,0 +1,263 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	mock_repository "good-todo-go/internal/domain/repository/mock"
+	mock_pkg "good-todo-go/internal/pkg/mock"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestProjectInteractor_GetProjects(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	projectRepo := mock_repository.NewMockIProjectRepository(ctrl)
+	projectRepo.EXPECT().
+		FindVisible(gomock.Any(), "user-1", false).
+		Return([]*model.Project{
+			{ID: "project-1", UserID: "user-1", Name: "Home"},
+			{ID: "project-2", UserID: "user-2", Name: "Team", IsPublic: true},
+		}, nil)
+	projectRepo.EXPECT().
+		CountTodos(gomock.Any(), "user-1", []string{"project-1", "project-2"}).
+		Return(map[string]*model.ProjectTodoCounts{
+			"project-1": {Open: 2, Completed: 1},
+		}, nil)
+
+	interactor := NewProjectInteractor(projectRepo, mock_pkg.NewMockIUUIDGenerator(ctrl))
+
+	result, err := interactor.GetProjects(context.Background(), &input.GetProjectsInput{UserID: "user-1"})
+	require.NoError(t, err)
+	require.Len(t, result.Projects, 2)
+	assert.Equal(t, 2, result.Projects[0].OpenCount)
+	assert.Equal(t, 1, result.Projects[0].CompletedCount)
+	// Projects without todos count zero
+	assert.Equal(t, 0, result.Projects[1].OpenCount)
+	assert.Equal(t, 0, result.Projects[1].CompletedCount)
+}
+
+func TestProjectInteractor_UpdateProject(t *testing.T) {
+	t.Parallel()
+
+	archivedAt := time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)
+	archived := true
+	unarchived := false
+
+	tests := []struct {
+		name        string
+		input       *input.UpdateProjectInput
+		project     *model.Project
+		wantUpdate  bool
+		checkTime   func(t *testing.T, at *time.Time)
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:       "success - archive sets archived_at",
+			input:      &input.UpdateProjectInput{ProjectID: "project-1", UserID: "user-1", Archived: &archived},
+			project:    &model.Project{ID: "project-1", UserID: "user-1", Name: "Home"},
+			wantUpdate: true,
+			checkTime: func(t *testing.T, at *time.Time) {
+				require.NotNil(t, at)
+				assert.WithinDuration(t, time.Now(), *at, time.Minute)
+			},
+		},
+		{
+			name:       "success - archiving again keeps the original time",
+			input:      &input.UpdateProjectInput{ProjectID: "project-1", UserID: "user-1", Archived: &archived},
+			project:    &model.Project{ID: "project-1", UserID: "user-1", Name: "Home", ArchivedAt: &archivedAt},
+			wantUpdate: true,
+			checkTime: func(t *testing.T, at *time.Time) {
+				require.NotNil(t, at)
+				assert.Equal(t, archivedAt, *at)
+			},
+		},
+		{
+			name:       "success - unarchive clears archived_at",
+			input:      &input.UpdateProjectInput{ProjectID: "project-1", UserID: "user-1", Archived: &unarchived},
+			project:    &model.Project{ID: "project-1", UserID: "user-1", Name: "Home", ArchivedAt: &archivedAt},
+			wantUpdate: true,
+			checkTime: func(t *testing.T, at *time.Time) {
+				assert.Nil(t, at)
+			},
+		},
+		{
+			name:        "fail - shared project of another user",
+			input:       &input.UpdateProjectInput{ProjectID: "project-2", UserID: "user-1", Name: strPtr("Mine")},
+			project:     &model.Project{ID: "project-2", UserID: "user-2", Name: "Team", IsPublic: true},
+			wantErr:     true,
+			errContains: "not allowed",
+		},
+		{
+			name:        "fail - private project of another user is hidden",
+			input:       &input.UpdateProjectInput{ProjectID: "project-3", UserID: "user-1", Name: strPtr("Mine")},
+			project:     &model.Project{ID: "project-3", UserID: "user-2", Name: "Secret"},
+			wantErr:     true,
+			errContains: "project not found",
+		},
+		{
+			name:        "fail - blank name",
+			input:       &input.UpdateProjectInput{ProjectID: "project-1", UserID: "user-1", Name: strPtr("  ")},
+			project:     &model.Project{ID: "project-1", UserID: "user-1", Name: "Home"},
+			wantErr:     true,
+			errContains: "name is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			projectRepo := mock_repository.NewMockIProjectRepository(ctrl)
+			projectRepo.EXPECT().FindByID(gomock.Any(), tt.input.ProjectID).Return(tt.project, nil)
+
+			var saved *model.Project
+			if tt.wantUpdate {
+				projectRepo.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, p *model.Project) (*model.Project, error) {
+						saved = p
+						return p, nil
+					})
+				projectRepo.EXPECT().
+					CountTodos(gomock.Any(), tt.input.UserID, []string{tt.input.ProjectID}).
+					Return(map[string]*model.ProjectTodoCounts{}, nil)
+			}
+
+			interactor := NewProjectInteractor(projectRepo, mock_pkg.NewMockIUUIDGenerator(ctrl))
+
+			_, err := interactor.UpdateProject(context.Background(), tt.input)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+			tt.checkTime(t, saved.ArchivedAt)
+		})
+	}
+}
+
+func TestTodoInteractor_MoveTodo(t *testing.T) {
+	t.Parallel()
+
+	archivedAt := time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		input       *input.MoveTodoInput
+		setupMocks  func(todoRepo *mock_repository.MockITodoRepository, projectRepo *mock_repository.MockIProjectRepository)
+		wantProject *string
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:  "success - move into own project",
+			input: &input.MoveTodoInput{TodoID: "todo-1", UserID: "user-1", ProjectID: strPtr("project-1")},
+			setupMocks: func(todoRepo *mock_repository.MockITodoRepository, projectRepo *mock_repository.MockIProjectRepository) {
+				todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(&model.Todo{ID: "todo-1", UserID: "user-1"}, nil)
+				projectRepo.EXPECT().FindByID(gomock.Any(), "project-1").Return(&model.Project{ID: "project-1", UserID: "user-1"}, nil)
+				todoRepo.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, todo *model.Todo) (*model.Todo, error) {
+						return todo, nil
+					})
+			},
+			wantProject: strPtr("project-1"),
+		},
+		{
+			name:  "success - take out of project",
+			input: &input.MoveTodoInput{TodoID: "todo-1", UserID: "user-1"},
+			setupMocks: func(todoRepo *mock_repository.MockITodoRepository, projectRepo *mock_repository.MockIProjectRepository) {
+				todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(&model.Todo{ID: "todo-1", UserID: "user-1", ProjectID: strPtr("project-1")}, nil)
+				todoRepo.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, todo *model.Todo) (*model.Todo, error) {
+						return todo, nil
+					})
+			},
+			wantProject: nil,
+		},
+		{
+			name:  "fail - archived project",
+			input: &input.MoveTodoInput{TodoID: "todo-1", UserID: "user-1", ProjectID: strPtr("project-1")},
+			setupMocks: func(todoRepo *mock_repository.MockITodoRepository, projectRepo *mock_repository.MockIProjectRepository) {
+				todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(&model.Todo{ID: "todo-1", UserID: "user-1"}, nil)
+				projectRepo.EXPECT().FindByID(gomock.Any(), "project-1").Return(&model.Project{ID: "project-1", UserID: "user-1", ArchivedAt: &archivedAt}, nil)
+			},
+			wantErr:     true,
+			errContains: "archived",
+		},
+		{
+			name:  "fail - shared project of another user",
+			input: &input.MoveTodoInput{TodoID: "todo-1", UserID: "user-1", ProjectID: strPtr("project-2")},
+			setupMocks: func(todoRepo *mock_repository.MockITodoRepository, projectRepo *mock_repository.MockIProjectRepository) {
+				todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(&model.Todo{ID: "todo-1", UserID: "user-1"}, nil)
+				projectRepo.EXPECT().FindByID(gomock.Any(), "project-2").Return(&model.Project{ID: "project-2", UserID: "user-2", IsPublic: true}, nil)
+			},
+			wantErr:     true,
+			errContains: "not allowed",
+		},
+		{
+			name:  "fail - project not found",
+			input: &input.MoveTodoInput{TodoID: "todo-1", UserID: "user-1", ProjectID: strPtr("missing")},
+			setupMocks: func(todoRepo *mock_repository.MockITodoRepository, projectRepo *mock_repository.MockIProjectRepository) {
+				todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(&model.Todo{ID: "todo-1", UserID: "user-1"}, nil)
+				projectRepo.EXPECT().FindByID(gomock.Any(), "missing").Return(nil, errors.New("not found"))
+			},
+			wantErr:     true,
+			errContains: "project not found",
+		},
+		{
+			name:  "fail - not the owner of the todo",
+			input: &input.MoveTodoInput{TodoID: "todo-1", UserID: "user-2", ProjectID: strPtr("project-1")},
+			setupMocks: func(todoRepo *mock_repository.MockITodoRepository, projectRepo *mock_repository.MockIProjectRepository) {
+				todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(&model.Todo{ID: "todo-1", UserID: "user-1", IsPublic: true}, nil)
+			},
+			wantErr:     true,
+			errContains: "not allowed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+			projectRepo := mock_repository.NewMockIProjectRepository(ctrl)
+			tt.setupMocks(todoRepo, projectRepo)
+
+			interactor := &TodoInteractor{todoRepo: todoRepo, projectRepo: projectRepo}
+
+			result, err := interactor.MoveTodo(context.Background(), tt.input)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantProject, result.ProjectID)
+		})
+	}
+}
@@ -0,0 +1,145 @@
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_usecase
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/pkg"
+	"good-todo-go/internal/pkg/cerror"
+	"good-todo-go/internal/usecase/input"
+	"good-todo-go/internal/usecase/output"
+)
+
+// IReminderInteractor manages reminders on todos. Reminders go to the todo's
+// owner, so only the owner may see or change them.
+type IReminderInteractor interface {
+	ListReminders(ctx context.Context, todoID, userID string) (*output.ReminderListOutput, error)
+	CreateReminder(ctx context.Context, in *input.CreateReminderInput) (*output.ReminderOutput, error)
+	DeleteReminder(ctx context.Context, in *input.DeleteReminderInput) error
+}
+
+const (
+	// maxRemindersPerTodo bounds the work a single todo can cause the scheduler
+	maxRemindersPerTodo = 10
+	// maxReminderOffsetMinutes is 30 days
+	maxReminderOffsetMinutes = 30 * 24 * 60
+)
+
+type ReminderInteractor struct {
+	reminderRepo repository.IReminderRepository
+	todoRepo     repository.ITodoRepository
+	uuidGen      pkg.IUUIDGenerator
+}
+
+func NewReminderInteractor(
+	reminderRepo repository.IReminderRepository,
+	todoRepo repository.ITodoRepository,
+	uuidGen pkg.IUUIDGenerator,
+) IReminderInteractor {
+	return &ReminderInteractor{
+		reminderRepo: reminderRepo,
+		todoRepo:     todoRepo,
+		uuidGen:      uuidGen,
+	}
+}
+
+func (i *ReminderInteractor) ListReminders(ctx context.Context, todoID, userID string) (*output.ReminderListOutput, error) {
+	if _, err := i.findOwnTodo(ctx, todoID, userID); err != nil {
+		return nil, err
+	}
+
+	reminders, err := i.reminderRepo.FindByTodoID(ctx, todoID)
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to get reminders", err)
+	}
+	return output.NewReminderListOutput(reminders), nil
+}
+
+func (i *ReminderInteractor) CreateReminder(ctx context.Context, in *input.CreateReminderInput) (*output.ReminderOutput, error) {
+	todo, err := i.findOwnTodo(ctx, in.TodoID, in.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	channel := in.Channel
+	if channel == "" {
+		channel = model.ReminderChannelInApp
+	}
+	if channel != model.ReminderChannelEmail && channel != model.ReminderChannelInApp {
+		return nil, cerror.NewBadRequest("channel must be email or in_app", nil)
+	}
+
+	var remindAt time.Time
+	switch {
+	case in.RemindAt != nil && in.OffsetMinutes != nil:
+		return nil, cerror.NewBadRequest("set either remind_at or offset_minutes, not both", nil)
+	case in.RemindAt != nil:
+		remindAt = in.RemindAt.UTC()
+		if !remindAt.After(time.Now()) {
+			return nil, cerror.NewBadRequest("remind_at must be in the future", nil)
+		}
+	case in.OffsetMinutes != nil:
+		if *in.OffsetMinutes < 0 || *in.OffsetMinutes > maxReminderOffsetMinutes {
+			return nil, cerror.NewBadRequest("offset_minutes must be between 0 and 43200", nil)
+		}
+		if todo.DueDate == nil {
+			return nil, cerror.NewBadRequest("todo has no due date to remind relative to", nil)
+		}
+		// A reminder that is already past still fires right away, like one
+		// whose due date was moved closer
+		remindAt = todo.DueDate.Add(-time.Duration(*in.OffsetMinutes) * time.Minute).UTC()
+	default:
+		return nil, cerror.NewBadRequest("remind_at or offset_minutes is required", nil)
+	}
+
+	existing, err := i.reminderRepo.FindByTodoID(ctx, todo.ID)
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to get reminders", err)
+	}
+	if len(existing) >= maxRemindersPerTodo {
+		return nil, cerror.NewBadRequest("a todo can have at most 10 reminders", nil)
+	}
+
+	created, err := i.reminderRepo.Create(ctx, &model.Reminder{
+		ID:            i.uuidGen.Generate(),
+		TenantID:      in.TenantID,
+		TodoID:        todo.ID,
+		RemindAt:      remindAt,
+		OffsetMinutes: in.OffsetMinutes,
+		Channel:       channel,
+	})
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to create reminder", err)
+	}
+	return output.NewReminderOutput(created), nil
+}
+
+func (i *ReminderInteractor) DeleteReminder(ctx context.Context, in *input.DeleteReminderInput) error {
+	if _, err := i.findOwnTodo(ctx, in.TodoID, in.UserID); err != nil {
+		return err
+	}
+
+	reminder, err := i.reminderRepo.FindByID(ctx, in.ReminderID)
+	if err != nil || reminder.TodoID != in.TodoID {
+		return cerror.NewNotFound("reminder not found", err)
+	}
+
+	if err := i.reminderRepo.Delete(ctx, reminder.ID); err != nil {
+		return cerror.NewInternalServerError("failed to delete reminder", err)
+	}
+	return nil
+}
+
+func (i *ReminderInteractor) findOwnTodo(ctx context.Context, todoID, userID string) (*model.Todo, error) {
+	todo, err := i.todoRepo.FindByID(ctx, todoID)
+	if err != nil {
+		return nil, cerror.NewNotFound("todo not found", err)
+	}
+	if todo.UserID != userID {
+		return nil, cerror.NewForbidden("not allowed to manage reminders of this todo", nil)
+	}
+	return todo, nil
+}
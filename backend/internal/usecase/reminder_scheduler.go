@@ -0,0 +1,162 @@
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_usecase
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/pkg"
+)
+
+// IReminderChannel delivers reminders of one channel, e.g. email
+type IReminderChannel interface {
+	// Channel is the model.ReminderChannel* value this channel handles
+	Channel() string
+	Deliver(ctx context.Context, reminder *model.DueReminder) error
+}
+
+// IReminderScheduler delivers due reminders. Any number of schedulers may run
+// at once; each reminder is still delivered by only one of them.
+type IReminderScheduler interface {
+	// RunOnce delivers the reminders due now across all tenants, at most
+	// batchSize per tenant, and returns how many it attempted
+	RunOnce(ctx context.Context) (int, error)
+}
+
+type ReminderScheduler struct {
+	tenantRepo   repository.ITenantRepository
+	reminderRepo repository.IReminderRepository
+	channels     map[string]IReminderChannel
+	batchSize    int
+}
+
+func NewReminderScheduler(
+	tenantRepo repository.ITenantRepository,
+	reminderRepo repository.IReminderRepository,
+	batchSize int,
+	channels ...IReminderChannel,
+) IReminderScheduler {
+	byName := make(map[string]IReminderChannel, len(channels))
+	for _, c := range channels {
+		byName[c.Channel()] = c
+	}
+	return &ReminderScheduler{
+		tenantRepo:   tenantRepo,
+		reminderRepo: reminderRepo,
+		channels:     byName,
+		batchSize:    batchSize,
+	}
+}
+
+func (s *ReminderScheduler) RunOnce(ctx context.Context) (int, error) {
+	tenantIDs, err := s.tenantRepo.ListIDs(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list tenants: %w", err)
+	}
+
+	// Reminders that become due while the run is in progress wait for the next one
+	now := time.Now().UTC()
+	attempted := 0
+	var errs []error
+	for _, tenantID := range tenantIDs {
+		// A batch limit per tenant keeps one busy tenant from delaying the others
+		for n := 0; n < s.batchSize; n++ {
+			if err := ctx.Err(); err != nil {
+				return attempted, err
+			}
+			found, err := s.reminderRepo.DeliverNext(ctx, tenantID, now, s.deliver)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("tenant %s: %w", tenantID, err))
+				break
+			}
+			if !found {
+				break
+			}
+			attempted++
+		}
+	}
+	return attempted, errors.Join(errs...)
+}
+
+func (s *ReminderScheduler) deliver(ctx context.Context, reminder *model.DueReminder) error {
+	channel, ok := s.channels[reminder.Reminder.Channel]
+	if !ok {
+		err := fmt.Errorf("no channel %q", reminder.Reminder.Channel)
+		log.Printf("failed to deliver reminder %s: %v", reminder.Reminder.ID, err)
+		return err
+	}
+	if err := channel.Deliver(ctx, reminder); err != nil {
+		log.Printf("failed to deliver reminder %s (attempt %d): %v", reminder.Reminder.ID, reminder.Attempts+1, err)
+		return err
+	}
+	return nil
+}
+
+// EmailReminderChannel sends reminders through the SMTP mailer. Email cannot
+// be taken back, so a crash between sending and recording the delivery may
+// send a reminder twice; the in-app channel has no such window.
+type EmailReminderChannel struct {
+	mailer      pkg.IMailer
+	linkBuilder *pkg.LinkBuilder
+}
+
+func NewEmailReminderChannel(mailer pkg.IMailer, linkBuilder *pkg.LinkBuilder) IReminderChannel {
+	return &EmailReminderChannel{mailer: mailer, linkBuilder: linkBuilder}
+}
+
+func (c *EmailReminderChannel) Channel() string {
+	return model.ReminderChannelEmail
+}
+
+func (c *EmailReminderChannel) Deliver(ctx context.Context, reminder *model.DueReminder) error {
+	return c.mailer.Send(ctx, &pkg.Mail{
+		To:      reminder.Email,
+		Subject: "Reminder: " + reminder.TodoTitle,
+		Body: fmt.Sprintf(
+			"Hi %s,\n\nthis is your reminder for \"%s\"%s.\n\n%s\n",
+			reminder.UserName,
+			reminder.TodoTitle,
+			dueSuffix(reminder.DueDate),
+			c.linkBuilder.Todos(),
+		),
+	})
+}
+
+// InAppReminderChannel stores reminders as notifications. Notifications are
+// unique per reminder, so delivering a reminder again is a no-op.
+type InAppReminderChannel struct {
+	notificationRepo repository.INotificationRepository
+	uuidGen          pkg.IUUIDGenerator
+}
+
+func NewInAppReminderChannel(notificationRepo repository.INotificationRepository, uuidGen pkg.IUUIDGenerator) IReminderChannel {
+	return &InAppReminderChannel{notificationRepo: notificationRepo, uuidGen: uuidGen}
+}
+
+func (c *InAppReminderChannel) Channel() string {
+	return model.ReminderChannelInApp
+}
+
+func (c *InAppReminderChannel) Deliver(ctx context.Context, reminder *model.DueReminder) error {
+	return c.notificationRepo.Create(ctx, &model.Notification{
+		ID:         c.uuidGen.Generate(),
+		TenantID:   reminder.Reminder.TenantID,
+		UserID:     reminder.UserID,
+		TodoID:     &reminder.Reminder.TodoID,
+		ReminderID: &reminder.Reminder.ID,
+		Title:      "Reminder: " + reminder.TodoTitle,
+		Body:       "Your todo is" + dueSuffix(reminder.DueDate) + ".",
+	})
+}
+
+func dueSuffix(dueDate *time.Time) string {
+	if dueDate == nil {
+		return ""
+	}
+	return " due " + dueDate.UTC().Format("2006-01-02 15:04 UTC")
+}
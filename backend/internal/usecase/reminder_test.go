@@ -0,0 +1,222 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	mock_repository "good-todo-go/internal/domain/repository/mock"
+	mock_pkg "good-todo-go/internal/pkg/mock"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestReminderInteractor_CreateReminder(t *testing.T) {
+	t.Parallel()
+
+	due := time.Now().Add(48 * time.Hour).UTC().Truncate(time.Second)
+	future := time.Now().Add(time.Hour)
+	past := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name         string
+		todo         *model.Todo
+		in           *input.CreateReminderInput
+		existing     int
+		wantRemindAt time.Time
+		wantChannel  string
+		wantErr      bool
+		errContains  string
+	}{
+		{
+			name:         "success - offset before due date",
+			todo:         &model.Todo{ID: "todo-1", UserID: "user-1", DueDate: &due},
+			in:           &input.CreateReminderInput{OffsetMinutes: intPtr(30), Channel: model.ReminderChannelEmail},
+			wantRemindAt: due.Add(-30 * time.Minute),
+			wantChannel:  model.ReminderChannelEmail,
+		},
+		{
+			name:         "success - absolute time defaults to in-app",
+			todo:         &model.Todo{ID: "todo-1", UserID: "user-1"},
+			in:           &input.CreateReminderInput{RemindAt: &future},
+			wantRemindAt: future.UTC(),
+			wantChannel:  model.ReminderChannelInApp,
+		},
+		{
+			name:        "fail - not the owner",
+			todo:        &model.Todo{ID: "todo-1", UserID: "user-2"},
+			in:          &input.CreateReminderInput{RemindAt: &future},
+			wantErr:     true,
+			errContains: "not allowed",
+		},
+		{
+			name:        "fail - both time and offset",
+			todo:        &model.Todo{ID: "todo-1", UserID: "user-1", DueDate: &due},
+			in:          &input.CreateReminderInput{RemindAt: &future, OffsetMinutes: intPtr(10)},
+			wantErr:     true,
+			errContains: "not both",
+		},
+		{
+			name:        "fail - neither time nor offset",
+			todo:        &model.Todo{ID: "todo-1", UserID: "user-1"},
+			in:          &input.CreateReminderInput{},
+			wantErr:     true,
+			errContains: "required",
+		},
+		{
+			name:        "fail - time in the past",
+			todo:        &model.Todo{ID: "todo-1", UserID: "user-1"},
+			in:          &input.CreateReminderInput{RemindAt: &past},
+			wantErr:     true,
+			errContains: "in the future",
+		},
+		{
+			name:        "fail - offset without due date",
+			todo:        &model.Todo{ID: "todo-1", UserID: "user-1"},
+			in:          &input.CreateReminderInput{OffsetMinutes: intPtr(10)},
+			wantErr:     true,
+			errContains: "no due date",
+		},
+		{
+			name:        "fail - offset out of range",
+			todo:        &model.Todo{ID: "todo-1", UserID: "user-1", DueDate: &due},
+			in:          &input.CreateReminderInput{OffsetMinutes: intPtr(-5)},
+			wantErr:     true,
+			errContains: "between 0 and 43200",
+		},
+		{
+			name:        "fail - unknown channel",
+			todo:        &model.Todo{ID: "todo-1", UserID: "user-1"},
+			in:          &input.CreateReminderInput{RemindAt: &future, Channel: "sms"},
+			wantErr:     true,
+			errContains: "email or in_app",
+		},
+		{
+			name:        "fail - too many reminders",
+			todo:        &model.Todo{ID: "todo-1", UserID: "user-1"},
+			in:          &input.CreateReminderInput{RemindAt: &future},
+			existing:    maxRemindersPerTodo,
+			wantErr:     true,
+			errContains: "at most 10",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+			reminderRepo := mock_repository.NewMockIReminderRepository(ctrl)
+			uuidGen := mock_pkg.NewMockIUUIDGenerator(ctrl)
+
+			todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(tt.todo, nil)
+			if tt.existing > 0 || !tt.wantErr {
+				reminderRepo.EXPECT().FindByTodoID(gomock.Any(), "todo-1").Return(make([]*model.Reminder, tt.existing), nil)
+			}
+			if !tt.wantErr {
+				uuidGen.EXPECT().Generate().Return("reminder-1")
+				reminderRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, r *model.Reminder) (*model.Reminder, error) {
+						return r, nil
+					})
+			}
+
+			interactor := NewReminderInteractor(reminderRepo, todoRepo, uuidGen)
+
+			in := *tt.in
+			in.TodoID = "todo-1"
+			in.UserID = "user-1"
+			in.TenantID = "tenant-1"
+			result, err := interactor.CreateReminder(context.Background(), &in)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "reminder-1", result.ID)
+			assert.Equal(t, tt.wantRemindAt.Format(time.RFC3339), result.RemindAt)
+			assert.Equal(t, tt.wantChannel, result.Channel)
+			assert.Equal(t, "pending", result.Status)
+		})
+	}
+}
+
+// fakeReminderChannel records what it delivers and fails when err is set
+type fakeReminderChannel struct {
+	channel   string
+	err       error
+	delivered []string
+}
+
+func (c *fakeReminderChannel) Channel() string { return c.channel }
+
+func (c *fakeReminderChannel) Deliver(_ context.Context, r *model.DueReminder) error {
+	c.delivered = append(c.delivered, r.Reminder.ID)
+	return c.err
+}
+
+func TestReminderScheduler_RunOnce(t *testing.T) {
+	t.Parallel()
+
+	dueReminder := func(id, channel string) *model.DueReminder {
+		return &model.DueReminder{Reminder: &model.Reminder{ID: id, Channel: channel}}
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tenantRepo := mock_repository.NewMockITenantRepository(ctrl)
+	reminderRepo := mock_repository.NewMockIReminderRepository(ctrl)
+
+	// Each tenant's queue; DeliverNext hands out one reminder per call
+	queues := map[string][]*model.DueReminder{
+		"tenant-1": {dueReminder("r-1", model.ReminderChannelEmail), dueReminder("r-2", model.ReminderChannelInApp)},
+		"tenant-2": {dueReminder("r-3", "sms"), dueReminder("r-4", model.ReminderChannelInApp), dueReminder("r-5", model.ReminderChannelInApp)},
+	}
+	deliverErrs := map[string]error{}
+
+	tenantRepo.EXPECT().ListIDs(gomock.Any()).Return([]string{"tenant-1", "tenant-2"}, nil)
+	reminderRepo.EXPECT().
+		DeliverNext(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, tenantID string, _ time.Time, deliver repository.DeliverReminderFunc) (bool, error) {
+			queue := queues[tenantID]
+			if len(queue) == 0 {
+				return false, nil
+			}
+			queues[tenantID] = queue[1:]
+			deliverErrs[queue[0].Reminder.ID] = deliver(ctx, queue[0])
+			return true, nil
+		}).
+		AnyTimes()
+
+	email := &fakeReminderChannel{channel: model.ReminderChannelEmail, err: errors.New("smtp down")}
+	inApp := &fakeReminderChannel{channel: model.ReminderChannelInApp}
+
+	// A batch size of 2 leaves r-5 for the next run
+	scheduler := NewReminderScheduler(tenantRepo, reminderRepo, 2, email, inApp)
+
+	attempted, err := scheduler.RunOnce(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 4, attempted)
+	assert.Equal(t, []string{"r-1"}, email.delivered)
+	assert.Equal(t, []string{"r-2", "r-4"}, inApp.delivered)
+	// Failures are reported to the repository so that it can schedule a retry
+	assert.Error(t, deliverErrs["r-1"])
+	assert.Error(t, deliverErrs["r-3"])
+	assert.NoError(t, deliverErrs["r-2"])
+	assert.Len(t, queues["tenant-2"], 1)
+}
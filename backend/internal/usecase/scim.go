@@ -0,0 +1,545 @@
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_usecase
+package usecase
+
+import (
+	"context"
+	"errors"
+	"net/mail"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/pkg"
+	"good-todo-go/internal/pkg/cerror"
+	"good-todo-go/internal/pkg/passwordpolicy"
+	"good-todo-go/internal/usecase/input"
+	"good-todo-go/internal/usecase/output"
+)
+
+// IScimInteractor maps SCIM 2.0 user provisioning onto tenant users.
+// The tenant is taken from the context, which the SCIM auth middleware
+// sets from the bearer token, so every call goes through RLS.
+type IScimInteractor interface {
+	Authenticate(ctx context.Context, token string) (string, error)
+	ListUsers(ctx context.Context, in *input.ListScimUsersInput) (*output.ScimUserListOutput, error)
+	GetUser(ctx context.Context, userID string) (*output.UserOutput, error)
+	CreateUser(ctx context.Context, in *input.CreateScimUserInput) (*output.UserOutput, error)
+	ReplaceUser(ctx context.Context, in *input.ReplaceScimUserInput) (*output.UserOutput, error)
+	PatchUser(ctx context.Context, in *input.PatchScimUserInput) (*output.UserOutput, error)
+	// DeleteUser deactivates the user; todos and history are kept
+	DeleteUser(ctx context.Context, userID string) error
+}
+
+// SCIM error types (RFC 7644 section 3.12), returned in AppError details
+const (
+	ScimTypeDetailKey     = "scimType"
+	scimTypeUniqueness    = "uniqueness"
+	scimTypeInvalidFilter = "invalidFilter"
+	scimTypeInvalidPath   = "invalidPath"
+	scimTypeInvalidValue  = "invalidValue"
+)
+
+const (
+	scimDefaultCount = 100
+	scimMaxCount     = 200
+)
+
+// scimFilterPattern matches the equality filters identity providers use to
+// look up an existing account before provisioning it
+var scimFilterPattern = regexp.MustCompile(`^\s*(?i:(userName|externalId))\s+(?i:eq)\s+("(?:[^"\\]|\\.)*")\s*$`)
+
+type ScimInteractor struct {
+	userRepo       repository.IUserRepository
+	tenantRepo     repository.ITenantRepository
+	uuidGen        pkg.IUUIDGenerator
+	passwordPolicy *passwordpolicy.Policy
+	auditLogger    *AuditLogger
+}
+
+func NewScimInteractor(
+	userRepo repository.IUserRepository,
+	tenantRepo repository.ITenantRepository,
+	uuidGen pkg.IUUIDGenerator,
+	passwordPolicy *passwordpolicy.Policy,
+	auditLogger *AuditLogger,
+) IScimInteractor {
+	return &ScimInteractor{
+		userRepo:       userRepo,
+		tenantRepo:     tenantRepo,
+		uuidGen:        uuidGen,
+		passwordPolicy: passwordPolicy,
+		auditLogger:    auditLogger,
+	}
+}
+
+func (i *ScimInteractor) Authenticate(ctx context.Context, token string) (string, error) {
+	if token == "" {
+		return "", cerror.NewUnauthorized("missing SCIM token", nil)
+	}
+
+	tenant, err := i.tenantRepo.FindBySCIMTokenHash(ctx, hashToken(token))
+	if err != nil {
+		return "", cerror.NewUnauthorized("invalid SCIM token", nil)
+	}
+
+	return tenant.ID, nil
+}
+
+func (i *ScimInteractor) ListUsers(ctx context.Context, in *input.ListScimUsersInput) (*output.ScimUserListOutput, error) {
+	filter, err := parseScimFilter(in.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	startIndex := in.StartIndex
+	if startIndex < 1 {
+		startIndex = 1
+	}
+	count := in.Count
+	if count <= 0 {
+		count = scimDefaultCount
+	}
+	if count > scimMaxCount {
+		count = scimMaxCount
+	}
+
+	users, total, err := i.userRepo.List(ctx, filter, startIndex-1, count)
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to list users", err)
+	}
+
+	out := &output.ScimUserListOutput{
+		Users:        make([]*output.UserOutput, len(users)),
+		TotalResults: total,
+		StartIndex:   startIndex,
+	}
+	for idx, u := range users {
+		out.Users[idx] = output.NewUserOutput(u)
+	}
+	return out, nil
+}
+
+func (i *ScimInteractor) GetUser(ctx context.Context, userID string) (*output.UserOutput, error) {
+	user, err := i.findUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return output.NewUserOutput(user), nil
+}
+
+func (i *ScimInteractor) CreateUser(ctx context.Context, in *input.CreateScimUserInput) (*output.UserOutput, error) {
+	email, err := normalizeScimUserName(in.User.UserName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := i.checkUnique(ctx, "", email, in.User.ExternalID); err != nil {
+		return nil, err
+	}
+
+	user := &model.User{
+		ID:         i.uuidGen.Generate(),
+		TenantID:   in.TenantID,
+		Email:      email,
+		Name:       in.User.Name,
+		Role:       "member",
+		ExternalID: in.User.ExternalID,
+		// The identity provider owns the address, so no verification mail is needed
+		EmailVerified: true,
+		IsActive:      true,
+	}
+	if in.User.Active != nil {
+		user.IsActive = *in.User.Active
+	}
+	if in.User.Role != nil {
+		if err := setScimRole(user, *in.User.Role); err != nil {
+			return nil, err
+		}
+	}
+
+	user.PasswordHash, err = i.passwordHash(in.User.Password, user)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := i.userRepo.Create(ctx, user)
+	if err != nil {
+		return nil, scimWriteError("failed to create user", err)
+	}
+	i.auditLogger.Record(ctx, scimEvent(created.TenantID, model.AuditActionUserCreated, created.ID, map[string]string{
+		"source": "scim",
+		"email":  created.Email,
+		"role":   created.Role,
+	}))
+
+	return output.NewUserOutput(created), nil
+}
+
+func (i *ScimInteractor) ReplaceUser(ctx context.Context, in *input.ReplaceScimUserInput) (*output.UserOutput, error) {
+	user, err := i.findUser(ctx, in.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	email, err := normalizeScimUserName(in.User.UserName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := i.checkUnique(ctx, user.ID, email, in.User.ExternalID); err != nil {
+		return nil, err
+	}
+
+	before := *user
+	user.Email = email
+	user.Name = in.User.Name
+	user.ExternalID = in.User.ExternalID
+	if in.User.Active != nil {
+		user.IsActive = *in.User.Active
+	}
+	if in.User.Role != nil {
+		if err := setScimRole(user, *in.User.Role); err != nil {
+			return nil, err
+		}
+	}
+	if in.User.Password != nil {
+		user.PasswordHash, err = i.passwordHash(in.User.Password, user)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	updated, err := i.userRepo.UpdateAccount(ctx, user)
+	if err != nil {
+		return nil, scimWriteError("failed to update user", err)
+	}
+	i.recordAccountChanges(ctx, &before, updated)
+
+	return output.NewUserOutput(updated), nil
+}
+
+func (i *ScimInteractor) PatchUser(ctx context.Context, in *input.PatchScimUserInput) (*output.UserOutput, error) {
+	user, err := i.findUser(ctx, in.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	before := *user
+	for _, op := range in.Operations {
+		if err := applyScimPatchOperation(user, op); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := i.checkUnique(ctx, user.ID, user.Email, user.ExternalID); err != nil {
+		return nil, err
+	}
+
+	updated, err := i.userRepo.UpdateAccount(ctx, user)
+	if err != nil {
+		return nil, scimWriteError("failed to update user", err)
+	}
+	i.recordAccountChanges(ctx, &before, updated)
+
+	return output.NewUserOutput(updated), nil
+}
+
+func (i *ScimInteractor) DeleteUser(ctx context.Context, userID string) error {
+	user, err := i.findUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if !user.IsActive {
+		return nil
+	}
+
+	user.IsActive = false
+	if _, err := i.userRepo.UpdateAccount(ctx, user); err != nil {
+		return cerror.NewInternalServerError("failed to deactivate user", err)
+	}
+	i.auditLogger.Record(ctx, scimEvent(user.TenantID, model.AuditActionUserDeactivated, user.ID, nil))
+
+	return nil
+}
+
+// findUser looks up a user the identity provider can manage. System users
+// are not provisioned, so they are not found.
+func (i *ScimInteractor) findUser(ctx context.Context, userID string) (*model.User, error) {
+	user, err := i.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, cerror.NewNotFound("user not found", err)
+	}
+	if user.IsSystem {
+		return nil, cerror.NewNotFound("user not found", nil)
+	}
+	return user, nil
+}
+
+// recordAccountChanges audits the security-relevant differences between two
+// versions of a provisioned user
+func (i *ScimInteractor) recordAccountChanges(ctx context.Context, before, after *model.User) {
+	if before.Email != after.Email {
+		i.auditLogger.Record(ctx, scimEvent(after.TenantID, model.AuditActionEmailChanged, after.ID, map[string]string{
+			"from": before.Email,
+			"to":   after.Email,
+		}))
+	}
+	if before.Role != after.Role {
+		i.auditLogger.Record(ctx, scimEvent(after.TenantID, model.AuditActionRoleChanged, after.ID, map[string]string{
+			"from": before.Role,
+			"to":   after.Role,
+		}))
+	}
+	if before.IsActive != after.IsActive {
+		action := model.AuditActionUserDeactivated
+		if after.IsActive {
+			action = model.AuditActionUserActivated
+		}
+		i.auditLogger.Record(ctx, scimEvent(after.TenantID, action, after.ID, nil))
+	}
+	if before.PasswordHash != after.PasswordHash {
+		i.auditLogger.Record(ctx, scimEvent(after.TenantID, model.AuditActionPasswordChanged, after.ID, nil))
+	}
+}
+
+// scimEvent builds an event performed by the tenant's identity provider on a user account
+func scimEvent(tenantID, action, targetUserID string, metadata map[string]string) *model.AuditEvent {
+	targetType := model.AuditTargetUser
+	return &model.AuditEvent{
+		TenantID:   tenantID,
+		ActorType:  model.AuditActorScim,
+		Action:     action,
+		TargetType: &targetType,
+		TargetID:   &targetUserID,
+		Metadata:   metadata,
+	}
+}
+
+// checkUnique rejects a userName or externalId that belongs to another user in the tenant
+func (i *ScimInteractor) checkUnique(ctx context.Context, userID, email string, externalID *string) error {
+	users, _, err := i.userRepo.List(ctx, &model.UserFilter{Email: &email}, 0, 1)
+	if err != nil {
+		return cerror.NewInternalServerError("failed to check user uniqueness", err)
+	}
+	if len(users) > 0 && users[0].ID != userID {
+		return scimError(cerror.NewConflict("userName already exists", nil), scimTypeUniqueness)
+	}
+
+	if externalID == nil {
+		return nil
+	}
+	users, _, err = i.userRepo.List(ctx, &model.UserFilter{ExternalID: externalID}, 0, 1)
+	if err != nil {
+		return cerror.NewInternalServerError("failed to check user uniqueness", err)
+	}
+	if len(users) > 0 && users[0].ID != userID {
+		return scimError(cerror.NewConflict("externalId already exists", nil), scimTypeUniqueness)
+	}
+
+	return nil
+}
+
+// scimWriteError maps a failed user write to a SCIM error. A concurrent
+// request may have taken the userName or externalId after checkUnique passed.
+func scimWriteError(message string, err error) error {
+	if errors.Is(err, repository.ErrUserConflict) {
+		return scimError(cerror.NewConflict("userName or externalId already exists", err), scimTypeUniqueness)
+	}
+	return cerror.NewInternalServerError(message, err)
+}
+
+// passwordHash hashes a provisioned password after checking it against the
+// policy. Without one the user gets an unusable random password and signs in
+// with a magic link or by resetting it.
+func (i *ScimInteractor) passwordHash(password *string, user *model.User) (string, error) {
+	if password == nil || *password == "" {
+		random, err := generateVerificationToken()
+		if err != nil {
+			return "", cerror.NewInternalServerError("failed to generate password", err)
+		}
+		password = &random
+	} else {
+		result := i.passwordPolicy.Check(*password, user.Email, user.Name)
+		if !result.OK() {
+			return "", scimError(cerror.NewBadRequest("password does not meet the password policy", nil), scimTypeInvalidValue)
+		}
+	}
+
+	hash, err := pkg.HashPassword(*password)
+	if err != nil {
+		return "", cerror.NewInternalServerError("failed to hash password", err)
+	}
+	return hash, nil
+}
+
+func parseScimFilter(filter string) (*model.UserFilter, error) {
+	if strings.TrimSpace(filter) == "" {
+		return nil, nil
+	}
+
+	m := scimFilterPattern.FindStringSubmatch(filter)
+	if m == nil {
+		return nil, scimError(cerror.NewBadRequest("only userName eq and externalId eq filters are supported", nil), scimTypeInvalidFilter)
+	}
+
+	value, err := strconv.Unquote(m[2])
+	if err != nil {
+		return nil, scimError(cerror.NewBadRequest("invalid filter value", err), scimTypeInvalidFilter)
+	}
+
+	if strings.EqualFold(m[1], "userName") {
+		return &model.UserFilter{Email: &value}, nil
+	}
+	return &model.UserFilter{ExternalID: &value}, nil
+}
+
+// normalizeScimUserName validates userName, which maps onto the user's email
+func normalizeScimUserName(userName string) (string, error) {
+	addr, err := mail.ParseAddress(userName)
+	if err != nil || addr.Address != userName {
+		return "", scimError(cerror.NewBadRequest("userName must be an email address", err), scimTypeInvalidValue)
+	}
+	return userName, nil
+}
+
+func applyScimPatchOperation(user *model.User, op input.ScimPatchOperation) error {
+	switch strings.ToLower(op.Op) {
+	case "add", "replace":
+		if op.Path == "" {
+			// Without a path the value is a partial resource
+			attrs, ok := op.Value.(map[string]interface{})
+			if !ok {
+				return scimError(cerror.NewBadRequest("patch value must be an object when path is omitted", nil), scimTypeInvalidValue)
+			}
+			for attr, value := range attrs {
+				if err := setScimAttribute(user, attr, value); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		return setScimAttribute(user, op.Path, op.Value)
+	case "remove":
+		switch strings.ToLower(op.Path) {
+		case "externalid":
+			user.ExternalID = nil
+		case "roles":
+			user.Role = "member"
+		default:
+			return scimError(cerror.NewBadRequest("attribute cannot be removed: "+op.Path, nil), scimTypeInvalidPath)
+		}
+		return nil
+	default:
+		return scimError(cerror.NewBadRequest("unsupported patch op: "+op.Op, nil), scimTypeInvalidValue)
+	}
+}
+
+func setScimAttribute(user *model.User, attr string, value interface{}) error {
+	switch strings.ToLower(attr) {
+	case "active":
+		active, ok := scimBool(value)
+		if !ok {
+			return scimError(cerror.NewBadRequest("active must be a boolean", nil), scimTypeInvalidValue)
+		}
+		user.IsActive = active
+	case "username":
+		s, _ := value.(string)
+		email, err := normalizeScimUserName(s)
+		if err != nil {
+			return err
+		}
+		user.Email = email
+	case "displayname", "name.formatted":
+		s, ok := value.(string)
+		if !ok {
+			return scimError(cerror.NewBadRequest(attr+" must be a string", nil), scimTypeInvalidValue)
+		}
+		user.Name = s
+	case "name":
+		name, ok := value.(map[string]interface{})
+		if !ok {
+			return scimError(cerror.NewBadRequest("name must be an object", nil), scimTypeInvalidValue)
+		}
+		if formatted, ok := name["formatted"].(string); ok {
+			user.Name = formatted
+		}
+	case "externalid":
+		s, ok := value.(string)
+		if !ok {
+			return scimError(cerror.NewBadRequest("externalId must be a string", nil), scimTypeInvalidValue)
+		}
+		user.ExternalID = &s
+	case "roles":
+		role, ok := ScimRoleValue(value)
+		if !ok {
+			return scimError(cerror.NewBadRequest("roles must contain a value", nil), scimTypeInvalidValue)
+		}
+		return setScimRole(user, role)
+	default:
+		// Attributes such as emails or phoneNumbers are not stored
+		return nil
+	}
+	return nil
+}
+
+func setScimRole(user *model.User, role string) error {
+	role = strings.ToLower(role)
+	if role != "admin" && role != "member" {
+		return scimError(cerror.NewBadRequest("role must be admin or member", nil), scimTypeInvalidValue)
+	}
+	user.Role = role
+	return nil
+}
+
+// ScimRoleValue extracts the role from a SCIM roles value: a list of
+// {value, primary} objects, a single such object, or a plain string.
+// The primary entry wins, otherwise the first one is used.
+func ScimRoleValue(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, v != ""
+	case map[string]interface{}:
+		s, ok := v["value"].(string)
+		return s, ok && s != ""
+	case []interface{}:
+		var first string
+		for _, item := range v {
+			entry, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			s, _ := entry["value"].(string)
+			if s == "" {
+				continue
+			}
+			if primary, _ := scimBool(entry["primary"]); primary {
+				return s, true
+			}
+			if first == "" {
+				first = s
+			}
+		}
+		return first, first != ""
+	}
+	return "", false
+}
+
+// scimBool accepts JSON booleans and the "True"/"False" strings some identity providers send
+func scimBool(value interface{}) (bool, bool) {
+	switch v := value.(type) {
+	case bool:
+		return v, true
+	case string:
+		b, err := strconv.ParseBool(v)
+		return b, err == nil
+	}
+	return false, false
+}
+
+func scimError(err *cerror.AppError, scimType string) *cerror.AppError {
+	err.Details = map[string]interface{}{ScimTypeDetailKey: scimType}
+	return err
+}
@@ -0,0 +1,461 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	mock_repository "good-todo-go/internal/domain/repository/mock"
+	"good-todo-go/internal/pkg/cerror"
+	mock_pkg "good-todo-go/internal/pkg/mock"
+	"good-todo-go/internal/pkg/passwordpolicy"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestScimInteractor_Authenticate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		token      string
+		setupMocks func(tenantRepo *mock_repository.MockITenantRepository)
+		want       string
+		wantErr    bool
+	}{
+		{
+			name:  "success - token matches tenant",
+			token: "scim_valid",
+			setupMocks: func(tenantRepo *mock_repository.MockITenantRepository) {
+				tenantRepo.EXPECT().
+					FindBySCIMTokenHash(gomock.Any(), hashToken("scim_valid")).
+					Return(&model.Tenant{ID: "tenant-id"}, nil)
+			},
+			want: "tenant-id",
+		},
+		{
+			name:  "fail - unknown token",
+			token: "scim_unknown",
+			setupMocks: func(tenantRepo *mock_repository.MockITenantRepository) {
+				tenantRepo.EXPECT().
+					FindBySCIMTokenHash(gomock.Any(), hashToken("scim_unknown")).
+					Return(nil, errors.New("not found"))
+			},
+			wantErr: true,
+		},
+		{
+			name:       "fail - empty token",
+			token:      "",
+			setupMocks: func(tenantRepo *mock_repository.MockITenantRepository) {},
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepo := mock_repository.NewMockIUserRepository(ctrl)
+			tenantRepo := mock_repository.NewMockITenantRepository(ctrl)
+			uuidGen := mock_pkg.NewMockIUUIDGenerator(ctrl)
+			tt.setupMocks(tenantRepo)
+
+			interactor := NewScimInteractor(userRepo, tenantRepo, uuidGen, passwordpolicy.NewPolicy(8, 3), newNopAuditLogger(ctrl))
+
+			tenantID, err := interactor.Authenticate(context.Background(), tt.token)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, tenantID)
+		})
+	}
+}
+
+func TestScimInteractor_ListUsers(t *testing.T) {
+	t.Parallel()
+
+	email := "alice@example.com"
+	externalID := "00u1abcd"
+
+	tests := []struct {
+		name         string
+		input        *input.ListScimUsersInput
+		setupMocks   func(userRepo *mock_repository.MockIUserRepository)
+		wantErr      bool
+		wantScimType string
+	}{
+		{
+			name:  "success - userName filter",
+			input: &input.ListScimUsersInput{TenantID: "tenant-id", Filter: `userName eq "alice@example.com"`},
+			setupMocks: func(userRepo *mock_repository.MockIUserRepository) {
+				userRepo.EXPECT().
+					List(gomock.Any(), &model.UserFilter{Email: &email}, 0, scimDefaultCount).
+					Return([]*model.User{{ID: "user-id", Email: email}}, 1, nil)
+			},
+		},
+		{
+			name:  "success - externalId filter with paging",
+			input: &input.ListScimUsersInput{TenantID: "tenant-id", Filter: `externalId EQ "00u1abcd"`, StartIndex: 11, Count: 500},
+			setupMocks: func(userRepo *mock_repository.MockIUserRepository) {
+				userRepo.EXPECT().
+					List(gomock.Any(), &model.UserFilter{ExternalID: &externalID}, 10, scimMaxCount).
+					Return([]*model.User{}, 0, nil)
+			},
+		},
+		{
+			name:         "fail - unsupported filter",
+			input:        &input.ListScimUsersInput{TenantID: "tenant-id", Filter: `name.familyName co "smith"`},
+			setupMocks:   func(userRepo *mock_repository.MockIUserRepository) {},
+			wantErr:      true,
+			wantScimType: scimTypeInvalidFilter,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepo := mock_repository.NewMockIUserRepository(ctrl)
+			tenantRepo := mock_repository.NewMockITenantRepository(ctrl)
+			uuidGen := mock_pkg.NewMockIUUIDGenerator(ctrl)
+			tt.setupMocks(userRepo)
+
+			interactor := NewScimInteractor(userRepo, tenantRepo, uuidGen, passwordpolicy.NewPolicy(8, 3), newNopAuditLogger(ctrl))
+
+			_, err := interactor.ListUsers(context.Background(), tt.input)
+
+			if tt.wantErr {
+				var appErr *cerror.AppError
+				require.ErrorAs(t, err, &appErr)
+				assert.Equal(t, tt.wantScimType, appErr.Details[ScimTypeDetailKey])
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestScimInteractor_CreateUser(t *testing.T) {
+	t.Parallel()
+
+	admin := "admin"
+	externalID := "00u1abcd"
+	weakPassword := "password123"
+
+	tests := []struct {
+		name         string
+		input        *input.CreateScimUserInput
+		setupMocks   func(userRepo *mock_repository.MockIUserRepository, uuidGen *mock_pkg.MockIUUIDGenerator)
+		wantErr      bool
+		wantCode     cerror.ErrorCode
+		wantScimType string
+	}{
+		{
+			name: "success - provisioned as verified admin",
+			input: &input.CreateScimUserInput{
+				TenantID: "tenant-id",
+				User: input.ScimUserInput{
+					UserName:   "alice@example.com",
+					Name:       "Alice",
+					ExternalID: &externalID,
+					Role:       &admin,
+				},
+			},
+			setupMocks: func(userRepo *mock_repository.MockIUserRepository, uuidGen *mock_pkg.MockIUUIDGenerator) {
+				userRepo.EXPECT().List(gomock.Any(), gomock.Any(), 0, 1).Return(nil, 0, nil).Times(2)
+				uuidGen.EXPECT().Generate().Return("user-id")
+				userRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, u *model.User) (*model.User, error) {
+						assert.Equal(t, "tenant-id", u.TenantID)
+						assert.Equal(t, "alice@example.com", u.Email)
+						assert.Equal(t, "admin", u.Role)
+						assert.True(t, u.IsActive)
+						assert.True(t, u.EmailVerified)
+						assert.NotEmpty(t, u.PasswordHash)
+						return u, nil
+					})
+			},
+		},
+		{
+			name: "fail - userName already exists",
+			input: &input.CreateScimUserInput{
+				TenantID: "tenant-id",
+				User:     input.ScimUserInput{UserName: "alice@example.com"},
+			},
+			setupMocks: func(userRepo *mock_repository.MockIUserRepository, uuidGen *mock_pkg.MockIUUIDGenerator) {
+				userRepo.EXPECT().
+					List(gomock.Any(), gomock.Any(), 0, 1).
+					Return([]*model.User{{ID: "other-user-id"}}, 1, nil)
+			},
+			wantErr:      true,
+			wantCode:     cerror.ErrCodeConflict,
+			wantScimType: scimTypeUniqueness,
+		},
+		{
+			name: "fail - userName taken by a concurrent create",
+			input: &input.CreateScimUserInput{
+				TenantID: "tenant-id",
+				User:     input.ScimUserInput{UserName: "alice@example.com"},
+			},
+			setupMocks: func(userRepo *mock_repository.MockIUserRepository, uuidGen *mock_pkg.MockIUUIDGenerator) {
+				userRepo.EXPECT().List(gomock.Any(), gomock.Any(), 0, 1).Return(nil, 0, nil)
+				uuidGen.EXPECT().Generate().Return("user-id")
+				userRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					Return(nil, fmt.Errorf("%w: duplicate key", repository.ErrUserConflict))
+			},
+			wantErr:      true,
+			wantCode:     cerror.ErrCodeConflict,
+			wantScimType: scimTypeUniqueness,
+		},
+		{
+			name: "fail - userName is not an email",
+			input: &input.CreateScimUserInput{
+				TenantID: "tenant-id",
+				User:     input.ScimUserInput{UserName: "alice"},
+			},
+			setupMocks:   func(userRepo *mock_repository.MockIUserRepository, uuidGen *mock_pkg.MockIUUIDGenerator) {},
+			wantErr:      true,
+			wantCode:     cerror.ErrCodeBadRequest,
+			wantScimType: scimTypeInvalidValue,
+		},
+		{
+			name: "fail - password violates policy",
+			input: &input.CreateScimUserInput{
+				TenantID: "tenant-id",
+				User:     input.ScimUserInput{UserName: "alice@example.com", Password: &weakPassword},
+			},
+			setupMocks: func(userRepo *mock_repository.MockIUserRepository, uuidGen *mock_pkg.MockIUUIDGenerator) {
+				userRepo.EXPECT().List(gomock.Any(), gomock.Any(), 0, 1).Return(nil, 0, nil)
+				uuidGen.EXPECT().Generate().Return("user-id")
+			},
+			wantErr:      true,
+			wantCode:     cerror.ErrCodeBadRequest,
+			wantScimType: scimTypeInvalidValue,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepo := mock_repository.NewMockIUserRepository(ctrl)
+			tenantRepo := mock_repository.NewMockITenantRepository(ctrl)
+			uuidGen := mock_pkg.NewMockIUUIDGenerator(ctrl)
+			tt.setupMocks(userRepo, uuidGen)
+
+			interactor := NewScimInteractor(userRepo, tenantRepo, uuidGen, passwordpolicy.NewPolicy(8, 3), newNopAuditLogger(ctrl))
+
+			result, err := interactor.CreateUser(context.Background(), tt.input)
+
+			if tt.wantErr {
+				var appErr *cerror.AppError
+				require.ErrorAs(t, err, &appErr)
+				assert.Equal(t, tt.wantCode, appErr.Code)
+				assert.Equal(t, tt.wantScimType, appErr.Details[ScimTypeDetailKey])
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "user-id", result.ID)
+		})
+	}
+}
+
+func TestScimInteractor_PatchUser(t *testing.T) {
+	t.Parallel()
+
+	existingUser := func() *model.User {
+		return &model.User{
+			ID:       "user-id",
+			TenantID: "tenant-id",
+			Email:    "alice@example.com",
+			Name:     "Alice",
+			Role:     "member",
+			IsActive: true,
+		}
+	}
+
+	tests := []struct {
+		name       string
+		operations []input.ScimPatchOperation
+		check      func(t *testing.T, u *model.User)
+		wantErr    bool
+	}{
+		{
+			name: "success - deactivate with path (Okta style)",
+			operations: []input.ScimPatchOperation{
+				{Op: "replace", Path: "active", Value: false},
+			},
+			check: func(t *testing.T, u *model.User) {
+				assert.False(t, u.IsActive)
+			},
+		},
+		{
+			name: "success - string boolean without path (Azure style)",
+			operations: []input.ScimPatchOperation{
+				{Op: "Replace", Value: map[string]interface{}{"active": "False", "displayName": "Alice Smith"}},
+			},
+			check: func(t *testing.T, u *model.User) {
+				assert.False(t, u.IsActive)
+				assert.Equal(t, "Alice Smith", u.Name)
+			},
+		},
+		{
+			name: "success - promote with primary role",
+			operations: []input.ScimPatchOperation{
+				{Op: "add", Path: "roles", Value: []interface{}{
+					map[string]interface{}{"value": "member"},
+					map[string]interface{}{"value": "admin", "primary": true},
+				}},
+			},
+			check: func(t *testing.T, u *model.User) {
+				assert.Equal(t, "admin", u.Role)
+			},
+		},
+		{
+			name: "fail - unknown role",
+			operations: []input.ScimPatchOperation{
+				{Op: "replace", Path: "roles", Value: "owner"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail - userName cannot be removed",
+			operations: []input.ScimPatchOperation{
+				{Op: "remove", Path: "userName"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepo := mock_repository.NewMockIUserRepository(ctrl)
+			tenantRepo := mock_repository.NewMockITenantRepository(ctrl)
+			uuidGen := mock_pkg.NewMockIUUIDGenerator(ctrl)
+
+			userRepo.EXPECT().FindByID(gomock.Any(), "user-id").Return(existingUser(), nil)
+			if !tt.wantErr {
+				userRepo.EXPECT().List(gomock.Any(), gomock.Any(), 0, 1).Return(nil, 0, nil)
+				userRepo.EXPECT().
+					UpdateAccount(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, u *model.User) (*model.User, error) {
+						tt.check(t, u)
+						return u, nil
+					})
+			}
+
+			interactor := NewScimInteractor(userRepo, tenantRepo, uuidGen, passwordpolicy.NewPolicy(8, 3), newNopAuditLogger(ctrl))
+
+			_, err := interactor.PatchUser(context.Background(), &input.PatchScimUserInput{
+				TenantID:   "tenant-id",
+				UserID:     "user-id",
+				Operations: tt.operations,
+			})
+
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestScimInteractor_DeleteUser(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		setupMocks  func(userRepo *mock_repository.MockIUserRepository)
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name: "success - user is deactivated, not deleted",
+			setupMocks: func(userRepo *mock_repository.MockIUserRepository) {
+				userRepo.EXPECT().
+					FindByID(gomock.Any(), "user-id").
+					Return(&model.User{ID: "user-id", IsActive: true}, nil)
+				userRepo.EXPECT().
+					UpdateAccount(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, u *model.User) (*model.User, error) {
+						assert.False(t, u.IsActive)
+						return u, nil
+					})
+			},
+		},
+		{
+			name: "success - already deactivated",
+			setupMocks: func(userRepo *mock_repository.MockIUserRepository) {
+				userRepo.EXPECT().
+					FindByID(gomock.Any(), "user-id").
+					Return(&model.User{ID: "user-id", IsActive: false}, nil)
+			},
+		},
+		{
+			name: "fail - user not found",
+			setupMocks: func(userRepo *mock_repository.MockIUserRepository) {
+				userRepo.EXPECT().
+					FindByID(gomock.Any(), "user-id").
+					Return(nil, errors.New("not found"))
+			},
+			wantErr:     true,
+			errContains: "user not found",
+		},
+		{
+			name: "fail - system users are not provisioned",
+			setupMocks: func(userRepo *mock_repository.MockIUserRepository) {
+				userRepo.EXPECT().
+					FindByID(gomock.Any(), "user-id").
+					Return(&model.User{ID: "user-id", IsActive: true, IsSystem: true}, nil)
+			},
+			wantErr:     true,
+			errContains: "user not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepo := mock_repository.NewMockIUserRepository(ctrl)
+			tenantRepo := mock_repository.NewMockITenantRepository(ctrl)
+			uuidGen := mock_pkg.NewMockIUUIDGenerator(ctrl)
+			tt.setupMocks(userRepo)
+
+			interactor := NewScimInteractor(userRepo, tenantRepo, uuidGen, passwordpolicy.NewPolicy(8, 3), newNopAuditLogger(ctrl))
+
+			err := interactor.DeleteUser(context.Background(), "user-id")
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
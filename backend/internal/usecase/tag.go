@@ -0,0 +1,210 @@
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_usecase
+package usecase
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/pkg"
+	"good-todo-go/internal/pkg/cerror"
+	"good-todo-go/internal/usecase/input"
+	"good-todo-go/internal/usecase/output"
+)
+
+type ITagInteractor interface {
+	ListTags(ctx context.Context) (*output.TagListOutput, error)
+	CreateTag(ctx context.Context, in *input.CreateTagInput) (*output.TagOutput, error)
+	// UpdateTag and DeleteTag affect every todo in the tenant, so only admins may call them
+	UpdateTag(ctx context.Context, in *input.UpdateTagInput) (*output.TagOutput, error)
+	DeleteTag(ctx context.Context, in *input.DeleteTagInput) error
+	// AttachTag and DetachTag are limited to the todo's owner
+	AttachTag(ctx context.Context, in *input.TodoTagInput) (*output.TodoOutput, error)
+	DetachTag(ctx context.Context, in *input.TodoTagInput) error
+}
+
+const tagNameMaxLength = 50
+
+var tagColorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+type TagInteractor struct {
+	tagRepo  repository.ITagRepository
+	todoRepo repository.ITodoRepository
+	uuidGen  pkg.IUUIDGenerator
+}
+
+func NewTagInteractor(
+	tagRepo repository.ITagRepository,
+	todoRepo repository.ITodoRepository,
+	uuidGen pkg.IUUIDGenerator,
+) ITagInteractor {
+	return &TagInteractor{
+		tagRepo:  tagRepo,
+		todoRepo: todoRepo,
+		uuidGen:  uuidGen,
+	}
+}
+
+func (i *TagInteractor) ListTags(ctx context.Context) (*output.TagListOutput, error) {
+	tags, err := i.tagRepo.FindAll(ctx)
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to get tags", err)
+	}
+	return output.NewTagListOutput(tags), nil
+}
+
+func (i *TagInteractor) CreateTag(ctx context.Context, in *input.CreateTagInput) (*output.TagOutput, error) {
+	name, err := normalizeTagName(in.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	color := model.DefaultTagColor
+	if in.Color != nil {
+		if !tagColorPattern.MatchString(*in.Color) {
+			return nil, cerror.NewBadRequest("color must be a hex color like #1a2b3c", nil)
+		}
+		color = strings.ToLower(*in.Color)
+	}
+
+	if err := i.ensureTagNameAvailable(ctx, name, ""); err != nil {
+		return nil, err
+	}
+
+	tag := &model.Tag{
+		ID:       i.uuidGen.Generate(),
+		TenantID: in.TenantID,
+		Name:     name,
+		Color:    color,
+	}
+
+	created, err := i.tagRepo.Create(ctx, tag)
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to create tag", err)
+	}
+
+	return output.NewTagOutput(created), nil
+}
+
+func (i *TagInteractor) UpdateTag(ctx context.Context, in *input.UpdateTagInput) (*output.TagOutput, error) {
+	if in.Role != "admin" {
+		return nil, cerror.NewForbidden("only tenant admins can change tags", nil)
+	}
+
+	tag, err := i.tagRepo.FindByID(ctx, in.TagID)
+	if err != nil {
+		return nil, cerror.NewNotFound("tag not found", err)
+	}
+
+	if in.Name != nil {
+		name, err := normalizeTagName(*in.Name)
+		if err != nil {
+			return nil, err
+		}
+		if err := i.ensureTagNameAvailable(ctx, name, tag.ID); err != nil {
+			return nil, err
+		}
+		tag.Name = name
+	}
+	if in.Color != nil {
+		if !tagColorPattern.MatchString(*in.Color) {
+			return nil, cerror.NewBadRequest("color must be a hex color like #1a2b3c", nil)
+		}
+		tag.Color = strings.ToLower(*in.Color)
+	}
+
+	updated, err := i.tagRepo.Update(ctx, tag)
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to update tag", err)
+	}
+
+	return output.NewTagOutput(updated), nil
+}
+
+func (i *TagInteractor) DeleteTag(ctx context.Context, in *input.DeleteTagInput) error {
+	if in.Role != "admin" {
+		return cerror.NewForbidden("only tenant admins can delete tags", nil)
+	}
+
+	if _, err := i.tagRepo.FindByID(ctx, in.TagID); err != nil {
+		return cerror.NewNotFound("tag not found", err)
+	}
+
+	if err := i.tagRepo.Delete(ctx, in.TagID); err != nil {
+		return cerror.NewInternalServerError("failed to delete tag", err)
+	}
+
+	return nil
+}
+
+func (i *TagInteractor) AttachTag(ctx context.Context, in *input.TodoTagInput) (*output.TodoOutput, error) {
+	if err := i.authorizeTodoTagChange(ctx, in); err != nil {
+		return nil, err
+	}
+
+	if _, err := i.tagRepo.FindByID(ctx, in.TagID); err != nil {
+		return nil, cerror.NewNotFound("tag not found", err)
+	}
+
+	if err := i.tagRepo.Attach(ctx, in.TenantID, in.TodoID, in.TagID); err != nil {
+		return nil, cerror.NewInternalServerError("failed to attach tag", err)
+	}
+
+	todo, err := i.todoRepo.FindByID(ctx, in.TodoID)
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to get todo", err)
+	}
+
+	return output.NewTodoOutput(todo), nil
+}
+
+func (i *TagInteractor) DetachTag(ctx context.Context, in *input.TodoTagInput) error {
+	if err := i.authorizeTodoTagChange(ctx, in); err != nil {
+		return err
+	}
+
+	if err := i.tagRepo.Detach(ctx, in.TodoID, in.TagID); err != nil {
+		return cerror.NewInternalServerError("failed to detach tag", err)
+	}
+
+	return nil
+}
+
+// authorizeTodoTagChange checks that the todo exists and belongs to the caller
+func (i *TagInteractor) authorizeTodoTagChange(ctx context.Context, in *input.TodoTagInput) error {
+	todo, err := i.todoRepo.FindByID(ctx, in.TodoID)
+	if err != nil {
+		return cerror.NewNotFound("todo not found", err)
+	}
+
+	// Only owner can change a todo's tags
+	if todo.UserID != in.UserID {
+		return cerror.NewForbidden("not allowed to update this todo", nil)
+	}
+	return nil
+}
+
+// ensureTagNameAvailable rejects names already used by another tag in the
+// tenant, ignoring case. The unique index still guards against races.
+func (i *TagInteractor) ensureTagNameAvailable(ctx context.Context, name, tagID string) error {
+	existing, err := i.tagRepo.FindByName(ctx, name)
+	if err == nil && existing != nil && existing.ID != tagID {
+		return cerror.NewConflict("tag name already exists", nil)
+	}
+	return nil
+}
+
+// normalizeTagName trims surrounding whitespace and checks the length
+func normalizeTagName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", cerror.NewBadRequest("tag name is required", nil)
+	}
+	if utf8.RuneCountInString(name) > tagNameMaxLength {
+		return "", cerror.NewBadRequest("tag name must be at most 50 characters", nil)
+	}
+	return name, nil
+}
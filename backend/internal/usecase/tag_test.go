@@ -0,0 +1,278 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"good-todo-go/internal/domain/model"
+	mock_repository "good-todo-go/internal/domain/repository/mock"
+	mock_pkg "good-todo-go/internal/pkg/mock"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTagInteractor_CreateTag(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		input       *input.CreateTagInput
+		setupMocks  func(tagRepo *mock_repository.MockITagRepository, uuidGen *mock_pkg.MockIUUIDGenerator)
+		wantName    string
+		wantColor   string
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:  "success - name is trimmed and color defaults to gray",
+			input: &input.CreateTagInput{TenantID: "tenant-1", Name: "  Work  "},
+			setupMocks: func(tagRepo *mock_repository.MockITagRepository, uuidGen *mock_pkg.MockIUUIDGenerator) {
+				tagRepo.EXPECT().FindByName(gomock.Any(), "Work").Return(nil, errors.New("not found"))
+				uuidGen.EXPECT().Generate().Return("tag-1")
+				tagRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, tag *model.Tag) (*model.Tag, error) {
+						return tag, nil
+					})
+			},
+			wantName:  "Work",
+			wantColor: model.DefaultTagColor,
+		},
+		{
+			name:  "success - color is normalized to lower case",
+			input: &input.CreateTagInput{TenantID: "tenant-1", Name: "Urgent", Color: strPtr("#FF00AA")},
+			setupMocks: func(tagRepo *mock_repository.MockITagRepository, uuidGen *mock_pkg.MockIUUIDGenerator) {
+				tagRepo.EXPECT().FindByName(gomock.Any(), "Urgent").Return(nil, errors.New("not found"))
+				uuidGen.EXPECT().Generate().Return("tag-2")
+				tagRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, tag *model.Tag) (*model.Tag, error) {
+						return tag, nil
+					})
+			},
+			wantName:  "Urgent",
+			wantColor: "#ff00aa",
+		},
+		{
+			name:  "fail - name taken with different case",
+			input: &input.CreateTagInput{TenantID: "tenant-1", Name: "work"},
+			setupMocks: func(tagRepo *mock_repository.MockITagRepository, uuidGen *mock_pkg.MockIUUIDGenerator) {
+				tagRepo.EXPECT().FindByName(gomock.Any(), "work").Return(&model.Tag{ID: "tag-1", Name: "Work"}, nil)
+			},
+			wantErr:     true,
+			errContains: "already exists",
+		},
+		{
+			name:        "fail - blank name",
+			input:       &input.CreateTagInput{TenantID: "tenant-1", Name: "   "},
+			setupMocks:  func(tagRepo *mock_repository.MockITagRepository, uuidGen *mock_pkg.MockIUUIDGenerator) {},
+			wantErr:     true,
+			errContains: "required",
+		},
+		{
+			name:        "fail - name too long",
+			input:       &input.CreateTagInput{TenantID: "tenant-1", Name: strings.Repeat("ä", 51)},
+			setupMocks:  func(tagRepo *mock_repository.MockITagRepository, uuidGen *mock_pkg.MockIUUIDGenerator) {},
+			wantErr:     true,
+			errContains: "at most 50",
+		},
+		{
+			name:        "fail - invalid color",
+			input:       &input.CreateTagInput{TenantID: "tenant-1", Name: "Work", Color: strPtr("red")},
+			setupMocks:  func(tagRepo *mock_repository.MockITagRepository, uuidGen *mock_pkg.MockIUUIDGenerator) {},
+			wantErr:     true,
+			errContains: "hex color",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tagRepo := mock_repository.NewMockITagRepository(ctrl)
+			uuidGen := mock_pkg.NewMockIUUIDGenerator(ctrl)
+			tt.setupMocks(tagRepo, uuidGen)
+
+			interactor := NewTagInteractor(tagRepo, mock_repository.NewMockITodoRepository(ctrl), uuidGen)
+
+			result, err := interactor.CreateTag(context.Background(), tt.input)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantName, result.Name)
+			assert.Equal(t, tt.wantColor, result.Color)
+		})
+	}
+}
+
+func TestTagInteractor_UpdateTag(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		input       *input.UpdateTagInput
+		setupMocks  func(tagRepo *mock_repository.MockITagRepository)
+		wantName    string
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:  "success - renaming to a different case of the same name",
+			input: &input.UpdateTagInput{TagID: "tag-1", Role: "admin", Name: strPtr("WORK")},
+			setupMocks: func(tagRepo *mock_repository.MockITagRepository) {
+				tagRepo.EXPECT().FindByID(gomock.Any(), "tag-1").Return(&model.Tag{ID: "tag-1", Name: "Work"}, nil)
+				tagRepo.EXPECT().FindByName(gomock.Any(), "WORK").Return(&model.Tag{ID: "tag-1", Name: "Work"}, nil)
+				tagRepo.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, tag *model.Tag) (*model.Tag, error) {
+						return tag, nil
+					})
+			},
+			wantName: "WORK",
+		},
+		{
+			name:        "fail - member cannot change tags",
+			input:       &input.UpdateTagInput{TagID: "tag-1", Role: "member", Name: strPtr("Home")},
+			setupMocks:  func(tagRepo *mock_repository.MockITagRepository) {},
+			wantErr:     true,
+			errContains: "only tenant admins",
+		},
+		{
+			name:  "fail - name used by another tag",
+			input: &input.UpdateTagInput{TagID: "tag-1", Role: "admin", Name: strPtr("home")},
+			setupMocks: func(tagRepo *mock_repository.MockITagRepository) {
+				tagRepo.EXPECT().FindByID(gomock.Any(), "tag-1").Return(&model.Tag{ID: "tag-1", Name: "Work"}, nil)
+				tagRepo.EXPECT().FindByName(gomock.Any(), "home").Return(&model.Tag{ID: "tag-2", Name: "Home"}, nil)
+			},
+			wantErr:     true,
+			errContains: "already exists",
+		},
+		{
+			name:  "fail - tag not found",
+			input: &input.UpdateTagInput{TagID: "missing", Role: "admin", Name: strPtr("Home")},
+			setupMocks: func(tagRepo *mock_repository.MockITagRepository) {
+				tagRepo.EXPECT().FindByID(gomock.Any(), "missing").Return(nil, errors.New("not found"))
+			},
+			wantErr:     true,
+			errContains: "tag not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tagRepo := mock_repository.NewMockITagRepository(ctrl)
+			tt.setupMocks(tagRepo)
+
+			interactor := NewTagInteractor(tagRepo, mock_repository.NewMockITodoRepository(ctrl), mock_pkg.NewMockIUUIDGenerator(ctrl))
+
+			result, err := interactor.UpdateTag(context.Background(), tt.input)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantName, result.Name)
+		})
+	}
+}
+
+func TestTagInteractor_AttachTag(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		input       *input.TodoTagInput
+		setupMocks  func(tagRepo *mock_repository.MockITagRepository, todoRepo *mock_repository.MockITodoRepository)
+		wantTags    []string
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:  "success - returns the todo with its tags",
+			input: &input.TodoTagInput{TenantID: "tenant-1", UserID: "user-1", TodoID: "todo-1", TagID: "tag-1"},
+			setupMocks: func(tagRepo *mock_repository.MockITagRepository, todoRepo *mock_repository.MockITodoRepository) {
+				gomock.InOrder(
+					todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(&model.Todo{ID: "todo-1", UserID: "user-1"}, nil),
+					tagRepo.EXPECT().FindByID(gomock.Any(), "tag-1").Return(&model.Tag{ID: "tag-1", Name: "Work"}, nil),
+					tagRepo.EXPECT().Attach(gomock.Any(), "tenant-1", "todo-1", "tag-1").Return(nil),
+					todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(&model.Todo{
+						ID:     "todo-1",
+						UserID: "user-1",
+						Tags:   []*model.Tag{{ID: "tag-1", Name: "Work"}},
+					}, nil),
+				)
+			},
+			wantTags: []string{"Work"},
+		},
+		{
+			name:  "fail - not the owner",
+			input: &input.TodoTagInput{TenantID: "tenant-1", UserID: "user-2", TodoID: "todo-1", TagID: "tag-1"},
+			setupMocks: func(tagRepo *mock_repository.MockITagRepository, todoRepo *mock_repository.MockITodoRepository) {
+				todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(&model.Todo{ID: "todo-1", UserID: "user-1", IsPublic: true}, nil)
+			},
+			wantErr:     true,
+			errContains: "not allowed",
+		},
+		{
+			name:  "fail - tag not found",
+			input: &input.TodoTagInput{TenantID: "tenant-1", UserID: "user-1", TodoID: "todo-1", TagID: "missing"},
+			setupMocks: func(tagRepo *mock_repository.MockITagRepository, todoRepo *mock_repository.MockITodoRepository) {
+				todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(&model.Todo{ID: "todo-1", UserID: "user-1"}, nil)
+				tagRepo.EXPECT().FindByID(gomock.Any(), "missing").Return(nil, errors.New("not found"))
+			},
+			wantErr:     true,
+			errContains: "tag not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tagRepo := mock_repository.NewMockITagRepository(ctrl)
+			todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+			tt.setupMocks(tagRepo, todoRepo)
+
+			interactor := NewTagInteractor(tagRepo, todoRepo, mock_pkg.NewMockIUUIDGenerator(ctrl))
+
+			result, err := interactor.AttachTag(context.Background(), tt.input)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+			names := make([]string, len(result.Tags))
+			for i, tag := range result.Tags {
+				names[i] = tag.Name
+			}
+			assert.Equal(t, tt.wantTags, names)
+		})
+	}
+}
@@ -0,0 +1,140 @@
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_usecase
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/pkg/cerror"
+	"good-todo-go/internal/usecase/input"
+	"good-todo-go/internal/usecase/output"
+)
+
+type ITenantInteractor interface {
+	GetSettings(ctx context.Context, tenantID string) (*output.TenantSettingsOutput, error)
+	UpdateSettings(ctx context.Context, in *input.UpdateTenantSettingsInput) (*output.TenantSettingsOutput, error)
+	// RotateScimToken issues a new SCIM bearer token, invalidating the previous one
+	RotateScimToken(ctx context.Context, in *input.ScimTokenInput) (*output.ScimTokenOutput, error)
+	RevokeScimToken(ctx context.Context, in *input.ScimTokenInput) error
+}
+
+// scimTokenPrefix makes SCIM tokens recognisable, e.g. to secret scanners
+const scimTokenPrefix = "scim_"
+
+type TenantInteractor struct {
+	tenantRepo  repository.ITenantRepository
+	auditLogger *AuditLogger
+}
+
+func NewTenantInteractor(tenantRepo repository.ITenantRepository, auditLogger *AuditLogger) ITenantInteractor {
+	return &TenantInteractor{
+		tenantRepo:  tenantRepo,
+		auditLogger: auditLogger,
+	}
+}
+
+func (i *TenantInteractor) GetSettings(ctx context.Context, tenantID string) (*output.TenantSettingsOutput, error) {
+	tenant, err := i.tenantRepo.FindByID(ctx, tenantID)
+	if err != nil {
+		return nil, cerror.NewNotFound("tenant not found", err)
+	}
+	return output.NewTenantSettingsOutput(tenant), nil
+}
+
+func (i *TenantInteractor) UpdateSettings(ctx context.Context, in *input.UpdateTenantSettingsInput) (*output.TenantSettingsOutput, error) {
+	if in.Role != "admin" {
+		return nil, cerror.NewForbidden("only tenant admins can change tenant settings", nil)
+	}
+
+	tenant, err := i.tenantRepo.FindByID(ctx, in.TenantID)
+	if err != nil {
+		return nil, cerror.NewNotFound("tenant not found", err)
+	}
+
+	settings := tenant.Settings
+	if in.MagicLinkEnabled != nil {
+		settings.MagicLinkEnabled = *in.MagicLinkEnabled
+	}
+	if in.AccountDeletionPolicy != nil {
+		switch *in.AccountDeletionPolicy {
+		case model.AccountDeletionAnonymize, model.AccountDeletionReassign:
+			settings.AccountDeletionPolicy = *in.AccountDeletionPolicy
+		default:
+			return nil, cerror.NewBadRequest("invalid account deletion policy", nil)
+		}
+	}
+	if in.SearchLanguage != nil {
+		if !slices.Contains(model.SearchLanguages, *in.SearchLanguage) {
+			return nil, cerror.NewBadRequest("invalid search language", nil)
+		}
+		settings.SearchLanguage = *in.SearchLanguage
+	}
+	if in.TrashRetentionDays != nil {
+		if *in.TrashRetentionDays < model.MinTrashRetentionDays || *in.TrashRetentionDays > model.MaxTrashRetentionDays {
+			return nil, cerror.NewBadRequest(fmt.Sprintf("trash retention must be between %d and %d days", model.MinTrashRetentionDays, model.MaxTrashRetentionDays), nil)
+		}
+		settings.TrashRetentionDays = *in.TrashRetentionDays
+	}
+
+	updated, err := i.tenantRepo.UpdateSettings(ctx, in.TenantID, &settings)
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to update tenant settings", err)
+	}
+
+	// Only the settings that actually changed are recorded, with their new value
+	changes := map[string]string{}
+	if updated.Settings.MagicLinkEnabled != tenant.Settings.MagicLinkEnabled {
+		changes["magic_link_enabled"] = strconv.FormatBool(updated.Settings.MagicLinkEnabled)
+	}
+	if updated.Settings.AccountDeletionPolicy != tenant.Settings.AccountDeletionPolicy {
+		changes["account_deletion_policy"] = updated.Settings.AccountDeletionPolicy
+	}
+	if updated.Settings.SearchLanguage != tenant.Settings.SearchLanguage {
+		changes["search_language"] = updated.Settings.SearchLanguage
+	}
+	if updated.Settings.TrashRetentionDays != tenant.Settings.TrashRetentionDays {
+		changes["trash_retention_days"] = strconv.Itoa(updated.Settings.TrashRetentionDays)
+	}
+	if len(changes) > 0 {
+		i.auditLogger.Record(ctx, tenantEvent(in.TenantID, in.UserID, model.AuditActionSettingsUpdated, changes))
+	}
+
+	return output.NewTenantSettingsOutput(updated), nil
+}
+
+func (i *TenantInteractor) RotateScimToken(ctx context.Context, in *input.ScimTokenInput) (*output.ScimTokenOutput, error) {
+	if in.Role != "admin" {
+		return nil, cerror.NewForbidden("only tenant admins can manage the SCIM token", nil)
+	}
+
+	random, err := generateVerificationToken()
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to generate SCIM token", err)
+	}
+	token := scimTokenPrefix + random
+
+	tokenHash := hashToken(token)
+	if err := i.tenantRepo.SetSCIMTokenHash(ctx, in.TenantID, &tokenHash); err != nil {
+		return nil, cerror.NewInternalServerError("failed to store SCIM token", err)
+	}
+	i.auditLogger.Record(ctx, tenantEvent(in.TenantID, in.UserID, model.AuditActionScimTokenRotated, nil))
+
+	return &output.ScimTokenOutput{Token: token}, nil
+}
+
+func (i *TenantInteractor) RevokeScimToken(ctx context.Context, in *input.ScimTokenInput) error {
+	if in.Role != "admin" {
+		return cerror.NewForbidden("only tenant admins can manage the SCIM token", nil)
+	}
+
+	if err := i.tenantRepo.SetSCIMTokenHash(ctx, in.TenantID, nil); err != nil {
+		return cerror.NewInternalServerError("failed to revoke SCIM token", err)
+	}
+	i.auditLogger.Record(ctx, tenantEvent(in.TenantID, in.UserID, model.AuditActionScimTokenRevoked, nil))
+
+	return nil
+}
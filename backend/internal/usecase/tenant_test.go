@@ -0,0 +1,339 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"good-todo-go/internal/domain/model"
+	mock_repository "good-todo-go/internal/domain/repository/mock"
+	"good-todo-go/internal/usecase/input"
+	"good-todo-go/internal/usecase/output"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTenantInteractor_GetSettings(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		tenantID    string
+		setupMocks  func(tenantRepo *mock_repository.MockITenantRepository)
+		want        bool
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:     "success - settings found",
+			tenantID: "tenant-id",
+			setupMocks: func(tenantRepo *mock_repository.MockITenantRepository) {
+				tenantRepo.EXPECT().
+					FindByID(gomock.Any(), "tenant-id").
+					Return(&model.Tenant{
+						ID:       "tenant-id",
+						Settings: model.TenantSettings{MagicLinkEnabled: true},
+					}, nil)
+			},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name:     "fail - tenant not found",
+			tenantID: "non-existent-id",
+			setupMocks: func(tenantRepo *mock_repository.MockITenantRepository) {
+				tenantRepo.EXPECT().
+					FindByID(gomock.Any(), "non-existent-id").
+					Return(nil, errors.New("not found"))
+			},
+			wantErr:     true,
+			errContains: "not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tenantRepo := mock_repository.NewMockITenantRepository(ctrl)
+			tt.setupMocks(tenantRepo)
+
+			interactor := NewTenantInteractor(tenantRepo, newNopAuditLogger(ctrl))
+
+			result, err := interactor.GetSettings(context.Background(), tt.tenantID)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				if tt.errContains != "" {
+					assert.Contains(t, err.Error(), tt.errContains)
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, result.MagicLinkEnabled)
+		})
+	}
+}
+
+func TestTenantInteractor_UpdateSettings(t *testing.T) {
+	t.Parallel()
+
+	enabled := true
+	reassign := model.AccountDeletionReassign
+	unknownPolicy := "keep-everything"
+	english := "english"
+	klingon := "klingon"
+	retention := 7
+	tooLong := 400
+
+	tests := []struct {
+		name        string
+		input       *input.UpdateTenantSettingsInput
+		setupMocks  func(tenantRepo *mock_repository.MockITenantRepository)
+		want        *output.TenantSettingsOutput
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name: "success - admin enables magic link",
+			input: &input.UpdateTenantSettingsInput{
+				TenantID:         "tenant-id",
+				Role:             "admin",
+				MagicLinkEnabled: &enabled,
+			},
+			setupMocks: func(tenantRepo *mock_repository.MockITenantRepository) {
+				tenantRepo.EXPECT().
+					FindByID(gomock.Any(), "tenant-id").
+					Return(&model.Tenant{ID: "tenant-id"}, nil)
+
+				tenantRepo.EXPECT().
+					UpdateSettings(gomock.Any(), "tenant-id", &model.TenantSettings{MagicLinkEnabled: true}).
+					Return(&model.Tenant{
+						ID:       "tenant-id",
+						Settings: model.TenantSettings{MagicLinkEnabled: true},
+					}, nil)
+			},
+			want:    &output.TenantSettingsOutput{MagicLinkEnabled: true},
+			wantErr: false,
+		},
+		{
+			name: "success - admin changes the account deletion policy",
+			input: &input.UpdateTenantSettingsInput{
+				TenantID:              "tenant-id",
+				Role:                  "admin",
+				AccountDeletionPolicy: &reassign,
+			},
+			setupMocks: func(tenantRepo *mock_repository.MockITenantRepository) {
+				tenantRepo.EXPECT().
+					FindByID(gomock.Any(), "tenant-id").
+					Return(&model.Tenant{
+						ID:       "tenant-id",
+						Settings: model.TenantSettings{AccountDeletionPolicy: model.AccountDeletionAnonymize},
+					}, nil)
+
+				tenantRepo.EXPECT().
+					UpdateSettings(gomock.Any(), "tenant-id", &model.TenantSettings{AccountDeletionPolicy: model.AccountDeletionReassign}).
+					Return(&model.Tenant{
+						ID:       "tenant-id",
+						Settings: model.TenantSettings{AccountDeletionPolicy: model.AccountDeletionReassign},
+					}, nil)
+			},
+			want:    &output.TenantSettingsOutput{AccountDeletionPolicy: model.AccountDeletionReassign},
+			wantErr: false,
+		},
+		{
+			name: "fail - unknown account deletion policy",
+			input: &input.UpdateTenantSettingsInput{
+				TenantID:              "tenant-id",
+				Role:                  "admin",
+				AccountDeletionPolicy: &unknownPolicy,
+			},
+			setupMocks: func(tenantRepo *mock_repository.MockITenantRepository) {
+				tenantRepo.EXPECT().
+					FindByID(gomock.Any(), "tenant-id").
+					Return(&model.Tenant{ID: "tenant-id"}, nil)
+			},
+			wantErr:     true,
+			errContains: "invalid account deletion policy",
+		},
+		{
+			name: "success - admin changes the search language",
+			input: &input.UpdateTenantSettingsInput{
+				TenantID:       "tenant-id",
+				Role:           "admin",
+				SearchLanguage: &english,
+			},
+			setupMocks: func(tenantRepo *mock_repository.MockITenantRepository) {
+				tenantRepo.EXPECT().
+					FindByID(gomock.Any(), "tenant-id").
+					Return(&model.Tenant{
+						ID:       "tenant-id",
+						Settings: model.TenantSettings{SearchLanguage: model.DefaultSearchLanguage},
+					}, nil)
+
+				tenantRepo.EXPECT().
+					UpdateSettings(gomock.Any(), "tenant-id", &model.TenantSettings{SearchLanguage: "english"}).
+					Return(&model.Tenant{
+						ID:       "tenant-id",
+						Settings: model.TenantSettings{SearchLanguage: "english"},
+					}, nil)
+			},
+			want:    &output.TenantSettingsOutput{SearchLanguage: "english"},
+			wantErr: false,
+		},
+		{
+			name: "fail - unknown search language",
+			input: &input.UpdateTenantSettingsInput{
+				TenantID:       "tenant-id",
+				Role:           "admin",
+				SearchLanguage: &klingon,
+			},
+			setupMocks: func(tenantRepo *mock_repository.MockITenantRepository) {
+				tenantRepo.EXPECT().
+					FindByID(gomock.Any(), "tenant-id").
+					Return(&model.Tenant{ID: "tenant-id"}, nil)
+			},
+			wantErr:     true,
+			errContains: "invalid search language",
+		},
+		{
+			name: "success - admin changes the trash retention",
+			input: &input.UpdateTenantSettingsInput{
+				TenantID:           "tenant-id",
+				Role:               "admin",
+				TrashRetentionDays: &retention,
+			},
+			setupMocks: func(tenantRepo *mock_repository.MockITenantRepository) {
+				tenantRepo.EXPECT().
+					FindByID(gomock.Any(), "tenant-id").
+					Return(&model.Tenant{
+						ID:       "tenant-id",
+						Settings: model.TenantSettings{TrashRetentionDays: 30},
+					}, nil)
+
+				tenantRepo.EXPECT().
+					UpdateSettings(gomock.Any(), "tenant-id", &model.TenantSettings{TrashRetentionDays: 7}).
+					Return(&model.Tenant{
+						ID:       "tenant-id",
+						Settings: model.TenantSettings{TrashRetentionDays: 7},
+					}, nil)
+			},
+			want:    &output.TenantSettingsOutput{TrashRetentionDays: 7},
+			wantErr: false,
+		},
+		{
+			name: "fail - trash retention out of range",
+			input: &input.UpdateTenantSettingsInput{
+				TenantID:           "tenant-id",
+				Role:               "admin",
+				TrashRetentionDays: &tooLong,
+			},
+			setupMocks: func(tenantRepo *mock_repository.MockITenantRepository) {
+				tenantRepo.EXPECT().
+					FindByID(gomock.Any(), "tenant-id").
+					Return(&model.Tenant{ID: "tenant-id"}, nil)
+			},
+			wantErr:     true,
+			errContains: "between 1 and 365 days",
+		},
+		{
+			name: "fail - member cannot change settings",
+			input: &input.UpdateTenantSettingsInput{
+				TenantID:         "tenant-id",
+				Role:             "member",
+				MagicLinkEnabled: &enabled,
+			},
+			setupMocks:  func(tenantRepo *mock_repository.MockITenantRepository) {},
+			wantErr:     true,
+			errContains: "only tenant admins",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tenantRepo := mock_repository.NewMockITenantRepository(ctrl)
+			tt.setupMocks(tenantRepo)
+
+			interactor := NewTenantInteractor(tenantRepo, newNopAuditLogger(ctrl))
+
+			result, err := interactor.UpdateSettings(context.Background(), tt.input)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				if tt.errContains != "" {
+					assert.Contains(t, err.Error(), tt.errContains)
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, result)
+		})
+	}
+}
+
+func TestTenantInteractor_RotateScimToken(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		role        string
+		setupMocks  func(tenantRepo *mock_repository.MockITenantRepository)
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name: "success - only the hash is stored",
+			role: "admin",
+			setupMocks: func(tenantRepo *mock_repository.MockITenantRepository) {
+				tenantRepo.EXPECT().
+					SetSCIMTokenHash(gomock.Any(), "tenant-id", gomock.Not(gomock.Nil())).
+					Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:        "fail - member cannot issue token",
+			role:        "member",
+			setupMocks:  func(tenantRepo *mock_repository.MockITenantRepository) {},
+			wantErr:     true,
+			errContains: "only tenant admins",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tenantRepo := mock_repository.NewMockITenantRepository(ctrl)
+			tt.setupMocks(tenantRepo)
+
+			interactor := NewTenantInteractor(tenantRepo, newNopAuditLogger(ctrl))
+
+			result, err := interactor.RotateScimToken(context.Background(), &input.ScimTokenInput{
+				TenantID: "tenant-id",
+				Role:     tt.role,
+			})
+
+			if tt.wantErr {
+				require.Error(t, err)
+				if tt.errContains != "" {
+					assert.Contains(t, err.Error(), tt.errContains)
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			assert.True(t, strings.HasPrefix(result.Token, scimTokenPrefix))
+		})
+	}
+}
@@ -3,11 +3,16 @@ package usecase
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
 
 	"good-todo-go/internal/domain/model"
 	"good-todo-go/internal/domain/repository"
 	"good-todo-go/internal/pkg"
 	"good-todo-go/internal/pkg/cerror"
+	"good-todo-go/internal/pkg/fracindex"
 	"good-todo-go/internal/usecase/input"
 	"good-todo-go/internal/usecase/output"
 )
@@ -18,28 +23,94 @@ type ITodoInteractor interface {
 	GetTodo(ctx context.Context, todoID, userID string) (*output.TodoOutput, error)
 	CreateTodo(ctx context.Context, in *input.CreateTodoInput) (*output.TodoOutput, error)
 	UpdateTodo(ctx context.Context, in *input.UpdateTodoInput) (*output.TodoOutput, error)
-	DeleteTodo(ctx context.Context, todoID, userID string) error
+	// PatchTodo applies a JSON merge patch, which unlike UpdateTodo can clear nullable fields
+	PatchTodo(ctx context.Context, in *input.PatchTodoInput) (*output.TodoOutput, error)
+	// DeleteTodo moves the todo to the trash, from where RestoreTodo can bring it back
+	DeleteTodo(ctx context.Context, in *input.DeleteTodoInput) error
+	// GetTrash lists the user's todos in the trash, most recently deleted first
+	GetTrash(ctx context.Context, in *input.GetTrashInput) (*output.TodoListOutput, error)
+	RestoreTodo(ctx context.Context, todoID, userID string) (*output.TodoOutput, error)
+	// GetCompletionStats counts the user's completed todos per day for today or the current week
+	GetCompletionStats(ctx context.Context, in *input.GetCompletionStatsInput) (*output.CompletionStatsOutput, error)
+	// SearchTodos runs a ranked full-text search over the user's own and public todos
+	SearchTodos(ctx context.Context, in *input.SearchTodosInput) (*output.TodoSearchOutput, error)
+	// MoveTodo puts the user's todo into one of their projects, or takes it out
+	MoveTodo(ctx context.Context, in *input.MoveTodoInput) (*output.TodoOutput, error)
+	// SetTodoParent makes the user's todo a subtask of another of their todos, or a top-level todo
+	SetTodoParent(ctx context.Context, in *input.SetTodoParentInput) (*output.TodoOutput, error)
+	// SetTodoRecurrence makes the user's todo repeat, or changes its rule
+	SetTodoRecurrence(ctx context.Context, in *input.SetTodoRecurrenceInput) (*output.TodoOutput, error)
+	// SkipTodoOccurrence moves a recurring todo on to the next due date of its series without completing it
+	SkipTodoOccurrence(ctx context.Context, todoID, userID string) (*output.TodoOutput, error)
+	// StopTodoRecurrence ends the series; the todo stays as a one-off
+	StopTodoRecurrence(ctx context.Context, todoID, userID string) (*output.TodoOutput, error)
+	// GetAssignedTodos lists the todos assigned to in.UserID, with the same filters as GetTodos
+	GetAssignedTodos(ctx context.Context, in *input.GetTodosInput) (*output.TodoListOutput, error)
+	// AssignTodo hands the user's todo to another member of the tenant, or takes it back
+	AssignTodo(ctx context.Context, in *input.AssignTodoInput) (*output.TodoOutput, error)
+	// GetTodoAssignments lists the todo's assignee changes, oldest first
+	GetTodoAssignments(ctx context.Context, todoID, userID string) (*output.TodoAssignmentListOutput, error)
+	// GetSharedTodos lists the todos shared with in.UserID, with the same filters as GetTodos
+	GetSharedTodos(ctx context.Context, in *input.GetTodosInput) (*output.TodoListOutput, error)
+	// GetTodoShares lists who the owner's todo is shared with, oldest share first
+	GetTodoShares(ctx context.Context, todoID, userID string) (*output.TodoShareListOutput, error)
+	// ShareTodo gives another member of the tenant read or edit access to the owner's todo
+	ShareTodo(ctx context.Context, in *input.ShareTodoInput) (*output.TodoShareOutput, error)
+	// UnshareTodo revokes a member's access; members may also give up their own
+	UnshareTodo(ctx context.Context, todoID, userID, memberID string) error
+	// BulkUpdateTodos applies one operation to many todos in a single transaction and reports the outcome per todo
+	BulkUpdateTodos(ctx context.Context, in *input.BulkTodosInput) (*output.TodoBulkOutput, error)
+	// ReorderTodo places the user's todo directly before or after another of their todos in the manual order
+	ReorderTodo(ctx context.Context, in *input.ReorderTodoInput) (*output.TodoOutput, error)
+	// GetTodoHistory lists the todo's revisions, newest first, to whoever can see the todo
+	GetTodoHistory(ctx context.Context, in *input.GetTodoHistoryInput) (*output.TodoRevisionListOutput, error)
+	// RevertTodo puts the owner's todo back the way it was right after a revision
+	// by undoing every later revision; the revert is a revision of its own
+	RevertTodo(ctx context.Context, in *input.RevertTodoInput) (*output.TodoOutput, error)
 }
 
 type TodoInteractor struct {
-	todoRepo repository.ITodoRepository
-	userRepo repository.IUserRepository
-	uuidGen  pkg.IUUIDGenerator
+	todoRepo     repository.ITodoRepository
+	userRepo     repository.IUserRepository
+	projectRepo  repository.IProjectRepository
+	tagRepo      repository.ITagRepository
+	revisionRepo repository.ITodoRevisionRepository
+	reminderRepo repository.IReminderRepository
+	uuidGen      pkg.IUUIDGenerator
 }
 
 func NewTodoInteractor(
 	todoRepo repository.ITodoRepository,
 	userRepo repository.IUserRepository,
+	projectRepo repository.IProjectRepository,
+	tagRepo repository.ITagRepository,
+	revisionRepo repository.ITodoRevisionRepository,
+	reminderRepo repository.IReminderRepository,
 	uuidGen pkg.IUUIDGenerator,
 ) ITodoInteractor {
 	return &TodoInteractor{
-		todoRepo: todoRepo,
-		userRepo: userRepo,
-		uuidGen:  uuidGen,
+		todoRepo:     todoRepo,
+		userRepo:     userRepo,
+		projectRepo:  projectRepo,
+		tagRepo:      tagRepo,
+		revisionRepo: revisionRepo,
+		reminderRepo: reminderRepo,
+		uuidGen:      uuidGen,
 	}
 }
 
 func (i *TodoInteractor) GetTodos(ctx context.Context, in *input.GetTodosInput) (*output.TodoListOutput, error) {
+	return i.listTodos(ctx, in, i.todoRepo.FindByUserID, i.todoRepo.CountByUserID)
+}
+
+// listTodos serves GetTodos and GetAssignedTodos, which differ only in how
+// todos belong to in.UserID
+func (i *TodoInteractor) listTodos(
+	ctx context.Context,
+	in *input.GetTodosInput,
+	find func(context.Context, string, *model.TodoFilter, *model.TodoSort, *model.TodoPage) ([]*model.Todo, error),
+	count func(context.Context, string, *model.TodoFilter) (int, error),
+) (*output.TodoListOutput, error) {
 	limit := in.Limit
 	if limit <= 0 {
 		limit = 20
@@ -48,17 +119,85 @@ func (i *TodoInteractor) GetTodos(ctx context.Context, in *input.GetTodosInput)
 		limit = 100
 	}
 
-	todos, err := i.todoRepo.FindByUserID(ctx, in.UserID, limit, in.Offset)
+	sort, err := newTodoSort(in.Sort, in.Order)
 	if err != nil {
-		return nil, cerror.NewInternalServerError("failed to get todos", err)
+		return nil, err
 	}
 
-	total, err := i.todoRepo.CountByUserID(ctx, in.UserID)
+	filter := &model.TodoFilter{
+		Completed:       in.Completed,
+		Overdue:         in.Overdue,
+		DueBefore:       in.DueBefore,
+		DueAfter:        in.DueAfter,
+		CreatedBefore:   in.CreatedBefore,
+		CreatedAfter:    in.CreatedAfter,
+		CompletedBefore: in.CompletedBefore,
+		CompletedAfter:  in.CompletedAfter,
+		IsPublic:        in.IsPublic,
+		Search:          in.Search,
+		TagIDs:          in.TagIDs,
+		ProjectID:       in.ProjectID,
+	}
+
+	page, err := todoPage(in.Cursor, sort, limit, in.Offset)
 	if err != nil {
-		return nil, cerror.NewInternalServerError("failed to count todos", err)
+		return nil, err
+	}
+
+	todos, err := find(ctx, in.UserID, filter, sort, page)
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to get todos", err)
+	}
+	todos, nextCursor := trimTodoPage(todos, sort, limit)
+
+	var total *int
+	if includeTotal(in.IncludeTotal, in.Cursor) {
+		n, err := count(ctx, in.UserID, filter)
+		if err != nil {
+			return nil, cerror.NewInternalServerError("failed to count todos", err)
+		}
+		total = &n
 	}
 
-	return output.NewTodoListOutput(todos, total), nil
+	return output.NewTodoListOutput(todos, total, nextCursor), nil
+}
+
+// includeTotal decides whether a listing is counted. Offset pages keep the
+// total by default for existing clients; cursor pages skip it unless asked.
+func includeTotal(requested *bool, cursor string) bool {
+	if requested != nil {
+		return *requested
+	}
+	return cursor == ""
+}
+
+// newTodoSort validates the requested sort field and direction
+func newTodoSort(field, order string) (*model.TodoSort, error) {
+	sort := &model.TodoSort{Field: model.TodoSortCreatedAt, Desc: true}
+
+	switch field {
+	case "":
+	case model.TodoSortDueDate, model.TodoSortCreatedAt, model.TodoSortUpdatedAt, model.TodoSortTitle, model.TodoSortPriority:
+		sort.Field = field
+	case model.TodoSortPosition:
+		// The manual order reads top to bottom
+		sort.Field = field
+		if order == "" {
+			order = "asc"
+		}
+	default:
+		return nil, cerror.NewBadRequest("invalid sort field", nil)
+	}
+
+	switch order {
+	case "", "desc":
+	case "asc":
+		sort.Desc = false
+	default:
+		return nil, cerror.NewBadRequest("invalid sort order", nil)
+	}
+
+	return sort, nil
 }
 
 func (i *TodoInteractor) GetPublicTodos(ctx context.Context, in *input.GetPublicTodosInput) (*output.TodoListOutput, error) {
@@ -70,14 +209,26 @@ func (i *TodoInteractor) GetPublicTodos(ctx context.Context, in *input.GetPublic
 		limit = 100
 	}
 
-	todos, err := i.todoRepo.FindPublic(ctx, limit, in.Offset)
+	sort := &model.TodoSort{Field: model.TodoSortCreatedAt, Desc: true}
+	filter := &model.TodoFilter{TagIDs: in.TagIDs, ProjectID: in.ProjectID}
+	page, err := todoPage(in.Cursor, sort, limit, in.Offset)
 	if err != nil {
-		return nil, cerror.NewInternalServerError("failed to get public todos", err)
+		return nil, err
 	}
 
-	total, err := i.todoRepo.CountPublic(ctx)
+	todos, err := i.todoRepo.FindPublic(ctx, filter, page)
 	if err != nil {
-		return nil, cerror.NewInternalServerError("failed to count public todos", err)
+		return nil, cerror.NewInternalServerError("failed to get public todos", err)
+	}
+	todos, nextCursor := trimTodoPage(todos, sort, limit)
+
+	var total *int
+	if includeTotal(in.IncludeTotal, in.Cursor) {
+		count, err := i.todoRepo.CountPublic(ctx, filter)
+		if err != nil {
+			return nil, cerror.NewInternalServerError("failed to count public todos", err)
+		}
+		total = &count
 	}
 
 	// Collect unique user IDs and fetch user info
@@ -100,7 +251,7 @@ func (i *TodoInteractor) GetPublicTodos(ctx context.Context, in *input.GetPublic
 		userMap[u.ID] = u
 	}
 
-	return output.NewTodoListOutputWithCreators(todos, total, userMap), nil
+	return output.NewTodoListOutputWithCreators(todos, total, nextCursor, userMap), nil
 }
 
 func (i *TodoInteractor) GetTodo(ctx context.Context, todoID, userID string) (*output.TodoOutput, error) {
@@ -109,15 +260,54 @@ func (i *TodoInteractor) GetTodo(ctx context.Context, todoID, userID string) (*o
 		return nil, cerror.NewNotFound("todo not found", err)
 	}
 
-	// Allow access if user owns the todo OR if it's public
-	if todo.UserID != userID && !todo.IsPublic {
+	if !canViewTodo(todo, userID) {
 		return nil, cerror.NewForbidden("not allowed to access this todo", nil)
 	}
 
-	return output.NewTodoOutput(todo), nil
+	children, err := i.todoRepo.FindChildren(ctx, todo.ID)
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to get subtasks", err)
+	}
+
+	// Subtasks share the owner, so other users only see the public ones and
+	// those assigned to them
+	visible := children[:0]
+	for _, c := range children {
+		if canViewTodo(c, userID) {
+			visible = append(visible, c)
+		}
+	}
+
+	return output.NewTodoDetailOutput(todo, visible), nil
 }
 
 func (i *TodoInteractor) CreateTodo(ctx context.Context, in *input.CreateTodoInput) (*output.TodoOutput, error) {
+	priority := in.Priority
+	if priority == "" {
+		priority = model.TodoPriorityNone
+	}
+	if !slices.Contains(model.TodoPriorities, priority) {
+		return nil, cerror.NewBadRequest("invalid priority", nil)
+	}
+	if in.ProjectID != nil {
+		if err := checkTodoProject(ctx, i.projectRepo, *in.ProjectID, in.UserID); err != nil {
+			return nil, err
+		}
+	}
+	if in.ParentID != nil {
+		if err := i.checkTodoParent(ctx, nil, *in.ParentID, in.UserID); err != nil {
+			return nil, err
+		}
+	}
+	var recurrence *model.TodoRecurrence
+	if in.Recurrence != nil {
+		var err error
+		recurrence, err = newTodoRecurrence(in.Recurrence, in.DueDate)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	todo := &model.Todo{
 		ID:          i.uuidGen.Generate(),
 		UserID:      in.UserID,
@@ -127,6 +317,10 @@ func (i *TodoInteractor) CreateTodo(ctx context.Context, in *input.CreateTodoInp
 		Completed:   false,
 		IsPublic:    in.IsPublic,
 		DueDate:     in.DueDate,
+		Priority:    priority,
+		ProjectID:   in.ProjectID,
+		ParentID:    in.ParentID,
+		Recurrence:  recurrence,
 	}
 
 	created, err := i.todoRepo.Create(ctx, todo)
@@ -143,10 +337,12 @@ func (i *TodoInteractor) UpdateTodo(ctx context.Context, in *input.UpdateTodoInp
 		return nil, cerror.NewNotFound("todo not found", err)
 	}
 
-	// Only owner can update
-	if todo.UserID != in.UserID {
+	if !canUpdateTodo(todo, in.UserID, in) {
 		return nil, cerror.NewForbidden("not allowed to update this todo", nil)
 	}
+	if err := checkTodoIfMatch(todo, in.IfMatch); err != nil {
+		return nil, err
+	}
 
 	if in.Title != nil {
 		todo.Title = *in.Title
@@ -155,37 +351,322 @@ func (i *TodoInteractor) UpdateTodo(ctx context.Context, in *input.UpdateTodoInp
 		todo.Description = *in.Description
 	}
 	if in.Completed != nil {
-		todo.Completed = *in.Completed
+		setTodoCompleted(todo, *in.Completed, time.Now().UTC())
 	}
+	cascade := in.CascadeCompletion && todo.Completed && in.Completed != nil
 	if in.IsPublic != nil {
 		todo.IsPublic = *in.IsPublic
 	}
 	if in.DueDate != nil {
 		todo.DueDate = in.DueDate
 	}
+	if in.Priority != nil {
+		if !slices.Contains(model.TodoPriorities, *in.Priority) {
+			return nil, cerror.NewBadRequest("invalid priority", nil)
+		}
+		todo.Priority = *in.Priority
+	}
+
+	next, err := i.handOverSeries(ctx, todo)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, created, err := i.saveTodo(ctx, todo, cascade, next)
+	if err != nil {
+		return nil, todoWriteError(err, in.IfMatch, "failed to update todo")
+	}
+
+	return newTodoOutputWithNext(updated, created), nil
+}
+
+func (i *TodoInteractor) MoveTodo(ctx context.Context, in *input.MoveTodoInput) (*output.TodoOutput, error) {
+	todo, err := i.todoRepo.FindByID(ctx, in.TodoID)
+	if err != nil {
+		return nil, cerror.NewNotFound("todo not found", err)
+	}
+
+	// Only owner can move
+	if todo.UserID != in.UserID {
+		return nil, cerror.NewForbidden("not allowed to update this todo", nil)
+	}
+
+	if in.ProjectID != nil {
+		if err := checkTodoProject(ctx, i.projectRepo, *in.ProjectID, in.UserID); err != nil {
+			return nil, err
+		}
+	}
+	todo.ProjectID = in.ProjectID
 
 	updated, err := i.todoRepo.Update(ctx, todo)
 	if err != nil {
-		return nil, cerror.NewInternalServerError("failed to update todo", err)
+		return nil, todoWriteError(err, nil, "failed to move todo")
 	}
 
 	return output.NewTodoOutput(updated), nil
 }
 
-func (i *TodoInteractor) DeleteTodo(ctx context.Context, todoID, userID string) error {
-	todo, err := i.todoRepo.FindByID(ctx, todoID)
+func (i *TodoInteractor) ReorderTodo(ctx context.Context, in *input.ReorderTodoInput) (*output.TodoOutput, error) {
+	if (in.Before == nil) == (in.After == nil) {
+		return nil, cerror.NewBadRequest("exactly one of before and after is required", nil)
+	}
+	before := in.Before != nil
+	anchorID := in.After
+	if before {
+		anchorID = in.Before
+	}
+
+	todo, err := i.todoRepo.FindByID(ctx, in.TodoID)
+	if err != nil {
+		return nil, cerror.NewNotFound("todo not found", err)
+	}
+
+	// Only owner can reorder
+	if todo.UserID != in.UserID {
+		return nil, cerror.NewForbidden("not allowed to update this todo", nil)
+	}
+	if *anchorID == todo.ID {
+		return nil, cerror.NewBadRequest("a todo cannot be placed next to itself", nil)
+	}
+
+	// Each user has their own order, so the anchor must be one of their todos too
+	anchor, err := i.todoRepo.FindByID(ctx, *anchorID)
+	if err != nil || anchor.UserID != in.UserID {
+		return nil, cerror.NewNotFound("todo to place next to not found", err)
+	}
+
+	position, err := i.positionNextTo(ctx, todo.ID, anchor, before)
+	if err != nil || len(position) > model.MaxTodoPositionLength {
+		// The neighbours share a position or the keys have grown long; spread
+		// the user's positions out and place the todo again
+		if err := i.todoRepo.RebalancePositions(ctx, in.UserID); err != nil {
+			return nil, cerror.NewInternalServerError("failed to reorder todo", err)
+		}
+		anchor, err = i.todoRepo.FindByID(ctx, anchor.ID)
+		if err != nil {
+			return nil, cerror.NewInternalServerError("failed to reorder todo", err)
+		}
+		position, err = i.positionNextTo(ctx, todo.ID, anchor, before)
+		if err != nil {
+			return nil, cerror.NewInternalServerError("failed to reorder todo", err)
+		}
+	}
+	todo.Position = position
+
+	updated, err := i.todoRepo.Update(ctx, todo)
+	if err != nil {
+		return nil, todoWriteError(err, nil, "failed to reorder todo")
+	}
+
+	return output.NewTodoOutput(updated), nil
+}
+
+// positionNextTo returns a position between anchor and its neighbour on the
+// given side, leaving todoID itself out of the neighbours
+func (i *TodoInteractor) positionNextTo(ctx context.Context, todoID string, anchor *model.Todo, before bool) (string, error) {
+	neighbor, err := i.todoRepo.PositionNextTo(ctx, anchor, todoID, before)
+	if err != nil {
+		return "", err
+	}
+	if before {
+		return fracindex.Between(neighbor, anchor.Position)
+	}
+	return fracindex.Between(anchor.Position, neighbor)
+}
+
+func (i *TodoInteractor) SetTodoParent(ctx context.Context, in *input.SetTodoParentInput) (*output.TodoOutput, error) {
+	todo, err := i.todoRepo.FindByID(ctx, in.TodoID)
+	if err != nil {
+		return nil, cerror.NewNotFound("todo not found", err)
+	}
+
+	// Only owner can move
+	if todo.UserID != in.UserID {
+		return nil, cerror.NewForbidden("not allowed to update this todo", nil)
+	}
+
+	if in.ParentID != nil {
+		if err := i.checkTodoParent(ctx, todo, *in.ParentID, in.UserID); err != nil {
+			return nil, err
+		}
+	}
+	todo.ParentID = in.ParentID
+
+	updated, err := i.todoRepo.Update(ctx, todo)
+	if err != nil {
+		return nil, todoWriteError(err, nil, "failed to move todo")
+	}
+
+	return output.NewTodoOutput(updated), nil
+}
+
+// checkTodoParent verifies that parentID can take todo as a subtask: the parent
+// must be one of the user's todos, must not be the todo or one of its subtasks,
+// and the resulting hierarchy must stay within model.MaxTodoDepth levels.
+// todo is nil for a todo that is being created.
+func (i *TodoInteractor) checkTodoParent(ctx context.Context, todo *model.Todo, parentID, userID string) error {
+	// Todos of other tenants are hidden by RLS, so they are simply not found
+	parent, err := i.todoRepo.FindByID(ctx, parentID)
+	if err != nil {
+		return cerror.NewNotFound("parent todo not found", err)
+	}
+	if parent.UserID != userID {
+		if !parent.IsPublic {
+			return cerror.NewNotFound("parent todo not found", nil)
+		}
+		return cerror.NewForbidden("not allowed to add subtasks to this todo", nil)
+	}
+
+	// Walk up from the parent; depth ends up as the parent's level
+	depth := 1
+	for ancestor := parent; ; depth++ {
+		if todo != nil && ancestor.ID == todo.ID {
+			return cerror.NewBadRequest("a todo cannot be a subtask of itself or of its subtasks", nil)
+		}
+		if ancestor.ParentID == nil || depth >= model.MaxTodoDepth {
+			break
+		}
+		ancestor, err = i.todoRepo.FindByID(ctx, *ancestor.ParentID)
+		if err != nil {
+			return cerror.NewInternalServerError("failed to get parent todo", err)
+		}
+	}
+
+	subtree := 1
+	if todo != nil {
+		subtree, err = i.todoRepo.SubtreeDepth(ctx, todo.ID)
+		if err != nil {
+			return cerror.NewInternalServerError("failed to get subtasks", err)
+		}
+	}
+	if depth+subtree > model.MaxTodoDepth {
+		return cerror.NewBadRequest(fmt.Sprintf("subtasks can be nested at most %d levels deep", model.MaxTodoDepth), nil)
+	}
+	return nil
+}
+
+// How DeleteTodo handles the subtasks of the deleted todo
+const (
+	DeleteChildrenDelete = "delete"
+	DeleteChildrenDetach = "detach"
+)
+
+func (i *TodoInteractor) DeleteTodo(ctx context.Context, in *input.DeleteTodoInput) error {
+	todo, err := i.todoRepo.FindByID(ctx, in.TodoID)
 	if err != nil {
 		return cerror.NewNotFound("todo not found", err)
 	}
 
 	// Only owner can delete
-	if todo.UserID != userID {
+	if todo.UserID != in.UserID {
 		return cerror.NewForbidden("not allowed to delete this todo", nil)
 	}
+	if err := checkTodoIfMatch(todo, in.IfMatch); err != nil {
+		return err
+	}
 
-	if err := i.todoRepo.Delete(ctx, todoID); err != nil {
-		return cerror.NewInternalServerError("failed to delete todo", err)
+	switch in.Children {
+	case "":
+		// Subtasks are never removed or orphaned without the caller saying so
+		children, err := i.todoRepo.FindChildren(ctx, todo.ID)
+		if err != nil {
+			return cerror.NewInternalServerError("failed to get subtasks", err)
+		}
+		if len(children) > 0 {
+			return cerror.NewConflict("todo has subtasks; pass children=delete or children=detach", nil)
+		}
+		err = i.todoRepo.Delete(ctx, todo.ID, todo.Version)
+		if err != nil {
+			return todoWriteError(err, in.IfMatch, "failed to delete todo")
+		}
+	case DeleteChildrenDelete:
+		if err := i.todoRepo.DeleteSubtree(ctx, todo.ID, todo.Version); err != nil {
+			return todoWriteError(err, in.IfMatch, "failed to delete todo")
+		}
+	case DeleteChildrenDetach:
+		if err := i.todoRepo.Delete(ctx, todo.ID, todo.Version); err != nil {
+			return todoWriteError(err, in.IfMatch, "failed to delete todo")
+		}
+	default:
+		return cerror.NewBadRequest("children must be delete or detach", nil)
 	}
 
 	return nil
 }
+
+// checkTodoIfMatch fails unless the todo is at one of the versions the client
+// based its write on. A nil list means the client did not ask for a check.
+func checkTodoIfMatch(todo *model.Todo, ifMatch []int) error {
+	if ifMatch != nil && !slices.Contains(ifMatch, todo.Version) {
+		return cerror.NewPreconditionFailed("todo has been changed since it was read", nil)
+	}
+	return nil
+}
+
+// todoWriteError turns a failed todo write into an error for the client. A
+// version conflict means the todo changed after it was read here: a client
+// that sent If-Match gets a failed precondition, any other one can simply try
+// again.
+func todoWriteError(err error, ifMatch []int, message string) error {
+	if !errors.Is(err, repository.ErrTodoVersionConflict) {
+		return cerror.NewInternalServerError(message, err)
+	}
+	if ifMatch != nil {
+		return cerror.NewPreconditionFailed("todo has been changed since it was read", err)
+	}
+	return cerror.NewConflict("todo was changed at the same time; try again", err)
+}
+
+// setTodoCompleted completes or reopens the todo. completed_at tracks the
+// latest transition into the completed state.
+func setTodoCompleted(todo *model.Todo, completed bool, now time.Time) {
+	if completed && !todo.Completed {
+		todo.CompletedAt = &now
+	} else if !completed {
+		todo.CompletedAt = nil
+	}
+	todo.Completed = completed
+}
+
+// Completion stats periods
+const (
+	CompletionPeriodToday = "today"
+	CompletionPeriodWeek  = "week"
+)
+
+func (i *TodoInteractor) GetCompletionStats(ctx context.Context, in *input.GetCompletionStatsInput) (*output.CompletionStatsOutput, error) {
+	loc := time.UTC
+	if in.Timezone != "" {
+		var err error
+		loc, err = time.LoadLocation(in.Timezone)
+		if err != nil {
+			return nil, cerror.NewBadRequest("invalid timezone", err)
+		}
+	}
+
+	period := in.Period
+	if period == "" {
+		period = CompletionPeriodWeek
+	}
+
+	now := time.Now().In(loc)
+	from := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+	days := 1
+	switch period {
+	case CompletionPeriodToday:
+	case CompletionPeriodWeek:
+		// Weeks start on Monday (ISO 8601)
+		from = from.AddDate(0, 0, -((int(from.Weekday()) + 6) % 7))
+		days = 7
+	default:
+		return nil, cerror.NewBadRequest("invalid period", nil)
+	}
+	to := from.AddDate(0, 0, days)
+
+	completions, err := i.todoRepo.ListCompletionTimes(ctx, in.UserID, from, to)
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to get completed todos", err)
+	}
+
+	return output.NewCompletionStatsOutput(period, from, days, completions), nil
+}
@@ -0,0 +1,109 @@
+package usecase
+
+import (
+	"context"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/pkg/cerror"
+	"good-todo-go/internal/usecase/input"
+	"good-todo-go/internal/usecase/output"
+)
+
+func (i *TodoInteractor) GetAssignedTodos(ctx context.Context, in *input.GetTodosInput) (*output.TodoListOutput, error) {
+	return i.listTodos(ctx, in, i.todoRepo.FindByAssigneeID, i.todoRepo.CountByAssigneeID)
+}
+
+func (i *TodoInteractor) AssignTodo(ctx context.Context, in *input.AssignTodoInput) (*output.TodoOutput, error) {
+	todo, err := i.todoRepo.FindByID(ctx, in.TodoID)
+	if err != nil {
+		return nil, cerror.NewNotFound("todo not found", err)
+	}
+
+	// Only the owner assigns; the assignee may hand the todo back
+	handBack := in.AssigneeID == nil && isTodoAssignee(todo, in.UserID)
+	if todo.UserID != in.UserID && !handBack {
+		return nil, cerror.NewForbidden("not allowed to assign this todo", nil)
+	}
+
+	if sameAssignee(todo.AssigneeID, in.AssigneeID) {
+		return output.NewTodoOutput(todo), nil
+	}
+
+	if in.AssigneeID != nil {
+		// Members of other tenants are hidden by RLS, so they are simply not found
+		assignee, err := i.userRepo.FindByID(ctx, *in.AssigneeID)
+		if err != nil || assignee.TenantID != todo.TenantID {
+			return nil, cerror.NewBadRequest("assignee must be a member of this tenant", err)
+		}
+		if !assignee.IsActive {
+			return nil, cerror.NewBadRequest("assignee is deactivated", nil)
+		}
+	}
+
+	updated, err := i.todoRepo.Assign(ctx, &model.TodoAssignment{
+		ID:                 i.uuidGen.Generate(),
+		TenantID:           todo.TenantID,
+		TodoID:             todo.ID,
+		PreviousAssigneeID: todo.AssigneeID,
+		AssigneeID:         in.AssigneeID,
+		ChangedBy:          in.UserID,
+	})
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to assign todo", err)
+	}
+
+	return output.NewTodoOutput(updated), nil
+}
+
+func (i *TodoInteractor) GetTodoAssignments(ctx context.Context, todoID, userID string) (*output.TodoAssignmentListOutput, error) {
+	todo, err := i.todoRepo.FindByID(ctx, todoID)
+	if err != nil {
+		return nil, cerror.NewNotFound("todo not found", err)
+	}
+	if !canViewTodo(todo, userID) {
+		return nil, cerror.NewForbidden("not allowed to access this todo", nil)
+	}
+
+	assignments, err := i.todoRepo.FindAssignments(ctx, todo.ID)
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to get assignment history", err)
+	}
+
+	return output.NewTodoAssignmentListOutput(assignments), nil
+}
+
+// canViewTodo allows the owner, the assignee, members the todo is shared with
+// and, for public todos, everyone in the tenant
+func canViewTodo(todo *model.Todo, userID string) bool {
+	return todo.UserID == userID || todo.IsPublic || isTodoAssignee(todo, userID) || todoShareOf(todo, userID) != nil
+}
+
+func isTodoAssignee(todo *model.Todo, userID string) bool {
+	return todo.AssigneeID != nil && *todo.AssigneeID == userID
+}
+
+// canUpdateTodo allows the owner any update, members with edit access any
+// update of the todo alone and the assignee only to complete or reopen the todo
+func canUpdateTodo(todo *model.Todo, userID string, in *input.UpdateTodoInput) bool {
+	return todo.UserID == userID ||
+		isTodoEditor(todo, userID) && in.IsPublic == nil && !in.CascadeCompletion ||
+		isTodoAssignee(todo, userID) && completionOnly(in)
+}
+
+// completionOnly reports whether the update does nothing but complete or reopen the todo
+func completionOnly(in *input.UpdateTodoInput) bool {
+	return in.Completed != nil &&
+		in.Title == nil &&
+		in.Description == nil &&
+		in.IsPublic == nil &&
+		in.DueDate == nil &&
+		in.Priority == nil &&
+		!in.CascadeCompletion
+}
+
+func sameAssignee(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
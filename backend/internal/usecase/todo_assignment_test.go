@@ -0,0 +1,238 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"good-todo-go/internal/domain/model"
+	mock_repository "good-todo-go/internal/domain/repository/mock"
+	mock_pkg "good-todo-go/internal/pkg/mock"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTodoInteractor_AssignTodo(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		callerID        string
+		currentAssignee *string
+		assigneeID      *string
+		// lookup is set when the assignee is looked up; a nil assignee is not found
+		lookup      bool
+		assignee    *model.User
+		wantAssign  bool
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:       "success - owner assigns a member",
+			callerID:   "owner",
+			assigneeID: strPtr("member"),
+			lookup:     true,
+			assignee:   &model.User{ID: "member", TenantID: "tenant-1", IsActive: true},
+			wantAssign: true,
+		},
+		{
+			name:            "success - owner reassigns",
+			callerID:        "owner",
+			currentAssignee: strPtr("member"),
+			assigneeID:      strPtr("other"),
+			lookup:          true,
+			assignee:        &model.User{ID: "other", TenantID: "tenant-1", IsActive: true},
+			wantAssign:      true,
+		},
+		{
+			name:            "success - assignee hands the todo back",
+			callerID:        "member",
+			currentAssignee: strPtr("member"),
+			wantAssign:      true,
+		},
+		{
+			name:            "success - same assignee is not recorded again",
+			callerID:        "owner",
+			currentAssignee: strPtr("member"),
+			assigneeID:      strPtr("member"),
+		},
+		{
+			name:        "fail - assignee not in the tenant",
+			callerID:    "owner",
+			assigneeID:  strPtr("stranger"),
+			lookup:      true,
+			wantErr:     true,
+			errContains: "member of this tenant",
+		},
+		{
+			name:        "fail - assignee deactivated",
+			callerID:    "owner",
+			assigneeID:  strPtr("member"),
+			lookup:      true,
+			assignee:    &model.User{ID: "member", TenantID: "tenant-1", IsActive: false},
+			wantErr:     true,
+			errContains: "deactivated",
+		},
+		{
+			name:            "fail - assignee cannot pass the todo on",
+			callerID:        "member",
+			currentAssignee: strPtr("member"),
+			assigneeID:      strPtr("other"),
+			wantErr:         true,
+			errContains:     "not allowed",
+		},
+		{
+			name:        "fail - other member cannot assign",
+			callerID:    "other",
+			assigneeID:  strPtr("other"),
+			wantErr:     true,
+			errContains: "not allowed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+			userRepo := mock_repository.NewMockIUserRepository(ctrl)
+			uuidGen := mock_pkg.NewMockIUUIDGenerator(ctrl)
+
+			todo := &model.Todo{ID: "todo-1", UserID: "owner", TenantID: "tenant-1", AssigneeID: tt.currentAssignee}
+			todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(todo, nil)
+			if tt.lookup {
+				var err error
+				if tt.assignee == nil {
+					err = errors.New("not found")
+				}
+				userRepo.EXPECT().FindByID(gomock.Any(), *tt.assigneeID).Return(tt.assignee, err)
+			}
+			if tt.wantAssign {
+				uuidGen.EXPECT().Generate().Return("assignment-1")
+				todoRepo.EXPECT().
+					Assign(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, change *model.TodoAssignment) (*model.Todo, error) {
+						assert.Equal(t, tt.currentAssignee, change.PreviousAssigneeID)
+						assert.Equal(t, tt.assigneeID, change.AssigneeID)
+						assert.Equal(t, tt.callerID, change.ChangedBy)
+						return &model.Todo{ID: "todo-1", UserID: "owner", AssigneeID: change.AssigneeID}, nil
+					})
+			}
+
+			interactor := &TodoInteractor{todoRepo: todoRepo, userRepo: userRepo, uuidGen: uuidGen}
+
+			result, err := interactor.AssignTodo(context.Background(), &input.AssignTodoInput{
+				TodoID:     "todo-1",
+				UserID:     tt.callerID,
+				TenantID:   "tenant-1",
+				AssigneeID: tt.assigneeID,
+			})
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.assigneeID, result.AssigneeID)
+		})
+	}
+}
+
+func TestTodoInteractor_AssigneeAccess(t *testing.T) {
+	t.Parallel()
+
+	assigned := func() *model.Todo {
+		return &model.Todo{ID: "todo-1", UserID: "owner", TenantID: "tenant-1", Title: "Review", AssigneeID: strPtr("member")}
+	}
+	update := func(_ context.Context, todo *model.Todo) (*model.Todo, error) {
+		return todo, nil
+	}
+	completed := true
+
+	t.Run("assignee can view a private todo", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+		todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(assigned(), nil)
+		todoRepo.EXPECT().FindChildren(gomock.Any(), "todo-1").Return([]*model.Todo{}, nil)
+
+		interactor := &TodoInteractor{todoRepo: todoRepo}
+
+		result, err := interactor.GetTodo(context.Background(), "todo-1", "member")
+
+		require.NoError(t, err)
+		assert.Equal(t, "todo-1", result.ID)
+	})
+
+	t.Run("assignee can complete", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+		todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(assigned(), nil)
+		todoRepo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(update)
+
+		interactor := &TodoInteractor{todoRepo: todoRepo}
+
+		result, err := interactor.UpdateTodo(context.Background(), &input.UpdateTodoInput{
+			TodoID:    "todo-1",
+			UserID:    "member",
+			Completed: &completed,
+		})
+
+		require.NoError(t, err)
+		assert.True(t, result.Completed)
+	})
+
+	t.Run("assignee cannot edit", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+		todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(assigned(), nil)
+
+		interactor := &TodoInteractor{todoRepo: todoRepo}
+
+		_, err := interactor.UpdateTodo(context.Background(), &input.UpdateTodoInput{
+			TodoID:    "todo-1",
+			UserID:    "member",
+			Completed: &completed,
+			Title:     strPtr("Renamed"),
+		})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not allowed")
+	})
+
+	t.Run("assignee cannot delete", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+		todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(assigned(), nil)
+
+		interactor := &TodoInteractor{todoRepo: todoRepo}
+
+		err := interactor.DeleteTodo(context.Background(), &input.DeleteTodoInput{TodoID: "todo-1", UserID: "member"})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not allowed")
+	})
+}
@@ -0,0 +1,241 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/pkg/cerror"
+	"good-todo-go/internal/usecase/input"
+	"good-todo-go/internal/usecase/output"
+)
+
+// Operations BulkUpdateTodos can apply
+const (
+	BulkTodoComplete      = "complete"
+	BulkTodoReopen        = "reopen"
+	BulkTodoDelete        = "delete"
+	BulkTodoSetVisibility = "set_visibility"
+	BulkTodoMoveProject   = "move_project"
+	BulkTodoAddTag        = "add_tag"
+)
+
+// maxBulkTodos is the most todos a single bulk request may touch
+const maxBulkTodos = 100
+
+// BulkUpdateTodos checks every todo like UpdateTodo and DeleteTodo would. Todos
+// that fail the checks are reported and skipped; the rest are written in one
+// transaction, so either all of them change or the request fails.
+func (i *TodoInteractor) BulkUpdateTodos(ctx context.Context, in *input.BulkTodosInput) (*output.TodoBulkOutput, error) {
+	if err := i.checkBulkOperation(ctx, in); err != nil {
+		return nil, err
+	}
+
+	ids, todos, err := i.findBulkTargets(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	change := &model.TodoBulkChange{}
+	now := time.Now().UTC()
+	results := make([]*output.TodoBulkResultOutput, len(ids))
+	for n, id := range ids {
+		results[n] = &output.TodoBulkResultOutput{TodoID: id}
+
+		todo, ok := todos[id]
+		if !ok {
+			results[n].Error = newTodoBulkErrorOutput(cerror.NewNotFound("todo not found", nil))
+			continue
+		}
+		if err := i.stageBulkOperation(ctx, in, todo, now, change); err != nil {
+			var appErr *cerror.AppError
+			if !errors.As(err, &appErr) || appErr.HTTPStatus >= http.StatusInternalServerError {
+				return nil, err
+			}
+			results[n].Error = newTodoBulkErrorOutput(appErr)
+		}
+	}
+
+	if err := i.todoRepo.ApplyBulk(ctx, change); err != nil {
+		return nil, todoWriteError(err, nil, "failed to apply bulk operation")
+	}
+
+	return newTodoBulkOutput(results), nil
+}
+
+// checkBulkOperation validates everything that does not depend on the individual todos
+func (i *TodoInteractor) checkBulkOperation(ctx context.Context, in *input.BulkTodosInput) error {
+	if (len(in.TodoIDs) == 0) == (in.Filter == nil) {
+		return cerror.NewBadRequest("exactly one of todo_ids and filter is required", nil)
+	}
+	if len(in.TodoIDs) > maxBulkTodos {
+		return cerror.NewBadRequest(fmt.Sprintf("at most %d todos can be changed at once", maxBulkTodos), nil)
+	}
+
+	switch in.Operation {
+	case BulkTodoComplete, BulkTodoReopen:
+	case BulkTodoDelete:
+		if in.Children != "" && in.Children != DeleteChildrenDelete && in.Children != DeleteChildrenDetach {
+			return cerror.NewBadRequest("children must be delete or detach", nil)
+		}
+	case BulkTodoSetVisibility:
+		if in.IsPublic == nil {
+			return cerror.NewBadRequest("is_public is required", nil)
+		}
+	case BulkTodoMoveProject:
+		if in.ProjectID != nil {
+			if err := checkTodoProject(ctx, i.projectRepo, *in.ProjectID, in.UserID); err != nil {
+				return err
+			}
+		}
+	case BulkTodoAddTag:
+		if in.TagID == "" {
+			return cerror.NewBadRequest("tag_id is required", nil)
+		}
+		if _, err := i.tagRepo.FindByID(ctx, in.TagID); err != nil {
+			return cerror.NewNotFound("tag not found", err)
+		}
+	default:
+		return cerror.NewBadRequest("unknown operation", nil)
+	}
+	return nil
+}
+
+// findBulkTargets returns the requested todo IDs in request order without
+// duplicates, and the todos among them that exist keyed by ID. A filter only
+// matches the user's own todos.
+func (i *TodoInteractor) findBulkTargets(ctx context.Context, in *input.BulkTodosInput) ([]string, map[string]*model.Todo, error) {
+	var ids []string
+	var todos []*model.Todo
+	if in.Filter != nil {
+		filter := &model.TodoFilter{
+			Completed: in.Filter.Completed,
+			Overdue:   in.Filter.Overdue,
+			DueBefore: in.Filter.DueBefore,
+			DueAfter:  in.Filter.DueAfter,
+			IsPublic:  in.Filter.IsPublic,
+			Search:    in.Filter.Search,
+			TagIDs:    in.Filter.TagIDs,
+			ProjectID: in.Filter.ProjectID,
+		}
+		var err error
+		todos, err = i.todoRepo.FindByUserID(ctx, in.UserID, filter, nil, &model.TodoPage{Limit: maxBulkTodos + 1})
+		if err != nil {
+			return nil, nil, cerror.NewInternalServerError("failed to get todos", err)
+		}
+		if len(todos) > maxBulkTodos {
+			return nil, nil, cerror.NewBadRequest(fmt.Sprintf("filter matches more than %d todos", maxBulkTodos), nil)
+		}
+		for _, t := range todos {
+			ids = append(ids, t.ID)
+		}
+	} else {
+		for _, id := range in.TodoIDs {
+			if !slices.Contains(ids, id) {
+				ids = append(ids, id)
+			}
+		}
+		var err error
+		todos, err = i.todoRepo.FindByIDs(ctx, ids)
+		if err != nil {
+			return nil, nil, cerror.NewInternalServerError("failed to get todos", err)
+		}
+	}
+
+	byID := make(map[string]*model.Todo, len(todos))
+	for _, t := range todos {
+		byID[t.ID] = t
+	}
+	return ids, byID, nil
+}
+
+// stageBulkOperation checks the operation against one todo and adds its
+// writes to change
+func (i *TodoInteractor) stageBulkOperation(ctx context.Context, in *input.BulkTodosInput, todo *model.Todo, now time.Time, change *model.TodoBulkChange) error {
+	switch in.Operation {
+	case BulkTodoComplete, BulkTodoReopen:
+		completed := in.Operation == BulkTodoComplete
+		if !canUpdateTodo(todo, in.UserID, &input.UpdateTodoInput{
+			Completed:         &completed,
+			CascadeCompletion: in.CascadeCompletion,
+		}) {
+			return cerror.NewForbidden("not allowed to update this todo", nil)
+		}
+		setTodoCompleted(todo, completed, now)
+		next, err := i.handOverSeries(ctx, todo)
+		if err != nil {
+			return err
+		}
+		change.Updates = append(change.Updates, todo)
+		if completed && in.CascadeCompletion {
+			change.CompleteDescendants = append(change.CompleteDescendants, todo)
+		}
+		if next != nil {
+			change.Creates = append(change.Creates, next)
+		}
+		return nil
+	}
+
+	// Everything else is limited to the owner
+	if todo.UserID != in.UserID {
+		if in.Operation == BulkTodoDelete {
+			return cerror.NewForbidden("not allowed to delete this todo", nil)
+		}
+		return cerror.NewForbidden("not allowed to update this todo", nil)
+	}
+
+	switch in.Operation {
+	case BulkTodoDelete:
+		switch in.Children {
+		case "":
+			children, err := i.todoRepo.FindChildren(ctx, todo.ID)
+			if err != nil {
+				return cerror.NewInternalServerError("failed to get subtasks", err)
+			}
+			if len(children) > 0 {
+				return cerror.NewConflict("todo has subtasks; pass children=delete or children=detach", nil)
+			}
+			change.Deletes = append(change.Deletes, todo.ID)
+		case DeleteChildrenDelete:
+			change.DeleteSubtrees = append(change.DeleteSubtrees, todo.ID)
+		case DeleteChildrenDetach:
+			change.Deletes = append(change.Deletes, todo.ID)
+		}
+	case BulkTodoSetVisibility:
+		todo.IsPublic = *in.IsPublic
+		change.Updates = append(change.Updates, todo)
+	case BulkTodoMoveProject:
+		todo.ProjectID = in.ProjectID
+		change.Updates = append(change.Updates, todo)
+	case BulkTodoAddTag:
+		change.TagLinks = append(change.TagLinks, &model.TodoTagLink{
+			TenantID: todo.TenantID,
+			TodoID:   todo.ID,
+			TagID:    in.TagID,
+		})
+	}
+	return nil
+}
+
+func newTodoBulkErrorOutput(err *cerror.AppError) *output.TodoBulkErrorOutput {
+	return &output.TodoBulkErrorOutput{
+		Code:    string(err.Code),
+		Message: err.Message,
+	}
+}
+
+func newTodoBulkOutput(results []*output.TodoBulkResultOutput) *output.TodoBulkOutput {
+	out := &output.TodoBulkOutput{Results: results}
+	for _, r := range results {
+		if r.Error != nil {
+			out.Failed++
+		} else {
+			out.Succeeded++
+		}
+	}
+	return out
+}
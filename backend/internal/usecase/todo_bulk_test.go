@@ -0,0 +1,263 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"good-todo-go/internal/domain/model"
+	mock_repository "good-todo-go/internal/domain/repository/mock"
+	"good-todo-go/internal/usecase/input"
+	"good-todo-go/internal/usecase/output"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTodoInteractor_BulkUpdateTodos(t *testing.T) {
+	t.Parallel()
+
+	own := func(id string) *model.Todo {
+		return &model.Todo{ID: id, UserID: "user-1", TenantID: "tenant-1"}
+	}
+	isPublic := true
+
+	tests := []struct {
+		name        string
+		input       *input.BulkTodosInput
+		setupMocks  func(todoRepo *mock_repository.MockITodoRepository, tagRepo *mock_repository.MockITagRepository)
+		wantChange  *model.TodoBulkChange
+		wantResults map[string]string
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name: "success - complete checks each todo like UpdateTodo",
+			input: &input.BulkTodosInput{
+				UserID:    "user-1",
+				Operation: BulkTodoComplete,
+				TodoIDs:   []string{"own", "assigned", "other", "missing", "own"},
+			},
+			setupMocks: func(todoRepo *mock_repository.MockITodoRepository, tagRepo *mock_repository.MockITagRepository) {
+				todoRepo.EXPECT().FindByIDs(gomock.Any(), []string{"own", "assigned", "other", "missing"}).Return([]*model.Todo{
+					own("own"),
+					{ID: "assigned", UserID: "owner", AssigneeID: strPtr("user-1")},
+					{ID: "other", UserID: "owner", IsPublic: true},
+				}, nil)
+			},
+			wantResults: map[string]string{"own": "", "assigned": "", "other": "FORBIDDEN", "missing": "NOT_FOUND"},
+		},
+		{
+			name: "success - only the owner cascades completion to subtasks",
+			input: &input.BulkTodosInput{
+				UserID:            "user-1",
+				Operation:         BulkTodoComplete,
+				TodoIDs:           []string{"own", "assigned", "shared"},
+				CascadeCompletion: true,
+			},
+			setupMocks: func(todoRepo *mock_repository.MockITodoRepository, tagRepo *mock_repository.MockITagRepository) {
+				todoRepo.EXPECT().FindByIDs(gomock.Any(), []string{"own", "assigned", "shared"}).Return([]*model.Todo{
+					own("own"),
+					{ID: "assigned", UserID: "owner", AssigneeID: strPtr("user-1")},
+					{ID: "shared", UserID: "owner", Shares: []*model.TodoShare{{UserID: "user-1", Permission: model.TodoPermissionEdit}}},
+				}, nil)
+			},
+			wantResults: map[string]string{"own": "", "assigned": "FORBIDDEN", "shared": "FORBIDDEN"},
+		},
+		{
+			name: "success - delete skips todos with subtasks unless children is given",
+			input: &input.BulkTodosInput{
+				UserID:    "user-1",
+				Operation: BulkTodoDelete,
+				TodoIDs:   []string{"leaf", "parent"},
+			},
+			setupMocks: func(todoRepo *mock_repository.MockITodoRepository, tagRepo *mock_repository.MockITagRepository) {
+				todoRepo.EXPECT().FindByIDs(gomock.Any(), []string{"leaf", "parent"}).Return([]*model.Todo{own("leaf"), own("parent")}, nil)
+				todoRepo.EXPECT().FindChildren(gomock.Any(), "leaf").Return(nil, nil)
+				todoRepo.EXPECT().FindChildren(gomock.Any(), "parent").Return([]*model.Todo{own("child")}, nil)
+			},
+			wantChange:  &model.TodoBulkChange{Deletes: []string{"leaf"}},
+			wantResults: map[string]string{"leaf": "", "parent": "CONFLICT"},
+		},
+		{
+			name: "success - add tag to the todos matched by a filter",
+			input: &input.BulkTodosInput{
+				UserID:    "user-1",
+				Operation: BulkTodoAddTag,
+				Filter:    &input.TodoFilterInput{ProjectID: strPtr("project-1")},
+				TagID:     "tag-1",
+			},
+			setupMocks: func(todoRepo *mock_repository.MockITodoRepository, tagRepo *mock_repository.MockITagRepository) {
+				tagRepo.EXPECT().FindByID(gomock.Any(), "tag-1").Return(&model.Tag{ID: "tag-1"}, nil)
+				todoRepo.EXPECT().
+					FindByUserID(gomock.Any(), "user-1", &model.TodoFilter{ProjectID: strPtr("project-1")}, nil, &model.TodoPage{Limit: maxBulkTodos + 1}).
+					Return([]*model.Todo{own("todo-1")}, nil)
+			},
+			wantChange: &model.TodoBulkChange{TagLinks: []*model.TodoTagLink{
+				{TenantID: "tenant-1", TodoID: "todo-1", TagID: "tag-1"},
+			}},
+			wantResults: map[string]string{"todo-1": ""},
+		},
+		{
+			name: "success - only the owner changes visibility",
+			input: &input.BulkTodosInput{
+				UserID:    "user-1",
+				Operation: BulkTodoSetVisibility,
+				TodoIDs:   []string{"todo-1", "assigned"},
+				IsPublic:  &isPublic,
+			},
+			setupMocks: func(todoRepo *mock_repository.MockITodoRepository, tagRepo *mock_repository.MockITagRepository) {
+				todoRepo.EXPECT().FindByIDs(gomock.Any(), gomock.Any()).Return([]*model.Todo{
+					own("todo-1"),
+					{ID: "assigned", UserID: "owner", AssigneeID: strPtr("user-1")},
+				}, nil)
+			},
+			wantChange: &model.TodoBulkChange{Updates: []*model.Todo{
+				{ID: "todo-1", UserID: "user-1", TenantID: "tenant-1", IsPublic: true},
+			}},
+			wantResults: map[string]string{"todo-1": "", "assigned": "FORBIDDEN"},
+		},
+		{
+			name:        "fail - both todo IDs and a filter",
+			input:       &input.BulkTodosInput{UserID: "user-1", Operation: BulkTodoComplete, TodoIDs: []string{"todo-1"}, Filter: &input.TodoFilterInput{}},
+			setupMocks:  func(todoRepo *mock_repository.MockITodoRepository, tagRepo *mock_repository.MockITagRepository) {},
+			wantErr:     true,
+			errContains: "exactly one of todo_ids and filter",
+		},
+		{
+			name:        "fail - unknown operation",
+			input:       &input.BulkTodosInput{UserID: "user-1", Operation: "archive", TodoIDs: []string{"todo-1"}},
+			setupMocks:  func(todoRepo *mock_repository.MockITodoRepository, tagRepo *mock_repository.MockITagRepository) {},
+			wantErr:     true,
+			errContains: "unknown operation",
+		},
+		{
+			name:        "fail - set visibility without is_public",
+			input:       &input.BulkTodosInput{UserID: "user-1", Operation: BulkTodoSetVisibility, TodoIDs: []string{"todo-1"}},
+			setupMocks:  func(todoRepo *mock_repository.MockITodoRepository, tagRepo *mock_repository.MockITagRepository) {},
+			wantErr:     true,
+			errContains: "is_public is required",
+		},
+		{
+			name:  "fail - unknown tag",
+			input: &input.BulkTodosInput{UserID: "user-1", Operation: BulkTodoAddTag, TodoIDs: []string{"todo-1"}, TagID: "tag-1"},
+			setupMocks: func(todoRepo *mock_repository.MockITodoRepository, tagRepo *mock_repository.MockITagRepository) {
+				tagRepo.EXPECT().FindByID(gomock.Any(), "tag-1").Return(nil, errors.New("not found"))
+			},
+			wantErr:     true,
+			errContains: "tag not found",
+		},
+		{
+			name:  "fail - filter matches too many todos",
+			input: &input.BulkTodosInput{UserID: "user-1", Operation: BulkTodoReopen, Filter: &input.TodoFilterInput{}},
+			setupMocks: func(todoRepo *mock_repository.MockITodoRepository, tagRepo *mock_repository.MockITagRepository) {
+				todos := make([]*model.Todo, maxBulkTodos+1)
+				for n := range todos {
+					todos[n] = own("todo")
+				}
+				todoRepo.EXPECT().FindByUserID(gomock.Any(), "user-1", gomock.Any(), nil, gomock.Any()).Return(todos, nil)
+			},
+			wantErr:     true,
+			errContains: "more than 100 todos",
+		},
+		{
+			name:  "fail - the transaction fails as a whole",
+			input: &input.BulkTodosInput{UserID: "user-1", Operation: BulkTodoReopen, TodoIDs: []string{"todo-1"}},
+			setupMocks: func(todoRepo *mock_repository.MockITodoRepository, tagRepo *mock_repository.MockITagRepository) {
+				todoRepo.EXPECT().FindByIDs(gomock.Any(), []string{"todo-1"}).Return([]*model.Todo{own("todo-1")}, nil)
+				todoRepo.EXPECT().ApplyBulk(gomock.Any(), gomock.Any()).Return(errors.New("db error"))
+			},
+			wantErr:     true,
+			errContains: "failed to apply bulk operation",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+			tagRepo := mock_repository.NewMockITagRepository(ctrl)
+			tt.setupMocks(todoRepo, tagRepo)
+
+			var applied *model.TodoBulkChange
+			if !tt.wantErr {
+				todoRepo.EXPECT().
+					ApplyBulk(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, change *model.TodoBulkChange) error {
+						applied = change
+						return nil
+					})
+			}
+
+			interactor := &TodoInteractor{todoRepo: todoRepo, tagRepo: tagRepo}
+
+			result, err := interactor.BulkUpdateTodos(context.Background(), tt.input)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+			if tt.wantChange != nil {
+				assert.Equal(t, tt.wantChange, applied)
+			}
+
+			codes := make(map[string]string, len(result.Results))
+			failed := 0
+			for _, r := range result.Results {
+				codes[r.TodoID] = ""
+				if r.Error != nil {
+					codes[r.TodoID] = r.Error.Code
+					failed++
+				}
+			}
+			assert.Equal(t, tt.wantResults, codes)
+			assert.Len(t, result.Results, len(tt.wantResults))
+			assert.Equal(t, failed, result.Failed)
+			assert.Equal(t, len(result.Results)-failed, result.Succeeded)
+		})
+	}
+}
+
+func TestTodoInteractor_BulkUpdateTodos_Complete(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+	todoRepo.EXPECT().FindByIDs(gomock.Any(), []string{"todo-1"}).Return([]*model.Todo{
+		{ID: "todo-1", UserID: "user-1"},
+	}, nil)
+
+	var applied *model.TodoBulkChange
+	todoRepo.EXPECT().
+		ApplyBulk(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, change *model.TodoBulkChange) error {
+			applied = change
+			return nil
+		})
+
+	interactor := &TodoInteractor{todoRepo: todoRepo}
+
+	result, err := interactor.BulkUpdateTodos(context.Background(), &input.BulkTodosInput{
+		UserID:            "user-1",
+		Operation:         BulkTodoComplete,
+		TodoIDs:           []string{"todo-1"},
+		CascadeCompletion: true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []*output.TodoBulkResultOutput{{TodoID: "todo-1"}}, result.Results)
+
+	require.Len(t, applied.Updates, 1)
+	assert.True(t, applied.Updates[0].Completed)
+	assert.NotNil(t, applied.Updates[0].CompletedAt)
+	assert.Equal(t, applied.Updates, applied.CompleteDescendants)
+}
@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/pkg/cerror"
+)
+
+// todoCursorToken is the JSON payload of an opaque todo cursor. The sort is
+// embedded so a cursor cannot silently be replayed against another order.
+type todoCursorToken struct {
+	Sort     string     `json:"s"`
+	Desc     bool       `json:"d"`
+	ID       string     `json:"id"`
+	Time     *time.Time `json:"t,omitempty"`
+	Title    string     `json:"ti,omitempty"`
+	Priority string     `json:"pr,omitempty"`
+	Position string     `json:"p,omitempty"`
+}
+
+// encodeTodoCursor returns a cursor pointing just after t in the given order
+func encodeTodoCursor(sort *model.TodoSort, t *model.Todo) string {
+	token := todoCursorToken{Sort: sort.Field, Desc: sort.Desc, ID: t.ID}
+	switch sort.Field {
+	case model.TodoSortDueDate:
+		token.Time = t.DueDate
+	case model.TodoSortUpdatedAt:
+		token.Time = &t.UpdatedAt
+	case model.TodoSortTitle:
+		token.Title = t.Title
+	case model.TodoSortPriority:
+		token.Priority = t.Priority
+	case model.TodoSortPosition:
+		token.Position = t.Position
+	default:
+		token.Time = &t.CreatedAt
+	}
+
+	payload, _ := json.Marshal(token)
+	return base64.RawURLEncoding.EncodeToString(payload)
+}
+
+// decodeTodoCursor parses a cursor issued by encodeTodoCursor for the same sort
+func decodeTodoCursor(cursor string, sort *model.TodoSort) (*model.TodoCursor, error) {
+	payload, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, cerror.NewBadRequest("invalid cursor", err)
+	}
+
+	var token todoCursorToken
+	if err := json.Unmarshal(payload, &token); err != nil || token.ID == "" {
+		return nil, cerror.NewBadRequest("invalid cursor", err)
+	}
+	if token.Sort != sort.Field || token.Desc != sort.Desc {
+		return nil, cerror.NewBadRequest("cursor does not match the requested sort", nil)
+	}
+
+	return &model.TodoCursor{
+		ID:       token.ID,
+		Time:     token.Time,
+		Title:    token.Title,
+		Priority: token.Priority,
+		Position: token.Position,
+	}, nil
+}
+
+// todoPage builds the repository page for a listing. One extra row is
+// requested so the caller can tell whether another page follows.
+func todoPage(cursor string, sort *model.TodoSort, limit, offset int) (*model.TodoPage, error) {
+	page := &model.TodoPage{Limit: limit + 1, Offset: offset}
+	if cursor != "" {
+		after, err := decodeTodoCursor(cursor, sort)
+		if err != nil {
+			return nil, err
+		}
+		page.After = after
+	}
+	return page, nil
+}
+
+// trimTodoPage drops the look-ahead row fetched by todoPage and returns the
+// cursor for the next page, or nil on the last page
+func trimTodoPage(todos []*model.Todo, sort *model.TodoSort, limit int) ([]*model.Todo, *string) {
+	if len(todos) <= limit {
+		return todos, nil
+	}
+	todos = todos[:limit]
+	next := encodeTodoCursor(sort, todos[limit-1])
+	return todos, &next
+}
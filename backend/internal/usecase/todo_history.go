@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/pkg/cerror"
+	"good-todo-go/internal/usecase/input"
+	"good-todo-go/internal/usecase/output"
+)
+
+func (i *TodoInteractor) GetTodoHistory(ctx context.Context, in *input.GetTodoHistoryInput) (*output.TodoRevisionListOutput, error) {
+	todo, err := i.todoRepo.FindByID(ctx, in.TodoID)
+	if err != nil {
+		return nil, cerror.NewNotFound("todo not found", err)
+	}
+	if !canViewTodo(todo, in.UserID) {
+		return nil, cerror.NewForbidden("not allowed to access this todo", nil)
+	}
+
+	limit := in.Limit
+	if limit <= 0 {
+		limit = 20
+	}
+	if limit > 100 {
+		limit = 100
+	}
+
+	revisions, total, err := i.revisionRepo.List(ctx, todo.ID, in.Offset, limit)
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to get todo history", err)
+	}
+
+	return output.NewTodoRevisionListOutput(revisions, total), nil
+}
+
+func (i *TodoInteractor) RevertTodo(ctx context.Context, in *input.RevertTodoInput) (*output.TodoOutput, error) {
+	todo, err := i.todoRepo.FindByID(ctx, in.TodoID)
+	if err != nil {
+		return nil, cerror.NewNotFound("todo not found", err)
+	}
+
+	// A revert may touch any field, so it is limited to the owner like other edits
+	if todo.UserID != in.UserID {
+		return nil, cerror.NewForbidden("not allowed to revert this todo", nil)
+	}
+
+	revisions, err := i.revisionRepo.FindSince(ctx, todo.ID, in.RevisionID)
+	if err != nil {
+		return nil, cerror.NewNotFound("revision not found", err)
+	}
+
+	// Undo the later revisions, newest first; the first one is the target
+	reverted := *todo
+	for n := len(revisions) - 1; n > 0; n-- {
+		for _, change := range revisions[n].Changes {
+			revertTodoField(&reverted, change)
+		}
+	}
+
+	if reverted.ProjectID != nil && (todo.ProjectID == nil || *todo.ProjectID != *reverted.ProjectID) {
+		if err := checkTodoProject(ctx, i.projectRepo, *reverted.ProjectID, in.UserID); err != nil {
+			return nil, err
+		}
+	}
+
+	// Completing a recurring todo moves the series on, like UpdateTodo does
+	var next *model.Todo
+	if reverted.Completed && !todo.Completed {
+		next, err = i.handOverSeries(ctx, &reverted)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	updated, created, err := i.saveTodo(ctx, &reverted, false, next)
+	if err != nil {
+		return nil, todoWriteError(err, nil, "failed to revert todo")
+	}
+
+	return newTodoOutputWithNext(updated, created), nil
+}
+
+// revertTodoField sets the field back to the old value of the change. Only
+// the fields UpdateTodo and MoveTodo write are reverted; the assignee, the
+// parent and the recurrence have their own rules and stay as they are.
+func revertTodoField(todo *model.Todo, change *model.TodoFieldChange) {
+	switch change.Field {
+	case model.TodoFieldTitle:
+		if title, ok := change.Old.(string); ok {
+			todo.Title = title
+		}
+	case model.TodoFieldDescription:
+		if description, ok := change.Old.(string); ok {
+			todo.Description = description
+		}
+	case model.TodoFieldCompleted:
+		if completed, ok := change.Old.(bool); ok {
+			todo.Completed = completed
+		}
+	case model.TodoFieldCompletedAt:
+		todo.CompletedAt = revertedTime(change.Old)
+	case model.TodoFieldIsPublic:
+		if isPublic, ok := change.Old.(bool); ok {
+			todo.IsPublic = isPublic
+		}
+	case model.TodoFieldPriority:
+		if priority, ok := change.Old.(string); ok {
+			todo.Priority = priority
+		}
+	case model.TodoFieldDueDate:
+		todo.DueDate = revertedTime(change.Old)
+	case model.TodoFieldProjectID:
+		todo.ProjectID = nil
+		if projectID, ok := change.Old.(string); ok {
+			todo.ProjectID = &projectID
+		}
+	}
+}
+
+func revertedTime(value any) *time.Time {
+	if t, ok := value.(time.Time); ok {
+		return &t
+	}
+	return nil
+}
@@ -0,0 +1,215 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	mock_repository "good-todo-go/internal/domain/repository/mock"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTodoInteractor_GetTodoHistory(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2026, 1, 3, 9, 0, 0, 0, time.UTC)
+	dueDate := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		todo        *model.Todo
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name: "success - public todo of someone else",
+			todo: &model.Todo{ID: "todo-1", UserID: "owner", IsPublic: true},
+		},
+		{
+			name:        "fail - private todo of someone else",
+			todo:        &model.Todo{ID: "todo-1", UserID: "owner"},
+			wantErr:     true,
+			errContains: "not allowed to access this todo",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+			revisionRepo := mock_repository.NewMockITodoRevisionRepository(ctrl)
+			todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(tt.todo, nil)
+			if !tt.wantErr {
+				revisionRepo.EXPECT().List(gomock.Any(), "todo-1", 0, 100).Return([]*model.TodoRevision{{
+					ID:        "rev-1",
+					TodoID:    "todo-1",
+					ActorID:   strPtr("owner"),
+					Operation: model.TodoRevisionUpdate,
+					Changes: []*model.TodoFieldChange{
+						{Field: model.TodoFieldDueDate, Old: nil, New: dueDate},
+						{Field: model.TodoFieldTitle, Old: "Old", New: "New"},
+					},
+					CreatedAt: createdAt,
+				}}, 1, nil)
+			}
+
+			interactor := &TodoInteractor{todoRepo: todoRepo, revisionRepo: revisionRepo}
+
+			result, err := interactor.GetTodoHistory(context.Background(), &input.GetTodoHistoryInput{
+				TodoID: "todo-1",
+				UserID: "user-1",
+				Limit:  500,
+			})
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, 1, result.Total)
+			require.Len(t, result.Revisions, 1)
+			rev := result.Revisions[0]
+			assert.Equal(t, "update", rev.Operation)
+			assert.Equal(t, "2026-01-03T09:00:00Z", rev.CreatedAt)
+			require.Len(t, rev.Changes, 2)
+			assert.Nil(t, rev.Changes[0].Old)
+			assert.Equal(t, "2026-01-10T00:00:00Z", rev.Changes[0].New)
+			assert.Equal(t, "Old", rev.Changes[1].Old)
+		})
+	}
+}
+
+func TestTodoInteractor_RevertTodo(t *testing.T) {
+	t.Parallel()
+
+	dueDate := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+	current := func() *model.Todo {
+		return &model.Todo{
+			ID:        "todo-1",
+			UserID:    "user-1",
+			Title:     "Third",
+			Priority:  model.TodoPriorityHigh,
+			DueDate:   &dueDate,
+			ProjectID: strPtr("project-1"),
+		}
+	}
+	// rev-1 created the todo; rev-2 and rev-3 changed it afterwards
+	history := []*model.TodoRevision{
+		{ID: "rev-1", Operation: model.TodoRevisionCreate, Changes: []*model.TodoFieldChange{
+			{Field: model.TodoFieldTitle, New: "First"},
+		}},
+		{ID: "rev-2", Operation: model.TodoRevisionUpdate, Changes: []*model.TodoFieldChange{
+			{Field: model.TodoFieldTitle, Old: "First", New: "Second"},
+			{Field: model.TodoFieldDueDate, Old: nil, New: dueDate},
+			{Field: model.TodoFieldProjectID, Old: nil, New: "project-1"},
+		}},
+		{ID: "rev-3", Operation: model.TodoRevisionUpdate, Changes: []*model.TodoFieldChange{
+			{Field: model.TodoFieldTitle, Old: "Second", New: "Third"},
+			{Field: model.TodoFieldPriority, Old: model.TodoPriorityLow, New: model.TodoPriorityHigh},
+			{Field: model.TodoFieldAssigneeID, Old: nil, New: "user-2"},
+		}},
+	}
+
+	tests := []struct {
+		name        string
+		in          *input.RevertTodoInput
+		setupMocks  func(todoRepo *mock_repository.MockITodoRepository, revisionRepo *mock_repository.MockITodoRevisionRepository)
+		want        *model.Todo
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name: "success - undoes every later revision",
+			in:   &input.RevertTodoInput{TodoID: "todo-1", UserID: "user-1", RevisionID: "rev-1"},
+			setupMocks: func(todoRepo *mock_repository.MockITodoRepository, revisionRepo *mock_repository.MockITodoRevisionRepository) {
+				todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(current(), nil)
+				revisionRepo.EXPECT().FindSince(gomock.Any(), "todo-1", "rev-1").Return(history, nil)
+			},
+			want: &model.Todo{ID: "todo-1", UserID: "user-1", Title: "First", Priority: model.TodoPriorityLow},
+		},
+		{
+			name: "success - the revision's own changes are kept",
+			in:   &input.RevertTodoInput{TodoID: "todo-1", UserID: "user-1", RevisionID: "rev-2"},
+			setupMocks: func(todoRepo *mock_repository.MockITodoRepository, revisionRepo *mock_repository.MockITodoRevisionRepository) {
+				todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(current(), nil)
+				revisionRepo.EXPECT().FindSince(gomock.Any(), "todo-1", "rev-2").Return(history[1:], nil)
+			},
+			want: &model.Todo{
+				ID:        "todo-1",
+				UserID:    "user-1",
+				Title:     "Second",
+				Priority:  model.TodoPriorityLow,
+				DueDate:   &dueDate,
+				ProjectID: strPtr("project-1"),
+			},
+		},
+		{
+			name: "fail - not the owner",
+			in:   &input.RevertTodoInput{TodoID: "todo-1", UserID: "user-2", RevisionID: "rev-1"},
+			setupMocks: func(todoRepo *mock_repository.MockITodoRepository, revisionRepo *mock_repository.MockITodoRevisionRepository) {
+				todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(current(), nil)
+			},
+			wantErr:     true,
+			errContains: "not allowed to revert this todo",
+		},
+		{
+			name: "fail - revision of another todo",
+			in:   &input.RevertTodoInput{TodoID: "todo-1", UserID: "user-1", RevisionID: "rev-x"},
+			setupMocks: func(todoRepo *mock_repository.MockITodoRepository, revisionRepo *mock_repository.MockITodoRevisionRepository) {
+				todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(current(), nil)
+				revisionRepo.EXPECT().FindSince(gomock.Any(), "todo-1", "rev-x").Return(nil, errors.New("not found"))
+			},
+			wantErr:     true,
+			errContains: "revision not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+			revisionRepo := mock_repository.NewMockITodoRevisionRepository(ctrl)
+			tt.setupMocks(todoRepo, revisionRepo)
+
+			var saved *model.Todo
+			if !tt.wantErr {
+				todoRepo.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, todo *model.Todo) (*model.Todo, error) {
+						saved = todo
+						return todo, nil
+					})
+			}
+
+			interactor := &TodoInteractor{todoRepo: todoRepo, revisionRepo: revisionRepo}
+
+			result, err := interactor.RevertTodo(context.Background(), tt.in)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, saved)
+			assert.Equal(t, tt.want.Title, result.Title)
+		})
+	}
+}
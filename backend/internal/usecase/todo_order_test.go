@@ -0,0 +1,203 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"good-todo-go/internal/domain/model"
+	mock_repository "good-todo-go/internal/domain/repository/mock"
+	mock_pkg "good-todo-go/internal/pkg/mock"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTodoInteractor_ReorderTodo(t *testing.T) {
+	t.Parallel()
+
+	own := func(id, position string) *model.Todo {
+		return &model.Todo{ID: id, UserID: "user-1", Position: position}
+	}
+
+	tests := []struct {
+		name         string
+		input        *input.ReorderTodoInput
+		setupMocks   func(todoRepo *mock_repository.MockITodoRepository)
+		wantPosition string
+		wantErr      bool
+		errContains  string
+	}{
+		{
+			name:  "success - before a todo with a neighbour",
+			input: &input.ReorderTodoInput{TodoID: "todo-1", UserID: "user-1", Before: strPtr("anchor")},
+			setupMocks: func(todoRepo *mock_repository.MockITodoRepository) {
+				todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(own("todo-1", "x"), nil)
+				todoRepo.EXPECT().FindByID(gomock.Any(), "anchor").Return(own("anchor", "b"), nil)
+				todoRepo.EXPECT().PositionNextTo(gomock.Any(), own("anchor", "b"), "todo-1", true).Return("a", nil)
+			},
+			wantPosition: "aV",
+		},
+		{
+			name:  "success - after the last todo",
+			input: &input.ReorderTodoInput{TodoID: "todo-1", UserID: "user-1", After: strPtr("anchor")},
+			setupMocks: func(todoRepo *mock_repository.MockITodoRepository) {
+				todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(own("todo-1", "A"), nil)
+				todoRepo.EXPECT().FindByID(gomock.Any(), "anchor").Return(own("anchor", "V"), nil)
+				todoRepo.EXPECT().PositionNextTo(gomock.Any(), own("anchor", "V"), "todo-1", false).Return("", nil)
+			},
+			wantPosition: "k",
+		},
+		{
+			name:  "success - neighbours share a position and are spread out first",
+			input: &input.ReorderTodoInput{TodoID: "todo-1", UserID: "user-1", After: strPtr("anchor")},
+			setupMocks: func(todoRepo *mock_repository.MockITodoRepository) {
+				todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(own("todo-1", "A"), nil)
+				todoRepo.EXPECT().FindByID(gomock.Any(), "anchor").Return(own("anchor", "V"), nil)
+				todoRepo.EXPECT().PositionNextTo(gomock.Any(), own("anchor", "V"), "todo-1", false).Return("V", nil)
+				todoRepo.EXPECT().RebalancePositions(gomock.Any(), "user-1").Return(nil)
+				todoRepo.EXPECT().FindByID(gomock.Any(), "anchor").Return(own("anchor", "F"), nil)
+				todoRepo.EXPECT().PositionNextTo(gomock.Any(), own("anchor", "F"), "todo-1", false).Return("V", nil)
+			},
+			wantPosition: "N",
+		},
+		{
+			name:        "fail - neither before nor after",
+			input:       &input.ReorderTodoInput{TodoID: "todo-1", UserID: "user-1"},
+			setupMocks:  func(todoRepo *mock_repository.MockITodoRepository) {},
+			wantErr:     true,
+			errContains: "exactly one",
+		},
+		{
+			name:        "fail - both before and after",
+			input:       &input.ReorderTodoInput{TodoID: "todo-1", UserID: "user-1", Before: strPtr("a"), After: strPtr("b")},
+			setupMocks:  func(todoRepo *mock_repository.MockITodoRepository) {},
+			wantErr:     true,
+			errContains: "exactly one",
+		},
+		{
+			name:  "fail - todo of someone else",
+			input: &input.ReorderTodoInput{TodoID: "todo-1", UserID: "user-1", Before: strPtr("anchor")},
+			setupMocks: func(todoRepo *mock_repository.MockITodoRepository) {
+				todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(&model.Todo{ID: "todo-1", UserID: "owner", IsPublic: true}, nil)
+			},
+			wantErr:     true,
+			errContains: "not allowed",
+		},
+		{
+			name:  "fail - next to itself",
+			input: &input.ReorderTodoInput{TodoID: "todo-1", UserID: "user-1", Before: strPtr("todo-1")},
+			setupMocks: func(todoRepo *mock_repository.MockITodoRepository) {
+				todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(own("todo-1", "V"), nil)
+			},
+			wantErr:     true,
+			errContains: "next to itself",
+		},
+		{
+			name:  "fail - anchor in someone else's order",
+			input: &input.ReorderTodoInput{TodoID: "todo-1", UserID: "user-1", After: strPtr("anchor")},
+			setupMocks: func(todoRepo *mock_repository.MockITodoRepository) {
+				todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(own("todo-1", "V"), nil)
+				todoRepo.EXPECT().FindByID(gomock.Any(), "anchor").Return(&model.Todo{ID: "anchor", UserID: "owner", IsPublic: true}, nil)
+			},
+			wantErr:     true,
+			errContains: "not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+			tt.setupMocks(todoRepo)
+			if !tt.wantErr {
+				todoRepo.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, todo *model.Todo) (*model.Todo, error) {
+						return todo, nil
+					})
+			}
+
+			interactor := &TodoInteractor{todoRepo: todoRepo}
+
+			result, err := interactor.ReorderTodo(context.Background(), tt.input)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantPosition, result.Position)
+		})
+	}
+}
+
+func TestTodoInteractor_Priority(t *testing.T) {
+	t.Parallel()
+
+	t.Run("create defaults to none", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+		uuidGen := mock_pkg.NewMockIUUIDGenerator(ctrl)
+		uuidGen.EXPECT().Generate().Return("todo-1")
+		todoRepo.EXPECT().
+			Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, todo *model.Todo) (*model.Todo, error) {
+				return todo, nil
+			})
+
+		interactor := &TodoInteractor{todoRepo: todoRepo, uuidGen: uuidGen}
+
+		result, err := interactor.CreateTodo(context.Background(), &input.CreateTodoInput{UserID: "user-1", TenantID: "tenant-1", Title: "Todo"})
+		require.NoError(t, err)
+		assert.Equal(t, model.TodoPriorityNone, result.Priority)
+	})
+
+	t.Run("create rejects an unknown priority", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		interactor := &TodoInteractor{todoRepo: mock_repository.NewMockITodoRepository(ctrl)}
+
+		_, err := interactor.CreateTodo(context.Background(), &input.CreateTodoInput{UserID: "user-1", Title: "Todo", Priority: "critical"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid priority")
+	})
+
+	t.Run("assignee cannot change the priority", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+		todoRepo.EXPECT().
+			FindByID(gomock.Any(), "todo-1").
+			Return(&model.Todo{ID: "todo-1", UserID: "owner", AssigneeID: strPtr("user-1")}, nil)
+
+		interactor := &TodoInteractor{todoRepo: todoRepo}
+
+		completed := true
+		_, err := interactor.UpdateTodo(context.Background(), &input.UpdateTodoInput{
+			TodoID:    "todo-1",
+			UserID:    "user-1",
+			Completed: &completed,
+			Priority:  strPtr(model.TodoPriorityUrgent),
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not allowed")
+	})
+}
@@ -0,0 +1,181 @@
+package usecase
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/pkg/cerror"
+	"good-todo-go/internal/usecase/input"
+	"good-todo-go/internal/usecase/output"
+)
+
+// Fields of a todo merge patch. The rest of the todo is read-only or has its
+// own endpoint, so any other field is rejected.
+const (
+	todoPatchTitle       = "title"
+	todoPatchDescription = "description"
+	todoPatchCompleted   = "completed"
+	todoPatchIsPublic    = "is_public"
+	todoPatchDueDate     = "due_date"
+	todoPatchPriority    = "priority"
+	todoPatchProjectID   = "project_id"
+	todoPatchParentID    = "parent_id"
+)
+
+func (i *TodoInteractor) PatchTodo(ctx context.Context, in *input.PatchTodoInput) (*output.TodoOutput, error) {
+	todo, err := i.todoRepo.FindByID(ctx, in.TodoID)
+	if err != nil {
+		return nil, cerror.NewNotFound("todo not found", err)
+	}
+
+	if !canPatchTodo(todo, in.UserID, in.Patch) {
+		return nil, cerror.NewForbidden("not allowed to update this todo", nil)
+	}
+	if err := checkTodoIfMatch(todo, in.IfMatch); err != nil {
+		return nil, err
+	}
+	if len(in.Patch) == 0 {
+		return output.NewTodoOutput(todo), nil
+	}
+
+	before := *todo
+	if err := applyTodoPatch(todo, in.Patch, time.Now().UTC()); err != nil {
+		return nil, err
+	}
+	if todo.Recurrence != nil && todo.DueDate == nil {
+		return nil, cerror.NewBadRequest("a recurring todo needs a due date; stop the recurrence first", nil)
+	}
+	if todo.ProjectID != nil && (before.ProjectID == nil || *before.ProjectID != *todo.ProjectID) {
+		if err := checkTodoProject(ctx, i.projectRepo, *todo.ProjectID, in.UserID); err != nil {
+			return nil, err
+		}
+	}
+	if todo.ParentID != nil && (before.ParentID == nil || *before.ParentID != *todo.ParentID) {
+		if err := i.checkTodoParent(ctx, todo, *todo.ParentID, in.UserID); err != nil {
+			return nil, err
+		}
+	}
+
+	next, err := i.handOverSeries(ctx, todo)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, created, err := i.saveTodo(ctx, todo, false, next)
+	if err != nil {
+		return nil, todoWriteError(err, in.IfMatch, "failed to update todo")
+	}
+
+	return newTodoOutputWithNext(updated, created), nil
+}
+
+// canPatchTodo allows what UpdateTodo allows: the owner may change anything,
+// members with edit access everything but where and to whom the todo is
+// visible, and the assignee only whether it is completed
+func canPatchTodo(todo *model.Todo, userID string, patch map[string]interface{}) bool {
+	if todo.UserID == userID {
+		return true
+	}
+	if isTodoEditor(todo, userID) {
+		_, isPublic := patch[todoPatchIsPublic]
+		_, projectID := patch[todoPatchProjectID]
+		_, parentID := patch[todoPatchParentID]
+		return !isPublic && !projectID && !parentID
+	}
+	_, completed := patch[todoPatchCompleted]
+	return isTodoAssignee(todo, userID) && completed && len(patch) == 1
+}
+
+// applyTodoPatch sets the patched fields on todo. Every field is checked
+// before the error is returned, so the client learns about all invalid fields
+// at once.
+func applyTodoPatch(todo *model.Todo, patch map[string]interface{}, now time.Time) error {
+	details := map[string]interface{}{}
+	for _, field := range slices.Sorted(maps.Keys(patch)) {
+		if message := applyTodoPatchField(todo, field, patch[field], now); message != "" {
+			details[field] = message
+		}
+	}
+	if len(details) > 0 {
+		return cerror.NewValidationError("invalid todo patch", details)
+	}
+	return nil
+}
+
+// applyTodoPatchField sets a single field and returns why the value is
+// invalid, or an empty string
+func applyTodoPatchField(todo *model.Todo, field string, value interface{}, now time.Time) string {
+	switch field {
+	case todoPatchTitle:
+		title, ok := value.(string)
+		if !ok || title == "" {
+			return "must be a non-empty string"
+		}
+		todo.Title = title
+	case todoPatchDescription:
+		description, ok := value.(string)
+		if !ok {
+			return "must be a string"
+		}
+		todo.Description = description
+	case todoPatchCompleted:
+		completed, ok := value.(bool)
+		if !ok {
+			return "must be a boolean"
+		}
+		setTodoCompleted(todo, completed, now)
+	case todoPatchIsPublic:
+		isPublic, ok := value.(bool)
+		if !ok {
+			return "must be a boolean"
+		}
+		todo.IsPublic = isPublic
+	case todoPatchDueDate:
+		if value == nil {
+			todo.DueDate = nil
+			return ""
+		}
+		s, ok := value.(string)
+		if !ok {
+			return "must be a date-time or null"
+		}
+		dueDate, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			return "must be a date-time or null"
+		}
+		todo.DueDate = &dueDate
+	case todoPatchPriority:
+		priority, ok := value.(string)
+		if !ok || !slices.Contains(model.TodoPriorities, priority) {
+			return "must be one of " + strings.Join(model.TodoPriorities, ", ")
+		}
+		todo.Priority = priority
+	case todoPatchProjectID:
+		if value == nil {
+			todo.ProjectID = nil
+			return ""
+		}
+		projectID, ok := value.(string)
+		if !ok || projectID == "" {
+			return "must be a project ID or null"
+		}
+		todo.ProjectID = &projectID
+	case todoPatchParentID:
+		if value == nil {
+			todo.ParentID = nil
+			return ""
+		}
+		parentID, ok := value.(string)
+		if !ok || parentID == "" {
+			return "must be a todo ID or null"
+		}
+		todo.ParentID = &parentID
+	default:
+		return "unknown field"
+	}
+	return ""
+}
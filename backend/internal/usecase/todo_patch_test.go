@@ -0,0 +1,188 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	mock_repository "good-todo-go/internal/domain/repository/mock"
+	"good-todo-go/internal/pkg/cerror"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTodoInteractor_PatchTodo(t *testing.T) {
+	t.Parallel()
+
+	dueDate := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	parentID := "parent-1"
+	assigneeID := "user-2"
+
+	tests := []struct {
+		name        string
+		todo        model.Todo
+		userID      string
+		patch       map[string]interface{}
+		setupMocks  func(todoRepo *mock_repository.MockITodoRepository)
+		wantErr     bool
+		errContains string
+		wantDetails map[string]interface{}
+		check       func(t *testing.T, updated *model.Todo)
+	}{
+		{
+			name:   "success - null clears nullable fields",
+			todo:   model.Todo{ID: "todo-1", UserID: "user-1", Title: "original", DueDate: &dueDate, ParentID: &parentID},
+			userID: "user-1",
+			patch:  map[string]interface{}{"title": "renamed", "due_date": nil, "parent_id": nil},
+			check: func(t *testing.T, updated *model.Todo) {
+				assert.Equal(t, "renamed", updated.Title)
+				assert.Nil(t, updated.DueDate)
+				assert.Nil(t, updated.ParentID)
+			},
+		},
+		{
+			name:   "success - missing fields stay",
+			todo:   model.Todo{ID: "todo-1", UserID: "user-1", Title: "original", DueDate: &dueDate, Priority: model.TodoPriorityHigh},
+			userID: "user-1",
+			patch:  map[string]interface{}{"due_date": "2026-04-01T09:00:00Z"},
+			check: func(t *testing.T, updated *model.Todo) {
+				assert.Equal(t, "original", updated.Title)
+				assert.Equal(t, model.TodoPriorityHigh, updated.Priority)
+				require.NotNil(t, updated.DueDate)
+				assert.True(t, time.Date(2026, 4, 1, 9, 0, 0, 0, time.UTC).Equal(*updated.DueDate))
+			},
+		},
+		{
+			name:   "success - assignee completes the todo",
+			todo:   model.Todo{ID: "todo-1", UserID: "user-1", AssigneeID: &assigneeID},
+			userID: "user-2",
+			patch:  map[string]interface{}{"completed": true},
+			check: func(t *testing.T, updated *model.Todo) {
+				assert.True(t, updated.Completed)
+				assert.NotNil(t, updated.CompletedAt)
+			},
+		},
+		{
+			name:        "fail - assignee renames the todo",
+			todo:        model.Todo{ID: "todo-1", UserID: "user-1", AssigneeID: &assigneeID},
+			userID:      "user-2",
+			patch:       map[string]interface{}{"completed": true, "title": "mine now"},
+			wantErr:     true,
+			errContains: "not allowed to update",
+		},
+		{
+			name:    "fail - every invalid field is reported",
+			todo:    model.Todo{ID: "todo-1", UserID: "user-1", Title: "original"},
+			userID:  "user-1",
+			patch:   map[string]interface{}{"title": nil, "priority": "critical", "completed": "yes", "owner": "user-2", "description": "fine"},
+			wantErr: true,
+			wantDetails: map[string]interface{}{
+				"title":     "must be a non-empty string",
+				"priority":  "must be one of none, low, medium, high, urgent",
+				"completed": "must be a boolean",
+				"owner":     "unknown field",
+			},
+		},
+		{
+			name: "fail - clearing the due date of a recurring todo",
+			todo: model.Todo{
+				ID:         "todo-1",
+				UserID:     "user-1",
+				DueDate:    &dueDate,
+				Recurrence: &model.TodoRecurrence{Rule: "FREQ=DAILY", Timezone: "UTC", Start: dueDate},
+			},
+			userID:      "user-1",
+			patch:       map[string]interface{}{"due_date": nil},
+			wantErr:     true,
+			errContains: "recurring todo needs a due date",
+		},
+		{
+			name:   "fail - parent that cannot be found",
+			todo:   model.Todo{ID: "todo-1", UserID: "user-1"},
+			userID: "user-1",
+			patch:  map[string]interface{}{"parent_id": "missing"},
+			setupMocks: func(todoRepo *mock_repository.MockITodoRepository) {
+				todoRepo.EXPECT().FindByID(gomock.Any(), "missing").Return(nil, errors.New("not found"))
+			},
+			wantErr:     true,
+			errContains: "parent todo not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+			current := tt.todo
+			todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(&current, nil)
+			if tt.setupMocks != nil {
+				tt.setupMocks(todoRepo)
+			}
+			var updated *model.Todo
+			if !tt.wantErr {
+				todoRepo.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, todo *model.Todo) (*model.Todo, error) {
+						updated = todo
+						return todo, nil
+					})
+			}
+
+			interactor := &TodoInteractor{todoRepo: todoRepo}
+
+			_, err := interactor.PatchTodo(context.Background(), &input.PatchTodoInput{
+				TodoID: "todo-1",
+				UserID: tt.userID,
+				Patch:  tt.patch,
+			})
+
+			if tt.wantErr {
+				require.Error(t, err)
+				if tt.wantDetails != nil {
+					var appErr *cerror.AppError
+					require.True(t, errors.As(err, &appErr))
+					assert.Equal(t, tt.wantDetails, appErr.Details)
+					return
+				}
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+			tt.check(t, updated)
+		})
+	}
+}
+
+func TestTodoInteractor_PatchTodo_Empty(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+	todoRepo.EXPECT().
+		FindByID(gomock.Any(), "todo-1").
+		Return(&model.Todo{ID: "todo-1", UserID: "user-1", Title: "original", Version: 2}, nil)
+
+	interactor := &TodoInteractor{todoRepo: todoRepo}
+
+	// An empty patch changes nothing, so nothing is written
+	result, err := interactor.PatchTodo(context.Background(), &input.PatchTodoInput{
+		TodoID: "todo-1",
+		UserID: "user-1",
+		Patch:  map[string]interface{}{},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "original", result.Title)
+	assert.Equal(t, 2, result.Version)
+}
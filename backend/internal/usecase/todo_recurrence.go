@@ -0,0 +1,238 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/pkg/cerror"
+	"good-todo-go/internal/pkg/rrule"
+	"good-todo-go/internal/usecase/input"
+	"good-todo-go/internal/usecase/output"
+)
+
+func (i *TodoInteractor) SetTodoRecurrence(ctx context.Context, in *input.SetTodoRecurrenceInput) (*output.TodoOutput, error) {
+	todo, err := i.findOwnTodo(ctx, in.TodoID, in.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if todo.Completed {
+		return nil, cerror.NewBadRequest("a completed todo cannot recur", nil)
+	}
+
+	recurrence, err := newTodoRecurrence(&in.Recurrence, todo.DueDate)
+	if err != nil {
+		return nil, err
+	}
+	// Changing the rule of a running series keeps its original anchor
+	if todo.Recurrence != nil && !todo.Recurrence.Start.After(*todo.DueDate) {
+		recurrence.Start = todo.Recurrence.Start
+	}
+	todo.Recurrence = recurrence
+
+	updated, err := i.todoRepo.Update(ctx, todo)
+	if err != nil {
+		return nil, todoWriteError(err, nil, "failed to update todo")
+	}
+
+	return output.NewTodoOutput(updated), nil
+}
+
+func (i *TodoInteractor) SkipTodoOccurrence(ctx context.Context, todoID, userID string) (*output.TodoOutput, error) {
+	todo, err := i.findOwnTodo(ctx, todoID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if todo.Recurrence == nil || todo.DueDate == nil {
+		return nil, cerror.NewBadRequest("todo is not recurring", nil)
+	}
+
+	next, ok := nextOccurrence(todo.Recurrence, *todo.DueDate)
+	if !ok {
+		return nil, cerror.NewBadRequest("this is the last occurrence of the series", nil)
+	}
+	todo.DueDate = &next
+
+	updated, err := i.todoRepo.Update(ctx, todo)
+	if err != nil {
+		return nil, todoWriteError(err, nil, "failed to update todo")
+	}
+
+	return output.NewTodoOutput(updated), nil
+}
+
+func (i *TodoInteractor) StopTodoRecurrence(ctx context.Context, todoID, userID string) (*output.TodoOutput, error) {
+	todo, err := i.findOwnTodo(ctx, todoID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if todo.Recurrence == nil {
+		return output.NewTodoOutput(todo), nil
+	}
+	todo.Recurrence = nil
+
+	updated, err := i.todoRepo.Update(ctx, todo)
+	if err != nil {
+		return nil, todoWriteError(err, nil, "failed to update todo")
+	}
+
+	return output.NewTodoOutput(updated), nil
+}
+
+func (i *TodoInteractor) findOwnTodo(ctx context.Context, todoID, userID string) (*model.Todo, error) {
+	todo, err := i.todoRepo.FindByID(ctx, todoID)
+	if err != nil {
+		return nil, cerror.NewNotFound("todo not found", err)
+	}
+	if todo.UserID != userID {
+		return nil, cerror.NewForbidden("not allowed to update this todo", nil)
+	}
+	return todo, nil
+}
+
+// handOverRecurrence takes the series off a completed occurrence and returns
+// it for the next occurrence, so that reopening and completing this todo
+// again does not repeat it twice. It returns nil when there is nothing to hand over.
+func handOverRecurrence(todo *model.Todo) *model.TodoRecurrence {
+	if !todo.Completed || todo.Recurrence == nil || todo.DueDate == nil {
+		return nil
+	}
+	recurrence := todo.Recurrence
+	todo.Recurrence = nil
+	return recurrence
+}
+
+// handOverSeries takes the series off a just-completed recurring todo and
+// returns its next occurrence, to be written in the same transaction as the
+// completion so a failure cannot lose the series. It returns nil when the todo
+// is not a completed recurring todo or its series has ended.
+func (i *TodoInteractor) handOverSeries(ctx context.Context, todo *model.Todo) (*model.Todo, error) {
+	recurrence := handOverRecurrence(todo)
+	if recurrence == nil {
+		return nil, nil
+	}
+	next := i.newOccurrence(todo, recurrence)
+	if next == nil {
+		return nil, nil
+	}
+
+	reminders, err := i.reminderRepo.FindByTodoID(ctx, todo.ID)
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to get reminders", err)
+	}
+	next.Reminders = i.occurrenceReminders(reminders, next, time.Now())
+	return next, nil
+}
+
+// occurrenceReminders sets up the reminders relative to the due date again
+// for the next occurrence, whether or not they were sent for this one.
+// Reminders at a fixed time belong to this occurrence, and those whose time
+// has already passed would only fire late, so both are left out.
+func (i *TodoInteractor) occurrenceReminders(reminders []*model.Reminder, next *model.Todo, now time.Time) []*model.Reminder {
+	var result []*model.Reminder
+	for _, rm := range reminders {
+		if rm.OffsetMinutes == nil {
+			continue
+		}
+		remindAt := next.DueDate.Add(-time.Duration(*rm.OffsetMinutes) * time.Minute).UTC()
+		if remindAt.Before(now) {
+			continue
+		}
+		offset := *rm.OffsetMinutes
+		result = append(result, &model.Reminder{
+			ID:            i.uuidGen.Generate(),
+			TenantID:      next.TenantID,
+			TodoID:        next.ID,
+			RemindAt:      remindAt,
+			OffsetMinutes: &offset,
+			Channel:       rm.Channel,
+		})
+	}
+	return result
+}
+
+// saveTodo writes the todo together with what completing it triggers: its
+// open subtasks are completed when cascade is set, and next, the following
+// occurrence of its series, is created unless it is nil. Either all of it is
+// written or none of it.
+func (i *TodoInteractor) saveTodo(ctx context.Context, todo *model.Todo, cascade bool, next *model.Todo) (*model.Todo, *model.Todo, error) {
+	if !cascade && next == nil {
+		updated, err := i.todoRepo.Update(ctx, todo)
+		return updated, nil, err
+	}
+	return i.todoRepo.UpdateWithFollowUps(ctx, todo, cascade, next)
+}
+
+// newTodoOutputWithNext is the output of an update that may have created the
+// todo's next occurrence
+func newTodoOutputWithNext(updated, next *model.Todo) *output.TodoOutput {
+	out := output.NewTodoOutput(updated)
+	if next != nil {
+		out.NextOccurrence = output.NewTodoOutput(next)
+	}
+	return out
+}
+
+// newOccurrence builds the next occurrence of a just-completed recurring
+// todo, or returns nil when the series has ended
+func (i *TodoInteractor) newOccurrence(completed *model.Todo, recurrence *model.TodoRecurrence) *model.Todo {
+	next, ok := nextOccurrence(recurrence, *completed.DueDate)
+	if !ok {
+		return nil
+	}
+
+	return &model.Todo{
+		ID:          i.uuidGen.Generate(),
+		UserID:      completed.UserID,
+		TenantID:    completed.TenantID,
+		Title:       completed.Title,
+		Description: completed.Description,
+		IsPublic:    completed.IsPublic,
+		Priority:    completed.Priority,
+		DueDate:     &next,
+		ProjectID:   completed.ProjectID,
+		ParentID:    completed.ParentID,
+		AssigneeID:  completed.AssigneeID,
+		Recurrence:  recurrence,
+		Tags:        completed.Tags,
+	}
+}
+
+// newTodoRecurrence validates a rule and anchors it on the todo's due date
+func newTodoRecurrence(in *input.TodoRecurrenceInput, dueDate *time.Time) (*model.TodoRecurrence, error) {
+	if dueDate == nil {
+		return nil, cerror.NewBadRequest("a recurring todo needs a due date", nil)
+	}
+	rule, err := rrule.Parse(in.Rule)
+	if err != nil {
+		return nil, cerror.NewBadRequest("invalid recurrence rule: "+err.Error(), err)
+	}
+	timezone := in.Timezone
+	if timezone == "" {
+		timezone = "UTC"
+	}
+	if _, err := time.LoadLocation(timezone); err != nil {
+		return nil, cerror.NewBadRequest("unknown timezone", err)
+	}
+	return &model.TodoRecurrence{
+		Rule:     rule.String(),
+		Timezone: timezone,
+		Start:    dueDate.UTC(),
+	}, nil
+}
+
+// nextOccurrence is the first due date of the series after due; ok is false
+// when the series has ended
+func nextOccurrence(recurrence *model.TodoRecurrence, due time.Time) (next time.Time, ok bool) {
+	// Stored rules were validated on the way in
+	rule, err := rrule.Parse(recurrence.Rule)
+	if err != nil {
+		return time.Time{}, false
+	}
+	loc, err := time.LoadLocation(recurrence.Timezone)
+	if err != nil {
+		return time.Time{}, false
+	}
+	next, ok = rule.Next(recurrence.Start, due, loc)
+	return next.UTC(), ok
+}
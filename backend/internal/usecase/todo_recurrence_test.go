@@ -0,0 +1,378 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	mock_repository "good-todo-go/internal/domain/repository/mock"
+	mock_pkg "good-todo-go/internal/pkg/mock"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTodoInteractor_CreateTodo_Recurrence(t *testing.T) {
+	t.Parallel()
+
+	due := time.Date(2025, 12, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		dueDate     *time.Time
+		recurrence  *input.TodoRecurrenceInput
+		wantRule    string
+		wantTZ      string
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:       "success - rule is stored in canonical form",
+			dueDate:    &due,
+			recurrence: &input.TodoRecurrenceInput{Rule: "RRULE:freq=weekly;interval=1;byday=mo", Timezone: "Europe/Berlin"},
+			wantRule:   "FREQ=WEEKLY;BYDAY=MO",
+			wantTZ:     "Europe/Berlin",
+		},
+		{
+			name:       "success - timezone defaults to UTC",
+			dueDate:    &due,
+			recurrence: &input.TodoRecurrenceInput{Rule: "FREQ=DAILY"},
+			wantRule:   "FREQ=DAILY",
+			wantTZ:     "UTC",
+		},
+		{
+			name:        "fail - no due date",
+			recurrence:  &input.TodoRecurrenceInput{Rule: "FREQ=DAILY"},
+			wantErr:     true,
+			errContains: "needs a due date",
+		},
+		{
+			name:        "fail - unsupported rule",
+			dueDate:     &due,
+			recurrence:  &input.TodoRecurrenceInput{Rule: "FREQ=HOURLY"},
+			wantErr:     true,
+			errContains: "invalid recurrence rule",
+		},
+		{
+			name:        "fail - unknown timezone",
+			dueDate:     &due,
+			recurrence:  &input.TodoRecurrenceInput{Rule: "FREQ=DAILY", Timezone: "Mars/Olympus"},
+			wantErr:     true,
+			errContains: "unknown timezone",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+			uuidGen := mock_pkg.NewMockIUUIDGenerator(ctrl)
+			if !tt.wantErr {
+				uuidGen.EXPECT().Generate().Return("todo-1")
+				todoRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, todo *model.Todo) (*model.Todo, error) {
+						return todo, nil
+					})
+			}
+
+			interactor := &TodoInteractor{todoRepo: todoRepo, uuidGen: uuidGen}
+
+			result, err := interactor.CreateTodo(context.Background(), &input.CreateTodoInput{
+				UserID:     "user-1",
+				TenantID:   "tenant-1",
+				Title:      "Water the plants",
+				DueDate:    tt.dueDate,
+				Recurrence: tt.recurrence,
+			})
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result.Recurrence)
+			assert.Equal(t, tt.wantRule, result.Recurrence.Rule)
+			assert.Equal(t, tt.wantTZ, result.Recurrence.Timezone)
+			assert.Equal(t, "2025-12-01T09:00:00Z", result.Recurrence.Start)
+		})
+	}
+}
+
+func TestTodoInteractor_UpdateTodo_CompleteOccurrence(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2025, 12, 1, 9, 0, 0, 0, time.UTC)
+	due := time.Date(2025, 12, 8, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		rule string
+		// wantNext is the due date of the next occurrence; empty when the series ends
+		wantNext string
+	}{
+		{
+			name:     "creates the next occurrence",
+			rule:     "FREQ=WEEKLY",
+			wantNext: "2025-12-15T09:00:00Z",
+		},
+		{
+			name: "series ends after the last occurrence",
+			rule: "FREQ=WEEKLY;COUNT=2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			recurrence := &model.TodoRecurrence{Rule: tt.rule, Timezone: "UTC", Start: start}
+			tags := []*model.Tag{{ID: "tag-1", Name: "chores"}}
+
+			todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+			reminderRepo := mock_repository.NewMockIReminderRepository(ctrl)
+			uuidGen := mock_pkg.NewMockIUUIDGenerator(ctrl)
+			todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(&model.Todo{
+				ID: "todo-1", UserID: "user-1", TenantID: "tenant-1", Title: "Take out the trash",
+				DueDate: &due, Recurrence: recurrence, Tags: tags,
+			}, nil)
+			if tt.wantNext != "" {
+				uuidGen.EXPECT().Generate().Return("todo-2")
+				reminderRepo.EXPECT().FindByTodoID(gomock.Any(), "todo-1").Return(nil, nil)
+				// The completion and the next occurrence are written together
+				todoRepo.EXPECT().
+					UpdateWithFollowUps(gomock.Any(), gomock.Any(), false, gomock.Any()).
+					DoAndReturn(func(_ context.Context, todo *model.Todo, _ bool, next *model.Todo) (*model.Todo, *model.Todo, error) {
+						// The completed occurrence no longer carries the series
+						assert.Nil(t, todo.Recurrence)
+						assert.Equal(t, recurrence, next.Recurrence)
+						assert.Equal(t, tags, next.Tags)
+						assert.False(t, next.Completed)
+						return todo, next, nil
+					})
+			} else {
+				todoRepo.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, todo *model.Todo) (*model.Todo, error) {
+						assert.Nil(t, todo.Recurrence)
+						return todo, nil
+					})
+			}
+
+			interactor := &TodoInteractor{todoRepo: todoRepo, reminderRepo: reminderRepo, uuidGen: uuidGen}
+
+			completed := true
+			result, err := interactor.UpdateTodo(context.Background(), &input.UpdateTodoInput{
+				TodoID:    "todo-1",
+				UserID:    "user-1",
+				Completed: &completed,
+			})
+
+			require.NoError(t, err)
+			assert.True(t, result.Completed)
+			assert.Nil(t, result.Recurrence)
+			if tt.wantNext == "" {
+				assert.Nil(t, result.NextOccurrence)
+				return
+			}
+			require.NotNil(t, result.NextOccurrence)
+			assert.Equal(t, "todo-2", result.NextOccurrence.ID)
+			assert.Equal(t, tt.wantNext, *result.NextOccurrence.DueDate)
+		})
+	}
+}
+
+func TestTodoInteractor_UpdateTodo_CompleteOccurrenceFails(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	due := time.Date(2025, 12, 8, 9, 0, 0, 0, time.UTC)
+	todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+	uuidGen := mock_pkg.NewMockIUUIDGenerator(ctrl)
+	todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(&model.Todo{
+		ID: "todo-1", UserID: "user-1", DueDate: &due,
+		Recurrence: &model.TodoRecurrence{Rule: "FREQ=WEEKLY", Timezone: "UTC", Start: due},
+	}, nil)
+	uuidGen.EXPECT().Generate().Return("todo-2")
+	reminderRepo := mock_repository.NewMockIReminderRepository(ctrl)
+	reminderRepo.EXPECT().FindByTodoID(gomock.Any(), "todo-1").Return(nil, nil)
+	// Creating the next occurrence fails, so the completion is rolled back
+	// with it; nothing else is written
+	todoRepo.EXPECT().
+		UpdateWithFollowUps(gomock.Any(), gomock.Any(), false, gomock.Any()).
+		Return(nil, nil, errors.New("db error"))
+
+	interactor := &TodoInteractor{todoRepo: todoRepo, reminderRepo: reminderRepo, uuidGen: uuidGen}
+
+	completed := true
+	_, err := interactor.UpdateTodo(context.Background(), &input.UpdateTodoInput{
+		TodoID:    "todo-1",
+		UserID:    "user-1",
+		Completed: &completed,
+	})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to update todo")
+}
+
+func TestTodoInteractor_UpdateTodo_CompleteOccurrenceReminders(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	due := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Minute)
+	sentAt := due.Add(-time.Hour)
+	hour, day, fortnight := 60, 24*60, 14*24*60
+
+	todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+	reminderRepo := mock_repository.NewMockIReminderRepository(ctrl)
+	uuidGen := mock_pkg.NewMockIUUIDGenerator(ctrl)
+	todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(&model.Todo{
+		ID: "todo-1", UserID: "user-1", TenantID: "tenant-1", DueDate: &due,
+		Recurrence: &model.TodoRecurrence{Rule: "FREQ=WEEKLY", Timezone: "UTC", Start: due},
+	}, nil)
+	reminderRepo.EXPECT().FindByTodoID(gomock.Any(), "todo-1").Return([]*model.Reminder{
+		{ID: "sent", TodoID: "todo-1", RemindAt: sentAt, OffsetMinutes: &hour, Channel: model.ReminderChannelEmail, SentAt: &sentAt},
+		{ID: "pending", TodoID: "todo-1", RemindAt: due.Add(-24 * time.Hour), OffsetMinutes: &day, Channel: model.ReminderChannelInApp},
+		// Two weeks before the next occurrence is already over
+		{ID: "too-early", TodoID: "todo-1", RemindAt: due.Add(-14 * 24 * time.Hour), OffsetMinutes: &fortnight, Channel: model.ReminderChannelEmail},
+		// A fixed time belongs to this occurrence alone
+		{ID: "fixed", TodoID: "todo-1", RemindAt: due.Add(-2 * time.Hour), Channel: model.ReminderChannelEmail},
+	}, nil)
+	gomock.InOrder(
+		uuidGen.EXPECT().Generate().Return("todo-2"),
+		uuidGen.EXPECT().Generate().Return("reminder-1"),
+		uuidGen.EXPECT().Generate().Return("reminder-2"),
+	)
+
+	nextDue := due.Add(7 * 24 * time.Hour)
+	todoRepo.EXPECT().
+		UpdateWithFollowUps(gomock.Any(), gomock.Any(), false, gomock.Any()).
+		DoAndReturn(func(_ context.Context, todo *model.Todo, _ bool, next *model.Todo) (*model.Todo, *model.Todo, error) {
+			// Sent or not, the reminders relative to the due date are set up
+			// again against the next due date
+			assert.Equal(t, []*model.Reminder{
+				{ID: "reminder-1", TenantID: "tenant-1", TodoID: "todo-2", RemindAt: nextDue.Add(-time.Hour), OffsetMinutes: &hour, Channel: model.ReminderChannelEmail},
+				{ID: "reminder-2", TenantID: "tenant-1", TodoID: "todo-2", RemindAt: nextDue.Add(-24 * time.Hour), OffsetMinutes: &day, Channel: model.ReminderChannelInApp},
+			}, next.Reminders)
+			return todo, next, nil
+		})
+
+	interactor := &TodoInteractor{todoRepo: todoRepo, reminderRepo: reminderRepo, uuidGen: uuidGen}
+
+	completed := true
+	result, err := interactor.UpdateTodo(context.Background(), &input.UpdateTodoInput{
+		TodoID:    "todo-1",
+		UserID:    "user-1",
+		Completed: &completed,
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, result.NextOccurrence)
+	assert.Equal(t, "todo-2", result.NextOccurrence.ID)
+}
+
+func TestTodoInteractor_SkipAndStopRecurrence(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2025, 1, 31, 9, 0, 0, 0, time.UTC)
+
+	newTodo := func(rule string) *model.Todo {
+		due := start
+		return &model.Todo{
+			ID: "todo-1", UserID: "user-1", DueDate: &due,
+			Recurrence: &model.TodoRecurrence{Rule: rule, Timezone: "UTC", Start: start},
+		}
+	}
+	update := func(_ context.Context, todo *model.Todo) (*model.Todo, error) {
+		return todo, nil
+	}
+
+	t.Run("skip moves to the next due date", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+		todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(newTodo("FREQ=MONTHLY"), nil)
+		todoRepo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(update)
+
+		interactor := &TodoInteractor{todoRepo: todoRepo}
+
+		result, err := interactor.SkipTodoOccurrence(context.Background(), "todo-1", "user-1")
+
+		require.NoError(t, err)
+		assert.Equal(t, "2025-03-31T09:00:00Z", *result.DueDate)
+		assert.NotNil(t, result.Recurrence)
+	})
+
+	t.Run("skip fails on the last occurrence", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+		todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(newTodo("FREQ=DAILY;COUNT=1"), nil)
+
+		interactor := &TodoInteractor{todoRepo: todoRepo}
+
+		_, err := interactor.SkipTodoOccurrence(context.Background(), "todo-1", "user-1")
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "last occurrence")
+	})
+
+	t.Run("stop keeps the todo as a one-off", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+		todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(newTodo("FREQ=DAILY"), nil)
+		todoRepo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(update)
+
+		interactor := &TodoInteractor{todoRepo: todoRepo}
+
+		result, err := interactor.StopTodoRecurrence(context.Background(), "todo-1", "user-1")
+
+		require.NoError(t, err)
+		assert.Nil(t, result.Recurrence)
+		assert.Equal(t, "2025-01-31T09:00:00Z", *result.DueDate)
+	})
+
+	t.Run("only the owner can skip", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+		todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(newTodo("FREQ=DAILY"), nil)
+
+		interactor := &TodoInteractor{todoRepo: todoRepo}
+
+		_, err := interactor.SkipTodoOccurrence(context.Background(), "todo-1", "user-2")
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not allowed")
+	})
+}
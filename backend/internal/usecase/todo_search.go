@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"unicode"
+
+	"good-todo-go/internal/pkg/cerror"
+	"good-todo-go/internal/usecase/input"
+	"good-todo-go/internal/usecase/output"
+)
+
+// maxSearchTerms bounds the size of the generated tsquery
+const maxSearchTerms = 16
+
+func (i *TodoInteractor) SearchTodos(ctx context.Context, in *input.SearchTodosInput) (*output.TodoSearchOutput, error) {
+	tsquery := buildPrefixTSQuery(in.Query)
+	if tsquery == "" {
+		return nil, cerror.NewBadRequest("search query must contain at least one word", nil)
+	}
+
+	limit := in.Limit
+	if limit <= 0 {
+		limit = 20
+	}
+	if limit > 100 {
+		limit = 100
+	}
+
+	hits, total, err := i.todoRepo.Search(ctx, in.UserID, tsquery, limit, in.Offset)
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to search todos", err)
+	}
+
+	return output.NewTodoSearchOutput(hits, total), nil
+}
+
+// buildPrefixTSQuery turns free text into a to_tsquery expression that
+// requires every word, each matched as a prefix. Only letters and digits are
+// kept, so user input can never inject tsquery operators.
+func buildPrefixTSQuery(text string) string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(words) > maxSearchTerms {
+		words = words[:maxSearchTerms]
+	}
+
+	terms := make([]string, len(words))
+	for n, w := range words {
+		terms[n] = w + ":*"
+	}
+	return strings.Join(terms, " & ")
+}
@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"good-todo-go/internal/domain/model"
+	mock_repository "good-todo-go/internal/domain/repository/mock"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestBuildPrefixTSQuery(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{name: "single word", text: "report", want: "report:*"},
+		{name: "words are lowercased and all required", text: "Quarterly Report", want: "quarterly:* & report:*"},
+		{name: "operators are stripped", text: "a|b & !c:* <-> 'd'", want: "a:* & b:* & c:* & d:*"},
+		{name: "non-latin letters are kept", text: "café München", want: "café:* & münchen:*"},
+		{name: "punctuation only", text: "!!! ---", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, buildPrefixTSQuery(tt.text))
+		})
+	}
+}
+
+func TestTodoInteractor_SearchTodos(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		input       *input.SearchTodosInput
+		setupMocks  func(todoRepo *mock_repository.MockITodoRepository)
+		wantTitle   string
+		wantSnippet string
+		wantTotal   int
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:  "success - highlights are escaped before marking",
+			input: &input.SearchTodosInput{UserID: "user-1", Query: "report", Limit: 500},
+			setupMocks: func(todoRepo *mock_repository.MockITodoRepository) {
+				todoRepo.EXPECT().
+					Search(gomock.Any(), "user-1", "report:*", 100, 0).
+					Return([]*model.TodoSearchHit{{
+						Todo:           &model.Todo{ID: "todo-1", Title: "<b>report</b>"},
+						Rank:           0.5,
+						TitleHighlight: "<b>" + model.SearchHighlightStart + "report" + model.SearchHighlightEnd + "</b>",
+						Snippet:        "send the " + model.SearchHighlightStart + "reports" + model.SearchHighlightEnd + " & more",
+					}}, 1, nil)
+			},
+			wantTitle:   "&lt;b&gt;<mark>report</mark>&lt;/b&gt;",
+			wantSnippet: "send the <mark>reports</mark> &amp; more",
+			wantTotal:   1,
+		},
+		{
+			name:        "fail - query without words",
+			input:       &input.SearchTodosInput{UserID: "user-1", Query: "&&"},
+			setupMocks:  func(todoRepo *mock_repository.MockITodoRepository) {},
+			wantErr:     true,
+			errContains: "at least one word",
+		},
+		{
+			name:  "fail - repository error",
+			input: &input.SearchTodosInput{UserID: "user-1", Query: "report"},
+			setupMocks: func(todoRepo *mock_repository.MockITodoRepository) {
+				todoRepo.EXPECT().
+					Search(gomock.Any(), "user-1", "report:*", 20, 0).
+					Return(nil, 0, errors.New("db error"))
+			},
+			wantErr:     true,
+			errContains: "failed to search todos",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+			tt.setupMocks(todoRepo)
+
+			interactor := &TodoInteractor{todoRepo: todoRepo}
+
+			got, err := interactor.SearchTodos(context.Background(), tt.input)
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantTotal, got.Total)
+			require.Len(t, got.Results, 1)
+			assert.Equal(t, tt.wantTitle, got.Results[0].TitleHighlight)
+			assert.Equal(t, tt.wantSnippet, got.Results[0].Snippet)
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"context"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/pkg/cerror"
+	"good-todo-go/internal/usecase/input"
+	"good-todo-go/internal/usecase/output"
+)
+
+func (i *TodoInteractor) GetSharedTodos(ctx context.Context, in *input.GetTodosInput) (*output.TodoListOutput, error) {
+	return i.listTodos(ctx, in, i.todoRepo.FindSharedWith, i.todoRepo.CountSharedWith)
+}
+
+func (i *TodoInteractor) GetTodoShares(ctx context.Context, todoID, userID string) (*output.TodoShareListOutput, error) {
+	todo, err := i.todoRepo.FindByID(ctx, todoID)
+	if err != nil {
+		return nil, cerror.NewNotFound("todo not found", err)
+	}
+	if todo.UserID != userID {
+		return nil, cerror.NewForbidden("not allowed to access the shares of this todo", nil)
+	}
+
+	shares, err := i.todoRepo.FindShares(ctx, todo.ID)
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to get todo shares", err)
+	}
+
+	return output.NewTodoShareListOutput(shares), nil
+}
+
+func (i *TodoInteractor) ShareTodo(ctx context.Context, in *input.ShareTodoInput) (*output.TodoShareOutput, error) {
+	todo, err := i.todoRepo.FindByID(ctx, in.TodoID)
+	if err != nil {
+		return nil, cerror.NewNotFound("todo not found", err)
+	}
+	if todo.UserID != in.UserID {
+		return nil, cerror.NewForbidden("not allowed to share this todo", nil)
+	}
+
+	if in.Permission != model.TodoPermissionRead && in.Permission != model.TodoPermissionEdit {
+		return nil, cerror.NewBadRequest("permission must be read or edit", nil)
+	}
+	if in.MemberID == todo.UserID {
+		return nil, cerror.NewBadRequest("a todo cannot be shared with its owner", nil)
+	}
+
+	// Members of other tenants are hidden by RLS, so they are simply not found
+	member, err := i.userRepo.FindByID(ctx, in.MemberID)
+	if err != nil || member.TenantID != todo.TenantID {
+		return nil, cerror.NewBadRequest("member must be a member of this tenant", err)
+	}
+	if !member.IsActive {
+		return nil, cerror.NewBadRequest("member is deactivated", nil)
+	}
+
+	share, err := i.todoRepo.Share(ctx, &model.TodoShare{
+		ID:         i.uuidGen.Generate(),
+		TenantID:   todo.TenantID,
+		TodoID:     todo.ID,
+		UserID:     in.MemberID,
+		Permission: in.Permission,
+		CreatedBy:  in.UserID,
+	})
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to share todo", err)
+	}
+
+	return output.NewTodoShareOutput(share), nil
+}
+
+func (i *TodoInteractor) UnshareTodo(ctx context.Context, todoID, userID, memberID string) error {
+	todo, err := i.todoRepo.FindByID(ctx, todoID)
+	if err != nil {
+		return cerror.NewNotFound("todo not found", err)
+	}
+
+	// Only the owner revokes; a member may give up their own access
+	if todo.UserID != userID && memberID != userID {
+		return cerror.NewForbidden("not allowed to unshare this todo", nil)
+	}
+
+	if err := i.todoRepo.Unshare(ctx, todo.ID, memberID); err != nil {
+		return cerror.NewInternalServerError("failed to unshare todo", err)
+	}
+
+	return nil
+}
+
+// todoShareOf returns the share giving userID access to the todo, or nil
+func todoShareOf(todo *model.Todo, userID string) *model.TodoShare {
+	for _, share := range todo.Shares {
+		if share.UserID == userID {
+			return share
+		}
+	}
+	return nil
+}
+
+func isTodoEditor(todo *model.Todo, userID string) bool {
+	share := todoShareOf(todo, userID)
+	return share != nil && share.Permission == model.TodoPermissionEdit
+}
@@ -0,0 +1,374 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"good-todo-go/internal/domain/model"
+	mock_repository "good-todo-go/internal/domain/repository/mock"
+	mock_pkg "good-todo-go/internal/pkg/mock"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTodoInteractor_ShareTodo(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		callerID   string
+		memberID   string
+		permission string
+		// lookup is set when the member is looked up; a nil member is not found
+		lookup      bool
+		member      *model.User
+		wantShare   bool
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:       "success - owner shares for reading",
+			callerID:   "owner",
+			memberID:   "member",
+			permission: model.TodoPermissionRead,
+			lookup:     true,
+			member:     &model.User{ID: "member", TenantID: "tenant-1", IsActive: true},
+			wantShare:  true,
+		},
+		{
+			name:       "success - owner shares for editing",
+			callerID:   "owner",
+			memberID:   "member",
+			permission: model.TodoPermissionEdit,
+			lookup:     true,
+			member:     &model.User{ID: "member", TenantID: "tenant-1", IsActive: true},
+			wantShare:  true,
+		},
+		{
+			name:        "fail - unknown permission",
+			callerID:    "owner",
+			memberID:    "member",
+			permission:  "admin",
+			wantErr:     true,
+			errContains: "read or edit",
+		},
+		{
+			name:        "fail - shared with the owner",
+			callerID:    "owner",
+			memberID:    "owner",
+			permission:  model.TodoPermissionRead,
+			wantErr:     true,
+			errContains: "its owner",
+		},
+		{
+			name:        "fail - member not in the tenant",
+			callerID:    "owner",
+			memberID:    "stranger",
+			permission:  model.TodoPermissionRead,
+			lookup:      true,
+			wantErr:     true,
+			errContains: "member of this tenant",
+		},
+		{
+			name:        "fail - member deactivated",
+			callerID:    "owner",
+			memberID:    "member",
+			permission:  model.TodoPermissionRead,
+			lookup:      true,
+			member:      &model.User{ID: "member", TenantID: "tenant-1", IsActive: false},
+			wantErr:     true,
+			errContains: "deactivated",
+		},
+		{
+			name:        "fail - editor cannot share on",
+			callerID:    "editor",
+			memberID:    "member",
+			permission:  model.TodoPermissionRead,
+			wantErr:     true,
+			errContains: "not allowed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+			userRepo := mock_repository.NewMockIUserRepository(ctrl)
+			uuidGen := mock_pkg.NewMockIUUIDGenerator(ctrl)
+
+			todo := &model.Todo{
+				ID:       "todo-1",
+				UserID:   "owner",
+				TenantID: "tenant-1",
+				Shares:   []*model.TodoShare{{UserID: "editor", Permission: model.TodoPermissionEdit}},
+			}
+			todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(todo, nil)
+			if tt.lookup {
+				var err error
+				if tt.member == nil {
+					err = errors.New("not found")
+				}
+				userRepo.EXPECT().FindByID(gomock.Any(), tt.memberID).Return(tt.member, err)
+			}
+			if tt.wantShare {
+				uuidGen.EXPECT().Generate().Return("share-1")
+				todoRepo.EXPECT().
+					Share(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, share *model.TodoShare) (*model.TodoShare, error) {
+						assert.Equal(t, "tenant-1", share.TenantID)
+						assert.Equal(t, tt.memberID, share.UserID)
+						assert.Equal(t, tt.callerID, share.CreatedBy)
+						return share, nil
+					})
+			}
+
+			interactor := &TodoInteractor{todoRepo: todoRepo, userRepo: userRepo, uuidGen: uuidGen}
+
+			result, err := interactor.ShareTodo(context.Background(), &input.ShareTodoInput{
+				TodoID:     "todo-1",
+				UserID:     tt.callerID,
+				MemberID:   tt.memberID,
+				Permission: tt.permission,
+			})
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.memberID, result.UserID)
+			assert.Equal(t, tt.permission, result.Permission)
+		})
+	}
+}
+
+func TestTodoInteractor_UnshareTodo(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		callerID    string
+		memberID    string
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:     "success - owner revokes",
+			callerID: "owner",
+			memberID: "member",
+		},
+		{
+			name:     "success - member gives up their access",
+			callerID: "member",
+			memberID: "member",
+		},
+		{
+			name:        "fail - member cannot revoke someone else",
+			callerID:    "member",
+			memberID:    "other",
+			wantErr:     true,
+			errContains: "not allowed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+			todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(&model.Todo{
+				ID:     "todo-1",
+				UserID: "owner",
+				Shares: []*model.TodoShare{
+					{UserID: "member", Permission: model.TodoPermissionRead},
+					{UserID: "other", Permission: model.TodoPermissionRead},
+				},
+			}, nil)
+			if !tt.wantErr {
+				todoRepo.EXPECT().Unshare(gomock.Any(), "todo-1", tt.memberID).Return(nil)
+			}
+
+			interactor := &TodoInteractor{todoRepo: todoRepo}
+
+			err := interactor.UnshareTodo(context.Background(), "todo-1", tt.callerID, tt.memberID)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestTodoInteractor_SharedAccess(t *testing.T) {
+	t.Parallel()
+
+	shared := func() *model.Todo {
+		return &model.Todo{
+			ID:       "todo-1",
+			UserID:   "owner",
+			TenantID: "tenant-1",
+			Title:    "Plan",
+			Shares: []*model.TodoShare{
+				{UserID: "reader", Permission: model.TodoPermissionRead},
+				{UserID: "editor", Permission: model.TodoPermissionEdit},
+			},
+		}
+	}
+	update := func(_ context.Context, todo *model.Todo) (*model.Todo, error) {
+		return todo, nil
+	}
+
+	t.Run("reader can view a private todo", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+		todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(shared(), nil)
+		todoRepo.EXPECT().FindChildren(gomock.Any(), "todo-1").Return([]*model.Todo{}, nil)
+
+		interactor := &TodoInteractor{todoRepo: todoRepo}
+
+		result, err := interactor.GetTodo(context.Background(), "todo-1", "reader")
+
+		require.NoError(t, err)
+		assert.Equal(t, "todo-1", result.ID)
+	})
+
+	t.Run("other members cannot view a private todo", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+		todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(shared(), nil)
+
+		interactor := &TodoInteractor{todoRepo: todoRepo}
+
+		_, err := interactor.GetTodo(context.Background(), "todo-1", "stranger")
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not allowed")
+	})
+
+	t.Run("reader cannot edit", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+		todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(shared(), nil)
+
+		interactor := &TodoInteractor{todoRepo: todoRepo}
+
+		_, err := interactor.UpdateTodo(context.Background(), &input.UpdateTodoInput{
+			TodoID: "todo-1",
+			UserID: "reader",
+			Title:  strPtr("Renamed"),
+		})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not allowed")
+	})
+
+	t.Run("editor can edit", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+		todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(shared(), nil)
+		todoRepo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(update)
+
+		interactor := &TodoInteractor{todoRepo: todoRepo}
+
+		result, err := interactor.UpdateTodo(context.Background(), &input.UpdateTodoInput{
+			TodoID: "todo-1",
+			UserID: "editor",
+			Title:  strPtr("Renamed"),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Renamed", result.Title)
+	})
+
+	t.Run("editor cannot change visibility", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+		todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(shared(), nil)
+
+		interactor := &TodoInteractor{todoRepo: todoRepo}
+
+		isPublic := true
+		_, err := interactor.UpdateTodo(context.Background(), &input.UpdateTodoInput{
+			TodoID:   "todo-1",
+			UserID:   "editor",
+			IsPublic: &isPublic,
+		})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not allowed")
+	})
+
+	t.Run("editor cannot move with a patch", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+		todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(shared(), nil)
+
+		interactor := &TodoInteractor{todoRepo: todoRepo}
+
+		_, err := interactor.PatchTodo(context.Background(), &input.PatchTodoInput{
+			TodoID: "todo-1",
+			UserID: "editor",
+			Patch:  map[string]interface{}{"title": "Renamed", "project_id": "project-1"},
+		})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not allowed")
+	})
+
+	t.Run("editor cannot see the shares", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+		todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(shared(), nil)
+
+		interactor := &TodoInteractor{todoRepo: todoRepo}
+
+		_, err := interactor.GetTodoShares(context.Background(), "todo-1", "editor")
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not allowed")
+	})
+}
@@ -0,0 +1,259 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"good-todo-go/internal/domain/model"
+	mock_repository "good-todo-go/internal/domain/repository/mock"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTodoInteractor_SetTodoParent(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		input       *input.SetTodoParentInput
+		setupMocks  func(todoRepo *mock_repository.MockITodoRepository)
+		wantParent  *string
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:  "success - nest under own todo",
+			input: &input.SetTodoParentInput{TodoID: "todo-1", UserID: "user-1", ParentID: strPtr("parent-1")},
+			setupMocks: func(todoRepo *mock_repository.MockITodoRepository) {
+				todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(&model.Todo{ID: "todo-1", UserID: "user-1"}, nil)
+				todoRepo.EXPECT().FindByID(gomock.Any(), "parent-1").Return(&model.Todo{ID: "parent-1", UserID: "user-1"}, nil)
+				todoRepo.EXPECT().SubtreeDepth(gomock.Any(), "todo-1").Return(2, nil)
+				todoRepo.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, todo *model.Todo) (*model.Todo, error) {
+						return todo, nil
+					})
+			},
+			wantParent: strPtr("parent-1"),
+		},
+		{
+			name:  "success - make top-level",
+			input: &input.SetTodoParentInput{TodoID: "todo-1", UserID: "user-1"},
+			setupMocks: func(todoRepo *mock_repository.MockITodoRepository) {
+				todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(&model.Todo{ID: "todo-1", UserID: "user-1", ParentID: strPtr("parent-1")}, nil)
+				todoRepo.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, todo *model.Todo) (*model.Todo, error) {
+						return todo, nil
+					})
+			},
+			wantParent: nil,
+		},
+		{
+			name:  "fail - parent is one of its subtasks",
+			input: &input.SetTodoParentInput{TodoID: "todo-1", UserID: "user-1", ParentID: strPtr("child-1")},
+			setupMocks: func(todoRepo *mock_repository.MockITodoRepository) {
+				todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(&model.Todo{ID: "todo-1", UserID: "user-1"}, nil)
+				todoRepo.EXPECT().FindByID(gomock.Any(), "child-1").Return(&model.Todo{ID: "child-1", UserID: "user-1", ParentID: strPtr("todo-1")}, nil)
+				todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(&model.Todo{ID: "todo-1", UserID: "user-1"}, nil)
+			},
+			wantErr:     true,
+			errContains: "cannot be a subtask of itself",
+		},
+		{
+			name:  "fail - too deep",
+			input: &input.SetTodoParentInput{TodoID: "todo-1", UserID: "user-1", ParentID: strPtr("parent-2")},
+			setupMocks: func(todoRepo *mock_repository.MockITodoRepository) {
+				todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(&model.Todo{ID: "todo-1", UserID: "user-1"}, nil)
+				todoRepo.EXPECT().FindByID(gomock.Any(), "parent-2").Return(&model.Todo{ID: "parent-2", UserID: "user-1", ParentID: strPtr("parent-1")}, nil)
+				todoRepo.EXPECT().FindByID(gomock.Any(), "parent-1").Return(&model.Todo{ID: "parent-1", UserID: "user-1"}, nil)
+				todoRepo.EXPECT().SubtreeDepth(gomock.Any(), "todo-1").Return(2, nil)
+			},
+			wantErr:     true,
+			errContains: "at most 3 levels",
+		},
+		{
+			name:  "fail - public todo of another user",
+			input: &input.SetTodoParentInput{TodoID: "todo-1", UserID: "user-1", ParentID: strPtr("other-1")},
+			setupMocks: func(todoRepo *mock_repository.MockITodoRepository) {
+				todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(&model.Todo{ID: "todo-1", UserID: "user-1"}, nil)
+				todoRepo.EXPECT().FindByID(gomock.Any(), "other-1").Return(&model.Todo{ID: "other-1", UserID: "user-2", IsPublic: true}, nil)
+			},
+			wantErr:     true,
+			errContains: "not allowed",
+		},
+		{
+			name:  "fail - private todo of another user",
+			input: &input.SetTodoParentInput{TodoID: "todo-1", UserID: "user-1", ParentID: strPtr("other-1")},
+			setupMocks: func(todoRepo *mock_repository.MockITodoRepository) {
+				todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(&model.Todo{ID: "todo-1", UserID: "user-1"}, nil)
+				todoRepo.EXPECT().FindByID(gomock.Any(), "other-1").Return(&model.Todo{ID: "other-1", UserID: "user-2"}, nil)
+			},
+			wantErr:     true,
+			errContains: "parent todo not found",
+		},
+		{
+			name:  "fail - parent not found",
+			input: &input.SetTodoParentInput{TodoID: "todo-1", UserID: "user-1", ParentID: strPtr("missing")},
+			setupMocks: func(todoRepo *mock_repository.MockITodoRepository) {
+				todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(&model.Todo{ID: "todo-1", UserID: "user-1"}, nil)
+				todoRepo.EXPECT().FindByID(gomock.Any(), "missing").Return(nil, errors.New("not found"))
+			},
+			wantErr:     true,
+			errContains: "parent todo not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+			tt.setupMocks(todoRepo)
+
+			interactor := &TodoInteractor{todoRepo: todoRepo}
+
+			result, err := interactor.SetTodoParent(context.Background(), tt.input)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantParent, result.ParentID)
+		})
+	}
+}
+
+func TestTodoInteractor_DeleteTodo_Children(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		children    string
+		setupMocks  func(todoRepo *mock_repository.MockITodoRepository)
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name: "fail - subtasks without a mode",
+			setupMocks: func(todoRepo *mock_repository.MockITodoRepository) {
+				todoRepo.EXPECT().FindChildren(gomock.Any(), "todo-1").Return([]*model.Todo{{ID: "child-1"}}, nil)
+			},
+			wantErr:     true,
+			errContains: "todo has subtasks",
+		},
+		{
+			name:     "success - delete subtasks",
+			children: DeleteChildrenDelete,
+			setupMocks: func(todoRepo *mock_repository.MockITodoRepository) {
+				todoRepo.EXPECT().DeleteSubtree(gomock.Any(), "todo-1", 1).Return(nil)
+			},
+		},
+		{
+			name:     "success - detach subtasks",
+			children: DeleteChildrenDetach,
+			setupMocks: func(todoRepo *mock_repository.MockITodoRepository) {
+				todoRepo.EXPECT().Delete(gomock.Any(), "todo-1", 1).Return(nil)
+			},
+		},
+		{
+			name:        "fail - unknown mode",
+			children:    "orphan",
+			setupMocks:  func(todoRepo *mock_repository.MockITodoRepository) {},
+			wantErr:     true,
+			errContains: "children must be",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+			todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(&model.Todo{ID: "todo-1", UserID: "user-1", Version: 1}, nil)
+			tt.setupMocks(todoRepo)
+
+			interactor := &TodoInteractor{todoRepo: todoRepo}
+
+			err := interactor.DeleteTodo(context.Background(), &input.DeleteTodoInput{TodoID: "todo-1", UserID: "user-1", Children: tt.children})
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestTodoInteractor_GetTodo_Children(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+	todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(&model.Todo{ID: "todo-1", UserID: "user-1", IsPublic: true}, nil)
+	todoRepo.EXPECT().FindChildren(gomock.Any(), "todo-1").Return([]*model.Todo{
+		{ID: "child-1", UserID: "user-1", IsPublic: true, Completed: true},
+		{ID: "child-2", UserID: "user-1", IsPublic: true},
+		{ID: "child-3", UserID: "user-1"},
+	}, nil)
+
+	interactor := &TodoInteractor{todoRepo: todoRepo}
+
+	// Another user only sees the public subtasks
+	result, err := interactor.GetTodo(context.Background(), "todo-1", "user-2")
+
+	require.NoError(t, err)
+	require.Len(t, result.Children, 2)
+	assert.Equal(t, "child-1", result.Children[0].ID)
+	assert.Equal(t, "child-2", result.Children[1].ID)
+	require.NotNil(t, result.Progress)
+	assert.Equal(t, 1, result.Progress.Completed)
+	assert.Equal(t, 2, result.Progress.Total)
+	assert.InDelta(t, 0.5, result.Progress.Ratio, 1e-9)
+}
+
+func TestTodoInteractor_UpdateTodo_CascadeCompletion(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+	todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(&model.Todo{ID: "todo-1", UserID: "user-1"}, nil)
+	todoRepo.EXPECT().
+		UpdateWithFollowUps(gomock.Any(), gomock.Any(), true, nil).
+		DoAndReturn(func(_ context.Context, todo *model.Todo, _ bool, _ *model.Todo) (*model.Todo, *model.Todo, error) {
+			return todo, nil, nil
+		})
+
+	interactor := &TodoInteractor{todoRepo: todoRepo}
+
+	completed := true
+	result, err := interactor.UpdateTodo(context.Background(), &input.UpdateTodoInput{
+		TodoID:            "todo-1",
+		UserID:            "user-1",
+		Completed:         &completed,
+		CascadeCompletion: true,
+	})
+
+	require.NoError(t, err)
+	assert.True(t, result.Completed)
+}
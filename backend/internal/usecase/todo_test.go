@@ -14,6 +14,7 @@ import (
 	"good-todo-go/internal/usecase/output"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
 
@@ -26,6 +27,9 @@ func TestTodoInteractor_GetTodos(t *testing.T) {
 	}
 
 	now := time.Now()
+	completed := false
+	search := "report"
+	defaultTodoSort := &model.TodoSort{Field: model.TodoSortCreatedAt, Desc: true}
 
 	tests := []struct {
 		name    string
@@ -66,11 +70,11 @@ func TestTodoInteractor_GetTodos(t *testing.T) {
 				}
 
 				todoRepo.EXPECT().
-					FindByUserID(ctx, "user-1", 20, 0).
+					FindByUserID(ctx, "user-1", &model.TodoFilter{}, defaultTodoSort, &model.TodoPage{Limit: 21}).
 					Return(todos, nil)
 
 				todoRepo.EXPECT().
-					CountByUserID(ctx, "user-1").
+					CountByUserID(ctx, "user-1", &model.TodoFilter{}).
 					Return(2, nil)
 
 				return &TodoInteractor{
@@ -110,7 +114,7 @@ func TestTodoInteractor_GetTodos(t *testing.T) {
 							UpdatedAt:   now.Format("2006-01-02T15:04:05Z07:00"),
 						},
 					},
-					Total: 2,
+					Total: intPtr(2),
 				},
 				err: nil,
 			},
@@ -123,11 +127,11 @@ func TestTodoInteractor_GetTodos(t *testing.T) {
 				uuidGen := mock_pkg.NewMockIUUIDGenerator(ctrl)
 
 				todoRepo.EXPECT().
-					FindByUserID(ctx, "user-1", 100, 0). // capped at 100
+					FindByUserID(ctx, "user-1", &model.TodoFilter{}, defaultTodoSort, &model.TodoPage{Limit: 101}). // capped at 100
 					Return([]*model.Todo{}, nil)
 
 				todoRepo.EXPECT().
-					CountByUserID(ctx, "user-1").
+					CountByUserID(ctx, "user-1", &model.TodoFilter{}).
 					Return(0, nil)
 
 				return &TodoInteractor{
@@ -144,11 +148,115 @@ func TestTodoInteractor_GetTodos(t *testing.T) {
 			want: want{
 				output: &output.TodoListOutput{
 					Todos: []*output.TodoOutput{},
-					Total: 0,
+					Total: intPtr(0),
 				},
 				err: nil,
 			},
 		},
+		{
+			name: "success - filters and sort are passed to the repository",
+			usecase: func(ctx context.Context, ctrl *gomock.Controller) ITodoInteractor {
+				todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+				userRepo := mock_repository.NewMockIUserRepository(ctrl)
+				uuidGen := mock_pkg.NewMockIUUIDGenerator(ctrl)
+
+				filter := &model.TodoFilter{Completed: &completed, DueBefore: &now, Search: &search}
+				todoRepo.EXPECT().
+					FindByUserID(ctx, "user-1", filter, &model.TodoSort{Field: model.TodoSortDueDate, Desc: false}, &model.TodoPage{Limit: 21}).
+					Return([]*model.Todo{}, nil)
+
+				todoRepo.EXPECT().
+					CountByUserID(ctx, "user-1", filter).
+					Return(0, nil)
+
+				return &TodoInteractor{
+					todoRepo: todoRepo,
+					userRepo: userRepo,
+					uuidGen:  uuidGen,
+				}
+			},
+			input: &input.GetTodosInput{
+				UserID:    "user-1",
+				Completed: &completed,
+				DueBefore: &now,
+				Search:    &search,
+				Sort:      model.TodoSortDueDate,
+				Order:     "asc",
+			},
+			want: want{
+				output: &output.TodoListOutput{
+					Todos: []*output.TodoOutput{},
+					Total: intPtr(0),
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "success - manual order reads top to bottom by default",
+			usecase: func(ctx context.Context, ctrl *gomock.Controller) ITodoInteractor {
+				todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+
+				todoRepo.EXPECT().
+					FindByUserID(ctx, "user-1", &model.TodoFilter{}, &model.TodoSort{Field: model.TodoSortPosition, Desc: false}, &model.TodoPage{Limit: 21}).
+					Return([]*model.Todo{}, nil)
+				todoRepo.EXPECT().
+					CountByUserID(ctx, "user-1", &model.TodoFilter{}).
+					Return(0, nil)
+
+				return &TodoInteractor{
+					todoRepo: todoRepo,
+					userRepo: mock_repository.NewMockIUserRepository(ctrl),
+					uuidGen:  mock_pkg.NewMockIUUIDGenerator(ctrl),
+				}
+			},
+			input: &input.GetTodosInput{
+				UserID: "user-1",
+				Sort:   model.TodoSortPosition,
+			},
+			want: want{
+				output: &output.TodoListOutput{
+					Todos: []*output.TodoOutput{},
+					Total: intPtr(0),
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "error - unknown sort field",
+			usecase: func(ctx context.Context, ctrl *gomock.Controller) ITodoInteractor {
+				return &TodoInteractor{
+					todoRepo: mock_repository.NewMockITodoRepository(ctrl),
+					userRepo: mock_repository.NewMockIUserRepository(ctrl),
+					uuidGen:  mock_pkg.NewMockIUUIDGenerator(ctrl),
+				}
+			},
+			input: &input.GetTodosInput{
+				UserID: "user-1",
+				Sort:   "importance",
+			},
+			want: want{
+				output: nil,
+				err:    cerror.NewBadRequest("invalid sort field", nil),
+			},
+		},
+		{
+			name: "error - unknown sort order",
+			usecase: func(ctx context.Context, ctrl *gomock.Controller) ITodoInteractor {
+				return &TodoInteractor{
+					todoRepo: mock_repository.NewMockITodoRepository(ctrl),
+					userRepo: mock_repository.NewMockIUserRepository(ctrl),
+					uuidGen:  mock_pkg.NewMockIUUIDGenerator(ctrl),
+				}
+			},
+			input: &input.GetTodosInput{
+				UserID: "user-1",
+				Order:  "sideways",
+			},
+			want: want{
+				output: nil,
+				err:    cerror.NewBadRequest("invalid sort order", nil),
+			},
+		},
 		{
 			name: "error - repository error on find",
 			usecase: func(ctx context.Context, ctrl *gomock.Controller) ITodoInteractor {
@@ -157,7 +265,7 @@ func TestTodoInteractor_GetTodos(t *testing.T) {
 				uuidGen := mock_pkg.NewMockIUUIDGenerator(ctrl)
 
 				todoRepo.EXPECT().
-					FindByUserID(ctx, "user-1", 20, 0).
+					FindByUserID(ctx, "user-1", &model.TodoFilter{}, defaultTodoSort, &model.TodoPage{Limit: 21}).
 					Return(nil, errors.New("db error"))
 
 				return &TodoInteractor{
@@ -200,6 +308,96 @@ func TestTodoInteractor_GetTodos(t *testing.T) {
 	}
 }
 
+func TestTodoInteractor_GetTodos_Cursor(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	todos := []*model.Todo{
+		{ID: "todo-3", UserID: "user-1", Title: "c", CreatedAt: created.Add(2 * time.Hour)},
+		{ID: "todo-2", UserID: "user-1", Title: "b", CreatedAt: created.Add(time.Hour)},
+		{ID: "todo-1", UserID: "user-1", Title: "a", CreatedAt: created},
+	}
+	defaultSort := &model.TodoSort{Field: model.TodoSortCreatedAt, Desc: true}
+
+	t.Run("first page returns a cursor and the total", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+		todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+		todoRepo.EXPECT().
+			FindByUserID(ctx, "user-1", gomock.Any(), defaultSort, &model.TodoPage{Limit: 3}).
+			Return(todos, nil)
+		todoRepo.EXPECT().CountByUserID(ctx, "user-1", gomock.Any()).Return(3, nil)
+
+		interactor := &TodoInteractor{todoRepo: todoRepo}
+
+		got, err := interactor.GetTodos(ctx, &input.GetTodosInput{UserID: "user-1", Limit: 2})
+		require.NoError(t, err)
+		assert.Len(t, got.Todos, 2)
+		assert.Equal(t, intPtr(3), got.Total)
+		require.NotNil(t, got.NextCursor)
+
+		// The cursor points at the last returned todo
+		after, err := decodeTodoCursor(*got.NextCursor, defaultSort)
+		require.NoError(t, err)
+		assert.Equal(t, "todo-2", after.ID)
+		assert.True(t, after.Time.Equal(todos[1].CreatedAt))
+	})
+
+	t.Run("cursor page skips the count and ends without a cursor", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+		cursor := encodeTodoCursor(defaultSort, todos[1])
+
+		todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+		todoRepo.EXPECT().
+			FindByUserID(ctx, "user-1", gomock.Any(), defaultSort, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, userID string, filter *model.TodoFilter, sort *model.TodoSort, page *model.TodoPage) ([]*model.Todo, error) {
+				require.NotNil(t, page.After)
+				assert.Equal(t, "todo-2", page.After.ID)
+				return todos[2:], nil
+			})
+
+		interactor := &TodoInteractor{todoRepo: todoRepo}
+
+		got, err := interactor.GetTodos(ctx, &input.GetTodosInput{UserID: "user-1", Limit: 2, Cursor: cursor})
+		require.NoError(t, err)
+		assert.Len(t, got.Todos, 1)
+		assert.Nil(t, got.Total)
+		assert.Nil(t, got.NextCursor)
+	})
+
+	t.Run("cursor from another sort is rejected", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		cursor := encodeTodoCursor(&model.TodoSort{Field: model.TodoSortTitle}, todos[0])
+		interactor := &TodoInteractor{todoRepo: mock_repository.NewMockITodoRepository(ctrl)}
+
+		_, err := interactor.GetTodos(context.Background(), &input.GetTodosInput{UserID: "user-1", Cursor: cursor})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cursor does not match")
+	})
+
+	t.Run("malformed cursor is rejected", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		interactor := &TodoInteractor{todoRepo: mock_repository.NewMockITodoRepository(ctrl)}
+
+		_, err := interactor.GetTodos(context.Background(), &input.GetTodosInput{UserID: "user-1", Cursor: "not a cursor!"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid cursor")
+	})
+}
+
 func TestTodoInteractor_GetTodo(t *testing.T) {
 	t.Parallel()
 
@@ -232,6 +430,10 @@ func TestTodoInteractor_GetTodo(t *testing.T) {
 						UpdatedAt:   now,
 					}, nil)
 
+				todoRepo.EXPECT().
+					FindChildren(ctx, "todo-1").
+					Return([]*model.Todo{}, nil)
+
 				return &TodoInteractor{
 					todoRepo: todoRepo,
 					userRepo: userRepo,
@@ -262,6 +464,10 @@ func TestTodoInteractor_GetTodo(t *testing.T) {
 						UpdatedAt:   now,
 					}, nil)
 
+				todoRepo.EXPECT().
+					FindChildren(ctx, "todo-1").
+					Return([]*model.Todo{}, nil)
+
 				return &TodoInteractor{
 					todoRepo: todoRepo,
 					userRepo: userRepo,
@@ -583,6 +789,174 @@ func TestTodoInteractor_UpdateTodo(t *testing.T) {
 	}
 }
 
+func TestTodoInteractor_UpdateTodo_CompletedAt(t *testing.T) {
+	t.Parallel()
+
+	earlier := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	yes, no := true, false
+
+	tests := []struct {
+		name          string
+		existing      *model.Todo
+		completed     *bool
+		wantCompleted bool
+		check         func(t *testing.T, completedAt *time.Time)
+	}{
+		{
+			name:          "completing sets completed_at",
+			existing:      &model.Todo{ID: "todo-1", UserID: "user-1"},
+			completed:     &yes,
+			wantCompleted: true,
+			check: func(t *testing.T, completedAt *time.Time) {
+				require.NotNil(t, completedAt)
+				assert.WithinDuration(t, time.Now(), *completedAt, time.Minute)
+			},
+		},
+		{
+			name:          "re-completing keeps the original completed_at",
+			existing:      &model.Todo{ID: "todo-1", UserID: "user-1", Completed: true, CompletedAt: &earlier},
+			completed:     &yes,
+			wantCompleted: true,
+			check: func(t *testing.T, completedAt *time.Time) {
+				require.NotNil(t, completedAt)
+				assert.Equal(t, earlier, *completedAt)
+			},
+		},
+		{
+			name:          "reopening clears completed_at",
+			existing:      &model.Todo{ID: "todo-1", UserID: "user-1", Completed: true, CompletedAt: &earlier},
+			completed:     &no,
+			wantCompleted: false,
+			check: func(t *testing.T, completedAt *time.Time) {
+				assert.Nil(t, completedAt)
+			},
+		},
+		{
+			name:          "other updates leave completed_at alone",
+			existing:      &model.Todo{ID: "todo-1", UserID: "user-1", Completed: true, CompletedAt: &earlier},
+			wantCompleted: true,
+			check: func(t *testing.T, completedAt *time.Time) {
+				require.NotNil(t, completedAt)
+				assert.Equal(t, earlier, *completedAt)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+			todoRepo.EXPECT().FindByID(ctx, "todo-1").Return(tt.existing, nil)
+
+			var saved *model.Todo
+			todoRepo.EXPECT().
+				Update(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, todo *model.Todo) (*model.Todo, error) {
+					saved = todo
+					return todo, nil
+				})
+
+			interactor := &TodoInteractor{todoRepo: todoRepo}
+
+			_, err := interactor.UpdateTodo(ctx, &input.UpdateTodoInput{
+				TodoID:    "todo-1",
+				UserID:    "user-1",
+				Completed: tt.completed,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantCompleted, saved.Completed)
+			tt.check(t, saved.CompletedAt)
+		})
+	}
+}
+
+func TestTodoInteractor_GetCompletionStats(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		input       *input.GetCompletionStatsInput
+		completions func(from time.Time) []time.Time
+		wantDays    int
+		wantTotal   int
+		wantErr     error
+	}{
+		{
+			name:  "success - week is split into seven days",
+			input: &input.GetCompletionStatsInput{UserID: "user-1", Period: CompletionPeriodWeek, Timezone: "Asia/Tokyo"},
+			completions: func(from time.Time) []time.Time {
+				return []time.Time{
+					from.Add(time.Hour),
+					from.Add(2 * time.Hour),
+					from.AddDate(0, 0, 6).Add(23 * time.Hour),
+				}
+			},
+			wantDays:  7,
+			wantTotal: 3,
+		},
+		{
+			name:  "success - today defaults to UTC",
+			input: &input.GetCompletionStatsInput{UserID: "user-1", Period: CompletionPeriodToday},
+			completions: func(from time.Time) []time.Time {
+				return []time.Time{from.Add(time.Minute)}
+			},
+			wantDays:  1,
+			wantTotal: 1,
+		},
+		{
+			name:    "error - unknown timezone",
+			input:   &input.GetCompletionStatsInput{UserID: "user-1", Timezone: "Mars/Olympus_Mons"},
+			wantErr: cerror.NewBadRequest("invalid timezone", nil),
+		},
+		{
+			name:    "error - unknown period",
+			input:   &input.GetCompletionStatsInput{UserID: "user-1", Period: "fortnight"},
+			wantErr: cerror.NewBadRequest("invalid period", nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+			if tt.completions != nil {
+				todoRepo.EXPECT().
+					ListCompletionTimes(ctx, "user-1", gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, userID string, from, to time.Time) ([]time.Time, error) {
+						assert.Equal(t, tt.wantDays, int(to.Sub(from).Hours()/24))
+						assert.Equal(t, 0, from.Hour())
+						return tt.completions(from), nil
+					})
+			}
+
+			interactor := &TodoInteractor{todoRepo: todoRepo}
+
+			got, err := interactor.GetCompletionStats(ctx, tt.input)
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantTotal, got.Total)
+			require.Len(t, got.Days, tt.wantDays)
+			if tt.wantDays == 7 {
+				assert.Equal(t, 2, got.Days[0].Count)
+				assert.Equal(t, 1, got.Days[6].Count)
+			}
+		})
+	}
+}
+
 func TestTodoInteractor_DeleteTodo(t *testing.T) {
 	t.Parallel()
 
@@ -616,7 +990,11 @@ func TestTodoInteractor_DeleteTodo(t *testing.T) {
 					}, nil)
 
 				todoRepo.EXPECT().
-					Delete(ctx, "todo-1").
+					FindChildren(ctx, "todo-1").
+					Return([]*model.Todo{}, nil)
+
+				todoRepo.EXPECT().
+					Delete(ctx, "todo-1", 0).
 					Return(nil)
 
 				return &TodoInteractor{
@@ -670,7 +1048,7 @@ func TestTodoInteractor_DeleteTodo(t *testing.T) {
 			ctx := context.Background()
 			interactor := tt.usecase(ctx, ctrl)
 
-			gotErr := interactor.DeleteTodo(ctx, tt.todoID, tt.userID)
+			gotErr := interactor.DeleteTodo(ctx, &input.DeleteTodoInput{TodoID: tt.todoID, UserID: tt.userID})
 
 			if tt.wantErr {
 				assert.Error(t, gotErr)
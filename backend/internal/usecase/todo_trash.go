@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/pkg/cerror"
+	"good-todo-go/internal/usecase/input"
+	"good-todo-go/internal/usecase/output"
+)
+
+func (i *TodoInteractor) GetTrash(ctx context.Context, in *input.GetTrashInput) (*output.TodoListOutput, error) {
+	limit := in.Limit
+	if limit <= 0 {
+		limit = 20
+	}
+	if limit > 100 {
+		limit = 100
+	}
+
+	todos, err := i.todoRepo.FindTrash(ctx, in.UserID, &model.TodoPage{Limit: limit, Offset: in.Offset})
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to get trash", err)
+	}
+
+	total, err := i.todoRepo.CountTrash(ctx, in.UserID)
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to count trash", err)
+	}
+
+	return output.NewTodoListOutput(todos, &total, nil), nil
+}
+
+func (i *TodoInteractor) RestoreTodo(ctx context.Context, todoID, userID string) (*output.TodoOutput, error) {
+	todo, err := i.todoRepo.FindTrashedByID(ctx, todoID)
+	if err != nil {
+		return nil, cerror.NewNotFound("todo not found in trash", err)
+	}
+
+	// Only the owner can delete, so only the owner can restore
+	if todo.UserID != userID {
+		return nil, cerror.NewForbidden("not allowed to restore this todo", nil)
+	}
+
+	restored, err := i.todoRepo.Restore(ctx, todo.ID)
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to restore todo", err)
+	}
+
+	return output.NewTodoOutput(restored), nil
+}
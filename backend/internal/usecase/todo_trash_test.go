@@ -0,0 +1,158 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	mock_repository "good-todo-go/internal/domain/repository/mock"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTodoInteractor_GetTrash(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	deletedAt := time.Date(2026, 1, 2, 9, 0, 0, 0, time.UTC)
+	todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+	todoRepo.EXPECT().
+		FindTrash(gomock.Any(), "user-1", &model.TodoPage{Limit: 100, Offset: 5}).
+		Return([]*model.Todo{{ID: "todo-1", UserID: "user-1", DeletedAt: &deletedAt}}, nil)
+	todoRepo.EXPECT().CountTrash(gomock.Any(), "user-1").Return(6, nil)
+
+	interactor := &TodoInteractor{todoRepo: todoRepo}
+
+	result, err := interactor.GetTrash(context.Background(), &input.GetTrashInput{UserID: "user-1", Limit: 500, Offset: 5})
+	require.NoError(t, err)
+	require.Len(t, result.Todos, 1)
+	require.NotNil(t, result.Todos[0].DeletedAt)
+	assert.Equal(t, "2026-01-02T09:00:00Z", *result.Todos[0].DeletedAt)
+	require.NotNil(t, result.Total)
+	assert.Equal(t, 6, *result.Total)
+	assert.Nil(t, result.NextCursor)
+}
+
+func TestTodoInteractor_RestoreTodo(t *testing.T) {
+	t.Parallel()
+
+	deletedAt := time.Date(2026, 1, 2, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		setupMocks  func(todoRepo *mock_repository.MockITodoRepository)
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name: "success",
+			setupMocks: func(todoRepo *mock_repository.MockITodoRepository) {
+				todoRepo.EXPECT().
+					FindTrashedByID(gomock.Any(), "todo-1").
+					Return(&model.Todo{ID: "todo-1", UserID: "user-1", DeletedAt: &deletedAt}, nil)
+				todoRepo.EXPECT().
+					Restore(gomock.Any(), "todo-1").
+					Return(&model.Todo{ID: "todo-1", UserID: "user-1"}, nil)
+			},
+		},
+		{
+			name: "fail - not in the trash",
+			setupMocks: func(todoRepo *mock_repository.MockITodoRepository) {
+				todoRepo.EXPECT().FindTrashedByID(gomock.Any(), "todo-1").Return(nil, errors.New("not found"))
+			},
+			wantErr:     true,
+			errContains: "todo not found in trash",
+		},
+		{
+			name: "fail - todo of someone else",
+			setupMocks: func(todoRepo *mock_repository.MockITodoRepository) {
+				todoRepo.EXPECT().
+					FindTrashedByID(gomock.Any(), "todo-1").
+					Return(&model.Todo{ID: "todo-1", UserID: "owner", IsPublic: true, DeletedAt: &deletedAt}, nil)
+			},
+			wantErr:     true,
+			errContains: "not allowed to restore",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+			tt.setupMocks(todoRepo)
+
+			interactor := &TodoInteractor{todoRepo: todoRepo}
+
+			result, err := interactor.RestoreTodo(context.Background(), "todo-1", "user-1")
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "todo-1", result.ID)
+			assert.Nil(t, result.DeletedAt)
+		})
+	}
+}
+
+func TestTrashPurger_RunOnce(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tenantRepo := mock_repository.NewMockITenantRepository(ctrl)
+	todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+
+	tenantRepo.EXPECT().ListIDs(gomock.Any()).Return([]string{"tenant-1", "tenant-2", "tenant-3"}, nil)
+	tenantRepo.EXPECT().
+		FindByID(gomock.Any(), "tenant-1").
+		Return(&model.Tenant{ID: "tenant-1", Settings: model.TenantSettings{TrashRetentionDays: 7}}, nil)
+	tenantRepo.EXPECT().
+		FindByID(gomock.Any(), "tenant-2").
+		Return(&model.Tenant{ID: "tenant-2", Settings: model.TenantSettings{TrashRetentionDays: 30}}, nil)
+	tenantRepo.EXPECT().FindByID(gomock.Any(), "tenant-3").Return(nil, errors.New("db error"))
+
+	// Each tenant's cutoff follows its own retention
+	var cutoffs []time.Time
+	todoRepo.EXPECT().
+		PurgeTrash(gomock.Any(), "tenant-1", gomock.Any(), 10).
+		DoAndReturn(func(_ context.Context, _ string, deletedBefore time.Time, _ int) (int, error) {
+			cutoffs = append(cutoffs, deletedBefore)
+			return 3, nil
+		})
+	todoRepo.EXPECT().
+		PurgeTrash(gomock.Any(), "tenant-2", gomock.Any(), 10).
+		DoAndReturn(func(_ context.Context, _ string, deletedBefore time.Time, _ int) (int, error) {
+			cutoffs = append(cutoffs, deletedBefore)
+			return 0, nil
+		})
+
+	purger := NewTrashPurger(tenantRepo, todoRepo, 10)
+
+	before := time.Now().UTC()
+	purged, err := purger.RunOnce(context.Background())
+	after := time.Now().UTC()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "tenant-3")
+	assert.Equal(t, 3, purged)
+
+	require.Len(t, cutoffs, 2)
+	assert.WithinRange(t, cutoffs[0], before.AddDate(0, 0, -7), after.AddDate(0, 0, -7))
+	assert.WithinRange(t, cutoffs[1], before.AddDate(0, 0, -30), after.AddDate(0, 0, -30))
+}
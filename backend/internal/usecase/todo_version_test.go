@@ -0,0 +1,173 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	mock_repository "good-todo-go/internal/domain/repository/mock"
+	"good-todo-go/internal/pkg/cerror"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTodoInteractor_UpdateTodo_Version(t *testing.T) {
+	t.Parallel()
+
+	title := "renamed"
+
+	tests := []struct {
+		name       string
+		ifMatch    []int
+		setupMocks func(todoRepo *mock_repository.MockITodoRepository)
+		wantStatus int
+	}{
+		{
+			name:    "success - matching version",
+			ifMatch: []int{2, 3},
+			setupMocks: func(todoRepo *mock_repository.MockITodoRepository) {
+				todoRepo.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, todo *model.Todo) (*model.Todo, error) {
+						updated := *todo
+						updated.Version++
+						return &updated, nil
+					})
+			},
+		},
+		{
+			name:       "fail - version not listed",
+			ifMatch:    []int{2},
+			setupMocks: func(todoRepo *mock_repository.MockITodoRepository) {},
+			wantStatus: http.StatusPreconditionFailed,
+		},
+		{
+			name:       "fail - no valid tag",
+			ifMatch:    []int{},
+			setupMocks: func(todoRepo *mock_repository.MockITodoRepository) {},
+			wantStatus: http.StatusPreconditionFailed,
+		},
+		{
+			name:    "fail - changed after the check",
+			ifMatch: []int{3},
+			setupMocks: func(todoRepo *mock_repository.MockITodoRepository) {
+				todoRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil, repository.ErrTodoVersionConflict)
+			},
+			wantStatus: http.StatusPreconditionFailed,
+		},
+		{
+			name: "fail - changed at the same time without If-Match",
+			setupMocks: func(todoRepo *mock_repository.MockITodoRepository) {
+				todoRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil, repository.ErrTodoVersionConflict)
+			},
+			wantStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+			todoRepo.EXPECT().
+				FindByID(gomock.Any(), "todo-1").
+				Return(&model.Todo{ID: "todo-1", UserID: "user-1", Title: "original", Version: 3}, nil)
+			tt.setupMocks(todoRepo)
+
+			interactor := &TodoInteractor{todoRepo: todoRepo}
+
+			result, err := interactor.UpdateTodo(context.Background(), &input.UpdateTodoInput{
+				TodoID:  "todo-1",
+				UserID:  "user-1",
+				Title:   &title,
+				IfMatch: tt.ifMatch,
+			})
+
+			if tt.wantStatus != 0 {
+				var appErr *cerror.AppError
+				require.True(t, errors.As(err, &appErr))
+				assert.Equal(t, tt.wantStatus, appErr.HTTPStatus)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "renamed", result.Title)
+			assert.Equal(t, 4, result.Version)
+		})
+	}
+}
+
+func TestTodoInteractor_DeleteTodo_Version(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		ifMatch    []int
+		setupMocks func(todoRepo *mock_repository.MockITodoRepository)
+		wantStatus int
+	}{
+		{
+			name:    "success - matching version",
+			ifMatch: []int{3},
+			setupMocks: func(todoRepo *mock_repository.MockITodoRepository) {
+				todoRepo.EXPECT().Delete(gomock.Any(), "todo-1", 3).Return(nil)
+			},
+		},
+		{
+			name:       "fail - version not listed",
+			ifMatch:    []int{2},
+			setupMocks: func(todoRepo *mock_repository.MockITodoRepository) {},
+			wantStatus: http.StatusPreconditionFailed,
+		},
+		{
+			name:    "fail - changed after the check",
+			ifMatch: []int{3},
+			setupMocks: func(todoRepo *mock_repository.MockITodoRepository) {
+				todoRepo.EXPECT().Delete(gomock.Any(), "todo-1", 3).Return(repository.ErrTodoVersionConflict)
+			},
+			wantStatus: http.StatusPreconditionFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+			todoRepo.EXPECT().
+				FindByID(gomock.Any(), "todo-1").
+				Return(&model.Todo{ID: "todo-1", UserID: "user-1", Version: 3}, nil)
+			tt.setupMocks(todoRepo)
+
+			interactor := &TodoInteractor{todoRepo: todoRepo}
+
+			err := interactor.DeleteTodo(context.Background(), &input.DeleteTodoInput{
+				TodoID:   "todo-1",
+				UserID:   "user-1",
+				Children: DeleteChildrenDetach,
+				IfMatch:  tt.ifMatch,
+			})
+
+			if tt.wantStatus != 0 {
+				var appErr *cerror.AppError
+				require.True(t, errors.As(err, &appErr))
+				assert.Equal(t, tt.wantStatus, appErr.HTTPStatus)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
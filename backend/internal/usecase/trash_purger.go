@@ -0,0 +1,66 @@
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_usecase
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"good-todo-go/internal/domain/repository"
+)
+
+// ITrashPurger permanently removes todos that have been in the trash for
+// longer than their tenant's retention period
+type ITrashPurger interface {
+	// RunOnce purges up to batchSize todos per tenant and returns how many it purged
+	RunOnce(ctx context.Context) (int, error)
+}
+
+type TrashPurger struct {
+	tenantRepo repository.ITenantRepository
+	todoRepo   repository.ITodoRepository
+	batchSize  int
+}
+
+func NewTrashPurger(
+	tenantRepo repository.ITenantRepository,
+	todoRepo repository.ITodoRepository,
+	batchSize int,
+) ITrashPurger {
+	return &TrashPurger{
+		tenantRepo: tenantRepo,
+		todoRepo:   todoRepo,
+		batchSize:  batchSize,
+	}
+}
+
+func (p *TrashPurger) RunOnce(ctx context.Context) (int, error) {
+	tenantIDs, err := p.tenantRepo.ListIDs(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list tenants: %w", err)
+	}
+
+	now := time.Now().UTC()
+	purged := 0
+	var errs []error
+	for _, tenantID := range tenantIDs {
+		if err := ctx.Err(); err != nil {
+			return purged, err
+		}
+		tenant, err := p.tenantRepo.FindByID(ctx, tenantID)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("tenant %s: %w", tenantID, err))
+			continue
+		}
+
+		cutoff := now.AddDate(0, 0, -tenant.Settings.TrashRetentionDays)
+		n, err := p.todoRepo.PurgeTrash(ctx, tenantID, cutoff, p.batchSize)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("tenant %s: %w", tenantID, err))
+			continue
+		}
+		purged += n
+	}
+	return purged, errors.Join(errs...)
+}
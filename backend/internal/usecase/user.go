@@ -3,26 +3,72 @@ package usecase
 
 import (
 	"context"
+	"time"
 
+	"good-todo-go/internal/domain/model"
 	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/pkg"
 	"good-todo-go/internal/pkg/cerror"
 	"good-todo-go/internal/usecase/input"
 	"good-todo-go/internal/usecase/output"
 )
 
 type IUserInteractor interface {
+	// Authenticate loads the user an access token was issued to. Deleted and
+	// deactivated users are rejected, so their unexpired tokens stop working.
+	Authenticate(ctx context.Context, userID string) (*output.UserOutput, error)
 	GetMe(ctx context.Context, userID string) (*output.UserOutput, error)
 	UpdateMe(ctx context.Context, in *input.UpdateUserInput) (*output.UserOutput, error)
+	// ExportMe collects the user's profile and todos into a personal data export
+	ExportMe(ctx context.Context, userID string) (*output.AccountExportOutput, error)
+	// DeleteMe removes the user's account after confirming their password. The
+	// tenant's only active admin cannot leave, as nobody could manage it after them.
+	DeleteMe(ctx context.Context, in *input.DeleteMeInput) error
 }
 
+const (
+	// deletedUserEmail identifies the per-tenant placeholder that takes over
+	// public todos of deleted members. The .invalid TLD can never receive mail.
+	deletedUserEmail = "deleted-user@deleted.invalid"
+	deletedUserName  = "Deleted user"
+	// exportPageSize is how many todos are read per query while exporting
+	exportPageSize = 100
+)
+
 type UserInteractor struct {
-	userRepo repository.IUserRepository
+	userRepo    repository.IUserRepository
+	todoRepo    repository.ITodoRepository
+	tenantRepo  repository.ITenantRepository
+	uuidGen     pkg.IUUIDGenerator
+	auditLogger *AuditLogger
 }
 
-func NewUserInteractor(userRepo repository.IUserRepository) IUserInteractor {
+func NewUserInteractor(
+	userRepo repository.IUserRepository,
+	todoRepo repository.ITodoRepository,
+	tenantRepo repository.ITenantRepository,
+	uuidGen pkg.IUUIDGenerator,
+	auditLogger *AuditLogger,
+) IUserInteractor {
 	return &UserInteractor{
-		userRepo: userRepo,
+		userRepo:    userRepo,
+		todoRepo:    todoRepo,
+		tenantRepo:  tenantRepo,
+		uuidGen:     uuidGen,
+		auditLogger: auditLogger,
+	}
+}
+
+func (i *UserInteractor) Authenticate(ctx context.Context, userID string) (*output.UserOutput, error) {
+	user, err := i.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, cerror.NewUnauthorized("invalid or expired token", nil)
+	}
+	if !user.IsActive {
+		return nil, cerror.NewUnauthorized("invalid or expired token", nil)
 	}
+
+	return output.NewUserOutput(user), nil
 }
 
 func (i *UserInteractor) GetMe(ctx context.Context, userID string) (*output.UserOutput, error) {
@@ -50,3 +96,153 @@ func (i *UserInteractor) UpdateMe(ctx context.Context, in *input.UpdateUserInput
 
 	return output.NewUserOutput(updated), nil
 }
+
+func (i *UserInteractor) ExportMe(ctx context.Context, userID string) (*output.AccountExportOutput, error) {
+	user, err := i.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, cerror.NewNotFound("user not found", err)
+	}
+
+	// Todos in the trash are still the user's data until they are purged
+	filter := &model.TodoFilter{IncludeDeleted: true}
+	var todos []*model.Todo
+	for offset := 0; ; offset += exportPageSize {
+		page, err := i.todoRepo.FindByUserID(ctx, userID, filter, nil, &model.TodoPage{Limit: exportPageSize, Offset: offset})
+		if err != nil {
+			return nil, cerror.NewInternalServerError("failed to get todos", err)
+		}
+		todos = append(todos, page...)
+		if len(page) < exportPageSize {
+			break
+		}
+	}
+
+	return output.NewAccountExportOutput(user, todos, time.Now()), nil
+}
+
+func (i *UserInteractor) DeleteMe(ctx context.Context, in *input.DeleteMeInput) error {
+	user, err := i.userRepo.FindByID(ctx, in.UserID)
+	if err != nil {
+		return cerror.NewNotFound("user not found", err)
+	}
+
+	if !pkg.CheckPasswordHash(in.Password, user.PasswordHash) {
+		return cerror.NewBadRequest("password is incorrect", nil)
+	}
+
+	if user.Role == "admin" && user.IsActive {
+		otherAdminID, err := i.findOtherAdmin(ctx, user.ID)
+		if err != nil {
+			return err
+		}
+		if otherAdminID == "" {
+			return cerror.NewConflict("the only admin of the tenant cannot delete their account", nil)
+		}
+	}
+
+	tenant, err := i.tenantRepo.FindByID(ctx, user.TenantID)
+	if err != nil {
+		return cerror.NewInternalServerError("failed to get tenant", err)
+	}
+
+	heirID, err := i.findHeir(ctx, user, tenant.Settings.AccountDeletionPolicy)
+	if err != nil {
+		return err
+	}
+
+	if err := i.userRepo.Delete(ctx, user.ID, heirID); err != nil {
+		return cerror.NewInternalServerError("failed to delete user", err)
+	}
+	i.auditLogger.Record(ctx, userEvent(user.TenantID, user.ID, model.AuditActionUserDeleted, user.ID, map[string]string{
+		"email":           user.Email,
+		"public_todos_to": heirID,
+	}))
+
+	return nil
+}
+
+// findHeir picks who takes over the deleting user's public todos. Under the
+// reassign policy that is the longest-standing other active admin; without
+// one, and under the anonymize policy, it is the tenant's placeholder user.
+func (i *UserInteractor) findHeir(ctx context.Context, user *model.User, policy string) (string, error) {
+	if policy == model.AccountDeletionReassign {
+		adminID, err := i.findOtherAdmin(ctx, user.ID)
+		if err != nil {
+			return "", err
+		}
+		if adminID != "" {
+			return adminID, nil
+		}
+	}
+
+	placeholder, err := i.findOrCreateDeletedUser(ctx, user.TenantID)
+	if err != nil {
+		return "", cerror.NewInternalServerError("failed to prepare placeholder user", err)
+	}
+	return placeholder.ID, nil
+}
+
+// findOtherAdmin returns the longest-standing active admin other than the
+// user, or "" if there is none
+func (i *UserInteractor) findOtherAdmin(ctx context.Context, userID string) (string, error) {
+	role := "admin"
+	active := true
+	// List returns the oldest admins first; the user may be one of them
+	admins, _, err := i.userRepo.List(ctx, &model.UserFilter{Role: &role, IsActive: &active}, 0, 2)
+	if err != nil {
+		return "", cerror.NewInternalServerError("failed to list admins", err)
+	}
+	for _, admin := range admins {
+		if admin.ID != userID {
+			return admin.ID, nil
+		}
+	}
+	return "", nil
+}
+
+// findOrCreateDeletedUser returns the tenant's inactive "Deleted user"
+// placeholder, creating it on first use. It is a system user, so it does not
+// show up among the tenant's members.
+func (i *UserInteractor) findOrCreateDeletedUser(ctx context.Context, tenantID string) (*model.User, error) {
+	email := deletedUserEmail
+	filter := &model.UserFilter{Email: &email, System: true}
+
+	users, _, err := i.userRepo.List(ctx, filter, 0, 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(users) > 0 {
+		return users[0], nil
+	}
+
+	// Nobody can sign in as the placeholder: it is inactive and its password is never revealed
+	random, err := generateVerificationToken()
+	if err != nil {
+		return nil, err
+	}
+	passwordHash, err := pkg.HashPassword(random)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := i.userRepo.Create(ctx, &model.User{
+		ID:            i.uuidGen.Generate(),
+		TenantID:      tenantID,
+		Email:         deletedUserEmail,
+		PasswordHash:  passwordHash,
+		Name:          deletedUserName,
+		Role:          "member",
+		EmailVerified: true,
+		IsActive:      false,
+		IsSystem:      true,
+	})
+	if err != nil {
+		// Another deletion in the same tenant may have created it concurrently
+		users, _, findErr := i.userRepo.List(ctx, filter, 0, 1)
+		if findErr == nil && len(users) > 0 {
+			return users[0], nil
+		}
+		return nil, err
+	}
+	return created, nil
+}
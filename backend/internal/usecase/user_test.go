@@ -3,10 +3,14 @@ package usecase
 import (
 	"context"
 	"errors"
+	"net/http"
 	"testing"
 
 	"good-todo-go/internal/domain/model"
 	mock_repository "good-todo-go/internal/domain/repository/mock"
+	"good-todo-go/internal/pkg"
+	"good-todo-go/internal/pkg/cerror"
+	mock_pkg "good-todo-go/internal/pkg/mock"
 	"good-todo-go/internal/usecase/input"
 
 	"github.com/stretchr/testify/assert"
@@ -14,6 +18,66 @@ import (
 	"go.uber.org/mock/gomock"
 )
 
+func TestUserInteractor_Authenticate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		user     *model.User
+		findErr  error
+		wantErr  bool
+		wantRole string
+	}{
+		{
+			name:     "success - role comes from the database",
+			user:     &model.User{ID: "user-id-1", TenantID: "tenant-id", Role: "admin", IsActive: true},
+			wantRole: "admin",
+		},
+		{
+			name:    "fail - user deactivated",
+			user:    &model.User{ID: "user-id-1", TenantID: "tenant-id", Role: "admin", IsActive: false},
+			wantErr: true,
+		},
+		{
+			name:    "fail - user deleted",
+			findErr: errors.New("not found"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepo := mock_repository.NewMockIUserRepository(ctrl)
+			userRepo.EXPECT().
+				FindByID(gomock.Any(), "user-id-1").
+				Return(tt.user, tt.findErr)
+
+			interactor := NewUserInteractor(
+				userRepo,
+				mock_repository.NewMockITodoRepository(ctrl),
+				mock_repository.NewMockITenantRepository(ctrl),
+				mock_pkg.NewMockIUUIDGenerator(ctrl),
+				newNopAuditLogger(ctrl),
+			)
+
+			result, err := interactor.Authenticate(context.Background(), "user-id-1")
+
+			if tt.wantErr {
+				var appErr *cerror.AppError
+				require.ErrorAs(t, err, &appErr)
+				assert.Equal(t, http.StatusUnauthorized, appErr.HTTPStatus)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantRole, result.Role)
+		})
+	}
+}
+
 func TestUserInteractor_GetMe(t *testing.T) {
 	t.Parallel()
 
@@ -61,7 +125,13 @@ func TestUserInteractor_GetMe(t *testing.T) {
 			userRepo := mock_repository.NewMockIUserRepository(ctrl)
 			tt.setupMocks(userRepo)
 
-			interactor := NewUserInteractor(userRepo)
+			interactor := NewUserInteractor(
+				userRepo,
+				mock_repository.NewMockITodoRepository(ctrl),
+				mock_repository.NewMockITenantRepository(ctrl),
+				mock_pkg.NewMockIUUIDGenerator(ctrl),
+				newNopAuditLogger(ctrl),
+			)
 
 			result, err := interactor.GetMe(context.Background(), tt.userID)
 
@@ -202,7 +272,13 @@ func TestUserInteractor_UpdateMe(t *testing.T) {
 			userRepo := mock_repository.NewMockIUserRepository(ctrl)
 			tt.setupMocks(userRepo)
 
-			interactor := NewUserInteractor(userRepo)
+			interactor := NewUserInteractor(
+				userRepo,
+				mock_repository.NewMockITodoRepository(ctrl),
+				mock_repository.NewMockITenantRepository(ctrl),
+				mock_pkg.NewMockIUUIDGenerator(ctrl),
+				newNopAuditLogger(ctrl),
+			)
 
 			result, err := interactor.UpdateMe(context.Background(), tt.input)
 
@@ -220,6 +296,206 @@ func TestUserInteractor_UpdateMe(t *testing.T) {
 	}
 }
 
+func TestUserInteractor_ExportMe(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepo := mock_repository.NewMockIUserRepository(ctrl)
+	todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+
+	userRepo.EXPECT().
+		FindByID(gomock.Any(), "user-id-1").
+		Return(&model.User{ID: "user-id-1", Email: "test@example.com", Role: "member"}, nil)
+
+	// A full first page forces a second read
+	firstPage := make([]*model.Todo, exportPageSize)
+	for i := range firstPage {
+		firstPage[i] = &model.Todo{ID: "todo", UserID: "user-id-1"}
+	}
+	// Todos in the trash are part of the export
+	withTrash := &model.TodoFilter{IncludeDeleted: true}
+	gomock.InOrder(
+		todoRepo.EXPECT().FindByUserID(gomock.Any(), "user-id-1", withTrash, nil, &model.TodoPage{Limit: exportPageSize}).Return(firstPage, nil),
+		todoRepo.EXPECT().FindByUserID(gomock.Any(), "user-id-1", withTrash, nil, &model.TodoPage{Limit: exportPageSize, Offset: exportPageSize}).
+			Return([]*model.Todo{{ID: "last", UserID: "user-id-1"}}, nil),
+	)
+
+	interactor := NewUserInteractor(userRepo, todoRepo, mock_repository.NewMockITenantRepository(ctrl), mock_pkg.NewMockIUUIDGenerator(ctrl), newNopAuditLogger(ctrl))
+
+	result, err := interactor.ExportMe(context.Background(), "user-id-1")
+	require.NoError(t, err)
+	assert.Equal(t, "test@example.com", result.User.Email)
+	assert.Len(t, result.Todos, exportPageSize+1)
+	assert.Equal(t, "last", result.Todos[exportPageSize].ID)
+	assert.NotEmpty(t, result.ExportedAt)
+}
+
+func TestUserInteractor_DeleteMe(t *testing.T) {
+	t.Parallel()
+
+	passwordHash, err := pkg.HashPassword("correct-password")
+	require.NoError(t, err)
+
+	leaving := func(role string) *model.User {
+		return &model.User{
+			ID:           "user-id-1",
+			TenantID:     "tenant-id",
+			Email:        "test@example.com",
+			PasswordHash: passwordHash,
+			Role:         role,
+			IsActive:     true,
+		}
+	}
+	tenantWith := func(policy string) *model.Tenant {
+		return &model.Tenant{ID: "tenant-id", Settings: model.TenantSettings{AccountDeletionPolicy: policy}}
+	}
+
+	tests := []struct {
+		name        string
+		password    string
+		setupMocks  func(userRepo *mock_repository.MockIUserRepository, tenantRepo *mock_repository.MockITenantRepository, uuidGen *mock_pkg.MockIUUIDGenerator)
+		wantErr     bool
+		wantStatus  int
+		errContains string
+	}{
+		{
+			name:     "success - anonymize reuses the placeholder",
+			password: "correct-password",
+			setupMocks: func(userRepo *mock_repository.MockIUserRepository, tenantRepo *mock_repository.MockITenantRepository, _ *mock_pkg.MockIUUIDGenerator) {
+				userRepo.EXPECT().FindByID(gomock.Any(), "user-id-1").Return(leaving("member"), nil)
+				tenantRepo.EXPECT().FindByID(gomock.Any(), "tenant-id").Return(tenantWith(model.AccountDeletionAnonymize), nil)
+				userRepo.EXPECT().List(gomock.Any(), gomock.Any(), 0, 1).
+					DoAndReturn(func(_ context.Context, filter *model.UserFilter, _, _ int) ([]*model.User, int, error) {
+						assert.Equal(t, deletedUserEmail, *filter.Email)
+						assert.True(t, filter.System)
+						return []*model.User{{ID: "placeholder-id"}}, 1, nil
+					})
+				userRepo.EXPECT().Delete(gomock.Any(), "user-id-1", "placeholder-id").Return(nil)
+			},
+		},
+		{
+			name:     "success - anonymize creates the placeholder on first use",
+			password: "correct-password",
+			setupMocks: func(userRepo *mock_repository.MockIUserRepository, tenantRepo *mock_repository.MockITenantRepository, uuidGen *mock_pkg.MockIUUIDGenerator) {
+				userRepo.EXPECT().FindByID(gomock.Any(), "user-id-1").Return(leaving("member"), nil)
+				tenantRepo.EXPECT().FindByID(gomock.Any(), "tenant-id").Return(tenantWith(model.AccountDeletionAnonymize), nil)
+				userRepo.EXPECT().List(gomock.Any(), gomock.Any(), 0, 1).Return([]*model.User{}, 0, nil)
+				uuidGen.EXPECT().Generate().Return("placeholder-id")
+				userRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, u *model.User) (*model.User, error) {
+						assert.Equal(t, deletedUserName, u.Name)
+						assert.False(t, u.IsActive)
+						assert.True(t, u.IsSystem)
+						return u, nil
+					})
+				userRepo.EXPECT().Delete(gomock.Any(), "user-id-1", "placeholder-id").Return(nil)
+			},
+		},
+		{
+			name:     "success - reassign hands todos to another admin",
+			password: "correct-password",
+			setupMocks: func(userRepo *mock_repository.MockIUserRepository, tenantRepo *mock_repository.MockITenantRepository, _ *mock_pkg.MockIUUIDGenerator) {
+				userRepo.EXPECT().FindByID(gomock.Any(), "user-id-1").Return(leaving("admin"), nil)
+				tenantRepo.EXPECT().FindByID(gomock.Any(), "tenant-id").Return(tenantWith(model.AccountDeletionReassign), nil)
+				// The oldest admins come first; the leaving admin is skipped. The
+				// admins are listed to check that one stays and to pick the heir.
+				userRepo.EXPECT().List(gomock.Any(), gomock.Any(), 0, 2).
+					DoAndReturn(func(_ context.Context, filter *model.UserFilter, _, _ int) ([]*model.User, int, error) {
+						assert.Equal(t, "admin", *filter.Role)
+						assert.True(t, *filter.IsActive)
+						assert.False(t, filter.System)
+						return []*model.User{{ID: "user-id-1"}, {ID: "admin-id"}}, 2, nil
+					}).
+					Times(2)
+				userRepo.EXPECT().Delete(gomock.Any(), "user-id-1", "admin-id").Return(nil)
+			},
+		},
+		{
+			name:     "success - reassign without another admin falls back to the placeholder",
+			password: "correct-password",
+			setupMocks: func(userRepo *mock_repository.MockIUserRepository, tenantRepo *mock_repository.MockITenantRepository, _ *mock_pkg.MockIUUIDGenerator) {
+				userRepo.EXPECT().FindByID(gomock.Any(), "user-id-1").Return(leaving("member"), nil)
+				tenantRepo.EXPECT().FindByID(gomock.Any(), "tenant-id").Return(tenantWith(model.AccountDeletionReassign), nil)
+				userRepo.EXPECT().List(gomock.Any(), gomock.Any(), 0, 2).Return([]*model.User{}, 0, nil)
+				userRepo.EXPECT().List(gomock.Any(), gomock.Any(), 0, 1).Return([]*model.User{{ID: "placeholder-id"}}, 1, nil)
+				userRepo.EXPECT().Delete(gomock.Any(), "user-id-1", "placeholder-id").Return(nil)
+			},
+		},
+		{
+			name:     "fail - the tenant's only active admin",
+			password: "correct-password",
+			setupMocks: func(userRepo *mock_repository.MockIUserRepository, _ *mock_repository.MockITenantRepository, _ *mock_pkg.MockIUUIDGenerator) {
+				userRepo.EXPECT().FindByID(gomock.Any(), "user-id-1").Return(leaving("admin"), nil)
+				userRepo.EXPECT().List(gomock.Any(), gomock.Any(), 0, 2).Return([]*model.User{{ID: "user-id-1"}}, 1, nil)
+			},
+			wantErr:     true,
+			wantStatus:  http.StatusConflict,
+			errContains: "only admin",
+		},
+		{
+			name:     "fail - wrong password",
+			password: "wrong-password",
+			setupMocks: func(userRepo *mock_repository.MockIUserRepository, _ *mock_repository.MockITenantRepository, _ *mock_pkg.MockIUUIDGenerator) {
+				userRepo.EXPECT().FindByID(gomock.Any(), "user-id-1").Return(leaving("member"), nil)
+			},
+			wantErr:     true,
+			errContains: "password is incorrect",
+		},
+		{
+			name:     "fail - delete error",
+			password: "correct-password",
+			setupMocks: func(userRepo *mock_repository.MockIUserRepository, tenantRepo *mock_repository.MockITenantRepository, _ *mock_pkg.MockIUUIDGenerator) {
+				userRepo.EXPECT().FindByID(gomock.Any(), "user-id-1").Return(leaving("member"), nil)
+				tenantRepo.EXPECT().FindByID(gomock.Any(), "tenant-id").Return(tenantWith(model.AccountDeletionAnonymize), nil)
+				userRepo.EXPECT().List(gomock.Any(), gomock.Any(), 0, 1).Return([]*model.User{{ID: "placeholder-id"}}, 1, nil)
+				userRepo.EXPECT().Delete(gomock.Any(), "user-id-1", "placeholder-id").Return(errors.New("database error"))
+			},
+			wantErr:     true,
+			errContains: "failed to delete user",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepo := mock_repository.NewMockIUserRepository(ctrl)
+			tenantRepo := mock_repository.NewMockITenantRepository(ctrl)
+			uuidGen := mock_pkg.NewMockIUUIDGenerator(ctrl)
+			tt.setupMocks(userRepo, tenantRepo, uuidGen)
+
+			interactor := NewUserInteractor(userRepo, mock_repository.NewMockITodoRepository(ctrl), tenantRepo, uuidGen, newNopAuditLogger(ctrl))
+
+			err := interactor.DeleteMe(context.Background(), &input.DeleteMeInput{
+				UserID:   "user-id-1",
+				Password: tt.password,
+			})
+
+			if tt.wantErr {
+				require.Error(t, err)
+				if tt.wantStatus != 0 {
+					var appErr *cerror.AppError
+					require.ErrorAs(t, err, &appErr)
+					assert.Equal(t, tt.wantStatus, appErr.HTTPStatus)
+				}
+				if tt.errContains != "" {
+					assert.Contains(t, err.Error(), tt.errContains)
+				}
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func intPtr(i int) *int {
+	return &i
+}
+
 func strPtr(s string) *string {
 	return &s
 }